@@ -0,0 +1,46 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// topoproxy serves the topology over gRPC, from an in-memory cache kept
+// up to date by watching the topology server. vtgate and vttablet can
+// then use the 'grpc' topo implementation to talk to it, instead of
+// all talking to the topology server directly.
+package main
+
+import (
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/topo"
+)
+
+func init() {
+	servenv.RegisterDefaultFlags()
+}
+
+// used at runtime by plug-ins
+var (
+	ts *topo.Server
+)
+
+func main() {
+	servenv.ParseFlags("topoproxy")
+	servenv.Init()
+	defer servenv.Close()
+
+	ts = topo.Open()
+	defer ts.Close()
+
+	servenv.RunDefault()
+}
@@ -53,7 +53,12 @@ to the handling of the query path.
 The command-line parameters to vtbackup specify a policy for when a new backup
 is needed, and when old backups should be removed. If the existing backups
 already satisfy the policy, then vtbackup will do nothing and return success
-immediately.
+immediately. With -prune_only, vtbackup only removes the old backups.
+
+With -verify, vtbackup instead restores the most recent backup (or the one
+named by -verify_backup_name) into its own mysqld, checks its tables, and
+stores the result in the backup storage, where vtctl GetBackupVerification
+can show it. vtbackup fails if the backup didn't pass the verification.
 */
 package main
 
@@ -66,7 +71,6 @@ import (
 	"math/big"
 	"os"
 	"os/signal"
-	"strings"
 	"syscall"
 	"time"
 
@@ -107,9 +111,15 @@ var (
 	_ = flag.Duration("timeout", 2*time.Hour, "DEPRECATED AND UNUSED")
 	_ = flag.Duration("replication_timeout", 1*time.Hour, "DEPRECATED AND UNUSED")
 
-	minBackupInterval = flag.Duration("min_backup_interval", 0, "Only take a new backup if it's been at least this long since the most recent backup.")
-	minRetentionTime  = flag.Duration("min_retention_time", 0, "Keep each old backup for at least this long before removing it. Set to 0 to disable pruning of old backups.")
-	minRetentionCount = flag.Int("min_retention_count", 1, "Always keep at least this many of the most recent backups in this backup storage location, even if some are older than the min_retention_time. This must be at least 1 since a backup must always exist to allow new backups to be made")
+	minBackupInterval   = flag.Duration("min_backup_interval", 0, "Only take a new backup if it's been at least this long since the most recent backup.")
+	minRetentionTime    = flag.Duration("min_retention_time", 0, "Keep each old backup for at least this long before removing it. Pruning of old backups is disabled if this, retention_keep_daily and retention_keep_weekly are all 0.")
+	minRetentionCount   = flag.Int("min_retention_count", 1, "Always keep at least this many of the most recent backups in this backup storage location, even if some are older than the min_retention_time. This must be at least 1 since a backup must always exist to allow new backups to be made")
+	retentionKeepDaily  = flag.Int("retention_keep_daily", 0, "When pruning, also keep the most recent backup of each of this many days, today included (UTC).")
+	retentionKeepWeekly = flag.Int("retention_keep_weekly", 0, "When pruning, also keep the most recent backup of each of this many weeks starting on Monday, this week included (UTC).")
+	pruneOnly           = flag.Bool("prune_only", false, "Only prune old backups, without taking a new backup.")
+	pruneDryRun         = flag.Bool("prune_dry_run", false, "Only log the old backups that would be pruned, without removing them.")
+	verify              = flag.Bool("verify", false, "Instead of taking a backup, restore a backup into a scratch mysqld, check its tables, and store the result in the backup storage.")
+	verifyBackupName    = flag.String("verify_backup_name", "", "With -verify, the name of the backup to verify. The most recent complete backup is verified if empty.")
 
 	initialBackup    = flag.Bool("initial_backup", false, "Instead of restoring from backup, initialize an empty database with the provided init_db_sql_file and upload a backup of that for the shard, if the shard has no backups yet. This can be used to seed a brand new shard with an initial, empty backup. If any backups already exist for the shard, this will be considered a successful no-op. This can only be done before the shard exists in topology (i.e. before any tablets are deployed).")
 	allowFirstBackup = flag.Bool("allow_first_backup", false, "Allow this job to take the first backup of an existing shard.")
@@ -146,6 +156,10 @@ func main() {
 		log.Errorf("min_retention_count must be at least 1 to allow restores to succeed")
 		exit.Return(1)
 	}
+	if *retentionKeepDaily < 0 || *retentionKeepWeekly < 0 {
+		log.Errorf("retention_keep_daily and retention_keep_weekly can't be negative")
+		exit.Return(1)
+	}
 
 	// Catch SIGTERM and SIGINT so we get a chance to clean up.
 	ctx, cancel := context.WithCancel(context.Background())
@@ -168,14 +182,25 @@ func main() {
 	topoServer := topo.Open()
 	defer topoServer.Close()
 
+	if *verify {
+		if err := verifyBackup(ctx); err != nil {
+			log.Errorf("Failed to verify backup: %v", err)
+			exit.Return(1)
+		}
+		return
+	}
+
 	// Try to take a backup, if it's been long enough since the last one.
 	// Skip pruning if backup wasn't fully successful. We don't want to be
 	// deleting things if the backup process is not healthy.
 	backupDir := mysqlctl.GetBackupDir(*initKeyspace, *initShard)
-	doBackup, err := shouldBackup(ctx, topoServer, backupStorage, backupDir)
-	if err != nil {
-		log.Errorf("Can't take backup: %v", err)
-		exit.Return(1)
+	doBackup := false
+	if !*pruneOnly {
+		doBackup, err = shouldBackup(ctx, topoServer, backupStorage, backupDir)
+		if err != nil {
+			log.Errorf("Can't take backup: %v", err)
+			exit.Return(1)
+		}
 	}
 	if doBackup {
 		if err := takeBackup(ctx, topoServer, backupStorage); err != nil {
@@ -192,18 +217,9 @@ func main() {
 }
 
 func takeBackup(ctx context.Context, topoServer *topo.Server, backupStorage backupstorage.BackupStorage) error {
-	// This is an imaginary tablet alias. The value doesn't matter for anything,
-	// except that we generate a random UID to ensure the target backup
-	// directory is unique if multiple vtbackup instances are launched for the
-	// same shard, at exactly the same second, pointed at the same backup
-	// storage location.
-	bigN, err := rand.Int(rand.Reader, big.NewInt(math.MaxUint32))
+	tabletAlias, err := newTabletAlias()
 	if err != nil {
-		return fmt.Errorf("can't generate random tablet UID: %v", err)
-	}
-	tabletAlias := &topodatapb.TabletAlias{
-		Cell: "vtbackup",
-		Uid:  uint32(bigN.Uint64()),
+		return err
 	}
 
 	// Clean up our temporary data dir if we exit for any reason, to make sure
@@ -408,6 +424,72 @@ func takeBackup(ctx context.Context, topoServer *topo.Server, backupStorage back
 	return nil
 }
 
+// newTabletAlias returns an imaginary tablet alias. The value doesn't matter
+// for anything, except that we generate a random UID to ensure the target
+// backup directory is unique if multiple vtbackup instances are launched for
+// the same shard, at exactly the same second, pointed at the same backup
+// storage location.
+func newTabletAlias() (*topodatapb.TabletAlias, error) {
+	bigN, err := rand.Int(rand.Reader, big.NewInt(math.MaxUint32))
+	if err != nil {
+		return nil, fmt.Errorf("can't generate random tablet UID: %v", err)
+	}
+	return &topodatapb.TabletAlias{
+		Cell: "vtbackup",
+		Uid:  uint32(bigN.Uint64()),
+	}, nil
+}
+
+// verifyBackup restores a backup into a scratch mysqld to check it. It fails
+// if the backup didn't pass the verification.
+func verifyBackup(ctx context.Context) error {
+	tabletAlias, err := newTabletAlias()
+	if err != nil {
+		return err
+	}
+	tabletDir := mysqlctl.TabletDir(tabletAlias.Uid)
+	defer func() {
+		log.Infof("Removing temporary tablet directory: %v", tabletDir)
+		if err := os.RemoveAll(tabletDir); err != nil {
+			log.Warningf("Failed to remove temporary tablet directory: %v", err)
+		}
+	}()
+
+	mysqld, mycnf, err := mysqlctl.CreateMysqldAndMycnf(tabletAlias.Uid, *mysqlSocket, int32(*mysqlPort))
+	if err != nil {
+		return fmt.Errorf("failed to initialize mysql config: %v", err)
+	}
+	initCtx, initCancel := context.WithTimeout(ctx, *mysqlTimeout)
+	defer initCancel()
+	if err := mysqld.Init(initCtx, mycnf, *initDBSQLFile); err != nil {
+		return fmt.Errorf("failed to initialize mysql data dir and start mysqld: %v", err)
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		mysqld.Shutdown(ctx, mycnf, false)
+	}()
+
+	v, err := mysqlctl.VerifyBackup(ctx, mysqlctl.VerifyParams{
+		Cnf:          mycnf,
+		Mysqld:       mysqld,
+		Logger:       logutil.NewConsoleLogger(),
+		Concurrency:  *concurrency,
+		HookExtraEnv: map[string]string{"TABLET_ALIAS": topoproto.TabletAliasString(tabletAlias)},
+		Keyspace:     *initKeyspace,
+		Shard:        *initShard,
+		BackupName:   *verifyBackupName,
+	})
+	if err != nil {
+		return err
+	}
+	if !v.Passed {
+		return fmt.Errorf("backup %v failed verification: %v", v.BackupName, v.Errors)
+	}
+	log.Infof("Backup %v passed verification", v.BackupName)
+	return nil
+}
+
 func resetReplication(ctx context.Context, pos mysql.Position, mysqld mysqlctl.MysqlDaemon) error {
 	cmds := []string{
 		"STOP SLAVE",
@@ -505,60 +587,28 @@ func retryOnError(ctx context.Context, fn func() error) error {
 }
 
 func pruneBackups(ctx context.Context, backupStorage backupstorage.BackupStorage, backupDir string) error {
-	if *minRetentionTime == 0 {
+	if *minRetentionTime == 0 && *retentionKeepDaily == 0 && *retentionKeepWeekly == 0 {
 		log.Info("Pruning of old backups is disabled.")
 		return nil
 	}
-	backups, err := backupStorage.ListBackups(ctx, backupDir)
+	policy := mysqlctl.RetentionPolicy{
+		KeepLast:   *minRetentionCount,
+		KeepDaily:  *retentionKeepDaily,
+		KeepWeekly: *retentionKeepWeekly,
+		MinAge:     *minRetentionTime,
+	}
+	pruned, err := mysqlctl.PruneBackups(ctx, backupStorage, backupDir, policy, time.Now(), *pruneDryRun, logutil.NewConsoleLogger())
 	if err != nil {
-		return fmt.Errorf("can't list backups: %v", err)
+		return err
 	}
-	numBackups := len(backups)
-	if numBackups <= *minRetentionCount {
-		log.Infof("Found %v backups. Not pruning any since this is within the min_retention_count of %v.", numBackups, *minRetentionCount)
+	if *pruneDryRun {
+		log.Infof("Would prune %v old backups with retention policy %+v.", len(pruned), policy)
 		return nil
 	}
-	// We have more than the minimum retention count, so we could afford to
-	// prune some. See if any are beyond the minimum retention time.
-	// ListBackups returns them sorted by oldest first.
-	for _, backup := range backups {
-		backupTime, err := parseBackupTime(backup.Name())
-		if err != nil {
-			return err
-		}
-		if time.Since(backupTime) < *minRetentionTime {
-			// The oldest remaining backup is not old enough to prune.
-			log.Infof("Oldest backup taken at %v has not reached min_retention_time of %v. Nothing left to prune.", backupTime, *minRetentionTime)
-			break
-		}
-		// Remove the backup.
-		log.Infof("Removing old backup %v from %v, since it's older than min_retention_time of %v", backup.Name(), backupDir, *minRetentionTime)
-		if err := backupStorage.RemoveBackup(ctx, backupDir, backup.Name()); err != nil {
-			return fmt.Errorf("couldn't remove backup %v from %v: %v", backup.Name(), backupDir, err)
-		}
-		// We successfully removed one backup. Can we afford to prune any more?
-		numBackups--
-		if numBackups == *minRetentionCount {
-			log.Infof("Successfully pruned backup count to min_retention_count of %v.", *minRetentionCount)
-			break
-		}
-	}
+	log.Infof("Pruned %v old backups with retention policy %+v.", len(pruned), policy)
 	return nil
 }
 
-func parseBackupTime(name string) (time.Time, error) {
-	// Backup names are formatted as "date.time.tablet-alias".
-	parts := strings.Split(name, ".")
-	if len(parts) != 3 {
-		return time.Time{}, fmt.Errorf("backup name not in expected format (date.time.tablet-alias): %v", name)
-	}
-	backupTime, err := time.Parse(mysqlctl.BackupTimestampFormat, fmt.Sprintf("%s.%s", parts[0], parts[1]))
-	if err != nil {
-		return time.Time{}, fmt.Errorf("can't parse timestamp from backup %q: %v", name, err)
-	}
-	return backupTime, nil
-}
-
 func shouldBackup(ctx context.Context, topoServer *topo.Server, backupStorage backupstorage.BackupStorage, backupDir string) (bool, error) {
 	// Look for the most recent, complete backup.
 	backups, err := backupStorage.ListBackups(ctx, backupDir)
@@ -626,7 +676,7 @@ func shouldBackup(ctx context.Context, topoServer *topo.Server, backupStorage ba
 		// No minimum interval is set, so always backup.
 		return true, nil
 	}
-	lastBackupTime, err := parseBackupTime(lastBackup.Name())
+	lastBackupTime, err := mysqlctl.ParseBackupTime(lastBackup.Name())
 	if err != nil {
 		return false, fmt.Errorf("can't check last backup time: %v", err)
 	}
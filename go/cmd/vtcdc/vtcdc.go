@@ -0,0 +1,163 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"flag"
+	"strings"
+
+	"github.com/golang/protobuf/jsonpb"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/exit"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtcdc"
+	"vitess.io/vitess/go/vt/vtgate/vtgateconn"
+
+	// Import and register the gRPC vtgateconn client
+	_ "vitess.io/vitess/go/vt/vtgate/grpcvtgateconn"
+)
+
+/*
+
+  vtcdc streams the changes of a keyspace from vtgate, and writes them
+  as Debezium style change envelopes to a sink.
+
+  Stream the changes of all the tables of a keyspace to stdout:
+  vtcdc \
+        -server vtgate-host.my.domain:15999 \
+        -keyspace commerce
+
+  Stream to stdout, and save the last VGtid written in a checkpoint
+  file. If the file exists, the stream resumes from it:
+  vtcdc \
+        -server vtgate-host.my.domain:15999 \
+        -keyspace commerce \
+        -sink_address /var/lib/vtcdc/commerce.vgtid
+
+  Copy two tables and stream their changes to a file, in Avro, with the
+  schemas registered in a Confluent schema registry. If the file exists,
+  the stream resumes from the last VGtid in it:
+  vtcdc \
+        -server vtgate-host.my.domain:15999 \
+        -keyspace commerce \
+        -position "" \
+        -tables customer,corder \
+        -format avro \
+        -schema_registry http://registry-host.my.domain:8081 \
+        -sink file \
+        -sink_address /var/lib/vtcdc/commerce.log
+
+*/
+
+var (
+	server     = flag.String("server", "", "vtgate server to connect to")
+	name       = flag.String("name", "vitess", "logical name of the source, used as the topic prefix")
+	keyspace   = flag.String("keyspace", "", "keyspace to stream from, if -vgtid is not set")
+	position   = flag.String("position", "current", "position to start from in every shard of -keyspace: 'current', or empty to copy the tables first")
+	vgtidFlag  = flag.String("vgtid", "", "VGtid to start from, in JSON. Overrides -keyspace and -position")
+	tables     = flag.String("tables", "", "comma separated list of tables to stream. All tables are streamed if empty")
+	tabletType = flag.String("tablet_type", "master", "tablet type to stream from")
+	streamID   = flag.String("stream_id", "", "name of the stream in vtgate, to allow adding tables to it while it runs")
+	format     = flag.String("format", "json", "message format: json or avro")
+	schemas    = flag.Bool("json_schemas", false, "add the schema to json messages")
+	sinkName   = flag.String("sink", "stdout", "sink to write to: file, stdout, or a registered sink")
+	sinkAddr   = flag.String("sink_address", "", "address of the sink, e.g. the name of the file for the file sink, or of the checkpoint file for the stdout sink")
+	registry   = flag.String("schema_registry", "", "URL of the Confluent schema registry where the avro schemas are registered. Required with -format avro")
+)
+
+func main() {
+	defer exit.Recover()
+
+	logger := logutil.NewConsoleLogger()
+	flag.CommandLine.SetOutput(logutil.NewLoggerWriter(logger))
+	servenv.ParseFlags("vtcdc")
+
+	if *server == "" {
+		log.Exitf("-server is required")
+	}
+	cfg := &vtcdc.Config{
+		Name:     *name,
+		StreamID: *streamID,
+		Filter: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{Match: "/.*"}},
+		},
+	}
+	var err error
+	if cfg.TabletType, err = topoproto.ParseTabletType(*tabletType); err != nil {
+		log.Exitf("invalid -tablet_type: %v", err)
+	}
+	if *tables != "" {
+		cfg.Filter.Rules = nil
+		for _, table := range strings.Split(*tables, ",") {
+			cfg.Filter.Rules = append(cfg.Filter.Rules, &binlogdatapb.Rule{Match: strings.TrimSpace(table)})
+		}
+	}
+	switch {
+	case *vgtidFlag != "":
+		cfg.Vgtid = &binlogdatapb.VGtid{}
+		if err := jsonpb.UnmarshalString(*vgtidFlag, cfg.Vgtid); err != nil {
+			log.Exitf("invalid -vgtid: %v", err)
+		}
+	case *keyspace != "":
+		cfg.Vgtid = &binlogdatapb.VGtid{
+			ShardGtids: []*binlogdatapb.ShardGtid{{
+				Keyspace: *keyspace,
+				Gtid:     *position,
+			}},
+		}
+	default:
+		log.Exitf("one of -vgtid or -keyspace is required")
+	}
+
+	var enc vtcdc.Encoder
+	switch *format {
+	case "json":
+		enc = &vtcdc.JSONEncoder{Schemas: *schemas}
+	case "avro":
+		if *registry == "" {
+			log.Exitf("-schema_registry is required with -format avro")
+		}
+		enc = vtcdc.NewAvroEncoder(vtcdc.NewRegistryClient(*registry))
+	default:
+		log.Exitf("invalid -format: %s", *format)
+	}
+
+	sink, err := vtcdc.NewSink(*sinkName, *sinkAddr)
+	if err != nil {
+		log.Exitf("cannot create sink: %v", err)
+	}
+	defer sink.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	conn, err := vtgateconn.Dial(ctx, *server)
+	if err != nil {
+		log.Exitf("cannot connect to vtgate %s: %v", *server, err)
+	}
+	defer conn.Close()
+
+	servenv.OnTerm(cancel)
+	if err := vtcdc.Run(ctx, conn, cfg, enc, sink); err != nil && ctx.Err() == nil {
+		log.Errorf("vtcdc: %v", err)
+		exit.Return(1)
+	}
+}
@@ -56,8 +56,12 @@ func (c fallbackClient) ResolveTransaction(ctx context.Context, dtid string) err
 	return c.fallback.ResolveTransaction(ctx, dtid)
 }
 
-func (c fallbackClient) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, send func([]*binlogdatapb.VEvent) error) error {
-	return c.fallback.VStream(ctx, tabletType, vgtid, filter, send)
+func (c fallbackClient) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, streamID string, send func([]*binlogdatapb.VEvent) error) error {
+	return c.fallback.VStream(ctx, tabletType, vgtid, filter, streamID, send)
+}
+
+func (c fallbackClient) VStreamCopyTables(ctx context.Context, streamID, keyspace string, tables []string) error {
+	return c.fallback.VStreamCopyTables(ctx, streamID, keyspace, tables)
 }
 
 func (c fallbackClient) HandlePanic(err *error) {
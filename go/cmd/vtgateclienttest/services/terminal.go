@@ -65,7 +65,11 @@ func (c *terminalClient) ResolveTransaction(ctx context.Context, dtid string) er
 	return errTerminal
 }
 
-func (c *terminalClient) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, send func([]*binlogdatapb.VEvent) error) error {
+func (c *terminalClient) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, streamID string, send func([]*binlogdatapb.VEvent) error) error {
+	return errTerminal
+}
+
+func (c *terminalClient) VStreamCopyTables(ctx context.Context, streamID, keyspace string, tables []string) error {
 	return errTerminal
 }
 
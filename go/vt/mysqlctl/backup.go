@@ -74,8 +74,8 @@ var (
 	// and used as the transform hook name again.
 	backupStorageHook = flag.String("backup_storage_hook", "", "if set, we send the contents of the backup files through this hook.")
 
-	// backupStorageCompress can be set to false to not use gzip
-	// on the backups. Usually would be set if a hook is used, and
+	// backupStorageCompress can be set to false to not compress
+	// the backups. Usually would be set if a hook is used, and
 	// the hook compresses the data.
 	backupStorageCompress = flag.Bool("backup_storage_compress", true, "if set, the backup files will be compressed (default is true). Set to false for instance if a backup_storage_hook is specified and it compresses the data.")
 
@@ -264,6 +264,10 @@ func Restore(ctx context.Context, params RestoreParams) (*BackupManifest, error)
 
 	if len(bhs) == 0 {
 		// There are no backups (not even broken/incomplete ones).
+		if params.pointInTime() {
+			// Starting up empty is not a point in time recovery.
+			return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "no backup to restore on BackupStorage for directory %v", backupDir)
+		}
 		params.Logger.Errorf("no backup to restore on BackupStorage for directory %v. Starting up empty.", backupDir)
 		// Wait for mysqld to be ready, in case it was launched in parallel with us.
 		if err = params.Mysqld.Wait(ctx, params.Cnf); err != nil {
@@ -317,6 +321,18 @@ func Restore(ctx context.Context, params RestoreParams) (*BackupManifest, error)
 		return nil, vterrors.Wrap(err, "mysql_upgrade failed")
 	}
 
+	// Replay the binlog backups for point in time recovery. The returned
+	// manifest then has the position mysqld was restored to.
+	if params.pointInTime() {
+		params.Logger.Infof("Restore: replaying binlog backups from %v", manifest.Position)
+		pos, err := applyBinlogBackups(ctx, params, bs, manifest.Position)
+		if err != nil {
+			return nil, vterrors.Wrap(err, "point in time recovery failed")
+		}
+		params.Logger.Infof("Restore: restored to position %v", pos)
+		manifest.Position = pos
+	}
+
 	// Add backupTime and restorePosition to LocalMetadata
 	params.LocalMetadata["RestoredBackupTime"] = manifest.BackupTime
 	params.LocalMetadata["RestorePosition"] = mysql.EncodePosition(manifest.Position)
@@ -0,0 +1,280 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// RetentionPolicy says which backups of a shard to keep. A backup is kept
+// if any of the rules keeps it. The most recent complete backup is always
+// kept, since new backups start from it, and so are the backups that the
+// kept incremental backups need.
+// Binlog backups are kept as long as a kept backup needs them for point
+// in time recovery: only those whose transactions are all in every kept
+// complete backup are removed.
+type RetentionPolicy struct {
+	// KeepLast keeps the most recent complete backups.
+	KeepLast int
+
+	// KeepDaily keeps the most recent complete backup of each of the
+	// last KeepDaily days, in UTC, today included.
+	KeepDaily int
+
+	// KeepWeekly keeps the most recent complete backup of each of the
+	// last KeepWeekly weeks, starting on Mondays in UTC, this one included.
+	KeepWeekly int
+
+	// MinAge keeps all the backups, complete or not, taken less than
+	// MinAge ago.
+	MinAge time.Duration
+}
+
+// backupInfo is what a RetentionPolicy looks at in a backup.
+type backupInfo struct {
+	name     string
+	time     time.Time
+	complete bool
+	parent   string
+	position mysql.Position
+}
+
+// binlogBackupInfo is what a RetentionPolicy looks at in a binlog backup.
+type binlogBackupInfo struct {
+	name     string
+	position mysql.Position
+}
+
+// ParseBackupTime returns the time a backup was taken at, from its name.
+func ParseBackupTime(name string) (time.Time, error) {
+	// Backup names are formatted as "date.time.tablet-alias".
+	parts := strings.Split(name, ".")
+	if len(parts) != 3 {
+		return time.Time{}, fmt.Errorf("backup name not in expected format (date.time.tablet-alias): %v", name)
+	}
+	backupTime, err := time.Parse(BackupTimestampFormat, fmt.Sprintf("%s.%s", parts[0], parts[1]))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("can't parse timestamp from backup %q: %v", name, err)
+	}
+	return backupTime, nil
+}
+
+// PruneBackups removes the backups in dir that policy doesn't keep, as of
+// now, and returns their names. They are removed newest first, so an
+// incremental backup is always removed before its parent. The binlog
+// backups no kept backup needs are removed next, their names are
+// returned with their directory. With dryRun, nothing is removed.
+func PruneBackups(ctx context.Context, bs backupstorage.BackupStorage, dir string, policy RetentionPolicy, now time.Time, dryRun bool, logger logutil.Logger) ([]string, error) {
+	bhs, err := bs.ListBackups(ctx, dir)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "ListBackups failed")
+	}
+	var backups []backupInfo
+	for _, bh := range bhs {
+		backupTime, err := ParseBackupTime(bh.Name())
+		if err != nil {
+			logger.Warningf("Keeping backup %v: %v", bh.Name(), err)
+			continue
+		}
+		b := backupInfo{name: bh.Name(), time: backupTime}
+		if bm, err := GetBackupManifest(ctx, bh); err == nil {
+			b.complete = true
+			b.parent = bm.ParentBackup
+			b.position = bm.Position
+		}
+		backups = append(backups, b)
+	}
+
+	prune := backupsToPrune(backups, policy, now)
+
+	binlogDir := binlogBackupDir(dir)
+	bbhs, err := bs.ListBackups(ctx, binlogDir)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "ListBackups failed")
+	}
+	var binlogs []binlogBackupInfo
+	for _, bbh := range bbhs {
+		bm := &BinlogBackupManifest{}
+		if err := getBackupManifestInto(ctx, bbh, bm); err != nil {
+			// It may be in progress.
+			logger.Warningf("Keeping binlog backup %v: %v", bbh.Name(), err)
+			continue
+		}
+		binlogs = append(binlogs, binlogBackupInfo{name: bbh.Name(), position: bm.Position})
+	}
+	pruneBinlogs := binlogBackupsToPrune(backups, prune, binlogs)
+
+	pruned := prune
+	for _, name := range pruneBinlogs {
+		pruned = append(pruned, binlogDir+"/"+name)
+	}
+	if dryRun {
+		for _, name := range prune {
+			logger.Infof("Would remove backup %v from %v", name, dir)
+		}
+		for _, name := range pruneBinlogs {
+			logger.Infof("Would remove binlog backup %v from %v", name, binlogDir)
+		}
+		return pruned, nil
+	}
+
+	// The verification results of the removed backups go too.
+	verified := make(map[string]bool)
+	verificationDir := backupVerificationDir(dir)
+	vbhs, err := bs.ListBackups(ctx, verificationDir)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "ListBackups failed")
+	}
+	for _, vbh := range vbhs {
+		verified[vbh.Name()] = true
+	}
+	for _, name := range prune {
+		logger.Infof("Removing backup %v from %v", name, dir)
+		if err := bs.RemoveBackup(ctx, dir, name); err != nil {
+			return nil, vterrors.Wrapf(err, "couldn't remove backup %v from %v", name, dir)
+		}
+		if verified[name] {
+			if err := bs.RemoveBackup(ctx, verificationDir, name); err != nil {
+				logger.Warningf("Couldn't remove verification of backup %v from %v: %v", name, verificationDir, err)
+			}
+		}
+	}
+	for _, name := range pruneBinlogs {
+		logger.Infof("Removing binlog backup %v from %v", name, binlogDir)
+		if err := bs.RemoveBackup(ctx, binlogDir, name); err != nil {
+			return nil, vterrors.Wrapf(err, "couldn't remove binlog backup %v from %v", name, binlogDir)
+		}
+	}
+	return pruned, nil
+}
+
+// backupsToPrune returns the names of the backups that policy doesn't
+// keep as of now, newest first. backups are sorted oldest first.
+func backupsToPrune(backups []backupInfo, policy RetentionPolicy, now time.Time) []string {
+	keep := make(map[string]bool)
+	parents := make(map[string]string)
+	days := make(map[int64]bool)
+	weeks := make(map[int64]bool)
+	today := unixDay(now)
+	thisWeek := unixWeek(now)
+	kept := 0
+	var newestComplete time.Time
+	for i := len(backups) - 1; i >= 0; i-- {
+		b := backups[i]
+		if now.Sub(b.time) < policy.MinAge {
+			keep[b.name] = true
+		}
+		if !b.complete {
+			continue
+		}
+		parents[b.name] = b.parent
+		if newestComplete.IsZero() {
+			newestComplete = b.time
+			keep[b.name] = true
+		}
+		if kept < policy.KeepLast {
+			keep[b.name] = true
+			kept++
+		}
+		if day := unixDay(b.time); today-day < int64(policy.KeepDaily) && !days[day] {
+			keep[b.name] = true
+			days[day] = true
+		}
+		if week := unixWeek(b.time); thisWeek-week < int64(policy.KeepWeekly) && !weeks[week] {
+			keep[b.name] = true
+			weeks[week] = true
+		}
+	}
+
+	// Keep what the kept incremental backups need.
+	for name := range keep {
+		for parent := parents[name]; parent != "" && !keep[parent]; parent = parents[parent] {
+			keep[parent] = true
+		}
+	}
+
+	var prune []string
+	for i := len(backups) - 1; i >= 0; i-- {
+		b := backups[i]
+		if keep[b.name] {
+			continue
+		}
+		// An incomplete backup may still be in progress,
+		// unless a complete backup was taken after it.
+		if !b.complete && !b.time.Before(newestComplete) {
+			continue
+		}
+		prune = append(prune, b.name)
+	}
+	return prune
+}
+
+// binlogBackupsToPrune returns the names of the binlog backups that no
+// backup kept after pruning the backups named prune needs: the ones
+// whose transactions are all in every kept complete backup. A point in
+// time recovery from a kept backup never replays them. Nothing is
+// pruned if no complete backup is kept.
+func binlogBackupsToPrune(backups []backupInfo, prune []string, binlogs []binlogBackupInfo) []string {
+	pruned := make(map[string]bool, len(prune))
+	for _, name := range prune {
+		pruned[name] = true
+	}
+	var kept []mysql.Position
+	for _, b := range backups {
+		if b.complete && !pruned[b.name] {
+			kept = append(kept, b.position)
+		}
+	}
+	if len(kept) == 0 {
+		return nil
+	}
+
+	var result []string
+	for _, bb := range binlogs {
+		needed := false
+		for _, pos := range kept {
+			// Backups taken without a position need all the binlogs.
+			if pos.IsZero() || !pos.AtLeast(bb.position) {
+				needed = true
+				break
+			}
+		}
+		if !needed {
+			result = append(result, bb.name)
+		}
+	}
+	return result
+}
+
+// unixDay returns the number of days between the epoch and t, in UTC.
+func unixDay(t time.Time) int64 {
+	return t.Unix() / (24 * 60 * 60)
+}
+
+// unixWeek returns the number of weeks starting on Mondays between the
+// epoch and t, in UTC. The epoch was a Thursday.
+func unixWeek(t time.Time) int64 {
+	return (unixDay(t) + 3) / 7
+}
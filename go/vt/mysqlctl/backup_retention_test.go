@@ -0,0 +1,213 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/mysqlctl/filebackupstorage"
+)
+
+func TestParseBackupTime(t *testing.T) {
+	got, err := ParseBackupTime("2020-03-04.050607.cell-0000000100")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2020, 3, 4, 5, 6, 7, 0, time.UTC), got)
+
+	_, err = ParseBackupTime("cell-0000000100.vt-bin.000001")
+	assert.Error(t, err)
+	_, err = ParseBackupTime("backup")
+	assert.Error(t, err)
+}
+
+func TestBackupsToPrune(t *testing.T) {
+	// Wednesday.
+	now := time.Date(2020, 3, 4, 12, 0, 0, 0, time.UTC)
+	backup := func(name string, age time.Duration, complete bool, parent string) backupInfo {
+		return backupInfo{name: name, time: now.Add(-age), complete: complete, parent: parent}
+	}
+	day := 24 * time.Hour
+	// Oldest first, twice a day.
+	backups := []backupInfo{
+		backup("b14", 14*day, true, ""),
+		backup("b10", 10*day, true, ""),
+		backup("b9.5", 9*day+12*time.Hour, true, ""),
+		backup("b6", 6*day, true, ""),
+		backup("b3", 3*day, true, ""),
+		backup("b2.5", 2*day+12*time.Hour, true, ""),
+		backup("b2", 2*day, true, "b3"),
+		backup("b1", 1*day, false, ""),
+		backup("b0.5", 12*time.Hour, true, "b2"),
+		backup("b0", 0, false, ""),
+	}
+
+	testcases := []struct {
+		name   string
+		policy RetentionPolicy
+		want   []string
+	}{{
+		name:   "latest complete backup and its parents only",
+		policy: RetentionPolicy{},
+		want:   []string{"b1", "b2.5", "b6", "b9.5", "b10", "b14"},
+	}, {
+		name:   "keep last",
+		policy: RetentionPolicy{KeepLast: 3},
+		want:   []string{"b1", "b6", "b9.5", "b10", "b14"},
+	}, {
+		name:   "keep daily",
+		policy: RetentionPolicy{KeepDaily: 3},
+		// Today: b0.5. Yesterday: nothing complete. The day before: b2.
+		want: []string{"b1", "b2.5", "b6", "b9.5", "b10", "b14"},
+	}, {
+		name:   "keep daily for a week",
+		policy: RetentionPolicy{KeepDaily: 7},
+		// Also b3 and b6, the oldest day kept.
+		want: []string{"b1", "b2.5", "b9.5", "b10", "b14"},
+	}, {
+		name:   "keep weekly",
+		policy: RetentionPolicy{KeepWeekly: 3},
+		// This week since Monday: b0.5. Last week: b3. The one before,
+		// that ended on Sunday: b10.
+		want: []string{"b1", "b2.5", "b6", "b9.5", "b14"},
+	}, {
+		name:   "min age",
+		policy: RetentionPolicy{MinAge: 7 * day},
+		want:   []string{"b9.5", "b10", "b14"},
+	}, {
+		name:   "everything",
+		policy: RetentionPolicy{KeepLast: 1, KeepDaily: 7, KeepWeekly: 4, MinAge: time.Hour},
+		want:   []string{"b1", "b2.5", "b9.5", "b14"},
+	}}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, backupsToPrune(backups, tc.policy, now))
+		})
+	}
+}
+
+func TestPruneBackups(t *testing.T) {
+	root, err := ioutil.TempDir("", "prunebackupstest")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+	defer func(saved string) { *filebackupstorage.FileBackupStorageRoot = saved }(*filebackupstorage.FileBackupStorageRoot)
+	*filebackupstorage.FileBackupStorageRoot = root
+	bs := &filebackupstorage.FileBackupStorage{}
+	ctx := context.Background()
+	dir := GetBackupDir("ks", "0")
+
+	now := time.Date(2020, 3, 4, 12, 0, 0, 0, time.UTC)
+	for i, parent := range []string{"", "", "", ""} {
+		name := now.Add(time.Duration(i-3)*24*time.Hour).Format(BackupTimestampFormat) + ".cell-0000000100"
+		bh, err := bs.StartBackup(ctx, dir, name)
+		require.NoError(t, err)
+		wc, err := bh.AddFile(ctx, backupManifestFileName, backupstorage.FileSizeUnknown)
+		require.NoError(t, err)
+		require.NoError(t, json.NewEncoder(wc).Encode(&BackupManifest{BackupMethod: "builtin", ParentBackup: parent}))
+		require.NoError(t, wc.Close())
+		require.NoError(t, bh.EndBackup(ctx))
+	}
+	// A backup that doesn't follow the naming convention is left alone.
+	require.NoError(t, os.MkdirAll(path.Join(root, dir, "manual"), os.ModePerm))
+
+	policy := RetentionPolicy{KeepLast: 2}
+	want := []string{"2020-03-02.120000.cell-0000000100", "2020-03-01.120000.cell-0000000100"}
+	pruned, err := PruneBackups(ctx, bs, dir, policy, now, true, logutil.NewMemoryLogger())
+	require.NoError(t, err)
+	assert.Equal(t, want, pruned)
+	bhs, err := bs.ListBackups(ctx, dir)
+	require.NoError(t, err)
+	assert.Len(t, bhs, 5)
+
+	// The verification of a removed backup is removed too.
+	require.NoError(t, storeBackupVerification(ctx, bs, backupVerificationDir(dir), &BackupVerification{BackupName: want[0]}))
+	pruned, err = PruneBackups(ctx, bs, dir, policy, now, false, logutil.NewMemoryLogger())
+	require.NoError(t, err)
+	assert.Equal(t, want, pruned)
+	vbhs, err := bs.ListBackups(ctx, backupVerificationDir(dir))
+	require.NoError(t, err)
+	assert.Empty(t, vbhs)
+	bhs, err = bs.ListBackups(ctx, dir)
+	require.NoError(t, err)
+	var names []string
+	for _, bh := range bhs {
+		names = append(names, bh.Name())
+	}
+	assert.Equal(t, []string{"2020-03-03.120000.cell-0000000100", "2020-03-04.120000.cell-0000000100", "manual"}, names)
+}
+
+func TestPruneBinlogBackups(t *testing.T) {
+	root, err := ioutil.TempDir("", "prunebackupstest")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+	defer func(saved string) { *filebackupstorage.FileBackupStorageRoot = saved }(*filebackupstorage.FileBackupStorageRoot)
+	*filebackupstorage.FileBackupStorageRoot = root
+	bs := &filebackupstorage.FileBackupStorage{}
+	ctx := context.Background()
+	dir := GetBackupDir("ks", "0")
+	binlogDir := GetBinlogBackupDir("ks", "0")
+	store := func(dir, name string, manifest interface{}) {
+		bh, err := bs.StartBackup(ctx, dir, name)
+		require.NoError(t, err)
+		if manifest != nil {
+			wc, err := bh.AddFile(ctx, backupManifestFileName, backupstorage.FileSizeUnknown)
+			require.NoError(t, err)
+			require.NoError(t, json.NewEncoder(wc).Encode(manifest))
+			require.NoError(t, wc.Close())
+		}
+		require.NoError(t, bh.EndBackup(ctx))
+	}
+
+	sid := "01020304-0506-0708-090a-0b0c0d0e0f10"
+	now := time.Date(2020, 3, 4, 12, 0, 0, 0, time.UTC)
+	for i, gtids := range []string{"1-10", "1-20", "1-30"} {
+		name := now.Add(time.Duration(i-2)*24*time.Hour).Format(BackupTimestampFormat) + ".cell-0000000100"
+		store(dir, name, &BackupManifest{BackupMethod: "builtin", Position: testPosition(t, sid+":"+gtids)})
+	}
+	for i, gtids := range []string{"1-10", "1-15", "1-25", "1-35"} {
+		store(binlogDir, fmt.Sprintf("cell-0000000100.vt-bin.00000%d", i+1), &BinlogBackupManifest{Position: testPosition(t, sid+":"+gtids)})
+	}
+	// A binlog backup in progress is left alone.
+	store(binlogDir, "cell-0000000100.vt-bin.000005", nil)
+
+	// The binlog backups before the oldest kept backup go, the ones
+	// point in time recovery from it needs stay.
+	pruned, err := PruneBackups(ctx, bs, dir, RetentionPolicy{KeepLast: 2}, now, false, logutil.NewMemoryLogger())
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"2020-03-02.120000.cell-0000000100",
+		"binlogs/ks/0/cell-0000000100.vt-bin.000001",
+		"binlogs/ks/0/cell-0000000100.vt-bin.000002",
+	}, pruned)
+	bhs, err := bs.ListBackups(ctx, binlogDir)
+	require.NoError(t, err)
+	var names []string
+	for _, bh := range bhs {
+		names = append(names, bh.Name())
+	}
+	assert.Equal(t, []string{"cell-0000000100.vt-bin.000003", "cell-0000000100.vt-bin.000004", "cell-0000000100.vt-bin.000005"}, names)
+}
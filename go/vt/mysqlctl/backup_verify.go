@@ -0,0 +1,355 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"vitess.io/vitess/go/sqlescape"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+const (
+	// backupVerificationFileName is the file holding a BackupVerification.
+	backupVerificationFileName = "VERIFICATION"
+
+	// backupTablesQuery lists the user tables, with their estimated size.
+	backupTablesQuery = "SELECT table_schema, table_name, table_rows FROM information_schema.tables WHERE table_type = 'BASE TABLE' AND table_schema NOT IN ('mysql', 'information_schema', 'performance_schema', 'sys') ORDER BY table_schema, table_name"
+)
+
+// BackupTable is a table of a backup, recorded in the MANIFEST so
+// VerifyBackup can check it was restored.
+type BackupTable struct {
+	Schema string
+	Name   string
+
+	// Rows is the estimated number of rows when the backup was taken,
+	// from information_schema.
+	Rows uint64
+}
+
+// VerifyParams are the parameters of VerifyBackup.
+type VerifyParams struct {
+	// Cnf and Mysqld are a throwaway mysqld to restore the backup into.
+	// Its data is deleted, and it is shut down when VerifyBackup returns.
+	Cnf    *Mycnf
+	Mysqld MysqlDaemon
+	Logger logutil.Logger
+	// Concurrency is the number of files restored in parallel.
+	Concurrency int
+	// Extra env variables for the restore transform hooks.
+	HookExtraEnv map[string]string
+	// Keyspace and Shard are the shard of the backup.
+	Keyspace string
+	Shard    string
+	// BackupName is the backup to verify. If empty, the most recent
+	// complete backup of the shard is verified.
+	BackupName string
+}
+
+// BackupVerification is the result of VerifyBackup. It is stored in the
+// BackupStorage, under GetBackupVerificationDir, as a backup named like
+// the verified one.
+type BackupVerification struct {
+	BackupName string
+
+	// VerifyTime is when the verification was done, in RFC 3339 format.
+	VerifyTime string
+
+	// Passed is true if the backup was restored, and no error was found.
+	Passed bool
+
+	// Errors are the problems found, which mean the backup can't be trusted.
+	Errors []string
+
+	// Warnings are suspicious findings that don't fail the verification.
+	Warnings []string
+
+	// Tables are the tables of the restored backup.
+	Tables []TableVerification
+}
+
+// TableVerification is the verification of a table of a restored backup.
+type TableVerification struct {
+	Schema string
+	Name   string
+
+	// Check is the status CHECK TABLE returned.
+	Check string
+
+	// Rows is the number of rows of the table.
+	Rows uint64
+}
+
+func (v *BackupVerification) errorf(format string, args ...interface{}) {
+	v.Errors = append(v.Errors, fmt.Sprintf(format, args...))
+}
+
+func (v *BackupVerification) warningf(format string, args ...interface{}) {
+	v.Warnings = append(v.Warnings, fmt.Sprintf(format, args...))
+}
+
+// GetBackupVerificationDir returns the directory where the results of
+// VerifyBackup are stored, for a shard.
+func GetBackupVerificationDir(keyspace, shard string) string {
+	return backupVerificationDir(GetBackupDir(keyspace, shard))
+}
+
+func backupVerificationDir(backupDir string) string {
+	return "verifications/" + backupDir
+}
+
+// getBackupTables returns the user tables of mysqld.
+func getBackupTables(ctx context.Context, mysqld MysqlDaemon) ([]BackupTable, error) {
+	qr, err := mysqld.FetchSuperQuery(ctx, backupTablesQuery)
+	if err != nil {
+		return nil, err
+	}
+	tables := make([]BackupTable, 0, len(qr.Rows))
+	for _, row := range qr.Rows {
+		// table_rows is NULL for some engines.
+		rows, _ := row[2].ToUint64()
+		tables = append(tables, BackupTable{
+			Schema: row[0].ToString(),
+			Name:   row[1].ToString(),
+			Rows:   rows,
+		})
+	}
+	return tables, nil
+}
+
+// VerifyBackup restores a backup into a throwaway mysqld, checks its
+// tables, compares them with the backup MANIFEST, and stores the result
+// in the BackupStorage. Problems with the backup are reported in the
+// result; an error is only returned if the verification couldn't be done.
+func VerifyBackup(ctx context.Context, params VerifyParams) (*BackupVerification, error) {
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return nil, err
+	}
+	defer bs.Close()
+	backupDir := GetBackupDir(params.Keyspace, params.Shard)
+	bhs, err := bs.ListBackups(ctx, backupDir)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "ListBackups failed")
+	}
+	var bh backupstorage.BackupHandle
+	if params.BackupName == "" {
+		if bh, err = FindBackupToRestore(ctx, RestoreParams{Logger: params.Logger}, bhs); err != nil {
+			return nil, err
+		}
+	} else {
+		for _, candidate := range bhs {
+			if candidate.Name() == params.BackupName {
+				bh = candidate
+			}
+		}
+		if bh == nil {
+			return nil, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "no backup %v in %v", params.BackupName, backupDir)
+		}
+	}
+
+	v := &BackupVerification{
+		BackupName: bh.Name(),
+		VerifyTime: time.Now().UTC().Format(time.RFC3339),
+	}
+	params.Logger.Infof("Verify: restoring backup %v into a scratch mysqld", bh.Name())
+	verifyRestoredBackup(ctx, params, bh, v)
+	if err := params.Mysqld.Shutdown(context.Background(), params.Cnf, true); err != nil {
+		params.Logger.Warningf("Verify: can't shut down scratch mysqld: %v", err)
+	}
+	v.Passed = len(v.Errors) == 0
+	params.Logger.Infof("Verify: backup %v passed: %v, errors: %v, warnings: %v", v.BackupName, v.Passed, v.Errors, v.Warnings)
+
+	if err := storeBackupVerification(ctx, bs, backupVerificationDir(backupDir), v); err != nil {
+		return nil, vterrors.Wrapf(err, "can't store verification of backup %v", v.BackupName)
+	}
+	return v, nil
+}
+
+// verifyRestoredBackup restores the backup into the scratch mysqld and
+// checks it, recording the problems in v.
+func verifyRestoredBackup(ctx context.Context, params VerifyParams, bh backupstorage.BackupHandle, v *BackupVerification) {
+	re, err := GetRestoreEngine(ctx, bh)
+	if err != nil {
+		v.errorf("can't find restore engine: %v", err)
+		return
+	}
+	// The scratch mysqld may be running, but its data is about to be replaced.
+	if err := params.Mysqld.Shutdown(ctx, params.Cnf, true); err != nil {
+		v.errorf("can't shut down scratch mysqld before restore: %v", err)
+		return
+	}
+	manifest, err := re.ExecuteRestore(ctx, RestoreParams{
+		Cnf:                 params.Cnf,
+		Mysqld:              params.Mysqld,
+		Logger:              params.Logger,
+		Concurrency:         params.Concurrency,
+		HookExtraEnv:        params.HookExtraEnv,
+		DeleteBeforeRestore: true,
+		Keyspace:            params.Keyspace,
+		Shard:               params.Shard,
+	}, bh)
+	if err != nil {
+		v.errorf("restore failed: %v", err)
+		return
+	}
+	if err := removeStateFile(params.Cnf); err != nil {
+		params.Logger.Warningf("Verify: can't remove restore state file: %v", err)
+	}
+
+	// Nothing else needs to connect to the scratch mysqld, see Restore.
+	if err := params.Mysqld.Start(ctx, params.Cnf, "--skip-grant-tables", "--skip-networking"); err != nil {
+		v.errorf("mysqld failed to start on the restored data: %v", err)
+		return
+	}
+	if err := params.Mysqld.RunMysqlUpgrade(); err != nil {
+		v.errorf("mysql_upgrade failed on the restored data: %v", err)
+		return
+	}
+
+	tables, err := getBackupTables(ctx, params.Mysqld)
+	if err != nil {
+		v.errorf("can't list the restored tables: %v", err)
+		return
+	}
+	restoredRows := make(map[string]uint64, len(tables))
+	for _, table := range tables {
+		name := sqlescape.EscapeID(table.Schema) + "." + sqlescape.EscapeID(table.Name)
+		tv := TableVerification{Schema: table.Schema, Name: table.Name}
+		checkTable(ctx, params.Mysqld, name, &tv, v)
+		qr, err := params.Mysqld.FetchSuperQuery(ctx, "SELECT COUNT(*) FROM "+name)
+		if err != nil {
+			v.errorf("can't count the rows of %v: %v", name, err)
+		} else if tv.Rows, err = qr.Rows[0][0].ToUint64(); err != nil {
+			v.errorf("can't count the rows of %v: %v", name, err)
+		}
+		v.Tables = append(v.Tables, tv)
+		restoredRows[name] = tv.Rows
+	}
+
+	// Compare with the tables when the backup was taken.
+	if len(manifest.Tables) == 0 {
+		v.warningf("the backup MANIFEST has no tables to compare the restored tables with")
+	}
+	for _, table := range manifest.Tables {
+		name := sqlescape.EscapeID(table.Schema) + "." + sqlescape.EscapeID(table.Name)
+		rows, ok := restoredRows[name]
+		switch {
+		case !ok:
+			v.errorf("table %v is in the backup MANIFEST, but was not restored", name)
+		case rows == 0 && table.Rows > 0:
+			v.warningf("table %v is empty, but had about %v rows when the backup was taken", name, table.Rows)
+		}
+	}
+}
+
+// checkTable runs CHECK TABLE, and records its status in tv.
+func checkTable(ctx context.Context, mysqld MysqlDaemon, name string, tv *TableVerification, v *BackupVerification) {
+	qr, err := mysqld.FetchSuperQuery(ctx, "CHECK TABLE "+name)
+	if err != nil {
+		v.errorf("CHECK TABLE %v failed: %v", name, err)
+		return
+	}
+	// The result rows are Table, Op, Msg_type and Msg_text. The last
+	// one has the status, the others are errors and warnings.
+	for i, row := range qr.Rows {
+		if len(row) < 4 {
+			continue
+		}
+		msgType, msgText := row[2].ToString(), row[3].ToString()
+		if i == len(qr.Rows)-1 {
+			tv.Check = msgText
+		}
+		if msgType == "error" {
+			v.errorf("CHECK TABLE %v: %v", name, msgText)
+		}
+	}
+	if tv.Check != "OK" {
+		v.errorf("CHECK TABLE %v returned %q", name, tv.Check)
+	}
+}
+
+// storeBackupVerification writes v in dir, replacing the previous
+// verification of the same backup.
+func storeBackupVerification(ctx context.Context, bs backupstorage.BackupStorage, dir string, v *BackupVerification) error {
+	bhs, err := bs.ListBackups(ctx, dir)
+	if err != nil {
+		return vterrors.Wrap(err, "ListBackups failed")
+	}
+	for _, bh := range bhs {
+		if bh.Name() == v.BackupName {
+			if err := bs.RemoveBackup(ctx, dir, v.BackupName); err != nil {
+				return err
+			}
+		}
+	}
+
+	bh, err := bs.StartBackup(ctx, dir, v.BackupName)
+	if err != nil {
+		return vterrors.Wrap(err, "StartBackup failed")
+	}
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	wc, err := bh.AddFile(ctx, backupVerificationFileName, int64(len(data)))
+	if err != nil {
+		bh.AbortBackup(ctx)
+		return vterrors.Wrapf(err, "cannot add %v to backup", backupVerificationFileName)
+	}
+	_, err = wc.Write(data)
+	if cerr := wc.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		bh.AbortBackup(ctx)
+		return vterrors.Wrapf(err, "cannot write %v", backupVerificationFileName)
+	}
+	return bh.EndBackup(ctx)
+}
+
+// GetBackupVerification returns the result of the last verification of
+// a backup, or nil if it wasn't verified.
+func GetBackupVerification(ctx context.Context, bs backupstorage.BackupStorage, keyspace, shard, name string) (*BackupVerification, error) {
+	bhs, err := bs.ListBackups(ctx, GetBackupVerificationDir(keyspace, shard))
+	if err != nil {
+		return nil, vterrors.Wrap(err, "ListBackups failed")
+	}
+	for _, bh := range bhs {
+		if bh.Name() != name {
+			continue
+		}
+		file, err := bh.ReadFile(ctx, backupVerificationFileName)
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "can't read %v", backupVerificationFileName)
+		}
+		defer file.Close()
+		v := &BackupVerification{}
+		if err := json.NewDecoder(file).Decode(v); err != nil {
+			return nil, vterrors.Wrapf(err, "can't decode %v", backupVerificationFileName)
+		}
+		return v, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/mysqlctl/filebackupstorage"
+)
+
+// verifyDaemon is a scratch mysqld that answers the queries of
+// VerifyBackup from a map.
+type verifyDaemon struct {
+	MysqlDaemon
+	running bool
+	queries map[string]*sqltypes.Result
+}
+
+func (d *verifyDaemon) Start(ctx context.Context, cnf *Mycnf, mysqldArgs ...string) error {
+	d.running = true
+	return nil
+}
+
+func (d *verifyDaemon) Shutdown(ctx context.Context, cnf *Mycnf, waitForMysqld bool) error {
+	d.running = false
+	return nil
+}
+
+func (d *verifyDaemon) ReinitConfig(ctx context.Context, cnf *Mycnf) error {
+	return nil
+}
+
+func (d *verifyDaemon) RunMysqlUpgrade() error {
+	return nil
+}
+
+func (d *verifyDaemon) FetchSuperQuery(ctx context.Context, query string) (*sqltypes.Result, error) {
+	if !d.running {
+		return nil, fmt.Errorf("mysqld is not running")
+	}
+	qr, ok := d.queries[query]
+	if !ok {
+		return nil, fmt.Errorf("unexpected query: %v", query)
+	}
+	return qr, nil
+}
+
+func TestVerifyBackup(t *testing.T) {
+	root, err := ioutil.TempDir("", "verifybackuptest")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+	defer func(saved string) { *filebackupstorage.FileBackupStorageRoot = saved }(*filebackupstorage.FileBackupStorageRoot)
+	*filebackupstorage.FileBackupStorageRoot = path.Join(root, "backups")
+	defer func(saved string) { *backupstorage.BackupStorageImplementation = saved }(*backupstorage.BackupStorageImplementation)
+	*backupstorage.BackupStorageImplementation = "file"
+
+	ctx := context.Background()
+	bs, err := backupstorage.GetBackupStorage()
+	require.NoError(t, err)
+	defer bs.Close()
+
+	// Take a backup with three tables.
+	cnf := testMycnf(t, path.Join(root, "source"))
+	require.NoError(t, ioutil.WriteFile(path.Join(cnf.DataDir, "vt_db", "t1.ibd"), []byte("t1"), 0600))
+	name := "2020-01-01.000000.cell-0000000100"
+	bh, err := bs.StartBackup(ctx, GetBackupDir("ks", "0"), name)
+	require.NoError(t, err)
+	be := &BuiltinBackupEngine{}
+	require.NoError(t, be.backupFiles(ctx, BackupParams{
+		Cnf:         cnf,
+		Logger:      logutil.NewMemoryLogger(),
+		Concurrency: 1,
+		BackupTime:  time.Now(),
+	}, bh, mysql.Position{}, []BackupTable{
+		{Schema: "vt_db", Name: "t1", Rows: 10},
+		{Schema: "vt_db", Name: "t2", Rows: 5},
+		{Schema: "vt_db", Name: "t3", Rows: 0},
+	}))
+	require.NoError(t, bh.EndBackup(ctx))
+
+	// t2 is corrupt and empty, and t3 is missing.
+	scratchCnf := testMycnf(t, path.Join(root, "scratch"))
+	scratchCnf.BinLogPath = path.Join(root, "scratch", "bin-logs", "vt-bin")
+	scratchCnf.RelayLogPath = path.Join(root, "scratch", "relay-logs", "vt-relay-bin")
+	scratchCnf.RelayLogIndexPath = path.Join(root, "scratch", "relay-log.index")
+	scratchCnf.RelayLogInfoPath = path.Join(root, "scratch", "relay-log.info")
+	checkFields := sqltypes.MakeTestFields("Table|Op|Msg_type|Msg_text", "varchar|varchar|varchar|varchar")
+	countFields := sqltypes.MakeTestFields("count(*)", "int64")
+	mysqld := &verifyDaemon{queries: map[string]*sqltypes.Result{
+		backupTablesQuery: sqltypes.MakeTestResult(sqltypes.MakeTestFields("table_schema|table_name|table_rows", "varchar|varchar|uint64"),
+			"vt_db|t1|10",
+			"vt_db|t2|5",
+		),
+		"CHECK TABLE `vt_db`.`t1`":          sqltypes.MakeTestResult(checkFields, "vt_db.t1|check|status|OK"),
+		"CHECK TABLE `vt_db`.`t2`":          sqltypes.MakeTestResult(checkFields, "vt_db.t2|check|error|Corrupt", "vt_db.t2|check|status|Operation failed"),
+		"SELECT COUNT(*) FROM `vt_db`.`t1`": sqltypes.MakeTestResult(countFields, "10"),
+		"SELECT COUNT(*) FROM `vt_db`.`t2`": sqltypes.MakeTestResult(countFields, "0"),
+	}}
+	params := VerifyParams{
+		Cnf:         scratchCnf,
+		Mysqld:      mysqld,
+		Logger:      logutil.NewMemoryLogger(),
+		Concurrency: 1,
+		Keyspace:    "ks",
+		Shard:       "0",
+	}
+	v, err := VerifyBackup(ctx, params)
+	require.NoError(t, err)
+	want := &BackupVerification{
+		BackupName: name,
+		VerifyTime: v.VerifyTime,
+		Passed:     false,
+		Errors: []string{
+			"CHECK TABLE `vt_db`.`t2`: Corrupt",
+			"CHECK TABLE `vt_db`.`t2` returned \"Operation failed\"",
+			"table `vt_db`.`t3` is in the backup MANIFEST, but was not restored",
+		},
+		Warnings: []string{
+			"table `vt_db`.`t2` is empty, but had about 5 rows when the backup was taken",
+		},
+		Tables: []TableVerification{
+			{Schema: "vt_db", Name: "t1", Check: "OK", Rows: 10},
+			{Schema: "vt_db", Name: "t2", Check: "Operation failed", Rows: 0},
+		},
+	}
+	assert.Equal(t, want, v)
+	assert.False(t, mysqld.running)
+	restored, err := ioutil.ReadFile(path.Join(scratchCnf.DataDir, "vt_db", "t1.ibd"))
+	require.NoError(t, err)
+	assert.Equal(t, "t1", string(restored))
+
+	// The result is stored next to the backup.
+	stored, err := GetBackupVerification(ctx, bs, "ks", "0", name)
+	require.NoError(t, err)
+	assert.Equal(t, want, stored)
+
+	// A corrupt backup fails to restore. The result replaces the previous one.
+	dataFile := path.Join(*filebackupstorage.FileBackupStorageRoot, "ks", "0", name, "0")
+	require.NoError(t, ioutil.WriteFile(dataFile, []byte("corrupt"), 0600))
+	params.BackupName = name
+	v, err = VerifyBackup(ctx, params)
+	require.NoError(t, err)
+	assert.False(t, v.Passed)
+	require.Len(t, v.Errors, 1)
+	assert.Contains(t, v.Errors[0], "restore failed")
+	stored, err = GetBackupVerification(ctx, bs, "ks", "0", name)
+	require.NoError(t, err)
+	assert.Equal(t, v, stored)
+
+	// Unknown backups can't be verified, and have no verification.
+	params.BackupName = "2020-01-02.000000.cell-0000000100"
+	_, err = VerifyBackup(ctx, params)
+	assert.EqualError(t, err, "no backup 2020-01-02.000000.cell-0000000100 in ks/0")
+	stored, err = GetBackupVerification(ctx, bs, "ks", "0", params.BackupName)
+	require.NoError(t, err)
+	assert.Nil(t, stored)
+}
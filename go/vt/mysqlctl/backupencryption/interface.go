@@ -0,0 +1,117 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package backupencryption contains the client-side encryption of backups.
+// Each backup is encrypted with its own random data key, using AES-GCM.
+// The data key is stored in the backup MANIFEST, wrapped by a KeyProvider
+// that holds the master keys.
+package backupencryption
+
+import (
+	"crypto/rand"
+	"flag"
+	"fmt"
+
+	"golang.org/x/net/context"
+)
+
+const (
+	// dataKeySize is the size of the data keys, for AES-256.
+	dataKeySize = 32
+)
+
+var (
+	// KeyProviderImplementation is the KeyProvider used to encrypt new
+	// backups. Backups are not encrypted if it is empty. Exported for
+	// test purposes.
+	KeyProviderImplementation = flag.String("backup_encryption_key_provider", "", "if set, the key provider used to encrypt backups (for instance keyfile). Restores always use the key provider that encrypted a given backup.")
+)
+
+// KeyProvider wraps the data keys of backups with master keys.
+type KeyProvider interface {
+	// WrapKey encrypts a data key with the current master key. It
+	// returns the ID of the master key, and the wrapped data key.
+	WrapKey(ctx context.Context, dataKey []byte) (keyID string, wrappedKey []byte, err error)
+
+	// UnwrapKey decrypts a data key wrapped with the master key keyID.
+	UnwrapKey(ctx context.Context, keyID string, wrappedKey []byte) ([]byte, error)
+}
+
+// KeyProviderMap contains the registered implementations for KeyProvider.
+var KeyProviderMap = make(map[string]KeyProvider)
+
+// Params are the encryption parameters of a backup, recorded in its MANIFEST.
+type Params struct {
+	// KeyProvider is the name of the KeyProvider that wrapped the data key.
+	KeyProvider string
+
+	// KeyID identifies the master key the data key is wrapped with.
+	KeyID string
+
+	// WrappedKey is the data key of the backup, wrapped with the master key.
+	WrappedKey []byte
+}
+
+// Enabled returns true if new backups are encrypted.
+func Enabled() bool {
+	return *KeyProviderImplementation != ""
+}
+
+// NewDataKey returns a new data key to encrypt a backup with, and the
+// Params to record in its MANIFEST. It returns nil for both if backups
+// are not encrypted.
+func NewDataKey(ctx context.Context) ([]byte, *Params, error) {
+	if !Enabled() {
+		return nil, nil, nil
+	}
+	kp, ok := KeyProviderMap[*KeyProviderImplementation]
+	if !ok {
+		return nil, nil, fmt.Errorf("no registered KeyProvider named %v", *KeyProviderImplementation)
+	}
+	dataKey := make([]byte, dataKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, nil, fmt.Errorf("can't generate data key: %v", err)
+	}
+	keyID, wrappedKey, err := kp.WrapKey(ctx, dataKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("can't wrap data key with %v: %v", *KeyProviderImplementation, err)
+	}
+	return dataKey, &Params{
+		KeyProvider: *KeyProviderImplementation,
+		KeyID:       keyID,
+		WrappedKey:  wrappedKey,
+	}, nil
+}
+
+// DataKey returns the data key a backup was encrypted with, or nil if
+// the backup is not encrypted (p is nil).
+func (p *Params) DataKey(ctx context.Context) ([]byte, error) {
+	if p == nil {
+		return nil, nil
+	}
+	kp, ok := KeyProviderMap[p.KeyProvider]
+	if !ok {
+		return nil, fmt.Errorf("backup is encrypted with the %v key provider, which is not registered", p.KeyProvider)
+	}
+	dataKey, err := kp.UnwrapKey(ctx, p.KeyID, p.WrappedKey)
+	if err != nil {
+		return nil, fmt.Errorf("can't unwrap data key with %v key %v: %v", p.KeyProvider, p.KeyID, err)
+	}
+	if len(dataKey) != dataKeySize {
+		return nil, fmt.Errorf("unwrapped data key has %v bytes, expected %v", len(dataKey), dataKeySize)
+	}
+	return dataKey, nil
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backupencryption
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"golang.org/x/net/context"
+)
+
+var (
+	// KeyFile is the file of master keys for the keyfile KeyProvider.
+	// Exported for test purposes.
+	KeyFile = flag.String("backup_encryption_keyfile", "", "the file holding the master keys for the keyfile backup encryption key provider. Each line is a key ID and a base64-encoded 32 byte key, separated by a space. The last key encrypts new backups, the others are kept to restore older backups.")
+)
+
+// KeyFileProvider is a KeyProvider that reads the master keys from a
+// local file. The file is read for every operation, so keys can be
+// rotated by appending a new key to it.
+type KeyFileProvider struct{}
+
+// readKeys returns the master keys of the file by ID, and the ID of
+// the last one.
+func (kp *KeyFileProvider) readKeys() (map[string][]byte, string, error) {
+	if *KeyFile == "" {
+		return nil, "", fmt.Errorf("-backup_encryption_keyfile is not set")
+	}
+	data, err := ioutil.ReadFile(*KeyFile)
+	if err != nil {
+		return nil, "", err
+	}
+	keys := make(map[string][]byte)
+	lastID := ""
+	for i, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, "", fmt.Errorf("%v:%v: expected a key ID and a key", *KeyFile, i+1)
+		}
+		key, err := base64.StdEncoding.DecodeString(fields[1])
+		if err != nil {
+			return nil, "", fmt.Errorf("%v:%v: can't decode key %v: %v", *KeyFile, i+1, fields[0], err)
+		}
+		if len(key) != dataKeySize {
+			return nil, "", fmt.Errorf("%v:%v: key %v has %v bytes, expected %v", *KeyFile, i+1, fields[0], len(key), dataKeySize)
+		}
+		keys[fields[0]] = key
+		lastID = fields[0]
+	}
+	if lastID == "" {
+		return nil, "", fmt.Errorf("%v has no keys", *KeyFile)
+	}
+	return keys, lastID, nil
+}
+
+// WrapKey is part of the KeyProvider interface.
+func (kp *KeyFileProvider) WrapKey(ctx context.Context, dataKey []byte) (string, []byte, error) {
+	keys, keyID, err := kp.readKeys()
+	if err != nil {
+		return "", nil, err
+	}
+	aead, err := newAEAD(keys[keyID])
+	if err != nil {
+		return "", nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", nil, fmt.Errorf("can't generate nonce: %v", err)
+	}
+	return keyID, aead.Seal(nonce, nonce, dataKey, []byte(keyID)), nil
+}
+
+// UnwrapKey is part of the KeyProvider interface.
+func (kp *KeyFileProvider) UnwrapKey(ctx context.Context, keyID string, wrappedKey []byte) ([]byte, error) {
+	keys, _, err := kp.readKeys()
+	if err != nil {
+		return nil, err
+	}
+	key, ok := keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("no key %v in %v", keyID, *KeyFile)
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(wrappedKey) < aead.NonceSize() {
+		return nil, fmt.Errorf("wrapped key is too short")
+	}
+	nonce := wrappedKey[:aead.NonceSize()]
+	return aead.Open(nil, nonce, wrappedKey[aead.NonceSize():], []byte(keyID))
+}
+
+func init() {
+	KeyProviderMap["keyfile"] = &KeyFileProvider{}
+}
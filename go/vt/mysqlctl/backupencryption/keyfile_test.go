@@ -0,0 +1,90 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backupencryption
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+)
+
+func TestKeyFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keyfiletest")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	defer func(saved string) { *KeyFile = saved }(*KeyFile)
+	*KeyFile = path.Join(dir, "keys")
+	defer func(saved string) { *KeyProviderImplementation = saved }(*KeyProviderImplementation)
+	*KeyProviderImplementation = "keyfile"
+
+	writeKeys := func(keys string) {
+		require.NoError(t, ioutil.WriteFile(*KeyFile, []byte(keys), 0600))
+	}
+	key1 := base64.StdEncoding.EncodeToString(testDataKey(t))
+	key2 := base64.StdEncoding.EncodeToString(testDataKey(t))
+	ctx := context.Background()
+
+	// Backups get the last key of the file.
+	writeKeys(fmt.Sprintf("# master keys\nkey1 %v\n", key1))
+	dataKey1, params1, err := NewDataKey(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "keyfile", params1.KeyProvider)
+	assert.Equal(t, "key1", params1.KeyID)
+	assert.NotEqual(t, dataKey1, params1.WrappedKey)
+
+	// After a rotation, older backups can still be restored.
+	writeKeys(fmt.Sprintf("key1 %v\nkey2 %v\n", key1, key2))
+	dataKey2, params2, err := NewDataKey(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "key2", params2.KeyID)
+	got, err := params1.DataKey(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, dataKey1, got)
+	got, err = params2.DataKey(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, dataKey2, got)
+
+	// Unless their key is gone.
+	writeKeys(fmt.Sprintf("key2 %v\n", key2))
+	_, err = params1.DataKey(ctx)
+	assert.EqualError(t, err, fmt.Sprintf("can't unwrap data key with keyfile key key1: no key key1 in %v", *KeyFile))
+
+	// A wrapped key is bound to its key ID.
+	params2.KeyID = "key1"
+	writeKeys(fmt.Sprintf("key1 %v\n", key2))
+	_, err = params2.DataKey(ctx)
+	assert.Error(t, err)
+
+	// Unencrypted backups have no data key.
+	got, err = (*Params)(nil).DataKey(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	// Bad key files.
+	for _, keys := range []string{"", "key1\n", "key1 notbase64!\n", "key1 " + base64.StdEncoding.EncodeToString([]byte("short")) + "\n"} {
+		writeKeys(keys)
+		_, _, err := NewDataKey(ctx)
+		assert.Error(t, err, "keys %q", keys)
+	}
+}
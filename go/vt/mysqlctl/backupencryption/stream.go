@@ -0,0 +1,204 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backupencryption
+
+import (
+	"bufio"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+// An encrypted stream starts with a random nonce prefix, followed by the
+// data split in chunks of chunkSize bytes, each sealed with AES-GCM. The
+// nonce of a chunk is the prefix followed by the chunk number, so a data
+// key can encrypt many streams. The last chunk, possibly empty, is sealed
+// with different additional data, so a truncated stream doesn't decrypt.
+const (
+	chunkSize       = 64 * 1024
+	noncePrefixSize = 8
+)
+
+var (
+	chunkAdditionalData = []byte{0}
+	lastAdditionalData  = []byte{1}
+
+	errTruncated = errors.New("encrypted stream is truncated")
+)
+
+func newAEAD(dataKey []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+type writer struct {
+	w       io.Writer
+	aead    cipher.AEAD
+	nonce   []byte
+	counter uint32
+	buf     []byte
+	out     []byte
+}
+
+// NewWriter returns a WriteCloser that encrypts the data with dataKey,
+// and writes it to w. Close writes the last chunk, it doesn't close w.
+func NewWriter(w io.Writer, dataKey []byte) (io.WriteCloser, error) {
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce[:noncePrefixSize]); err != nil {
+		return nil, fmt.Errorf("can't generate nonce: %v", err)
+	}
+	if _, err := w.Write(nonce[:noncePrefixSize]); err != nil {
+		return nil, err
+	}
+	return &writer{
+		w:     w,
+		aead:  aead,
+		nonce: nonce,
+		buf:   make([]byte, 0, chunkSize),
+		out:   make([]byte, 0, chunkSize+aead.Overhead()),
+	}, nil
+}
+
+// Write is part of the io.Writer interface.
+func (w *writer) Write(p []byte) (int, error) {
+	n := 0
+	for len(p) > 0 {
+		// A full chunk is only sealed once more data comes,
+		// since the last one is sealed differently.
+		if len(w.buf) == chunkSize {
+			if err := w.seal(chunkAdditionalData); err != nil {
+				return n, err
+			}
+		}
+		copied := copy(w.buf[len(w.buf):chunkSize], p)
+		w.buf = w.buf[:len(w.buf)+copied]
+		p = p[copied:]
+		n += copied
+	}
+	return n, nil
+}
+
+// Close is part of the io.Closer interface.
+func (w *writer) Close() error {
+	return w.seal(lastAdditionalData)
+}
+
+func (w *writer) seal(additionalData []byte) error {
+	if w.counter == math.MaxUint32 {
+		return fmt.Errorf("encrypted stream is too long")
+	}
+	binary.BigEndian.PutUint32(w.nonce[noncePrefixSize:], w.counter)
+	w.counter++
+	w.out = w.aead.Seal(w.out[:0], w.nonce, w.buf, additionalData)
+	w.buf = w.buf[:0]
+	_, err := w.w.Write(w.out)
+	return err
+}
+
+type reader struct {
+	r       *bufio.Reader
+	aead    cipher.AEAD
+	nonce   []byte
+	counter uint32
+	in      []byte
+	buf     []byte
+	plain   []byte
+	done    bool
+}
+
+// NewReader returns a Reader that decrypts the data read from r with
+// dataKey. Reads fail if the data was altered or truncated.
+func NewReader(r io.Reader, dataKey []byte) (io.Reader, error) {
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(r, nonce[:noncePrefixSize]); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil, errTruncated
+		}
+		return nil, err
+	}
+	return &reader{
+		r:     bufio.NewReaderSize(r, chunkSize+aead.Overhead()),
+		aead:  aead,
+		nonce: nonce,
+		in:    make([]byte, chunkSize+aead.Overhead()),
+		buf:   make([]byte, 0, chunkSize),
+	}, nil
+}
+
+// Read is part of the io.Reader interface.
+func (r *reader) Read(p []byte) (int, error) {
+	for len(r.plain) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+		if err := r.open(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, r.plain)
+	r.plain = r.plain[n:]
+	return n, nil
+}
+
+func (r *reader) open() error {
+	n, err := io.ReadFull(r.r, r.in)
+	last := false
+	switch err {
+	case nil:
+		// A full chunk is the last one if nothing follows.
+		if _, err := r.r.Peek(1); err == io.EOF {
+			last = true
+		} else if err != nil {
+			return err
+		}
+	case io.ErrUnexpectedEOF:
+		last = true
+	case io.EOF:
+		return errTruncated
+	default:
+		return err
+	}
+
+	additionalData := chunkAdditionalData
+	if last {
+		additionalData = lastAdditionalData
+	}
+	binary.BigEndian.PutUint32(r.nonce[noncePrefixSize:], r.counter)
+	r.counter++
+	r.plain, err = r.aead.Open(r.buf[:0], r.nonce, r.in[:n], additionalData)
+	if err != nil {
+		return fmt.Errorf("can't decrypt chunk %v, the data is corrupt, truncated, or encrypted with another key: %v", r.counter-1, err)
+	}
+	r.done = last
+	return nil
+}
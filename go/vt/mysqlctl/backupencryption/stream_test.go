@@ -0,0 +1,123 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backupencryption
+
+import (
+	"bytes"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testDataKey(t *testing.T) []byte {
+	key := make([]byte, dataKeySize)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	return key
+}
+
+func encrypt(t *testing.T, key, data []byte) []byte {
+	buf := &bytes.Buffer{}
+	w, err := NewWriter(buf, key)
+	require.NoError(t, err)
+	// Write in odd sizes, so chunks span writes.
+	for len(data) > 0 {
+		n := 1000
+		if n > len(data) {
+			n = len(data)
+		}
+		written, err := w.Write(data[:n])
+		require.NoError(t, err)
+		require.Equal(t, n, written)
+		data = data[n:]
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func decrypt(key, encrypted []byte) ([]byte, error) {
+	r, err := NewReader(bytes.NewReader(encrypted), key)
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.ReadAll(r)
+}
+
+func TestStream(t *testing.T) {
+	key := testDataKey(t)
+	for _, size := range []int{0, 1, chunkSize - 1, chunkSize, chunkSize + 1, 3*chunkSize + 17} {
+		t.Run(fmt.Sprintf("%v bytes", size), func(t *testing.T) {
+			data := make([]byte, size)
+			_, err := rand.Read(data)
+			require.NoError(t, err)
+
+			encrypted := encrypt(t, key, data)
+			// The last chunk is only empty for empty data.
+			chunks := (size + chunkSize - 1) / chunkSize
+			if chunks == 0 {
+				chunks = 1
+			}
+			assert.Equal(t, noncePrefixSize+size+16*chunks, len(encrypted))
+
+			decrypted, err := decrypt(key, encrypted)
+			require.NoError(t, err)
+			assert.Equal(t, data, append([]byte{}, decrypted...))
+		})
+	}
+}
+
+func TestStreamErrors(t *testing.T) {
+	key := testDataKey(t)
+	data := bytes.Repeat([]byte("vitess"), chunkSize)
+	encrypted := encrypt(t, key, data)
+
+	// Another key.
+	_, err := decrypt(testDataKey(t), encrypted)
+	assert.Error(t, err)
+
+	// Altered data.
+	altered := append([]byte{}, encrypted...)
+	altered[len(altered)/2]++
+	_, err = decrypt(key, altered)
+	assert.Error(t, err)
+
+	// Truncated at a chunk boundary, and anywhere else.
+	for _, size := range []int{0, noncePrefixSize, noncePrefixSize + chunkSize + 16, len(encrypted) - 1} {
+		_, err = decrypt(key, encrypted[:size])
+		assert.Error(t, err, "truncated to %v bytes", size)
+	}
+
+	// Two streams with the same key have different nonces.
+	assert.NotEqual(t, encrypted, encrypt(t, key, data))
+}
+
+func TestStreamEOF(t *testing.T) {
+	key := testDataKey(t)
+	r, err := NewReader(bytes.NewReader(encrypt(t, key, []byte("data"))), key)
+	require.NoError(t, err)
+	buf := make([]byte, 10)
+	n, err := r.Read(buf)
+	require.NoError(t, err)
+	assert.Equal(t, "data", string(buf[:n]))
+	_, err = r.Read(buf)
+	assert.Equal(t, io.EOF, err)
+}
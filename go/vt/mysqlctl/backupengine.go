@@ -25,11 +25,13 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupencryption"
 	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
 	"vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/topo"
@@ -92,6 +94,18 @@ type RestoreParams struct {
 	// StartTime: if non-zero, look for a backup that was taken at or before this time
 	// Otherwise, find the most recent backup
 	StartTime time.Time
+	// RestoreToPos: if non-zero, look for a backup at or before this position,
+	// and replay the binlog backups on top of it up to this position
+	RestoreToPos mysql.Position
+	// RestoreToTime: if non-zero, look for a backup that was taken at or before this time,
+	// and replay the binlog backups on top of it up to this time
+	RestoreToTime time.Time
+}
+
+// pointInTime returns true if the restore replays binlog backups
+// on top of the restored backup.
+func (params *RestoreParams) pointInTime() bool {
+	return !params.RestoreToPos.IsZero() || !params.RestoreToTime.IsZero()
 }
 
 // RestoreEngine is the interface to restore a backup with a given engine.
@@ -188,16 +202,70 @@ type BackupManifest struct {
 	// FinishedTime is the time (in RFC 3339 format, UTC) at which the backup finished, if known.
 	// Some backups may not set this field if they were created before the field was added.
 	FinishedTime string
+
+	// ParentBackup is the name of the backup, in the same directory, that
+	// an incremental backup was taken on top of. Restoring the backup
+	// needs its parent, and the parent's own parent if any. It is empty
+	// for full backups.
+	ParentBackup string
+
+	// Encryption has the data key the backup files are encrypted with,
+	// wrapped by a key provider. It is nil if the backup is not encrypted.
+	Encryption *backupencryption.Params
+
+	// Tables are the user tables when the backup was taken, for
+	// VerifyBackup. Backups taken before the field existed, or while
+	// the tables couldn't be listed, have none.
+	Tables []BackupTable
+}
+
+// GetBackupParents returns the parent of each incremental backup in bhs,
+// by backup name. Full backups and incomplete backups are not in the map.
+func GetBackupParents(ctx context.Context, bhs []backupstorage.BackupHandle) map[string]string {
+	parents := make(map[string]string)
+	for _, bh := range bhs {
+		bm, err := GetBackupManifest(ctx, bh)
+		if err != nil {
+			// An incomplete backup can't be restored, so nothing needs
+			// its parent.
+			continue
+		}
+		if bm.ParentBackup != "" {
+			parents[bh.Name()] = bm.ParentBackup
+		}
+	}
+	return parents
+}
+
+// FindDependentBackups returns the names of the backups that need the
+// backup with the given name to be restored: the incremental backups
+// taken on top of it, directly or through other incremental backups.
+// parents is the result of GetBackupParents. The names are sorted.
+func FindDependentBackups(parents map[string]string, name string) []string {
+	var dependents []string
+	for backup := range parents {
+		// Walk up the chain of parents. A chain is never longer than
+		// the number of backups, unless the MANIFEST files are corrupt.
+		for i, parent := 0, parents[backup]; parent != "" && i < len(parents); i, parent = i+1, parents[parent] {
+			if parent == name {
+				dependents = append(dependents, backup)
+				break
+			}
+		}
+	}
+	sort.Strings(dependents)
+	return dependents
 }
 
 // FindBackupToRestore returns a selected candidate backup to be restored.
 // It returns the most recent backup that is complete, meaning it has a valid
-// MANIFEST file.
+// MANIFEST file. With a StartTime, the backup must have started at or before
+// it. With a RestoreToTime, the backup must have finished at or before it,
+// because binlogs can only be replayed from the end of the backup. A backup
+// that doesn't record when it finished is judged by when it started.
 func FindBackupToRestore(ctx context.Context, params RestoreParams, bhs []backupstorage.BackupHandle) (backupstorage.BackupHandle, error) {
 	var bh backupstorage.BackupHandle
 	var index int
-	// if a StartTime is provided in params, then find a backup that was taken at or before that time
-	checkBackupTime := !params.StartTime.IsZero()
 	backupDir := GetBackupDir(params.Keyspace, params.Shard)
 
 	for index = len(bhs) - 1; index >= 0; index-- {
@@ -209,23 +277,48 @@ func FindBackupToRestore(ctx context.Context, params RestoreParams, bhs []backup
 			continue
 		}
 
-		var backupTime time.Time
-		if checkBackupTime {
-			backupTime, err = time.Parse(time.RFC3339, bm.BackupTime)
+		if !params.RestoreToPos.IsZero() && !params.RestoreToPos.AtLeast(bm.Position) {
+			params.Logger.Infof("Restore: skipping backup %v/%v at position %v, which is not before %v", backupDir, bh.Name(), bm.Position, params.RestoreToPos)
+			continue
+		}
+
+		if !params.StartTime.IsZero() || !params.RestoreToTime.IsZero() {
+			backupTime, err := time.Parse(time.RFC3339, bm.BackupTime)
 			if err != nil {
 				params.Logger.Warningf("Restore: skipping backup %v/%v with invalid time %v: %v", backupDir, bh.Name(), bm.BackupTime, err)
 				continue
 			}
+			if !params.StartTime.IsZero() && backupTime.After(params.StartTime) {
+				continue
+			}
+			if !params.RestoreToTime.IsZero() {
+				finishedTime := backupTime
+				if bm.FinishedTime != "" {
+					finishedTime, err = time.Parse(time.RFC3339, bm.FinishedTime)
+					if err != nil {
+						params.Logger.Warningf("Restore: skipping backup %v/%v with invalid finished time %v: %v", backupDir, bh.Name(), bm.FinishedTime, err)
+						continue
+					}
+				}
+				if finishedTime.After(params.RestoreToTime) {
+					params.Logger.Infof("Restore: skipping backup %v/%v finished at %v, which is after %v", backupDir, bh.Name(), finishedTime.Format(time.RFC3339), params.RestoreToTime.Format(time.RFC3339))
+					continue
+				}
+			}
 		}
-		if !checkBackupTime /* not snapshot */ || backupTime.Equal(params.StartTime) || backupTime.Before(params.StartTime) {
-			params.Logger.Infof("Restore: found backup %v %v to restore", bh.Directory(), bh.Name())
-			break
-		}
+		params.Logger.Infof("Restore: found backup %v %v to restore", bh.Directory(), bh.Name())
+		break
 	}
 	if index < 0 {
-		if checkBackupTime {
+		if !params.StartTime.IsZero() {
 			params.Logger.Errorf("No valid backup found before time %v", params.StartTime.Format(BackupTimestampFormat))
 		}
+		if !params.RestoreToTime.IsZero() {
+			params.Logger.Errorf("No valid backup found that finished before time %v", params.RestoreToTime.Format(BackupTimestampFormat))
+		}
+		if !params.RestoreToPos.IsZero() {
+			params.Logger.Errorf("No valid backup found before position %v", params.RestoreToPos)
+		}
 		// There is at least one attempted backup, but none could be read.
 		// This implies there is data we ought to have, so it's not safe to start
 		// up empty.
@@ -0,0 +1,674 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path"
+	"sort"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql"
+	vtenv "vitess.io/vitess/go/vt/env"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupencryption"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// This file handles the backup of closed binlog files to the
+// BackupStorage, and their replay on top of a restored backup
+// for point in time recovery.
+//
+// Each binlog file is stored as its own backup in the
+// binlogs/<keyspace>/<shard> directory, with a MANIFEST that
+// describes the GTIDs it contains. At restore time, the binlog
+// backups are chained by GTID set, starting from the position
+// of the restored backup, so binlogs taken on different masters
+// (after a reparent) can be mixed.
+
+const (
+	// binlogBackupFileName is the binlog file name within a binlog backup.
+	binlogBackupFileName = "binlog"
+
+	// binlogEventHeaderLength is the length of the header of all
+	// binlog events since MySQL 5.0.
+	binlogEventHeaderLength = 19
+)
+
+// binlogMagic is the header of every binlog file.
+var binlogMagic = []byte{0xfe, 'b', 'i', 'n'}
+
+// BinlogBackupManifest is the MANIFEST file of a binlog backup.
+type BinlogBackupManifest struct {
+	// BinlogFile is the name of the binlog file on the tablet that backed it up.
+	BinlogFile string
+
+	// TabletAlias is the tablet that backed up the binlog file.
+	TabletAlias string
+
+	// PreviousGTIDs is the GTID set that was executed before the
+	// first transaction of the binlog file.
+	PreviousGTIDs mysql.Position
+
+	// Position is PreviousGTIDs plus all the transactions of the binlog file.
+	Position mysql.Position
+
+	// FirstTimestamp and LastTimestamp are the commit times (in RFC 3339
+	// format, UTC) of the first and last transactions of the binlog file.
+	// They are empty if the binlog file has no transaction.
+	FirstTimestamp string
+	LastTimestamp  string
+
+	// BackupTime is when the binlog file was backed up (RFC 3339 format, UTC).
+	BackupTime string
+
+	// SkipCompress is true if the binlog file was NOT compressed.
+	SkipCompress bool
+
+	// CompressionEngine is the engine the binlog file was compressed with.
+	// It is empty for backups taken before the field existed, which were
+	// compressed with pgzip.
+	CompressionEngine string
+
+	// ExternalDecompressor is the command decompressing the binlog file,
+	// if it was compressed with the external engine. It's informational
+	// only, the restore runs -backup_storage_external_decompressor.
+	ExternalDecompressor string
+
+	// Encryption has the data key the binlog file is encrypted with,
+	// wrapped by a key provider. It is nil if the binlog file is not
+	// encrypted.
+	Encryption *backupencryption.Params
+}
+
+// BinlogBackupParams is the struct that holds all params passed to BackupBinlogs
+type BinlogBackupParams struct {
+	Cnf    *Mycnf
+	Mysqld MysqlDaemon
+	Logger logutil.Logger
+	// Keyspace and Shard are used to infer the directory where binlog backups should be stored
+	Keyspace string
+	Shard    string
+	// TabletAlias is used along with the binlog file name to construct the backup name
+	TabletAlias string
+	// FileTimeout, if greater than 0, is the timeout of the upload of one binlog file.
+	FileTimeout time.Duration
+}
+
+// GetBinlogBackupDir returns the directory where binlog backups for the
+// given keyspace/shard are (or will be) stored
+func GetBinlogBackupDir(keyspace, shard string) string {
+	return binlogBackupDir(GetBackupDir(keyspace, shard))
+}
+
+// binlogBackupDir returns the directory of the binlog backups
+// of the shard whose backups are in backupDir.
+func binlogBackupDir(backupDir string) string {
+	return "binlogs/" + backupDir
+}
+
+// binlogBackupName returns the backup name of a binlog file.
+func binlogBackupName(tabletAlias, binlogFile string) string {
+	return fmt.Sprintf("%v.%v", tabletAlias, binlogFile)
+}
+
+// BackupBinlogs uploads the closed binlog files of mysqld that are not
+// in the BackupStorage yet. The binlog file mysqld is currently writing
+// to is never uploaded, callers can FLUSH BINARY LOGS to close it first.
+// It returns the number of binlog files that were uploaded.
+func BackupBinlogs(ctx context.Context, params BinlogBackupParams) (int, error) {
+	pos, err := params.Mysqld.MasterPosition()
+	if err != nil {
+		return 0, vterrors.Wrap(err, "can't get master position")
+	}
+	if _, ok := pos.GTIDSet.(mysql.Mysql56GTIDSet); !ok {
+		return 0, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "binlog backups require MySQL 5.6+ GTIDs, got position %v", pos)
+	}
+
+	qr, err := params.Mysqld.FetchSuperQuery(ctx, "SHOW BINARY LOGS")
+	if err != nil {
+		return 0, vterrors.Wrap(err, "can't list binary logs")
+	}
+	if len(qr.Rows) < 2 {
+		// Only the active binlog file is there.
+		return 0, nil
+	}
+
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return 0, vterrors.Wrap(err, "unable to get backup storage")
+	}
+	defer bs.Close()
+
+	dir := GetBinlogBackupDir(params.Keyspace, params.Shard)
+	bhs, err := bs.ListBackups(ctx, dir)
+	if err != nil {
+		return 0, vterrors.Wrap(err, "ListBackups failed")
+	}
+	existing := make(map[string]bool, len(bhs))
+	for _, bh := range bhs {
+		existing[bh.Name()] = true
+	}
+
+	binlogDir := path.Dir(params.Cnf.BinLogPath)
+	uploaded := 0
+	// The last binlog file is the one mysqld is writing to.
+	for _, row := range qr.Rows[:len(qr.Rows)-1] {
+		binlogFile := row[0].ToString()
+		name := binlogBackupName(params.TabletAlias, binlogFile)
+		if existing[name] {
+			continue
+		}
+		params.Logger.Infof("Backing up binlog file %v to %v/%v", binlogFile, dir, name)
+		if err := backupBinlogFileWithTimeout(ctx, params, bs, dir, name, path.Join(binlogDir, binlogFile)); err != nil {
+			return uploaded, vterrors.Wrapf(err, "can't backup binlog file %v", binlogFile)
+		}
+		uploaded++
+	}
+	return uploaded, nil
+}
+
+// backupBinlogFileWithTimeout calls backupBinlogFile with params.FileTimeout.
+func backupBinlogFileWithTimeout(ctx context.Context, params BinlogBackupParams, bs backupstorage.BackupStorage, dir, name, binlogPath string) error {
+	if params.FileTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, params.FileTimeout)
+		defer cancel()
+	}
+	return backupBinlogFile(ctx, params, bs, dir, name, binlogPath)
+}
+
+// backupBinlogFile uploads one binlog file and its MANIFEST.
+func backupBinlogFile(ctx context.Context, params BinlogBackupParams, bs backupstorage.BackupStorage, dir, name, binlogPath string) (finalErr error) {
+	source, err := os.Open(binlogPath)
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+	fi, err := source.Stat()
+	if err != nil {
+		return err
+	}
+
+	// Binlog files are encrypted like the files of full backups,
+	// each with its own data key.
+	dataKey, encryption, err := backupencryption.NewDataKey(ctx)
+	if err != nil {
+		return vterrors.Wrap(err, "can't create backup encryption key")
+	}
+
+	bh, err := bs.StartBackup(ctx, dir, name)
+	if err != nil {
+		return vterrors.Wrap(err, "StartBackup failed")
+	}
+	defer func() {
+		if finalErr != nil {
+			if err := bh.AbortBackup(ctx); err != nil {
+				params.Logger.Errorf2(err, "failed to abort binlog backup %v/%v", dir, name)
+			}
+		}
+	}()
+
+	wc, err := bh.AddFile(ctx, binlogBackupFileName, fi.Size())
+	if err != nil {
+		return vterrors.Wrapf(err, "cannot add file %v", binlogBackupFileName)
+	}
+	var writer io.Writer = wc
+	var encrypter io.WriteCloser
+	if dataKey != nil {
+		if encrypter, err = backupencryption.NewWriter(writer, dataKey); err != nil {
+			wc.Close()
+			return vterrors.Wrap(err, "cannot create encrypter")
+		}
+		writer = encrypter
+	}
+	var compressor io.WriteCloser
+	if *backupStorageCompress {
+		engine, err := getBackupCompressionEngine()
+		if err == nil {
+			compressor, err = engine.NewWriter(ctx, writer)
+		}
+		if err != nil {
+			wc.Close()
+			return vterrors.Wrap(err, "cannot create compressor")
+		}
+		writer = compressor
+	}
+
+	// Scan the binlog file while it is being copied.
+	info, err := scanBinlogFile(io.TeeReader(source, writer))
+	if compressor != nil {
+		if closeErr := compressor.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	// Close the encrypter after the compressor, to write the last chunk.
+	if encrypter != nil {
+		if closeErr := encrypter.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	if closeErr := wc.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return vterrors.Wrapf(err, "cannot copy binlog file %v", binlogPath)
+	}
+
+	bm := info.manifest()
+	bm.BinlogFile = path.Base(binlogPath)
+	bm.TabletAlias = params.TabletAlias
+	bm.BackupTime = time.Now().UTC().Format(time.RFC3339)
+	bm.SkipCompress = !*backupStorageCompress
+	bm.CompressionEngine = *backupCompressionEngine
+	bm.ExternalDecompressor = *backupExternalDecompressor
+	bm.Encryption = encryption
+	data, err := json.MarshalIndent(bm, "", "  ")
+	if err != nil {
+		return vterrors.Wrapf(err, "cannot JSON encode %v", backupManifestFileName)
+	}
+	wc, err = bh.AddFile(ctx, backupManifestFileName, int64(len(data)))
+	if err != nil {
+		return vterrors.Wrapf(err, "cannot add %v to backup", backupManifestFileName)
+	}
+	if _, err := wc.Write(data); err != nil {
+		wc.Close()
+		return vterrors.Wrapf(err, "cannot write %v", backupManifestFileName)
+	}
+	if err := wc.Close(); err != nil {
+		return vterrors.Wrapf(err, "cannot close %v", backupManifestFileName)
+	}
+
+	return bh.EndBackup(ctx)
+}
+
+// binlogTransaction is a transaction found in a binlog file.
+type binlogTransaction struct {
+	gtid      mysql.GTID
+	timestamp time.Time
+}
+
+// binlogFileInfo describes the transactions of a binlog file.
+type binlogFileInfo struct {
+	previousGTIDs mysql.Position
+	transactions  []binlogTransaction
+}
+
+// position returns the GTID set executed after the last transaction
+// of the binlog file.
+func (info *binlogFileInfo) position() mysql.Position {
+	pos := info.previousGTIDs
+	for _, tx := range info.transactions {
+		pos = mysql.AppendGTID(pos, tx.gtid)
+	}
+	return pos
+}
+
+// manifest returns a BinlogBackupManifest with the fields
+// that describe the binlog file contents filled in.
+func (info *binlogFileInfo) manifest() *BinlogBackupManifest {
+	bm := &BinlogBackupManifest{
+		PreviousGTIDs: info.previousGTIDs,
+		Position:      info.position(),
+	}
+	if len(info.transactions) > 0 {
+		bm.FirstTimestamp = info.transactions[0].timestamp.UTC().Format(time.RFC3339)
+		bm.LastTimestamp = info.transactions[len(info.transactions)-1].timestamp.UTC().Format(time.RFC3339)
+	}
+	return bm
+}
+
+// scanBinlogFile reads a MySQL 5.6+ binlog file, and returns its
+// previous GTIDs and the GTIDs and commit times of its transactions.
+func scanBinlogFile(r io.Reader) (*binlogFileInfo, error) {
+	br := bufio.NewReader(r)
+	magic := make([]byte, len(binlogMagic))
+	if _, err := io.ReadFull(br, magic); err != nil {
+		return nil, vterrors.Wrap(err, "can't read binlog file header")
+	}
+	if !bytes.Equal(magic, binlogMagic) {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "not a binlog file: bad magic number %x", magic)
+	}
+
+	info := &binlogFileInfo{}
+	var format mysql.BinlogFormat
+	header := make([]byte, binlogEventHeaderLength)
+	for {
+		if _, err := io.ReadFull(br, header); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, vterrors.Wrap(err, "can't read binlog event header")
+		}
+		length := binary.LittleEndian.Uint32(header[9:13])
+		if length < binlogEventHeaderLength {
+			return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "invalid binlog event length %v", length)
+		}
+		buf := make([]byte, length)
+		copy(buf, header)
+		if _, err := io.ReadFull(br, buf[binlogEventHeaderLength:]); err != nil {
+			return nil, vterrors.Wrap(err, "can't read binlog event")
+		}
+
+		ev := mysql.NewMysql56BinlogEvent(buf)
+		if ev.IsFormatDescription() {
+			f, err := ev.Format()
+			if err != nil {
+				return nil, vterrors.Wrap(err, "can't parse format description event")
+			}
+			format = f
+			continue
+		}
+		if format.IsZero() {
+			return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "binlog event before the format description event")
+		}
+		ev, _, err := ev.StripChecksum(format)
+		if err != nil {
+			return nil, vterrors.Wrap(err, "can't strip checksum")
+		}
+		switch {
+		case ev.IsPreviousGTIDs():
+			pos, err := ev.PreviousGTIDs(format)
+			if err != nil {
+				return nil, vterrors.Wrap(err, "can't parse previous GTIDs event")
+			}
+			info.previousGTIDs = pos
+		case ev.IsGTID():
+			gtid, _, err := ev.GTID(format)
+			if err != nil {
+				return nil, vterrors.Wrap(err, "can't parse GTID event")
+			}
+			info.transactions = append(info.transactions, binlogTransaction{
+				gtid:      gtid,
+				timestamp: time.Unix(int64(ev.Timestamp()), 0),
+			})
+		}
+	}
+	if info.previousGTIDs.IsZero() {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "binlog file has no previous GTIDs event, is GTID mode enabled?")
+	}
+	return info, nil
+}
+
+// binlogBackup is a binlog backup found in the BackupStorage.
+type binlogBackup struct {
+	bh       backupstorage.BackupHandle
+	manifest *BinlogBackupManifest
+	// firstTime is the parsed manifest.FirstTimestamp.
+	firstTime time.Time
+}
+
+// findBinlogBackups returns all the readable binlog backups
+// of the keyspace/shard being restored.
+func findBinlogBackups(ctx context.Context, params RestoreParams, bs backupstorage.BackupStorage) ([]*binlogBackup, error) {
+	dir := GetBinlogBackupDir(params.Keyspace, params.Shard)
+	bhs, err := bs.ListBackups(ctx, dir)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "ListBackups failed")
+	}
+	result := make([]*binlogBackup, 0, len(bhs))
+	for _, bh := range bhs {
+		bm := &BinlogBackupManifest{}
+		if err := getBackupManifestInto(ctx, bh, bm); err != nil {
+			params.Logger.Warningf("Possibly incomplete binlog backup %v in directory %v on BackupStorage: %v", bh.Name(), dir, err)
+			continue
+		}
+		bb := &binlogBackup{bh: bh, manifest: bm}
+		if bm.FirstTimestamp != "" {
+			if bb.firstTime, err = time.Parse(time.RFC3339, bm.FirstTimestamp); err != nil {
+				params.Logger.Warningf("Skipping binlog backup %v/%v with invalid time %v: %v", dir, bh.Name(), bm.FirstTimestamp, err)
+				continue
+			}
+		}
+		result = append(result, bb)
+	}
+	return result, nil
+}
+
+// nextBinlogBackup returns the binlog backup to replay on top of pos,
+// or nil if there is none. A binlog backup can be replayed if all the
+// transactions before it were executed, and it has some that were not.
+// Binlog files of different masters overlap after a reparent, the one
+// with the oldest first transaction is picked.
+func nextBinlogBackup(pos mysql.Position, backups []*binlogBackup) *binlogBackup {
+	var result *binlogBackup
+	for _, bb := range backups {
+		if !pos.AtLeast(bb.manifest.PreviousGTIDs) || pos.AtLeast(bb.manifest.Position) {
+			continue
+		}
+		if result == nil || bb.firstTime.Before(result.firstTime) {
+			result = bb
+		}
+	}
+	return result
+}
+
+// binlogGTIDsToApply returns the GTIDs of the transactions of a binlog
+// file that need to be replayed on top of pos, to get closer to the
+// restore target (restoreToPos and/or restoreToTime). It returns nil
+// if there is no such transaction. done is true if the binlog file goes
+// past restoreToTime, so no other binlog file should be replayed.
+func binlogGTIDsToApply(pos mysql.Position, txs []binlogTransaction, restoreToPos mysql.Position, restoreToTime time.Time) (gtids mysql.GTIDSet, done bool) {
+	for _, tx := range txs {
+		if !restoreToTime.IsZero() && tx.timestamp.After(restoreToTime) {
+			return gtids, true
+		}
+		if pos.GTIDSet.ContainsGTID(tx.gtid) {
+			continue
+		}
+		if !restoreToPos.IsZero() && !restoreToPos.GTIDSet.ContainsGTID(tx.gtid) {
+			continue
+		}
+		if gtids == nil {
+			gtids = tx.gtid.GTIDSet()
+		} else {
+			gtids = gtids.AddGTID(tx.gtid)
+		}
+	}
+	return gtids, false
+}
+
+// applyBinlogBackups replays the binlog backups on top of a backup
+// restored at pos, until the restore target is reached. mysqld must
+// be running. It returns the position mysqld was restored to.
+func applyBinlogBackups(ctx context.Context, params RestoreParams, bs backupstorage.BackupStorage, pos mysql.Position) (mysql.Position, error) {
+	if _, ok := pos.GTIDSet.(mysql.Mysql56GTIDSet); !ok {
+		return pos, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "point in time recovery requires MySQL 5.6+ GTIDs, got position %v", pos)
+	}
+	backups, err := findBinlogBackups(ctx, params, bs)
+	if err != nil {
+		return pos, err
+	}
+	// Replay the binlog backups oldest first, so the error message
+	// below is about the first missing binlog backup.
+	sort.SliceStable(backups, func(i, j int) bool {
+		return backups[i].firstTime.Before(backups[j].firstTime)
+	})
+
+	for {
+		if !params.RestoreToPos.IsZero() && pos.AtLeast(params.RestoreToPos) {
+			return pos, nil
+		}
+		bb := nextBinlogBackup(pos, backups)
+		if bb == nil {
+			break
+		}
+		backups = removeBinlogBackup(backups, bb)
+
+		done, err := applyBinlogBackup(ctx, params, bb, &pos)
+		if err != nil {
+			return pos, vterrors.Wrapf(err, "can't replay binlog backup %v", bb.bh.Name())
+		}
+		if done {
+			return pos, nil
+		}
+	}
+
+	dir := GetBinlogBackupDir(params.Keyspace, params.Shard)
+	if !params.RestoreToPos.IsZero() {
+		return pos, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "binlog backups in %v do not go from %v to %v", dir, pos, params.RestoreToPos)
+	}
+	return pos, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "binlog backups in %v end at %v, before %v", dir, pos, params.RestoreToTime.UTC().Format(time.RFC3339))
+}
+
+// applyBinlogBackup downloads one binlog backup and replays the
+// transactions of it that are needed, updating pos. It returns true
+// if the binlog file goes past the restore target time.
+func applyBinlogBackup(ctx context.Context, params RestoreParams, bb *binlogBackup, pos *mysql.Position) (bool, error) {
+	tmpFile, info, err := downloadBinlogBackup(ctx, params, bb)
+	if err != nil {
+		return false, err
+	}
+	defer os.Remove(tmpFile)
+
+	gtids, done := binlogGTIDsToApply(*pos, info.transactions, params.RestoreToPos, params.RestoreToTime)
+	if gtids == nil {
+		return done, nil
+	}
+	params.Logger.Infof("Restore: replaying %v from binlog backup %v", gtids, bb.bh.Name())
+	if err := params.Mysqld.ApplyBinlogFile(ctx, tmpFile, gtids); err != nil {
+		return false, err
+	}
+	*pos = mysql.Position{GTIDSet: pos.GTIDSet.Union(gtids)}
+	return done, nil
+}
+
+// downloadBinlogBackup copies a binlog backup into a local temporary
+// file, and scans its transactions. The caller must remove the file.
+func downloadBinlogBackup(ctx context.Context, params RestoreParams, bb *binlogBackup) (string, *binlogFileInfo, error) {
+	source, err := bb.bh.ReadFile(ctx, binlogBackupFileName)
+	if err != nil {
+		return "", nil, vterrors.Wrapf(err, "can't open source file %v", binlogBackupFileName)
+	}
+	defer source.Close()
+	var reader io.Reader = source
+	dataKey, err := bb.manifest.Encryption.DataKey(ctx)
+	if err != nil {
+		return "", nil, vterrors.Wrap(err, "can't get backup encryption key")
+	}
+	if dataKey != nil {
+		if reader, err = backupencryption.NewReader(reader, dataKey); err != nil {
+			return "", nil, vterrors.Wrap(err, "can't create decrypter")
+		}
+	}
+	if !bb.manifest.SkipCompress {
+		engine, err := getRestoreCompressionEngine(bb.manifest.CompressionEngine, bb.manifest.ExternalDecompressor)
+		if err != nil {
+			return "", nil, err
+		}
+		decompressor, err := engine.NewReader(ctx, reader)
+		if err != nil {
+			return "", nil, vterrors.Wrap(err, "can't open decompressor")
+		}
+		defer decompressor.Close()
+		reader = decompressor
+	}
+
+	tmpFile, err := ioutil.TempFile(params.Cnf.TmpDir, "binlog")
+	if err != nil {
+		return "", nil, vterrors.Wrap(err, "can't create temporary binlog file")
+	}
+	info, err := scanBinlogFile(io.TeeReader(reader, tmpFile))
+	if closeErr := tmpFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmpFile.Name())
+		return "", nil, err
+	}
+	return tmpFile.Name(), info, nil
+}
+
+func removeBinlogBackup(backups []*binlogBackup, bb *binlogBackup) []*binlogBackup {
+	result := backups[:0]
+	for _, b := range backups {
+		if b != bb {
+			result = append(result, b)
+		}
+	}
+	return result
+}
+
+// ApplyBinlogFile replays the transactions of a binlog file that are in
+// includeGTIDs, by piping the output of mysqlbinlog into mysql.
+func (mysqld *Mysqld) ApplyBinlogFile(ctx context.Context, file string, includeGTIDs mysql.GTIDSet) error {
+	dir, err := vtenv.VtMysqlRoot()
+	if err != nil {
+		return err
+	}
+	mysqlbinlogPath, err := binaryPath(dir, "mysqlbinlog")
+	if err != nil {
+		return err
+	}
+	mysqlPath, err := binaryPath(dir, "mysql")
+	if err != nil {
+		return err
+	}
+	params, err := mysqld.dbcfgs.DbaConnector().MysqlParams()
+	if err != nil {
+		return err
+	}
+	cnf, err := mysqld.defaultsExtraFile(params)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(cnf)
+	ldPaths, err := buildLdPaths()
+	if err != nil {
+		return err
+	}
+
+	binlogCmd := exec.CommandContext(ctx, mysqlbinlogPath, "--include-gtids="+includeGTIDs.String(), file)
+	binlogCmd.Env = ldPaths
+	binlogCmd.Dir = dir
+	var binlogStderr bytes.Buffer
+	binlogCmd.Stderr = &binlogStderr
+	mysqlCmd := exec.CommandContext(ctx, mysqlPath, "--defaults-extra-file="+cnf, "--batch")
+	mysqlCmd.Env = ldPaths
+	mysqlCmd.Dir = dir
+	var mysqlOutput bytes.Buffer
+	mysqlCmd.Stdout = &mysqlOutput
+	mysqlCmd.Stderr = &mysqlOutput
+	if mysqlCmd.Stdin, err = binlogCmd.StdoutPipe(); err != nil {
+		return err
+	}
+
+	if err := binlogCmd.Start(); err != nil {
+		return vterrors.Wrap(err, "can't start mysqlbinlog")
+	}
+	if err := mysqlCmd.Run(); err != nil {
+		binlogCmd.Process.Kill()
+		binlogCmd.Wait()
+		return fmt.Errorf("mysql: %v, output: %v", err, mysqlOutput.String())
+	}
+	if err := binlogCmd.Wait(); err != nil {
+		return fmt.Errorf("mysqlbinlog: %v, output: %v", err, binlogStderr.String())
+	}
+	return nil
+}
@@ -0,0 +1,396 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupencryption"
+	"vitess.io/vitess/go/vt/mysqlctl/filebackupstorage"
+)
+
+const (
+	testGTIDEvent         = 33
+	testPreviousGTIDEvent = 35
+)
+
+var testSID = mysql.SID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+
+// testBinlogFile builds a MySQL 5.6 binlog file with the given previous
+// GTIDs, and one transaction per sequence number, one second apart
+// starting at the given timestamp.
+func testBinlogFile(t *testing.T, previous string, timestamp uint32, sequences ...int64) []byte {
+	t.Helper()
+	f := mysql.NewMySQL56BinlogFormat()
+	s := mysql.NewFakeBinlogStream()
+	s.Timestamp = timestamp
+
+	buf := bytes.NewBuffer(binlogMagic)
+	buf.Write(mysql.NewFormatDescriptionEvent(f, s).(interface{ Bytes() []byte }).Bytes())
+	prev, err := mysql.ParsePosition(mysql.Mysql56FlavorID, previous)
+	require.NoError(t, err)
+	buf.Write(s.Packetize(f, testPreviousGTIDEvent, 0, prev.GTIDSet.(mysql.Mysql56GTIDSet).SIDBlock()))
+	for _, seq := range sequences {
+		data := make([]byte, 1+16+8)
+		copy(data[1:], testSID[:])
+		binary.LittleEndian.PutUint64(data[17:], uint64(seq))
+		buf.Write(s.Packetize(f, testGTIDEvent, 0, data))
+		s.Timestamp++
+	}
+	return buf.Bytes()
+}
+
+func testPosition(t *testing.T, s string) mysql.Position {
+	t.Helper()
+	pos, err := mysql.ParsePosition(mysql.Mysql56FlavorID, s)
+	require.NoError(t, err)
+	return pos
+}
+
+func TestScanBinlogFile(t *testing.T) {
+	data := testBinlogFile(t, "01020304-0506-0708-090a-0b0c0d0e0f10:1-5", 1000, 6, 7, 8)
+	info, err := scanBinlogFile(bytes.NewReader(data))
+	require.NoError(t, err)
+	assert.Equal(t, "01020304-0506-0708-090a-0b0c0d0e0f10:1-5", info.previousGTIDs.String())
+	require.Len(t, info.transactions, 3)
+	assert.Equal(t, "01020304-0506-0708-090a-0b0c0d0e0f10:6", info.transactions[0].gtid.String())
+	assert.Equal(t, int64(1002), info.transactions[2].timestamp.Unix())
+
+	bm := info.manifest()
+	assert.Equal(t, "01020304-0506-0708-090a-0b0c0d0e0f10:1-8", bm.Position.String())
+	assert.Equal(t, time.Unix(1000, 0).UTC().Format(time.RFC3339), bm.FirstTimestamp)
+	assert.Equal(t, time.Unix(1002, 0).UTC().Format(time.RFC3339), bm.LastTimestamp)
+
+	_, err = scanBinlogFile(bytes.NewReader([]byte("not a binlog")))
+	assert.EqualError(t, err, "not a binlog file: bad magic number 6e6f7420")
+
+	_, err = scanBinlogFile(bytes.NewReader(data[:len(data)-3]))
+	assert.Contains(t, err.Error(), "can't read binlog event")
+}
+
+func TestNextBinlogBackup(t *testing.T) {
+	newBackup := func(previous, position string, first int64) *binlogBackup {
+		return &binlogBackup{
+			manifest: &BinlogBackupManifest{
+				PreviousGTIDs: testPosition(t, previous),
+				Position:      testPosition(t, position),
+			},
+			firstTime: time.Unix(first, 0),
+		}
+	}
+	sid := "01020304-0506-0708-090a-0b0c0d0e0f10"
+	b1 := newBackup(sid+":1-5", sid+":1-10", 100)
+	b2 := newBackup(sid+":1-10", sid+":1-20", 200)
+	// b3 is the binlog of a replica that overlaps b2.
+	b3 := newBackup(sid+":1-8", sid+":1-15", 150)
+	backups := []*binlogBackup{b2, b1, b3}
+
+	assert.Nil(t, nextBinlogBackup(testPosition(t, sid+":1-4"), backups), "gap before the first binlog")
+	assert.Equal(t, b1, nextBinlogBackup(testPosition(t, sid+":1-5"), backups))
+	assert.Equal(t, b1, nextBinlogBackup(testPosition(t, sid+":1-7"), backups))
+	assert.Equal(t, b3, nextBinlogBackup(testPosition(t, sid+":1-10"), backups))
+	assert.Equal(t, b2, nextBinlogBackup(testPosition(t, sid+":1-15"), backups))
+	assert.Nil(t, nextBinlogBackup(testPosition(t, sid+":1-20"), backups))
+}
+
+func TestBinlogGTIDsToApply(t *testing.T) {
+	sid := "01020304-0506-0708-090a-0b0c0d0e0f10"
+	info, err := scanBinlogFile(bytes.NewReader(testBinlogFile(t, sid+":1-5", 1000, 6, 7, 8, 9)))
+	require.NoError(t, err)
+
+	testcases := []struct {
+		pos      string
+		toPos    string
+		toTime   int64
+		wantGTID string
+		wantDone bool
+	}{{
+		pos:      sid + ":1-5",
+		toPos:    sid + ":1-7",
+		wantGTID: sid + ":6-7",
+	}, {
+		pos:      sid + ":1-6",
+		toPos:    sid + ":1-20",
+		wantGTID: sid + ":7-9",
+	}, {
+		pos:      sid + ":1-5",
+		toTime:   1001,
+		wantGTID: sid + ":6-7",
+		wantDone: true,
+	}, {
+		pos:      sid + ":1-7",
+		toTime:   1001,
+		wantDone: true,
+	}, {
+		pos:      sid + ":1-5",
+		toTime:   2000,
+		wantGTID: sid + ":6-9",
+	}}
+	for _, tcase := range testcases {
+		var toPos mysql.Position
+		if tcase.toPos != "" {
+			toPos = testPosition(t, tcase.toPos)
+		}
+		var toTime time.Time
+		if tcase.toTime != 0 {
+			toTime = time.Unix(tcase.toTime, 0)
+		}
+		gtids, done := binlogGTIDsToApply(testPosition(t, tcase.pos), info.transactions, toPos, toTime)
+		if tcase.wantGTID == "" {
+			assert.Nil(t, gtids, "pos %v", tcase.pos)
+		} else {
+			assert.Equal(t, tcase.wantGTID, gtids.String(), "pos %v", tcase.pos)
+		}
+		assert.Equal(t, tcase.wantDone, done, "pos %v", tcase.pos)
+	}
+}
+
+// applyBinlogDaemon is a MysqlDaemon that records ApplyBinlogFile calls.
+type applyBinlogDaemon struct {
+	MysqlDaemon
+	applied []string
+}
+
+func (d *applyBinlogDaemon) ApplyBinlogFile(ctx context.Context, file string, includeGTIDs mysql.GTIDSet) error {
+	d.applied = append(d.applied, includeGTIDs.String())
+	return nil
+}
+
+func TestApplyBinlogBackups(t *testing.T) {
+	root, err := ioutil.TempDir("", "binlogbackuptest")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+	defer func(saved string) { *filebackupstorage.FileBackupStorageRoot = saved }(*filebackupstorage.FileBackupStorageRoot)
+	*filebackupstorage.FileBackupStorageRoot = path.Join(root, "backups")
+	bs := &filebackupstorage.FileBackupStorage{}
+
+	// Back up three binlog files.
+	sid := "01020304-0506-0708-090a-0b0c0d0e0f10"
+	binlogs := map[string][]byte{
+		"vt-bin.000001": testBinlogFile(t, sid+":1-5", 1000, 6, 7),
+		"vt-bin.000002": testBinlogFile(t, sid+":1-7", 2000, 8, 9, 10),
+		"vt-bin.000003": testBinlogFile(t, sid+":1-10", 3000, 11),
+	}
+	ctx := context.Background()
+	dir := GetBinlogBackupDir("ks", "0")
+	backupParams := BinlogBackupParams{
+		Logger:      logutil.NewMemoryLogger(),
+		TabletAlias: "cell-0000000100",
+	}
+	for name, data := range binlogs {
+		binlogPath := path.Join(root, name)
+		require.NoError(t, ioutil.WriteFile(binlogPath, data, 0600))
+		require.NoError(t, backupBinlogFile(ctx, backupParams, bs, dir, binlogBackupName(backupParams.TabletAlias, name), binlogPath))
+	}
+	bhs, err := bs.ListBackups(ctx, dir)
+	require.NoError(t, err)
+	require.Len(t, bhs, 3)
+	bm := &BinlogBackupManifest{}
+	require.NoError(t, getBackupManifestInto(ctx, bhs[1], bm))
+	assert.Equal(t, "vt-bin.000002", bm.BinlogFile)
+	assert.Equal(t, sid+":1-10", bm.Position.String())
+
+	testcases := []struct {
+		name        string
+		toPos       string
+		toTime      int64
+		wantApplied []string
+		wantPos     string
+		wantErr     string
+	}{{
+		name:        "to position",
+		toPos:       sid + ":1-9",
+		wantApplied: []string{sid + ":7", sid + ":8-9"},
+		wantPos:     sid + ":1-9",
+	}, {
+		name:        "to time",
+		toTime:      2001,
+		wantApplied: []string{sid + ":7", sid + ":8-9"},
+		wantPos:     sid + ":1-9",
+	}, {
+		name:        "position too far",
+		toPos:       sid + ":1-12",
+		wantApplied: []string{sid + ":7", sid + ":8-10", sid + ":11"},
+		wantErr:     "binlog backups in binlogs/ks/0 do not go from " + sid + ":1-11 to " + sid + ":1-12",
+	}, {
+		name:        "time too far",
+		toTime:      4000,
+		wantApplied: []string{sid + ":7", sid + ":8-10", sid + ":11"},
+		wantErr:     "binlog backups in binlogs/ks/0 end at " + sid + ":1-11, before " + time.Unix(4000, 0).UTC().Format(time.RFC3339),
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			mysqld := &applyBinlogDaemon{}
+			params := RestoreParams{
+				Cnf:      &Mycnf{TmpDir: root},
+				Mysqld:   mysqld,
+				Logger:   logutil.NewMemoryLogger(),
+				Keyspace: "ks",
+				Shard:    "0",
+			}
+			if tcase.toPos != "" {
+				params.RestoreToPos = testPosition(t, tcase.toPos)
+			}
+			if tcase.toTime != 0 {
+				params.RestoreToTime = time.Unix(tcase.toTime, 0)
+			}
+			pos, err := applyBinlogBackups(ctx, params, bs, testPosition(t, sid+":1-6"))
+			assert.Equal(t, tcase.wantApplied, mysqld.applied)
+			if tcase.wantErr != "" {
+				assert.EqualError(t, err, tcase.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tcase.wantPos, pos.String())
+		})
+	}
+}
+
+func TestEncryptedBinlogBackup(t *testing.T) {
+	root, err := ioutil.TempDir("", "binlogbackuptest")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+	defer func(saved string) { *filebackupstorage.FileBackupStorageRoot = saved }(*filebackupstorage.FileBackupStorageRoot)
+	*filebackupstorage.FileBackupStorageRoot = path.Join(root, "backups")
+	bs := &filebackupstorage.FileBackupStorage{}
+	defer func(saved string) { *backupencryption.KeyFile = saved }(*backupencryption.KeyFile)
+	*backupencryption.KeyFile = path.Join(root, "keys")
+	require.NoError(t, ioutil.WriteFile(*backupencryption.KeyFile, []byte("key1 "+base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 32))), 0600))
+	defer func(saved string) { *backupencryption.KeyProviderImplementation = saved }(*backupencryption.KeyProviderImplementation)
+	*backupencryption.KeyProviderImplementation = "keyfile"
+	// Not compressed, to check that the stored data is encrypted.
+	defer func(saved bool) { *backupStorageCompress = saved }(*backupStorageCompress)
+	*backupStorageCompress = false
+
+	sid := "01020304-0506-0708-090a-0b0c0d0e0f10"
+	data := testBinlogFile(t, sid+":1-5", 1000, 6, 7)
+	binlogPath := path.Join(root, "vt-bin.000001")
+	require.NoError(t, ioutil.WriteFile(binlogPath, data, 0600))
+	ctx := context.Background()
+	dir := GetBinlogBackupDir("ks", "0")
+	name := binlogBackupName("cell-0000000100", "vt-bin.000001")
+	require.NoError(t, backupBinlogFile(ctx, BinlogBackupParams{Logger: logutil.NewMemoryLogger()}, bs, dir, name, binlogPath))
+
+	stored, err := ioutil.ReadFile(path.Join(*filebackupstorage.FileBackupStorageRoot, dir, name, binlogBackupFileName))
+	require.NoError(t, err)
+	assert.False(t, bytes.Contains(stored, binlogMagic), "binlog file stored in plaintext")
+
+	params := RestoreParams{
+		Cnf:      &Mycnf{TmpDir: root},
+		Logger:   logutil.NewMemoryLogger(),
+		Keyspace: "ks",
+		Shard:    "0",
+	}
+	backups, err := findBinlogBackups(ctx, params, bs)
+	require.NoError(t, err)
+	require.Len(t, backups, 1)
+	require.NotNil(t, backups[0].manifest.Encryption)
+	assert.Equal(t, "keyfile", backups[0].manifest.Encryption.KeyProvider)
+	assert.Equal(t, "key1", backups[0].manifest.Encryption.KeyID)
+
+	// Restores use the key of the manifest, even with encryption disabled.
+	*backupencryption.KeyProviderImplementation = ""
+	tmpFile, info, err := downloadBinlogBackup(ctx, params, backups[0])
+	require.NoError(t, err)
+	defer os.Remove(tmpFile)
+	assert.Equal(t, sid+":1-7", info.position().String())
+	restored, err := ioutil.ReadFile(tmpFile)
+	require.NoError(t, err)
+	assert.Equal(t, data, restored)
+}
+
+func TestFindBackupToRestorePointInTime(t *testing.T) {
+	root, err := ioutil.TempDir("", "binlogbackuptest")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+	defer func(saved string) { *filebackupstorage.FileBackupStorageRoot = saved }(*filebackupstorage.FileBackupStorageRoot)
+	*filebackupstorage.FileBackupStorageRoot = root
+	bs := &filebackupstorage.FileBackupStorage{}
+
+	ctx := context.Background()
+	sid := "01020304-0506-0708-090a-0b0c0d0e0f10"
+	for i, position := range []string{sid + ":1-10", sid + ":1-20"} {
+		backupTime := time.Unix(int64(1000*(i+1)), 0).UTC()
+		bh, err := bs.StartBackup(ctx, GetBackupDir("ks", "0"), backupTime.Format(BackupTimestampFormat)+".cell-0000000100")
+		require.NoError(t, err)
+		wc, err := bh.AddFile(ctx, backupManifestFileName, 0)
+		require.NoError(t, err)
+		// Every backup takes 600s.
+		finishedTime := backupTime.Add(600 * time.Second)
+		_, err = fmt.Fprintf(wc, `{"Position": "MySQL56/%v", "BackupTime": "%v", "FinishedTime": "%v"}`, position, backupTime.Format(time.RFC3339), finishedTime.Format(time.RFC3339))
+		require.NoError(t, err)
+		require.NoError(t, wc.Close())
+		require.NoError(t, bh.EndBackup(ctx))
+	}
+	bhs, err := bs.ListBackups(ctx, GetBackupDir("ks", "0"))
+	require.NoError(t, err)
+
+	params := RestoreParams{
+		Logger:   logutil.NewMemoryLogger(),
+		Keyspace: "ks",
+		Shard:    "0",
+	}
+	bh, err := FindBackupToRestore(ctx, params, bhs)
+	require.NoError(t, err)
+	assert.Equal(t, bhs[1], bh)
+
+	params.RestoreToPos = testPosition(t, sid+":1-15")
+	bh, err = FindBackupToRestore(ctx, params, bhs)
+	require.NoError(t, err)
+	assert.Equal(t, bhs[0], bh)
+
+	params.RestoreToPos = testPosition(t, sid+":1-5")
+	_, err = FindBackupToRestore(ctx, params, bhs)
+	assert.Equal(t, ErrNoCompleteBackup, err)
+
+	// A backup is selected by the time it finished, not the time it started.
+	params.RestoreToPos = mysql.Position{}
+	params.RestoreToTime = time.Unix(1500, 0)
+	_, err = FindBackupToRestore(ctx, params, bhs)
+	assert.Equal(t, ErrNoCompleteBackup, err)
+
+	params.RestoreToTime = time.Unix(2500, 0)
+	bh, err = FindBackupToRestore(ctx, params, bhs)
+	require.NoError(t, err)
+	assert.Equal(t, bhs[0], bh)
+
+	params.RestoreToTime = time.Unix(2600, 0)
+	bh, err = FindBackupToRestore(ctx, params, bhs)
+	require.NoError(t, err)
+	assert.Equal(t, bhs[1], bh)
+
+	// The start time still selects backups by the time they started.
+	params.RestoreToTime = time.Time{}
+	params.StartTime = time.Unix(2500, 0)
+	bh, err = FindBackupToRestore(ctx, params, bhs)
+	require.NoError(t, err)
+	assert.Equal(t, bhs[1], bh)
+}
@@ -20,6 +20,7 @@ import (
 	"bufio"
 	"context"
 	"encoding/json"
+	"flag"
 	"fmt"
 	"io"
 	"os"
@@ -27,12 +28,12 @@ import (
 	"sync"
 	"time"
 
-	"github.com/klauspost/pgzip"
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/vt/concurrency"
 	"vitess.io/vitess/go/vt/hook"
 	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/mysqlctl/backupencryption"
 	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
 	"vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/topo"
@@ -47,6 +48,17 @@ const (
 	dataDictionaryFile      = "mysql.ibd"
 )
 
+var (
+	// builtinBackupIncremental makes the builtin engine only store the
+	// files that changed since the latest complete backup, and reference
+	// the data stored by that backup for the others.
+	builtinBackupIncremental = flag.Bool("builtinbackup_incremental", false, "if set, the builtin backup engine takes incremental backups: only the files that changed since the latest complete builtin backup of the shard are stored, the others are read from that backup on restore")
+
+	// builtinBackupIncrementalMaxChain bounds the number of backups a
+	// restore has to read from.
+	builtinBackupIncrementalMaxChain = flag.Int("builtinbackup_incremental_max_chain", 6, "with builtinbackup_incremental, the maximum number of incremental backups taken on top of a full backup before a full backup is taken again")
+)
+
 // BuiltinBackupEngine encapsulates the logic of the builtin engine
 // it implements the BackupEngine interface and contains all the logic
 // required to implement a backup/restore by copying files from and to
@@ -72,6 +84,25 @@ type builtinBackupManifest struct {
 	// false for backups that were created before the field existed, and those
 	// backups all had compression enabled.
 	SkipCompress bool
+
+	// CompressionEngine is the engine the files were compressed with.
+	// It is empty for backups taken before the field existed, which were
+	// compressed with pgzip.
+	CompressionEngine string
+
+	// ExternalDecompressor is the command decompressing the files, if
+	// they were compressed with the external engine. It's informational
+	// only, the restore runs -backup_storage_external_decompressor.
+	ExternalDecompressor string
+}
+
+// compressionEngine returns the engine the files of the backup were
+// compressed with, or nil if they weren't compressed.
+func (bm *builtinBackupManifest) compressionEngine() (CompressionEngine, error) {
+	if bm.SkipCompress {
+		return nil, nil
+	}
+	return getRestoreCompressionEngine(bm.CompressionEngine, bm.ExternalDecompressor)
 }
 
 // FileEntry is one file to backup
@@ -88,6 +119,25 @@ type FileEntry struct {
 	// Hash is the hash of the final data (transformed and
 	// compressed if specified) stored in the BackupStorage.
 	Hash string
+
+	// SourceHash is the hash of the file contents, before any transform
+	// or compression. Incremental backups compare it with the parent
+	// backup to find the unchanged files. It is empty for backups taken
+	// before the field existed.
+	SourceHash string
+
+	// StoredIn is the name of the backup, in the same directory, that
+	// stores the data of a file that was unchanged since that backup.
+	// It is empty if the data is stored in this backup.
+	StoredIn string
+
+	// StoredName is the name of the data file in the StoredIn backup.
+	StoredName string
+}
+
+// location returns where the file goes in the mysql directories.
+func (fe *FileEntry) location() string {
+	return path.Join(fe.Base, fe.Name)
 }
 
 func (fe *FileEntry) open(cnf *Mycnf, readOnly bool) (*os.File, error) {
@@ -128,7 +178,13 @@ func (fe *FileEntry) open(cnf *Mycnf, readOnly bool) (*os.File, error) {
 // and an overall error.
 func (be *BuiltinBackupEngine) ExecuteBackup(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle) (bool, error) {
 
-	params.Logger.Infof("Hook: %v, Compress: %v", *backupStorageHook, *backupStorageCompress)
+	params.Logger.Infof("Hook: %v, Compress: %v, Compression engine: %v", *backupStorageHook, *backupStorageCompress, *backupCompressionEngine)
+	if *backupStorageCompress {
+		// Fail before stopping mysqld if the engine doesn't exist.
+		if _, err := getBackupCompressionEngine(); err != nil {
+			return false, err
+		}
+	}
 
 	// Save initial state so we can restore.
 	replicaStartRequired := false
@@ -181,6 +237,12 @@ func (be *BuiltinBackupEngine) ExecuteBackup(ctx context.Context, params BackupP
 	}
 	params.Logger.Infof("using replication position: %v", replicationPosition)
 
+	// List the tables to record in the MANIFEST, while mysqld is up.
+	tables, err := getBackupTables(ctx, params.Mysqld)
+	if err != nil {
+		params.Logger.Warningf("can't list the tables to record in the MANIFEST: %v", err)
+	}
+
 	// shutdown mysqld
 	err = params.Mysqld.Shutdown(ctx, params.Cnf, true)
 	if err != nil {
@@ -188,7 +250,7 @@ func (be *BuiltinBackupEngine) ExecuteBackup(ctx context.Context, params BackupP
 	}
 
 	// Backup everything, capture the error.
-	backupErr := be.backupFiles(ctx, params, bh, replicationPosition)
+	backupErr := be.backupFiles(ctx, params, bh, replicationPosition, tables)
 	usable := backupErr == nil
 
 	// Try to restart mysqld, use background context in case we timed out the original context
@@ -264,7 +326,7 @@ func (be *BuiltinBackupEngine) ExecuteBackup(ctx context.Context, params BackupP
 }
 
 // backupFiles finds the list of files to backup, and creates the backup.
-func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle, replicationPosition mysql.Position) (finalErr error) {
+func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle, replicationPosition mysql.Position, tables []BackupTable) (finalErr error) {
 
 	// Get the files to backup.
 	// We don't care about totalSize because we add each file separately.
@@ -274,6 +336,25 @@ func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupPar
 	}
 	params.Logger.Infof("found %v files to backup", len(fes))
 
+	// Get the key to encrypt the files with, if any.
+	dataKey, encryption, err := backupencryption.NewDataKey(ctx)
+	if err != nil {
+		return vterrors.Wrap(err, "can't create backup encryption key")
+	}
+
+	// Find the backup to take an incremental backup on top of, if any.
+	parent, err := be.findParentBackup(ctx, params, bh)
+	if err != nil {
+		return vterrors.Wrap(err, "can't find parent backup")
+	}
+	var parentFiles map[string]FileEntry
+	parentName := ""
+	if parent != nil {
+		parentName = parent.bh.Name()
+		parentFiles = parent.storedFiles()
+		params.Logger.Infof("taking an incremental backup on top of %v", parentName)
+	}
+
 	// Backup with the provided concurrency.
 	sema := sync2.NewSemaphore(params.Concurrency, 0)
 	wg := sync.WaitGroup{}
@@ -290,9 +371,21 @@ func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupPar
 				return
 			}
 
+			// Skip the file if it didn't change since the parent backup.
+			if pfe, ok := parentFiles[fes[i].location()]; ok {
+				unchanged, err := be.reuseFile(params, &fes[i], pfe)
+				if err != nil {
+					bh.RecordError(err)
+					return
+				}
+				if unchanged {
+					return
+				}
+			}
+
 			// Backup the individual file.
 			name := fmt.Sprintf("%v", i)
-			bh.RecordError(be.backupFile(ctx, params, bh, &fes[i], name))
+			bh.RecordError(be.backupFile(ctx, params, bh, &fes[i], dataKey, name))
 		}(i)
 	}
 
@@ -329,12 +422,18 @@ func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupPar
 			Position:     replicationPosition,
 			BackupTime:   params.BackupTime.UTC().Format(time.RFC3339),
 			FinishedTime: time.Now().UTC().Format(time.RFC3339),
+			ParentBackup: parentName,
+			Encryption:   encryption,
+			Tables:       tables,
 		},
 
 		// Builtin-specific fields
 		FileEntries:   fes,
 		TransformHook: *backupStorageHook,
 		SkipCompress:  !*backupStorageCompress,
+
+		CompressionEngine:    *backupCompressionEngine,
+		ExternalDecompressor: *backupExternalDecompressor,
 	}
 	data, err := json.MarshalIndent(bm, "", "  ")
 	if err != nil {
@@ -347,8 +446,8 @@ func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupPar
 	return nil
 }
 
-// backupFile backs up an individual file.
-func (be *BuiltinBackupEngine) backupFile(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle, fe *FileEntry, name string) (finalErr error) {
+// backupFile backs up an individual file, encrypted with dataKey if set.
+func (be *BuiltinBackupEngine) backupFile(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle, fe *FileEntry, dataKey []byte, name string) (finalErr error) {
 	// Open the source file for reading.
 	source, err := fe.open(params.Cnf, true)
 	if err != nil {
@@ -383,6 +482,16 @@ func (be *BuiltinBackupEngine) backupFile(ctx context.Context, params BackupPara
 	hasher := newHasher()
 	writer := io.MultiWriter(dst, hasher)
 
+	// Create the encrypter, if necessary.
+	var encrypter io.WriteCloser
+	if dataKey != nil {
+		encrypter, err = backupencryption.NewWriter(writer, dataKey)
+		if err != nil {
+			return vterrors.Wrap(err, "cannot create encrypter")
+		}
+		writer = encrypter
+	}
+
 	// Create the external write pipe, if any.
 	var pipe io.WriteCloser
 	var wait hook.WaitFunc
@@ -396,28 +505,33 @@ func (be *BuiltinBackupEngine) backupFile(ctx context.Context, params BackupPara
 		writer = pipe
 	}
 
-	// Create the gzip compression pipe, if necessary.
-	var gzip *pgzip.Writer
+	// Create the compressor, if necessary.
+	var compressor io.WriteCloser
 	if *backupStorageCompress {
-		gzip, err = pgzip.NewWriterLevel(writer, pgzip.BestSpeed)
+		engine, err := getBackupCompressionEngine()
 		if err != nil {
-			return vterrors.Wrap(err, "cannot create gziper")
+			return err
 		}
-		gzip.SetConcurrency(*backupCompressBlockSize, *backupCompressBlocks)
-		writer = gzip
+		compressor, err = engine.NewWriter(ctx, writer)
+		if err != nil {
+			return vterrors.Wrap(err, "cannot create compressor")
+		}
+		writer = compressor
 	}
 
-	// Copy from the source file to writer (optional gzip,
-	// optional pipe, tee, output file and hasher).
-	_, err = io.Copy(writer, source)
+	// Copy from the source file to writer (optional compressor,
+	// optional pipe, tee, output file and hasher), hashing
+	// the source contents on the way.
+	sourceHasher := newSourceHasher()
+	_, err = io.Copy(writer, io.TeeReader(source, sourceHasher))
 	if err != nil {
 		return vterrors.Wrap(err, "cannot copy data")
 	}
 
-	// Close gzip to flush it, after that all data is sent to writer.
-	if gzip != nil {
-		if err = gzip.Close(); err != nil {
-			return vterrors.Wrap(err, "cannot close gzip")
+	// Close the compressor to flush it, after that all data is sent to writer.
+	if compressor != nil {
+		if err = compressor.Close(); err != nil {
+			return vterrors.Wrap(err, "cannot close compressor")
 		}
 	}
 
@@ -435,16 +549,53 @@ func (be *BuiltinBackupEngine) backupFile(ctx context.Context, params BackupPara
 		}
 	}
 
+	// Close the encrypter to write the last chunk.
+	if encrypter != nil {
+		if err := encrypter.Close(); err != nil {
+			return vterrors.Wrap(err, "cannot close encrypter")
+		}
+	}
+
 	// Flush the buffer to finish writing on destination.
 	if err = dst.Flush(); err != nil {
 		return vterrors.Wrapf(err, "cannot flush destination: %v", name)
 	}
 
-	// Save the hash.
+	// Save the hashes.
 	fe.Hash = hasher.HashString()
+	fe.SourceHash = sourceHasher.HashString()
 	return nil
 }
 
+// reuseFile checks if a file has the same contents as the file pfe of
+// the parent backup. If so, it points fe to the data stored for pfe,
+// and returns true.
+func (be *BuiltinBackupEngine) reuseFile(params BackupParams, fe *FileEntry, pfe FileEntry) (bool, error) {
+	if pfe.SourceHash == "" {
+		// The parent backup was taken before source hashes existed.
+		return false, nil
+	}
+	source, err := fe.open(params.Cnf, true)
+	if err != nil {
+		return false, err
+	}
+	defer source.Close()
+	sourceHasher := newSourceHasher()
+	if _, err := io.Copy(sourceHasher, source); err != nil {
+		return false, vterrors.Wrapf(err, "cannot hash file %v", fe.Name)
+	}
+	if sourceHasher.HashString() != pfe.SourceHash {
+		return false, nil
+	}
+
+	params.Logger.Infof("Skipping unchanged file: %v, stored in %v", fe.Name, pfe.StoredIn)
+	fe.Hash = pfe.Hash
+	fe.SourceHash = pfe.SourceHash
+	fe.StoredIn = pfe.StoredIn
+	fe.StoredName = pfe.StoredName
+	return true, nil
+}
+
 // ExecuteRestore restores from a backup. If the restore is successful
 // we return the position from which replication should start
 // otherwise an error is returned
@@ -456,6 +607,13 @@ func (be *BuiltinBackupEngine) ExecuteRestore(ctx context.Context, params Restor
 		return nil, err
 	}
 
+	// An incremental backup also reads from the backups it was
+	// taken on top of, so they all need to be there.
+	ancestors, err := be.findAncestors(ctx, bh, bm)
+	if err != nil {
+		return nil, err
+	}
+
 	// mark restore as in progress
 	if err := createStateFile(params.Cnf); err != nil {
 		return nil, err
@@ -467,7 +625,7 @@ func (be *BuiltinBackupEngine) ExecuteRestore(ctx context.Context, params Restor
 
 	params.Logger.Infof("Restore: copying %v files", len(bm.FileEntries))
 
-	if err := be.restoreFiles(context.Background(), params, bh, bm); err != nil {
+	if err := be.restoreFiles(context.Background(), params, bh, bm, ancestors); err != nil {
 		// don't delete the file here because that is how we detect an interrupted restore
 		return nil, vterrors.Wrap(err, "failed to restore files")
 	}
@@ -477,8 +635,22 @@ func (be *BuiltinBackupEngine) ExecuteRestore(ctx context.Context, params Restor
 }
 
 // restoreFiles will copy all the files from the BackupStorage to the
-// right place.
-func (be *BuiltinBackupEngine) restoreFiles(ctx context.Context, params RestoreParams, bh backupstorage.BackupHandle, bm builtinBackupManifest) error {
+// right place. The files of an incremental backup that are stored in
+// one of its ancestors are read from there.
+func (be *BuiltinBackupEngine) restoreFiles(ctx context.Context, params RestoreParams, bh backupstorage.BackupHandle, bm builtinBackupManifest, ancestors map[string]*builtinBackup) error {
+	// Get the keys the files are encrypted with, if any.
+	dataKeys := make(map[string][]byte)
+	dataKey, err := bm.Encryption.DataKey(ctx)
+	if err != nil {
+		return vterrors.Wrap(err, "can't get backup encryption key")
+	}
+	dataKeys[""] = dataKey
+	for name, ancestor := range ancestors {
+		if dataKeys[name], err = ancestor.manifest.Encryption.DataKey(ctx); err != nil {
+			return vterrors.Wrapf(err, "can't get encryption key of backup %v", name)
+		}
+	}
+
 	fes := bm.FileEntries
 	sema := sync2.NewSemaphore(params.Concurrency, 0)
 	rec := concurrency.AllErrorRecorder{}
@@ -497,9 +669,18 @@ func (be *BuiltinBackupEngine) restoreFiles(ctx context.Context, params RestoreP
 			}
 
 			// And restore the file.
-			name := fmt.Sprintf("%v", i)
-			params.Logger.Infof("Copying file %v: %v", name, fes[i].Name)
-			err := be.restoreFile(ctx, params, bh, &fes[i], bm.TransformHook, !bm.SkipCompress, name)
+			src, srcManifest, name := bh, &bm, fmt.Sprintf("%v", i)
+			if fes[i].StoredIn != "" {
+				ancestor := ancestors[fes[i].StoredIn]
+				src, srcManifest, name = ancestor.bh, &ancestor.manifest, fes[i].StoredName
+				params.Logger.Infof("Copying file %v from %v: %v", name, fes[i].StoredIn, fes[i].Name)
+			} else {
+				params.Logger.Infof("Copying file %v: %v", name, fes[i].Name)
+			}
+			compression, err := srcManifest.compressionEngine()
+			if err == nil {
+				err = be.restoreFile(ctx, params, src, &fes[i], srcManifest.TransformHook, compression, dataKeys[fes[i].StoredIn], name)
+			}
 			if err != nil {
 				rec.RecordError(vterrors.Wrapf(err, "can't restore file %v to %v", name, fes[i].Name))
 			}
@@ -509,8 +690,9 @@ func (be *BuiltinBackupEngine) restoreFiles(ctx context.Context, params RestoreP
 	return rec.Error()
 }
 
-// restoreFile restores an individual file.
-func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestoreParams, bh backupstorage.BackupHandle, fe *FileEntry, transformHook string, compress bool, name string) (finalErr error) {
+// restoreFile restores an individual file, decrypting it with dataKey if
+// set, and decompressing it with compression if set.
+func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestoreParams, bh backupstorage.BackupHandle, fe *FileEntry, transformHook string, compression CompressionEngine, dataKey []byte, name string) (finalErr error) {
 	// Open the source file for reading.
 	source, err := bh.ReadFile(ctx, name)
 	if err != nil {
@@ -541,9 +723,17 @@ func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestorePa
 	hasher := newHasher()
 
 	// Create a Tee: we split the input into the hasher
-	// and into the gunziper.
+	// and into the decompressor.
 	reader := io.TeeReader(source, hasher)
 
+	// Create the decrypter, if needed.
+	if dataKey != nil {
+		reader, err = backupencryption.NewReader(reader, dataKey)
+		if err != nil {
+			return vterrors.Wrap(err, "can't create decrypter")
+		}
+	}
+
 	// Create the external read pipe, if any.
 	var wait hook.WaitFunc
 	if transformHook != "" {
@@ -555,23 +745,23 @@ func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestorePa
 		}
 	}
 
-	// Create the uncompresser if needed.
-	if compress {
-		gz, err := pgzip.NewReader(reader)
+	// Create the decompressor if needed.
+	if compression != nil {
+		decompressor, err := compression.NewReader(ctx, reader)
 		if err != nil {
-			return vterrors.Wrap(err, "can't open gzip decompressor")
+			return vterrors.Wrap(err, "can't open decompressor")
 		}
 		defer func() {
-			if cerr := gz.Close(); cerr != nil {
+			if cerr := decompressor.Close(); cerr != nil {
 				if finalErr != nil {
 					// We already have an error, just log this one.
-					log.Errorf("failed to close gzip decompressor %v: %v", name, cerr)
+					log.Errorf("failed to close decompressor %v: %v", name, cerr)
 				} else {
-					finalErr = vterrors.Wrap(err, "failed to close gzip decompressor")
+					finalErr = vterrors.Wrap(cerr, "failed to close decompressor")
 				}
 			}
 		}()
-		reader = gz
+		reader = decompressor
 	}
 
 	// Copy the data. Will also write to the hasher.
@@ -604,6 +794,136 @@ func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestorePa
 	return nil
 }
 
+// builtinBackup is a complete backup taken with the builtin engine.
+type builtinBackup struct {
+	bh       backupstorage.BackupHandle
+	manifest builtinBackupManifest
+}
+
+// storedFiles returns the files of the backup by location, pointing
+// to the backups and names their data is stored under.
+func (b *builtinBackup) storedFiles() map[string]FileEntry {
+	files := make(map[string]FileEntry, len(b.manifest.FileEntries))
+	for i, fe := range b.manifest.FileEntries {
+		if fe.StoredIn == "" {
+			fe.StoredIn = b.bh.Name()
+			fe.StoredName = fmt.Sprintf("%v", i)
+		}
+		files[fe.location()] = fe
+	}
+	return files
+}
+
+// readBuiltinBackups returns the complete builtin backups in bhs, by name.
+func readBuiltinBackups(ctx context.Context, bhs []backupstorage.BackupHandle) map[string]*builtinBackup {
+	backups := make(map[string]*builtinBackup)
+	for _, bh := range bhs {
+		b := &builtinBackup{bh: bh}
+		if err := getBackupManifestInto(ctx, bh, &b.manifest); err != nil {
+			continue
+		}
+		if b.manifest.BackupMethod != "" && b.manifest.BackupMethod != builtinBackupEngineName {
+			continue
+		}
+		backups[bh.Name()] = b
+	}
+	return backups
+}
+
+// backupChain returns the backups that an incremental backup was taken
+// on top of, from its parent to the full backup.
+func backupChain(b *builtinBackup, backups map[string]*builtinBackup) ([]*builtinBackup, error) {
+	var chain []*builtinBackup
+	for child := b; child.manifest.ParentBackup != ""; {
+		parent, ok := backups[child.manifest.ParentBackup]
+		if !ok {
+			return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "backup %v needs its parent backup %v, which is missing or incomplete", child.bh.Name(), child.manifest.ParentBackup)
+		}
+		if len(chain) == len(backups) {
+			return nil, vterrors.Errorf(vtrpc.Code_INTERNAL, "the parent backups of %v form a cycle", b.bh.Name())
+		}
+		chain = append(chain, parent)
+		child = parent
+	}
+	return chain, nil
+}
+
+// findParentBackup returns the backup to take an incremental backup on
+// top of, or nil to take a full backup: the latest complete builtin
+// backup, unless it is at the end of a chain of
+// -builtinbackup_incremental_max_chain incremental backups already.
+func (be *BuiltinBackupEngine) findParentBackup(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle) (*builtinBackup, error) {
+	if !*builtinBackupIncremental {
+		return nil, nil
+	}
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return nil, err
+	}
+	defer bs.Close()
+	bhs, err := bs.ListBackups(ctx, bh.Directory())
+	if err != nil {
+		return nil, vterrors.Wrap(err, "ListBackups failed")
+	}
+	backups := readBuiltinBackups(ctx, bhs)
+
+	// ListBackups returns them sorted by oldest first.
+	for i := len(bhs) - 1; i >= 0; i-- {
+		parent, ok := backups[bhs[i].Name()]
+		if !ok || bhs[i].Name() == bh.Name() {
+			continue
+		}
+		chain, err := backupChain(parent, backups)
+		if err != nil {
+			params.Logger.Warningf("can't take an incremental backup on top of %v: %v", parent.bh.Name(), err)
+			continue
+		}
+		if backupencryption.Enabled() && parent.manifest.Encryption == nil {
+			// Don't keep unencrypted data around in new backups.
+			params.Logger.Infof("latest backup %v is not encrypted, taking a full backup", parent.bh.Name())
+			return nil, nil
+		}
+		if len(chain) >= *builtinBackupIncrementalMaxChain {
+			params.Logger.Infof("latest backup %v is on top of %v other backups, taking a full backup", parent.bh.Name(), len(chain))
+			return nil, nil
+		}
+		return parent, nil
+	}
+	params.Logger.Infof("no complete builtin backup to take an incremental backup on top of, taking a full backup")
+	return nil, nil
+}
+
+// findAncestors returns the backups an incremental backup reads data
+// from, by name. It returns nil for a full backup.
+func (be *BuiltinBackupEngine) findAncestors(ctx context.Context, bh backupstorage.BackupHandle, bm builtinBackupManifest) (map[string]*builtinBackup, error) {
+	if bm.ParentBackup == "" {
+		return nil, nil
+	}
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return nil, err
+	}
+	defer bs.Close()
+	bhs, err := bs.ListBackups(ctx, bh.Directory())
+	if err != nil {
+		return nil, vterrors.Wrap(err, "ListBackups failed")
+	}
+	chain, err := backupChain(&builtinBackup{bh: bh, manifest: bm}, readBuiltinBackups(ctx, bhs))
+	if err != nil {
+		return nil, err
+	}
+	ancestors := make(map[string]*builtinBackup, len(chain))
+	for _, b := range chain {
+		ancestors[b.bh.Name()] = b
+	}
+	for _, fe := range bm.FileEntries {
+		if fe.StoredIn != "" && ancestors[fe.StoredIn] == nil {
+			return nil, vterrors.Errorf(vtrpc.Code_INTERNAL, "file %v is stored in backup %v, which is not a parent backup of %v", fe.Name, fe.StoredIn, bh.Name())
+		}
+	}
+	return ancestors, nil
+}
+
 // ShouldDrainForBackup satisfies the BackupEngine interface
 // backup requires query service to be stopped, hence true
 func (be *BuiltinBackupEngine) ShouldDrainForBackup() bool {
@@ -0,0 +1,350 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupencryption"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/mysqlctl/filebackupstorage"
+)
+
+// testMycnf returns a Mycnf with its directories under root.
+func testMycnf(t *testing.T, root string) *Mycnf {
+	cnf := &Mycnf{
+		DataDir:               path.Join(root, "data"),
+		InnodbDataHomeDir:     path.Join(root, "innodb_data"),
+		InnodbLogGroupHomeDir: path.Join(root, "innodb_log"),
+	}
+	for _, dir := range []string{path.Join(cnf.DataDir, "vt_db"), cnf.InnodbDataHomeDir, cnf.InnodbLogGroupHomeDir} {
+		require.NoError(t, os.MkdirAll(dir, os.ModePerm))
+	}
+	return cnf
+}
+
+func TestIncrementalBackup(t *testing.T) {
+	root, err := ioutil.TempDir("", "incrementalbackuptest")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+	defer func(saved string) { *filebackupstorage.FileBackupStorageRoot = saved }(*filebackupstorage.FileBackupStorageRoot)
+	*filebackupstorage.FileBackupStorageRoot = path.Join(root, "backups")
+	defer func(saved string) { *backupstorage.BackupStorageImplementation = saved }(*backupstorage.BackupStorageImplementation)
+	*backupstorage.BackupStorageImplementation = "file"
+	defer func(saved bool) { *builtinBackupIncremental = saved }(*builtinBackupIncremental)
+	*builtinBackupIncremental = true
+	defer func(saved int) { *builtinBackupIncrementalMaxChain = saved }(*builtinBackupIncrementalMaxChain)
+	*builtinBackupIncrementalMaxChain = 2
+
+	ctx := context.Background()
+	bs, err := backupstorage.GetBackupStorage()
+	require.NoError(t, err)
+	defer bs.Close()
+	dir := GetBackupDir("ks", "0")
+
+	cnf := testMycnf(t, path.Join(root, "source"))
+	files := map[string]string{
+		path.Join(cnf.InnodbDataHomeDir, "ibdata1"):    "ibdata1 v1",
+		path.Join(cnf.InnodbLogGroupHomeDir, "ib_log"): "ib_log v1",
+		path.Join(cnf.DataDir, "vt_db", "t1.ibd"):      "t1 v1",
+		path.Join(cnf.DataDir, "vt_db", "t2.ibd"):      "t2 v1",
+	}
+	writeFiles := func() {
+		for name, contents := range files {
+			require.NoError(t, ioutil.WriteFile(name, []byte(contents), 0600))
+		}
+	}
+	backup := func(i int) string {
+		name := fmt.Sprintf("2020-01-01.00000%d.cell-0000000100", i)
+		bh, err := bs.StartBackup(ctx, dir, name)
+		require.NoError(t, err)
+		be := &BuiltinBackupEngine{}
+		require.NoError(t, be.backupFiles(ctx, BackupParams{
+			Cnf:         cnf,
+			Logger:      logutil.NewMemoryLogger(),
+			Concurrency: 2,
+			BackupTime:  time.Now(),
+		}, bh, mysql.Position{}, nil))
+		require.NoError(t, bh.EndBackup(ctx))
+		return name
+	}
+	readBackup := func(name string) *builtinBackup {
+		bhs, err := bs.ListBackups(ctx, dir)
+		require.NoError(t, err)
+		b := readBuiltinBackups(ctx, bhs)[name]
+		require.NotNil(t, b)
+		return b
+	}
+	storedIn := func(name string) map[string]string {
+		result := make(map[string]string)
+		for _, fe := range readBackup(name).manifest.FileEntries {
+			result[fe.Name] = fe.StoredIn
+		}
+		return result
+	}
+
+	// The first backup is a full backup.
+	writeFiles()
+	b1 := backup(1)
+	assert.Equal(t, "", readBackup(b1).manifest.ParentBackup)
+
+	// The second one only stores the changed file.
+	files[path.Join(cnf.DataDir, "vt_db", "t1.ibd")] = "t1 v2"
+	writeFiles()
+	b2 := backup(2)
+	assert.Equal(t, b1, readBackup(b2).manifest.ParentBackup)
+	assert.Equal(t, map[string]string{
+		"ibdata1":      b1,
+		"ib_log":       b1,
+		"vt_db/t1.ibd": "",
+		"vt_db/t2.ibd": b1,
+	}, storedIn(b2))
+
+	// The third one points directly to the backups that store the data.
+	files[path.Join(cnf.InnodbLogGroupHomeDir, "ib_log")] = "ib_log v3"
+	writeFiles()
+	b3 := backup(3)
+	assert.Equal(t, b2, readBackup(b3).manifest.ParentBackup)
+	assert.Equal(t, map[string]string{
+		"ibdata1":      b1,
+		"ib_log":       "",
+		"vt_db/t1.ibd": b2,
+		"vt_db/t2.ibd": b1,
+	}, storedIn(b3))
+
+	// The chain is at its maximum length, so the fourth one is full.
+	b4 := backup(4)
+	assert.Equal(t, "", readBackup(b4).manifest.ParentBackup)
+	assert.Equal(t, map[string]string{
+		"ibdata1":      "",
+		"ib_log":       "",
+		"vt_db/t1.ibd": "",
+		"vt_db/t2.ibd": "",
+	}, storedIn(b4))
+
+	// Restoring the third one reads from the whole chain.
+	be := &BuiltinBackupEngine{}
+	b := readBackup(b3)
+	ancestors, err := be.findAncestors(ctx, b.bh, b.manifest)
+	require.NoError(t, err)
+	assert.Len(t, ancestors, 2)
+	restoreCnf := testMycnf(t, path.Join(root, "restore"))
+	require.NoError(t, be.restoreFiles(ctx, RestoreParams{
+		Cnf:         restoreCnf,
+		Logger:      logutil.NewMemoryLogger(),
+		Concurrency: 2,
+	}, b.bh, b.manifest, ancestors))
+	for name, contents := range files {
+		restored, err := ioutil.ReadFile(strings.Replace(name, "source", "restore", 1))
+		require.NoError(t, err)
+		assert.Equal(t, contents, string(restored), name)
+	}
+
+	// The backups the third one depends on can't be pruned.
+	bhs, err := bs.ListBackups(ctx, dir)
+	require.NoError(t, err)
+	parents := GetBackupParents(ctx, bhs)
+	assert.Equal(t, map[string]string{b2: b1, b3: b2}, parents)
+	assert.Equal(t, []string{b2, b3}, FindDependentBackups(parents, b1))
+	assert.Equal(t, []string{b3}, FindDependentBackups(parents, b2))
+	assert.Empty(t, FindDependentBackups(parents, b3))
+	assert.Empty(t, FindDependentBackups(parents, b4))
+
+	// And it can't be restored without them.
+	require.NoError(t, bs.RemoveBackup(ctx, dir, b2))
+	_, err = be.findAncestors(ctx, b.bh, b.manifest)
+	assert.EqualError(t, err, fmt.Sprintf("backup %v needs its parent backup %v, which is missing or incomplete", b3, b2))
+}
+
+func TestEncryptedBackup(t *testing.T) {
+	root, err := ioutil.TempDir("", "encryptedbackuptest")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+	defer func(saved string) { *filebackupstorage.FileBackupStorageRoot = saved }(*filebackupstorage.FileBackupStorageRoot)
+	*filebackupstorage.FileBackupStorageRoot = path.Join(root, "backups")
+	defer func(saved string) { *backupstorage.BackupStorageImplementation = saved }(*backupstorage.BackupStorageImplementation)
+	*backupstorage.BackupStorageImplementation = "file"
+	defer func(saved bool) { *builtinBackupIncremental = saved }(*builtinBackupIncremental)
+	*builtinBackupIncremental = true
+	defer func(saved string) { *backupencryption.KeyFile = saved }(*backupencryption.KeyFile)
+	*backupencryption.KeyFile = path.Join(root, "keys")
+	require.NoError(t, ioutil.WriteFile(*backupencryption.KeyFile, []byte("key1 "+base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 32))), 0600))
+	defer func(saved string) { *backupencryption.KeyProviderImplementation = saved }(*backupencryption.KeyProviderImplementation)
+
+	ctx := context.Background()
+	bs, err := backupstorage.GetBackupStorage()
+	require.NoError(t, err)
+	defer bs.Close()
+	dir := GetBackupDir("ks", "0")
+	cnf := testMycnf(t, path.Join(root, "source"))
+	tablePath := path.Join(cnf.DataDir, "vt_db", "t1.ibd")
+	require.NoError(t, ioutil.WriteFile(tablePath, []byte("secret table data"), 0600))
+
+	be := &BuiltinBackupEngine{}
+	backup := func(i int) *builtinBackup {
+		name := fmt.Sprintf("2020-01-01.00000%d.cell-0000000100", i)
+		bh, err := bs.StartBackup(ctx, dir, name)
+		require.NoError(t, err)
+		require.NoError(t, be.backupFiles(ctx, BackupParams{
+			Cnf:         cnf,
+			Logger:      logutil.NewMemoryLogger(),
+			Concurrency: 1,
+			BackupTime:  time.Now(),
+		}, bh, mysql.Position{}, nil))
+		require.NoError(t, bh.EndBackup(ctx))
+		bhs, err := bs.ListBackups(ctx, dir)
+		require.NoError(t, err)
+		return readBuiltinBackups(ctx, bhs)[name]
+	}
+
+	// An unencrypted backup, and an encrypted one that doesn't reuse
+	// its unencrypted data.
+	b1 := backup(1)
+	assert.Nil(t, b1.manifest.Encryption)
+	*backupencryption.KeyProviderImplementation = "keyfile"
+	b2 := backup(2)
+	assert.Equal(t, "", b2.manifest.ParentBackup)
+	require.NotNil(t, b2.manifest.Encryption)
+	assert.Equal(t, "keyfile", b2.manifest.Encryption.KeyProvider)
+	assert.Equal(t, "key1", b2.manifest.Encryption.KeyID)
+
+	// The stored data is encrypted.
+	assert.Equal(t, "vt_db/t1.ibd", b2.manifest.FileEntries[0].Name)
+	stored, err := ioutil.ReadFile(path.Join(*filebackupstorage.FileBackupStorageRoot, dir, b2.bh.Name(), "0"))
+	require.NoError(t, err)
+	assert.NotContains(t, string(stored), "secret")
+
+	// An incremental backup on top of it decrypts the parent data
+	// with the parent key.
+	require.NoError(t, ioutil.WriteFile(path.Join(cnf.InnodbDataHomeDir, "ibdata1"), []byte("ibdata1"), 0600))
+	b3 := backup(3)
+	assert.Equal(t, b2.bh.Name(), b3.manifest.ParentBackup)
+	assert.NotEqual(t, b2.manifest.Encryption.WrappedKey, b3.manifest.Encryption.WrappedKey)
+	ancestors, err := be.findAncestors(ctx, b3.bh, b3.manifest)
+	require.NoError(t, err)
+	restoreCnf := testMycnf(t, path.Join(root, "restore"))
+	require.NoError(t, be.restoreFiles(ctx, RestoreParams{
+		Cnf:         restoreCnf,
+		Logger:      logutil.NewMemoryLogger(),
+		Concurrency: 1,
+	}, b3.bh, b3.manifest, ancestors))
+	restored, err := ioutil.ReadFile(path.Join(restoreCnf.DataDir, "vt_db", "t1.ibd"))
+	require.NoError(t, err)
+	assert.Equal(t, "secret table data", string(restored))
+	restored, err = ioutil.ReadFile(path.Join(restoreCnf.InnodbDataHomeDir, "ibdata1"))
+	require.NoError(t, err)
+	assert.Equal(t, "ibdata1", string(restored))
+
+	// Without the key, it can't be restored.
+	require.NoError(t, ioutil.WriteFile(*backupencryption.KeyFile, []byte("key2 "+base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{2}, 32))), 0600))
+	err = be.restoreFiles(ctx, RestoreParams{
+		Cnf:         restoreCnf,
+		Logger:      logutil.NewMemoryLogger(),
+		Concurrency: 1,
+	}, b3.bh, b3.manifest, ancestors)
+	assert.Contains(t, fmt.Sprint(err), "no key key1")
+}
+
+func TestCompressedBackup(t *testing.T) {
+	if _, err := exec.LookPath("zstd"); err != nil {
+		t.Skip(err)
+	}
+	root, err := ioutil.TempDir("", "compressedbackuptest")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+	defer func(saved string) { *filebackupstorage.FileBackupStorageRoot = saved }(*filebackupstorage.FileBackupStorageRoot)
+	*filebackupstorage.FileBackupStorageRoot = path.Join(root, "backups")
+	defer func(saved string) { *backupstorage.BackupStorageImplementation = saved }(*backupstorage.BackupStorageImplementation)
+	*backupstorage.BackupStorageImplementation = "file"
+	defer func(saved bool) { *builtinBackupIncremental = saved }(*builtinBackupIncremental)
+	*builtinBackupIncremental = true
+	defer func(saved string) { *backupCompressionEngine = saved }(*backupCompressionEngine)
+
+	ctx := context.Background()
+	bs, err := backupstorage.GetBackupStorage()
+	require.NoError(t, err)
+	defer bs.Close()
+	dir := GetBackupDir("ks", "0")
+	cnf := testMycnf(t, path.Join(root, "source"))
+	t1Path := path.Join(cnf.DataDir, "vt_db", "t1.ibd")
+	t2Path := path.Join(cnf.DataDir, "vt_db", "t2.ibd")
+	require.NoError(t, ioutil.WriteFile(t1Path, []byte("t1 v1"), 0600))
+	require.NoError(t, ioutil.WriteFile(t2Path, []byte("t2 v1"), 0600))
+
+	be := &BuiltinBackupEngine{}
+	backup := func(i int) *builtinBackup {
+		name := fmt.Sprintf("2020-01-01.00000%d.cell-0000000100", i)
+		bh, err := bs.StartBackup(ctx, dir, name)
+		require.NoError(t, err)
+		require.NoError(t, be.backupFiles(ctx, BackupParams{
+			Cnf:         cnf,
+			Logger:      logutil.NewMemoryLogger(),
+			Concurrency: 1,
+			BackupTime:  time.Now(),
+		}, bh, mysql.Position{}, nil))
+		require.NoError(t, bh.EndBackup(ctx))
+		bhs, err := bs.ListBackups(ctx, dir)
+		require.NoError(t, err)
+		return readBuiltinBackups(ctx, bhs)[name]
+	}
+
+	// A full backup with pgzip, and an incremental one with zstd.
+	*backupCompressionEngine = PgzipCompressor
+	b1 := backup(1)
+	assert.Equal(t, PgzipCompressor, b1.manifest.CompressionEngine)
+	require.NoError(t, ioutil.WriteFile(t2Path, []byte("t2 v2"), 0600))
+	*backupCompressionEngine = ZstdCompressor
+	b2 := backup(2)
+	assert.Equal(t, b1.bh.Name(), b2.manifest.ParentBackup)
+	assert.Equal(t, ZstdCompressor, b2.manifest.CompressionEngine)
+
+	// Each file is decompressed with the engine of the backup storing
+	// it, whatever the flag is now.
+	*backupCompressionEngine = "unknown"
+	ancestors, err := be.findAncestors(ctx, b2.bh, b2.manifest)
+	require.NoError(t, err)
+	restoreCnf := testMycnf(t, path.Join(root, "restore"))
+	require.NoError(t, be.restoreFiles(ctx, RestoreParams{
+		Cnf:         restoreCnf,
+		Logger:      logutil.NewMemoryLogger(),
+		Concurrency: 1,
+	}, b2.bh, b2.manifest, ancestors))
+	for name, contents := range map[string]string{"t1.ibd": "t1 v1", "t2.ibd": "t2 v2"} {
+		restored, err := ioutil.ReadFile(path.Join(restoreCnf.DataDir, "vt_db", name))
+		require.NoError(t, err)
+		assert.Equal(t, contents, string(restored), name)
+	}
+
+	// Backups with an unknown engine fail early.
+	_, err = be.ExecuteBackup(ctx, BackupParams{Logger: logutil.NewMemoryLogger()}, b2.bh)
+	assert.EqualError(t, err, `unknown compression engine "unknown"`)
+}
@@ -0,0 +1,331 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"io"
+	"io/ioutil"
+	"os/exec"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/klauspost/pgzip"
+	"github.com/pierrec/lz4"
+
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// The names of the compression engines.
+const (
+	PgzipCompressor    = "pgzip"
+	ZstdCompressor     = "zstd"
+	Lz4Compressor      = "lz4"
+	ExternalCompressor = "external"
+)
+
+var (
+	// backupCompressionEngine is the engine used to compress the backup
+	// files. It is put in the MANIFEST, and the restore uses the engine of
+	// the MANIFEST, whatever the value of the flag then.
+	backupCompressionEngine = flag.String("backup_storage_compression_engine", PgzipCompressor, "if backup_storage_compress is true, the engine compressing the backup files: pgzip, zstd, lz4 or external.")
+
+	// backupExternalCompressor and backupExternalDecompressor are the
+	// commands of the external compression engine. The decompressor is
+	// put in the MANIFEST for information only: a restore never runs a
+	// command read from the backup storage.
+	backupExternalCompressor   = flag.String("backup_storage_external_compressor", "", "with backup_storage_compression_engine=external, the command (with its arguments) compressing its standard input to its standard output, e.g. 'xz -1 -T0'.")
+	backupExternalDecompressor = flag.String("backup_storage_external_decompressor", "", "with backup_storage_compression_engine=external, the command (with its arguments) decompressing its standard input to its standard output, e.g. 'xz -d'. It must be set to restore a backup compressed with the external engine. The command recorded in the backup MANIFEST is informational only, and never run.")
+
+	// backupExternalCompressorExtension is the file name extension of the
+	// files compressed by the external engine.
+	backupExternalCompressorExtension = flag.String("backup_storage_external_compressor_extension", "", "with backup_storage_compression_engine=external, the file name extension of the compressed files, e.g. '.xz'.")
+)
+
+// CompressionEngine compresses and decompresses backup files.
+type CompressionEngine interface {
+	// NewWriter returns a writer compressing into w. Closing it flushes
+	// the compressed data, but doesn't close w.
+	NewWriter(ctx context.Context, w io.Writer) (io.WriteCloser, error)
+
+	// NewReader returns a reader decompressing r. Read returns an error
+	// if r isn't properly compressed.
+	NewReader(ctx context.Context, r io.Reader) (io.ReadCloser, error)
+
+	// Extension is the file name extension of the compressed files,
+	// e.g. ".gz".
+	Extension() string
+}
+
+// CompressionEngineMap contains the registered compression engines.
+// The external engine isn't in the map, as its commands come from the
+// flags.
+var CompressionEngineMap = map[string]CompressionEngine{
+	PgzipCompressor: pgzipEngine{},
+	ZstdCompressor:  zstdEngine{},
+	Lz4Compressor:   lz4Engine{},
+}
+
+// getCompressionEngine returns the engine called name, using the given
+// commands for the external engine.
+func getCompressionEngine(name, externalCompressor, externalDecompressor string) (CompressionEngine, error) {
+	if name == ExternalCompressor {
+		return &commandEngine{
+			compressCmd:   strings.Fields(externalCompressor),
+			decompressCmd: strings.Fields(externalDecompressor),
+			extension:     *backupExternalCompressorExtension,
+		}, nil
+	}
+	engine, ok := CompressionEngineMap[name]
+	if !ok {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "unknown compression engine %q", name)
+	}
+	return engine, nil
+}
+
+// getBackupCompressionEngine returns the engine to compress a new backup.
+func getBackupCompressionEngine() (CompressionEngine, error) {
+	return getCompressionEngine(*backupCompressionEngine, *backupExternalCompressor, *backupExternalDecompressor)
+}
+
+// getRestoreCompressionEngine returns the engine to decompress a backup,
+// from the engine of its MANIFEST. Backups taken before the engine was
+// recorded were compressed with pgzip. Anyone who can write to the backup
+// storage can change the MANIFEST, so the decompressor it records is only
+// used in the error message: the external engine runs the command of
+// -backup_storage_external_decompressor, which must be set.
+func getRestoreCompressionEngine(name, recordedDecompressor string) (CompressionEngine, error) {
+	if name == "" {
+		name = PgzipCompressor
+	}
+	if name == ExternalCompressor && *backupExternalDecompressor == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "the backup was compressed with the external engine, -backup_storage_external_decompressor must be set to restore it (the MANIFEST records %q)", recordedDecompressor)
+	}
+	return getCompressionEngine(name, "", *backupExternalDecompressor)
+}
+
+// pgzipEngine compresses with parallel gzip, in process.
+type pgzipEngine struct{}
+
+// NewWriter is part of the CompressionEngine interface.
+func (pgzipEngine) NewWriter(ctx context.Context, w io.Writer) (io.WriteCloser, error) {
+	gz, err := pgzip.NewWriterLevel(w, pgzip.BestSpeed)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "cannot create gzip compressor")
+	}
+	gz.SetConcurrency(*backupCompressBlockSize, *backupCompressBlocks)
+	return gz, nil
+}
+
+// NewReader is part of the CompressionEngine interface.
+func (pgzipEngine) NewReader(ctx context.Context, r io.Reader) (io.ReadCloser, error) {
+	gz, err := pgzip.NewReader(r)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "can't open gzip decompressor")
+	}
+	return gz, nil
+}
+
+// Extension is part of the CompressionEngine interface.
+func (pgzipEngine) Extension() string {
+	return ".gz"
+}
+
+// zstdEngine compresses with zstd, in process. The files are
+// compatible with the zstd command.
+type zstdEngine struct{}
+
+// NewWriter is part of the CompressionEngine interface.
+func (zstdEngine) NewWriter(ctx context.Context, w io.Writer) (io.WriteCloser, error) {
+	zw, err := zstd.NewWriter(w)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "cannot create zstd compressor")
+	}
+	return zw, nil
+}
+
+// NewReader is part of the CompressionEngine interface.
+func (zstdEngine) NewReader(ctx context.Context, r io.Reader) (io.ReadCloser, error) {
+	zr, err := zstd.NewReader(r)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "can't open zstd decompressor")
+	}
+	return zr.IOReadCloser(), nil
+}
+
+// Extension is part of the CompressionEngine interface.
+func (zstdEngine) Extension() string {
+	return ".zst"
+}
+
+// lz4Engine compresses with lz4, in process. The files are
+// compatible with the lz4 command.
+type lz4Engine struct{}
+
+// NewWriter is part of the CompressionEngine interface.
+func (lz4Engine) NewWriter(ctx context.Context, w io.Writer) (io.WriteCloser, error) {
+	return lz4.NewWriter(w).WithConcurrency(*backupCompressBlocks), nil
+}
+
+// NewReader is part of the CompressionEngine interface.
+func (lz4Engine) NewReader(ctx context.Context, r io.Reader) (io.ReadCloser, error) {
+	return ioutil.NopCloser(lz4.NewReader(r)), nil
+}
+
+// Extension is part of the CompressionEngine interface.
+func (lz4Engine) Extension() string {
+	return ".lz4"
+}
+
+// commandEngine compresses by piping the data through commands.
+// It is only used by the external engine.
+type commandEngine struct {
+	compressCmd   []string
+	decompressCmd []string
+	extension     string
+}
+
+// NewWriter is part of the CompressionEngine interface.
+func (e *commandEngine) NewWriter(ctx context.Context, w io.Writer) (io.WriteCloser, error) {
+	cmd, stderr, err := newCompressionCommand(ctx, e.compressCmd)
+	if err != nil {
+		return nil, err
+	}
+	cmd.Stdout = w
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, vterrors.Wrap(err, "cannot create stdin pipe")
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, vterrors.Wrapf(err, "can't start %v", cmd.Args[0])
+	}
+	return &commandWriter{stdin: stdin, cmd: cmd, stderr: stderr}, nil
+}
+
+// NewReader is part of the CompressionEngine interface.
+func (e *commandEngine) NewReader(ctx context.Context, r io.Reader) (io.ReadCloser, error) {
+	cmd, stderr, err := newCompressionCommand(ctx, e.decompressCmd)
+	if err != nil {
+		return nil, err
+	}
+	cmd.Stdin = r
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, vterrors.Wrap(err, "cannot create stdout pipe")
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, vterrors.Wrapf(err, "can't start %v", cmd.Args[0])
+	}
+	return &commandReader{stdout: stdout, cmd: cmd, stderr: stderr}, nil
+}
+
+// Extension is part of the CompressionEngine interface.
+func (e *commandEngine) Extension() string {
+	return e.extension
+}
+
+func newCompressionCommand(ctx context.Context, args []string) (*exec.Cmd, *bytes.Buffer, error) {
+	if len(args) == 0 {
+		return nil, nil, vterrors.New(vtrpc.Code_INVALID_ARGUMENT, "no compression command specified")
+	}
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	stderr := &bytes.Buffer{}
+	cmd.Stderr = stderr
+	return cmd, stderr, nil
+}
+
+// commandWait waits for a compression command, and adds its stderr
+// to the error if it failed.
+func commandWait(cmd *exec.Cmd, stderr *bytes.Buffer) error {
+	if err := cmd.Wait(); err != nil {
+		return vterrors.Wrapf(err, "%v failed: %v", cmd.Args[0], strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
+
+// commandWriter writes to the standard input of a compression command.
+type commandWriter struct {
+	stdin  io.WriteCloser
+	cmd    *exec.Cmd
+	stderr *bytes.Buffer
+	done   bool
+	err    error
+}
+
+func (cw *commandWriter) Write(p []byte) (int, error) {
+	n, err := cw.stdin.Write(p)
+	if err != nil {
+		// The command most likely died, its error is more useful.
+		if werr := cw.wait(); werr != nil {
+			return n, werr
+		}
+	}
+	return n, err
+}
+
+// Close closes the standard input of the command, and waits for it to
+// write the rest of the compressed data.
+func (cw *commandWriter) Close() error {
+	return cw.wait()
+}
+
+func (cw *commandWriter) wait() error {
+	if !cw.done {
+		cw.done = true
+		cw.stdin.Close()
+		cw.err = commandWait(cw.cmd, cw.stderr)
+	}
+	return cw.err
+}
+
+// commandReader reads from the standard output of a decompression
+// command.
+type commandReader struct {
+	stdout io.ReadCloser
+	cmd    *exec.Cmd
+	stderr *bytes.Buffer
+	done   bool
+	err    error
+}
+
+// Read returns the error of the command, if it failed, instead of the
+// end of its output.
+func (cr *commandReader) Read(p []byte) (int, error) {
+	n, err := cr.stdout.Read(p)
+	if err == io.EOF && !cr.done {
+		cr.done = true
+		cr.err = commandWait(cr.cmd, cr.stderr)
+	}
+	if err == io.EOF && cr.err != nil {
+		return n, cr.err
+	}
+	return n, err
+}
+
+// Close stops the command if it didn't write all its output.
+func (cr *commandReader) Close() error {
+	if cr.done {
+		return cr.err
+	}
+	cr.done = true
+	cr.cmd.Process.Kill()
+	cr.cmd.Wait()
+	return nil
+}
@@ -0,0 +1,252 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testCompressionEngines returns the engines to test by name, including
+// an external one running gzip, skipped if gzip isn't installed.
+func testCompressionEngines(tb testing.TB) map[string]CompressionEngine {
+	engines := map[string]CompressionEngine{}
+	for name, engine := range CompressionEngineMap {
+		engines[name] = engine
+	}
+	external, err := getCompressionEngine(ExternalCompressor, "gzip -1", "gzip -d")
+	require.NoError(tb, err)
+	engines[ExternalCompressor] = external
+
+	for name, engine := range engines {
+		if ce, ok := engine.(*commandEngine); ok {
+			if _, err := exec.LookPath(ce.compressCmd[0]); err != nil {
+				tb.Logf("skipping compression engine %v: %v", name, err)
+				delete(engines, name)
+			}
+		}
+	}
+	return engines
+}
+
+// testCompressionData returns size bytes looking like table data: rows
+// of repetitive text, with some random bytes.
+func testCompressionData(size int) []byte {
+	r := rand.New(rand.NewSource(1))
+	words := []string{"vitess", "keyspace", "shard", "tablet", "backup", "restore", "binlog", "replica"}
+	buf := &bytes.Buffer{}
+	for buf.Len() < size {
+		fmt.Fprintf(buf, "%010d,%v-%v,user%d@example.com,%d,", r.Intn(1e9), words[r.Intn(len(words))], words[r.Intn(len(words))], r.Intn(1e5), r.Int63())
+		random := make([]byte, r.Intn(16))
+		r.Read(random)
+		buf.Write(random)
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes()[:size]
+}
+
+func compress(ctx context.Context, engine CompressionEngine, data []byte) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	compressor, err := engine.NewWriter(ctx, buf)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := compressor.Write(data); err != nil {
+		compressor.Close()
+		return nil, err
+	}
+	if err := compressor.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decompress(ctx context.Context, engine CompressionEngine, data []byte) ([]byte, error) {
+	decompressor, err := engine.NewReader(ctx, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer decompressor.Close()
+	return ioutil.ReadAll(decompressor)
+}
+
+func TestCompressionEngines(t *testing.T) {
+	ctx := context.Background()
+	data := testCompressionData(1 << 20)
+	for name, engine := range testCompressionEngines(t) {
+		t.Run(name, func(t *testing.T) {
+			compressed, err := compress(ctx, engine, data)
+			require.NoError(t, err)
+			assert.Less(t, len(compressed), len(data))
+			decompressed, err := decompress(ctx, engine, compressed)
+			require.NoError(t, err)
+			assert.True(t, bytes.Equal(data, decompressed), "decompressed data differs")
+
+			// An empty file works too.
+			compressed, err = compress(ctx, engine, nil)
+			require.NoError(t, err)
+			decompressed, err = decompress(ctx, engine, compressed)
+			require.NoError(t, err)
+			assert.Empty(t, decompressed)
+
+			// Corrupted data isn't silently truncated.
+			_, err = decompress(ctx, engine, data[:1000])
+			assert.Error(t, err)
+		})
+	}
+}
+
+// The in-process engines read the files of the zstd and lz4 commands,
+// which older backups were compressed with, and the other way around.
+func TestCompressionEnginesCommandCompatibility(t *testing.T) {
+	ctx := context.Background()
+	data := testCompressionData(1 << 20)
+	commands := map[string]*commandEngine{
+		ZstdCompressor: {
+			compressCmd:   []string{"zstd", "-q", "-c", "-T0"},
+			decompressCmd: []string{"zstd", "-q", "-d", "-c"},
+		},
+		Lz4Compressor: {
+			compressCmd:   []string{"lz4", "-q", "-c"},
+			decompressCmd: []string{"lz4", "-q", "-d", "-c"},
+		},
+	}
+	for name, command := range commands {
+		t.Run(name, func(t *testing.T) {
+			if _, err := exec.LookPath(command.compressCmd[0]); err != nil {
+				t.Skip(err)
+			}
+			engine := CompressionEngineMap[name]
+
+			compressed, err := compress(ctx, command, data)
+			require.NoError(t, err)
+			decompressed, err := decompress(ctx, engine, compressed)
+			require.NoError(t, err)
+			assert.True(t, bytes.Equal(data, decompressed), "decompressed data differs")
+
+			compressed, err = compress(ctx, engine, data)
+			require.NoError(t, err)
+			decompressed, err = decompress(ctx, command, compressed)
+			require.NoError(t, err)
+			assert.True(t, bytes.Equal(data, decompressed), "decompressed data differs")
+		})
+	}
+}
+
+func TestGetCompressionEngine(t *testing.T) {
+	_, err := getCompressionEngine("snappy", "", "")
+	assert.EqualError(t, err, `unknown compression engine "snappy"`)
+
+	engine, err := getCompressionEngine(PgzipCompressor, "", "")
+	require.NoError(t, err)
+	assert.Equal(t, ".gz", engine.Extension())
+
+	// Backups from before the engine was recorded used pgzip.
+	engine, err = getRestoreCompressionEngine("", "")
+	require.NoError(t, err)
+	assert.Equal(t, pgzipEngine{}, engine)
+
+	// The external decompressor of the MANIFEST is never run:
+	// the flag must be set.
+	defer func(saved string) { *backupExternalDecompressor = saved }(*backupExternalDecompressor)
+	*backupExternalDecompressor = ""
+	_, err = getRestoreCompressionEngine(ExternalCompressor, "xz -d")
+	assert.EqualError(t, err, `the backup was compressed with the external engine, -backup_storage_external_decompressor must be set to restore it (the MANIFEST records "xz -d")`)
+	*backupExternalDecompressor = "unxz -T0"
+	engine, err = getRestoreCompressionEngine(ExternalCompressor, "xz -d")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"unxz", "-T0"}, engine.(*commandEngine).decompressCmd)
+
+	// The external engine needs its commands.
+	engine, err = getCompressionEngine(ExternalCompressor, "", "")
+	require.NoError(t, err)
+	_, err = engine.NewWriter(context.Background(), ioutil.Discard)
+	assert.EqualError(t, err, "no compression command specified")
+}
+
+func TestCompressionCommandFailure(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip(err)
+	}
+	ctx := context.Background()
+	engine := &commandEngine{
+		compressCmd:   []string{"sh", "-c", "echo compressor broke >&2; exit 3"},
+		decompressCmd: []string{"sh", "-c", "echo decompressor broke >&2; exit 4"},
+	}
+
+	_, err := compress(ctx, engine, testCompressionData(1<<20))
+	assert.Contains(t, fmt.Sprint(err), "compressor broke")
+	_, err = decompress(ctx, engine, []byte("data"))
+	assert.Contains(t, fmt.Sprint(err), "decompressor broke")
+
+	// Closing the decompressor before the end stops the command.
+	engine.decompressCmd = []string{"cat"}
+	decompressor, err := engine.NewReader(ctx, bytes.NewReader(testCompressionData(1<<20)))
+	require.NoError(t, err)
+	_, err = io.ReadFull(decompressor, make([]byte, 10))
+	require.NoError(t, err)
+	assert.NoError(t, decompressor.Close())
+}
+
+// The benchmarks report the throughput and the compression ratio of
+// each engine. Run them with "go test -run=XXX -bench=Compression".
+func BenchmarkCompression(b *testing.B) {
+	ctx := context.Background()
+	data := testCompressionData(16 << 20)
+	for name, engine := range testCompressionEngines(b) {
+		b.Run(name, func(b *testing.B) {
+			b.SetBytes(int64(len(data)))
+			var compressed []byte
+			for i := 0; i < b.N; i++ {
+				var err error
+				if compressed, err = compress(ctx, engine, data); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.ReportMetric(float64(len(data))/float64(len(compressed)), "ratio")
+		})
+	}
+}
+
+func BenchmarkDecompression(b *testing.B) {
+	ctx := context.Background()
+	data := testCompressionData(16 << 20)
+	for name, engine := range testCompressionEngines(b) {
+		b.Run(name, func(b *testing.B) {
+			compressed, err := compress(ctx, engine, data)
+			if err != nil {
+				b.Fatal(err)
+			}
+			b.SetBytes(int64(len(data)))
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := decompress(ctx, engine, compressed); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -150,6 +150,13 @@ type FakeMysqlDaemon struct {
 	// BinlogPlayerEnabled is used by {Enable,Disable}BinlogPlayer
 	BinlogPlayerEnabled sync2.AtomicBool
 
+	// AppliedBinlogGTIDs has the includeGTIDs of every ApplyBinlogFile call.
+	// They are also added to CurrentMasterPosition.
+	AppliedBinlogGTIDs []mysql.GTIDSet
+
+	// ApplyBinlogFileError is used by ApplyBinlogFile
+	ApplyBinlogFileError error
+
 	// SemiSyncMasterEnabled represents the state of rpl_semi_sync_master_enabled.
 	SemiSyncMasterEnabled bool
 	// SemiSyncReplicaEnabled represents the state of rpl_semi_sync_slave_enabled.
@@ -444,6 +451,20 @@ func (fmd *FakeMysqlDaemon) DisableBinlogPlayback() error {
 	return nil
 }
 
+// ApplyBinlogFile is part of the MysqlDaemon interface
+func (fmd *FakeMysqlDaemon) ApplyBinlogFile(ctx context.Context, file string, includeGTIDs mysql.GTIDSet) error {
+	if fmd.ApplyBinlogFileError != nil {
+		return fmd.ApplyBinlogFileError
+	}
+	fmd.AppliedBinlogGTIDs = append(fmd.AppliedBinlogGTIDs, includeGTIDs)
+	if fmd.CurrentMasterPosition.IsZero() {
+		fmd.CurrentMasterPosition = mysql.Position{GTIDSet: includeGTIDs}
+	} else {
+		fmd.CurrentMasterPosition = mysql.Position{GTIDSet: fmd.CurrentMasterPosition.GTIDSet.Union(includeGTIDs)}
+	}
+	return nil
+}
+
 // Close is part of the MysqlDaemon interface
 func (fmd *FakeMysqlDaemon) Close() {
 	if fmd.appPool != nil {
@@ -17,6 +17,7 @@ limitations under the License.
 package mysqlctl
 
 import (
+	"crypto/sha256"
 	"encoding/hex"
 	"hash"
 	"hash/crc32"
@@ -24,15 +25,22 @@ import (
 
 // TODO(sougou): this file should be renamed.
 
-// our hasher, implemented using crc32
+// our hasher, implemented using crc32 or sha256
 type hasher struct {
-	hash.Hash32
+	hash.Hash
 }
 
 func newHasher() *hasher {
 	return &hasher{crc32.NewIEEE()}
 }
 
+// newSourceHasher returns the hasher for the contents of backed up files.
+// Incremental backups don't store the files whose hash didn't change, so
+// unlike newHasher that only detects corruptions, it is collision resistant.
+func newSourceHasher() *hasher {
+	return &hasher{sha256.New()}
+}
+
 func (h *hasher) HashString() string {
 	return hex.EncodeToString(h.Sum(nil))
 }
@@ -94,6 +94,10 @@ type MysqlDaemon interface {
 	// DisableBinlogPlayback disable playback of binlog events
 	DisableBinlogPlayback() error
 
+	// ApplyBinlogFile replays the transactions of a binlog file
+	// that are in includeGTIDs.
+	ApplyBinlogFile(ctx context.Context, file string, includeGTIDs mysql.GTIDSet) error
+
 	// Close will close this instance of Mysqld. It will wait for all dba
 	// queries to be finished.
 	Close()
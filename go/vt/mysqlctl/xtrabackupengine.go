@@ -31,9 +31,9 @@ import (
 	"sync"
 	"time"
 
-	"github.com/klauspost/pgzip"
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupencryption"
 	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
 	"vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/vterrors"
@@ -97,18 +97,36 @@ type xtraBackupManifest struct {
 	// false for backups that were created before the field existed, and those
 	// backups all had compression enabled.
 	SkipCompress bool
+
+	// CompressionEngine is the engine the files were compressed with.
+	// It is empty for backups taken before the field existed, which were
+	// compressed with pgzip.
+	CompressionEngine string
+
+	// ExternalDecompressor is the command decompressing the files, if
+	// they were compressed with the external engine. It's informational
+	// only, the restore runs -backup_storage_external_decompressor.
+	ExternalDecompressor string
+}
+
+// compressionEngine returns the engine the files of the backup were
+// compressed with, or nil if they weren't compressed.
+func (bm *xtraBackupManifest) compressionEngine() (CompressionEngine, error) {
+	if bm.SkipCompress {
+		return nil, nil
+	}
+	return getRestoreCompressionEngine(bm.CompressionEngine, bm.ExternalDecompressor)
 }
 
-func (be *XtrabackupEngine) backupFileName() string {
+// backupFileName returns the name of the backup file, with the extension
+// of the compression engine.
+func (be *XtrabackupEngine) backupFileName(extension string) string {
 	fileName := "backup"
 	if *xtrabackupStreamMode != "" {
 		fileName += "."
 		fileName += *xtrabackupStreamMode
 	}
-	if *backupStorageCompress {
-		fileName += ".gz"
-	}
-	return fileName
+	return fileName + extension
 }
 
 func closeFile(wc io.WriteCloser, fileName string, logger logutil.Logger, finalErr *error) {
@@ -144,7 +162,15 @@ func (be *XtrabackupEngine) ExecuteBackup(ctx context.Context, params BackupPara
 	flavor := pos.GTIDSet.Flavor()
 	params.Logger.Infof("Detected MySQL flavor: %v", flavor)
 
-	backupFileName := be.backupFileName()
+	var compression CompressionEngine
+	extension := ""
+	if *backupStorageCompress {
+		if compression, err = getBackupCompressionEngine(); err != nil {
+			return false, err
+		}
+		extension = compression.Extension()
+	}
+	backupFileName := be.backupFileName(extension)
 	numStripes := int(*xtrabackupStripes)
 
 	// Perform backups in a separate function, so deferred calls to Close() are
@@ -152,8 +178,17 @@ func (be *XtrabackupEngine) ExecuteBackup(ctx context.Context, params BackupPara
 	// do not write the MANIFEST unless all files were closed successfully,
 	// maintaining the contract that a MANIFEST file should only exist if the
 	// backup was created successfully.
+	dataKey, encryption, err := backupencryption.NewDataKey(ctx)
+	if err != nil {
+		return false, vterrors.Wrap(err, "can't create backup encryption key")
+	}
+	// List the tables to record in the MANIFEST.
+	tables, err := getBackupTables(ctx, params.Mysqld)
+	if err != nil {
+		params.Logger.Warningf("can't list the tables to record in the MANIFEST: %v", err)
+	}
 	params.Logger.Infof("Starting backup with %v stripe(s)", numStripes)
-	replicationPosition, err := be.backupFiles(ctx, params, bh, backupFileName, numStripes, flavor)
+	replicationPosition, err := be.backupFiles(ctx, params, bh, backupFileName, numStripes, flavor, compression, dataKey)
 	if err != nil {
 		return false, err
 	}
@@ -174,6 +209,8 @@ func (be *XtrabackupEngine) ExecuteBackup(ctx context.Context, params BackupPara
 			Position:     replicationPosition,
 			BackupTime:   params.BackupTime.UTC().Format(time.RFC3339),
 			FinishedTime: time.Now().UTC().Format(time.RFC3339),
+			Encryption:   encryption,
+			Tables:       tables,
 		},
 
 		// XtraBackup-specific fields
@@ -183,6 +220,9 @@ func (be *XtrabackupEngine) ExecuteBackup(ctx context.Context, params BackupPara
 		Params:          *xtrabackupBackupFlags,
 		NumStripes:      int32(numStripes),
 		StripeBlockSize: int32(*xtrabackupStripeBlockSize),
+
+		CompressionEngine:    *backupCompressionEngine,
+		ExternalDecompressor: *backupExternalDecompressor,
 	}
 
 	data, err := json.MarshalIndent(bm, "", "  ")
@@ -197,7 +237,7 @@ func (be *XtrabackupEngine) ExecuteBackup(ctx context.Context, params BackupPara
 	return true, nil
 }
 
-func (be *XtrabackupEngine) backupFiles(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle, backupFileName string, numStripes int, flavor string) (replicationPosition mysql.Position, finalErr error) {
+func (be *XtrabackupEngine) backupFiles(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle, backupFileName string, numStripes int, flavor string, compression CompressionEngine, dataKey []byte) (replicationPosition mysql.Position, finalErr error) {
 
 	backupProgram := path.Join(*xtrabackupEnginePath, xtrabackupBinaryName)
 	flagsToExec := []string{"--defaults-file=" + params.Cnf.path,
@@ -269,19 +309,29 @@ func (be *XtrabackupEngine) backupFiles(ctx context.Context, params BackupParams
 
 	destWriters := []io.Writer{}
 	destBuffers := []*bufio.Writer{}
-	destCompressors := []*pgzip.Writer{}
+	destEncrypters := []io.WriteCloser{}
+	destCompressors := []io.WriteCloser{}
 	for _, file := range destFiles {
 		buffer := bufio.NewWriterSize(file, writerBufferSize)
 		destBuffers = append(destBuffers, buffer)
 		writer := io.Writer(buffer)
 
-		// Create the gzip compression pipe, if necessary.
-		if *backupStorageCompress {
-			compressor, err := pgzip.NewWriterLevel(writer, pgzip.BestSpeed)
+		// Create the encrypter, if necessary.
+		if dataKey != nil {
+			encrypter, err := backupencryption.NewWriter(writer, dataKey)
+			if err != nil {
+				return replicationPosition, vterrors.Wrap(err, "cannot create encrypter")
+			}
+			writer = encrypter
+			destEncrypters = append(destEncrypters, encrypter)
+		}
+
+		// Create the compressor, if necessary.
+		if compression != nil {
+			compressor, err := compression.NewWriter(ctx, writer)
 			if err != nil {
-				return replicationPosition, vterrors.Wrap(err, "cannot create gzip compressor")
+				return replicationPosition, vterrors.Wrap(err, "cannot create compressor")
 			}
-			compressor.SetConcurrency(*backupCompressBlockSize, *backupCompressBlocks)
 			writer = compressor
 			destCompressors = append(destCompressors, compressor)
 		}
@@ -324,7 +374,7 @@ func (be *XtrabackupEngine) backupFiles(ctx context.Context, params BackupParams
 		}
 	}()
 
-	// Copy from the stream output to destination file (optional gzip)
+	// Copy from the stream output to destination file (optional compression)
 	blockSize := int64(*xtrabackupStripeBlockSize)
 	if blockSize < 1024 {
 		// Enforce minimum block size.
@@ -341,7 +391,14 @@ func (be *XtrabackupEngine) backupFiles(ctx context.Context, params BackupParams
 	// Close compressor to flush it. After that all data is sent to the buffer.
 	for _, compressor := range destCompressors {
 		if err := compressor.Close(); err != nil {
-			return replicationPosition, vterrors.Wrap(err, "cannot close gzip compressor")
+			return replicationPosition, vterrors.Wrap(err, "cannot close compressor")
+		}
+	}
+
+	// Close encrypter to write the last chunk.
+	for _, encrypter := range destEncrypters {
+		if err := encrypter.Close(); err != nil {
+			return replicationPosition, vterrors.Wrap(err, "cannot close encrypter")
 		}
 	}
 
@@ -497,14 +554,25 @@ func (be *XtrabackupEngine) extractFiles(ctx context.Context, logger logutil.Log
 	// Pull details from the MANIFEST where available, so we can still restore
 	// backups taken with different flags. Some fields were not always present,
 	// so if necessary we default to the flag values.
-	compressed := !bm.SkipCompress
+	compression, err := bm.compressionEngine()
+	if err != nil {
+		return err
+	}
+	dataKey, err := bm.Encryption.DataKey(ctx)
+	if err != nil {
+		return vterrors.Wrap(err, "can't get backup encryption key")
+	}
 	streamMode := bm.StreamMode
 	if streamMode == "" {
 		streamMode = *xtrabackupStreamMode
 	}
 	baseFileName := bm.FileName
 	if baseFileName == "" {
-		baseFileName = be.backupFileName()
+		extension := ""
+		if compression != nil {
+			extension = compression.Extension()
+		}
+		baseFileName = be.backupFileName(extension)
 	}
 
 	// Open the source files for reading.
@@ -519,15 +587,23 @@ func (be *XtrabackupEngine) extractFiles(ctx context.Context, logger logutil.Log
 	}()
 
 	srcReaders := []io.Reader{}
-	srcDecompressors := []*pgzip.Reader{}
+	srcDecompressors := []io.ReadCloser{}
 	for _, file := range srcFiles {
 		reader := io.Reader(file)
 
+		// Create the decrypter if needed.
+		if dataKey != nil {
+			reader, err = backupencryption.NewReader(reader, dataKey)
+			if err != nil {
+				return vterrors.Wrap(err, "can't create decrypter")
+			}
+		}
+
 		// Create the decompressor if needed.
-		if compressed {
-			decompressor, err := pgzip.NewReader(reader)
+		if compression != nil {
+			decompressor, err := compression.NewReader(ctx, reader)
 			if err != nil {
-				return vterrors.Wrap(err, "can't create gzip decompressor")
+				return vterrors.Wrap(err, "can't create decompressor")
 			}
 			srcDecompressors = append(srcDecompressors, decompressor)
 			reader = decompressor
@@ -538,7 +614,7 @@ func (be *XtrabackupEngine) extractFiles(ctx context.Context, logger logutil.Log
 	defer func() {
 		for _, decompressor := range srcDecompressors {
 			if cerr := decompressor.Close(); cerr != nil {
-				logger.Errorf("failed to close gzip decompressor: %v", cerr)
+				logger.Errorf("failed to close decompressor: %v", cerr)
 			}
 		}
 	}()
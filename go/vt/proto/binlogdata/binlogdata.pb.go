@@ -28,10 +28,11 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 type OnDDLAction int32
 
 const (
-	OnDDLAction_IGNORE      OnDDLAction = 0
-	OnDDLAction_STOP        OnDDLAction = 1
-	OnDDLAction_EXEC        OnDDLAction = 2
-	OnDDLAction_EXEC_IGNORE OnDDLAction = 3
+	OnDDLAction_IGNORE       OnDDLAction = 0
+	OnDDLAction_STOP         OnDDLAction = 1
+	OnDDLAction_EXEC         OnDDLAction = 2
+	OnDDLAction_EXEC_IGNORE  OnDDLAction = 3
+	OnDDLAction_EXEC_COLUMNS OnDDLAction = 4
 )
 
 var OnDDLAction_name = map[int32]string{
@@ -39,13 +40,15 @@ var OnDDLAction_name = map[int32]string{
 	1: "STOP",
 	2: "EXEC",
 	3: "EXEC_IGNORE",
+	4: "EXEC_COLUMNS",
 }
 
 var OnDDLAction_value = map[string]int32{
-	"IGNORE":      0,
-	"STOP":        1,
-	"EXEC":        2,
-	"EXEC_IGNORE": 3,
+	"IGNORE":       0,
+	"STOP":         1,
+	"EXEC":         2,
+	"EXEC_IGNORE":  3,
+	"EXEC_COLUMNS": 4,
 }
 
 func (x OnDDLAction) String() string {
@@ -2019,125 +2022,125 @@ func init() {
 func init() { proto.RegisterFile("binlogdata.proto", fileDescriptor_5fd02bcb2e350dad) }
 
 var fileDescriptor_5fd02bcb2e350dad = []byte{
-	// 1914 bytes of a gzipped FileDescriptorProto
+	// 1919 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x4b, 0x73, 0xe3, 0xc6,
-	0x11, 0x5e, 0xbe, 0xc9, 0x06, 0x45, 0x41, 0xa3, 0x47, 0x98, 0x2d, 0xdb, 0x25, 0xa3, 0xb2, 0x5e,
-	0x59, 0x55, 0xa1, 0x1c, 0x26, 0xde, 0x5c, 0x62, 0x3b, 0x7c, 0x60, 0xb5, 0x5c, 0xf1, 0xa1, 0x1d,
-	0x62, 0xb5, 0x2e, 0x5f, 0x50, 0x58, 0x70, 0x24, 0x21, 0x02, 0x08, 0x2c, 0x30, 0x94, 0xcc, 0x1f,
-	0x90, 0xaa, 0xdc, 0xf3, 0x2b, 0x72, 0xce, 0x31, 0xc9, 0x35, 0xf9, 0x13, 0xb9, 0xe6, 0x94, 0x5f,
-	0x90, 0x5b, 0x6a, 0x1e, 0x78, 0x69, 0xed, 0x95, 0xd6, 0x55, 0x39, 0xc4, 0x17, 0xd6, 0x4c, 0x4f,
-	0x77, 0x4f, 0xbf, 0xbe, 0x46, 0x73, 0x40, 0x7d, 0xed, 0x2c, 0x5d, 0xff, 0x62, 0x61, 0x51, 0xab,
-	0x13, 0x84, 0x3e, 0xf5, 0x11, 0xa4, 0x94, 0x87, 0xca, 0x35, 0x0d, 0x03, 0x5b, 0x1c, 0x3c, 0x54,
-	0xde, 0xac, 0x48, 0xb8, 0x96, 0x9b, 0x16, 0xf5, 0x03, 0x3f, 0x95, 0xd2, 0x26, 0x50, 0x1b, 0x5c,
-	0x5a, 0x61, 0x44, 0x28, 0xda, 0x83, 0xaa, 0xed, 0x3a, 0x64, 0x49, 0xdb, 0x85, 0xfd, 0xc2, 0x41,
-	0x05, 0xcb, 0x1d, 0x42, 0x50, 0xb6, 0xfd, 0xe5, 0xb2, 0x5d, 0xe4, 0x54, 0xbe, 0x66, 0xbc, 0x11,
-	0x09, 0xaf, 0x49, 0xd8, 0x2e, 0x09, 0x5e, 0xb1, 0xd3, 0xfe, 0x55, 0x82, 0xad, 0x3e, 0xb7, 0xc3,
-	0x08, 0xad, 0x65, 0x64, 0xd9, 0xd4, 0xf1, 0x97, 0xe8, 0x18, 0x20, 0xa2, 0x16, 0x25, 0x1e, 0x59,
-	0xd2, 0xa8, 0x5d, 0xd8, 0x2f, 0x1d, 0x28, 0xdd, 0xc7, 0x9d, 0x8c, 0x07, 0x6f, 0x89, 0x74, 0xe6,
-	0x31, 0x3f, 0xce, 0x88, 0xa2, 0x2e, 0x28, 0xe4, 0x9a, 0x2c, 0xa9, 0x49, 0xfd, 0x2b, 0xb2, 0x6c,
-	0x97, 0xf7, 0x0b, 0x07, 0x4a, 0x77, 0xab, 0x23, 0x1c, 0xd4, 0xd9, 0x89, 0xc1, 0x0e, 0x30, 0x90,
-	0x64, 0xfd, 0xf0, 0xef, 0x45, 0x68, 0x24, 0xda, 0xd0, 0x18, 0xea, 0xb6, 0x45, 0xc9, 0x85, 0x1f,
-	0xae, 0xb9, 0x9b, 0xad, 0xee, 0x67, 0xf7, 0x34, 0xa4, 0x33, 0x90, 0x72, 0x38, 0xd1, 0x80, 0x7e,
-	0x0e, 0x35, 0x5b, 0x44, 0x8f, 0x47, 0x47, 0xe9, 0x6e, 0x67, 0x95, 0xc9, 0xc0, 0xe2, 0x98, 0x07,
-	0xa9, 0x50, 0x8a, 0xde, 0xb8, 0x3c, 0x64, 0x4d, 0xcc, 0x96, 0xda, 0x9f, 0x0a, 0x50, 0x8f, 0xf5,
-	0xa2, 0x6d, 0xd8, 0xec, 0x8f, 0xcd, 0x97, 0x53, 0xac, 0x0f, 0x66, 0xc7, 0xd3, 0xd1, 0x37, 0xfa,
-	0x50, 0x7d, 0x80, 0x9a, 0x50, 0xef, 0x8f, 0xcd, 0xbe, 0x7e, 0x3c, 0x9a, 0xaa, 0x05, 0xb4, 0x01,
-	0x8d, 0xfe, 0xd8, 0x1c, 0xcc, 0x26, 0x93, 0x91, 0xa1, 0x16, 0xd1, 0x26, 0x28, 0xfd, 0xb1, 0x89,
-	0x67, 0xe3, 0x71, 0xbf, 0x37, 0x38, 0x51, 0x4b, 0x68, 0x17, 0xb6, 0xfa, 0x63, 0x73, 0x38, 0x19,
-	0x9b, 0x43, 0xfd, 0x14, 0xeb, 0x83, 0x9e, 0xa1, 0x0f, 0xd5, 0x32, 0x02, 0xa8, 0x32, 0xf2, 0x70,
-	0xac, 0x56, 0xe4, 0x7a, 0xae, 0x1b, 0x6a, 0x55, 0xaa, 0x1b, 0x4d, 0xe7, 0x3a, 0x36, 0xd4, 0x9a,
-	0xdc, 0xbe, 0x3c, 0x1d, 0xf6, 0x0c, 0x5d, 0xad, 0xcb, 0xed, 0x50, 0x1f, 0xeb, 0x86, 0xae, 0x36,
-	0x9e, 0x97, 0xeb, 0x45, 0xb5, 0xf4, 0xbc, 0x5c, 0x2f, 0xa9, 0x65, 0xed, 0x8f, 0x05, 0xd8, 0x9d,
-	0xd3, 0x90, 0x58, 0xde, 0x09, 0x59, 0x63, 0x6b, 0x79, 0x41, 0x30, 0x79, 0xb3, 0x22, 0x11, 0x45,
-	0x0f, 0xa1, 0x1e, 0xf8, 0x91, 0xc3, 0x62, 0xc7, 0x03, 0xdc, 0xc0, 0xc9, 0x1e, 0x1d, 0x41, 0xe3,
-	0x8a, 0xac, 0xcd, 0x90, 0xf1, 0xcb, 0x80, 0xa1, 0x4e, 0x52, 0x90, 0x89, 0xa6, 0xfa, 0x95, 0x5c,
-	0x65, 0xe3, 0x5b, 0xba, 0x3b, 0xbe, 0xda, 0x39, 0xec, 0xdd, 0x36, 0x2a, 0x0a, 0xfc, 0x65, 0x44,
-	0xd0, 0x18, 0x90, 0x10, 0x34, 0x69, 0x9a, 0x5b, 0x6e, 0x9f, 0xd2, 0xfd, 0xf0, 0x9d, 0x05, 0x80,
-	0xb7, 0x5e, 0xdf, 0x26, 0x69, 0xdf, 0xc2, 0xb6, 0xb8, 0xc7, 0xb0, 0x5e, 0xbb, 0x24, 0xba, 0x8f,
-	0xeb, 0x7b, 0x50, 0xa5, 0x9c, 0xb9, 0x5d, 0xdc, 0x2f, 0x1d, 0x34, 0xb0, 0xdc, 0xbd, 0xaf, 0x87,
-	0x0b, 0xd8, 0xc9, 0xdf, 0xfc, 0x3f, 0xf1, 0xef, 0x57, 0x50, 0xc6, 0x2b, 0x97, 0xa0, 0x1d, 0xa8,
-	0x78, 0x16, 0xb5, 0x2f, 0xa5, 0x37, 0x62, 0xc3, 0x5c, 0x39, 0x77, 0x5c, 0x4a, 0x42, 0x9e, 0xc2,
-	0x06, 0x96, 0x3b, 0xed, 0xcf, 0x05, 0xa8, 0x3e, 0xe5, 0x4b, 0xf4, 0x09, 0x54, 0xc2, 0x15, 0x73,
-	0x56, 0x60, 0x5d, 0xcd, 0x5a, 0xc0, 0x34, 0x63, 0x71, 0x8c, 0x46, 0xd0, 0x3a, 0x77, 0x88, 0xbb,
-	0xe0, 0xd0, 0x9d, 0xf8, 0x0b, 0x51, 0x15, 0xad, 0xee, 0xc7, 0x59, 0x01, 0xa1, 0xb3, 0xf3, 0x34,
-	0xc7, 0x88, 0x6f, 0x09, 0x6a, 0x4f, 0xa0, 0x95, 0xe7, 0x60, 0x70, 0xd2, 0x31, 0x36, 0x67, 0x53,
-	0x73, 0x32, 0x9a, 0x4f, 0x7a, 0xc6, 0xe0, 0x99, 0xfa, 0x80, 0x23, 0x46, 0x9f, 0x1b, 0xa6, 0xfe,
-	0xf4, 0xe9, 0x0c, 0x1b, 0x6a, 0x41, 0xfb, 0x77, 0x11, 0x9a, 0x22, 0x28, 0x73, 0x7f, 0x15, 0xda,
-	0x84, 0x65, 0xf1, 0x8a, 0xac, 0xa3, 0xc0, 0xb2, 0x49, 0x9c, 0xc5, 0x78, 0xcf, 0x02, 0x12, 0x5d,
-	0x5a, 0xe1, 0x42, 0x7a, 0x2e, 0x36, 0xe8, 0x73, 0x50, 0x78, 0x36, 0xa9, 0x49, 0xd7, 0x01, 0xe1,
-	0x79, 0x6c, 0x75, 0x77, 0xd2, 0xc2, 0xe6, 0xb9, 0xa2, 0xc6, 0x3a, 0x20, 0x18, 0x68, 0xb2, 0xce,
-	0xa3, 0xa1, 0x7c, 0x0f, 0x34, 0xa4, 0x35, 0x54, 0xc9, 0xd5, 0xd0, 0x61, 0x92, 0x90, 0xaa, 0xd4,
-	0xf2, 0x56, 0xf4, 0xe2, 0x24, 0xa1, 0x0e, 0x54, 0xfd, 0xa5, 0xb9, 0x58, 0xb8, 0xed, 0x1a, 0x37,
-	0xf3, 0x27, 0x59, 0xde, 0xd9, 0x72, 0x38, 0x1c, 0xf7, 0x44, 0x59, 0x54, 0xfc, 0xe5, 0x70, 0xe1,
-	0xa2, 0x47, 0xd0, 0x22, 0xdf, 0x52, 0x12, 0x2e, 0x2d, 0xd7, 0xf4, 0xd6, 0xac, 0x7b, 0xd5, 0xb9,
-	0xeb, 0x1b, 0x31, 0x75, 0xc2, 0x88, 0xe8, 0x13, 0xd8, 0x8c, 0xa8, 0x1f, 0x98, 0xd6, 0x39, 0x25,
-	0xa1, 0x69, 0xfb, 0xc1, 0xba, 0xdd, 0xd8, 0x2f, 0x1c, 0xd4, 0xf1, 0x06, 0x23, 0xf7, 0x18, 0x75,
-	0xe0, 0x07, 0x6b, 0xed, 0x05, 0x34, 0xb0, 0x7f, 0x33, 0xb8, 0xe4, 0xfe, 0x68, 0x50, 0x7d, 0x4d,
-	0xce, 0xfd, 0x90, 0xc8, 0x42, 0x05, 0xd9, 0xc8, 0xb1, 0x7f, 0x83, 0xe5, 0x09, 0xda, 0x87, 0x0a,
-	0xd7, 0x29, 0xdb, 0x45, 0x96, 0x45, 0x1c, 0x68, 0x16, 0xd4, 0xb1, 0x7f, 0xc3, 0xd3, 0x8e, 0x3e,
-	0x04, 0x11, 0x60, 0x73, 0x69, 0x79, 0x71, 0xf6, 0x1a, 0x9c, 0x32, 0xb5, 0x3c, 0x82, 0x9e, 0x80,
-	0x12, 0xfa, 0x37, 0xa6, 0xcd, 0xaf, 0x17, 0x48, 0x54, 0xba, 0xbb, 0xb9, 0xe2, 0x8c, 0x8d, 0xc3,
-	0x10, 0xc6, 0xcb, 0x48, 0x7b, 0x01, 0x90, 0xd6, 0xd6, 0x5d, 0x97, 0xfc, 0x8c, 0x65, 0x83, 0xb8,
-	0x8b, 0x58, 0x7f, 0x53, 0x9a, 0xcc, 0x35, 0x60, 0x79, 0xa6, 0xfd, 0xa1, 0x00, 0x8d, 0x39, 0xab,
-	0x9e, 0x63, 0xea, 0x2c, 0x7e, 0x40, 0xcd, 0x21, 0x28, 0x5f, 0x50, 0x67, 0xc1, 0x8b, 0xad, 0x81,
-	0xf9, 0x1a, 0x7d, 0x1e, 0x1b, 0x16, 0x98, 0x57, 0x51, 0xbb, 0xcc, 0x6f, 0xcf, 0xe5, 0x97, 0x17,
-	0xe2, 0xd8, 0x8a, 0xe8, 0xe9, 0x09, 0xae, 0x73, 0xd6, 0xd3, 0x93, 0x48, 0xfb, 0x0a, 0x2a, 0x67,
-	0xdc, 0x8a, 0x27, 0xa0, 0x70, 0xe5, 0x26, 0xd3, 0x16, 0x63, 0x37, 0x17, 0x9e, 0xc4, 0x62, 0x0c,
-	0x51, 0xbc, 0x8c, 0xb4, 0x1e, 0x6c, 0x9c, 0x48, 0x6b, 0x39, 0xc3, 0xfb, 0xbb, 0xa3, 0xfd, 0xb5,
-	0x08, 0xb5, 0xe7, 0xfe, 0x8a, 0x15, 0x14, 0x6a, 0x41, 0xd1, 0x59, 0x70, 0xb9, 0x12, 0x2e, 0x3a,
-	0x0b, 0xf4, 0x5b, 0x68, 0x79, 0xce, 0x45, 0x68, 0xb1, 0xb2, 0x14, 0x08, 0x13, 0x4d, 0xe2, 0xa7,
-	0x59, 0xcb, 0x26, 0x31, 0x07, 0x87, 0xd9, 0x86, 0x97, 0xdd, 0x66, 0x80, 0x53, 0xca, 0x01, 0xe7,
-	0x11, 0xb4, 0x5c, 0xdf, 0xb6, 0x5c, 0x33, 0x69, 0xdb, 0x65, 0x51, 0xdc, 0x9c, 0x7a, 0x1a, 0xf7,
-	0xee, 0x5b, 0x71, 0xa9, 0xdc, 0x33, 0x2e, 0xe8, 0x0b, 0x68, 0x06, 0x56, 0x48, 0x1d, 0xdb, 0x09,
-	0x2c, 0x36, 0xf8, 0x54, 0xb9, 0x60, 0xce, 0xec, 0x5c, 0xdc, 0x70, 0x8e, 0x1d, 0x7d, 0x0a, 0x6a,
-	0xc4, 0x5b, 0x92, 0x79, 0xe3, 0x87, 0x57, 0xe7, 0xae, 0x7f, 0x13, 0xb5, 0x6b, 0xdc, 0xfe, 0x4d,
-	0x41, 0x7f, 0x15, 0x93, 0xb5, 0xbf, 0x94, 0xa0, 0x7a, 0x26, 0xaa, 0xf3, 0x10, 0xca, 0x3c, 0x46,
-	0x62, 0xb8, 0xd9, 0xcb, 0x5e, 0x26, 0x38, 0x78, 0x80, 0x38, 0x0f, 0xfa, 0x00, 0x1a, 0xd4, 0xf1,
-	0x48, 0x44, 0x2d, 0x2f, 0xe0, 0x41, 0x2d, 0xe1, 0x94, 0xf0, 0x9d, 0x25, 0xf6, 0x01, 0x34, 0x92,
-	0x71, 0x4c, 0x06, 0x2b, 0x25, 0xa0, 0x5f, 0x40, 0x83, 0xe1, 0x8b, 0x0f, 0x5f, 0xed, 0x0a, 0x07,
-	0xec, 0xce, 0x2d, 0x74, 0x71, 0x13, 0x70, 0x3d, 0x8c, 0x11, 0xfb, 0x6b, 0x50, 0x38, 0x22, 0xa4,
-	0x90, 0x68, 0x60, 0x7b, 0xf9, 0x06, 0x16, 0x23, 0x0f, 0x43, 0xda, 0xf3, 0xd1, 0x63, 0xa8, 0x5c,
-	0x73, 0xf3, 0x6a, 0x72, 0x08, 0xcc, 0x3a, 0xca, 0x53, 0x21, 0xce, 0xd9, 0x17, 0xf6, 0x77, 0xa2,
-	0xb2, 0x78, 0xeb, 0xba, 0xf5, 0x85, 0x95, 0x45, 0x87, 0x63, 0x1e, 0x36, 0xa3, 0x2d, 0x3c, 0x97,
-	0x77, 0xaf, 0x06, 0x66, 0x4b, 0xf4, 0x31, 0x34, 0xed, 0x55, 0x18, 0xf2, 0xb1, 0xd3, 0xf1, 0x48,
-	0x7b, 0x87, 0x07, 0x4a, 0x91, 0x34, 0xc3, 0xf1, 0x08, 0xfa, 0x0d, 0xb4, 0x5c, 0x2b, 0xa2, 0x0c,
-	0x78, 0xd2, 0x91, 0x5d, 0x7e, 0x55, 0x0e, 0x7d, 0x02, 0x78, 0xc2, 0x13, 0xc5, 0x4d, 0x37, 0xda,
-	0x25, 0x34, 0x27, 0xce, 0xd2, 0xf1, 0x2c, 0x97, 0x03, 0x94, 0x05, 0x3e, 0xd3, 0x5a, 0xf8, 0xfa,
-	0x7e, 0x5d, 0x05, 0x7d, 0x04, 0x0a, 0x33, 0xc1, 0xf6, 0xdd, 0x95, 0xb7, 0x14, 0xd5, 0x5e, 0xc2,
-	0x8d, 0xe0, 0x64, 0x20, 0x08, 0x0c, 0xa9, 0xf2, 0xa6, 0xb9, 0x7d, 0x49, 0x3c, 0x0b, 0x7d, 0x96,
-	0x20, 0x43, 0xa0, 0xbd, 0x9d, 0xc7, 0x54, 0x6a, 0x54, 0x8c, 0x19, 0xed, 0x1f, 0x45, 0x68, 0x9d,
-	0x89, 0x19, 0x24, 0x9e, 0x7b, 0xbe, 0x82, 0x6d, 0x72, 0x7e, 0x4e, 0x6c, 0xea, 0x5c, 0x13, 0xd3,
-	0xb6, 0x5c, 0x97, 0x84, 0xa6, 0x44, 0xb0, 0xd2, 0xdd, 0xec, 0x88, 0xff, 0x22, 0x03, 0x4e, 0x1f,
-	0x0d, 0xf1, 0x56, 0xc2, 0x2b, 0x49, 0x0b, 0xa4, 0xc3, 0xb6, 0xe3, 0x79, 0x64, 0xe1, 0x58, 0x34,
-	0xab, 0x40, 0xb4, 0xfc, 0x5d, 0xe9, 0xe9, 0x99, 0x71, 0x6c, 0x51, 0x92, 0xaa, 0x49, 0x24, 0x12,
-	0x35, 0x8f, 0x98, 0x33, 0xe1, 0x45, 0x32, 0x4a, 0x6d, 0x48, 0x49, 0x83, 0x13, 0xb1, 0x3c, 0xcc,
-	0x8d, 0x69, 0xe5, 0x5b, 0x63, 0x5a, 0xfa, 0x29, 0xad, 0xdc, 0xf9, 0x29, 0xfd, 0x12, 0x36, 0x45,
-	0xbb, 0x8d, 0x53, 0x1f, 0x23, 0xfc, 0x7b, 0x7b, 0x6e, 0x93, 0xa6, 0x9b, 0x48, 0xfb, 0x02, 0x36,
-	0x93, 0x40, 0xca, 0x31, 0xee, 0x10, 0xaa, 0xbc, 0x7c, 0xe2, 0x74, 0xa0, 0xb7, 0xe1, 0x8b, 0x25,
-	0x87, 0xf6, 0xfb, 0x22, 0xa0, 0x58, 0xde, 0xbf, 0x89, 0xfe, 0x4f, 0x93, 0xb1, 0x03, 0x15, 0x4e,
-	0x97, 0x99, 0x10, 0x1b, 0x16, 0x07, 0x16, 0xd4, 0xe0, 0x2a, 0x49, 0x83, 0x10, 0x7e, 0xc1, 0x7e,
-	0x31, 0x89, 0x56, 0x2e, 0xc5, 0x92, 0x43, 0xfb, 0x5b, 0x01, 0xb6, 0x73, 0x71, 0x90, 0xb1, 0x4c,
-	0x11, 0x53, 0x78, 0x07, 0x62, 0x0e, 0xa0, 0x1e, 0x5c, 0xbd, 0x03, 0x59, 0xc9, 0xe9, 0x77, 0xb6,
-	0xc3, 0x8f, 0xa0, 0x1c, 0xb2, 0xb6, 0x2c, 0xbe, 0xb5, 0xd9, 0xe1, 0x84, 0xd3, 0xd9, 0x84, 0x93,
-	0xf3, 0x23, 0x37, 0xe1, 0x48, 0xfb, 0x1d, 0x50, 0x32, 0x9d, 0x81, 0xb5, 0x92, 0x7c, 0x55, 0xc9,
-	0xd4, 0x7d, 0x6f, 0x51, 0x29, 0x99, 0xa2, 0x62, 0xfd, 0xd9, 0xf6, 0xbd, 0xc0, 0x25, 0x94, 0x88,
-	0x94, 0xd5, 0x71, 0x4a, 0xd0, 0xbe, 0x06, 0x25, 0x23, 0x79, 0xd7, 0x20, 0x93, 0x26, 0xa1, 0x74,
-	0x67, 0x12, 0xfe, 0x59, 0x80, 0xdd, 0xb4, 0x98, 0x57, 0x2e, 0xfd, 0x51, 0xd5, 0xa3, 0x16, 0xc2,
-	0xde, 0x6d, 0xef, 0xde, 0xab, 0xca, 0x7e, 0x40, 0xed, 0x1c, 0x7e, 0x09, 0x4a, 0x66, 0x1e, 0x67,
-	0x7f, 0xdb, 0x47, 0xc7, 0xd3, 0x19, 0xd6, 0xd5, 0x07, 0xa8, 0x0e, 0xe5, 0xb9, 0x31, 0x3b, 0x55,
-	0x0b, 0x6c, 0xa5, 0x7f, 0xad, 0x0f, 0xc4, 0x53, 0x00, 0x5b, 0x99, 0x92, 0xa9, 0x74, 0xf8, 0x9f,
-	0x02, 0x40, 0xfa, 0xc5, 0x47, 0x0a, 0xd4, 0x5e, 0x4e, 0x4f, 0xa6, 0xb3, 0x57, 0x53, 0xa1, 0xe0,
-	0xd8, 0x18, 0x0d, 0xd5, 0x02, 0x6a, 0x40, 0x45, 0xbc, 0x2d, 0x14, 0xd9, 0x0d, 0xf2, 0x61, 0xa1,
-	0x84, 0x9a, 0x50, 0x4f, 0x5e, 0x15, 0xca, 0xa8, 0x06, 0xa5, 0xe4, 0xed, 0x40, 0x3e, 0x16, 0x54,
-	0x99, 0x42, 0xac, 0x9f, 0x8e, 0x7b, 0x03, 0x5d, 0xad, 0xb1, 0x83, 0xe4, 0xd9, 0x00, 0xa0, 0x1a,
-	0xbf, 0x19, 0x30, 0xc9, 0xb9, 0x6e, 0xa8, 0xc0, 0xee, 0x99, 0x19, 0xcf, 0x74, 0xac, 0x2a, 0x8c,
-	0x86, 0x67, 0xaf, 0xd4, 0x26, 0xa3, 0x3d, 0x1d, 0xe9, 0xe3, 0xa1, 0xba, 0x81, 0x36, 0xa0, 0xf1,
-	0x4c, 0xef, 0x61, 0xa3, 0xaf, 0xf7, 0x0c, 0xb5, 0xc5, 0x4e, 0xce, 0xb8, 0x81, 0x9b, 0xec, 0x9a,
-	0xe7, 0xb3, 0x97, 0x78, 0xda, 0x1b, 0xab, 0x2a, 0xdb, 0x9c, 0xe9, 0x78, 0x3e, 0x9a, 0x4d, 0xd5,
-	0x2d, 0x76, 0xcf, 0xb8, 0x37, 0x37, 0x4e, 0x4f, 0x54, 0xc4, 0xe4, 0xe7, 0xbd, 0x33, 0xfd, 0x74,
-	0x36, 0x9a, 0x1a, 0xea, 0xf6, 0xe1, 0x63, 0xf6, 0x9d, 0xcb, 0x4e, 0x80, 0x00, 0x55, 0xa3, 0xd7,
-	0x1f, 0xeb, 0x73, 0xf5, 0x01, 0x5b, 0xcf, 0x9f, 0xf5, 0xf0, 0x70, 0xae, 0x16, 0xfa, 0x9f, 0x7e,
-	0xf3, 0xf8, 0xda, 0xa1, 0x24, 0x8a, 0x3a, 0x8e, 0x7f, 0x24, 0x56, 0x47, 0x17, 0xfe, 0xd1, 0x35,
-	0x3d, 0xe2, 0xcf, 0x63, 0x47, 0x29, 0xe6, 0x5e, 0x57, 0x39, 0xe5, 0x97, 0xff, 0x0d, 0x00, 0x00,
-	0xff, 0xff, 0x23, 0xf6, 0xf5, 0x62, 0x7a, 0x13, 0x00, 0x00,
+	0x11, 0x5e, 0xbe, 0xc9, 0x06, 0x45, 0x41, 0xa3, 0x47, 0x98, 0x2d, 0xdb, 0x25, 0xa3, 0x62, 0xaf,
+	0xac, 0xaa, 0x50, 0x0e, 0x13, 0x6f, 0x2e, 0x71, 0x1c, 0x3e, 0xb0, 0x5a, 0xae, 0xc0, 0xc7, 0x0e,
+	0x21, 0xad, 0xcb, 0x17, 0x14, 0x44, 0x8e, 0x24, 0x44, 0x20, 0x80, 0x05, 0x86, 0x92, 0xf9, 0x03,
+	0x52, 0x95, 0x7b, 0x7e, 0x45, 0xce, 0x39, 0x26, 0xb9, 0x26, 0x7f, 0x22, 0xd7, 0x9c, 0xf2, 0x0b,
+	0x72, 0x4b, 0xcd, 0x03, 0x0f, 0x4a, 0xf6, 0x4a, 0xeb, 0xaa, 0x1c, 0x92, 0x0b, 0x6b, 0xa6, 0xa7,
+	0xbb, 0xa7, 0x5f, 0x5f, 0xa3, 0x39, 0xa0, 0x9e, 0x3b, 0x9e, 0xeb, 0x5f, 0xce, 0x6d, 0x6a, 0xb7,
+	0x82, 0xd0, 0xa7, 0x3e, 0x82, 0x94, 0xf2, 0x54, 0xb9, 0xa1, 0x61, 0x30, 0x13, 0x07, 0x4f, 0x95,
+	0xb7, 0x4b, 0x12, 0xae, 0xe4, 0xa6, 0x41, 0xfd, 0xc0, 0x4f, 0xa5, 0xb4, 0x21, 0x54, 0x7a, 0x57,
+	0x76, 0x18, 0x11, 0x8a, 0xf6, 0xa0, 0x3c, 0x73, 0x1d, 0xe2, 0xd1, 0x66, 0x6e, 0x3f, 0x77, 0x50,
+	0xc2, 0x72, 0x87, 0x10, 0x14, 0x67, 0xbe, 0xe7, 0x35, 0xf3, 0x9c, 0xca, 0xd7, 0x8c, 0x37, 0x22,
+	0xe1, 0x0d, 0x09, 0x9b, 0x05, 0xc1, 0x2b, 0x76, 0xda, 0x3f, 0x0b, 0xb0, 0xd5, 0xe5, 0x76, 0x98,
+	0xa1, 0xed, 0x45, 0xf6, 0x8c, 0x3a, 0xbe, 0x87, 0x8e, 0x01, 0x22, 0x6a, 0x53, 0xb2, 0x20, 0x1e,
+	0x8d, 0x9a, 0xb9, 0xfd, 0xc2, 0x81, 0xd2, 0x7e, 0xd6, 0xca, 0x78, 0x70, 0x4f, 0xa4, 0x35, 0x8d,
+	0xf9, 0x71, 0x46, 0x14, 0xb5, 0x41, 0x21, 0x37, 0xc4, 0xa3, 0x16, 0xf5, 0xaf, 0x89, 0xd7, 0x2c,
+	0xee, 0xe7, 0x0e, 0x94, 0xf6, 0x56, 0x4b, 0x38, 0xa8, 0xb3, 0x13, 0x93, 0x1d, 0x60, 0x20, 0xc9,
+	0xfa, 0xe9, 0xdf, 0xf2, 0x50, 0x4b, 0xb4, 0x21, 0x03, 0xaa, 0x33, 0x9b, 0x92, 0x4b, 0x3f, 0x5c,
+	0x71, 0x37, 0x1b, 0xed, 0xcf, 0x1f, 0x69, 0x48, 0xab, 0x27, 0xe5, 0x70, 0xa2, 0x01, 0xfd, 0x14,
+	0x2a, 0x33, 0x11, 0x3d, 0x1e, 0x1d, 0xa5, 0xbd, 0x9d, 0x55, 0x26, 0x03, 0x8b, 0x63, 0x1e, 0xa4,
+	0x42, 0x21, 0x7a, 0xeb, 0xf2, 0x90, 0xd5, 0x31, 0x5b, 0x6a, 0x7f, 0xcc, 0x41, 0x35, 0xd6, 0x8b,
+	0xb6, 0x61, 0xb3, 0x6b, 0x58, 0xa7, 0x23, 0xac, 0xf7, 0xc6, 0xc7, 0xa3, 0xc1, 0x37, 0x7a, 0x5f,
+	0x7d, 0x82, 0xea, 0x50, 0xed, 0x1a, 0x56, 0x57, 0x3f, 0x1e, 0x8c, 0xd4, 0x1c, 0xda, 0x80, 0x5a,
+	0xd7, 0xb0, 0x7a, 0xe3, 0xe1, 0x70, 0x60, 0xaa, 0x79, 0xb4, 0x09, 0x4a, 0xd7, 0xb0, 0xf0, 0xd8,
+	0x30, 0xba, 0x9d, 0xde, 0x89, 0x5a, 0x40, 0xbb, 0xb0, 0xd5, 0x35, 0xac, 0xfe, 0xd0, 0xb0, 0xfa,
+	0xfa, 0x04, 0xeb, 0xbd, 0x8e, 0xa9, 0xf7, 0xd5, 0x22, 0x02, 0x28, 0x33, 0x72, 0xdf, 0x50, 0x4b,
+	0x72, 0x3d, 0xd5, 0x4d, 0xb5, 0x2c, 0xd5, 0x0d, 0x46, 0x53, 0x1d, 0x9b, 0x6a, 0x45, 0x6e, 0x4f,
+	0x27, 0xfd, 0x8e, 0xa9, 0xab, 0x55, 0xb9, 0xed, 0xeb, 0x86, 0x6e, 0xea, 0x6a, 0xed, 0x55, 0xb1,
+	0x9a, 0x57, 0x0b, 0xaf, 0x8a, 0xd5, 0x82, 0x5a, 0xd4, 0xfe, 0x90, 0x83, 0xdd, 0x29, 0x0d, 0x89,
+	0xbd, 0x38, 0x21, 0x2b, 0x6c, 0x7b, 0x97, 0x04, 0x93, 0xb7, 0x4b, 0x12, 0x51, 0xf4, 0x14, 0xaa,
+	0x81, 0x1f, 0x39, 0x2c, 0x76, 0x3c, 0xc0, 0x35, 0x9c, 0xec, 0xd1, 0x11, 0xd4, 0xae, 0xc9, 0xca,
+	0x0a, 0x19, 0xbf, 0x0c, 0x18, 0x6a, 0x25, 0x05, 0x99, 0x68, 0xaa, 0x5e, 0xcb, 0x55, 0x36, 0xbe,
+	0x85, 0x87, 0xe3, 0xab, 0x5d, 0xc0, 0xde, 0x5d, 0xa3, 0xa2, 0xc0, 0xf7, 0x22, 0x82, 0x0c, 0x40,
+	0x42, 0xd0, 0xa2, 0x69, 0x6e, 0xb9, 0x7d, 0x4a, 0xfb, 0xc3, 0x77, 0x16, 0x00, 0xde, 0x3a, 0xbf,
+	0x4b, 0xd2, 0xbe, 0x85, 0x6d, 0x71, 0x8f, 0x69, 0x9f, 0xbb, 0x24, 0x7a, 0x8c, 0xeb, 0x7b, 0x50,
+	0xa6, 0x9c, 0xb9, 0x99, 0xdf, 0x2f, 0x1c, 0xd4, 0xb0, 0xdc, 0xbd, 0xaf, 0x87, 0x73, 0xd8, 0x59,
+	0xbf, 0xf9, 0xbf, 0xe2, 0xdf, 0x2f, 0xa0, 0x88, 0x97, 0x2e, 0x41, 0x3b, 0x50, 0x5a, 0xd8, 0x74,
+	0x76, 0x25, 0xbd, 0x11, 0x1b, 0xe6, 0xca, 0x85, 0xe3, 0x52, 0x12, 0xf2, 0x14, 0xd6, 0xb0, 0xdc,
+	0x69, 0x7f, 0xca, 0x41, 0xf9, 0x05, 0x5f, 0xa2, 0x4f, 0xa1, 0x14, 0x2e, 0x5d, 0x12, 0x63, 0x5d,
+	0xcd, 0x5a, 0xc0, 0x34, 0x63, 0x71, 0x8c, 0x06, 0xd0, 0xb8, 0x70, 0x88, 0x3b, 0xe7, 0xd0, 0x1d,
+	0xfa, 0x73, 0x51, 0x15, 0x8d, 0xf6, 0xc7, 0x59, 0x01, 0xa1, 0xb3, 0xf5, 0x62, 0x8d, 0x11, 0xdf,
+	0x11, 0xd4, 0x9e, 0x43, 0x63, 0x9d, 0x83, 0xc1, 0x49, 0xc7, 0xd8, 0x1a, 0x8f, 0xac, 0xe1, 0x60,
+	0x3a, 0xec, 0x98, 0xbd, 0x97, 0xea, 0x13, 0x8e, 0x18, 0x7d, 0x6a, 0x5a, 0xfa, 0x8b, 0x17, 0x63,
+	0x6c, 0xaa, 0x39, 0xed, 0x5f, 0x79, 0xa8, 0x8b, 0xa0, 0x4c, 0xfd, 0x65, 0x38, 0x23, 0x2c, 0x8b,
+	0xd7, 0x64, 0x15, 0x05, 0xf6, 0x8c, 0xc4, 0x59, 0x8c, 0xf7, 0x2c, 0x20, 0xd1, 0x95, 0x1d, 0xce,
+	0xa5, 0xe7, 0x62, 0x83, 0xbe, 0x00, 0x85, 0x67, 0x93, 0x5a, 0x74, 0x15, 0x10, 0x9e, 0xc7, 0x46,
+	0x7b, 0x27, 0x2d, 0x6c, 0x9e, 0x2b, 0x6a, 0xae, 0x02, 0x82, 0x81, 0x26, 0xeb, 0x75, 0x34, 0x14,
+	0x1f, 0x81, 0x86, 0xb4, 0x86, 0x4a, 0x6b, 0x35, 0x74, 0x98, 0x24, 0xa4, 0x2c, 0xb5, 0xdc, 0x8b,
+	0x5e, 0x9c, 0x24, 0xd4, 0x82, 0xb2, 0xef, 0x59, 0xf3, 0xb9, 0xdb, 0xac, 0x70, 0x33, 0x7f, 0x94,
+	0xe5, 0x1d, 0x7b, 0xfd, 0xbe, 0xd1, 0x11, 0x65, 0x51, 0xf2, 0xbd, 0xfe, 0xdc, 0x45, 0x9f, 0x40,
+	0x83, 0x7c, 0x4b, 0x49, 0xe8, 0xd9, 0xae, 0xb5, 0x58, 0xb1, 0xee, 0x55, 0xe5, 0xae, 0x6f, 0xc4,
+	0xd4, 0x21, 0x23, 0xa2, 0x4f, 0x61, 0x33, 0xa2, 0x7e, 0x60, 0xd9, 0x17, 0x94, 0x84, 0xd6, 0xcc,
+	0x0f, 0x56, 0xcd, 0xda, 0x7e, 0xee, 0xa0, 0x8a, 0x37, 0x18, 0xb9, 0xc3, 0xa8, 0x3d, 0x3f, 0x58,
+	0x69, 0xaf, 0xa1, 0x86, 0xfd, 0xdb, 0xde, 0x15, 0xf7, 0x47, 0x83, 0xf2, 0x39, 0xb9, 0xf0, 0x43,
+	0x22, 0x0b, 0x15, 0x64, 0x23, 0xc7, 0xfe, 0x2d, 0x96, 0x27, 0x68, 0x1f, 0x4a, 0x5c, 0x67, 0x33,
+	0x7f, 0x8f, 0x45, 0x1c, 0x68, 0x36, 0x54, 0xb1, 0x7f, 0xcb, 0xd3, 0x8e, 0x3e, 0x04, 0x11, 0x60,
+	0xcb, 0xb3, 0x17, 0x71, 0xf6, 0x6a, 0x9c, 0x32, 0xb2, 0x17, 0x04, 0x3d, 0x07, 0x25, 0xf4, 0x6f,
+	0xad, 0x19, 0xbf, 0x5e, 0x20, 0x51, 0x69, 0xef, 0xae, 0x15, 0x67, 0x6c, 0x1c, 0x86, 0x30, 0x5e,
+	0x46, 0xda, 0x6b, 0x80, 0xb4, 0xb6, 0x1e, 0xba, 0xe4, 0x27, 0x2c, 0x1b, 0xc4, 0x9d, 0xc7, 0xfa,
+	0xeb, 0xd2, 0x64, 0xae, 0x01, 0xcb, 0x33, 0xed, 0xf7, 0x39, 0xa8, 0x4d, 0x59, 0xf5, 0x1c, 0x53,
+	0x67, 0xfe, 0x03, 0x6a, 0x0e, 0x41, 0xf1, 0x92, 0x3a, 0x73, 0x5e, 0x6c, 0x35, 0xcc, 0xd7, 0xe8,
+	0x8b, 0xd8, 0xb0, 0xc0, 0xba, 0x8e, 0x9a, 0x45, 0x7e, 0xfb, 0x5a, 0x7e, 0x79, 0x21, 0x1a, 0x76,
+	0x44, 0x27, 0x27, 0xb8, 0xca, 0x59, 0x27, 0x27, 0x91, 0xf6, 0x15, 0x94, 0xce, 0xb8, 0x15, 0xcf,
+	0x41, 0xe1, 0xca, 0x2d, 0xa6, 0x2d, 0xc6, 0xee, 0x5a, 0x78, 0x12, 0x8b, 0x31, 0x44, 0xf1, 0x32,
+	0xd2, 0x3a, 0xb0, 0x71, 0x22, 0xad, 0xe5, 0x0c, 0xef, 0xef, 0x8e, 0xf6, 0x97, 0x3c, 0x54, 0x5e,
+	0xf9, 0x4b, 0x56, 0x50, 0xa8, 0x01, 0x79, 0x67, 0xce, 0xe5, 0x0a, 0x38, 0xef, 0xcc, 0xd1, 0x6f,
+	0xa0, 0xb1, 0x70, 0x2e, 0x43, 0x9b, 0x95, 0xa5, 0x40, 0x98, 0x68, 0x12, 0x3f, 0xce, 0x5a, 0x36,
+	0x8c, 0x39, 0x38, 0xcc, 0x36, 0x16, 0xd9, 0x6d, 0x06, 0x38, 0x85, 0x35, 0xe0, 0x7c, 0x02, 0x0d,
+	0xd7, 0x9f, 0xd9, 0xae, 0x95, 0xb4, 0xed, 0xa2, 0x28, 0x6e, 0x4e, 0x9d, 0x48, 0xe2, 0xdd, 0xb8,
+	0x94, 0x1e, 0x19, 0x17, 0xf4, 0x25, 0xd4, 0x03, 0x3b, 0xa4, 0xce, 0xcc, 0x09, 0x6c, 0x36, 0xf8,
+	0x94, 0xb9, 0xe0, 0x9a, 0xd9, 0x6b, 0x71, 0xc3, 0x6b, 0xec, 0xe8, 0x33, 0x50, 0x23, 0xde, 0x92,
+	0xac, 0x5b, 0x3f, 0xbc, 0xbe, 0x70, 0xfd, 0xdb, 0xa8, 0x59, 0xe1, 0xf6, 0x6f, 0x0a, 0xfa, 0x9b,
+	0x98, 0xac, 0xfd, 0xb9, 0x00, 0xe5, 0x33, 0x51, 0x9d, 0x87, 0x50, 0xe4, 0x31, 0x12, 0xc3, 0xcd,
+	0x5e, 0xf6, 0x32, 0xc1, 0xc1, 0x03, 0xc4, 0x79, 0xd0, 0x07, 0x50, 0xa3, 0xce, 0x82, 0x44, 0xd4,
+	0x5e, 0x04, 0x3c, 0xa8, 0x05, 0x9c, 0x12, 0xbe, 0xb3, 0xc4, 0x3e, 0x80, 0x5a, 0x32, 0x8e, 0xc9,
+	0x60, 0xa5, 0x04, 0xf4, 0x33, 0xa8, 0x31, 0x7c, 0xf1, 0xe1, 0xab, 0x59, 0xe2, 0x80, 0xdd, 0xb9,
+	0x83, 0x2e, 0x6e, 0x02, 0xae, 0x86, 0x72, 0x85, 0x7e, 0x09, 0x0a, 0x47, 0x84, 0x14, 0x12, 0x0d,
+	0x6c, 0x6f, 0xbd, 0x81, 0xc5, 0xc8, 0xc3, 0x90, 0xf6, 0x7c, 0xf4, 0x0c, 0x4a, 0x37, 0xdc, 0xbc,
+	0x8a, 0x1c, 0x02, 0xb3, 0x8e, 0xf2, 0x54, 0x88, 0x73, 0xf6, 0x85, 0xfd, 0xad, 0xa8, 0xac, 0x66,
+	0xf5, 0xfe, 0x17, 0x56, 0x16, 0x1d, 0x8e, 0x79, 0xd8, 0x8c, 0x36, 0x5f, 0xb8, 0xbc, 0x7b, 0xd5,
+	0x30, 0x5b, 0xa2, 0x8f, 0xa1, 0x3e, 0x5b, 0x86, 0x21, 0x1f, 0x3b, 0x9d, 0x05, 0x69, 0xee, 0xf0,
+	0x40, 0x29, 0x92, 0x66, 0x3a, 0x0b, 0x82, 0x7e, 0x05, 0x0d, 0xd7, 0x8e, 0x28, 0x03, 0x9e, 0x74,
+	0x64, 0x77, 0x3f, 0x77, 0x17, 0x7d, 0x02, 0x78, 0xc2, 0x13, 0xc5, 0x4d, 0x37, 0xda, 0x15, 0xd4,
+	0x87, 0x8e, 0xe7, 0x2c, 0x6c, 0x97, 0x03, 0x94, 0x05, 0x3e, 0xd3, 0x5a, 0x8a, 0xde, 0xa3, 0xbb,
+	0x0a, 0xfa, 0x08, 0x14, 0x66, 0xc2, 0xcc, 0x77, 0x97, 0x0b, 0x4f, 0x54, 0x7b, 0x01, 0xd7, 0x82,
+	0x93, 0x9e, 0x20, 0x30, 0xa4, 0xca, 0x9b, 0xa6, 0xb3, 0x2b, 0xb2, 0xb0, 0xd1, 0xe7, 0x09, 0x32,
+	0x04, 0xda, 0x9b, 0xeb, 0x98, 0x4a, 0x8d, 0x8a, 0x31, 0xa3, 0xfd, 0x3d, 0x0f, 0x8d, 0x33, 0x31,
+	0x83, 0xc4, 0x73, 0xcf, 0x57, 0xb0, 0x4d, 0x2e, 0x2e, 0xc8, 0x8c, 0x3a, 0x37, 0xc4, 0x9a, 0xd9,
+	0xae, 0x4b, 0x42, 0x4b, 0x22, 0x58, 0x69, 0x6f, 0xb6, 0xc4, 0x7f, 0x91, 0x1e, 0xa7, 0x0f, 0xfa,
+	0x78, 0x2b, 0xe1, 0x95, 0xa4, 0x39, 0xd2, 0x61, 0xdb, 0x59, 0x2c, 0xc8, 0xdc, 0xb1, 0x69, 0x56,
+	0x81, 0x68, 0xf9, 0xbb, 0xd2, 0xd3, 0x33, 0xf3, 0xd8, 0xa6, 0x24, 0x55, 0x93, 0x48, 0x24, 0x6a,
+	0x3e, 0x61, 0xce, 0x84, 0x97, 0xc9, 0x28, 0xb5, 0x21, 0x25, 0x4d, 0x4e, 0xc4, 0xf2, 0x70, 0x6d,
+	0x4c, 0x2b, 0xde, 0x19, 0xd3, 0xd2, 0x4f, 0x69, 0xe9, 0xc1, 0x4f, 0xe9, 0xaf, 0x61, 0x53, 0xb4,
+	0xdb, 0x38, 0xf5, 0x31, 0xc2, 0xbf, 0xb7, 0xe7, 0xd6, 0x69, 0xba, 0x89, 0xb4, 0x2f, 0x61, 0x33,
+	0x09, 0xa4, 0x1c, 0xe3, 0x0e, 0xa1, 0xcc, 0xcb, 0x27, 0x4e, 0x07, 0xba, 0x0f, 0x5f, 0x2c, 0x39,
+	0xb4, 0xdf, 0xe5, 0x01, 0xc5, 0xf2, 0xfe, 0x6d, 0xf4, 0x3f, 0x9a, 0x8c, 0x1d, 0x28, 0x71, 0xba,
+	0xcc, 0x84, 0xd8, 0xb0, 0x38, 0xb0, 0xa0, 0x06, 0xd7, 0x49, 0x1a, 0x84, 0xf0, 0x6b, 0xf6, 0x8b,
+	0x49, 0xb4, 0x74, 0x29, 0x96, 0x1c, 0xda, 0x5f, 0x73, 0xb0, 0xbd, 0x16, 0x07, 0x19, 0xcb, 0x14,
+	0x31, 0xb9, 0x77, 0x20, 0xe6, 0x00, 0xaa, 0xc1, 0xf5, 0x3b, 0x90, 0x95, 0x9c, 0x7e, 0x67, 0x3b,
+	0xfc, 0x08, 0x8a, 0xa1, 0x7f, 0x1b, 0x7f, 0x6b, 0xb3, 0xc3, 0x09, 0xa7, 0xb3, 0x09, 0x67, 0xcd,
+	0x8f, 0x2c, 0x47, 0x6c, 0xbf, 0x03, 0x4a, 0xa6, 0x33, 0xb0, 0x56, 0xb2, 0x5e, 0x55, 0x32, 0x75,
+	0xdf, 0x5b, 0x54, 0x4a, 0xa6, 0xa8, 0x58, 0x7f, 0x9e, 0xf9, 0x8b, 0xc0, 0x25, 0x94, 0x88, 0x94,
+	0x55, 0x71, 0x4a, 0xd0, 0xbe, 0x06, 0x25, 0x23, 0xf9, 0xd0, 0x20, 0x93, 0x26, 0xa1, 0xf0, 0x60,
+	0x12, 0xfe, 0x91, 0x83, 0xdd, 0xb4, 0x98, 0x97, 0x2e, 0xfd, 0xbf, 0xaa, 0x47, 0x2d, 0x84, 0xbd,
+	0xbb, 0xde, 0xbd, 0x57, 0x95, 0xfd, 0x80, 0xda, 0x39, 0x9c, 0x80, 0x92, 0x99, 0xc7, 0xd9, 0xdf,
+	0xf6, 0xc1, 0xf1, 0x68, 0x8c, 0x75, 0xf5, 0x09, 0xaa, 0x42, 0x71, 0x6a, 0x8e, 0x27, 0x6a, 0x8e,
+	0xad, 0xf4, 0xaf, 0xf5, 0x9e, 0x78, 0x0a, 0x60, 0x2b, 0x4b, 0x32, 0x15, 0x90, 0x0a, 0x75, 0x4e,
+	0xe8, 0x8d, 0x8d, 0xd3, 0xe1, 0x68, 0xaa, 0x16, 0x0f, 0xff, 0x9d, 0x03, 0x48, 0x67, 0x00, 0xa4,
+	0x40, 0xe5, 0x74, 0x74, 0x32, 0x1a, 0xbf, 0x19, 0x09, 0x95, 0xc7, 0xe6, 0xa0, 0xaf, 0xe6, 0x50,
+	0x0d, 0x4a, 0xe2, 0xb5, 0x21, 0xcf, 0xee, 0x94, 0x4f, 0x0d, 0x05, 0xf6, 0x0e, 0x91, 0xbc, 0x33,
+	0x14, 0x51, 0x05, 0x0a, 0xc9, 0x6b, 0x82, 0x7c, 0x3e, 0x28, 0x33, 0x85, 0x58, 0x9f, 0x18, 0x9d,
+	0x9e, 0xae, 0x56, 0xd8, 0x41, 0xf2, 0x90, 0x00, 0x50, 0x8e, 0x5f, 0x11, 0x98, 0x24, 0x7b, 0x7b,
+	0x00, 0x76, 0xcf, 0xd8, 0x7c, 0xa9, 0x63, 0x55, 0x61, 0x34, 0x3c, 0x7e, 0xa3, 0xd6, 0x19, 0xed,
+	0xc5, 0x40, 0x37, 0xfa, 0xea, 0x06, 0x7b, 0x7c, 0x78, 0xa9, 0x77, 0xb0, 0xd9, 0xd5, 0x3b, 0xa6,
+	0xda, 0x60, 0x27, 0x67, 0xdc, 0xc0, 0x4d, 0x76, 0xcd, 0xab, 0xf1, 0x29, 0x1e, 0x75, 0x0c, 0x55,
+	0x65, 0x9b, 0x33, 0x1d, 0x4f, 0x07, 0xe3, 0x91, 0xba, 0xc5, 0xee, 0x31, 0x3a, 0x53, 0x73, 0x72,
+	0xa2, 0x22, 0x26, 0x3f, 0xed, 0x9c, 0xe9, 0x93, 0xf1, 0x60, 0x64, 0xaa, 0xdb, 0x87, 0xcf, 0xd8,
+	0x97, 0x2f, 0x3b, 0x13, 0x02, 0x94, 0xcd, 0x4e, 0xd7, 0xd0, 0xa7, 0xea, 0x13, 0xb6, 0x9e, 0xbe,
+	0xec, 0xe0, 0xfe, 0x54, 0xcd, 0x75, 0x3f, 0xfb, 0xe6, 0xd9, 0x8d, 0x43, 0x49, 0x14, 0xb5, 0x1c,
+	0xff, 0x48, 0xac, 0x8e, 0x2e, 0xfd, 0xa3, 0x1b, 0x7a, 0xc4, 0x1f, 0xcc, 0x8e, 0x52, 0x14, 0x9e,
+	0x97, 0x39, 0xe5, 0xe7, 0xff, 0x19, 0x00, 0xe6, 0x79, 0x45, 0x0c, 0x8c, 0x13, 0x00, 0x00,
 }
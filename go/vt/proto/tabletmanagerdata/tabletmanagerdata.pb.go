@@ -12,6 +12,7 @@ import (
 	query "vitess.io/vitess/go/vt/proto/query"
 	replicationdata "vitess.io/vitess/go/vt/proto/replicationdata"
 	topodata "vitess.io/vitess/go/vt/proto/topodata"
+	vttime "vitess.io/vitess/go/vt/proto/vttime"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3641,9 +3642,15 @@ func (m *BackupResponse) GetEvent() *logutil.Event {
 }
 
 type RestoreFromBackupRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	// restore_to_pos, if set, is the GTID position to stop the point in
+	// time recovery at. Binlog backups are replayed up to this position.
+	RestoreToPos string `protobuf:"bytes,1,opt,name=restore_to_pos,json=restoreToPos,proto3" json:"restore_to_pos,omitempty"`
+	// restore_to_time, if set, is the time (in UTC) to stop the point in
+	// time recovery at. Transactions committed after it are not replayed.
+	RestoreToTime        *vttime.Time `protobuf:"bytes,2,opt,name=restore_to_time,json=restoreToTime,proto3" json:"restore_to_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *RestoreFromBackupRequest) Reset()         { *m = RestoreFromBackupRequest{} }
@@ -3671,6 +3678,20 @@ func (m *RestoreFromBackupRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RestoreFromBackupRequest proto.InternalMessageInfo
 
+func (m *RestoreFromBackupRequest) GetRestoreToPos() string {
+	if m != nil {
+		return m.RestoreToPos
+	}
+	return ""
+}
+
+func (m *RestoreFromBackupRequest) GetRestoreToTime() *vttime.Time {
+	if m != nil {
+		return m.RestoreToTime
+	}
+	return nil
+}
+
 type RestoreFromBackupResponse struct {
 	Event                *logutil.Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
@@ -4494,148 +4515,150 @@ func init() {
 func init() { proto.RegisterFile("tabletmanagerdata.proto", fileDescriptor_ff9ac4f89e61ffa4) }
 
 var fileDescriptor_ff9ac4f89e61ffa4 = []byte{
-	// 2276 bytes of a gzipped FileDescriptorProto
+	// 2315 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xdd, 0x72, 0xdb, 0xc6,
-	0x15, 0x1e, 0x50, 0x3f, 0x96, 0x0e, 0x7f, 0x44, 0x81, 0x94, 0x44, 0x51, 0xb1, 0x2c, 0xc3, 0x4e,
-	0xe2, 0x26, 0x53, 0x2a, 0x51, 0x12, 0x4f, 0x26, 0xfd, 0x99, 0xca, 0xb6, 0x64, 0x3b, 0x96, 0x63,
-	0x05, 0xb2, 0xe3, 0x4c, 0xa6, 0x53, 0x0c, 0x48, 0xac, 0x28, 0x8c, 0x40, 0x2c, 0xbc, 0xbb, 0xa0,
+	0x15, 0x1e, 0x52, 0x3f, 0x96, 0x0e, 0x7f, 0x44, 0x81, 0x94, 0x08, 0x51, 0xb1, 0x2c, 0xc3, 0x4e,
+	0xe2, 0x26, 0x53, 0x2a, 0x91, 0x1d, 0x4f, 0x26, 0xfd, 0x99, 0xca, 0xb6, 0x64, 0x3b, 0x96, 0x63,
+	0x05, 0xb2, 0xe3, 0x4c, 0xa6, 0x53, 0x0c, 0x48, 0xac, 0x28, 0x8c, 0x40, 0x2c, 0xbc, 0xbb, 0xa4,
 	0xc4, 0x9b, 0x3e, 0x42, 0xfb, 0x02, 0x9d, 0xde, 0x74, 0xa6, 0xbd, 0xef, 0x43, 0xf4, 0x11, 0xd2,
-	0x47, 0xe9, 0x45, 0x2f, 0xda, 0xd9, 0xdd, 0x03, 0x12, 0x20, 0x20, 0x59, 0x76, 0xdd, 0x99, 0xdc,
-	0x68, 0xb0, 0xdf, 0xd9, 0xf3, 0xbb, 0x67, 0xcf, 0x39, 0x4b, 0xc1, 0x9a, 0x70, 0xbb, 0x01, 0x11,
-	0x03, 0x37, 0x74, 0xfb, 0x84, 0x79, 0xae, 0x70, 0x3b, 0x11, 0xa3, 0x82, 0x9a, 0xcb, 0x39, 0x42,
-	0xbb, 0xfc, 0x2a, 0x26, 0x6c, 0xa4, 0xe9, 0xed, 0x9a, 0xa0, 0x11, 0x9d, 0xec, 0x6f, 0xaf, 0x30,
-	0x12, 0x05, 0x7e, 0xcf, 0x15, 0x3e, 0x0d, 0x53, 0x70, 0x35, 0xa0, 0xfd, 0x58, 0xf8, 0x81, 0x5e,
-	0x5a, 0xff, 0x31, 0x60, 0xe9, 0xb9, 0x14, 0xfc, 0x80, 0x1c, 0xfb, 0xa1, 0x2f, 0x37, 0x9b, 0x26,
-	0xcc, 0x86, 0xee, 0x80, 0xb4, 0x8c, 0x2d, 0xe3, 0xce, 0xa2, 0xad, 0xbe, 0xcd, 0x55, 0x98, 0xe7,
-	0xbd, 0x13, 0x32, 0x70, 0x5b, 0x25, 0x85, 0xe2, 0xca, 0x6c, 0xc1, 0xb5, 0x1e, 0x0d, 0xe2, 0x41,
-	0xc8, 0x5b, 0x33, 0x5b, 0x33, 0x77, 0x16, 0xed, 0x64, 0x69, 0x76, 0xa0, 0x11, 0x31, 0x7f, 0xe0,
-	0xb2, 0x91, 0x73, 0x4a, 0x46, 0x4e, 0xb2, 0x6b, 0x56, 0xed, 0x5a, 0x46, 0xd2, 0x13, 0x32, 0xba,
-	0x8f, 0xfb, 0x4d, 0x98, 0x15, 0xa3, 0x88, 0xb4, 0xe6, 0xb4, 0x56, 0xf9, 0x6d, 0xde, 0x80, 0xb2,
-	0x34, 0xdd, 0x09, 0x48, 0xd8, 0x17, 0x27, 0xad, 0xf9, 0x2d, 0xe3, 0xce, 0xac, 0x0d, 0x12, 0x3a,
-	0x50, 0x88, 0xb9, 0x01, 0x8b, 0x8c, 0x9e, 0x39, 0x3d, 0x1a, 0x87, 0xa2, 0x75, 0x4d, 0x91, 0x17,
-	0x18, 0x3d, 0xbb, 0x2f, 0xd7, 0xe6, 0x6d, 0x98, 0x3f, 0xf6, 0x49, 0xe0, 0xf1, 0xd6, 0xc2, 0xd6,
-	0xcc, 0x9d, 0xf2, 0x4e, 0xa5, 0xa3, 0xe3, 0xb5, 0x2f, 0x41, 0x1b, 0x69, 0xd6, 0x5f, 0x0d, 0xa8,
-	0x1f, 0x29, 0x67, 0x52, 0x21, 0xf8, 0x10, 0x96, 0xa4, 0x96, 0xae, 0xcb, 0x89, 0x83, 0x7e, 0xeb,
-	0x68, 0xd4, 0x12, 0x58, 0xb3, 0x98, 0xcf, 0x40, 0x9f, 0x8b, 0xe3, 0x8d, 0x99, 0x79, 0xab, 0xa4,
-	0xd4, 0x59, 0x9d, 0xfc, 0x51, 0x4e, 0x85, 0xda, 0xae, 0x8b, 0x2c, 0xc0, 0x65, 0x40, 0x87, 0x84,
-	0x71, 0x9f, 0x86, 0xad, 0x19, 0xa5, 0x31, 0x59, 0x4a, 0x43, 0x4d, 0xad, 0xf5, 0xfe, 0x89, 0x1b,
-	0xf6, 0x89, 0x4d, 0x78, 0x1c, 0x08, 0xf3, 0x11, 0x54, 0xbb, 0xe4, 0x98, 0xb2, 0x8c, 0xa1, 0xe5,
-	0x9d, 0x5b, 0x05, 0xda, 0xa7, 0xdd, 0xb4, 0x2b, 0x9a, 0x13, 0x7d, 0xd9, 0x87, 0x8a, 0x7b, 0x2c,
-	0x08, 0x73, 0x52, 0x27, 0x7d, 0x45, 0x41, 0x65, 0xc5, 0xa8, 0x61, 0xeb, 0x5f, 0x06, 0xd4, 0x5e,
-	0x70, 0xc2, 0x0e, 0x09, 0x1b, 0xf8, 0x9c, 0x63, 0x4a, 0x9d, 0x50, 0x2e, 0x92, 0x94, 0x92, 0xdf,
-	0x12, 0x8b, 0x39, 0x61, 0x98, 0x50, 0xea, 0xdb, 0xfc, 0x18, 0x96, 0x23, 0x97, 0xf3, 0x33, 0xca,
-	0x3c, 0xa7, 0x77, 0x42, 0x7a, 0xa7, 0x3c, 0x1e, 0xa8, 0x38, 0xcc, 0xda, 0xf5, 0x84, 0x70, 0x1f,
-	0x71, 0xf3, 0x5b, 0x80, 0x88, 0xf9, 0x43, 0x3f, 0x20, 0x7d, 0xa2, 0x13, 0xab, 0xbc, 0xf3, 0x69,
-	0x81, 0xb5, 0x59, 0x5b, 0x3a, 0x87, 0x63, 0x9e, 0xbd, 0x50, 0xb0, 0x91, 0x9d, 0x12, 0xd2, 0xfe,
-	0x15, 0x2c, 0x4d, 0x91, 0xcd, 0x3a, 0xcc, 0x9c, 0x92, 0x11, 0x5a, 0x2e, 0x3f, 0xcd, 0x26, 0xcc,
-	0x0d, 0xdd, 0x20, 0x26, 0x68, 0xb9, 0x5e, 0x7c, 0x55, 0xfa, 0xd2, 0xb0, 0x7e, 0x34, 0xa0, 0xf2,
-	0xa0, 0xfb, 0x1a, 0xbf, 0x6b, 0x50, 0xf2, 0xba, 0xc8, 0x5b, 0xf2, 0xba, 0xe3, 0x38, 0xcc, 0xa4,
-	0xe2, 0xf0, 0xac, 0xc0, 0xb5, 0xed, 0x02, 0xd7, 0xd2, 0xca, 0xfe, 0x9f, 0x8e, 0xfd, 0xc5, 0x80,
-	0xf2, 0x44, 0x13, 0x37, 0x0f, 0xa0, 0x2e, 0xed, 0x74, 0xa2, 0x09, 0xd6, 0x32, 0x94, 0x95, 0x37,
-	0x5f, 0x7b, 0x00, 0xf6, 0x52, 0x9c, 0x59, 0x73, 0x73, 0x1f, 0x6a, 0x5e, 0x37, 0x23, 0x4b, 0xdf,
-	0xa0, 0x1b, 0xaf, 0xf1, 0xd8, 0xae, 0x7a, 0xa9, 0x15, 0xb7, 0x3e, 0x84, 0xf2, 0xa1, 0x1f, 0xf6,
-	0x6d, 0xf2, 0x2a, 0x26, 0x5c, 0xc8, 0xab, 0x14, 0xb9, 0xa3, 0x80, 0xba, 0x1e, 0x3a, 0x99, 0x2c,
-	0xad, 0x3b, 0x50, 0xd1, 0x1b, 0x79, 0x44, 0x43, 0x4e, 0x2e, 0xd9, 0xf9, 0x11, 0x54, 0x8e, 0x02,
-	0x42, 0xa2, 0x44, 0x66, 0x1b, 0x16, 0xbc, 0x98, 0xa9, 0xa2, 0xaa, 0xb6, 0xce, 0xd8, 0xe3, 0xb5,
-	0xb5, 0x04, 0x55, 0xdc, 0xab, 0xc5, 0x5a, 0xff, 0x34, 0xc0, 0xdc, 0x3b, 0x27, 0xbd, 0x58, 0x90,
-	0x47, 0x94, 0x9e, 0x26, 0x32, 0x8a, 0xea, 0xeb, 0x26, 0x40, 0xe4, 0x32, 0x77, 0x40, 0x04, 0x61,
-	0xda, 0xfd, 0x45, 0x3b, 0x85, 0x98, 0x87, 0xb0, 0x48, 0xce, 0x05, 0x73, 0x1d, 0x12, 0x0e, 0x55,
-	0xa5, 0x2d, 0xef, 0x7c, 0x56, 0x10, 0x9d, 0xbc, 0xb6, 0xce, 0x9e, 0x64, 0xdb, 0x0b, 0x87, 0x3a,
-	0x27, 0x16, 0x08, 0x2e, 0xdb, 0xbf, 0x80, 0x6a, 0x86, 0xf4, 0x46, 0xf9, 0x70, 0x0c, 0x8d, 0x8c,
-	0x2a, 0x8c, 0xe3, 0x0d, 0x28, 0x93, 0x73, 0x5f, 0x38, 0x5c, 0xb8, 0x22, 0xe6, 0x18, 0x20, 0x90,
-	0xd0, 0x91, 0x42, 0x54, 0x1b, 0x11, 0x1e, 0x8d, 0xc5, 0xb8, 0x8d, 0xa8, 0x15, 0xe2, 0x84, 0x25,
-	0xb7, 0x00, 0x57, 0xd6, 0x10, 0xea, 0x0f, 0x89, 0xd0, 0x75, 0x25, 0x09, 0xdf, 0x2a, 0xcc, 0x2b,
-	0xc7, 0x75, 0xc6, 0x2d, 0xda, 0xb8, 0x32, 0x6f, 0x41, 0xd5, 0x0f, 0x7b, 0x41, 0xec, 0x11, 0x67,
-	0xe8, 0x93, 0x33, 0xae, 0x54, 0x2c, 0xd8, 0x15, 0x04, 0xbf, 0x93, 0x98, 0xf9, 0x3e, 0xd4, 0xc8,
-	0xb9, 0xde, 0x84, 0x42, 0x74, 0xdb, 0xaa, 0x22, 0xaa, 0x0a, 0x34, 0xb7, 0x08, 0x2c, 0xa7, 0xf4,
-	0xa2, 0x77, 0x87, 0xb0, 0xac, 0x2b, 0x63, 0xaa, 0xd8, 0xbf, 0x49, 0xb5, 0xad, 0xf3, 0x29, 0xc4,
-	0x5a, 0x83, 0x95, 0x87, 0x44, 0xa4, 0x52, 0x18, 0x7d, 0xb4, 0x7e, 0x80, 0xd5, 0x69, 0x02, 0x1a,
-	0xf1, 0x1b, 0x28, 0x67, 0x2f, 0x9d, 0x54, 0xbf, 0x59, 0xa0, 0x3e, 0xcd, 0x9c, 0x66, 0xb1, 0x9a,
-	0x60, 0x1e, 0x11, 0x61, 0x13, 0xd7, 0x7b, 0x16, 0x06, 0xa3, 0x44, 0xe3, 0x0a, 0x34, 0x32, 0x28,
-	0xa6, 0xf0, 0x04, 0x7e, 0xc9, 0x7c, 0x41, 0x92, 0xdd, 0xab, 0xd0, 0xcc, 0xc2, 0xb8, 0xfd, 0x6b,
-	0x58, 0xd6, 0xcd, 0xe9, 0xf9, 0x28, 0x4a, 0x36, 0x9b, 0x5f, 0x40, 0x59, 0x9b, 0xe7, 0xa8, 0x06,
-	0x2f, 0x4d, 0xae, 0xed, 0x34, 0x3b, 0xe3, 0x79, 0x45, 0xc5, 0x5c, 0x28, 0x0e, 0x10, 0xe3, 0x6f,
-	0x69, 0x67, 0x5a, 0xd6, 0xc4, 0x20, 0x9b, 0x1c, 0x33, 0xc2, 0x4f, 0x64, 0x4a, 0xa5, 0x0d, 0xca,
-	0xc2, 0xb8, 0x7d, 0x0d, 0x56, 0xec, 0x38, 0x7c, 0x44, 0xdc, 0x40, 0x9c, 0xa8, 0xc6, 0x91, 0x30,
-	0xb4, 0x60, 0x75, 0x9a, 0x80, 0x2c, 0x9f, 0x43, 0xeb, 0x71, 0x3f, 0xa4, 0x8c, 0x68, 0xe2, 0x1e,
-	0x63, 0x94, 0x65, 0x4a, 0x8a, 0x10, 0x84, 0x85, 0x93, 0x42, 0xa1, 0x96, 0xd6, 0x06, 0xac, 0x17,
-	0x70, 0xa1, 0xc8, 0xaf, 0xa4, 0xd1, 0xb2, 0x9e, 0x64, 0x33, 0xf9, 0x16, 0x54, 0xcf, 0x5c, 0x5f,
-	0x38, 0x11, 0xe5, 0x93, 0x64, 0x5a, 0xb4, 0x2b, 0x12, 0x3c, 0x44, 0x4c, 0x7b, 0x96, 0xe6, 0x45,
-	0x99, 0x3b, 0xb0, 0x7a, 0xc8, 0xc8, 0x71, 0xe0, 0xf7, 0x4f, 0xa6, 0x2e, 0x88, 0x9c, 0xc9, 0x54,
-	0xe0, 0x92, 0x1b, 0x92, 0x2c, 0xad, 0x3e, 0xac, 0xe5, 0x78, 0x30, 0xaf, 0x0e, 0xa0, 0xa6, 0x77,
-	0x39, 0x4c, 0xcd, 0x15, 0x49, 0x3d, 0x7f, 0xff, 0xc2, 0xcc, 0x4e, 0x4f, 0x21, 0x76, 0xb5, 0x97,
-	0x5a, 0x71, 0xeb, 0xdf, 0x06, 0x98, 0xbb, 0x51, 0x14, 0x8c, 0xb2, 0x96, 0xd5, 0x61, 0x86, 0xbf,
-	0x0a, 0x92, 0x12, 0xc3, 0x5f, 0x05, 0xb2, 0xc4, 0x1c, 0x53, 0xd6, 0x23, 0x78, 0x59, 0xf5, 0x42,
-	0x8e, 0x01, 0x6e, 0x10, 0xd0, 0x33, 0x27, 0x35, 0xc3, 0xaa, 0xca, 0xb0, 0x60, 0xd7, 0x15, 0xc1,
-	0x9e, 0xe0, 0xf9, 0x01, 0x68, 0xf6, 0x5d, 0x0d, 0x40, 0x73, 0x6f, 0x39, 0x00, 0xfd, 0xcd, 0x80,
-	0x46, 0xc6, 0x7b, 0x8c, 0xf1, 0x4f, 0x6f, 0x54, 0x6b, 0xc0, 0xf2, 0x01, 0xed, 0x9d, 0xea, 0xaa,
-	0x97, 0x5c, 0x8d, 0x26, 0x98, 0x69, 0x70, 0x72, 0xf1, 0x5e, 0x84, 0x41, 0x6e, 0xf3, 0x2a, 0x34,
-	0xb3, 0x30, 0x6e, 0xff, 0xbb, 0x01, 0x2d, 0x6c, 0x11, 0xfb, 0x44, 0xf4, 0x4e, 0x76, 0xf9, 0x83,
-	0xee, 0x38, 0x0f, 0x9a, 0x30, 0xa7, 0x46, 0x71, 0x15, 0x80, 0x8a, 0xad, 0x17, 0xe6, 0x1a, 0x5c,
-	0xf3, 0xba, 0x8e, 0x6a, 0x8d, 0xd8, 0x1d, 0xbc, 0xee, 0x37, 0xb2, 0x39, 0xae, 0xc3, 0xc2, 0xc0,
-	0x3d, 0x77, 0x18, 0x3d, 0xe3, 0x38, 0x0c, 0x5e, 0x1b, 0xb8, 0xe7, 0x36, 0x3d, 0xe3, 0x6a, 0x50,
-	0xf7, 0xb9, 0x9a, 0xc0, 0xbb, 0x7e, 0x18, 0xd0, 0x3e, 0x57, 0xc7, 0xbf, 0x60, 0xd7, 0x10, 0xbe,
-	0xa7, 0x51, 0x79, 0xd7, 0x98, 0xba, 0x46, 0xe9, 0xc3, 0x5d, 0xb0, 0x2b, 0x2c, 0x75, 0xb7, 0xac,
-	0x87, 0xb0, 0x5e, 0x60, 0x33, 0x9e, 0xde, 0x47, 0x30, 0xaf, 0xaf, 0x06, 0x1e, 0x9b, 0x89, 0xcf,
-	0x89, 0x6f, 0xe5, 0x5f, 0xbc, 0x06, 0xb8, 0xc3, 0xfa, 0x83, 0x01, 0xd7, 0xb3, 0x92, 0x76, 0x83,
-	0x40, 0x0e, 0x60, 0xfc, 0xdd, 0x87, 0x20, 0xe7, 0xd9, 0x6c, 0x81, 0x67, 0x07, 0xb0, 0x79, 0x91,
-	0x3d, 0x6f, 0xe1, 0xde, 0x93, 0xe9, 0xb3, 0xdd, 0x8d, 0xa2, 0xcb, 0x1d, 0x4b, 0xdb, 0x5f, 0xca,
-	0xd8, 0x9f, 0x0f, 0xba, 0x12, 0xf6, 0x16, 0x56, 0xb5, 0xa1, 0x95, 0xaa, 0x0b, 0x7a, 0xe2, 0x48,
-	0xd2, 0xf4, 0x00, 0xd6, 0x0b, 0x68, 0xa8, 0x64, 0x5b, 0x4e, 0x1f, 0xe3, 0x89, 0xa5, 0xbc, 0xb3,
-	0xd6, 0x99, 0x7e, 0x3b, 0x23, 0x03, 0x6e, 0x93, 0x77, 0xe1, 0xa9, 0xcb, 0xe5, 0x35, 0xca, 0x28,
-	0x79, 0x0a, 0xcd, 0x2c, 0x8c, 0xf2, 0xbf, 0x98, 0x92, 0x7f, 0x3d, 0x27, 0x3f, 0xc3, 0x96, 0x68,
-	0x59, 0x83, 0x15, 0x8d, 0x27, 0xbd, 0x20, 0xd1, 0xf3, 0x39, 0xac, 0x4e, 0x13, 0x50, 0x53, 0x1b,
-	0x16, 0xa6, 0x9a, 0xc9, 0x78, 0x2d, 0xb9, 0x5e, 0xba, 0xbe, 0xd8, 0xa7, 0xd3, 0xf2, 0x2e, 0xe5,
-	0x5a, 0x87, 0xb5, 0x1c, 0x17, 0x5e, 0xf1, 0x16, 0xac, 0x1e, 0x09, 0x1a, 0xa5, 0xe2, 0x9a, 0x18,
-	0xb8, 0x0e, 0x6b, 0x39, 0x0a, 0x32, 0xfd, 0x0e, 0xae, 0x4f, 0x91, 0x9e, 0xfa, 0xa1, 0x3f, 0x88,
-	0x07, 0x57, 0x30, 0xc6, 0xbc, 0x09, 0xaa, 0x37, 0x3a, 0xc2, 0x1f, 0x90, 0x64, 0x88, 0x9c, 0xb1,
-	0xcb, 0x12, 0x7b, 0xae, 0x21, 0xeb, 0x97, 0xb0, 0x79, 0x91, 0xfc, 0x2b, 0xc4, 0x48, 0x19, 0xee,
-	0x32, 0x51, 0xe0, 0x53, 0x1b, 0x5a, 0x79, 0x12, 0x3a, 0xd5, 0x85, 0x9b, 0xd3, 0xb4, 0x17, 0xa1,
-	0xf0, 0x83, 0x5d, 0x59, 0x6a, 0xdf, 0x91, 0x63, 0xb7, 0xc1, 0xba, 0x4c, 0x07, 0x5a, 0xd2, 0x04,
-	0xf3, 0x21, 0x49, 0xf6, 0x8c, 0x13, 0xf3, 0x63, 0x68, 0x64, 0x50, 0x8c, 0x44, 0x13, 0xe6, 0x5c,
-	0xcf, 0x63, 0xc9, 0x98, 0xa0, 0x17, 0x32, 0x06, 0x36, 0xe1, 0xe4, 0x82, 0x18, 0xe4, 0x49, 0xa8,
-	0x79, 0x1b, 0xd6, 0xbe, 0x4b, 0xe1, 0xf2, 0x4a, 0x17, 0x96, 0x84, 0x45, 0x2c, 0x09, 0xd6, 0x3e,
-	0xb4, 0xf2, 0x0c, 0x6f, 0x55, 0x8c, 0xae, 0xa7, 0xe5, 0x4c, 0xb2, 0x35, 0x51, 0x5f, 0x83, 0x92,
-	0xef, 0xe1, 0x63, 0xa4, 0xe4, 0x7b, 0x99, 0x83, 0x28, 0x4d, 0x25, 0xc0, 0x16, 0x6c, 0x5e, 0x24,
-	0x0c, 0xfd, 0x6c, 0xc0, 0xf2, 0xe3, 0xd0, 0x17, 0xfa, 0x02, 0x26, 0x81, 0xf9, 0x04, 0xcc, 0x34,
-	0x78, 0x85, 0x4c, 0xfb, 0xd1, 0x80, 0xcd, 0x43, 0x1a, 0xc5, 0x81, 0x9a, 0x56, 0x23, 0x97, 0x91,
-	0x50, 0x7c, 0x4d, 0x63, 0x16, 0xba, 0x41, 0x62, 0xf7, 0x07, 0xb0, 0x24, 0xf3, 0xc1, 0xe9, 0x31,
-	0xe2, 0x0a, 0xe2, 0x39, 0x61, 0xf2, 0xa2, 0xaa, 0x4a, 0xf8, 0xbe, 0x46, 0xbf, 0xe1, 0xf2, 0xd5,
-	0xe5, 0xf6, 0xa4, 0xd0, 0x74, 0xe3, 0x00, 0x0d, 0xa9, 0xe6, 0xf1, 0x25, 0x54, 0x06, 0xca, 0x32,
-	0xc7, 0x0d, 0x7c, 0x57, 0x37, 0x90, 0xf2, 0xce, 0xca, 0xf4, 0x04, 0xbe, 0x2b, 0x89, 0x76, 0x59,
-	0x6f, 0x55, 0x0b, 0xf3, 0x53, 0x68, 0xa6, 0x4a, 0xd5, 0x64, 0x50, 0x9d, 0x55, 0x3a, 0x1a, 0x29,
-	0xda, 0x78, 0x5e, 0xbd, 0x09, 0x37, 0x2e, 0xf4, 0x0b, 0x43, 0xf8, 0x67, 0x43, 0x87, 0x0b, 0x03,
-	0x9d, 0xf8, 0xfb, 0x73, 0x98, 0xd7, 0xfb, 0xf1, 0xd0, 0x2f, 0x30, 0x10, 0x37, 0x5d, 0x68, 0x5b,
-	0xe9, 0x42, 0xdb, 0x8a, 0x22, 0x3a, 0x53, 0x10, 0x51, 0x59, 0xdf, 0x33, 0xf6, 0x4d, 0x46, 0xa0,
-	0x07, 0x64, 0x40, 0x05, 0xc9, 0x1e, 0xfe, 0x1f, 0x0d, 0x68, 0x66, 0x71, 0x3c, 0xff, 0xcf, 0xa0,
-	0xe1, 0x91, 0x88, 0x91, 0x9e, 0x52, 0x96, 0x4d, 0x85, 0x7b, 0xa5, 0x96, 0x61, 0x9b, 0x13, 0xf2,
-	0xd8, 0xc6, 0x7b, 0x50, 0xc5, 0xc3, 0xc2, 0x9e, 0x51, 0xba, 0x4a, 0xcf, 0xc0, 0x03, 0xd6, 0x2b,
-	0x79, 0x85, 0x5f, 0x84, 0x1e, 0x2d, 0x32, 0xb6, 0x0d, 0xad, 0x3c, 0x09, 0xfd, 0xdb, 0x18, 0x37,
-	0xc9, 0x97, 0x2e, 0x3f, 0x64, 0x54, 0x6e, 0xf1, 0x12, 0xc6, 0xf7, 0xa0, 0x5d, 0x44, 0x44, 0xd6,
-	0x7f, 0x18, 0x50, 0x3f, 0x22, 0xd9, 0x5b, 0xf1, 0xa6, 0x07, 0x5a, 0x70, 0x3a, 0xa5, 0xa2, 0x7c,
-	0xbf, 0x0b, 0x6b, 0xea, 0x99, 0x20, 0x03, 0xc4, 0x44, 0xc1, 0x1b, 0x61, 0x45, 0x91, 0xa7, 0xab,
-	0x65, 0xfe, 0xb9, 0x35, 0x5b, 0xf0, 0xdc, 0x6a, 0xc0, 0x72, 0xca, 0x0f, 0xf4, 0xee, 0x49, 0xda,
-	0x77, 0x9b, 0x28, 0xbd, 0xe3, 0xc8, 0xbc, 0xa1, 0x9b, 0xd6, 0x75, 0xd8, 0x28, 0x14, 0x86, 0xba,
-	0x7e, 0x2f, 0xeb, 0x7c, 0xa6, 0x81, 0xed, 0x86, 0xde, 0x43, 0x22, 0x32, 0xa3, 0x86, 0xf9, 0x3d,
-	0xac, 0x70, 0x41, 0xa3, 0xb4, 0xf3, 0xce, 0x80, 0x7a, 0xc9, 0xeb, 0xfa, 0x76, 0xc1, 0x04, 0x93,
-	0x6d, 0x8a, 0xd4, 0x23, 0x76, 0x83, 0xe7, 0x41, 0xf9, 0x78, 0xb9, 0x75, 0xa9, 0x01, 0xe3, 0x1f,
-	0x22, 0xaa, 0x27, 0xa3, 0x2e, 0xf3, 0x3d, 0xe7, 0x4a, 0xb3, 0x93, 0xca, 0xf7, 0x8a, 0xe6, 0xc0,
-	0x1f, 0x83, 0x7e, 0x3d, 0x1e, 0x8b, 0x74, 0x8a, 0x7f, 0xf0, 0x3a, 0xa3, 0xf3, 0xf3, 0x11, 0xe6,
-	0x61, 0xb6, 0x90, 0xc8, 0x49, 0x67, 0x9a, 0x70, 0x85, 0x8a, 0x7c, 0x04, 0xd5, 0x7b, 0x6e, 0xef,
-	0x34, 0x1e, 0x4f, 0xb2, 0x5b, 0x50, 0xee, 0xd1, 0xb0, 0x17, 0x33, 0x46, 0xc2, 0xde, 0x08, 0x6b,
-	0x6f, 0x1a, 0x92, 0x3b, 0xd4, 0x73, 0x54, 0xa7, 0x0b, 0xbe, 0x61, 0xd3, 0x90, 0x75, 0x17, 0x6a,
-	0x89, 0x50, 0x34, 0xe1, 0x36, 0xcc, 0x91, 0xe1, 0x24, 0x59, 0x6a, 0x9d, 0xe4, 0x1f, 0x32, 0x7b,
-	0x12, 0xb5, 0x35, 0x11, 0x3b, 0xad, 0xa0, 0x8c, 0xec, 0x33, 0x3a, 0xc8, 0xd8, 0x65, 0xed, 0xca,
-	0x6b, 0x9a, 0xa3, 0xbd, 0x91, 0xf8, 0x26, 0x98, 0x47, 0x81, 0x3b, 0x24, 0xd9, 0xf9, 0x75, 0x1f,
-	0x1a, 0x19, 0xf4, 0x6d, 0xc7, 0x63, 0x13, 0xea, 0xf2, 0xe4, 0x94, 0xac, 0x44, 0xb6, 0xbc, 0x57,
-	0x13, 0x0c, 0x73, 0xfd, 0x7b, 0x3d, 0x27, 0x2a, 0xf0, 0xdd, 0x8e, 0x81, 0x77, 0xe5, 0xb4, 0x36,
-	0x2d, 0xf9, 0x0a, 0x49, 0xa0, 0xcc, 0x74, 0x99, 0xc8, 0xd8, 0x2e, 0xa3, 0x95, 0x02, 0xd1, 0xf8,
-	0xdf, 0xc2, 0xc6, 0x04, 0x7d, 0xe7, 0xe3, 0xde, 0x26, 0xbc, 0x57, 0x2c, 0x1d, 0xb5, 0x9b, 0xfa,
-	0x97, 0x51, 0x49, 0x1d, 0x9f, 0xdf, 0xcf, 0xf4, 0xaf, 0x96, 0x88, 0x5d, 0x3a, 0xe4, 0xfd, 0xc9,
-	0x80, 0xba, 0x6c, 0x71, 0x69, 0x3f, 0x7f, 0x42, 0x0d, 0x18, 0x87, 0xac, 0x6c, 0xc0, 0xe5, 0x70,
-	0x2e, 0x81, 0x82, 0xe6, 0x24, 0x87, 0xf3, 0x1c, 0x09, 0xd9, 0x1e, 0x4f, 0x68, 0xff, 0x6b, 0xe9,
-	0xde, 0x80, 0xf5, 0x02, 0x51, 0x5a, 0xcf, 0xbd, 0x4f, 0x7e, 0xe8, 0x0c, 0x7d, 0x41, 0x38, 0xef,
-	0xf8, 0x74, 0x5b, 0x7f, 0x6d, 0xf7, 0xe9, 0xf6, 0x50, 0x6c, 0xab, 0x7f, 0xb5, 0x6e, 0xe7, 0x7e,
-	0x9b, 0xe9, 0xce, 0x2b, 0xc2, 0x67, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x96, 0x1e, 0xcb, 0x36,
-	0xf4, 0x1d, 0x00, 0x00,
+	0x47, 0xe9, 0x45, 0x2f, 0xda, 0xd9, 0xdd, 0x03, 0x12, 0x20, 0x20, 0x59, 0x56, 0x3d, 0x9d, 0xdc,
+	0x68, 0xb8, 0xdf, 0xd9, 0xf3, 0xbb, 0x67, 0xcf, 0x39, 0x0b, 0x41, 0x53, 0xb8, 0x9d, 0x80, 0x88,
+	0xbe, 0x1b, 0xba, 0x3d, 0xc2, 0x3c, 0x57, 0xb8, 0xed, 0x88, 0x51, 0x41, 0x8d, 0xe5, 0x0c, 0xa1,
+	0x55, 0x7a, 0x33, 0x20, 0x6c, 0xa4, 0xe9, 0xad, 0xaa, 0xa0, 0x11, 0x9d, 0xec, 0x6f, 0xad, 0x30,
+	0x12, 0x05, 0x7e, 0xd7, 0x15, 0x3e, 0x0d, 0x13, 0x70, 0x25, 0xa0, 0xbd, 0x81, 0xf0, 0x03, 0x5c,
+	0x96, 0x87, 0x42, 0xf8, 0x7d, 0xa2, 0x57, 0xd6, 0x7f, 0x0a, 0xb0, 0xf4, 0x52, 0xaa, 0x79, 0x44,
+	0x8e, 0xfc, 0xd0, 0x97, 0xac, 0x86, 0x01, 0xb3, 0xa1, 0xdb, 0x27, 0x66, 0x61, 0xb3, 0x70, 0x67,
+	0xd1, 0x56, 0xbf, 0x8d, 0x55, 0x98, 0xe7, 0xdd, 0x63, 0xd2, 0x77, 0xcd, 0xa2, 0x42, 0x71, 0x65,
+	0x98, 0x70, 0xad, 0x4b, 0x83, 0x41, 0x3f, 0xe4, 0xe6, 0xcc, 0xe6, 0xcc, 0x9d, 0x45, 0x3b, 0x5e,
+	0x1a, 0x6d, 0xa8, 0x47, 0xcc, 0xef, 0xbb, 0x6c, 0xe4, 0x9c, 0x90, 0x91, 0x13, 0xef, 0x9a, 0x55,
+	0xbb, 0x96, 0x91, 0xf4, 0x8c, 0x8c, 0x1e, 0xe2, 0x7e, 0x03, 0x66, 0xc5, 0x28, 0x22, 0xe6, 0x9c,
+	0xd6, 0x2a, 0x7f, 0x1b, 0x37, 0xa0, 0x24, 0x1d, 0x71, 0x02, 0x12, 0xf6, 0xc4, 0xb1, 0x39, 0xbf,
+	0x59, 0xb8, 0x33, 0x6b, 0x83, 0x84, 0xf6, 0x15, 0x62, 0xac, 0xc3, 0x22, 0xa3, 0xa7, 0x4e, 0x97,
+	0x0e, 0x42, 0x61, 0x5e, 0x53, 0xe4, 0x05, 0x46, 0x4f, 0x1f, 0xca, 0xb5, 0x71, 0x1b, 0xe6, 0x8f,
+	0x7c, 0x12, 0x78, 0xdc, 0x5c, 0xd8, 0x9c, 0xb9, 0x53, 0xda, 0x2e, 0xb7, 0x75, 0xf4, 0xf6, 0x24,
+	0x68, 0x23, 0xcd, 0xfa, 0x6b, 0x01, 0x6a, 0x87, 0xca, 0x99, 0x44, 0x08, 0x3e, 0x86, 0x25, 0xa9,
+	0xa5, 0xe3, 0x72, 0xe2, 0xa0, 0xdf, 0x3a, 0x1a, 0xd5, 0x18, 0xd6, 0x2c, 0xc6, 0x0b, 0xd0, 0xa7,
+	0xe4, 0x78, 0x63, 0x66, 0x6e, 0x16, 0x95, 0x3a, 0xab, 0x9d, 0x3d, 0xd8, 0xa9, 0x50, 0xdb, 0x35,
+	0x91, 0x06, 0xb8, 0x0c, 0xe8, 0x90, 0x30, 0xee, 0xd3, 0xd0, 0x9c, 0x51, 0x1a, 0xe3, 0xa5, 0x34,
+	0xd4, 0xd0, 0x5a, 0x1f, 0x1e, 0xbb, 0x61, 0x8f, 0xd8, 0x84, 0x0f, 0x02, 0x61, 0x3c, 0x81, 0x4a,
+	0x87, 0x1c, 0x51, 0x96, 0x32, 0xb4, 0xb4, 0x7d, 0x2b, 0x47, 0xfb, 0xb4, 0x9b, 0x76, 0x59, 0x73,
+	0xa2, 0x2f, 0x7b, 0x50, 0x76, 0x8f, 0x04, 0x61, 0x4e, 0xe2, 0xa4, 0x2f, 0x29, 0xa8, 0xa4, 0x18,
+	0x35, 0x6c, 0xfd, 0xab, 0x00, 0xd5, 0x57, 0x9c, 0xb0, 0x03, 0xc2, 0xfa, 0x3e, 0xe7, 0x98, 0x52,
+	0xc7, 0x94, 0x8b, 0x38, 0xa5, 0xe4, 0x6f, 0x89, 0x0d, 0x38, 0x61, 0x98, 0x50, 0xea, 0xb7, 0xf1,
+	0x29, 0x2c, 0x47, 0x2e, 0xe7, 0xa7, 0x94, 0x79, 0x4e, 0xf7, 0x98, 0x74, 0x4f, 0xf8, 0xa0, 0xaf,
+	0xe2, 0x30, 0x6b, 0xd7, 0x62, 0xc2, 0x43, 0xc4, 0x8d, 0x6f, 0x01, 0x22, 0xe6, 0x0f, 0xfd, 0x80,
+	0xf4, 0x88, 0x4e, 0xac, 0xd2, 0xf6, 0xe7, 0x39, 0xd6, 0xa6, 0x6d, 0x69, 0x1f, 0x8c, 0x79, 0x76,
+	0x43, 0xc1, 0x46, 0x76, 0x42, 0x48, 0xeb, 0x57, 0xb0, 0x34, 0x45, 0x36, 0x6a, 0x30, 0x73, 0x42,
+	0x46, 0x68, 0xb9, 0xfc, 0x69, 0x34, 0x60, 0x6e, 0xe8, 0x06, 0x03, 0x82, 0x96, 0xeb, 0xc5, 0x57,
+	0xc5, 0x2f, 0x0b, 0xd6, 0x8f, 0x05, 0x28, 0x3f, 0xea, 0xbc, 0xc5, 0xef, 0x2a, 0x14, 0xbd, 0x0e,
+	0xf2, 0x16, 0xbd, 0xce, 0x38, 0x0e, 0x33, 0x89, 0x38, 0xbc, 0xc8, 0x71, 0x6d, 0x2b, 0xc7, 0xb5,
+	0x47, 0x9d, 0xff, 0x8f, 0x63, 0x7f, 0x29, 0x40, 0x69, 0xa2, 0x89, 0x1b, 0xfb, 0x50, 0x93, 0x76,
+	0x3a, 0xd1, 0x04, 0x33, 0x0b, 0xca, 0xca, 0x9b, 0x6f, 0x3d, 0x00, 0x7b, 0x69, 0x90, 0x5a, 0x73,
+	0x63, 0x0f, 0xaa, 0x5e, 0x27, 0x25, 0x4b, 0xdf, 0xa0, 0x1b, 0x6f, 0xf1, 0xd8, 0xae, 0x78, 0x89,
+	0x15, 0xb7, 0x3e, 0x86, 0xd2, 0x81, 0x1f, 0xf6, 0x6c, 0xf2, 0x66, 0x40, 0xb8, 0x90, 0x57, 0x29,
+	0x72, 0x47, 0x01, 0x75, 0x3d, 0x74, 0x32, 0x5e, 0x5a, 0x77, 0xa0, 0xac, 0x37, 0xf2, 0x88, 0x86,
+	0x9c, 0x5c, 0xb0, 0xf3, 0x13, 0x28, 0x1f, 0x06, 0x84, 0x44, 0xb1, 0xcc, 0x16, 0x2c, 0x78, 0x03,
+	0xa6, 0x4a, 0xac, 0xda, 0x3a, 0x63, 0x8f, 0xd7, 0xd6, 0x12, 0x54, 0x70, 0xaf, 0x16, 0x6b, 0xfd,
+	0xb3, 0x00, 0xc6, 0xee, 0x19, 0xe9, 0x0e, 0x04, 0x79, 0x42, 0xe9, 0x49, 0x2c, 0x23, 0xaf, 0xbe,
+	0x6e, 0x00, 0x44, 0x2e, 0x73, 0xfb, 0x44, 0x10, 0xa6, 0xdd, 0x5f, 0xb4, 0x13, 0x88, 0x71, 0x00,
+	0x8b, 0xe4, 0x4c, 0x30, 0xd7, 0x21, 0xe1, 0x50, 0x55, 0xda, 0xd2, 0xf6, 0xdd, 0x9c, 0xe8, 0x64,
+	0xb5, 0xb5, 0x77, 0x25, 0xdb, 0x6e, 0x38, 0xd4, 0x39, 0xb1, 0x40, 0x70, 0xd9, 0xfa, 0x05, 0x54,
+	0x52, 0xa4, 0x77, 0xca, 0x87, 0x23, 0xa8, 0xa7, 0x54, 0x61, 0x1c, 0x6f, 0x40, 0x89, 0x9c, 0xf9,
+	0xc2, 0xe1, 0xc2, 0x15, 0x03, 0x8e, 0x01, 0x02, 0x09, 0x1d, 0x2a, 0x44, 0xb5, 0x11, 0xe1, 0xd1,
+	0x81, 0x18, 0xb7, 0x11, 0xb5, 0x42, 0x9c, 0xb0, 0xf8, 0x16, 0xe0, 0xca, 0x1a, 0x42, 0xed, 0x31,
+	0x11, 0xba, 0xae, 0xc4, 0xe1, 0x5b, 0x85, 0x79, 0xe5, 0xb8, 0xce, 0xb8, 0x45, 0x1b, 0x57, 0xc6,
+	0x2d, 0xa8, 0xf8, 0x61, 0x37, 0x18, 0x78, 0xc4, 0x19, 0xfa, 0xe4, 0x94, 0x2b, 0x15, 0x0b, 0x76,
+	0x19, 0xc1, 0xef, 0x24, 0x66, 0x7c, 0x08, 0x55, 0x72, 0xa6, 0x37, 0xa1, 0x10, 0xdd, 0xb6, 0x2a,
+	0x88, 0xaa, 0x02, 0xcd, 0x2d, 0x02, 0xcb, 0x09, 0xbd, 0xe8, 0xdd, 0x01, 0x2c, 0xeb, 0xca, 0x98,
+	0x28, 0xf6, 0xef, 0x52, 0x6d, 0x6b, 0x7c, 0x0a, 0xb1, 0x9a, 0xb0, 0xf2, 0x98, 0x88, 0x44, 0x0a,
+	0xa3, 0x8f, 0xd6, 0x0f, 0xb0, 0x3a, 0x4d, 0x40, 0x23, 0x7e, 0x03, 0xa5, 0xf4, 0xa5, 0x93, 0xea,
+	0x37, 0x72, 0xd4, 0x27, 0x99, 0x93, 0x2c, 0x56, 0x03, 0x8c, 0x43, 0x22, 0x6c, 0xe2, 0x7a, 0x2f,
+	0xc2, 0x60, 0x14, 0x6b, 0x5c, 0x81, 0x7a, 0x0a, 0xc5, 0x14, 0x9e, 0xc0, 0xaf, 0x99, 0x2f, 0x48,
+	0xbc, 0x7b, 0x15, 0x1a, 0x69, 0x18, 0xb7, 0x7f, 0x0d, 0xcb, 0xba, 0x39, 0xbd, 0x1c, 0x45, 0xf1,
+	0x66, 0xe3, 0x0b, 0x28, 0x69, 0xf3, 0x1c, 0xd5, 0xe0, 0xa5, 0xc9, 0xd5, 0xed, 0x46, 0x7b, 0x3c,
+	0xbd, 0xa8, 0x98, 0x0b, 0xc5, 0x01, 0x62, 0xfc, 0x5b, 0xda, 0x99, 0x94, 0x35, 0x31, 0xc8, 0x26,
+	0x47, 0x8c, 0xf0, 0x63, 0x99, 0x52, 0x49, 0x83, 0xd2, 0x30, 0x6e, 0x6f, 0xc2, 0x8a, 0x3d, 0x08,
+	0x9f, 0x10, 0x37, 0x10, 0xc7, 0xaa, 0x71, 0xc4, 0x0c, 0x26, 0xac, 0x4e, 0x13, 0x90, 0xe5, 0x1e,
+	0x98, 0x4f, 0x7b, 0x21, 0x65, 0x44, 0x13, 0x77, 0x19, 0xa3, 0x2c, 0x55, 0x52, 0x84, 0x20, 0x2c,
+	0x9c, 0x14, 0x0a, 0xb5, 0xb4, 0xd6, 0x61, 0x2d, 0x87, 0x0b, 0x45, 0x7e, 0x25, 0x8d, 0x96, 0xf5,
+	0x24, 0x9d, 0xc9, 0xb7, 0xa0, 0x72, 0xea, 0xfa, 0xc2, 0x89, 0x28, 0x9f, 0x24, 0xd3, 0xa2, 0x5d,
+	0x96, 0xe0, 0x01, 0x62, 0xda, 0xb3, 0x24, 0x2f, 0xca, 0xdc, 0x86, 0xd5, 0x03, 0x46, 0x8e, 0x02,
+	0xbf, 0x77, 0x3c, 0x75, 0x41, 0xe4, 0x4c, 0xa6, 0x02, 0x17, 0xdf, 0x90, 0x78, 0x69, 0xf5, 0xa0,
+	0x99, 0xe1, 0xc1, 0xbc, 0xda, 0x87, 0xaa, 0xde, 0xe5, 0x30, 0x35, 0x57, 0xc4, 0xf5, 0xfc, 0xc3,
+	0x73, 0x33, 0x3b, 0x39, 0x85, 0xd8, 0x95, 0x6e, 0x62, 0xc5, 0xad, 0x7f, 0x17, 0xc0, 0xd8, 0x89,
+	0xa2, 0x60, 0x94, 0xb6, 0xac, 0x06, 0x33, 0xfc, 0x4d, 0x10, 0x97, 0x18, 0xfe, 0x26, 0x90, 0x25,
+	0xe6, 0x88, 0xb2, 0x2e, 0xc1, 0xcb, 0xaa, 0x17, 0x72, 0x0c, 0x70, 0x83, 0x80, 0x9e, 0x3a, 0x89,
+	0x89, 0x56, 0x55, 0x86, 0x05, 0xbb, 0xa6, 0x08, 0xf6, 0x04, 0xcf, 0x0e, 0x40, 0xb3, 0xef, 0x6b,
+	0x00, 0x9a, 0xbb, 0xe2, 0x00, 0xf4, 0xb7, 0x02, 0xd4, 0x53, 0xde, 0x63, 0x8c, 0x7f, 0x7a, 0xa3,
+	0x5a, 0x1d, 0x96, 0xf7, 0x69, 0xf7, 0x44, 0x57, 0xbd, 0xf8, 0x6a, 0x34, 0xc0, 0x48, 0x82, 0x93,
+	0x8b, 0xf7, 0x2a, 0x0c, 0x32, 0x9b, 0x57, 0xa1, 0x91, 0x86, 0x71, 0xfb, 0xdf, 0x0b, 0x60, 0x62,
+	0x8b, 0xd8, 0x23, 0xa2, 0x7b, 0xbc, 0xc3, 0x1f, 0x75, 0xc6, 0x79, 0xd0, 0x80, 0x39, 0x35, 0x8a,
+	0xab, 0x00, 0x94, 0x6d, 0xbd, 0x30, 0x9a, 0x70, 0xcd, 0xeb, 0x38, 0xaa, 0x35, 0x62, 0x77, 0xf0,
+	0x3a, 0xdf, 0xc8, 0xe6, 0xb8, 0x06, 0x0b, 0x7d, 0xf7, 0xcc, 0x61, 0xf4, 0x94, 0xe3, 0x30, 0x78,
+	0xad, 0xef, 0x9e, 0xd9, 0xf4, 0x94, 0xab, 0x41, 0xdd, 0xe7, 0x6a, 0x02, 0xef, 0xf8, 0x61, 0x40,
+	0x7b, 0x5c, 0x1d, 0xff, 0x82, 0x5d, 0x45, 0xf8, 0x81, 0x46, 0xe5, 0x5d, 0x63, 0xea, 0x1a, 0x25,
+	0x0f, 0x77, 0xc1, 0x2e, 0xb3, 0xc4, 0xdd, 0xb2, 0x1e, 0xc3, 0x5a, 0x8e, 0xcd, 0x78, 0x7a, 0x9f,
+	0xc0, 0xbc, 0xbe, 0x1a, 0x78, 0x6c, 0x06, 0x3e, 0x27, 0xbe, 0x95, 0x7f, 0xf1, 0x1a, 0xe0, 0x0e,
+	0xeb, 0x0f, 0x05, 0xb8, 0x9e, 0x96, 0xb4, 0x13, 0x04, 0x72, 0x00, 0xe3, 0xef, 0x3f, 0x04, 0x19,
+	0xcf, 0x66, 0x73, 0x3c, 0xdb, 0x87, 0x8d, 0xf3, 0xec, 0xb9, 0x82, 0x7b, 0xcf, 0xa6, 0xcf, 0x76,
+	0x27, 0x8a, 0x2e, 0x76, 0x2c, 0x69, 0x7f, 0x31, 0x65, 0x7f, 0x36, 0xe8, 0x4a, 0xd8, 0x15, 0xac,
+	0x6a, 0x81, 0x99, 0xa8, 0x0b, 0x7a, 0xe2, 0x88, 0xd3, 0x74, 0x1f, 0xd6, 0x72, 0x68, 0xa8, 0x64,
+	0x4b, 0x4e, 0x1f, 0xe3, 0x89, 0xa5, 0xb4, 0xdd, 0x6c, 0x4f, 0xbf, 0xa4, 0x91, 0x01, 0xb7, 0xc9,
+	0xbb, 0xf0, 0xdc, 0xe5, 0xf2, 0x1a, 0xa5, 0x94, 0x3c, 0x87, 0x46, 0x1a, 0x46, 0xf9, 0x5f, 0x4c,
+	0xc9, 0xbf, 0x9e, 0x91, 0x9f, 0x62, 0x8b, 0xb5, 0x34, 0x61, 0x45, 0xe3, 0x71, 0x2f, 0x88, 0xf5,
+	0xdc, 0x83, 0xd5, 0x69, 0x02, 0x6a, 0x6a, 0xc1, 0xc2, 0x54, 0x33, 0x19, 0xaf, 0x25, 0xd7, 0x6b,
+	0xd7, 0x17, 0x7b, 0x74, 0x5a, 0xde, 0x85, 0x5c, 0x6b, 0xd0, 0xcc, 0x70, 0xe1, 0x15, 0x37, 0x61,
+	0xf5, 0x50, 0xd0, 0x28, 0x11, 0xd7, 0xd8, 0xc0, 0x35, 0x68, 0x66, 0x28, 0xc8, 0xf4, 0x3b, 0xb8,
+	0x3e, 0x45, 0x7a, 0xee, 0x87, 0x7e, 0x7f, 0xd0, 0xbf, 0x84, 0x31, 0xc6, 0x4d, 0x50, 0xbd, 0xd1,
+	0x11, 0x7e, 0x9f, 0xc4, 0x43, 0xe4, 0x8c, 0x5d, 0x92, 0xd8, 0x4b, 0x0d, 0x59, 0xbf, 0x84, 0x8d,
+	0xf3, 0xe4, 0x5f, 0x22, 0x46, 0xca, 0x70, 0x97, 0x89, 0x1c, 0x9f, 0x5a, 0x60, 0x66, 0x49, 0xe8,
+	0x54, 0x07, 0x6e, 0x4e, 0xd3, 0x5e, 0x85, 0xc2, 0x0f, 0x76, 0x64, 0xa9, 0x7d, 0x4f, 0x8e, 0xdd,
+	0x06, 0xeb, 0x22, 0x1d, 0x68, 0x49, 0x03, 0x8c, 0xc7, 0x24, 0xde, 0x33, 0x4e, 0xcc, 0x4f, 0xa1,
+	0x9e, 0x42, 0x31, 0x12, 0x0d, 0x98, 0x73, 0x3d, 0x8f, 0xc5, 0x63, 0x82, 0x5e, 0xc8, 0x18, 0xd8,
+	0x84, 0x93, 0x73, 0x62, 0x90, 0x25, 0xa1, 0xe6, 0x2d, 0x68, 0x7e, 0x97, 0xc0, 0xe5, 0x95, 0xce,
+	0x2d, 0x09, 0x8b, 0x58, 0x12, 0xac, 0x3d, 0x30, 0xb3, 0x0c, 0x57, 0x2a, 0x46, 0xd7, 0x93, 0x72,
+	0x26, 0xd9, 0x1a, 0xab, 0xaf, 0x42, 0xd1, 0xf7, 0xf0, 0x31, 0x52, 0xf4, 0xbd, 0xd4, 0x41, 0x14,
+	0xa7, 0x12, 0x60, 0x13, 0x36, 0xce, 0x13, 0x86, 0x7e, 0xd6, 0x61, 0xf9, 0x69, 0xe8, 0x0b, 0x7d,
+	0x01, 0xe3, 0xc0, 0x7c, 0x06, 0x46, 0x12, 0xbc, 0x44, 0xa6, 0xfd, 0x58, 0x80, 0x8d, 0x03, 0x1a,
+	0x0d, 0x02, 0x35, 0xad, 0x46, 0x2e, 0x23, 0xa1, 0xf8, 0x9a, 0x0e, 0x58, 0xe8, 0x06, 0xb1, 0xdd,
+	0x1f, 0xc1, 0x92, 0xcc, 0x07, 0xa7, 0xcb, 0x88, 0x2b, 0x88, 0xe7, 0x84, 0xf1, 0x8b, 0xaa, 0x22,
+	0xe1, 0x87, 0x1a, 0xfd, 0x86, 0xcb, 0x57, 0x97, 0xdb, 0x95, 0x42, 0x93, 0x8d, 0x03, 0x34, 0xa4,
+	0x9a, 0xc7, 0x97, 0x50, 0xee, 0x2b, 0xcb, 0x1c, 0x37, 0xf0, 0x5d, 0xdd, 0x40, 0x4a, 0xdb, 0x2b,
+	0xd3, 0x13, 0xf8, 0x8e, 0x24, 0xda, 0x25, 0xbd, 0x55, 0x2d, 0x8c, 0xcf, 0xa1, 0x91, 0x28, 0x55,
+	0x93, 0x41, 0x75, 0x56, 0xe9, 0xa8, 0x27, 0x68, 0xe3, 0x79, 0xf5, 0x26, 0xdc, 0x38, 0xd7, 0x2f,
+	0x0c, 0xe1, 0x9f, 0x0b, 0x3a, 0x5c, 0x18, 0xe8, 0xd8, 0xdf, 0x9f, 0xc3, 0xbc, 0xde, 0x6f, 0x16,
+	0x2e, 0x32, 0x10, 0x37, 0x9d, 0x6b, 0x5b, 0xf1, 0x5c, 0xdb, 0xf2, 0x22, 0x3a, 0x93, 0x13, 0x51,
+	0x59, 0xdf, 0x53, 0xf6, 0x4d, 0x46, 0xa0, 0x47, 0xa4, 0x4f, 0x05, 0x49, 0x1f, 0xfe, 0x1f, 0x0b,
+	0xd0, 0x48, 0xe3, 0x78, 0xfe, 0x77, 0xa1, 0xee, 0x91, 0x88, 0x91, 0xae, 0x52, 0x96, 0x4e, 0x85,
+	0x07, 0x45, 0xb3, 0x60, 0x1b, 0x13, 0xf2, 0xd8, 0xc6, 0x07, 0x50, 0xc1, 0xc3, 0xc2, 0x9e, 0x51,
+	0xbc, 0x4c, 0xcf, 0x28, 0xf7, 0x13, 0x2b, 0x79, 0x85, 0x5f, 0x85, 0x1e, 0xcd, 0x33, 0xb6, 0x05,
+	0x66, 0x96, 0x84, 0xfe, 0xad, 0x8f, 0x9b, 0xe4, 0x6b, 0x97, 0x1f, 0x30, 0x2a, 0xb7, 0x78, 0x31,
+	0xe3, 0x07, 0xd0, 0xca, 0x23, 0x22, 0xeb, 0x3f, 0xe4, 0x57, 0x54, 0x92, 0xbe, 0x15, 0xef, 0x7a,
+	0xa0, 0x39, 0xa7, 0x53, 0xcc, 0xcb, 0xf7, 0xfb, 0xd0, 0x54, 0xcf, 0x04, 0x19, 0x20, 0x26, 0x72,
+	0xde, 0x08, 0x2b, 0x8a, 0x3c, 0x5d, 0x2d, 0xb3, 0xcf, 0xad, 0xd9, 0x9c, 0xe7, 0x56, 0x1d, 0x96,
+	0x13, 0x7e, 0xa0, 0x77, 0xcf, 0x92, 0xbe, 0xdb, 0x44, 0xe9, 0x25, 0xde, 0xd5, 0xdc, 0xb4, 0xae,
+	0xc3, 0x7a, 0xae, 0x30, 0xd4, 0xf5, 0x7b, 0x59, 0xe7, 0x53, 0x0d, 0x6c, 0x27, 0xf4, 0xe4, 0xc7,
+	0x88, 0xe4, 0xa8, 0x61, 0x7c, 0x0f, 0x2b, 0x5c, 0xd0, 0x28, 0xe9, 0xbc, 0xd3, 0xa7, 0x5e, 0xfc,
+	0xba, 0xbe, 0x9d, 0x33, 0xc1, 0xa4, 0x9b, 0x22, 0xf5, 0x88, 0x5d, 0xe7, 0x59, 0x50, 0x3e, 0x5e,
+	0x6e, 0x5d, 0x68, 0xc0, 0xf8, 0x43, 0x44, 0xe5, 0x78, 0xd4, 0x61, 0xbe, 0xe7, 0x5c, 0x6a, 0x76,
+	0x52, 0xf9, 0x5e, 0xd6, 0x1c, 0x1a, 0x31, 0x7e, 0x3d, 0x1e, 0x8b, 0x74, 0x8a, 0x7f, 0xf4, 0x36,
+	0xa3, 0xb3, 0xf3, 0x11, 0xe6, 0x61, 0xba, 0x90, 0xc8, 0x49, 0x67, 0x9a, 0x70, 0x89, 0x8a, 0x7c,
+	0x08, 0x95, 0x07, 0x6e, 0xf7, 0x64, 0x30, 0x9e, 0x64, 0x37, 0xa1, 0xd4, 0xa5, 0x61, 0x77, 0xc0,
+	0x18, 0x09, 0xbb, 0x23, 0xac, 0xbd, 0x49, 0x48, 0xee, 0x50, 0xcf, 0x51, 0x9d, 0x2e, 0xf8, 0x86,
+	0x4d, 0x42, 0xd6, 0x7d, 0xa8, 0xc6, 0x42, 0xd1, 0x84, 0xdb, 0x30, 0x47, 0x86, 0x93, 0x64, 0xa9,
+	0xb6, 0xe3, 0x7f, 0xcf, 0xec, 0x4a, 0xd4, 0xd6, 0x44, 0x6b, 0xa8, 0x3a, 0xad, 0xa0, 0x8c, 0xec,
+	0x31, 0xda, 0x4f, 0xdb, 0x75, 0x1b, 0xaa, 0x4c, 0xd3, 0x1c, 0x41, 0x65, 0x36, 0xc7, 0xdf, 0x0d,
+	0x10, 0x7d, 0x49, 0x0f, 0x28, 0x37, 0xee, 0xc1, 0x52, 0x62, 0x97, 0xbc, 0x41, 0x18, 0xe6, 0x72,
+	0x1b, 0xff, 0x03, 0x24, 0x27, 0x0b, 0xbb, 0x32, 0x66, 0x92, 0x4b, 0x6b, 0x47, 0x96, 0x80, 0x8c,
+	0xde, 0x77, 0x32, 0x5d, 0x7e, 0x5f, 0x0a, 0xdc, 0x21, 0x49, 0xcf, 0xc6, 0x7b, 0x50, 0x4f, 0xa1,
+	0x57, 0x1d, 0xbd, 0x0d, 0xa8, 0xc9, 0xac, 0x50, 0xb2, 0x62, 0xd9, 0xf2, 0xce, 0x4e, 0x30, 0xbc,
+	0x47, 0xdf, 0x43, 0x73, 0x0c, 0xbe, 0xdf, 0x11, 0xf3, 0x3e, 0x98, 0x59, 0xc9, 0x97, 0x48, 0x30,
+	0x65, 0xa6, 0xcb, 0x44, 0xca, 0x76, 0x19, 0xad, 0x04, 0x88, 0xc6, 0xff, 0x16, 0xd6, 0x27, 0xe8,
+	0x7b, 0x1f, 0x25, 0x37, 0xe0, 0x83, 0x7c, 0xe9, 0xa8, 0xdd, 0xd0, 0x5f, 0x5d, 0x25, 0x75, 0x7c,
+	0x7e, 0x3f, 0x83, 0xe5, 0x04, 0x76, 0xe1, 0x00, 0xf9, 0xa7, 0x02, 0xd4, 0x64, 0xfb, 0x4c, 0xfa,
+	0xf9, 0x13, 0x6a, 0xee, 0x38, 0xc0, 0xa5, 0x03, 0x2e, 0x07, 0x7f, 0x09, 0xe4, 0x34, 0x3e, 0x39,
+	0xf8, 0x67, 0x48, 0xc8, 0xf6, 0x74, 0x42, 0xfb, 0x5f, 0xdb, 0xc2, 0x3a, 0xac, 0xe5, 0x88, 0xd2,
+	0x7a, 0x1e, 0x7c, 0xf6, 0x43, 0x7b, 0xe8, 0x0b, 0xc2, 0x79, 0xdb, 0xa7, 0x5b, 0xfa, 0xd7, 0x56,
+	0x8f, 0x6e, 0x0d, 0xc5, 0x96, 0xfa, 0x37, 0xee, 0x56, 0xe6, 0xbb, 0x4f, 0x67, 0x5e, 0x11, 0xee,
+	0xfe, 0x77, 0x00, 0x70, 0x3c, 0xa3, 0xd6, 0x5e, 0x1e, 0x00, 0x00,
 }
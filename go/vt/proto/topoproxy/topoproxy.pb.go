@@ -0,0 +1,1064 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: topoproxy.proto
+
+package topoproxy
+
+import (
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type DirEntry_Type int32
+
+const (
+	DirEntry_DIRECTORY DirEntry_Type = 0
+	DirEntry_FILE      DirEntry_Type = 1
+)
+
+var DirEntry_Type_name = map[int32]string{
+	0: "DIRECTORY",
+	1: "FILE",
+}
+
+var DirEntry_Type_value = map[string]int32{
+	"DIRECTORY": 0,
+	"FILE":      1,
+}
+
+func (x DirEntry_Type) String() string {
+	return proto.EnumName(DirEntry_Type_name, int32(x))
+}
+
+func (DirEntry_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ba9169467365fc00, []int{9, 0}
+}
+
+type LockRequest_Action int32
+
+const (
+	LockRequest_LOCK   LockRequest_Action = 0
+	LockRequest_CHECK  LockRequest_Action = 1
+	LockRequest_UNLOCK LockRequest_Action = 2
+)
+
+var LockRequest_Action_name = map[int32]string{
+	0: "LOCK",
+	1: "CHECK",
+	2: "UNLOCK",
+}
+
+var LockRequest_Action_value = map[string]int32{
+	"LOCK":   0,
+	"CHECK":  1,
+	"UNLOCK": 2,
+}
+
+func (x LockRequest_Action) String() string {
+	return proto.EnumName(LockRequest_Action_name, int32(x))
+}
+
+func (LockRequest_Action) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ba9169467365fc00, []int{13, 0}
+}
+
+// GetRequest is the payload for Get.
+type GetRequest struct {
+	Cell                 string   `protobuf:"bytes,1,opt,name=cell,proto3" json:"cell,omitempty"`
+	Path                 string   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetRequest) Reset()         { *m = GetRequest{} }
+func (m *GetRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRequest) ProtoMessage()    {}
+func (*GetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba9169467365fc00, []int{0}
+}
+
+func (m *GetRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRequest.Unmarshal(m, b)
+}
+func (m *GetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRequest.Marshal(b, m, deterministic)
+}
+func (m *GetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRequest.Merge(m, src)
+}
+func (m *GetRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRequest.Size(m)
+}
+func (m *GetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRequest proto.InternalMessageInfo
+
+func (m *GetRequest) GetCell() string {
+	if m != nil {
+		return m.Cell
+	}
+	return ""
+}
+
+func (m *GetRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+// GetResponse is the response for Get.
+type GetResponse struct {
+	Contents             []byte   `protobuf:"bytes,1,opt,name=contents,proto3" json:"contents,omitempty"`
+	Version              string   `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetResponse) Reset()         { *m = GetResponse{} }
+func (m *GetResponse) String() string { return proto.CompactTextString(m) }
+func (*GetResponse) ProtoMessage()    {}
+func (*GetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba9169467365fc00, []int{1}
+}
+
+func (m *GetResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetResponse.Unmarshal(m, b)
+}
+func (m *GetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetResponse.Marshal(b, m, deterministic)
+}
+func (m *GetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetResponse.Merge(m, src)
+}
+func (m *GetResponse) XXX_Size() int {
+	return xxx_messageInfo_GetResponse.Size(m)
+}
+func (m *GetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetResponse proto.InternalMessageInfo
+
+func (m *GetResponse) GetContents() []byte {
+	if m != nil {
+		return m.Contents
+	}
+	return nil
+}
+
+func (m *GetResponse) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+// CreateRequest is the payload for Create.
+type CreateRequest struct {
+	Cell                 string   `protobuf:"bytes,1,opt,name=cell,proto3" json:"cell,omitempty"`
+	Path                 string   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Contents             []byte   `protobuf:"bytes,3,opt,name=contents,proto3" json:"contents,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateRequest) Reset()         { *m = CreateRequest{} }
+func (m *CreateRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateRequest) ProtoMessage()    {}
+func (*CreateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba9169467365fc00, []int{2}
+}
+
+func (m *CreateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateRequest.Unmarshal(m, b)
+}
+func (m *CreateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateRequest.Merge(m, src)
+}
+func (m *CreateRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateRequest.Size(m)
+}
+func (m *CreateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateRequest proto.InternalMessageInfo
+
+func (m *CreateRequest) GetCell() string {
+	if m != nil {
+		return m.Cell
+	}
+	return ""
+}
+
+func (m *CreateRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *CreateRequest) GetContents() []byte {
+	if m != nil {
+		return m.Contents
+	}
+	return nil
+}
+
+// CreateResponse is the response for Create.
+type CreateResponse struct {
+	Version              string   `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateResponse) Reset()         { *m = CreateResponse{} }
+func (m *CreateResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateResponse) ProtoMessage()    {}
+func (*CreateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba9169467365fc00, []int{3}
+}
+
+func (m *CreateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateResponse.Unmarshal(m, b)
+}
+func (m *CreateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateResponse.Merge(m, src)
+}
+func (m *CreateResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateResponse.Size(m)
+}
+func (m *CreateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateResponse proto.InternalMessageInfo
+
+func (m *CreateResponse) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+// UpdateRequest is the payload for Update.
+type UpdateRequest struct {
+	Cell     string `protobuf:"bytes,1,opt,name=cell,proto3" json:"cell,omitempty"`
+	Path     string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Contents []byte `protobuf:"bytes,3,opt,name=contents,proto3" json:"contents,omitempty"`
+	// version is the expected version of the file. If empty, the file
+	// is created or overwritten.
+	Version              string   `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateRequest) Reset()         { *m = UpdateRequest{} }
+func (m *UpdateRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateRequest) ProtoMessage()    {}
+func (*UpdateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba9169467365fc00, []int{4}
+}
+
+func (m *UpdateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateRequest.Unmarshal(m, b)
+}
+func (m *UpdateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateRequest.Merge(m, src)
+}
+func (m *UpdateRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateRequest.Size(m)
+}
+func (m *UpdateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateRequest proto.InternalMessageInfo
+
+func (m *UpdateRequest) GetCell() string {
+	if m != nil {
+		return m.Cell
+	}
+	return ""
+}
+
+func (m *UpdateRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *UpdateRequest) GetContents() []byte {
+	if m != nil {
+		return m.Contents
+	}
+	return nil
+}
+
+func (m *UpdateRequest) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+// UpdateResponse is the response for Update.
+type UpdateResponse struct {
+	Version              string   `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateResponse) Reset()         { *m = UpdateResponse{} }
+func (m *UpdateResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateResponse) ProtoMessage()    {}
+func (*UpdateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba9169467365fc00, []int{5}
+}
+
+func (m *UpdateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateResponse.Unmarshal(m, b)
+}
+func (m *UpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateResponse.Marshal(b, m, deterministic)
+}
+func (m *UpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateResponse.Merge(m, src)
+}
+func (m *UpdateResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateResponse.Size(m)
+}
+func (m *UpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateResponse proto.InternalMessageInfo
+
+func (m *UpdateResponse) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+// DeleteRequest is the payload for Delete.
+type DeleteRequest struct {
+	Cell string `protobuf:"bytes,1,opt,name=cell,proto3" json:"cell,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// version is the expected version of the file. If empty, the file
+	// is deleted whatever its version.
+	Version              string   `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
+func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteRequest) ProtoMessage()    {}
+func (*DeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba9169467365fc00, []int{6}
+}
+
+func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteRequest.Unmarshal(m, b)
+}
+func (m *DeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteRequest.Merge(m, src)
+}
+func (m *DeleteRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteRequest.Size(m)
+}
+func (m *DeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteRequest proto.InternalMessageInfo
+
+func (m *DeleteRequest) GetCell() string {
+	if m != nil {
+		return m.Cell
+	}
+	return ""
+}
+
+func (m *DeleteRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *DeleteRequest) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+// DeleteResponse is the response for Delete.
+type DeleteResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteResponse) Reset()         { *m = DeleteResponse{} }
+func (m *DeleteResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteResponse) ProtoMessage()    {}
+func (*DeleteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba9169467365fc00, []int{7}
+}
+
+func (m *DeleteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteResponse.Unmarshal(m, b)
+}
+func (m *DeleteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteResponse.Merge(m, src)
+}
+func (m *DeleteResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteResponse.Size(m)
+}
+func (m *DeleteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteResponse proto.InternalMessageInfo
+
+// ListDirRequest is the payload for ListDir.
+type ListDirRequest struct {
+	Cell                 string   `protobuf:"bytes,1,opt,name=cell,proto3" json:"cell,omitempty"`
+	Path                 string   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Full                 bool     `protobuf:"varint,3,opt,name=full,proto3" json:"full,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListDirRequest) Reset()         { *m = ListDirRequest{} }
+func (m *ListDirRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDirRequest) ProtoMessage()    {}
+func (*ListDirRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba9169467365fc00, []int{8}
+}
+
+func (m *ListDirRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDirRequest.Unmarshal(m, b)
+}
+func (m *ListDirRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDirRequest.Marshal(b, m, deterministic)
+}
+func (m *ListDirRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDirRequest.Merge(m, src)
+}
+func (m *ListDirRequest) XXX_Size() int {
+	return xxx_messageInfo_ListDirRequest.Size(m)
+}
+func (m *ListDirRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDirRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDirRequest proto.InternalMessageInfo
+
+func (m *ListDirRequest) GetCell() string {
+	if m != nil {
+		return m.Cell
+	}
+	return ""
+}
+
+func (m *ListDirRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *ListDirRequest) GetFull() bool {
+	if m != nil {
+		return m.Full
+	}
+	return false
+}
+
+// DirEntry is an entry of a directory, see topo.DirEntry.
+type DirEntry struct {
+	Name                 string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type                 DirEntry_Type `protobuf:"varint,2,opt,name=type,proto3,enum=topoproxy.DirEntry_Type" json:"type,omitempty"`
+	Ephemeral            bool          `protobuf:"varint,3,opt,name=ephemeral,proto3" json:"ephemeral,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *DirEntry) Reset()         { *m = DirEntry{} }
+func (m *DirEntry) String() string { return proto.CompactTextString(m) }
+func (*DirEntry) ProtoMessage()    {}
+func (*DirEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba9169467365fc00, []int{9}
+}
+
+func (m *DirEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DirEntry.Unmarshal(m, b)
+}
+func (m *DirEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DirEntry.Marshal(b, m, deterministic)
+}
+func (m *DirEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DirEntry.Merge(m, src)
+}
+func (m *DirEntry) XXX_Size() int {
+	return xxx_messageInfo_DirEntry.Size(m)
+}
+func (m *DirEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_DirEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DirEntry proto.InternalMessageInfo
+
+func (m *DirEntry) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DirEntry) GetType() DirEntry_Type {
+	if m != nil {
+		return m.Type
+	}
+	return DirEntry_DIRECTORY
+}
+
+func (m *DirEntry) GetEphemeral() bool {
+	if m != nil {
+		return m.Ephemeral
+	}
+	return false
+}
+
+// ListDirResponse is the response for ListDir.
+type ListDirResponse struct {
+	Entries              []*DirEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ListDirResponse) Reset()         { *m = ListDirResponse{} }
+func (m *ListDirResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDirResponse) ProtoMessage()    {}
+func (*ListDirResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba9169467365fc00, []int{10}
+}
+
+func (m *ListDirResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDirResponse.Unmarshal(m, b)
+}
+func (m *ListDirResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDirResponse.Marshal(b, m, deterministic)
+}
+func (m *ListDirResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDirResponse.Merge(m, src)
+}
+func (m *ListDirResponse) XXX_Size() int {
+	return xxx_messageInfo_ListDirResponse.Size(m)
+}
+func (m *ListDirResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDirResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDirResponse proto.InternalMessageInfo
+
+func (m *ListDirResponse) GetEntries() []*DirEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+// WatchRequest is the payload for Watch.
+type WatchRequest struct {
+	Cell                 string   `protobuf:"bytes,1,opt,name=cell,proto3" json:"cell,omitempty"`
+	Path                 string   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchRequest) Reset()         { *m = WatchRequest{} }
+func (m *WatchRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchRequest) ProtoMessage()    {}
+func (*WatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba9169467365fc00, []int{11}
+}
+
+func (m *WatchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchRequest.Unmarshal(m, b)
+}
+func (m *WatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchRequest.Marshal(b, m, deterministic)
+}
+func (m *WatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchRequest.Merge(m, src)
+}
+func (m *WatchRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchRequest.Size(m)
+}
+func (m *WatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchRequest proto.InternalMessageInfo
+
+func (m *WatchRequest) GetCell() string {
+	if m != nil {
+		return m.Cell
+	}
+	return ""
+}
+
+func (m *WatchRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+// WatchResponse is streamed back by Watch: the first one contains the
+// current value of the file, the next ones its new values. The stream
+// ends with the error ending the watch.
+type WatchResponse struct {
+	Contents             []byte   `protobuf:"bytes,1,opt,name=contents,proto3" json:"contents,omitempty"`
+	Version              string   `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchResponse) Reset()         { *m = WatchResponse{} }
+func (m *WatchResponse) String() string { return proto.CompactTextString(m) }
+func (*WatchResponse) ProtoMessage()    {}
+func (*WatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba9169467365fc00, []int{12}
+}
+
+func (m *WatchResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchResponse.Unmarshal(m, b)
+}
+func (m *WatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchResponse.Marshal(b, m, deterministic)
+}
+func (m *WatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchResponse.Merge(m, src)
+}
+func (m *WatchResponse) XXX_Size() int {
+	return xxx_messageInfo_WatchResponse.Size(m)
+}
+func (m *WatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchResponse proto.InternalMessageInfo
+
+func (m *WatchResponse) GetContents() []byte {
+	if m != nil {
+		return m.Contents
+	}
+	return nil
+}
+
+func (m *WatchResponse) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+// LockRequest is streamed by Lock. The first request takes the lock,
+// the next ones check or release it.
+type LockRequest struct {
+	Action LockRequest_Action `protobuf:"varint,1,opt,name=action,proto3,enum=topoproxy.LockRequest_Action" json:"action,omitempty"`
+	// cell, path and contents are only set in the LOCK request.
+	Cell                 string   `protobuf:"bytes,2,opt,name=cell,proto3" json:"cell,omitempty"`
+	Path                 string   `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	Contents             string   `protobuf:"bytes,4,opt,name=contents,proto3" json:"contents,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LockRequest) Reset()         { *m = LockRequest{} }
+func (m *LockRequest) String() string { return proto.CompactTextString(m) }
+func (*LockRequest) ProtoMessage()    {}
+func (*LockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba9169467365fc00, []int{13}
+}
+
+func (m *LockRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LockRequest.Unmarshal(m, b)
+}
+func (m *LockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LockRequest.Marshal(b, m, deterministic)
+}
+func (m *LockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockRequest.Merge(m, src)
+}
+func (m *LockRequest) XXX_Size() int {
+	return xxx_messageInfo_LockRequest.Size(m)
+}
+func (m *LockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockRequest proto.InternalMessageInfo
+
+func (m *LockRequest) GetAction() LockRequest_Action {
+	if m != nil {
+		return m.Action
+	}
+	return LockRequest_LOCK
+}
+
+func (m *LockRequest) GetCell() string {
+	if m != nil {
+		return m.Cell
+	}
+	return ""
+}
+
+func (m *LockRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *LockRequest) GetContents() string {
+	if m != nil {
+		return m.Contents
+	}
+	return ""
+}
+
+// LockResponse is streamed back by Lock for each successful request.
+// A failed request ends the stream with its error, and the lock is
+// released if it was held.
+type LockResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LockResponse) Reset()         { *m = LockResponse{} }
+func (m *LockResponse) String() string { return proto.CompactTextString(m) }
+func (*LockResponse) ProtoMessage()    {}
+func (*LockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba9169467365fc00, []int{14}
+}
+
+func (m *LockResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LockResponse.Unmarshal(m, b)
+}
+func (m *LockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LockResponse.Marshal(b, m, deterministic)
+}
+func (m *LockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockResponse.Merge(m, src)
+}
+func (m *LockResponse) XXX_Size() int {
+	return xxx_messageInfo_LockResponse.Size(m)
+}
+func (m *LockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockResponse proto.InternalMessageInfo
+
+// WaitForMastershipRequest is the payload for WaitForMastership.
+type WaitForMastershipRequest struct {
+	Cell                 string   `protobuf:"bytes,1,opt,name=cell,proto3" json:"cell,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Id                   string   `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WaitForMastershipRequest) Reset()         { *m = WaitForMastershipRequest{} }
+func (m *WaitForMastershipRequest) String() string { return proto.CompactTextString(m) }
+func (*WaitForMastershipRequest) ProtoMessage()    {}
+func (*WaitForMastershipRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba9169467365fc00, []int{15}
+}
+
+func (m *WaitForMastershipRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WaitForMastershipRequest.Unmarshal(m, b)
+}
+func (m *WaitForMastershipRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WaitForMastershipRequest.Marshal(b, m, deterministic)
+}
+func (m *WaitForMastershipRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WaitForMastershipRequest.Merge(m, src)
+}
+func (m *WaitForMastershipRequest) XXX_Size() int {
+	return xxx_messageInfo_WaitForMastershipRequest.Size(m)
+}
+func (m *WaitForMastershipRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WaitForMastershipRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WaitForMastershipRequest proto.InternalMessageInfo
+
+func (m *WaitForMastershipRequest) GetCell() string {
+	if m != nil {
+		return m.Cell
+	}
+	return ""
+}
+
+func (m *WaitForMastershipRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *WaitForMastershipRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// WaitForMastershipResponse is streamed back by WaitForMastership when
+// the participant becomes the master. The stream ends when it isn't
+// the master any more.
+type WaitForMastershipResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WaitForMastershipResponse) Reset()         { *m = WaitForMastershipResponse{} }
+func (m *WaitForMastershipResponse) String() string { return proto.CompactTextString(m) }
+func (*WaitForMastershipResponse) ProtoMessage()    {}
+func (*WaitForMastershipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba9169467365fc00, []int{16}
+}
+
+func (m *WaitForMastershipResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WaitForMastershipResponse.Unmarshal(m, b)
+}
+func (m *WaitForMastershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WaitForMastershipResponse.Marshal(b, m, deterministic)
+}
+func (m *WaitForMastershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WaitForMastershipResponse.Merge(m, src)
+}
+func (m *WaitForMastershipResponse) XXX_Size() int {
+	return xxx_messageInfo_WaitForMastershipResponse.Size(m)
+}
+func (m *WaitForMastershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WaitForMastershipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WaitForMastershipResponse proto.InternalMessageInfo
+
+// GetCurrentMasterIDRequest is the payload for GetCurrentMasterID.
+type GetCurrentMasterIDRequest struct {
+	Cell                 string   `protobuf:"bytes,1,opt,name=cell,proto3" json:"cell,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Id                   string   `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCurrentMasterIDRequest) Reset()         { *m = GetCurrentMasterIDRequest{} }
+func (m *GetCurrentMasterIDRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCurrentMasterIDRequest) ProtoMessage()    {}
+func (*GetCurrentMasterIDRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba9169467365fc00, []int{17}
+}
+
+func (m *GetCurrentMasterIDRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCurrentMasterIDRequest.Unmarshal(m, b)
+}
+func (m *GetCurrentMasterIDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCurrentMasterIDRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCurrentMasterIDRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCurrentMasterIDRequest.Merge(m, src)
+}
+func (m *GetCurrentMasterIDRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCurrentMasterIDRequest.Size(m)
+}
+func (m *GetCurrentMasterIDRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCurrentMasterIDRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCurrentMasterIDRequest proto.InternalMessageInfo
+
+func (m *GetCurrentMasterIDRequest) GetCell() string {
+	if m != nil {
+		return m.Cell
+	}
+	return ""
+}
+
+func (m *GetCurrentMasterIDRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GetCurrentMasterIDRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// GetCurrentMasterIDResponse is the response for GetCurrentMasterID.
+type GetCurrentMasterIDResponse struct {
+	MasterId             string   `protobuf:"bytes,1,opt,name=master_id,json=masterId,proto3" json:"master_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCurrentMasterIDResponse) Reset()         { *m = GetCurrentMasterIDResponse{} }
+func (m *GetCurrentMasterIDResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCurrentMasterIDResponse) ProtoMessage()    {}
+func (*GetCurrentMasterIDResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba9169467365fc00, []int{18}
+}
+
+func (m *GetCurrentMasterIDResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCurrentMasterIDResponse.Unmarshal(m, b)
+}
+func (m *GetCurrentMasterIDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCurrentMasterIDResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCurrentMasterIDResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCurrentMasterIDResponse.Merge(m, src)
+}
+func (m *GetCurrentMasterIDResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCurrentMasterIDResponse.Size(m)
+}
+func (m *GetCurrentMasterIDResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCurrentMasterIDResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCurrentMasterIDResponse proto.InternalMessageInfo
+
+func (m *GetCurrentMasterIDResponse) GetMasterId() string {
+	if m != nil {
+		return m.MasterId
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("topoproxy.DirEntry_Type", DirEntry_Type_name, DirEntry_Type_value)
+	proto.RegisterEnum("topoproxy.LockRequest_Action", LockRequest_Action_name, LockRequest_Action_value)
+	proto.RegisterType((*GetRequest)(nil), "topoproxy.GetRequest")
+	proto.RegisterType((*GetResponse)(nil), "topoproxy.GetResponse")
+	proto.RegisterType((*CreateRequest)(nil), "topoproxy.CreateRequest")
+	proto.RegisterType((*CreateResponse)(nil), "topoproxy.CreateResponse")
+	proto.RegisterType((*UpdateRequest)(nil), "topoproxy.UpdateRequest")
+	proto.RegisterType((*UpdateResponse)(nil), "topoproxy.UpdateResponse")
+	proto.RegisterType((*DeleteRequest)(nil), "topoproxy.DeleteRequest")
+	proto.RegisterType((*DeleteResponse)(nil), "topoproxy.DeleteResponse")
+	proto.RegisterType((*ListDirRequest)(nil), "topoproxy.ListDirRequest")
+	proto.RegisterType((*DirEntry)(nil), "topoproxy.DirEntry")
+	proto.RegisterType((*ListDirResponse)(nil), "topoproxy.ListDirResponse")
+	proto.RegisterType((*WatchRequest)(nil), "topoproxy.WatchRequest")
+	proto.RegisterType((*WatchResponse)(nil), "topoproxy.WatchResponse")
+	proto.RegisterType((*LockRequest)(nil), "topoproxy.LockRequest")
+	proto.RegisterType((*LockResponse)(nil), "topoproxy.LockResponse")
+	proto.RegisterType((*WaitForMastershipRequest)(nil), "topoproxy.WaitForMastershipRequest")
+	proto.RegisterType((*WaitForMastershipResponse)(nil), "topoproxy.WaitForMastershipResponse")
+	proto.RegisterType((*GetCurrentMasterIDRequest)(nil), "topoproxy.GetCurrentMasterIDRequest")
+	proto.RegisterType((*GetCurrentMasterIDResponse)(nil), "topoproxy.GetCurrentMasterIDResponse")
+}
+
+func init() { proto.RegisterFile("topoproxy.proto", fileDescriptor_ba9169467365fc00) }
+
+var fileDescriptor_ba9169467365fc00 = []byte{
+	// 545 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xdf, 0x6f, 0xd2, 0x50,
+	0x18, 0x5d, 0x4b, 0x65, 0xf4, 0x1b, 0x74, 0xe4, 0xfa, 0xc2, 0x36, 0x8d, 0xe6, 0x3e, 0x18, 0x5c,
+	0x14, 0x12, 0xfc, 0x91, 0xf8, 0xa6, 0x16, 0x36, 0xc9, 0xd0, 0xc5, 0x6e, 0xcb, 0xa2, 0x2f, 0xa6,
+	0xc2, 0xa7, 0xdc, 0x08, 0xbd, 0xf5, 0xf6, 0x83, 0xc8, 0xdf, 0xe0, 0x9f, 0xe3, 0x3f, 0x68, 0x7a,
+	0xdb, 0x0b, 0x25, 0xc1, 0x2c, 0x64, 0xbe, 0x7d, 0x3d, 0x9c, 0xef, 0x9c, 0xc3, 0xed, 0xb9, 0x85,
+	0x7d, 0x92, 0xb1, 0x8c, 0x95, 0xfc, 0xb5, 0x68, 0xc5, 0x4a, 0x92, 0x64, 0xee, 0x12, 0xe0, 0xcf,
+	0x01, 0x4e, 0x91, 0x02, 0xfc, 0x39, 0xc3, 0x84, 0x18, 0x03, 0x67, 0x88, 0x93, 0x49, 0xc3, 0x7a,
+	0x68, 0x35, 0xdd, 0x40, 0xcf, 0x29, 0x16, 0x87, 0x34, 0x6e, 0xd8, 0x19, 0x96, 0xce, 0xdc, 0x87,
+	0x3d, 0xbd, 0x95, 0xc4, 0x32, 0x4a, 0x90, 0x1d, 0x42, 0x65, 0x28, 0x23, 0xc2, 0x88, 0x12, 0xbd,
+	0x5a, 0x0d, 0x96, 0xcf, 0xac, 0x01, 0xbb, 0x73, 0x54, 0x89, 0x90, 0x51, 0xae, 0x60, 0x1e, 0xf9,
+	0x05, 0xd4, 0x7c, 0x85, 0x21, 0xe1, 0x96, 0xee, 0x6b, 0x76, 0xa5, 0x75, 0x3b, 0x7e, 0x0c, 0x9e,
+	0x11, 0xcd, 0xc3, 0x15, 0x02, 0x58, 0xeb, 0x01, 0xa6, 0x50, 0xbb, 0x8a, 0x47, 0xff, 0x37, 0x40,
+	0xd1, 0xce, 0x59, 0xb7, 0x3b, 0x06, 0xcf, 0xd8, 0xdd, 0x18, 0xed, 0x23, 0xd4, 0xba, 0x38, 0xc1,
+	0xed, 0xa3, 0x15, 0x24, 0x4b, 0xeb, 0x92, 0x75, 0xf0, 0x8c, 0x64, 0x66, 0xcf, 0x07, 0xe0, 0x0d,
+	0x44, 0x42, 0x5d, 0xa1, 0xb6, 0x75, 0x61, 0xe0, 0x7c, 0x9b, 0x4d, 0x26, 0xda, 0xa2, 0x12, 0xe8,
+	0x99, 0xff, 0xb6, 0xa0, 0xd2, 0x15, 0xaa, 0x17, 0x91, 0x5a, 0xa4, 0x84, 0x28, 0x9c, 0xa2, 0x11,
+	0x4a, 0x67, 0xf6, 0x04, 0x1c, 0x5a, 0xc4, 0xa8, 0x85, 0xbc, 0x4e, 0xa3, 0xb5, 0x6a, 0xa5, 0x59,
+	0x6b, 0x5d, 0x2e, 0x62, 0x0c, 0x34, 0x8b, 0xdd, 0x03, 0x17, 0xe3, 0x31, 0x4e, 0x51, 0x85, 0xc6,
+	0x67, 0x05, 0xf0, 0x07, 0xe0, 0xa4, 0x5c, 0x56, 0x03, 0xb7, 0xdb, 0x0f, 0x7a, 0xfe, 0xe5, 0x79,
+	0xf0, 0xa9, 0xbe, 0xc3, 0x2a, 0xe0, 0x9c, 0xf4, 0x07, 0xbd, 0xba, 0xc5, 0x5f, 0xc3, 0xfe, 0xf2,
+	0xbf, 0xe5, 0xa7, 0xfd, 0x14, 0x76, 0x31, 0x22, 0x25, 0x30, 0x2d, 0x69, 0xa9, 0xb9, 0xd7, 0xb9,
+	0xbb, 0x21, 0x42, 0x60, 0x38, 0xfc, 0x25, 0x54, 0xaf, 0x43, 0x1a, 0x8e, 0xb7, 0xbd, 0x1b, 0x3d,
+	0xa8, 0xe5, 0x7b, 0xb7, 0xba, 0x1d, 0x7f, 0x2c, 0xd8, 0x1b, 0xc8, 0xe1, 0x0f, 0x63, 0xff, 0x02,
+	0xca, 0xe1, 0x90, 0x4c, 0x55, 0xbc, 0xce, 0xfd, 0x42, 0xf8, 0x02, 0xaf, 0xf5, 0x46, 0x93, 0x82,
+	0x9c, 0xbc, 0x4c, 0x6d, 0x6f, 0x48, 0x5d, 0xfa, 0x47, 0xa5, 0xb3, 0xde, 0xae, 0xee, 0xd4, 0x63,
+	0x28, 0x67, 0xaa, 0xe9, 0xf9, 0x0e, 0xce, 0xfd, 0xb3, 0xfa, 0x0e, 0x73, 0xe1, 0x8e, 0xff, 0xae,
+	0xe7, 0x9f, 0xd5, 0x2d, 0x06, 0x50, 0xbe, 0xfa, 0xa0, 0x61, 0x9b, 0x7b, 0x50, 0xcd, 0xc2, 0xe4,
+	0x15, 0x0b, 0xa0, 0x71, 0x1d, 0x0a, 0x3a, 0x91, 0xea, 0x7d, 0x98, 0x10, 0xaa, 0x64, 0x2c, 0xe2,
+	0x1b, 0x0e, 0x54, 0xf7, 0xc6, 0x2e, 0xf4, 0xc6, 0x03, 0x5b, 0x8c, 0xf2, 0xb0, 0xb6, 0x18, 0xf1,
+	0x23, 0x38, 0xd8, 0xa0, 0x99, 0x1b, 0x5e, 0xc0, 0xc1, 0x29, 0x92, 0x3f, 0x53, 0x0a, 0x23, 0xca,
+	0x7e, 0xef, 0x77, 0x6f, 0xeb, 0xf8, 0x0a, 0x0e, 0x37, 0x89, 0xe6, 0xef, 0xf7, 0x08, 0xdc, 0xa9,
+	0xc6, 0xbe, 0x88, 0x51, 0x2e, 0x5d, 0xc9, 0x80, 0xfe, 0xe8, 0x6d, 0xf3, 0xf3, 0xa3, 0xb9, 0x20,
+	0x4c, 0x92, 0x96, 0x90, 0xed, 0x6c, 0x6a, 0x7f, 0x97, 0xed, 0x39, 0xb5, 0xf5, 0xa7, 0xb8, 0xbd,
+	0x7c, 0x89, 0x5f, 0xcb, 0x1a, 0x78, 0xf6, 0x77, 0x00, 0xfb, 0xea, 0x22, 0x85, 0xae, 0x05, 0x00,
+	0x00,
+}
@@ -0,0 +1,528 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: topoproxyservice.proto
+
+package topoproxyservice
+
+import (
+	context "context"
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	topoproxy "vitess.io/vitess/go/vt/proto/topoproxy"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+func init() { proto.RegisterFile("topoproxyservice.proto", fileDescriptor_0b9859721f7c1ede) }
+
+var fileDescriptor_0b9859721f7c1ede = []byte{
+	// 305 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x51, 0x4b, 0x02, 0x41,
+	0x10, 0xc7, 0x93, 0xd2, 0x68, 0x5f, 0xaa, 0x81, 0xd4, 0x7c, 0x2c, 0x83, 0x5e, 0x72, 0xa5, 0xa0,
+	0xb7, 0x0a, 0x54, 0x92, 0xc0, 0x20, 0xa2, 0x10, 0x7a, 0x3b, 0xaf, 0x21, 0x97, 0xc2, 0xd9, 0x76,
+	0xc7, 0xa3, 0xbe, 0x46, 0x9f, 0x38, 0xbc, 0x6d, 0xaf, 0x75, 0x3d, 0xea, 0xed, 0xf8, 0xfd, 0xff,
+	0xf3, 0x9b, 0x63, 0x19, 0x51, 0x67, 0xd2, 0xa4, 0x0d, 0x7d, 0x7c, 0x5a, 0x34, 0x99, 0x4a, 0xb1,
+	0xa3, 0x0d, 0x31, 0xc1, 0x4e, 0xcc, 0x5b, 0xdb, 0x05, 0x71, 0x95, 0xd3, 0xaf, 0xaa, 0xd8, 0x7a,
+	0x20, 0x4d, 0x77, 0x0b, 0x06, 0xe7, 0x62, 0x7d, 0x88, 0x0c, 0x7b, 0x9d, 0xdf, 0xda, 0x10, 0xf9,
+	0x1e, 0xdf, 0xe7, 0x68, 0xb9, 0x55, 0x8f, 0xb1, 0xd5, 0x34, 0xb3, 0x78, 0xb0, 0x06, 0x57, 0xa2,
+	0xd6, 0x37, 0x98, 0x30, 0x42, 0x33, 0xe8, 0x38, 0xe4, 0xa7, 0xf7, 0x4b, 0x92, 0x50, 0xf0, 0xa8,
+	0x9f, 0x63, 0x81, 0x43, 0x65, 0x02, 0x9f, 0x84, 0x82, 0x01, 0xbe, 0x61, 0x24, 0x70, 0xa8, 0x4c,
+	0xe0, 0x93, 0x42, 0xd0, 0x13, 0x9b, 0x23, 0x65, 0x79, 0xa0, 0x0c, 0x84, 0xbd, 0x1f, 0xe6, 0x15,
+	0xad, 0xb2, 0xa8, 0x70, 0x5c, 0x8a, 0xea, 0x38, 0xe1, 0x74, 0x0a, 0x8d, 0xa0, 0x96, 0x13, 0x3f,
+	0xdf, 0x5c, 0x0d, 0xfc, 0x74, 0xb7, 0x02, 0x17, 0x62, 0x63, 0x44, 0xe9, 0x2b, 0x84, 0x0f, 0xbd,
+	0x00, 0x7e, 0xba, 0xb1, 0xc2, 0xfd, 0xf0, 0x71, 0xa5, 0x5b, 0x81, 0x89, 0xd8, 0x1d, 0x27, 0x8a,
+	0xaf, 0xc9, 0xdc, 0x26, 0x96, 0xd1, 0xd8, 0xa9, 0xd2, 0x70, 0xb8, 0xb4, 0x31, 0x4a, 0xbd, 0xb8,
+	0xfd, 0x77, 0x29, 0xf8, 0xc5, 0x54, 0xc0, 0x10, 0xb9, 0x3f, 0x37, 0x06, 0x67, 0xec, 0x3a, 0x37,
+	0x03, 0x68, 0x2f, 0x5f, 0x46, 0x14, 0xfb, 0x2d, 0x47, 0xff, 0xb4, 0xfc, 0x9a, 0x9e, 0x7c, 0x3a,
+	0xc9, 0x14, 0xa3, 0xb5, 0x1d, 0x45, 0xd2, 0x7d, 0xc9, 0x17, 0x92, 0x19, 0xcb, 0xfc, 0x68, 0x65,
+	0x7c, 0xd6, 0x93, 0x5a, 0xce, 0xcf, 0xbe, 0x07, 0x00, 0xd3, 0x9b, 0x83, 0xd7, 0x09, 0x03, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// TopoProxyClient is the client API for TopoProxy service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type TopoProxyClient interface {
+	// Get returns the contents and version of a file.
+	Get(ctx context.Context, in *topoproxy.GetRequest, opts ...grpc.CallOption) (*topoproxy.GetResponse, error)
+	// Create creates a file.
+	Create(ctx context.Context, in *topoproxy.CreateRequest, opts ...grpc.CallOption) (*topoproxy.CreateResponse, error)
+	// Update updates a file.
+	Update(ctx context.Context, in *topoproxy.UpdateRequest, opts ...grpc.CallOption) (*topoproxy.UpdateResponse, error)
+	// Delete deletes a file.
+	Delete(ctx context.Context, in *topoproxy.DeleteRequest, opts ...grpc.CallOption) (*topoproxy.DeleteResponse, error)
+	// ListDir lists the entries of a directory.
+	ListDir(ctx context.Context, in *topoproxy.ListDirRequest, opts ...grpc.CallOption) (*topoproxy.ListDirResponse, error)
+	// Watch streams the values of a file.
+	Watch(ctx context.Context, in *topoproxy.WatchRequest, opts ...grpc.CallOption) (TopoProxy_WatchClient, error)
+	// Lock holds a lock on a directory as long as the stream is open.
+	Lock(ctx context.Context, opts ...grpc.CallOption) (TopoProxy_LockClient, error)
+	// WaitForMastership takes part in a master election, as long as the
+	// stream is open.
+	WaitForMastership(ctx context.Context, in *topoproxy.WaitForMastershipRequest, opts ...grpc.CallOption) (TopoProxy_WaitForMastershipClient, error)
+	// GetCurrentMasterID returns the id of the master of an election.
+	GetCurrentMasterID(ctx context.Context, in *topoproxy.GetCurrentMasterIDRequest, opts ...grpc.CallOption) (*topoproxy.GetCurrentMasterIDResponse, error)
+}
+
+type topoProxyClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewTopoProxyClient(cc *grpc.ClientConn) TopoProxyClient {
+	return &topoProxyClient{cc}
+}
+
+func (c *topoProxyClient) Get(ctx context.Context, in *topoproxy.GetRequest, opts ...grpc.CallOption) (*topoproxy.GetResponse, error) {
+	out := new(topoproxy.GetResponse)
+	err := c.cc.Invoke(ctx, "/topoproxyservice.TopoProxy/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *topoProxyClient) Create(ctx context.Context, in *topoproxy.CreateRequest, opts ...grpc.CallOption) (*topoproxy.CreateResponse, error) {
+	out := new(topoproxy.CreateResponse)
+	err := c.cc.Invoke(ctx, "/topoproxyservice.TopoProxy/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *topoProxyClient) Update(ctx context.Context, in *topoproxy.UpdateRequest, opts ...grpc.CallOption) (*topoproxy.UpdateResponse, error) {
+	out := new(topoproxy.UpdateResponse)
+	err := c.cc.Invoke(ctx, "/topoproxyservice.TopoProxy/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *topoProxyClient) Delete(ctx context.Context, in *topoproxy.DeleteRequest, opts ...grpc.CallOption) (*topoproxy.DeleteResponse, error) {
+	out := new(topoproxy.DeleteResponse)
+	err := c.cc.Invoke(ctx, "/topoproxyservice.TopoProxy/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *topoProxyClient) ListDir(ctx context.Context, in *topoproxy.ListDirRequest, opts ...grpc.CallOption) (*topoproxy.ListDirResponse, error) {
+	out := new(topoproxy.ListDirResponse)
+	err := c.cc.Invoke(ctx, "/topoproxyservice.TopoProxy/ListDir", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *topoProxyClient) Watch(ctx context.Context, in *topoproxy.WatchRequest, opts ...grpc.CallOption) (TopoProxy_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_TopoProxy_serviceDesc.Streams[0], "/topoproxyservice.TopoProxy/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &topoProxyWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TopoProxy_WatchClient interface {
+	Recv() (*topoproxy.WatchResponse, error)
+	grpc.ClientStream
+}
+
+type topoProxyWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *topoProxyWatchClient) Recv() (*topoproxy.WatchResponse, error) {
+	m := new(topoproxy.WatchResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *topoProxyClient) Lock(ctx context.Context, opts ...grpc.CallOption) (TopoProxy_LockClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_TopoProxy_serviceDesc.Streams[1], "/topoproxyservice.TopoProxy/Lock", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &topoProxyLockClient{stream}
+	return x, nil
+}
+
+type TopoProxy_LockClient interface {
+	Send(*topoproxy.LockRequest) error
+	Recv() (*topoproxy.LockResponse, error)
+	grpc.ClientStream
+}
+
+type topoProxyLockClient struct {
+	grpc.ClientStream
+}
+
+func (x *topoProxyLockClient) Send(m *topoproxy.LockRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *topoProxyLockClient) Recv() (*topoproxy.LockResponse, error) {
+	m := new(topoproxy.LockResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *topoProxyClient) WaitForMastership(ctx context.Context, in *topoproxy.WaitForMastershipRequest, opts ...grpc.CallOption) (TopoProxy_WaitForMastershipClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_TopoProxy_serviceDesc.Streams[2], "/topoproxyservice.TopoProxy/WaitForMastership", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &topoProxyWaitForMastershipClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TopoProxy_WaitForMastershipClient interface {
+	Recv() (*topoproxy.WaitForMastershipResponse, error)
+	grpc.ClientStream
+}
+
+type topoProxyWaitForMastershipClient struct {
+	grpc.ClientStream
+}
+
+func (x *topoProxyWaitForMastershipClient) Recv() (*topoproxy.WaitForMastershipResponse, error) {
+	m := new(topoproxy.WaitForMastershipResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *topoProxyClient) GetCurrentMasterID(ctx context.Context, in *topoproxy.GetCurrentMasterIDRequest, opts ...grpc.CallOption) (*topoproxy.GetCurrentMasterIDResponse, error) {
+	out := new(topoproxy.GetCurrentMasterIDResponse)
+	err := c.cc.Invoke(ctx, "/topoproxyservice.TopoProxy/GetCurrentMasterID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TopoProxyServer is the server API for TopoProxy service.
+type TopoProxyServer interface {
+	// Get returns the contents and version of a file.
+	Get(context.Context, *topoproxy.GetRequest) (*topoproxy.GetResponse, error)
+	// Create creates a file.
+	Create(context.Context, *topoproxy.CreateRequest) (*topoproxy.CreateResponse, error)
+	// Update updates a file.
+	Update(context.Context, *topoproxy.UpdateRequest) (*topoproxy.UpdateResponse, error)
+	// Delete deletes a file.
+	Delete(context.Context, *topoproxy.DeleteRequest) (*topoproxy.DeleteResponse, error)
+	// ListDir lists the entries of a directory.
+	ListDir(context.Context, *topoproxy.ListDirRequest) (*topoproxy.ListDirResponse, error)
+	// Watch streams the values of a file.
+	Watch(*topoproxy.WatchRequest, TopoProxy_WatchServer) error
+	// Lock holds a lock on a directory as long as the stream is open.
+	Lock(TopoProxy_LockServer) error
+	// WaitForMastership takes part in a master election, as long as the
+	// stream is open.
+	WaitForMastership(*topoproxy.WaitForMastershipRequest, TopoProxy_WaitForMastershipServer) error
+	// GetCurrentMasterID returns the id of the master of an election.
+	GetCurrentMasterID(context.Context, *topoproxy.GetCurrentMasterIDRequest) (*topoproxy.GetCurrentMasterIDResponse, error)
+}
+
+// UnimplementedTopoProxyServer can be embedded to have forward compatible implementations.
+type UnimplementedTopoProxyServer struct {
+}
+
+func (*UnimplementedTopoProxyServer) Get(ctx context.Context, req *topoproxy.GetRequest) (*topoproxy.GetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (*UnimplementedTopoProxyServer) Create(ctx context.Context, req *topoproxy.CreateRequest) (*topoproxy.CreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (*UnimplementedTopoProxyServer) Update(ctx context.Context, req *topoproxy.UpdateRequest) (*topoproxy.UpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (*UnimplementedTopoProxyServer) Delete(ctx context.Context, req *topoproxy.DeleteRequest) (*topoproxy.DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (*UnimplementedTopoProxyServer) ListDir(ctx context.Context, req *topoproxy.ListDirRequest) (*topoproxy.ListDirResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDir not implemented")
+}
+func (*UnimplementedTopoProxyServer) Watch(req *topoproxy.WatchRequest, srv TopoProxy_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+func (*UnimplementedTopoProxyServer) Lock(srv TopoProxy_LockServer) error {
+	return status.Errorf(codes.Unimplemented, "method Lock not implemented")
+}
+func (*UnimplementedTopoProxyServer) WaitForMastership(req *topoproxy.WaitForMastershipRequest, srv TopoProxy_WaitForMastershipServer) error {
+	return status.Errorf(codes.Unimplemented, "method WaitForMastership not implemented")
+}
+func (*UnimplementedTopoProxyServer) GetCurrentMasterID(ctx context.Context, req *topoproxy.GetCurrentMasterIDRequest) (*topoproxy.GetCurrentMasterIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCurrentMasterID not implemented")
+}
+
+func RegisterTopoProxyServer(s *grpc.Server, srv TopoProxyServer) {
+	s.RegisterService(&_TopoProxy_serviceDesc, srv)
+}
+
+func _TopoProxy_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(topoproxy.GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TopoProxyServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/topoproxyservice.TopoProxy/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TopoProxyServer).Get(ctx, req.(*topoproxy.GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TopoProxy_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(topoproxy.CreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TopoProxyServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/topoproxyservice.TopoProxy/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TopoProxyServer).Create(ctx, req.(*topoproxy.CreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TopoProxy_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(topoproxy.UpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TopoProxyServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/topoproxyservice.TopoProxy/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TopoProxyServer).Update(ctx, req.(*topoproxy.UpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TopoProxy_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(topoproxy.DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TopoProxyServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/topoproxyservice.TopoProxy/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TopoProxyServer).Delete(ctx, req.(*topoproxy.DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TopoProxy_ListDir_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(topoproxy.ListDirRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TopoProxyServer).ListDir(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/topoproxyservice.TopoProxy/ListDir",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TopoProxyServer).ListDir(ctx, req.(*topoproxy.ListDirRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TopoProxy_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(topoproxy.WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TopoProxyServer).Watch(m, &topoProxyWatchServer{stream})
+}
+
+type TopoProxy_WatchServer interface {
+	Send(*topoproxy.WatchResponse) error
+	grpc.ServerStream
+}
+
+type topoProxyWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *topoProxyWatchServer) Send(m *topoproxy.WatchResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TopoProxy_Lock_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TopoProxyServer).Lock(&topoProxyLockServer{stream})
+}
+
+type TopoProxy_LockServer interface {
+	Send(*topoproxy.LockResponse) error
+	Recv() (*topoproxy.LockRequest, error)
+	grpc.ServerStream
+}
+
+type topoProxyLockServer struct {
+	grpc.ServerStream
+}
+
+func (x *topoProxyLockServer) Send(m *topoproxy.LockResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *topoProxyLockServer) Recv() (*topoproxy.LockRequest, error) {
+	m := new(topoproxy.LockRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _TopoProxy_WaitForMastership_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(topoproxy.WaitForMastershipRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TopoProxyServer).WaitForMastership(m, &topoProxyWaitForMastershipServer{stream})
+}
+
+type TopoProxy_WaitForMastershipServer interface {
+	Send(*topoproxy.WaitForMastershipResponse) error
+	grpc.ServerStream
+}
+
+type topoProxyWaitForMastershipServer struct {
+	grpc.ServerStream
+}
+
+func (x *topoProxyWaitForMastershipServer) Send(m *topoproxy.WaitForMastershipResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TopoProxy_GetCurrentMasterID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(topoproxy.GetCurrentMasterIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TopoProxyServer).GetCurrentMasterID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/topoproxyservice.TopoProxy/GetCurrentMasterID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TopoProxyServer).GetCurrentMasterID(ctx, req.(*topoproxy.GetCurrentMasterIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _TopoProxy_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "topoproxyservice.TopoProxy",
+	HandlerType: (*TopoProxyServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _TopoProxy_Get_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _TopoProxy_Create_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _TopoProxy_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _TopoProxy_Delete_Handler,
+		},
+		{
+			MethodName: "ListDir",
+			Handler:    _TopoProxy_ListDir_Handler,
+		},
+		{
+			MethodName: "GetCurrentMasterID",
+			Handler:    _TopoProxy_GetCurrentMasterID_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _TopoProxy_Watch_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Lock",
+			Handler:       _TopoProxy_Lock_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WaitForMastership",
+			Handler:       _TopoProxy_WaitForMastership_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "topoproxyservice.proto",
+}
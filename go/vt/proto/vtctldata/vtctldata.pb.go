@@ -181,8 +181,11 @@ type MaterializeSettings struct {
 	StopAfterCopy bool                        `protobuf:"varint,4,opt,name=stop_after_copy,json=stopAfterCopy,proto3" json:"stop_after_copy,omitempty"`
 	TableSettings []*TableMaterializeSettings `protobuf:"bytes,5,rep,name=table_settings,json=tableSettings,proto3" json:"table_settings,omitempty"`
 	// optional parameters.
-	Cell                 string   `protobuf:"bytes,6,opt,name=cell,proto3" json:"cell,omitempty"`
-	TabletTypes          string   `protobuf:"bytes,7,opt,name=tablet_types,json=tabletTypes,proto3" json:"tablet_types,omitempty"`
+	Cell        string `protobuf:"bytes,6,opt,name=cell,proto3" json:"cell,omitempty"`
+	TabletTypes string `protobuf:"bytes,7,opt,name=tablet_types,json=tabletTypes,proto3" json:"tablet_types,omitempty"`
+	// on_ddl is the name of the binlogdata.OnDDLAction applied by the
+	// streams of the workflow when they encounter a DDL.
+	OnDdl                string   `protobuf:"bytes,8,opt,name=on_ddl,json=onDdl,proto3" json:"on_ddl,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -262,6 +265,13 @@ func (m *MaterializeSettings) GetTabletTypes() string {
 	return ""
 }
 
+func (m *MaterializeSettings) GetOnDdl() string {
+	if m != nil {
+		return m.OnDdl
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ExecuteVtctlCommandRequest)(nil), "vtctldata.ExecuteVtctlCommandRequest")
 	proto.RegisterType((*ExecuteVtctlCommandResponse)(nil), "vtctldata.ExecuteVtctlCommandResponse")
@@ -272,32 +282,33 @@ func init() {
 func init() { proto.RegisterFile("vtctldata.proto", fileDescriptor_f41247b323a1ab2e) }
 
 var fileDescriptor_f41247b323a1ab2e = []byte{
-	// 422 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xd1, 0x6e, 0xd3, 0x30,
-	0x14, 0x86, 0x95, 0xb5, 0x1b, 0xeb, 0x29, 0x4d, 0xc1, 0xdc, 0x58, 0x45, 0x48, 0xa1, 0xc0, 0x88,
-	0x84, 0xd4, 0x48, 0xe3, 0x09, 0xa0, 0xf4, 0x06, 0xc4, 0x4d, 0xa8, 0x40, 0xe2, 0x26, 0x72, 0x93,
-	0xb3, 0xc8, 0x9a, 0x1b, 0x07, 0xfb, 0xa4, 0x5b, 0x79, 0x03, 0x5e, 0x86, 0x67, 0x44, 0xb6, 0xb3,
-	0x70, 0xb3, 0xdd, 0x1d, 0x7f, 0xe7, 0xb7, 0xfd, 0x9f, 0x5f, 0x07, 0xe6, 0x07, 0x2a, 0x49, 0x55,
-	0x82, 0xc4, 0xaa, 0x35, 0x9a, 0x34, 0x9b, 0x0c, 0x60, 0x31, 0x53, 0xba, 0xee, 0x48, 0xaa, 0xd0,
-	0x59, 0xfe, 0x80, 0xc5, 0xe6, 0x16, 0xcb, 0x8e, 0xf0, 0xbb, 0x93, 0xac, 0xf5, 0x7e, 0x2f, 0x9a,
-	0x2a, 0xc7, 0x5f, 0x1d, 0x5a, 0x62, 0x0c, 0xc6, 0xc2, 0xd4, 0x96, 0x47, 0xc9, 0x28, 0x9d, 0xe4,
-	0xbe, 0x66, 0x6f, 0x20, 0x16, 0x25, 0x49, 0xdd, 0x14, 0x24, 0xf7, 0xa8, 0x3b, 0xe2, 0x27, 0x49,
-	0x94, 0x8e, 0xf2, 0x59, 0xa0, 0xdb, 0x00, 0x97, 0x6b, 0x78, 0x7e, 0xef, 0xc3, 0xb6, 0xd5, 0x8d,
-	0x45, 0xf6, 0x1a, 0x4e, 0xf1, 0x80, 0x0d, 0xf1, 0x28, 0x89, 0xd2, 0xe9, 0x65, 0xbc, 0xba, 0xb3,
-	0xb5, 0x71, 0x34, 0x0f, 0xcd, 0xe5, 0x9f, 0x08, 0xf8, 0x56, 0xec, 0x14, 0x7e, 0x15, 0x84, 0x46,
-	0x0a, 0x25, 0x7f, 0xe3, 0x37, 0x24, 0x92, 0x4d, 0x6d, 0xd9, 0x4b, 0x78, 0x4c, 0xc2, 0xd4, 0x48,
-	0x05, 0x39, 0x89, 0x7f, 0x69, 0x92, 0x4f, 0x03, 0xf3, 0xb7, 0xd8, 0x3b, 0x78, 0x6a, 0x75, 0x67,
-	0x4a, 0x2c, 0xf0, 0xb6, 0x35, 0x68, 0xad, 0xd4, 0x8d, 0xb7, 0x3b, 0xc9, 0x9f, 0x84, 0xc6, 0x66,
-	0xe0, 0xec, 0x05, 0x40, 0x69, 0x50, 0x10, 0x16, 0x55, 0xa5, 0xf8, 0xc8, 0xab, 0x26, 0x81, 0x7c,
-	0xaa, 0xd4, 0xf2, 0xef, 0x09, 0x3c, 0xbb, 0xcf, 0xc6, 0x02, 0xce, 0x6f, 0xb4, 0xb9, 0xbe, 0x52,
-	0xfa, 0xa6, 0xb7, 0x30, 0x9c, 0xd9, 0x5b, 0x98, 0xf7, 0xff, 0x5f, 0xe3, 0xd1, 0xb6, 0xa2, 0xc4,
-	0xfe, 0xf7, 0x38, 0xe0, 0x2f, 0x3d, 0x75, 0xc2, 0x7e, 0x96, 0x41, 0x18, 0x0c, 0xc4, 0x01, 0x0f,
-	0xc2, 0x0b, 0x98, 0x5b, 0xd2, 0x6d, 0x21, 0xae, 0x08, 0x4d, 0x51, 0xea, 0xf6, 0xc8, 0xc7, 0x49,
-	0x94, 0x9e, 0xe7, 0x33, 0x87, 0x3f, 0x38, 0xba, 0xd6, 0xed, 0x91, 0x7d, 0x86, 0xd8, 0xa7, 0x52,
-	0xd8, 0xde, 0x27, 0x3f, 0x4d, 0x46, 0xe9, 0xf4, 0xf2, 0xd5, 0xea, 0xff, 0x6e, 0x3c, 0x94, 0x6c,
-	0x3e, 0xf3, 0x57, 0x87, 0x09, 0x19, 0x8c, 0x4b, 0x54, 0x8a, 0x9f, 0x79, 0x47, 0xbe, 0x0e, 0xe1,
-	0xef, 0x94, 0x0b, 0xff, 0xd8, 0xa2, 0xe5, 0x8f, 0xee, 0xc2, 0x77, 0x6c, 0xeb, 0xd0, 0xc7, 0xf4,
-	0xe7, 0xc5, 0x41, 0x12, 0x5a, 0xbb, 0x92, 0x3a, 0x0b, 0x55, 0x56, 0xeb, 0xec, 0x40, 0x99, 0x5f,
-	0xbd, 0x6c, 0x30, 0xb2, 0x3b, 0xf3, 0xe0, 0xfd, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x46, 0x37,
-	0xd0, 0x53, 0xb8, 0x02, 0x00, 0x00,
+	// 433 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xdf, 0x6e, 0xd3, 0x30,
+	0x14, 0xc6, 0x95, 0xf5, 0x0f, 0xed, 0x29, 0x4d, 0xc1, 0x08, 0x29, 0x2a, 0x42, 0x0a, 0x05, 0x46,
+	0x24, 0xa4, 0x46, 0x1a, 0x4f, 0x00, 0x5d, 0x6f, 0x40, 0xdc, 0x84, 0x0a, 0x24, 0x6e, 0x22, 0x37,
+	0x39, 0x8b, 0xa2, 0xb9, 0x39, 0x21, 0x3e, 0xe9, 0x56, 0xde, 0x80, 0x07, 0xe3, 0xbd, 0x90, 0xed,
+	0x2c, 0xdc, 0x6c, 0x77, 0xc7, 0xbf, 0xef, 0xb3, 0xfd, 0xf9, 0x93, 0x61, 0x71, 0xe4, 0x8c, 0x55,
+	0x2e, 0x59, 0xae, 0xeb, 0x86, 0x98, 0xc4, 0xb4, 0x07, 0xcb, 0xb9, 0xa2, 0xa2, 0xe5, 0x52, 0x39,
+	0x65, 0xf5, 0x03, 0x96, 0xdb, 0x5b, 0xcc, 0x5a, 0xc6, 0xef, 0xc6, 0xb2, 0xa1, 0xc3, 0x41, 0x56,
+	0x79, 0x82, 0xbf, 0x5a, 0xd4, 0x2c, 0x04, 0x0c, 0x65, 0x53, 0xe8, 0xc0, 0x0b, 0x07, 0xd1, 0x34,
+	0xb1, 0xb3, 0x78, 0x0b, 0xbe, 0xcc, 0xb8, 0xa4, 0x2a, 0xe5, 0xf2, 0x80, 0xd4, 0x72, 0x70, 0x16,
+	0x7a, 0xd1, 0x20, 0x99, 0x3b, 0xba, 0x73, 0x70, 0xb5, 0x81, 0x17, 0xf7, 0x1e, 0xac, 0x6b, 0xaa,
+	0x34, 0x8a, 0x37, 0x30, 0xc2, 0x23, 0x56, 0x1c, 0x78, 0xa1, 0x17, 0xcd, 0x2e, 0xfc, 0xf5, 0x5d,
+	0xac, 0xad, 0xa1, 0x89, 0x13, 0x57, 0x7f, 0x3c, 0x08, 0x76, 0x72, 0xaf, 0xf0, 0xab, 0x64, 0x6c,
+	0x4a, 0xa9, 0xca, 0xdf, 0xf8, 0x0d, 0x99, 0xcb, 0xaa, 0xd0, 0xe2, 0x15, 0x3c, 0x66, 0xd9, 0x14,
+	0xc8, 0x29, 0x1b, 0x8b, 0x3d, 0x69, 0x9a, 0xcc, 0x1c, 0xb3, 0xbb, 0xc4, 0x7b, 0x78, 0xaa, 0xa9,
+	0x6d, 0x32, 0x4c, 0xf1, 0xb6, 0x6e, 0x50, 0xeb, 0x92, 0x2a, 0x1b, 0x77, 0x9a, 0x3c, 0x71, 0xc2,
+	0xb6, 0xe7, 0xe2, 0x25, 0x40, 0xd6, 0xa0, 0x64, 0x4c, 0xf3, 0x5c, 0x05, 0x03, 0xeb, 0x9a, 0x3a,
+	0x72, 0x99, 0xab, 0xd5, 0xdf, 0x33, 0x78, 0x76, 0x5f, 0x8c, 0x25, 0x4c, 0x6e, 0xa8, 0xb9, 0xbe,
+	0x52, 0x74, 0xd3, 0x45, 0xe8, 0xd7, 0xe2, 0x1d, 0x2c, 0xba, 0xfb, 0xaf, 0xf1, 0xa4, 0x6b, 0x99,
+	0x61, 0x77, 0xbb, 0xef, 0xf0, 0x97, 0x8e, 0x1a, 0x63, 0xf7, 0x96, 0xde, 0xe8, 0x02, 0xf8, 0x0e,
+	0xf7, 0xc6, 0x73, 0x58, 0x68, 0xa6, 0x3a, 0x95, 0x57, 0x8c, 0x4d, 0x9a, 0x51, 0x7d, 0x0a, 0x86,
+	0xa1, 0x17, 0x4d, 0x92, 0xb9, 0xc1, 0x1f, 0x0d, 0xdd, 0x50, 0x7d, 0x12, 0x9f, 0xc1, 0xb7, 0xad,
+	0xa4, 0xba, 0xcb, 0x19, 0x8c, 0xc2, 0x41, 0x34, 0xbb, 0x78, 0xbd, 0xfe, 0xff, 0x37, 0x1e, 0x6a,
+	0x36, 0x99, 0xdb, 0xad, 0xfd, 0x0b, 0x05, 0x0c, 0x33, 0x54, 0x2a, 0x18, 0xdb, 0x44, 0x76, 0x76,
+	0xe5, 0xef, 0x95, 0x29, 0xff, 0x54, 0xa3, 0x0e, 0x1e, 0xdd, 0x95, 0x6f, 0xd8, 0xce, 0x20, 0xf1,
+	0x1c, 0xc6, 0x54, 0xd9, 0x2e, 0x27, 0x56, 0x1c, 0x51, 0x75, 0x99, 0xab, 0x4f, 0xd1, 0xcf, 0xf3,
+	0x63, 0xc9, 0xa8, 0xf5, 0xba, 0xa4, 0xd8, 0x4d, 0x71, 0x41, 0xf1, 0x91, 0x63, 0xfb, 0x23, 0xe3,
+	0x3e, 0xdf, 0x7e, 0x6c, 0xc1, 0x87, 0x7f, 0x03, 0x00, 0xf1, 0x4b, 0x71, 0x5b, 0xcf, 0x02, 0x00,
+	0x00,
 }
@@ -142,16 +142,17 @@ type Session struct {
 	SystemVariables map[string]string `protobuf:"bytes,14,rep,name=system_variables,json=systemVariables,proto3" json:"system_variables,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// row_count keeps track of the last seen rows affected for this session
 	RowCount int64 `protobuf:"varint,15,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
-	// Stores savepoint and release savepoint calls inside a transaction
-	// and is reset once transaction is committed or rolled back.
-	Savepoints []string `protobuf:"bytes,16,rep,name=savepoints,proto3" json:"savepoints,omitempty"`
 	// in_reserved_conn is set to true if the session should be using reserved connections.
 	InReservedConn bool `protobuf:"varint,17,opt,name=in_reserved_conn,json=inReservedConn,proto3" json:"in_reserved_conn,omitempty"`
 	// lock_session keep tracks of shard on which the lock query is sent.
-	LockSession          *Session_ShardSession `protobuf:"bytes,18,opt,name=lock_session,json=lockSession,proto3" json:"lock_session,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	LockSession *Session_ShardSession `protobuf:"bytes,18,opt,name=lock_session,json=lockSession,proto3" json:"lock_session,omitempty"`
+	// savepoints tracks the savepoints of the current transaction, in
+	// the order they were created, along with the shard sessions that
+	// existed at each of them.
+	Savepoints           []*Session_Savepoint `protobuf:"bytes,19,rep,name=savepoints,proto3" json:"savepoints,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *Session) Reset()         { *m = Session{} }
@@ -277,13 +278,6 @@ func (m *Session) GetRowCount() int64 {
 	return 0
 }
 
-func (m *Session) GetSavepoints() []string {
-	if m != nil {
-		return m.Savepoints
-	}
-	return nil
-}
-
 func (m *Session) GetInReservedConn() bool {
 	if m != nil {
 		return m.InReservedConn
@@ -298,6 +292,13 @@ func (m *Session) GetLockSession() *Session_ShardSession {
 	return nil
 }
 
+func (m *Session) GetSavepoints() []*Session_Savepoint {
+	if m != nil {
+		return m.Savepoints
+	}
+	return nil
+}
+
 type Session_ShardSession struct {
 	Target        *query.Target         `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
 	TransactionId int64                 `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
@@ -362,6 +363,58 @@ func (m *Session_ShardSession) GetReservedId() int64 {
 	return 0
 }
 
+// Savepoint is a savepoint of the current transaction.
+type Session_Savepoint struct {
+	// name is the savepoint identifier.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// shard_sessions are the shard transactions that were open when
+	// the savepoint was created. Transactions that were opened later
+	// are rolled back entirely by a rollback to the savepoint.
+	ShardSessions        []*Session_ShardSession `protobuf:"bytes,2,rep,name=shard_sessions,json=shardSessions,proto3" json:"shard_sessions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *Session_Savepoint) Reset()         { *m = Session_Savepoint{} }
+func (m *Session_Savepoint) String() string { return proto.CompactTextString(m) }
+func (*Session_Savepoint) ProtoMessage()    {}
+func (*Session_Savepoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab96496ceaf1ebb, []int{0, 3}
+}
+
+func (m *Session_Savepoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Session_Savepoint.Unmarshal(m, b)
+}
+func (m *Session_Savepoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Session_Savepoint.Marshal(b, m, deterministic)
+}
+func (m *Session_Savepoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Session_Savepoint.Merge(m, src)
+}
+func (m *Session_Savepoint) XXX_Size() int {
+	return xxx_messageInfo_Session_Savepoint.Size(m)
+}
+func (m *Session_Savepoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_Session_Savepoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Session_Savepoint proto.InternalMessageInfo
+
+func (m *Session_Savepoint) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Session_Savepoint) GetShardSessions() []*Session_ShardSession {
+	if m != nil {
+		return m.ShardSessions
+	}
+	return nil
+}
+
 // ExecuteRequest is the payload to Execute.
 type ExecuteRequest struct {
 	// caller_id identifies the caller. This is the effective caller ID,
@@ -885,11 +938,14 @@ type VStreamRequest struct {
 	// position specifies the starting point of the bin log positions
 	// as well as the keyspace-shards to pull events from.
 	// position is of the form 'ks1:0@MySQL56/<mysql_pos>|ks2:-80@MySQL56/<mysql_pos>'.
-	Vgtid                *binlogdata.VGtid  `protobuf:"bytes,3,opt,name=vgtid,proto3" json:"vgtid,omitempty"`
-	Filter               *binlogdata.Filter `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+	Vgtid  *binlogdata.VGtid  `protobuf:"bytes,3,opt,name=vgtid,proto3" json:"vgtid,omitempty"`
+	Filter *binlogdata.Filter `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	// stream_id optionally names the stream. A named stream accepts
+	// VStreamCopyTables requests while it is in flight.
+	StreamId             string   `protobuf:"bytes,5,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *VStreamRequest) Reset()         { *m = VStreamRequest{} }
@@ -945,6 +1001,13 @@ func (m *VStreamRequest) GetFilter() *binlogdata.Filter {
 	return nil
 }
 
+func (m *VStreamRequest) GetStreamId() string {
+	if m != nil {
+		return m.StreamId
+	}
+	return ""
+}
+
 // VStreamResponse is streamed by VStream.
 type VStreamResponse struct {
 	Events               []*binlogdata.VEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
@@ -985,6 +1048,107 @@ func (m *VStreamResponse) GetEvents() []*binlogdata.VEvent {
 	return nil
 }
 
+// VStreamCopyTablesRequest is the payload for VStreamCopyTables.
+type VStreamCopyTablesRequest struct {
+	CallerId *vtrpc.CallerID `protobuf:"bytes,1,opt,name=caller_id,json=callerId,proto3" json:"caller_id,omitempty"`
+	// stream_id is the name the in-flight stream was started with.
+	StreamId string `protobuf:"bytes,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	// keyspace is the keyspace of the tables.
+	Keyspace string `protobuf:"bytes,3,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	// tables are the tables to copy. Every shard of the keyspace
+	// that the stream reads from snapshots them and then streams
+	// their changes along with the tables it already had.
+	Tables               []string `protobuf:"bytes,4,rep,name=tables,proto3" json:"tables,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VStreamCopyTablesRequest) Reset()         { *m = VStreamCopyTablesRequest{} }
+func (m *VStreamCopyTablesRequest) String() string { return proto.CompactTextString(m) }
+func (*VStreamCopyTablesRequest) ProtoMessage()    {}
+func (*VStreamCopyTablesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab96496ceaf1ebb, []int{11}
+}
+
+func (m *VStreamCopyTablesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VStreamCopyTablesRequest.Unmarshal(m, b)
+}
+func (m *VStreamCopyTablesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VStreamCopyTablesRequest.Marshal(b, m, deterministic)
+}
+func (m *VStreamCopyTablesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VStreamCopyTablesRequest.Merge(m, src)
+}
+func (m *VStreamCopyTablesRequest) XXX_Size() int {
+	return xxx_messageInfo_VStreamCopyTablesRequest.Size(m)
+}
+func (m *VStreamCopyTablesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VStreamCopyTablesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VStreamCopyTablesRequest proto.InternalMessageInfo
+
+func (m *VStreamCopyTablesRequest) GetCallerId() *vtrpc.CallerID {
+	if m != nil {
+		return m.CallerId
+	}
+	return nil
+}
+
+func (m *VStreamCopyTablesRequest) GetStreamId() string {
+	if m != nil {
+		return m.StreamId
+	}
+	return ""
+}
+
+func (m *VStreamCopyTablesRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *VStreamCopyTablesRequest) GetTables() []string {
+	if m != nil {
+		return m.Tables
+	}
+	return nil
+}
+
+// VStreamCopyTablesResponse is the returned value from VStreamCopyTables.
+type VStreamCopyTablesResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VStreamCopyTablesResponse) Reset()         { *m = VStreamCopyTablesResponse{} }
+func (m *VStreamCopyTablesResponse) String() string { return proto.CompactTextString(m) }
+func (*VStreamCopyTablesResponse) ProtoMessage()    {}
+func (*VStreamCopyTablesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab96496ceaf1ebb, []int{12}
+}
+
+func (m *VStreamCopyTablesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VStreamCopyTablesResponse.Unmarshal(m, b)
+}
+func (m *VStreamCopyTablesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VStreamCopyTablesResponse.Marshal(b, m, deterministic)
+}
+func (m *VStreamCopyTablesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VStreamCopyTablesResponse.Merge(m, src)
+}
+func (m *VStreamCopyTablesResponse) XXX_Size() int {
+	return xxx_messageInfo_VStreamCopyTablesResponse.Size(m)
+}
+func (m *VStreamCopyTablesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VStreamCopyTablesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VStreamCopyTablesResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("vtgate.TransactionMode", TransactionMode_name, TransactionMode_value)
 	proto.RegisterEnum("vtgate.CommitOrder", CommitOrder_name, CommitOrder_value)
@@ -992,6 +1156,7 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "vtgate.Session.SystemVariablesEntry")
 	proto.RegisterMapType((map[string]*query.BindVariable)(nil), "vtgate.Session.UserDefinedVariablesEntry")
 	proto.RegisterType((*Session_ShardSession)(nil), "vtgate.Session.ShardSession")
+	proto.RegisterType((*Session_Savepoint)(nil), "vtgate.Session.Savepoint")
 	proto.RegisterType((*ExecuteRequest)(nil), "vtgate.ExecuteRequest")
 	proto.RegisterType((*ExecuteResponse)(nil), "vtgate.ExecuteResponse")
 	proto.RegisterType((*ExecuteBatchRequest)(nil), "vtgate.ExecuteBatchRequest")
@@ -1002,85 +1167,93 @@ func init() {
 	proto.RegisterType((*ResolveTransactionResponse)(nil), "vtgate.ResolveTransactionResponse")
 	proto.RegisterType((*VStreamRequest)(nil), "vtgate.VStreamRequest")
 	proto.RegisterType((*VStreamResponse)(nil), "vtgate.VStreamResponse")
+	proto.RegisterType((*VStreamCopyTablesRequest)(nil), "vtgate.VStreamCopyTablesRequest")
+	proto.RegisterType((*VStreamCopyTablesResponse)(nil), "vtgate.VStreamCopyTablesResponse")
 }
 
 func init() { proto.RegisterFile("vtgate.proto", fileDescriptor_aab96496ceaf1ebb) }
 
 var fileDescriptor_aab96496ceaf1ebb = []byte{
-	// 1200 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x6d, 0x6e, 0xdb, 0x46,
-	0x13, 0x0e, 0x45, 0x7d, 0x71, 0xf4, 0xc5, 0x6c, 0x9c, 0xbc, 0x8c, 0xdf, 0xb4, 0x15, 0x94, 0x04,
-	0x51, 0xd2, 0x42, 0x2a, 0x5c, 0xb4, 0x08, 0x8a, 0x16, 0x85, 0x2d, 0x2b, 0x81, 0x82, 0x38, 0x72,
-	0x57, 0xb2, 0x03, 0x14, 0x2d, 0x08, 0x46, 0xdc, 0x28, 0x8b, 0xc8, 0x5c, 0x66, 0x77, 0x25, 0x57,
-	0xa7, 0xe8, 0xff, 0x5e, 0xa0, 0x47, 0xe8, 0x1d, 0xfa, 0xaf, 0x87, 0xe8, 0x3d, 0x8a, 0xdd, 0x25,
-	0x25, 0x5a, 0x75, 0x1b, 0x27, 0x81, 0xff, 0x08, 0x9c, 0x8f, 0x1d, 0xce, 0x3c, 0xcf, 0xcc, 0x70,
-	0x05, 0xd5, 0x85, 0x9c, 0x06, 0x92, 0x74, 0x62, 0xce, 0x24, 0x43, 0x45, 0x23, 0x6d, 0xbb, 0x2f,
-	0x68, 0x34, 0x63, 0xd3, 0x30, 0x90, 0x81, 0xb1, 0x6c, 0x57, 0xde, 0xcc, 0x09, 0x5f, 0x26, 0x42,
-	0x5d, 0xb2, 0x98, 0x65, 0x8d, 0x0b, 0xc9, 0xe3, 0x89, 0x11, 0x5a, 0x7f, 0x39, 0x50, 0x1a, 0x11,
-	0x21, 0x28, 0x8b, 0xd0, 0x5d, 0xa8, 0xd3, 0xc8, 0x97, 0x3c, 0x88, 0x44, 0x30, 0x91, 0x94, 0x45,
-	0x9e, 0xd5, 0xb4, 0xda, 0x65, 0x5c, 0xa3, 0xd1, 0x78, 0xad, 0x44, 0x3d, 0xa8, 0x8b, 0x57, 0x01,
-	0x0f, 0x7d, 0x61, 0xce, 0x09, 0x2f, 0xd7, 0xb4, 0xdb, 0x95, 0x9d, 0x5b, 0x9d, 0x24, 0xbb, 0x24,
-	0x5e, 0x67, 0xa4, 0xbc, 0x12, 0x01, 0xd7, 0x44, 0x46, 0x12, 0xe8, 0x63, 0x80, 0x60, 0x2e, 0xd9,
-	0x84, 0x9d, 0x9c, 0x50, 0xe9, 0xe5, 0xf5, 0x7b, 0x32, 0x1a, 0x74, 0x1b, 0x6a, 0x32, 0xe0, 0x53,
-	0x22, 0x7d, 0x21, 0x39, 0x8d, 0xa6, 0x5e, 0xa1, 0x69, 0xb5, 0x1d, 0x5c, 0x35, 0xca, 0x91, 0xd6,
-	0xa1, 0x2e, 0x94, 0x58, 0x2c, 0x75, 0x0a, 0xc5, 0xa6, 0xd5, 0xae, 0xec, 0x5c, 0xef, 0x98, 0xc2,
-	0xfb, 0x3f, 0x93, 0xc9, 0x5c, 0x92, 0xa1, 0x31, 0xe2, 0xd4, 0x0b, 0xed, 0x81, 0x9b, 0x29, 0xcf,
-	0x3f, 0x61, 0x21, 0xf1, 0x4a, 0x4d, 0xab, 0x5d, 0xdf, 0xf9, 0x5f, 0x9a, 0x7c, 0xa6, 0xd2, 0x03,
-	0x16, 0x12, 0xdc, 0x90, 0x67, 0x15, 0xa8, 0x0b, 0xe5, 0xd3, 0x80, 0x47, 0x34, 0x9a, 0x0a, 0xaf,
-	0xac, 0x0b, 0xbf, 0x96, 0xbc, 0xf5, 0x7b, 0xf5, 0xfb, 0xdc, 0xd8, 0xf0, 0xca, 0x09, 0x7d, 0x07,
-	0xd5, 0x98, 0x93, 0x35, 0x5a, 0xce, 0x05, 0xd0, 0xaa, 0xc4, 0x9c, 0xac, 0xb0, 0xda, 0x85, 0x5a,
-	0xcc, 0x84, 0x5c, 0x47, 0x80, 0x0b, 0x44, 0xa8, 0xaa, 0x23, 0xab, 0x10, 0x77, 0xa0, 0x3e, 0x0b,
-	0x84, 0xf4, 0x69, 0x24, 0x08, 0x97, 0x3e, 0x0d, 0xbd, 0x4a, 0xd3, 0x6a, 0xe7, 0x71, 0x55, 0x69,
-	0x07, 0x5a, 0x39, 0x08, 0xd1, 0x47, 0x00, 0x2f, 0xd9, 0x3c, 0x0a, 0x7d, 0xce, 0x4e, 0x85, 0x57,
-	0xd5, 0x1e, 0x8e, 0xd6, 0x60, 0x76, 0x2a, 0x90, 0x0f, 0x37, 0xe6, 0x82, 0x70, 0x3f, 0x24, 0x2f,
-	0x69, 0x44, 0x42, 0x7f, 0x11, 0x70, 0x1a, 0xbc, 0x98, 0x11, 0xe1, 0xd5, 0x74, 0x42, 0xf7, 0x37,
-	0x13, 0x3a, 0x12, 0x84, 0xef, 0x1b, 0xe7, 0xe3, 0xd4, 0xb7, 0x1f, 0x49, 0xbe, 0xc4, 0x5b, 0xf3,
-	0x73, 0x4c, 0x68, 0x08, 0xae, 0x58, 0x0a, 0x49, 0x4e, 0x32, 0xa1, 0xeb, 0x3a, 0xf4, 0x9d, 0x7f,
-	0xd4, 0xaa, 0xfd, 0x36, 0xa2, 0x36, 0xc4, 0x59, 0x2d, 0xfa, 0x3f, 0x38, 0x9c, 0x9d, 0xfa, 0x13,
-	0x36, 0x8f, 0xa4, 0xd7, 0x68, 0x5a, 0x6d, 0x1b, 0x97, 0x39, 0x3b, 0xed, 0x29, 0x59, 0xb5, 0xa0,
-	0x08, 0x16, 0x24, 0x66, 0x34, 0x92, 0xc2, 0x73, 0x9b, 0x76, 0xdb, 0xc1, 0x19, 0x0d, 0x6a, 0x83,
-	0x4b, 0x23, 0x9f, 0x13, 0x41, 0xf8, 0x82, 0x84, 0xfe, 0x84, 0x45, 0x91, 0x77, 0x55, 0x37, 0x6a,
-	0x9d, 0x46, 0x38, 0x51, 0xf7, 0x58, 0x14, 0x29, 0x86, 0x67, 0x6c, 0xf2, 0x3a, 0x25, 0xc8, 0x43,
-	0xba, 0x19, 0xdf, 0xc2, 0xb0, 0x3a, 0x91, 0x08, 0xdb, 0xbf, 0x5b, 0x50, 0xcd, 0x5a, 0xd1, 0x5d,
-	0x28, 0x9a, 0x4e, 0xd7, 0x23, 0x58, 0xd9, 0xa9, 0x25, 0x2d, 0x36, 0xd6, 0x4a, 0x9c, 0x18, 0xd5,
-	0xc4, 0x66, 0xfb, 0x99, 0x86, 0x5e, 0x4e, 0x17, 0x59, 0xcb, 0x68, 0x07, 0x21, 0x7a, 0x08, 0x55,
-	0xa9, 0x00, 0x91, 0x7e, 0x30, 0xa3, 0x81, 0xf0, 0xec, 0x64, 0x58, 0x56, 0x8b, 0x61, 0xac, 0xad,
-	0xbb, 0xca, 0x88, 0x2b, 0x72, 0x2d, 0xa0, 0x4f, 0xa0, 0xb2, 0x02, 0x80, 0x86, 0x7a, 0x4e, 0x6d,
-	0x0c, 0xa9, 0x6a, 0x10, 0x6e, 0xff, 0x08, 0x37, 0xff, 0x95, 0x65, 0xe4, 0x82, 0xfd, 0x9a, 0x2c,
-	0x75, 0x09, 0x0e, 0x56, 0x8f, 0xe8, 0x3e, 0x14, 0x16, 0xc1, 0x6c, 0x4e, 0x74, 0x9e, 0xeb, 0xc9,
-	0xd9, 0xa3, 0xd1, 0xea, 0x2c, 0x36, 0x1e, 0x5f, 0xe7, 0x1e, 0x5a, 0xdb, 0x7b, 0xb0, 0x75, 0x1e,
-	0xd1, 0xe7, 0x04, 0xde, 0xca, 0x06, 0x76, 0x32, 0x31, 0x9e, 0xe4, 0xcb, 0xb6, 0x9b, 0x6f, 0xfd,
-	0x96, 0x83, 0x7a, 0xb2, 0x15, 0x30, 0x79, 0x33, 0x27, 0x42, 0xa2, 0xcf, 0xc0, 0x99, 0x04, 0xb3,
-	0x19, 0xe1, 0xaa, 0x32, 0x03, 0x73, 0xa3, 0x63, 0x76, 0x63, 0x4f, 0xeb, 0x07, 0xfb, 0xb8, 0x6c,
-	0x3c, 0x06, 0x21, 0xba, 0x0f, 0xa5, 0x94, 0xde, 0xdc, 0xca, 0x37, 0x4b, 0x2f, 0x4e, 0xed, 0xe8,
-	0x1e, 0x14, 0x74, 0x59, 0x09, 0xce, 0x57, 0xd3, 0x22, 0xd5, 0x20, 0xe9, 0x1d, 0x81, 0x8d, 0x1d,
-	0x7d, 0x09, 0x09, 0xd8, 0xbe, 0x5c, 0xc6, 0x44, 0xa3, 0x5b, 0xdf, 0xd9, 0xda, 0xa4, 0x65, 0xbc,
-	0x8c, 0x09, 0x06, 0xb9, 0x7a, 0x56, 0xac, 0xbf, 0x26, 0x4b, 0x11, 0x07, 0x13, 0xe2, 0xeb, 0xad,
-	0xaa, 0xb7, 0x9f, 0x83, 0x6b, 0xa9, 0x56, 0xb7, 0x52, 0x76, 0x3b, 0x96, 0x2e, 0xb2, 0x1d, 0x9f,
-	0xe4, 0xcb, 0x05, 0xb7, 0xd8, 0xfa, 0xc5, 0x82, 0xc6, 0x0a, 0x29, 0x11, 0xb3, 0x48, 0xa8, 0x37,
-	0x16, 0x08, 0xe7, 0x8c, 0x6f, 0xc0, 0x84, 0x0f, 0x7b, 0x7d, 0xa5, 0xc6, 0xc6, 0xfa, 0x2e, 0x18,
-	0x3d, 0x80, 0x22, 0x27, 0x62, 0x3e, 0x93, 0x09, 0x48, 0x28, 0xbb, 0x43, 0xb1, 0xb6, 0xe0, 0xc4,
-	0xa3, 0xf5, 0x67, 0x0e, 0xae, 0x25, 0x19, 0xed, 0x05, 0x72, 0xf2, 0xea, 0xd2, 0x09, 0xfc, 0x14,
-	0x4a, 0x2a, 0x1b, 0x4a, 0xd4, 0xa8, 0xd8, 0xe7, 0x53, 0x98, 0x7a, 0x7c, 0x00, 0x89, 0x81, 0x38,
-	0xf3, 0xb1, 0x2d, 0x98, 0x8f, 0x6d, 0x20, 0xb2, 0x1f, 0xdb, 0x4b, 0xe2, 0xba, 0xf5, 0xab, 0x05,
-	0x5b, 0x67, 0x31, 0xbd, 0x34, 0xaa, 0x3f, 0x87, 0x92, 0x21, 0x32, 0x45, 0xf3, 0x46, 0x92, 0x9b,
-	0xa1, 0xf9, 0x39, 0x95, 0xaf, 0x4c, 0xe8, 0xd4, 0x4d, 0x0d, 0xeb, 0xd6, 0x48, 0x72, 0x12, 0x9c,
-	0x7c, 0xd0, 0xc8, 0xae, 0xe6, 0x30, 0xf7, 0x6e, 0x73, 0x68, 0xbf, 0xf7, 0x1c, 0xe6, 0xdf, 0xc2,
-	0x4d, 0xe1, 0x42, 0xb7, 0x94, 0x0c, 0xb6, 0xc5, 0xff, 0xc6, 0xb6, 0xd5, 0x83, 0xeb, 0x1b, 0x40,
-	0x25, 0x34, 0xae, 0xe7, 0xcb, 0x7a, 0xeb, 0x7c, 0xfd, 0x04, 0x37, 0x31, 0x11, 0x6c, 0xb6, 0x20,
-	0x99, 0xce, 0x7b, 0x3f, 0xc8, 0x11, 0xe4, 0x43, 0x99, 0x7c, 0x86, 0x1c, 0xac, 0x9f, 0x5b, 0xb7,
-	0x60, 0xfb, 0xbc, 0xf0, 0x26, 0xd1, 0xd6, 0x1f, 0x16, 0xd4, 0x8f, 0x4d, 0x0d, 0xef, 0xf7, 0xca,
-	0x0d, 0xf2, 0x72, 0x17, 0x24, 0xef, 0x1e, 0x14, 0x16, 0x53, 0x95, 0x6a, 0xba, 0xa4, 0x33, 0x97,
-	0xe8, 0xe3, 0xc7, 0x92, 0x86, 0xd8, 0xd8, 0x15, 0x92, 0x2f, 0xe9, 0x4c, 0x12, 0xae, 0xd9, 0x55,
-	0x48, 0x66, 0x3c, 0x1f, 0x69, 0x0b, 0x4e, 0x3c, 0x5a, 0xdf, 0x42, 0x63, 0x55, 0xcb, 0x9a, 0x08,
-	0xb2, 0x20, 0xea, 0x86, 0x61, 0xe9, 0xe6, 0x3f, 0x73, 0xfc, 0xb8, 0xaf, 0x4c, 0x38, 0xf1, 0x78,
-	0xb0, 0x0f, 0x8d, 0x8d, 0xeb, 0x27, 0x6a, 0x40, 0xe5, 0xe8, 0xd9, 0xe8, 0xb0, 0xdf, 0x1b, 0x3c,
-	0x1a, 0xf4, 0xf7, 0xdd, 0x2b, 0x08, 0xa0, 0x38, 0x1a, 0x3c, 0x7b, 0xfc, 0xb4, 0xef, 0x5a, 0xc8,
-	0x81, 0xc2, 0xc1, 0xd1, 0xd3, 0xf1, 0xc0, 0xcd, 0xa9, 0xc7, 0xf1, 0xf3, 0xe1, 0x61, 0xcf, 0xb5,
-	0x1f, 0x7c, 0x03, 0x95, 0x9e, 0xbe, 0x44, 0x0f, 0x79, 0x48, 0xb8, 0x3a, 0xf0, 0x6c, 0x88, 0x0f,
-	0x76, 0x9f, 0xba, 0x57, 0x50, 0x09, 0xec, 0x43, 0xac, 0x4e, 0x96, 0x21, 0x7f, 0x38, 0x1c, 0x8d,
-	0xdd, 0x1c, 0xaa, 0x03, 0xec, 0x1e, 0x8d, 0x87, 0xbd, 0xe1, 0xc1, 0xc1, 0x60, 0xec, 0xda, 0x7b,
-	0x5f, 0x41, 0x83, 0xb2, 0xce, 0x82, 0x4a, 0x22, 0x84, 0xf9, 0x8f, 0xf0, 0xc3, 0xed, 0x44, 0xa2,
-	0xac, 0x6b, 0x9e, 0xba, 0x53, 0xd6, 0x5d, 0xc8, 0xae, 0xb6, 0x76, 0x4d, 0x6b, 0xbe, 0x28, 0x6a,
-	0xe9, 0x8b, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xd3, 0x84, 0xbf, 0x5f, 0xa3, 0x0c, 0x00, 0x00,
+	// 1284 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xeb, 0x6e, 0x1b, 0x45,
+	0x14, 0xee, 0xfa, 0xee, 0xe3, 0xdb, 0x76, 0x9a, 0x96, 0x8d, 0x5b, 0xc0, 0x72, 0x5b, 0xd5, 0x2d,
+	0x28, 0x46, 0x41, 0xa0, 0x82, 0x40, 0x28, 0x71, 0xdc, 0xca, 0x55, 0x53, 0x87, 0xb1, 0x93, 0x4a,
+	0x08, 0xb4, 0xda, 0x7a, 0xa7, 0xee, 0xa8, 0xce, 0x8e, 0x3b, 0x33, 0x76, 0xf0, 0x53, 0xf0, 0x1b,
+	0x5e, 0x80, 0x47, 0xe0, 0x39, 0x78, 0x0a, 0xfe, 0xf0, 0x10, 0x68, 0x2e, 0x6b, 0x6f, 0xdc, 0x40,
+	0xd3, 0x94, 0xfe, 0xb1, 0xe6, 0x5c, 0xf7, 0x9c, 0xef, 0x5c, 0x66, 0x0c, 0xe5, 0xb9, 0x1c, 0x07,
+	0x92, 0x6c, 0x4d, 0x39, 0x93, 0x0c, 0xe5, 0x0c, 0x55, 0x77, 0x9f, 0xd1, 0x68, 0xc2, 0xc6, 0x61,
+	0x20, 0x03, 0x23, 0xa9, 0x97, 0x5e, 0xcd, 0x08, 0x5f, 0x58, 0xa2, 0x2a, 0xd9, 0x94, 0x25, 0x85,
+	0x73, 0xc9, 0xa7, 0x23, 0x43, 0x34, 0xff, 0x06, 0xc8, 0x0f, 0x88, 0x10, 0x94, 0x45, 0xe8, 0x36,
+	0x54, 0x69, 0xe4, 0x4b, 0x1e, 0x44, 0x22, 0x18, 0x49, 0xca, 0x22, 0xcf, 0x69, 0x38, 0xad, 0x02,
+	0xae, 0xd0, 0x68, 0xb8, 0x62, 0xa2, 0x0e, 0x54, 0xc5, 0x8b, 0x80, 0x87, 0xbe, 0x30, 0x76, 0xc2,
+	0x4b, 0x35, 0xd2, 0xad, 0xd2, 0xf6, 0x8d, 0x2d, 0x1b, 0x9d, 0xf5, 0xb7, 0x35, 0x50, 0x5a, 0x96,
+	0xc0, 0x15, 0x91, 0xa0, 0x04, 0xfa, 0x08, 0x20, 0x98, 0x49, 0x36, 0x62, 0xc7, 0xc7, 0x54, 0x7a,
+	0x19, 0xfd, 0x9d, 0x04, 0x07, 0xdd, 0x84, 0x8a, 0x0c, 0xf8, 0x98, 0x48, 0x5f, 0x48, 0x4e, 0xa3,
+	0xb1, 0x97, 0x6d, 0x38, 0xad, 0x22, 0x2e, 0x1b, 0xe6, 0x40, 0xf3, 0x50, 0x1b, 0xf2, 0x6c, 0x2a,
+	0x75, 0x08, 0xb9, 0x86, 0xd3, 0x2a, 0x6d, 0x5f, 0xdd, 0x32, 0x89, 0x77, 0x7f, 0x26, 0xa3, 0x99,
+	0x24, 0x7d, 0x23, 0xc4, 0xb1, 0x16, 0xda, 0x05, 0x37, 0x91, 0x9e, 0x7f, 0xcc, 0x42, 0xe2, 0xe5,
+	0x1b, 0x4e, 0xab, 0xba, 0xfd, 0x41, 0x1c, 0x7c, 0x22, 0xd3, 0x7d, 0x16, 0x12, 0x5c, 0x93, 0xa7,
+	0x19, 0xa8, 0x0d, 0x85, 0x93, 0x80, 0x47, 0x34, 0x1a, 0x0b, 0xaf, 0xa0, 0x13, 0xbf, 0x62, 0xbf,
+	0xfa, 0xbd, 0xfa, 0x7d, 0x6a, 0x64, 0x78, 0xa9, 0x84, 0xbe, 0x83, 0xf2, 0x94, 0x93, 0x15, 0x5a,
+	0xc5, 0x73, 0xa0, 0x55, 0x9a, 0x72, 0xb2, 0xc4, 0x6a, 0x07, 0x2a, 0x53, 0x26, 0xe4, 0xca, 0x03,
+	0x9c, 0xc3, 0x43, 0x59, 0x99, 0x2c, 0x5d, 0xdc, 0x82, 0xea, 0x24, 0x10, 0xd2, 0xa7, 0x91, 0x20,
+	0x5c, 0xfa, 0x34, 0xf4, 0x4a, 0x0d, 0xa7, 0x95, 0xc1, 0x65, 0xc5, 0xed, 0x69, 0x66, 0x2f, 0x44,
+	0x1f, 0x02, 0x3c, 0x67, 0xb3, 0x28, 0xf4, 0x39, 0x3b, 0x11, 0x5e, 0x59, 0x6b, 0x14, 0x35, 0x07,
+	0xb3, 0x13, 0x81, 0x7c, 0xb8, 0x36, 0x13, 0x84, 0xfb, 0x21, 0x79, 0x4e, 0x23, 0x12, 0xfa, 0xf3,
+	0x80, 0xd3, 0xe0, 0xd9, 0x84, 0x08, 0xaf, 0xa2, 0x03, 0xba, 0xbb, 0x1e, 0xd0, 0xa1, 0x20, 0x7c,
+	0xcf, 0x28, 0x1f, 0xc5, 0xba, 0xdd, 0x48, 0xf2, 0x05, 0xde, 0x98, 0x9d, 0x21, 0x42, 0x7d, 0x70,
+	0xc5, 0x42, 0x48, 0x72, 0x9c, 0x70, 0x5d, 0xd5, 0xae, 0x6f, 0xbd, 0x96, 0xab, 0xd6, 0x5b, 0xf3,
+	0x5a, 0x13, 0xa7, 0xb9, 0xe8, 0x3a, 0x14, 0x39, 0x3b, 0xf1, 0x47, 0x6c, 0x16, 0x49, 0xaf, 0xd6,
+	0x70, 0x5a, 0x69, 0x5c, 0xe0, 0xec, 0xa4, 0xa3, 0x68, 0xd4, 0x02, 0x97, 0x46, 0x3e, 0x27, 0x82,
+	0xf0, 0x39, 0x09, 0xfd, 0x11, 0x8b, 0x22, 0xef, 0xb2, 0x6e, 0xc4, 0x2a, 0x8d, 0xb0, 0x65, 0x77,
+	0x58, 0x14, 0xa9, 0x0a, 0x4e, 0xd8, 0xe8, 0x65, 0x5c, 0x00, 0x0f, 0x35, 0x9c, 0x37, 0xe2, 0x5f,
+	0x52, 0x16, 0x96, 0x40, 0x5f, 0x01, 0x88, 0x60, 0x4e, 0xa6, 0x8c, 0x46, 0x52, 0x78, 0x57, 0x74,
+	0x4a, 0x9b, 0xaf, 0x99, 0xc7, 0x1a, 0x38, 0xa1, 0x5c, 0xff, 0xc3, 0x81, 0x72, 0xd2, 0x31, 0xba,
+	0x0d, 0x39, 0x33, 0x04, 0x7a, 0x3a, 0x4b, 0xdb, 0x15, 0xdb, 0x7d, 0x43, 0xcd, 0xc4, 0x56, 0xa8,
+	0x86, 0x39, 0xd9, 0xea, 0x34, 0xf4, 0x52, 0x3a, 0xff, 0x4a, 0x82, 0xdb, 0x0b, 0xd1, 0x7d, 0x28,
+	0x4b, 0x85, 0x95, 0xf4, 0x83, 0x09, 0x0d, 0x84, 0x97, 0xb6, 0x73, 0xb4, 0xdc, 0x19, 0x43, 0x2d,
+	0xdd, 0x51, 0x42, 0x5c, 0x92, 0x2b, 0x02, 0x7d, 0x0c, 0xa5, 0x25, 0x76, 0x34, 0xd4, 0x23, 0x9c,
+	0xc6, 0x10, 0xb3, 0x7a, 0x61, 0xfd, 0x47, 0xd8, 0xfc, 0xd7, 0x06, 0x40, 0x2e, 0xa4, 0x5f, 0x92,
+	0x85, 0x4e, 0xa1, 0x88, 0xd5, 0x11, 0xdd, 0x85, 0xec, 0x3c, 0x98, 0xcc, 0x88, 0x8e, 0x73, 0x35,
+	0x54, 0xbb, 0x34, 0x5a, 0xda, 0x62, 0xa3, 0xf1, 0x75, 0xea, 0xbe, 0x53, 0xdf, 0x85, 0x8d, 0xb3,
+	0x7a, 0xe0, 0x0c, 0xc7, 0x1b, 0x49, 0xc7, 0xc5, 0xa4, 0x8f, 0x10, 0x8a, 0x4b, 0xd0, 0x11, 0x82,
+	0x4c, 0x14, 0x1c, 0x13, 0x6b, 0xa9, 0xcf, 0xff, 0xcb, 0xaa, 0x7b, 0x94, 0x29, 0xa4, 0xdd, 0xcc,
+	0xa3, 0x4c, 0xc1, 0x75, 0x2f, 0x37, 0x7f, 0x4f, 0x41, 0xd5, 0x2e, 0x27, 0x4c, 0x5e, 0xcd, 0x88,
+	0x90, 0xe8, 0x53, 0x28, 0x8e, 0x82, 0xc9, 0x84, 0x70, 0x85, 0xa2, 0x29, 0x69, 0x6d, 0xcb, 0xac,
+	0xe8, 0x8e, 0xe6, 0xf7, 0xf6, 0x70, 0xc1, 0x68, 0xf4, 0x42, 0x74, 0x17, 0xf2, 0x71, 0x17, 0xa6,
+	0x96, 0xba, 0xc9, 0x50, 0x70, 0x2c, 0x47, 0x77, 0x20, 0xab, 0x21, 0xb4, 0x35, 0xbd, 0x1c, 0x03,
+	0xaa, 0xe6, 0x59, 0xaf, 0x2a, 0x6c, 0xe4, 0xe8, 0x0b, 0xb0, 0x85, 0xf5, 0xe5, 0x62, 0x4a, 0x74,
+	0x25, 0xab, 0xdb, 0x1b, 0xeb, 0x2d, 0x30, 0x5c, 0x4c, 0x09, 0x06, 0xb9, 0x3c, 0xab, 0x0e, 0x7b,
+	0x49, 0x16, 0x62, 0x1a, 0x8c, 0x88, 0xaf, 0x33, 0xd6, 0x4b, 0xb8, 0x88, 0x2b, 0x31, 0x57, 0x83,
+	0x92, 0x5c, 0xd2, 0xf9, 0xf3, 0x2c, 0xe9, 0x47, 0x99, 0x42, 0xd6, 0xcd, 0x35, 0x7f, 0x71, 0xa0,
+	0xb6, 0x44, 0x4a, 0x4c, 0x59, 0x24, 0xd4, 0x17, 0xb3, 0x84, 0x73, 0xc6, 0xd7, 0x60, 0xc2, 0x07,
+	0x9d, 0xae, 0x62, 0x63, 0x23, 0x7d, 0x1b, 0x8c, 0xee, 0x41, 0x8e, 0x13, 0x31, 0x9b, 0x48, 0x0b,
+	0x12, 0x4a, 0xae, 0x72, 0xac, 0x25, 0xd8, 0x6a, 0x34, 0xff, 0x4c, 0xc1, 0x15, 0x1b, 0xd1, 0x6e,
+	0x20, 0x47, 0x2f, 0xde, 0x7b, 0x01, 0x3f, 0x81, 0xbc, 0x8a, 0x86, 0x12, 0x35, 0x96, 0xe9, 0xb3,
+	0x4b, 0x18, 0x6b, 0xbc, 0x43, 0x11, 0x03, 0x71, 0xea, 0xce, 0xcf, 0x9a, 0x3b, 0x3f, 0x10, 0xc9,
+	0x3b, 0xff, 0x3d, 0xd5, 0xba, 0xf9, 0x9b, 0x03, 0x1b, 0xa7, 0x31, 0x7d, 0x6f, 0xa5, 0xfe, 0x0c,
+	0xf2, 0xa6, 0x90, 0x31, 0x9a, 0xd7, 0x6c, 0x6c, 0xa6, 0xcc, 0x4f, 0xa9, 0x7c, 0x61, 0x5c, 0xc7,
+	0x6a, 0x6a, 0x58, 0x37, 0x06, 0x92, 0x93, 0xe0, 0xf8, 0x9d, 0x46, 0x76, 0x39, 0x87, 0xa9, 0xb7,
+	0x9b, 0xc3, 0xf4, 0x85, 0xe7, 0x30, 0xf3, 0x86, 0xda, 0x64, 0xcf, 0xf5, 0x58, 0x4a, 0x60, 0x9b,
+	0xfb, 0x6f, 0x6c, 0x9b, 0x1d, 0xb8, 0xba, 0x06, 0x94, 0x2d, 0xe3, 0x6a, 0xbe, 0x9c, 0x37, 0xce,
+	0xd7, 0x4f, 0xb0, 0x89, 0x89, 0x60, 0x93, 0x39, 0x49, 0x74, 0xde, 0xc5, 0x20, 0x47, 0x90, 0x09,
+	0xa5, 0xbd, 0xf2, 0x8a, 0x58, 0x9f, 0x9b, 0x37, 0xa0, 0x7e, 0x96, 0x7b, 0x13, 0x68, 0xf3, 0x2f,
+	0x07, 0xaa, 0x47, 0x26, 0x87, 0x8b, 0x7d, 0x72, 0xad, 0x78, 0xa9, 0x73, 0x16, 0xef, 0x0e, 0x64,
+	0xe7, 0x63, 0x15, 0x6a, 0xbc, 0xa4, 0x13, 0x6f, 0xf9, 0xa3, 0x87, 0x92, 0x86, 0xd8, 0xc8, 0x15,
+	0x92, 0xcf, 0xe9, 0x44, 0x12, 0xee, 0x65, 0x2c, 0x92, 0x09, 0xcd, 0x07, 0x5a, 0x82, 0xad, 0x86,
+	0x7a, 0xf6, 0x08, 0x9d, 0x8a, 0x8a, 0xdc, 0x3c, 0x9c, 0x0b, 0x86, 0xd1, 0x0b, 0x9b, 0xdf, 0x42,
+	0x6d, 0x99, 0xe8, 0xaa, 0x4a, 0x64, 0x4e, 0xd4, 0xd3, 0xc4, 0x69, 0xa4, 0xd7, 0x7d, 0x1f, 0x75,
+	0x95, 0x08, 0x5b, 0x8d, 0xe6, 0xaf, 0x0e, 0x78, 0xd6, 0xbe, 0xc3, 0xa6, 0x0b, 0x9d, 0x96, 0xb8,
+	0x18, 0x64, 0xa7, 0xc2, 0x4c, 0x9d, 0x0e, 0x13, 0xd5, 0xa1, 0x10, 0xf7, 0xaf, 0xc6, 0xa6, 0x88,
+	0x97, 0x34, 0xba, 0xa6, 0x9e, 0x40, 0xfa, 0x75, 0x98, 0x69, 0xa4, 0x5b, 0x45, 0x6c, 0xa9, 0xe6,
+	0x75, 0xd8, 0x3c, 0x23, 0x34, 0x93, 0xe4, 0xbd, 0x3d, 0xa8, 0xad, 0xbd, 0xed, 0x51, 0x0d, 0x4a,
+	0x87, 0x4f, 0x06, 0x07, 0xdd, 0x4e, 0xef, 0x41, 0xaf, 0xbb, 0xe7, 0x5e, 0x42, 0x00, 0xb9, 0x41,
+	0xef, 0xc9, 0xc3, 0xc7, 0x5d, 0xd7, 0x41, 0x45, 0xc8, 0xee, 0x1f, 0x3e, 0x1e, 0xf6, 0xdc, 0x94,
+	0x3a, 0x0e, 0x9f, 0xf6, 0x0f, 0x3a, 0x6e, 0xfa, 0xde, 0x37, 0x50, 0xea, 0xe8, 0x7f, 0x28, 0x7d,
+	0x1e, 0x12, 0xae, 0x0c, 0x9e, 0xf4, 0xf1, 0xfe, 0xce, 0x63, 0xf7, 0x12, 0xca, 0x43, 0xfa, 0x00,
+	0x2b, 0xcb, 0x02, 0x64, 0x0e, 0xfa, 0x83, 0xa1, 0x9b, 0x42, 0x55, 0x80, 0x9d, 0xc3, 0x61, 0xbf,
+	0xd3, 0xdf, 0xdf, 0xef, 0x0d, 0xdd, 0xf4, 0xee, 0x97, 0x50, 0xa3, 0x6c, 0x6b, 0x4e, 0x25, 0x11,
+	0xc2, 0xfc, 0x01, 0xfb, 0xe1, 0xa6, 0xa5, 0x28, 0x6b, 0x9b, 0x53, 0x7b, 0xcc, 0xda, 0x73, 0xd9,
+	0xd6, 0xd2, 0xb6, 0x19, 0xb8, 0x67, 0x39, 0x4d, 0x7d, 0xfe, 0xcf, 0x00, 0x77, 0x41, 0x28, 0x78,
+	0x00, 0x0e, 0x00, 0x00,
 }
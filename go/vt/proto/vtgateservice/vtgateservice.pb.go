@@ -29,23 +29,24 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 func init() { proto.RegisterFile("vtgateservice.proto", fileDescriptor_601ae27c95081e0f) }
 
 var fileDescriptor_601ae27c95081e0f = []byte{
-	// 247 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0x3f, 0x4b, 0x03, 0x41,
-	0x10, 0xc5, 0x15, 0x21, 0x81, 0x25, 0x69, 0x46, 0x51, 0x88, 0x5a, 0x98, 0xd2, 0xe2, 0x56, 0xb4,
-	0x15, 0x8b, 0x03, 0x2b, 0x1b, 0x89, 0x92, 0x42, 0xb0, 0x58, 0x97, 0xe1, 0x5c, 0xd0, 0x9b, 0x73,
-	0x67, 0xb2, 0xf8, 0x01, 0xfc, 0xe0, 0xc2, 0xed, 0x9f, 0x70, 0x9e, 0xda, 0xdd, 0xfd, 0xde, 0x9b,
-	0xb7, 0xc3, 0x3c, 0xb5, 0x1f, 0xa4, 0x31, 0x82, 0x8c, 0x3e, 0x38, 0x8b, 0x55, 0xe7, 0x49, 0x08,
-	0xe6, 0x03, 0xb8, 0x98, 0xc5, 0xdf, 0x28, 0x5e, 0x7e, 0xed, 0xa9, 0xc9, 0xda, 0x09, 0x32, 0xc3,
-	0xb5, 0x9a, 0xde, 0x7e, 0xa2, 0xdd, 0x08, 0xc2, 0x61, 0x95, 0x4c, 0x09, 0xac, 0xf0, 0x63, 0x83,
-	0x2c, 0x8b, 0xa3, 0x11, 0xe7, 0x8e, 0x5a, 0xc6, 0xe5, 0x0e, 0xdc, 0xa9, 0x59, 0x82, 0xb5, 0x11,
-	0xfb, 0x0a, 0xc7, 0x3f, 0xac, 0x3d, 0xcd, 0x39, 0x27, 0xbf, 0x8b, 0x25, 0xec, 0x5e, 0xcd, 0x1f,
-	0xc4, 0xa3, 0x79, 0xcf, 0x0b, 0x95, 0x81, 0x01, 0xce, 0x71, 0xa7, 0x7f, 0xa8, 0x39, 0xef, 0x62,
-	0x17, 0x9e, 0x15, 0xac, 0x90, 0xe9, 0x2d, 0xe0, 0xa3, 0x37, 0x2d, 0x1b, 0x2b, 0x8e, 0x5a, 0x38,
-	0xcb, 0x83, 0x63, 0x2d, 0x67, 0x2f, 0xff, 0xb3, 0x94, 0x85, 0x6f, 0xd4, 0x74, 0x1d, 0x1f, 0xdf,
-	0xde, 0x2e, 0x81, 0xd1, 0xed, 0x0a, 0xdf, 0xae, 0x57, 0xd7, 0xea, 0xc0, 0x51, 0x15, 0xfa, 0x22,
-	0x62, 0x33, 0x55, 0xe3, 0x3b, 0xfb, 0x74, 0x9e, 0x90, 0x23, 0x1d, 0xbf, 0x74, 0x43, 0x3a, 0x88,
-	0xee, 0x2d, 0x7a, 0x50, 0xec, 0xcb, 0xa4, 0x87, 0x57, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xd5,
-	0x14, 0x87, 0x30, 0x05, 0x02, 0x00, 0x00,
+	// 269 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x41, 0x4b, 0x03, 0x31,
+	0x10, 0x85, 0x15, 0xa1, 0x85, 0xd0, 0x1e, 0x8c, 0xa2, 0x50, 0x15, 0x6c, 0x8f, 0x1e, 0x36, 0xa2,
+	0x57, 0xf1, 0xb0, 0xe2, 0xc9, 0x8b, 0xd4, 0xd2, 0x43, 0xc1, 0x43, 0x1a, 0x86, 0x35, 0x50, 0x77,
+	0xd6, 0xcc, 0x34, 0xe8, 0xbf, 0xf5, 0xa7, 0x08, 0x9b, 0x64, 0xeb, 0x76, 0xb5, 0xb7, 0xe4, 0x7b,
+	0x6f, 0x5e, 0x86, 0x99, 0x88, 0x23, 0xcf, 0x85, 0x66, 0x20, 0x70, 0xde, 0x1a, 0xc8, 0x2a, 0x87,
+	0x8c, 0x72, 0xd8, 0x82, 0xa3, 0x41, 0xb8, 0x06, 0xf1, 0xe6, 0xfb, 0x40, 0xf4, 0xe6, 0x96, 0x81,
+	0x48, 0xde, 0x89, 0xfe, 0xe3, 0x27, 0x98, 0x35, 0x83, 0x3c, 0xc9, 0xa2, 0x29, 0x82, 0x29, 0x7c,
+	0xac, 0x81, 0x78, 0x74, 0xda, 0xe1, 0x54, 0x61, 0x49, 0x30, 0xd9, 0x93, 0x4f, 0x62, 0x10, 0x61,
+	0xae, 0xd9, 0xbc, 0xc9, 0xb3, 0x2d, 0x6b, 0x4d, 0x53, 0xce, 0xf9, 0xdf, 0x62, 0x13, 0xf6, 0x2c,
+	0x86, 0x2f, 0xec, 0x40, 0xbf, 0xa7, 0x86, 0x9a, 0x82, 0x16, 0x4e, 0x71, 0x17, 0xff, 0xa8, 0x29,
+	0xef, 0x7a, 0x5f, 0xbe, 0x0a, 0x39, 0x05, 0xc2, 0x95, 0x87, 0x99, 0xd3, 0x25, 0x69, 0xc3, 0x16,
+	0x4b, 0x39, 0x4e, 0x85, 0x5d, 0x2d, 0x65, 0x4f, 0x76, 0x59, 0x9a, 0x86, 0xef, 0x45, 0x7f, 0x1e,
+	0x1e, 0xdf, 0xcc, 0x2e, 0x82, 0xce, 0xec, 0x1a, 0xfe, 0xab, 0xbd, 0x85, 0x38, 0x8c, 0xf8, 0x01,
+	0xab, 0xaf, 0x99, 0x5e, 0xae, 0x80, 0xe4, 0xe5, 0x56, 0xc5, 0x46, 0x4a, 0x99, 0xe3, 0x1d, 0x8e,
+	0x94, 0x9e, 0xe7, 0xe2, 0xd8, 0x62, 0xe6, 0xeb, 0x25, 0x87, 0xad, 0x67, 0x85, 0xab, 0xcc, 0xe2,
+	0x2a, 0x22, 0x8b, 0x2a, 0x9c, 0x54, 0x81, 0xca, 0xb3, 0xaa, 0x2d, 0xaa, 0xf5, 0x69, 0x96, 0xbd,
+	0x1a, 0xde, 0xfe, 0x0c, 0x00, 0x5d, 0x0c, 0x30, 0x3e, 0x61, 0x02, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -81,6 +82,10 @@ type VitessClient interface {
 	ResolveTransaction(ctx context.Context, in *vtgate.ResolveTransactionRequest, opts ...grpc.CallOption) (*vtgate.ResolveTransactionResponse, error)
 	// VStream streams binlog events from the requested sources.
 	VStream(ctx context.Context, in *vtgate.VStreamRequest, opts ...grpc.CallOption) (Vitess_VStreamClient, error)
+	// VStreamCopyTables asks an in-flight named VStream to copy tables.
+	// The tables are snapshotted and then streamed along with the
+	// tables the stream already had.
+	VStreamCopyTables(ctx context.Context, in *vtgate.VStreamCopyTablesRequest, opts ...grpc.CallOption) (*vtgate.VStreamCopyTablesResponse, error)
 }
 
 type vitessClient struct {
@@ -182,6 +187,15 @@ func (x *vitessVStreamClient) Recv() (*vtgate.VStreamResponse, error) {
 	return m, nil
 }
 
+func (c *vitessClient) VStreamCopyTables(ctx context.Context, in *vtgate.VStreamCopyTablesRequest, opts ...grpc.CallOption) (*vtgate.VStreamCopyTablesResponse, error) {
+	out := new(vtgate.VStreamCopyTablesResponse)
+	err := c.cc.Invoke(ctx, "/vtgateservice.Vitess/VStreamCopyTables", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VitessServer is the server API for Vitess service.
 type VitessServer interface {
 	// Execute tries to route the query to the right shard.
@@ -205,6 +219,10 @@ type VitessServer interface {
 	ResolveTransaction(context.Context, *vtgate.ResolveTransactionRequest) (*vtgate.ResolveTransactionResponse, error)
 	// VStream streams binlog events from the requested sources.
 	VStream(*vtgate.VStreamRequest, Vitess_VStreamServer) error
+	// VStreamCopyTables asks an in-flight named VStream to copy tables.
+	// The tables are snapshotted and then streamed along with the
+	// tables the stream already had.
+	VStreamCopyTables(context.Context, *vtgate.VStreamCopyTablesRequest) (*vtgate.VStreamCopyTablesResponse, error)
 }
 
 // UnimplementedVitessServer can be embedded to have forward compatible implementations.
@@ -226,6 +244,9 @@ func (*UnimplementedVitessServer) ResolveTransaction(ctx context.Context, req *v
 func (*UnimplementedVitessServer) VStream(req *vtgate.VStreamRequest, srv Vitess_VStreamServer) error {
 	return status.Errorf(codes.Unimplemented, "method VStream not implemented")
 }
+func (*UnimplementedVitessServer) VStreamCopyTables(ctx context.Context, req *vtgate.VStreamCopyTablesRequest) (*vtgate.VStreamCopyTablesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VStreamCopyTables not implemented")
+}
 
 func RegisterVitessServer(s *grpc.Server, srv VitessServer) {
 	s.RegisterService(&_Vitess_serviceDesc, srv)
@@ -327,6 +348,24 @@ func (x *vitessVStreamServer) Send(m *vtgate.VStreamResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Vitess_VStreamCopyTables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtgate.VStreamCopyTablesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VitessServer).VStreamCopyTables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtgateservice.Vitess/VStreamCopyTables",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VitessServer).VStreamCopyTables(ctx, req.(*vtgate.VStreamCopyTablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Vitess_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "vtgateservice.Vitess",
 	HandlerType: (*VitessServer)(nil),
@@ -343,6 +382,10 @@ var _Vitess_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ResolveTransaction",
 			Handler:    _Vitess_ResolveTransaction_Handler,
 		},
+		{
+			MethodName: "VStreamCopyTables",
+			Handler:    _Vitess_VStreamCopyTables_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -0,0 +1,318 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package schema contains the definitions shared between the components
+// that submit online schema migrations (schemamanager, vtctl) and the
+// vttablet component that executes them.
+package schema
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	gouuid "github.com/pborman/uuid"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// DDLStrategy is the method by which a schema change is applied.
+type DDLStrategy string
+
+const (
+	// DDLStrategyDirect applies the DDL directly on every master.
+	DDLStrategyDirect DDLStrategy = "direct"
+	// DDLStrategyOnline submits ALTER TABLE statements to vttablet, which
+	// applies them with a shadow table copy plus binlog catch-up.
+	DDLStrategyOnline DDLStrategy = "online"
+)
+
+// PostponeCutOverFlag can be passed as a strategy option. The migration then
+// stops in the ready state after catching up, and waits for an explicit
+// cut-over command.
+const PostponeCutOverFlag = "-postpone-cutover"
+
+// DDLStrategySetting is a strategy along with its options,
+// as specified by the user. For example: "online -postpone-cutover".
+type DDLStrategySetting struct {
+	Strategy DDLStrategy
+	Options  string
+}
+
+// ParseDDLStrategy parses a strategy setting. An empty string
+// means DDLStrategyDirect.
+func ParseDDLStrategy(setting string) (*DDLStrategySetting, error) {
+	fields := strings.Fields(setting)
+	if len(fields) == 0 {
+		return &DDLStrategySetting{Strategy: DDLStrategyDirect}, nil
+	}
+	s := &DDLStrategySetting{
+		Strategy: DDLStrategy(fields[0]),
+		Options:  strings.Join(fields[1:], " "),
+	}
+	switch s.Strategy {
+	case DDLStrategyDirect:
+		if s.Options != "" {
+			return nil, fmt.Errorf("strategy %s does not accept options: %s", s.Strategy, s.Options)
+		}
+	case DDLStrategyOnline:
+		for _, opt := range fields[1:] {
+			if opt != PostponeCutOverFlag {
+				return nil, fmt.Errorf("unknown option for strategy %s: %s", s.Strategy, opt)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unknown DDL strategy: %s", s.Strategy)
+	}
+	return s, nil
+}
+
+// IsOnline returns true if the strategy runs migrations through vttablet.
+func (s *DDLStrategySetting) IsOnline() bool {
+	return s.Strategy == DDLStrategyOnline
+}
+
+// IsPostponeCutOver returns true if the migration must wait
+// for an explicit cut-over command.
+func (s *DDLStrategySetting) IsPostponeCutOver() bool {
+	for _, opt := range strings.Fields(s.Options) {
+		if opt == PostponeCutOverFlag {
+			return true
+		}
+	}
+	return false
+}
+
+// OnlineDDLStatus is the state of a migration, as recorded
+// in _vt.schema_migrations.
+type OnlineDDLStatus string
+
+const (
+	// OnlineDDLStatusQueued means the migration waits to be picked up.
+	OnlineDDLStatusQueued OnlineDDLStatus = "queued"
+	// OnlineDDLStatusRunning means the shadow table is being populated.
+	OnlineDDLStatusRunning OnlineDDLStatus = "running"
+	// OnlineDDLStatusReady means the shadow table caught up and the
+	// migration waits for a cut-over command.
+	OnlineDDLStatusReady OnlineDDLStatus = "ready"
+	// OnlineDDLStatusCutOver is a request to cut over a ready migration.
+	OnlineDDLStatusCutOver OnlineDDLStatus = "cutover"
+	// OnlineDDLStatusComplete means the shadow table replaced the original.
+	OnlineDDLStatusComplete OnlineDDLStatus = "complete"
+	// OnlineDDLStatusFailed means the migration failed. It can be retried.
+	OnlineDDLStatusFailed OnlineDDLStatus = "failed"
+	// OnlineDDLStatusCancel is a request to cancel a pending migration.
+	OnlineDDLStatusCancel OnlineDDLStatus = "cancel"
+	// OnlineDDLStatusCancelled means the migration was cancelled. It can be retried.
+	OnlineDDLStatusCancelled OnlineDDLStatus = "cancelled"
+)
+
+// SchemaMigrationsTable is the per-shard table that records migrations.
+const SchemaMigrationsTable = "_vt.schema_migrations"
+
+// CreateSchemaMigrationsTable returns the statements that create
+// the _vt.schema_migrations table.
+func CreateSchemaMigrationsTable() []string {
+	return []string{
+		"CREATE DATABASE IF NOT EXISTS _vt",
+		`CREATE TABLE IF NOT EXISTS _vt.schema_migrations (
+  id bigint unsigned NOT NULL AUTO_INCREMENT,
+  migration_uuid varchar(64) NOT NULL,
+  keyspace varchar(256) NOT NULL,
+  shard varchar(256) NOT NULL,
+  mysql_schema varchar(128) NOT NULL,
+  mysql_table varchar(128) NOT NULL,
+  migration_statement text NOT NULL,
+  strategy varchar(128) NOT NULL,
+  options varchar(1024) NOT NULL DEFAULT '',
+  added_timestamp timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  started_timestamp timestamp NULL DEFAULT NULL,
+  ready_timestamp timestamp NULL DEFAULT NULL,
+  completed_timestamp timestamp NULL DEFAULT NULL,
+  migration_status varchar(128) NOT NULL,
+  vreplication_id bigint NOT NULL DEFAULT 0,
+  retries int unsigned NOT NULL DEFAULT 0,
+  message text NOT NULL,
+  PRIMARY KEY (id),
+  UNIQUE KEY uuid_idx (migration_uuid),
+  KEY status_idx (migration_status)
+) ENGINE=InnoDB`,
+	}
+}
+
+// OnlineDDL is a single schema migration, as submitted by the user.
+type OnlineDDL struct {
+	UUID     string
+	Keyspace string
+	Table    string
+	SQL      string
+	Strategy DDLStrategySetting
+}
+
+// NewOnlineDDL validates sql and creates a migration with a new UUID.
+// Only ALTER TABLE statements can be migrated online.
+func NewOnlineDDL(keyspace string, sql string, strategy *DDLStrategySetting) (*OnlineDDL, error) {
+	table, err := ParseAlterTable(sql)
+	if err != nil {
+		return nil, err
+	}
+	return &OnlineDDL{
+		UUID:     CreateUUID(),
+		Keyspace: keyspace,
+		Table:    table,
+		SQL:      sql,
+		Strategy: *strategy,
+	}, nil
+}
+
+// CreateUUID returns a new migration UUID. The UUID uses underscores
+// rather than dashes, so that it can be embedded in table names.
+func CreateUUID() string {
+	return strings.Replace(gouuid.New(), "-", "_", -1)
+}
+
+var uuidRegexp = regexp.MustCompile(`^[0-9a-f]{8}_[0-9a-f]{4}_[0-9a-f]{4}_[0-9a-f]{4}_[0-9a-f]{12}$`)
+
+// IsOnlineDDLUUID returns true if uuid looks like a migration UUID.
+func IsOnlineDDLUUID(uuid string) bool {
+	return uuidRegexp.MatchString(uuid)
+}
+
+// InsertQuery returns the statement that submits the migration
+// on a shard.
+func (o *OnlineDDL) InsertQuery(shard string) string {
+	return fmt.Sprintf("insert into %s "+
+		"(migration_uuid, keyspace, shard, mysql_schema, mysql_table, migration_statement, strategy, options, migration_status, message) "+
+		"values (%s, %s, %s, database(), %s, %s, %s, %s, '%s', '')",
+		SchemaMigrationsTable,
+		encodeString(o.UUID), encodeString(o.Keyspace), encodeString(shard), encodeString(o.Table),
+		encodeString(o.SQL), encodeString(string(o.Strategy.Strategy)), encodeString(o.Strategy.Options),
+		OnlineDDLStatusQueued)
+}
+
+// ParseAlterTable verifies that sql is a single ALTER TABLE statement
+// and returns the name of the altered table.
+func ParseAlterTable(sql string) (string, error) {
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse sql: %s, got error: %v", sql, err)
+	}
+	ddl, ok := stmt.(*sqlparser.DDL)
+	if !ok || ddl.Action != sqlparser.AlterStr {
+		return "", fmt.Errorf("only ALTER TABLE statements can be applied online: %s", sql)
+	}
+	if ddl.PartitionSpec != nil {
+		return "", fmt.Errorf("partition changes cannot be applied online: %s", sql)
+	}
+	if !ddl.Table.Qualifier.IsEmpty() {
+		return "", fmt.Errorf("table must not be qualified with a database name: %s", sql)
+	}
+	return ddl.Table.Name.String(), nil
+}
+
+// alterTableRegexp matches the beginning of an ALTER TABLE statement
+// up to and including the table name.
+var alterTableRegexp = regexp.MustCompile("(?is)^\\s*alter\\s+(ignore\\s+)?table\\s+(`[^`]+`|[\\w$]+)")
+
+// RewriteAlterTable returns the ALTER TABLE statement sql applied
+// to table instead of the table it was written for. The parser
+// does not preserve the alter specification, so the statement
+// is rewritten textually.
+func RewriteAlterTable(sql, table string) (string, error) {
+	loc := alterTableRegexp.FindStringSubmatchIndex(sql)
+	if loc == nil {
+		return "", fmt.Errorf("not an ALTER TABLE statement: %s", sql)
+	}
+	// loc[5] is the end of the table name.
+	return "alter table " + sqlparser.String(sqlparser.NewTableIdent(table)) + sql[loc[5]:], nil
+}
+
+// ShadowTableName returns the name of the table into which
+// the migration copies rows.
+func ShadowTableName(uuid string) string {
+	return fmt.Sprintf("_%s_shadow", uuid)
+}
+
+// OldTableName returns the name under which the original table
+// is kept after cut-over.
+func OldTableName(uuid string) string {
+	return fmt.Sprintf("_%s_old", uuid)
+}
+
+// WorkflowName returns the name of the vreplication workflow
+// that runs the migration.
+func WorkflowName(uuid string) string {
+	return "onlineddl_" + uuid
+}
+
+// CancelMigrationQuery returns the statement that requests cancellation.
+// uuid can be "all", which cancels all pending migrations.
+func CancelMigrationQuery(uuid string) string {
+	return fmt.Sprintf("update %s set migration_status='%s' where migration_status in ('%s', '%s', '%s')%s",
+		SchemaMigrationsTable, OnlineDDLStatusCancel,
+		OnlineDDLStatusQueued, OnlineDDLStatusRunning, OnlineDDLStatusReady, uuidCondition(uuid))
+}
+
+// RetryMigrationQuery returns the statement that requeues a failed or
+// cancelled migration.
+func RetryMigrationQuery(uuid string) string {
+	return fmt.Sprintf("update %s set migration_status='%s', retries=retries+1, vreplication_id=0, message='' where migration_status in ('%s', '%s')%s",
+		SchemaMigrationsTable, OnlineDDLStatusQueued,
+		OnlineDDLStatusFailed, OnlineDDLStatusCancelled, uuidCondition(uuid))
+}
+
+// CutOverMigrationQuery returns the statement that requests cut-over
+// of a ready migration.
+func CutOverMigrationQuery(uuid string) string {
+	return fmt.Sprintf("update %s set migration_status='%s' where migration_status='%s'%s",
+		SchemaMigrationsTable, OnlineDDLStatusCutOver, OnlineDDLStatusReady, uuidCondition(uuid))
+}
+
+// AlterMigrationQuery returns the statement that applies an
+// ALTER VITESS_MIGRATION statement to the migrations of a shard.
+func AlterMigrationQuery(stmt *sqlparser.AlterMigration) (string, error) {
+	if !IsOnlineDDLUUID(stmt.UUID) {
+		return "", fmt.Errorf("invalid migration UUID: %s", stmt.UUID)
+	}
+	switch stmt.Type {
+	case sqlparser.CancelMigrationStr:
+		return CancelMigrationQuery(stmt.UUID), nil
+	case sqlparser.RetryMigrationStr:
+		return RetryMigrationQuery(stmt.UUID), nil
+	case sqlparser.CutOverMigrationStr:
+		return CutOverMigrationQuery(stmt.UUID), nil
+	}
+	return "", fmt.Errorf("unknown migration command: %s", stmt.Type)
+}
+
+// ShowMigrationsQuery returns the statement that lists migrations.
+func ShowMigrationsQuery(uuid string) string {
+	return fmt.Sprintf("select * from %s where 1=1%s order by id", SchemaMigrationsTable, uuidCondition(uuid))
+}
+
+func uuidCondition(uuid string) string {
+	if uuid == "" || uuid == "all" {
+		return ""
+	}
+	return " and migration_uuid=" + encodeString(uuid)
+}
+
+func encodeString(in string) string {
+	var buf strings.Builder
+	sqltypes.NewVarChar(in).EncodeSQL(&buf)
+	return buf.String()
+}
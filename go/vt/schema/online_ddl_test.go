@@ -0,0 +1,140 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+func TestParseDDLStrategy(t *testing.T) {
+	tcases := []struct {
+		setting  string
+		strategy DDLStrategy
+		online   bool
+		postpone bool
+		err      string
+	}{{
+		setting:  "",
+		strategy: DDLStrategyDirect,
+	}, {
+		setting:  "direct",
+		strategy: DDLStrategyDirect,
+	}, {
+		setting:  "online",
+		strategy: DDLStrategyOnline,
+		online:   true,
+	}, {
+		setting:  "online -postpone-cutover",
+		strategy: DDLStrategyOnline,
+		online:   true,
+		postpone: true,
+	}, {
+		setting: "online -unknown",
+		err:     "unknown option for strategy online: -unknown",
+	}, {
+		setting: "direct -postpone-cutover",
+		err:     "strategy direct does not accept options: -postpone-cutover",
+	}, {
+		setting: "gh-ost",
+		err:     "unknown DDL strategy: gh-ost",
+	}}
+	for _, tcase := range tcases {
+		t.Run(tcase.setting, func(t *testing.T) {
+			s, err := ParseDDLStrategy(tcase.setting)
+			if tcase.err != "" {
+				assert.EqualError(t, err, tcase.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tcase.strategy, s.Strategy)
+			assert.Equal(t, tcase.online, s.IsOnline())
+			assert.Equal(t, tcase.postpone, s.IsPostponeCutOver())
+		})
+	}
+}
+
+func TestNewOnlineDDL(t *testing.T) {
+	strategy, err := ParseDDLStrategy("online")
+	require.NoError(t, err)
+
+	o, err := NewOnlineDDL("ks", "alter table t add column c int", strategy)
+	require.NoError(t, err)
+	assert.Equal(t, "t", o.Table)
+	assert.True(t, IsOnlineDDLUUID(o.UUID), o.UUID)
+	assert.Contains(t, o.InsertQuery("-80"), "values ('"+o.UUID+"', 'ks', '-80', database(), 't', 'alter table t add column c int', 'online', '', 'queued', '')")
+
+	_, err = NewOnlineDDL("ks", "create table t(id int)", strategy)
+	assert.EqualError(t, err, "only ALTER TABLE statements can be applied online: create table t(id int)")
+	_, err = NewOnlineDDL("ks", "alter table db.t add column c int", strategy)
+	assert.EqualError(t, err, "table must not be qualified with a database name: alter table db.t add column c int")
+	_, err = NewOnlineDDL("ks", "select 1 from", strategy)
+	assert.Error(t, err)
+}
+
+func TestRewriteAlterTable(t *testing.T) {
+	tcases := []struct {
+		in, out string
+	}{{
+		in:  "alter table t add column c int",
+		out: "alter table _x_shadow add column c int",
+	}, {
+		in:  "  ALTER  IGNORE TABLE `t` ADD KEY (c)",
+		out: "alter table _x_shadow ADD KEY (c)",
+	}, {
+		in:  "alter table `my table`\n  engine=innodb",
+		out: "alter table _x_shadow\n  engine=innodb",
+	}}
+	for _, tcase := range tcases {
+		got, err := RewriteAlterTable(tcase.in, "_x_shadow")
+		require.NoError(t, err)
+		assert.Equal(t, tcase.out, got)
+	}
+	_, err := RewriteAlterTable("drop table t", "_x_shadow")
+	assert.EqualError(t, err, "not an ALTER TABLE statement: drop table t")
+}
+
+func TestMigrationQueries(t *testing.T) {
+	assert.Equal(t,
+		"update _vt.schema_migrations set migration_status='cancel' where migration_status in ('queued', 'running', 'ready') and migration_uuid='u1'",
+		CancelMigrationQuery("u1"))
+	assert.Equal(t,
+		"update _vt.schema_migrations set migration_status='queued', retries=retries+1, vreplication_id=0, message='' where migration_status in ('failed', 'cancelled')",
+		RetryMigrationQuery("all"))
+	assert.Equal(t,
+		"update _vt.schema_migrations set migration_status='cutover' where migration_status='ready' and migration_uuid='u1'",
+		CutOverMigrationQuery("u1"))
+	assert.Equal(t,
+		"select * from _vt.schema_migrations where 1=1 order by id",
+		ShowMigrationsQuery(""))
+}
+
+func TestAlterMigrationQuery(t *testing.T) {
+	uuid := "9748c3b7_7fdb_11eb_ac2c_f875a4d24e90"
+	stmt, err := sqlparser.Parse("alter vitess_migration '" + uuid + "' retry")
+	require.NoError(t, err)
+	query, err := AlterMigrationQuery(stmt.(*sqlparser.AlterMigration))
+	require.NoError(t, err)
+	assert.Equal(t, RetryMigrationQuery(uuid), query)
+
+	_, err = AlterMigrationQuery(&sqlparser.AlterMigration{Type: sqlparser.CancelMigrationStr, UUID: "all"})
+	assert.EqualError(t, err, "invalid migration UUID: all")
+}
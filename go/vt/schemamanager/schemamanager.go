@@ -74,6 +74,9 @@ type ExecuteResult struct {
 	Sqls           []string
 	ExecutorErr    string
 	TotalTimeSpent time.Duration
+	// UUIDs are the identifiers of the online schema migrations
+	// that were submitted.
+	UUIDs []string `json:",omitempty"`
 }
 
 // ShardWithError contains information why a shard failed to execute given sql
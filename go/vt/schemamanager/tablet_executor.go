@@ -24,6 +24,7 @@ import (
 	"golang.org/x/net/context"
 
 	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/schema"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/wrangler"
 
@@ -38,6 +39,7 @@ type TabletExecutor struct {
 	allowBigSchemaChange bool
 	keyspace             string
 	waitReplicasTimeout  time.Duration
+	ddlStrategySetting   *schema.DDLStrategySetting
 }
 
 // NewTabletExecutor creates a new TabletExecutor instance
@@ -47,6 +49,7 @@ func NewTabletExecutor(wr *wrangler.Wrangler, waitReplicasTimeout time.Duration)
 		isClosed:             true,
 		allowBigSchemaChange: false,
 		waitReplicasTimeout:  waitReplicasTimeout,
+		ddlStrategySetting:   &schema.DDLStrategySetting{Strategy: schema.DDLStrategyDirect},
 	}
 }
 
@@ -62,6 +65,18 @@ func (exec *TabletExecutor) DisallowBigSchemaChange() {
 	exec.allowBigSchemaChange = false
 }
 
+// SetDDLStrategy sets the strategy used to apply DDLs, for example
+// "online". With an online strategy, ALTER TABLE statements are
+// submitted as migrations that vttablet runs in the background.
+func (exec *TabletExecutor) SetDDLStrategy(ddlStrategy string) error {
+	ddlStrategySetting, err := schema.ParseDDLStrategy(ddlStrategy)
+	if err != nil {
+		return err
+	}
+	exec.ddlStrategySetting = ddlStrategySetting
+	return nil
+}
+
 // Open opens a connection to the master for every shard.
 func (exec *TabletExecutor) Open(ctx context.Context, keyspace string) error {
 	if !exec.isClosed {
@@ -160,6 +175,11 @@ func (exec *TabletExecutor) detectBigSchemaChanges(ctx context.Context, parsedDD
 		switch ddl.Action {
 		case sqlparser.DropStr, sqlparser.CreateStr, sqlparser.TruncateStr, sqlparser.RenameStr:
 			continue
+		case sqlparser.AlterStr:
+			if exec.ddlStrategySetting.IsOnline() {
+				// Online migrations don't lock the table.
+				continue
+			}
 		}
 		tableName := ddl.Table.Name.String()
 		if rowCount, ok := tableWithCount[tableName]; ok {
@@ -217,7 +237,16 @@ func (exec *TabletExecutor) Execute(ctx context.Context, sqls []string) *Execute
 
 	for index, sql := range sqls {
 		execResult.CurSQLIndex = index
-		exec.executeOnAllTablets(ctx, &execResult, sql)
+		onlineDDL, err := exec.onlineDDL(sql)
+		if err != nil {
+			execResult.ExecutorErr = err.Error()
+			return &execResult
+		}
+		if onlineDDL != nil {
+			execResult.UUIDs = append(execResult.UUIDs, onlineDDL.UUID)
+			exec.wr.Logger().Infof("Submitted online schema migration %s: %s", onlineDDL.UUID, sql)
+		}
+		exec.executeOnAllTablets(ctx, &execResult, sql, onlineDDL)
 		if len(execResult.FailedShards) > 0 {
 			break
 		}
@@ -225,7 +254,23 @@ func (exec *TabletExecutor) Execute(ctx context.Context, sqls []string) *Execute
 	return &execResult
 }
 
-func (exec *TabletExecutor) executeOnAllTablets(ctx context.Context, execResult *ExecuteResult, sql string) {
+// onlineDDL returns the migration to submit for sql, or nil if sql
+// must be applied directly.
+func (exec *TabletExecutor) onlineDDL(sql string) (*schema.OnlineDDL, error) {
+	if !exec.ddlStrategySetting.IsOnline() {
+		return nil, nil
+	}
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse sql: %s, got error: %v", sql, err)
+	}
+	if ddl, ok := stmt.(*sqlparser.DDL); !ok || ddl.Action != sqlparser.AlterStr {
+		return nil, nil
+	}
+	return schema.NewOnlineDDL(exec.keyspace, sql, exec.ddlStrategySetting)
+}
+
+func (exec *TabletExecutor) executeOnAllTablets(ctx context.Context, execResult *ExecuteResult, sql string, onlineDDL *schema.OnlineDDL) {
 	var wg sync.WaitGroup
 	numOfMasterTablets := len(exec.tablets)
 	wg.Add(numOfMasterTablets)
@@ -234,6 +279,10 @@ func (exec *TabletExecutor) executeOnAllTablets(ctx context.Context, execResult
 	for _, tablet := range exec.tablets {
 		go func(tablet *topodatapb.Tablet) {
 			defer wg.Done()
+			if onlineDDL != nil {
+				exec.submitOnlineDDL(ctx, tablet, onlineDDL, errChan, successChan)
+				return
+			}
 			exec.executeOneTablet(ctx, tablet, sql, errChan, successChan)
 		}(tablet)
 	}
@@ -249,7 +298,7 @@ func (exec *TabletExecutor) executeOnAllTablets(ctx context.Context, execResult
 		execResult.SuccessShards = append(execResult.SuccessShards, r)
 	}
 
-	if len(execResult.FailedShards) > 0 {
+	if len(execResult.FailedShards) > 0 || onlineDDL != nil {
 		return
 	}
 
@@ -297,6 +346,31 @@ func (exec *TabletExecutor) executeOneTablet(
 	}
 }
 
+// submitOnlineDDL records the migration in the _vt.schema_migrations
+// table of the master, which then runs it asynchronously.
+func (exec *TabletExecutor) submitOnlineDDL(
+	ctx context.Context,
+	tablet *topodatapb.Tablet,
+	onlineDDL *schema.OnlineDDL,
+	errChan chan ShardWithError,
+	successChan chan ShardResult) {
+	for _, query := range schema.CreateSchemaMigrationsTable() {
+		if _, err := exec.wr.TabletManagerClient().ExecuteFetchAsDba(ctx, tablet, false, []byte(query), 0, false, false); err != nil {
+			errChan <- ShardWithError{Shard: tablet.Shard, Err: err.Error()}
+			return
+		}
+	}
+	result, err := exec.wr.TabletManagerClient().ExecuteFetchAsDba(ctx, tablet, false, []byte(onlineDDL.InsertQuery(tablet.Shard)), 0, false, false)
+	if err != nil {
+		errChan <- ShardWithError{Shard: tablet.Shard, Err: err.Error()}
+		return
+	}
+	successChan <- ShardResult{
+		Shard:  tablet.Shard,
+		Result: result,
+	}
+}
+
 // Close clears tablet executor states
 func (exec *TabletExecutor) Close() {
 	if !exec.isClosed {
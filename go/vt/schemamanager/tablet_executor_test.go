@@ -21,6 +21,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"golang.org/x/net/context"
 
 	"vitess.io/vitess/go/vt/logutil"
@@ -216,3 +218,50 @@ func TestTabletExecutorExecute(t *testing.T) {
 		t.Fatalf("execute should fail, call execute.Open first")
 	}
 }
+
+func TestTabletExecutorOnlineDDL(t *testing.T) {
+	fakeTmc := newFakeTabletManagerClient()
+	fakeTmc.AddSchemaDefinition("vt_test_keyspace", &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:     "test_table",
+			Schema:   "table schema",
+			Type:     tmutils.TableBaseTable,
+			RowCount: 200000,
+		}},
+	})
+	wr := wrangler.New(logutil.NewConsoleLogger(), newFakeTopo(t), fakeTmc)
+	executor := NewTabletExecutor(wr, testWaitReplicasTimeout)
+	ctx := context.Background()
+
+	err := executor.SetDDLStrategy("gh-ost")
+	assert.EqualError(t, err, "unknown DDL strategy: gh-ost")
+	err = executor.SetDDLStrategy("online")
+	require.NoError(t, err)
+
+	require.NoError(t, executor.Open(ctx, "test_keyspace"))
+	defer executor.Close()
+
+	// Online migrations are not subject to the big schema change check.
+	sqls := []string{"ALTER TABLE test_table ADD COLUMN new_id bigint(20)"}
+	require.NoError(t, executor.Validate(ctx, sqls))
+
+	result := executor.Execute(ctx, sqls)
+	require.Empty(t, result.ExecutorErr)
+	require.Empty(t, result.FailedShards)
+	require.Len(t, result.UUIDs, 1)
+	assert.Len(t, result.SuccessShards, 3)
+
+	var inserts []string
+	for _, query := range fakeTmc.executedQueries {
+		if strings.HasPrefix(query, "insert into _vt.schema_migrations") {
+			inserts = append(inserts, query)
+		}
+		// The ALTER must not be applied directly.
+		assert.NotEqual(t, sqls[0], query)
+	}
+	require.Len(t, inserts, 3)
+	for _, insert := range inserts {
+		assert.Contains(t, insert, "'"+result.UUIDs[0]+"', 'test_keyspace'")
+		assert.Contains(t, insert, "'ALTER TABLE test_table ADD COLUMN new_id bigint(20)', 'online', '', 'queued'")
+	}
+}
@@ -73,7 +73,7 @@ func ASTToStatementType(stmt Statement) StatementType {
 		return StmtSet
 	case *Show:
 		return StmtShow
-	case *DDL, *DBDDL:
+	case *DDL, *DBDDL, *AlterMigration:
 		return StmtDDL
 	case *Use:
 		return StmtUse
@@ -204,6 +204,13 @@ type (
 		AutoIncSpec *AutoIncSpec
 	}
 
+	// AlterMigration represents an ALTER VITESS_MIGRATION statement,
+	// which acts on an online schema migration.
+	AlterMigration struct {
+		Type string
+		UUID string
+	}
+
 	// ParenSelect is a parenthesized SELECT statement.
 	ParenSelect struct {
 		Select SelectStatement
@@ -277,6 +284,7 @@ func (*Set) iStatement()               {}
 func (*SetTransaction) iStatement()    {}
 func (*DBDDL) iStatement()             {}
 func (*DDL) iStatement()               {}
+func (*AlterMigration) iStatement()    {}
 func (*Show) iStatement()              {}
 func (*Use) iStatement()               {}
 func (*Begin) iStatement()             {}
@@ -1373,6 +1381,13 @@ func (node *Explain) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, "explain %s%v", format, node.Statement)
 }
 
+// Format formats the node.
+func (node *AlterMigration) Format(buf *TrackedBuffer) {
+	buf.WriteString("alter vitess_migration ")
+	sqltypes.MakeTrusted(sqltypes.VarBinary, []byte(node.UUID)).EncodeSQL(buf)
+	buf.astPrintf(node, " %s", node.Type)
+}
+
 // Format formats the node.
 func (node *OtherRead) Format(buf *TrackedBuffer) {
 	buf.WriteString("otherread")
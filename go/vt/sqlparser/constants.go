@@ -68,6 +68,11 @@ const (
 	// Partition strings
 	ReorganizeStr = "reorganize partition"
 
+	// AlterMigration.Type
+	CancelMigrationStr  = "cancel"
+	CutOverMigrationStr = "cutover"
+	RetryMigrationStr   = "retry"
+
 	// JoinTableExpr.Join
 	JoinStr             = "join"
 	StraightJoinStr     = "straight_join"
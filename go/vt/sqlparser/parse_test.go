@@ -1085,6 +1085,13 @@ var (
 		input: "alter vschema add sequence a_seq",
 	}, {
 		input: "alter vschema add sequence ks.a_seq",
+	}, {
+		input: "alter vitess_migration '9748c3b7_7fdb_11eb_ac2c_f875a4d24e90' cutover",
+	}, {
+		input:  "ALTER VITESS_MIGRATION '9748c3b7_7fdb_11eb_ac2c_f875a4d24e90' CANCEL",
+		output: "alter vitess_migration '9748c3b7_7fdb_11eb_ac2c_f875a4d24e90' cancel",
+	}, {
+		input: "alter vitess_migration '9748c3b7_7fdb_11eb_ac2c_f875a4d24e90' retry",
 	}, {
 		input: "alter vschema on a add auto_increment id using a_seq",
 	}, {
@@ -452,6 +452,10 @@ const FORMAT = 57685
 const TREE = 57686
 const VITESS = 57687
 const TRADITIONAL = 57688
+const VITESS_MIGRATION = 57689
+const CANCEL = 57690
+const CUTOVER = 57691
+const RETRY = 57692
 
 var yyToknames = [...]string{
 	"$end",
@@ -817,6 +821,10 @@ var yyToknames = [...]string{
 	"TREE",
 	"VITESS",
 	"TRADITIONAL",
+	"VITESS_MIGRATION",
+	"CANCEL",
+	"CUTOVER",
+	"RETRY",
 	"';'",
 }
 var yyStatenames = [...]string{}
@@ -831,1844 +839,1806 @@ var yyExca = [...]int{
 	1, -1,
 	-2, 0,
 	-1, 42,
-	33, 305,
-	132, 305,
-	144, 305,
-	169, 319,
-	170, 319,
-	-2, 307,
+	33, 309,
+	132, 309,
+	144, 309,
+	169, 323,
+	170, 323,
+	-2, 311,
 	-1, 47,
-	134, 329,
-	-2, 327,
+	134, 333,
+	-2, 331,
 	-1, 70,
-	38, 365,
-	-2, 373,
-	-1, 388,
-	120, 696,
-	-2, 692,
-	-1, 389,
-	120, 697,
-	-2, 693,
-	-1, 403,
-	38, 366,
-	-2, 378,
-	-1, 404,
-	38, 367,
-	-2, 379,
-	-1, 427,
-	88, 950,
+	38, 369,
+	-2, 377,
+	-1, 393,
+	120, 700,
+	-2, 696,
+	-1, 394,
+	120, 701,
+	-2, 697,
+	-1, 408,
+	38, 370,
+	-2, 382,
+	-1, 409,
+	38, 371,
+	-2, 383,
+	-1, 432,
+	88, 957,
 	-2, 72,
-	-1, 428,
-	88, 866,
-	-2, 73,
 	-1, 433,
-	88, 833,
-	-2, 658,
-	-1, 435,
-	88, 897,
-	-2, 660,
-	-1, 755,
+	88, 872,
+	-2, 73,
+	-1, 438,
+	88, 838,
+	-2, 662,
+	-1, 440,
+	88, 903,
+	-2, 664,
+	-1, 761,
 	56, 54,
 	58, 54,
 	-2, 58,
-	-1, 933,
-	120, 699,
-	-2, 695,
-	-1, 1361,
-	5, 617,
-	17, 617,
-	19, 617,
-	31, 617,
-	59, 617,
-	-2, 404,
+	-1, 943,
+	120, 703,
+	-2, 699,
+	-1, 1371,
+	5, 621,
+	17, 621,
+	19, 621,
+	31, 621,
+	59, 621,
+	-2, 408,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 17680
+const yyLast = 17292
 
 var yyAct = [...]int{
 
-	388, 1600, 1590, 1400, 1557, 1288, 332, 1193, 1473, 1506,
-	1038, 1213, 1460, 1341, 347, 1374, 588, 1011, 1194, 1338,
-	1081, 1047, 599, 722, 1342, 1239, 1037, 1347, 1353, 69,
-	3, 683, 361, 927, 1034, 1307, 1265, 89, 432, 597,
-	396, 283, 855, 303, 283, 1132, 874, 729, 1181, 89,
-	920, 283, 769, 997, 318, 748, 1256, 1051, 1013, 749,
-	405, 732, 897, 727, 1008, 953, 334, 27, 390, 768,
-	739, 1077, 766, 990, 557, 67, 283, 89, 421, 757,
-	558, 283, 330, 283, 65, 418, 70, 883, 323, 1067,
-	64, 7, 696, 6, 5, 1593, 1577, 1588, 1100, 429,
-	577, 1565, 1585, 1401, 1576, 1061, 1564, 319, 697, 1324,
-	322, 1430, 1099, 562, 1369, 1370, 1368, 72, 73, 74,
-	75, 76, 373, 1028, 379, 380, 377, 378, 376, 375,
-	374, 617, 271, 391, 411, 269, 321, 273, 381, 382,
-	1029, 1030, 320, 91, 92, 93, 1247, 1060, 29, 426,
-	58, 32, 33, 1463, 1098, 1532, 645, 644, 654, 655,
-	647, 648, 649, 650, 651, 652, 653, 646, 1290, 770,
-	656, 771, 1068, 279, 275, 276, 277, 1227, 1421, 1419,
-	1226, 612, 309, 1228, 311, 613, 610, 611, 882, 91,
-	92, 93, 313, 616, 844, 91, 92, 93, 615, 57,
-	1292, 29, 30, 58, 32, 33, 843, 1095, 1092, 1093,
-	1587, 1091, 841, 605, 606, 1584, 1558, 1287, 1550, 991,
-	62, 1052, 1608, 1604, 1291, 34, 53, 54, 578, 56,
-	602, 1214, 1216, 564, 272, 845, 884, 885, 886, 1507,
-	273, 1515, 842, 1293, 1102, 1105, 848, 1284, 43, 619,
-	832, 1054, 57, 1286, 1509, 1364, 270, 1363, 1362, 560,
-	594, 930, 596, 567, 286, 274, 1112, 668, 669, 1111,
-	283, 569, 570, 1539, 1443, 283, 327, 579, 1054, 1223,
-	1151, 283, 1148, 1186, 1097, 1161, 1140, 283, 586, 763,
-	743, 592, 89, 681, 593, 595, 89, 278, 89, 584,
-	646, 1035, 656, 656, 89, 1024, 1096, 875, 970, 636,
-	91, 92, 93, 1215, 89, 89, 574, 1308, 36, 37,
-	39, 38, 41, 879, 55, 1508, 869, 1563, 590, 601,
-	1275, 79, 1533, 1548, 1524, 604, 1068, 607, 1326, 1602,
-	1351, 603, 1603, 618, 1601, 625, 1101, 42, 61, 60,
-	1516, 1514, 51, 52, 40, 1053, 772, 1285, 1310, 1283,
-	629, 1103, 1271, 1272, 1273, 91, 92, 93, 44, 45,
-	80, 46, 47, 48, 49, 630, 631, 954, 580, 581,
-	582, 834, 1053, 591, 571, 1245, 572, 633, 563, 573,
-	668, 669, 668, 669, 876, 1312, 666, 1316, 954, 1311,
-	1158, 1309, 767, 636, 59, 1147, 1314, 628, 1553, 626,
-	627, 57, 589, 870, 89, 1313, 283, 283, 283, 1387,
-	1057, 904, 720, 900, 1568, 89, 736, 1058, 1315, 1317,
-	684, 89, 1609, 1469, 1274, 902, 903, 901, 719, 1279,
-	1276, 1267, 1277, 1270, 1468, 1266, 268, 429, 1570, 1268,
-	1269, 649, 650, 651, 652, 653, 646, 59, 1260, 656,
-	634, 635, 633, 1278, 1259, 400, 733, 699, 701, 703,
-	705, 707, 709, 710, 565, 566, 1610, 1248, 636, 424,
-	721, 747, 1549, 700, 702, 1486, 706, 708, 400, 711,
-	647, 648, 649, 650, 651, 652, 653, 646, 1466, 761,
-	656, 975, 976, 756, 1257, 645, 644, 654, 655, 647,
-	648, 649, 650, 651, 652, 653, 646, 1122, 1054, 656,
-	415, 416, 754, 645, 644, 654, 655, 647, 648, 649,
-	650, 651, 652, 653, 646, 860, 1350, 656, 1512, 1586,
-	634, 635, 633, 91, 92, 93, 1572, 400, 1328, 1439,
-	91, 92, 93, 1521, 634, 635, 633, 283, 636, 635,
-	633, 830, 89, 281, 833, 1520, 835, 283, 283, 89,
-	89, 89, 636, 314, 1339, 283, 636, 1350, 283, 556,
-	1133, 283, 853, 854, 1383, 283, 68, 89, 1125, 1126,
-	1127, 1055, 89, 89, 89, 283, 89, 89, 420, 1512,
-	1561, 831, 632, 559, 859, 561, 89, 89, 838, 839,
-	840, 993, 670, 671, 672, 673, 674, 675, 676, 677,
-	678, 679, 1053, 857, 1512, 400, 858, 1050, 1048, 983,
-	1049, 862, 863, 864, 1523, 866, 867, 1046, 1052, 994,
-	634, 635, 633, 1289, 1391, 871, 872, 654, 655, 647,
-	648, 649, 650, 651, 652, 653, 646, 921, 636, 656,
-	91, 92, 93, 849, 731, 640, 923, 643, 1512, 1540,
-	1512, 1511, 898, 657, 658, 659, 660, 661, 662, 663,
-	89, 641, 642, 639, 645, 644, 654, 655, 647, 648,
-	649, 650, 651, 652, 653, 646, 942, 945, 656, 91,
-	92, 93, 955, 922, 931, 1146, 899, 1145, 892, 894,
-	895, 1458, 1457, 89, 89, 893, 972, 91, 92, 93,
-	933, 1230, 1445, 400, 932, 994, 634, 635, 633, 1442,
-	400, 89, 937, 1393, 1392, 684, 1389, 1390, 283, 1389,
-	1388, 89, 66, 1018, 636, 758, 283, 983, 400, 963,
-	964, 924, 925, 759, 283, 283, 971, 984, 283, 283,
-	967, 29, 283, 283, 283, 89, 931, 934, 89, 759,
-	977, 994, 400, 632, 400, 634, 635, 633, 1231, 89,
-	558, 1027, 933, 1009, 1164, 1188, 989, 429, 779, 778,
-	429, 1189, 568, 636, 985, 57, 400, 576, 760, 1163,
-	762, 1039, 857, 583, 983, 1182, 983, 758, 973, 585,
-	847, 987, 57, 1578, 760, 1433, 758, 999, 1002, 1003,
-	1004, 1000, 1019, 1001, 1005, 1182, 1021, 1354, 1355, 1017,
-	1476, 764, 1475, 283, 89, 1026, 89, 1025, 1104, 393,
-	1062, 1022, 283, 283, 283, 283, 283, 1450, 283, 283,
-	1083, 994, 283, 89, 1042, 1082, 645, 644, 654, 655,
-	647, 648, 649, 650, 651, 652, 653, 646, 1379, 283,
-	656, 1350, 1234, 1087, 283, 1089, 283, 283, 1354, 1355,
-	1085, 283, 89, 1069, 1070, 1071, 29, 1078, 29, 1073,
-	57, 1119, 1116, 1072, 1063, 1064, 1065, 1066, 1079, 1080,
-	1595, 1591, 1381, 1357, 350, 349, 352, 353, 354, 355,
-	1074, 1075, 1076, 351, 356, 896, 1493, 1339, 905, 906,
-	907, 908, 909, 910, 911, 912, 913, 914, 915, 916,
-	917, 918, 919, 1261, 898, 1360, 880, 57, 746, 57,
-	755, 1427, 851, 1359, 1205, 1203, 1202, 938, 939, 1206,
-	1204, 944, 947, 948, 999, 1002, 1003, 1004, 1000, 1201,
-	1001, 1005, 1582, 1207, 1128, 1003, 1004, 406, 899, 1575,
-	1332, 1171, 730, 1580, 1180, 959, 962, 1179, 1142, 965,
-	966, 407, 1252, 777, 587, 283, 1244, 1555, 734, 735,
-	409, 1170, 408, 723, 950, 283, 283, 283, 283, 283,
-	1195, 1175, 391, 1141, 1554, 724, 1491, 283, 951, 1242,
-	1236, 283, 1437, 1471, 1088, 283, 850, 1007, 1157, 283,
-	1190, 645, 644, 654, 655, 647, 648, 649, 650, 651,
-	652, 653, 646, 394, 395, 656, 1229, 1174, 89, 397,
-	1212, 1436, 398, 68, 389, 1183, 1185, 1235, 1232, 1184,
-	1196, 1240, 1240, 1199, 1178, 1197, 1198, 1335, 1200, 1208,
-	1039, 1435, 1177, 1182, 614, 1152, 1219, 1149, 1221, 873,
-	1222, 1597, 1596, 1218, 737, 1597, 1537, 1241, 1464, 780,
-	1224, 90, 969, 1220, 393, 284, 89, 89, 284, 836,
-	837, 66, 71, 90, 63, 284, 1, 846, 1589, 1402,
-	420, 1472, 1251, 852, 1253, 1254, 1255, 1237, 1238, 1094,
-	406, 1556, 1505, 1373, 1045, 1036, 89, 865, 78, 555,
-	284, 90, 77, 1547, 407, 284, 1263, 284, 868, 1280,
-	1258, 403, 404, 409, 600, 408, 958, 1044, 1043, 1513,
-	1462, 89, 1056, 1246, 1059, 1264, 1380, 921, 1243, 1552,
-	785, 783, 784, 1249, 1250, 1294, 782, 787, 786, 781,
-	296, 881, 310, 1304, 1295, 1296, 1306, 1006, 773, 1084,
-	738, 81, 1282, 1303, 1281, 1297, 1090, 283, 878, 1129,
-	1130, 1131, 293, 1319, 1329, 608, 609, 89, 298, 1305,
-	1137, 1138, 89, 89, 664, 1195, 1340, 1318, 1176, 1225,
-	933, 430, 423, 1325, 932, 1345, 399, 974, 726, 1304,
-	1434, 1155, 1334, 1156, 693, 952, 752, 333, 89, 891,
-	348, 345, 1343, 346, 978, 1187, 638, 331, 1358, 325,
-	751, 744, 89, 998, 89, 89, 996, 995, 1240, 1240,
-	419, 1356, 1349, 1352, 1372, 1365, 750, 982, 402, 1429,
-	1531, 401, 949, 1386, 1039, 1432, 1039, 1376, 1371, 50,
-	986, 621, 283, 1377, 1378, 315, 31, 1366, 992, 1367,
-	644, 654, 655, 647, 648, 649, 650, 651, 652, 653,
-	646, 1020, 283, 656, 1384, 1385, 410, 22, 89, 21,
-	1403, 89, 89, 89, 283, 20, 645, 644, 654, 655,
-	647, 648, 649, 650, 651, 652, 653, 646, 19, 18,
-	656, 24, 1408, 1409, 284, 17, 16, 15, 575, 284,
-	1395, 35, 26, 25, 14, 284, 13, 12, 11, 10,
-	9, 284, 8, 1417, 4, 1396, 90, 1398, 624, 23,
-	90, 682, 90, 2, 0, 0, 1412, 0, 90, 0,
-	0, 0, 0, 0, 0, 1086, 0, 1195, 90, 90,
-	1438, 362, 28, 0, 1106, 1107, 1108, 1109, 1110, 1447,
-	1113, 1114, 89, 0, 1115, 0, 0, 0, 0, 0,
-	89, 0, 1232, 0, 0, 0, 0, 0, 0, 0,
-	28, 1117, 0, 0, 1039, 89, 1118, 1446, 0, 0,
-	0, 0, 89, 1123, 0, 0, 0, 1456, 0, 0,
-	1299, 1300, 0, 0, 1414, 1415, 1479, 1416, 0, 0,
-	1418, 0, 1420, 0, 1474, 1320, 1321, 392, 1322, 1323,
-	0, 0, 0, 0, 1470, 0, 1477, 0, 0, 0,
-	1330, 1331, 0, 89, 89, 0, 89, 0, 0, 0,
-	0, 89, 0, 89, 89, 89, 283, 0, 90, 89,
-	284, 284, 284, 1499, 1492, 1500, 1502, 1503, 1490, 90,
-	1485, 1494, 1343, 1504, 1510, 90, 89, 283, 1465, 1517,
-	1467, 1459, 0, 0, 0, 0, 0, 1498, 1525, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1518, 0, 1519, 0, 0, 1478, 0, 1538, 1546, 0,
-	0, 0, 0, 89, 0, 1545, 1544, 0, 0, 0,
-	0, 1382, 0, 0, 89, 89, 1343, 0, 0, 0,
-	0, 0, 1559, 0, 0, 1560, 0, 0, 0, 0,
-	89, 0, 0, 0, 1195, 1566, 1474, 1039, 0, 0,
-	0, 283, 0, 0, 0, 0, 0, 0, 0, 89,
-	0, 0, 0, 0, 0, 0, 0, 1574, 0, 0,
-	0, 0, 0, 0, 0, 1410, 0, 1579, 1581, 89,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1583, 1594, 0, 0, 0, 0, 0, 0, 1605,
-	0, 284, 0, 0, 0, 0, 90, 0, 0, 0,
-	0, 284, 284, 90, 90, 90, 0, 0, 0, 284,
-	0, 0, 284, 0, 0, 284, 0, 0, 0, 284,
-	0, 90, 413, 0, 0, 0, 90, 90, 90, 284,
-	90, 90, 0, 0, 0, 0, 0, 0, 0, 0,
-	90, 90, 0, 598, 0, 0, 0, 598, 0, 598,
-	0, 0, 0, 0, 0, 598, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 28, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 324,
-	665, 667, 0, 0, 0, 0, 0, 0, 0, 1333,
-	0, 1480, 1481, 1482, 1483, 1484, 0, 0, 0, 1487,
-	1488, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 680, 0, 0, 90, 685, 686, 687, 688, 689,
-	690, 691, 692, 0, 695, 698, 698, 698, 704, 698,
-	698, 704, 698, 712, 713, 714, 715, 716, 717, 718,
-	0, 0, 0, 0, 28, 0, 0, 90, 90, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 91, 92,
-	93, 0, 0, 0, 0, 90, 0, 0, 753, 0,
-	0, 0, 284, 0, 1394, 90, 0, 0, 0, 0,
-	284, 0, 0, 0, 0, 0, 0, 0, 284, 284,
-	0, 0, 284, 284, 1397, 0, 284, 284, 284, 90,
-	0, 0, 90, 0, 359, 0, 1407, 0, 0, 935,
-	936, 0, 287, 90, 0, 0, 0, 0, 0, 0,
-	0, 290, 0, 0, 0, 0, 0, 0, 0, 297,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 88, 0, 0, 0, 0, 0, 968, 0, 0,
-	0, 0, 0, 312, 0, 0, 0, 0, 0, 1598,
-	0, 0, 0, 295, 0, 0, 0, 284, 90, 302,
-	90, 0, 0, 0, 0, 0, 284, 284, 284, 284,
-	284, 431, 284, 284, 1426, 0, 284, 90, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 288,
-	0, 0, 0, 284, 0, 0, 0, 0, 284, 0,
-	284, 284, 0, 598, 0, 284, 90, 1425, 0, 0,
-	598, 598, 598, 0, 0, 0, 299, 291, 0, 300,
-	301, 307, 0, 0, 0, 292, 294, 304, 598, 289,
-	306, 305, 0, 598, 598, 598, 0, 598, 598, 637,
-	0, 0, 0, 0, 0, 0, 0, 598, 598, 0,
-	0, 0, 0, 0, 645, 644, 654, 655, 647, 648,
-	649, 650, 651, 652, 653, 646, 0, 0, 656, 0,
-	0, 0, 0, 0, 0, 324, 0, 0, 0, 1526,
-	0, 0, 0, 0, 694, 0, 0, 645, 644, 654,
-	655, 647, 648, 649, 650, 651, 652, 653, 646, 0,
-	0, 656, 0, 0, 0, 0, 0, 0, 0, 284,
-	725, 728, 0, 0, 0, 0, 0, 0, 0, 284,
-	284, 284, 284, 284, 0, 0, 0, 0, 0, 0,
-	0, 284, 0, 1424, 0, 284, 0, 0, 0, 284,
-	1135, 0, 0, 284, 1136, 0, 0, 0, 0, 0,
-	0, 0, 0, 1569, 0, 1143, 1144, 0, 0, 0,
-	0, 1150, 90, 0, 1153, 1154, 0, 0, 0, 0,
-	0, 0, 1160, 0, 0, 0, 1162, 0, 0, 1165,
-	1166, 1167, 1168, 1169, 0, 0, 431, 0, 0, 0,
-	431, 0, 431, 0, 0, 1010, 0, 0, 431, 753,
-	0, 0, 0, 753, 0, 0, 0, 0, 620, 622,
-	90, 90, 0, 645, 644, 654, 655, 647, 648, 649,
-	650, 651, 652, 653, 646, 1210, 1211, 656, 1298, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	90, 0, 0, 0, 0, 0, 0, 0, 645, 644,
-	654, 655, 647, 648, 649, 650, 651, 652, 653, 646,
-	0, 1134, 656, 0, 0, 90, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 598, 0, 598, 0, 0,
-	0, 645, 644, 654, 655, 647, 648, 649, 650, 651,
-	652, 653, 646, 0, 598, 656, 0, 0, 0, 0,
-	0, 284, 0, 861, 0, 0, 0, 0, 741, 0,
-	0, 90, 0, 0, 0, 0, 90, 90, 0, 431,
-	0, 0, 0, 0, 0, 774, 0, 877, 0, 645,
-	644, 654, 655, 647, 648, 649, 650, 651, 652, 653,
-	646, 0, 90, 656, 0, 887, 888, 889, 890, 0,
-	0, 0, 0, 0, 1301, 1302, 90, 0, 90, 90,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1139, 0, 0, 392, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 284, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	940, 941, 0, 0, 0, 0, 284, 0, 0, 0,
-	0, 0, 90, 0, 0, 90, 90, 90, 284, 0,
-	0, 0, 0, 0, 0, 0, 753, 0, 0, 1361,
-	0, 0, 1191, 1192, 0, 0, 753, 753, 753, 753,
-	753, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1010, 0, 1217, 0, 431, 0, 0, 0,
-	753, 0, 0, 431, 431, 431, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 431, 0, 0, 0, 1033, 431, 431, 431, 0,
-	431, 431, 0, 0, 0, 0, 90, 0, 0, 0,
-	431, 431, 0, 0, 90, 0, 0, 0, 0, 0,
+	393, 1610, 1600, 1410, 1567, 1298, 1483, 1223, 337, 1516,
+	1351, 728, 1048, 352, 1203, 401, 1384, 1470, 1352, 69,
+	3, 1348, 1249, 1021, 366, 1204, 1077, 1057, 1044, 1091,
+	603, 1357, 689, 1047, 1317, 1142, 1191, 89, 930, 323,
+	1363, 288, 594, 308, 288, 1275, 1023, 775, 865, 89,
+	937, 288, 884, 1061, 1266, 437, 1007, 410, 738, 733,
+	963, 339, 754, 907, 1087, 395, 27, 562, 426, 774,
+	1018, 755, 772, 1000, 65, 763, 288, 89, 335, 702,
+	563, 288, 745, 288, 64, 7, 703, 735, 893, 328,
+	423, 70, 324, 6, 5, 327, 854, 855, 856, 1110,
+	275, 583, 1603, 273, 378, 278, 384, 385, 382, 383,
+	381, 380, 379, 1109, 605, 67, 1587, 1598, 1575, 1071,
+	386, 387, 72, 73, 74, 75, 76, 1595, 1411, 1586,
+	1574, 416, 396, 1334, 1440, 29, 567, 58, 32, 33,
+	284, 280, 281, 282, 91, 92, 93, 1379, 1380, 431,
+	91, 92, 93, 1237, 1378, 1108, 1236, 1039, 1040, 1238,
+	776, 332, 777, 1038, 1542, 651, 650, 660, 661, 653,
+	654, 655, 656, 657, 658, 659, 652, 618, 326, 662,
+	623, 619, 616, 617, 940, 325, 57, 1257, 1070, 1473,
+	1078, 434, 1300, 1431, 91, 92, 93, 1429, 316, 892,
+	318, 314, 276, 850, 611, 612, 621, 849, 1105, 1102,
+	1103, 1302, 1101, 847, 1597, 1594, 1568, 1297, 1001, 1560,
+	1618, 600, 1062, 602, 274, 1517, 1318, 1614, 1064, 584,
+	569, 608, 1224, 1226, 278, 968, 1303, 894, 895, 896,
+	1519, 858, 622, 848, 851, 1112, 1115, 1525, 1301, 625,
+	1064, 1294, 838, 1374, 1373, 599, 601, 1296, 1372, 565,
+	91, 92, 93, 572, 283, 1549, 291, 1320, 279, 1122,
+	1161, 1453, 1121, 1233, 288, 574, 575, 1158, 674, 675,
+	288, 1196, 585, 1171, 1150, 1107, 288, 769, 749, 1045,
+	687, 590, 288, 592, 662, 652, 598, 89, 662, 1034,
+	579, 89, 914, 89, 1322, 404, 1326, 1106, 1321, 89,
+	1319, 1518, 980, 79, 1225, 1324, 912, 913, 911, 89,
+	89, 596, 889, 642, 1323, 91, 92, 93, 1558, 885,
+	607, 610, 1063, 613, 277, 1078, 1534, 1325, 1327, 624,
+	631, 1543, 609, 1612, 597, 1361, 1613, 1111, 1611, 778,
+	635, 1573, 80, 879, 1063, 1336, 1526, 1524, 91, 92,
+	93, 1295, 1113, 1293, 964, 636, 637, 760, 576, 568,
+	577, 985, 986, 578, 91, 92, 93, 1064, 1255, 1397,
+	674, 675, 840, 1563, 586, 587, 588, 674, 675, 742,
+	964, 59, 1168, 1578, 561, 1479, 672, 653, 654, 655,
+	656, 657, 658, 659, 652, 595, 634, 662, 286, 91,
+	92, 93, 641, 639, 632, 633, 886, 726, 319, 89,
+	1478, 288, 288, 288, 640, 641, 639, 1270, 292, 642,
+	89, 640, 641, 639, 690, 639, 89, 295, 1619, 1338,
+	880, 1269, 642, 425, 1258, 302, 1067, 1580, 564, 642,
+	566, 642, 1156, 1068, 1155, 570, 571, 1559, 982, 705,
+	707, 709, 711, 713, 715, 716, 706, 708, 739, 712,
+	714, 773, 717, 640, 641, 639, 640, 641, 639, 300,
+	1496, 1063, 1620, 725, 1476, 307, 1060, 1058, 1267, 1059,
+	727, 642, 753, 1132, 642, 870, 1056, 1062, 981, 580,
+	767, 405, 676, 677, 678, 679, 680, 681, 682, 683,
+	684, 685, 1531, 762, 66, 293, 1530, 640, 641, 639,
+	651, 650, 660, 661, 653, 654, 655, 656, 657, 658,
+	659, 652, 1393, 272, 662, 642, 1135, 1136, 1137, 91,
+	92, 93, 304, 296, 434, 305, 306, 312, 429, 57,
+	1192, 297, 299, 309, 1157, 294, 311, 310, 902, 904,
+	905, 910, 288, 1522, 1596, 903, 836, 89, 405, 839,
+	1065, 841, 288, 288, 89, 89, 89, 1143, 1582, 405,
+	288, 1522, 1571, 1285, 288, 1522, 405, 288, 863, 864,
+	57, 288, 737, 89, 1522, 1550, 1004, 837, 89, 89,
+	89, 288, 89, 89, 844, 845, 846, 420, 421, 640,
+	641, 639, 89, 89, 1360, 1281, 1282, 1283, 91, 92,
+	93, 1449, 932, 868, 1522, 1521, 765, 642, 872, 873,
+	874, 638, 876, 877, 1468, 1467, 869, 1455, 405, 1452,
+	405, 573, 881, 882, 1403, 1402, 867, 582, 91, 92,
+	93, 1192, 1240, 589, 1009, 1012, 1013, 1014, 1010, 591,
+	1011, 1015, 994, 931, 1364, 1365, 1399, 1400, 1399, 1398,
+	908, 766, 933, 768, 859, 655, 656, 657, 658, 659,
+	652, 405, 29, 662, 993, 405, 89, 1284, 1004, 405,
+	638, 405, 1289, 1286, 1277, 1287, 1280, 1360, 1276, 785,
+	784, 765, 1278, 1279, 952, 955, 1198, 909, 29, 993,
+	965, 1349, 1199, 68, 1360, 1028, 1288, 764, 1533, 89,
+	89, 651, 650, 660, 661, 653, 654, 655, 656, 657,
+	658, 659, 652, 57, 1003, 662, 942, 89, 1503, 1401,
+	690, 977, 1004, 943, 288, 1241, 766, 89, 764, 1037,
+	1174, 987, 288, 934, 935, 1173, 993, 398, 993, 57,
+	288, 288, 1004, 944, 288, 288, 764, 983, 288, 288,
+	288, 89, 973, 974, 89, 857, 770, 1588, 947, 1019,
+	29, 1485, 1072, 1460, 1092, 89, 563, 1389, 752, 1244,
+	761, 355, 354, 357, 358, 359, 360, 995, 999, 1088,
+	356, 361, 941, 1364, 1365, 943, 906, 1083, 57, 915,
+	916, 917, 918, 919, 920, 921, 922, 923, 924, 925,
+	926, 927, 928, 929, 1082, 867, 1079, 1080, 1081, 997,
+	1605, 57, 1299, 1486, 1029, 1027, 1095, 1032, 1031, 288,
+	89, 1036, 89, 1035, 1114, 1601, 1391, 1367, 288, 288,
+	288, 288, 288, 1052, 1349, 1271, 890, 861, 288, 288,
+	1370, 1369, 288, 89, 941, 1093, 969, 1212, 1215, 1213,
+	1097, 1211, 1099, 1216, 1214, 1592, 948, 949, 1585, 288,
+	954, 957, 958, 1342, 288, 434, 288, 288, 434, 1181,
+	736, 288, 89, 1126, 1590, 1190, 1189, 1089, 1090, 1049,
+	1009, 1012, 1013, 1014, 1010, 972, 1011, 1015, 975, 976,
+	1217, 729, 1013, 1014, 1073, 1074, 1075, 1076, 1262, 960,
+	411, 783, 1254, 730, 945, 946, 593, 1129, 1565, 786,
+	1084, 1085, 1086, 961, 412, 1443, 908, 1564, 1501, 842,
+	843, 740, 741, 414, 411, 413, 1252, 852, 1246, 1447,
+	1481, 425, 402, 1098, 862, 860, 1017, 1446, 412, 399,
+	400, 403, 978, 1152, 1188, 408, 409, 414, 875, 413,
+	68, 1445, 1187, 909, 1345, 1138, 651, 650, 660, 661,
+	653, 654, 655, 656, 657, 658, 659, 652, 1192, 1180,
+	662, 620, 1607, 1606, 398, 288, 1162, 1159, 883, 1185,
+	743, 1607, 1547, 1474, 979, 288, 288, 288, 288, 288,
+	1151, 396, 1205, 66, 71, 63, 1, 288, 1599, 1412,
+	1200, 288, 1482, 1167, 1104, 288, 1566, 1515, 1383, 288,
+	660, 661, 653, 654, 655, 656, 657, 658, 659, 652,
+	1222, 1055, 662, 1184, 1046, 78, 1239, 560, 89, 77,
+	1557, 878, 1193, 1195, 394, 606, 1054, 1245, 1053, 1523,
+	1242, 1250, 1250, 1472, 1229, 1194, 1231, 1206, 1232, 1066,
+	1209, 1228, 1218, 1256, 1139, 1140, 1141, 1207, 1208, 1069,
+	1210, 1390, 1253, 1562, 1251, 1234, 791, 789, 790, 788,
+	793, 90, 792, 787, 301, 289, 89, 89, 289, 1230,
+	1259, 1260, 891, 90, 315, 289, 1261, 1016, 1263, 1264,
+	1265, 996, 779, 1247, 1248, 1094, 744, 81, 1292, 1002,
+	1291, 1100, 888, 1147, 1148, 298, 89, 1273, 614, 615,
+	289, 90, 1030, 303, 853, 289, 670, 289, 1268, 1186,
+	1274, 1235, 435, 428, 1165, 1355, 984, 732, 1290, 1444,
+	1344, 89, 1166, 699, 962, 758, 1304, 931, 338, 901,
+	353, 350, 1049, 351, 1316, 988, 1197, 644, 336, 1145,
+	330, 757, 750, 1146, 1008, 1307, 1006, 1005, 424, 1366,
+	1305, 1306, 1362, 756, 1153, 1154, 992, 288, 407, 1439,
+	1160, 1541, 1329, 1163, 1164, 1328, 406, 89, 959, 50,
+	1313, 1170, 89, 89, 627, 1172, 1096, 1205, 1175, 1176,
+	1177, 1178, 1179, 1350, 320, 1116, 1117, 1118, 1119, 1120,
+	942, 31, 415, 22, 1353, 1123, 1124, 943, 89, 1125,
+	21, 20, 19, 18, 24, 17, 16, 15, 581, 1315,
+	1359, 35, 89, 26, 89, 89, 1127, 25, 1250, 1250,
+	1368, 1128, 14, 1335, 1220, 1221, 1382, 13, 1133, 1375,
+	12, 11, 10, 1396, 9, 1314, 8, 1377, 4, 630,
+	1387, 1388, 288, 1386, 23, 1381, 688, 2, 0, 0,
+	0, 0, 0, 0, 0, 0, 1339, 0, 1394, 1395,
+	0, 0, 288, 0, 0, 0, 0, 0, 89, 0,
+	1413, 89, 89, 89, 288, 1309, 1310, 0, 0, 0,
+	0, 1314, 0, 0, 0, 1405, 0, 1376, 0, 0,
+	1330, 1331, 0, 1332, 1333, 0, 0, 0, 289, 0,
+	1406, 1422, 1408, 0, 289, 1340, 1341, 0, 0, 0,
+	289, 0, 0, 0, 0, 0, 289, 0, 1418, 1419,
+	0, 90, 1427, 0, 0, 90, 1049, 90, 1049, 0,
+	0, 0, 0, 90, 0, 0, 0, 0, 0, 1205,
+	0, 0, 1448, 90, 90, 0, 0, 0, 0, 0,
+	0, 0, 89, 1311, 1312, 0, 1457, 0, 0, 0,
+	89, 0, 1466, 0, 1242, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 89, 0, 0, 0, 0,
+	0, 0, 89, 0, 0, 0, 1392, 0, 0, 0,
+	0, 0, 0, 0, 0, 1475, 1489, 1477, 0, 0,
+	0, 0, 0, 0, 1487, 1480, 0, 0, 1424, 1425,
+	0, 1426, 0, 0, 1428, 0, 1430, 1456, 0, 0,
+	0, 0, 1488, 89, 89, 1495, 89, 0, 1371, 0,
+	0, 89, 0, 89, 89, 89, 288, 0, 1502, 89,
+	1420, 1504, 1508, 90, 1353, 289, 289, 289, 1509, 1500,
+	1510, 1512, 1513, 0, 90, 1520, 89, 288, 1527, 1514,
+	90, 0, 0, 0, 0, 0, 1049, 0, 0, 0,
+	0, 0, 0, 1535, 1528, 1469, 1529, 0, 0, 0,
+	0, 0, 0, 0, 1548, 0, 0, 0, 1556, 0,
+	0, 0, 1554, 89, 0, 1555, 1484, 0, 1353, 0,
+	0, 0, 0, 0, 89, 89, 0, 0, 0, 0,
+	1569, 0, 0, 0, 0, 0, 0, 1570, 0, 1421,
+	89, 0, 0, 1423, 1343, 0, 1205, 0, 0, 0,
+	0, 288, 1576, 0, 1432, 1433, 0, 0, 0, 89,
+	0, 0, 0, 0, 0, 0, 0, 1584, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1589, 1591, 89,
+	1450, 1451, 0, 1454, 0, 0, 1490, 1491, 1492, 1493,
+	1494, 0, 1604, 0, 1497, 1498, 1593, 0, 0, 1615,
+	0, 1465, 0, 0, 0, 0, 289, 0, 0, 1437,
+	0, 90, 0, 0, 0, 0, 289, 289, 90, 90,
+	90, 0, 0, 0, 289, 0, 0, 0, 289, 1404,
+	1442, 289, 0, 0, 0, 289, 0, 90, 1484, 1049,
+	367, 28, 90, 90, 90, 289, 90, 90, 0, 1407,
+	0, 0, 0, 0, 0, 0, 90, 90, 0, 0,
+	0, 1417, 0, 0, 0, 0, 0, 0, 0, 28,
+	0, 651, 650, 660, 661, 653, 654, 655, 656, 657,
+	658, 659, 652, 0, 0, 662, 808, 0, 1511, 651,
+	650, 660, 661, 653, 654, 655, 656, 657, 658, 659,
+	652, 0, 0, 662, 0, 0, 397, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1537, 1538, 1539, 1540,
+	0, 1544, 0, 1545, 1546, 0, 0, 0, 0, 0,
+	90, 0, 0, 0, 0, 0, 1551, 0, 1552, 1553,
+	0, 0, 418, 650, 660, 661, 653, 654, 655, 656,
+	657, 658, 659, 652, 1608, 0, 662, 0, 0, 0,
+	0, 0, 0, 90, 90, 0, 0, 1572, 0, 0,
+	796, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 90, 0, 0, 0, 0, 0, 0, 289, 0,
+	0, 90, 1581, 0, 0, 0, 289, 0, 0, 329,
+	0, 0, 0, 0, 289, 289, 0, 0, 289, 289,
+	0, 809, 289, 289, 289, 90, 0, 0, 90, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 90,
-	1411, 0, 0, 0, 1413, 0, 90, 0, 598, 0,
-	0, 0, 0, 0, 0, 1422, 1423, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 598, 0, 0,
-	0, 1440, 1441, 0, 1444, 0, 0, 90, 90, 0,
-	90, 0, 0, 0, 926, 90, 431, 90, 90, 90,
-	284, 0, 1455, 90, 0, 0, 0, 0, 0, 0,
-	956, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	90, 284, 0, 0, 0, 0, 0, 960, 961, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 979, 0, 0, 0, 0,
-	0, 1344, 0, 28, 0, 741, 0, 90, 431, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 90, 90,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 431,
-	0, 0, 431, 0, 90, 0, 0, 1159, 0, 1501,
-	0, 0, 0, 431, 0, 284, 0, 0, 802, 0,
-	0, 0, 360, 90, 1172, 1173, 728, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1527, 1528, 1529,
-	1530, 0, 1534, 90, 1535, 1536, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1541, 0, 1542,
-	1543, 0, 0, 282, 0, 0, 308, 0, 431, 0,
-	431, 0, 0, 282, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 431, 1562, 0,
-	0, 0, 0, 0, 0, 414, 0, 0, 422, 0,
-	0, 0, 790, 282, 0, 282, 1428, 0, 0, 0,
-	0, 0, 0, 1571, 0, 0, 1124, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 803, 1452, 1453, 1454, 0, 0, 0,
-	0, 0, 0, 0, 0, 1606, 1607, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 816,
-	819, 820, 821, 822, 823, 824, 598, 825, 826, 827,
-	828, 829, 804, 805, 806, 807, 788, 789, 817, 0,
-	791, 0, 792, 793, 794, 795, 796, 797, 798, 799,
-	800, 801, 808, 809, 810, 811, 812, 813, 814, 815,
-	0, 1327, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1344, 0, 28, 0, 0, 0, 0, 956, 0,
-	0, 0, 0, 0, 0, 1336, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1522, 0, 0, 0, 0, 0, 0,
-	0, 818, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 431, 0, 0, 1344, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 282, 0, 0, 0, 0, 282, 0, 0,
-	0, 0, 0, 282, 0, 0, 0, 0, 0, 282,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1262, 431, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	431, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1592,
-	0, 0, 0, 0, 0, 431, 0, 0, 0, 0,
-	0, 1431, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 431, 324, 0, 0,
-	0, 0, 0, 0, 1448, 0, 0, 1449, 0, 0,
-	1451, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 431, 0, 956, 0, 414, 1346, 1348, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 282, 282,
-	282, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1348, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 431, 0, 431, 1375,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1489, 324,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1399, 0, 0, 1404, 1405, 1406, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 282,
-	0, 0, 0, 0, 0, 956, 0, 0, 0, 282,
-	282, 0, 0, 0, 0, 0, 0, 282, 0, 0,
-	282, 0, 0, 282, 0, 0, 431, 856, 0, 0,
-	0, 0, 0, 0, 1461, 0, 0, 282, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 431,
-	0, 0, 0, 0, 0, 0, 431, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1495, 1496, 0,
-	1497, 0, 0, 0, 0, 1461, 0, 1461, 1461, 1461,
-	0, 0, 0, 1375, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 414, 856, 0, 0, 0, 414, 414,
-	1461, 0, 414, 414, 414, 0, 0, 0, 957, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 414, 414, 414,
-	414, 414, 0, 0, 0, 0, 0, 1551, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 431, 431,
-	282, 0, 0, 0, 0, 0, 856, 0, 282, 0,
-	0, 0, 956, 0, 1567, 0, 282, 1015, 0, 0,
-	282, 282, 0, 0, 282, 1023, 856, 0, 0, 0,
-	0, 0, 0, 1573, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1461, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 282, 0, 0, 0, 0,
-	0, 0, 0, 0, 282, 282, 282, 282, 282, 0,
-	282, 282, 0, 0, 282, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 282, 0, 0, 0, 0, 282, 0, 1120, 1121,
-	0, 0, 0, 282, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 414, 414, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 414, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 414, 282, 0, 0,
-	0, 0, 0, 0, 0, 0, 957, 282, 282, 282,
-	282, 282, 0, 0, 0, 0, 0, 0, 0, 1209,
-	0, 0, 0, 282, 0, 0, 0, 1015, 0, 0,
-	0, 282, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 414, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 856, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 282,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 957, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 282, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 282, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 282, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 957, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1616, 1617, 0, 822, 825, 826,
+	827, 828, 829, 830, 1536, 831, 832, 833, 834, 835,
+	810, 811, 812, 813, 794, 795, 823, 0, 797, 0,
+	798, 799, 800, 801, 802, 803, 804, 805, 806, 807,
+	814, 815, 816, 817, 818, 819, 820, 821, 0, 0,
+	0, 0, 0, 289, 90, 0, 90, 0, 0, 0,
+	0, 0, 289, 289, 289, 289, 289, 0, 0, 0,
+	0, 0, 289, 289, 0, 0, 289, 90, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1579, 0,
+	0, 0, 0, 289, 0, 0, 0, 0, 289, 824,
+	289, 289, 0, 0, 0, 289, 90, 604, 0, 0,
+	0, 604, 0, 604, 0, 0, 0, 0, 0, 604,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 28, 0, 0, 0, 0, 0, 0, 646, 0,
+	649, 0, 0, 0, 671, 673, 663, 664, 665, 666,
+	667, 668, 669, 0, 647, 648, 645, 651, 650, 660,
+	661, 653, 654, 655, 656, 657, 658, 659, 652, 364,
+	1436, 662, 0, 0, 0, 686, 0, 0, 0, 691,
+	692, 693, 694, 695, 696, 697, 698, 0, 701, 704,
+	704, 704, 710, 704, 704, 710, 704, 718, 719, 720,
+	721, 722, 723, 724, 0, 0, 88, 0, 28, 289,
+	0, 0, 0, 0, 0, 0, 0, 0, 317, 289,
+	289, 289, 289, 289, 0, 0, 0, 0, 0, 0,
+	0, 289, 759, 0, 0, 289, 0, 0, 0, 289,
+	0, 0, 0, 289, 643, 0, 436, 0, 0, 0,
+	651, 650, 660, 661, 653, 654, 655, 656, 657, 658,
+	659, 652, 90, 0, 662, 0, 0, 0, 0, 0,
+	0, 0, 0, 29, 30, 58, 32, 33, 0, 0,
+	329, 365, 0, 0, 0, 0, 0, 0, 0, 700,
+	0, 0, 62, 0, 0, 0, 0, 34, 53, 54,
+	0, 56, 0, 0, 0, 0, 0, 0, 0, 0,
+	90, 90, 0, 0, 0, 731, 734, 0, 0, 0,
+	43, 1308, 287, 0, 57, 313, 0, 0, 0, 0,
+	0, 0, 287, 0, 0, 0, 0, 0, 0, 0,
+	90, 651, 650, 660, 661, 653, 654, 655, 656, 657,
+	658, 659, 652, 0, 419, 662, 0, 427, 0, 0,
+	0, 0, 287, 0, 287, 90, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 604, 0, 0,
+	0, 0, 0, 0, 604, 604, 604, 0, 0, 0,
+	36, 37, 39, 38, 41, 0, 55, 0, 0, 0,
+	0, 289, 0, 604, 0, 0, 0, 0, 604, 604,
+	604, 90, 604, 604, 1435, 0, 90, 90, 0, 42,
+	61, 60, 604, 604, 51, 52, 40, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	44, 45, 90, 46, 47, 48, 49, 0, 0, 0,
+	0, 0, 0, 0, 1434, 0, 90, 0, 90, 90,
+	0, 0, 0, 0, 0, 0, 436, 0, 0, 0,
+	436, 0, 436, 0, 0, 0, 0, 0, 436, 0,
+	0, 0, 0, 0, 0, 0, 289, 0, 626, 628,
+	0, 0, 0, 0, 651, 650, 660, 661, 653, 654,
+	655, 656, 657, 658, 659, 652, 289, 0, 662, 871,
+	0, 0, 90, 0, 0, 90, 90, 90, 289, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 59,
+	0, 0, 0, 887, 651, 650, 660, 661, 653, 654,
+	655, 656, 657, 658, 659, 652, 0, 0, 662, 0,
+	0, 897, 898, 899, 900, 287, 0, 0, 0, 0,
+	0, 287, 0, 0, 0, 0, 0, 287, 0, 0,
+	1020, 0, 0, 287, 759, 0, 0, 0, 759, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 747, 0,
+	0, 0, 0, 0, 0, 0, 90, 0, 0, 436,
+	0, 0, 0, 0, 90, 780, 950, 951, 1144, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 90,
+	0, 0, 0, 0, 0, 0, 90, 0, 651, 650,
+	660, 661, 653, 654, 655, 656, 657, 658, 659, 652,
+	0, 0, 662, 0, 0, 0, 0, 0, 0, 0,
+	604, 0, 604, 0, 0, 651, 650, 660, 661, 653,
+	654, 655, 656, 657, 658, 659, 652, 90, 90, 662,
+	90, 0, 0, 604, 0, 90, 0, 90, 90, 90,
+	289, 0, 0, 90, 0, 0, 0, 0, 0, 419,
+	0, 1043, 0, 0, 0, 0, 0, 0, 0, 0,
+	90, 289, 287, 287, 287, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 436, 90, 0, 0,
+	0, 0, 0, 436, 436, 436, 0, 0, 90, 90,
+	0, 0, 1149, 0, 0, 397, 0, 0, 0, 0,
+	0, 0, 436, 0, 90, 0, 0, 436, 436, 436,
+	0, 436, 436, 0, 0, 289, 0, 0, 0, 0,
+	0, 436, 436, 90, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 90, 0, 759, 0, 0, 0, 0,
+	0, 1201, 1202, 0, 0, 759, 759, 759, 759, 759,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1015, 0,
-	0, 0, 0, 0, 541, 529, 0, 486, 544, 459,
-	476, 552, 477, 480, 517, 444, 499, 180, 474, 282,
-	463, 439, 470, 440, 461, 488, 123, 492, 458, 531,
-	502, 543, 152, 0, 464, 550, 154, 508, 0, 227,
-	168, 0, 0, 0, 490, 533, 497, 526, 485, 518,
-	449, 507, 545, 475, 515, 546, 0, 0, 0, 91,
-	92, 93, 0, 1040, 1041, 0, 0, 0, 0, 0,
-	113, 0, 512, 540, 472, 514, 516, 554, 438, 509,
-	957, 442, 445, 551, 536, 467, 468, 1233, 0, 0,
-	0, 0, 0, 282, 489, 498, 523, 483, 0, 0,
-	0, 0, 0, 0, 0, 0, 465, 0, 506, 0,
-	0, 0, 446, 443, 0, 0, 0, 0, 487, 0,
-	0, 0, 448, 0, 466, 524, 0, 436, 132, 528,
-	535, 484, 285, 539, 482, 481, 542, 199, 0, 231,
-	136, 151, 109, 148, 95, 105, 0, 134, 177, 207,
-	211, 532, 462, 471, 117, 469, 209, 187, 248, 505,
-	189, 208, 155, 237, 200, 247, 257, 258, 234, 255,
-	263, 224, 98, 233, 245, 114, 219, 0, 0, 0,
-	100, 243, 230, 166, 145, 146, 99, 0, 205, 122,
-	130, 119, 179, 240, 241, 118, 266, 106, 254, 102,
-	107, 253, 173, 236, 244, 167, 160, 101, 242, 165,
-	159, 150, 126, 138, 197, 157, 198, 139, 170, 169,
-	171, 0, 441, 0, 228, 251, 267, 111, 457, 235,
-	261, 262, 0, 201, 112, 131, 125, 196, 129, 172,
-	108, 141, 225, 149, 156, 204, 265, 186, 210, 115,
-	250, 226, 453, 456, 451, 452, 500, 501, 547, 548,
-	549, 525, 447, 0, 454, 455, 0, 530, 537, 538,
-	504, 94, 103, 153, 264, 202, 128, 252, 437, 450,
-	121, 460, 0, 0, 473, 478, 479, 491, 493, 494,
-	495, 496, 503, 510, 511, 513, 519, 520, 521, 522,
-	527, 534, 553, 96, 97, 104, 110, 116, 120, 124,
-	127, 133, 137, 140, 142, 143, 144, 147, 158, 161,
-	162, 163, 164, 174, 175, 176, 178, 181, 182, 183,
-	184, 185, 188, 190, 191, 192, 193, 194, 195, 203,
-	206, 212, 213, 214, 215, 216, 217, 218, 220, 221,
-	222, 223, 229, 232, 238, 239, 249, 256, 259, 135,
-	246, 260, 541, 529, 0, 486, 544, 459, 476, 552,
-	477, 480, 517, 444, 499, 180, 474, 0, 463, 439,
-	470, 440, 461, 488, 123, 492, 458, 531, 502, 543,
-	152, 0, 464, 550, 154, 508, 0, 227, 168, 0,
-	0, 0, 490, 533, 497, 526, 485, 518, 449, 507,
-	545, 475, 515, 546, 0, 0, 0, 91, 92, 93,
-	0, 1040, 1041, 0, 0, 0, 0, 0, 113, 0,
-	512, 540, 472, 514, 516, 554, 438, 509, 0, 442,
-	445, 551, 536, 467, 468, 0, 0, 0, 0, 0,
-	0, 0, 489, 498, 523, 483, 0, 0, 0, 0,
-	0, 0, 0, 0, 465, 0, 506, 0, 0, 0,
-	446, 443, 0, 0, 0, 0, 487, 0, 0, 0,
-	448, 0, 466, 524, 0, 436, 132, 528, 535, 484,
-	285, 539, 482, 481, 542, 199, 0, 231, 136, 151,
-	109, 148, 95, 105, 0, 134, 177, 207, 211, 532,
-	462, 471, 117, 469, 209, 187, 248, 505, 189, 208,
-	155, 237, 200, 247, 257, 258, 234, 255, 263, 224,
-	98, 233, 245, 114, 219, 0, 0, 0, 100, 243,
-	230, 166, 145, 146, 99, 0, 205, 122, 130, 119,
-	179, 240, 241, 118, 266, 106, 254, 102, 107, 253,
-	173, 236, 244, 167, 160, 101, 242, 165, 159, 150,
-	126, 138, 197, 157, 198, 139, 170, 169, 171, 0,
-	441, 0, 228, 251, 267, 111, 457, 235, 261, 262,
-	0, 201, 112, 131, 125, 196, 129, 172, 108, 141,
-	225, 149, 156, 204, 265, 186, 210, 115, 250, 226,
-	453, 456, 451, 452, 500, 501, 547, 548, 549, 525,
-	447, 0, 454, 455, 0, 530, 537, 538, 504, 94,
-	103, 153, 264, 202, 128, 252, 437, 450, 121, 460,
-	0, 0, 473, 478, 479, 491, 493, 494, 495, 496,
-	503, 510, 511, 513, 519, 520, 521, 522, 527, 534,
-	553, 96, 97, 104, 110, 116, 120, 124, 127, 133,
-	137, 140, 142, 143, 144, 147, 158, 161, 162, 163,
-	164, 174, 175, 176, 178, 181, 182, 183, 184, 185,
-	188, 190, 191, 192, 193, 194, 195, 203, 206, 212,
-	213, 214, 215, 216, 217, 218, 220, 221, 222, 223,
-	229, 232, 238, 239, 249, 256, 259, 135, 246, 260,
-	541, 529, 0, 486, 544, 459, 476, 552, 477, 480,
-	517, 444, 499, 180, 474, 0, 463, 439, 470, 440,
-	461, 488, 123, 492, 458, 531, 502, 543, 152, 0,
-	464, 550, 154, 508, 0, 227, 168, 0, 0, 0,
-	490, 533, 497, 526, 485, 518, 449, 507, 545, 475,
-	515, 546, 57, 0, 0, 91, 92, 93, 0, 0,
-	0, 0, 0, 0, 0, 0, 113, 0, 512, 540,
-	472, 514, 516, 554, 438, 509, 0, 442, 445, 551,
-	536, 467, 468, 0, 0, 0, 0, 0, 0, 0,
-	489, 498, 523, 483, 0, 0, 0, 0, 0, 0,
-	0, 0, 465, 0, 506, 0, 0, 0, 446, 443,
-	0, 0, 0, 0, 487, 0, 0, 0, 448, 0,
-	466, 524, 0, 436, 132, 528, 535, 484, 285, 539,
-	482, 481, 542, 199, 0, 231, 136, 151, 109, 148,
-	95, 105, 0, 134, 177, 207, 211, 532, 462, 471,
-	117, 469, 209, 187, 248, 505, 189, 208, 155, 237,
-	200, 247, 257, 258, 234, 255, 263, 224, 98, 233,
-	245, 114, 219, 0, 0, 0, 100, 243, 230, 166,
-	145, 146, 99, 0, 205, 122, 130, 119, 179, 240,
-	241, 118, 266, 106, 254, 102, 107, 253, 173, 236,
-	244, 167, 160, 101, 242, 165, 159, 150, 126, 138,
-	197, 157, 198, 139, 170, 169, 171, 0, 441, 0,
-	228, 251, 267, 111, 457, 235, 261, 262, 0, 201,
-	112, 131, 125, 196, 129, 172, 108, 141, 225, 149,
-	156, 204, 265, 186, 210, 115, 250, 226, 453, 456,
-	451, 452, 500, 501, 547, 548, 549, 525, 447, 0,
-	454, 455, 0, 530, 537, 538, 504, 94, 103, 153,
-	264, 202, 128, 252, 437, 450, 121, 460, 0, 0,
-	473, 478, 479, 491, 493, 494, 495, 496, 503, 510,
-	511, 513, 519, 520, 521, 522, 527, 534, 553, 96,
-	97, 104, 110, 116, 120, 124, 127, 133, 137, 140,
-	142, 143, 144, 147, 158, 161, 162, 163, 164, 174,
-	175, 176, 178, 181, 182, 183, 184, 185, 188, 190,
-	191, 192, 193, 194, 195, 203, 206, 212, 213, 214,
-	215, 216, 217, 218, 220, 221, 222, 223, 229, 232,
-	238, 239, 249, 256, 259, 135, 246, 260, 541, 529,
-	0, 486, 544, 459, 476, 552, 477, 480, 517, 444,
-	499, 180, 474, 0, 463, 439, 470, 440, 461, 488,
-	123, 492, 458, 531, 502, 543, 152, 0, 464, 550,
-	154, 508, 0, 227, 168, 0, 0, 0, 490, 533,
-	497, 526, 485, 518, 449, 507, 545, 475, 515, 546,
+	0, 1020, 0, 1227, 0, 0, 0, 0, 0, 759,
+	0, 0, 0, 287, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 287, 287, 936, 0, 436, 0, 0,
+	0, 287, 0, 0, 0, 287, 0, 0, 287, 0,
+	0, 966, 866, 0, 0, 0, 0, 1169, 0, 0,
+	0, 0, 287, 0, 0, 0, 0, 0, 970, 971,
+	0, 0, 0, 0, 1182, 1183, 734, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 989, 604, 0, 0,
+	0, 0, 0, 0, 0, 0, 747, 0, 0, 436,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 604, 0, 0, 0,
+	436, 0, 0, 436, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 436, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 419, 866,
+	0, 0, 0, 419, 419, 0, 0, 419, 419, 419,
+	0, 0, 0, 967, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 419, 419, 419, 419, 419, 0, 0, 436,
+	1354, 436, 28, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 287, 0, 0, 0, 0,
+	0, 866, 436, 287, 0, 0, 0, 0, 0, 0,
+	0, 287, 1025, 0, 0, 287, 287, 0, 0, 287,
+	1033, 866, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1134, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1337, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1346, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	287, 0, 0, 0, 0, 0, 0, 0, 0, 287,
+	287, 287, 287, 287, 0, 0, 0, 0, 0, 287,
+	287, 0, 0, 287, 0, 1438, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	287, 0, 0, 0, 0, 287, 0, 1130, 1131, 0,
+	0, 0, 287, 966, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1462, 1463, 1464, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 604, 0, 436, 0, 0,
+	419, 419, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 419, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1441, 0, 0, 0, 0, 0, 0, 0, 0,
+	1354, 0, 28, 0, 0, 1272, 436, 329, 0, 0,
+	0, 0, 0, 0, 1458, 419, 287, 1459, 0, 0,
+	1461, 0, 0, 0, 0, 967, 287, 287, 287, 287,
+	287, 0, 1532, 0, 0, 436, 0, 0, 1219, 0,
+	0, 0, 287, 0, 0, 0, 1025, 0, 0, 0,
+	287, 0, 0, 0, 1354, 0, 0, 0, 0, 0,
+	436, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 436, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1499, 329,
+	0, 0, 0, 0, 0, 0, 436, 0, 966, 0,
+	0, 1356, 1358, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1358, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1602, 0,
+	0, 436, 0, 436, 1385, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 419, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 866, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1409, 287, 0,
+	1414, 1415, 1416, 0, 0, 0, 0, 0, 0, 0,
+	967, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	966, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 436, 0, 287, 0, 0, 0, 0, 0, 1471,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 287, 436, 0, 0, 0, 0, 0,
+	0, 436, 0, 0, 0, 287, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1505, 1506, 0, 1507, 0, 0, 0, 0,
+	1471, 0, 1471, 1471, 1471, 0, 0, 0, 1385, 0,
+	0, 0, 967, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1471, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1561, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 436, 436, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 966, 0, 1577,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1583, 0,
+	0, 0, 0, 0, 0, 0, 0, 1025, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1471, 0,
+	0, 0, 0, 0, 0, 0, 546, 534, 287, 491,
+	549, 464, 481, 557, 482, 485, 522, 449, 504, 182,
+	479, 0, 468, 444, 475, 445, 466, 493, 125, 497,
+	463, 536, 507, 548, 154, 0, 469, 555, 156, 513,
+	0, 230, 170, 0, 0, 0, 495, 538, 502, 531,
+	490, 523, 454, 512, 550, 480, 520, 551, 0, 0,
+	0, 91, 92, 93, 0, 1050, 1051, 0, 0, 967,
+	0, 0, 114, 0, 517, 545, 477, 519, 521, 559,
+	443, 514, 287, 447, 450, 556, 541, 472, 473, 1243,
+	0, 0, 0, 0, 0, 0, 494, 503, 528, 488,
+	0, 0, 0, 0, 0, 0, 0, 0, 470, 0,
+	511, 0, 0, 0, 451, 448, 0, 0, 0, 0,
+	492, 0, 0, 0, 453, 0, 471, 529, 0, 441,
+	134, 533, 540, 489, 290, 544, 487, 486, 547, 201,
+	0, 234, 138, 153, 110, 150, 95, 106, 0, 136,
+	179, 209, 213, 537, 467, 476, 119, 474, 211, 189,
+	251, 510, 191, 210, 157, 240, 202, 250, 260, 261,
+	237, 258, 267, 227, 98, 236, 248, 115, 222, 0,
+	0, 0, 100, 246, 233, 168, 147, 148, 99, 0,
+	207, 124, 132, 121, 181, 243, 244, 120, 270, 107,
+	257, 102, 108, 256, 175, 239, 247, 169, 162, 101,
+	245, 167, 161, 152, 128, 140, 199, 159, 200, 141,
+	172, 171, 173, 0, 446, 0, 231, 254, 271, 112,
+	462, 238, 264, 266, 0, 203, 113, 133, 127, 198,
+	131, 174, 109, 143, 228, 151, 158, 206, 269, 188,
+	212, 116, 253, 229, 458, 461, 456, 457, 505, 506,
+	552, 553, 554, 530, 452, 0, 459, 460, 0, 535,
+	542, 543, 509, 94, 103, 155, 268, 204, 130, 255,
+	442, 455, 123, 465, 0, 0, 478, 483, 484, 496,
+	498, 499, 500, 501, 508, 515, 516, 518, 524, 525,
+	526, 527, 532, 539, 558, 96, 97, 104, 111, 117,
+	122, 126, 129, 135, 139, 142, 144, 145, 146, 149,
+	160, 163, 164, 165, 166, 176, 177, 178, 180, 183,
+	184, 185, 186, 187, 190, 192, 193, 194, 195, 196,
+	197, 205, 208, 214, 215, 216, 217, 218, 220, 221,
+	223, 224, 225, 226, 232, 235, 241, 242, 252, 259,
+	262, 137, 249, 263, 0, 265, 105, 118, 219, 546,
+	534, 0, 491, 549, 464, 481, 557, 482, 485, 522,
+	449, 504, 182, 479, 0, 468, 444, 475, 445, 466,
+	493, 125, 497, 463, 536, 507, 548, 154, 0, 469,
+	555, 156, 513, 0, 230, 170, 0, 0, 0, 495,
+	538, 502, 531, 490, 523, 454, 512, 550, 480, 520,
+	551, 0, 0, 0, 91, 92, 93, 0, 1050, 1051,
+	0, 0, 0, 0, 0, 114, 0, 517, 545, 477,
+	519, 521, 559, 443, 514, 0, 447, 450, 556, 541,
+	472, 473, 0, 0, 0, 0, 0, 0, 0, 494,
+	503, 528, 488, 0, 0, 0, 0, 0, 0, 0,
+	0, 470, 0, 511, 0, 0, 0, 451, 448, 0,
+	0, 0, 0, 492, 0, 0, 0, 453, 0, 471,
+	529, 0, 441, 134, 533, 540, 489, 290, 544, 487,
+	486, 547, 201, 0, 234, 138, 153, 110, 150, 95,
+	106, 0, 136, 179, 209, 213, 537, 467, 476, 119,
+	474, 211, 189, 251, 510, 191, 210, 157, 240, 202,
+	250, 260, 261, 237, 258, 267, 227, 98, 236, 248,
+	115, 222, 0, 0, 0, 100, 246, 233, 168, 147,
+	148, 99, 0, 207, 124, 132, 121, 181, 243, 244,
+	120, 270, 107, 257, 102, 108, 256, 175, 239, 247,
+	169, 162, 101, 245, 167, 161, 152, 128, 140, 199,
+	159, 200, 141, 172, 171, 173, 0, 446, 0, 231,
+	254, 271, 112, 462, 238, 264, 266, 0, 203, 113,
+	133, 127, 198, 131, 174, 109, 143, 228, 151, 158,
+	206, 269, 188, 212, 116, 253, 229, 458, 461, 456,
+	457, 505, 506, 552, 553, 554, 530, 452, 0, 459,
+	460, 0, 535, 542, 543, 509, 94, 103, 155, 268,
+	204, 130, 255, 442, 455, 123, 465, 0, 0, 478,
+	483, 484, 496, 498, 499, 500, 501, 508, 515, 516,
+	518, 524, 525, 526, 527, 532, 539, 558, 96, 97,
+	104, 111, 117, 122, 126, 129, 135, 139, 142, 144,
+	145, 146, 149, 160, 163, 164, 165, 166, 176, 177,
+	178, 180, 183, 184, 185, 186, 187, 190, 192, 193,
+	194, 195, 196, 197, 205, 208, 214, 215, 216, 217,
+	218, 220, 221, 223, 224, 225, 226, 232, 235, 241,
+	242, 252, 259, 262, 137, 249, 263, 0, 265, 105,
+	118, 219, 546, 534, 0, 491, 549, 464, 481, 557,
+	482, 485, 522, 449, 504, 182, 479, 0, 468, 444,
+	475, 445, 466, 493, 125, 497, 463, 536, 507, 548,
+	154, 0, 469, 555, 156, 513, 0, 230, 170, 0,
+	0, 0, 495, 538, 502, 531, 490, 523, 454, 512,
+	550, 480, 520, 551, 57, 0, 0, 91, 92, 93,
+	0, 0, 0, 0, 0, 0, 0, 0, 114, 0,
+	517, 545, 477, 519, 521, 559, 443, 514, 0, 447,
+	450, 556, 541, 472, 473, 0, 0, 0, 0, 0,
+	0, 0, 494, 503, 528, 488, 0, 0, 0, 0,
+	0, 0, 0, 0, 470, 0, 511, 0, 0, 0,
+	451, 448, 0, 0, 0, 0, 492, 0, 0, 0,
+	453, 0, 471, 529, 0, 441, 134, 533, 540, 489,
+	290, 544, 487, 486, 547, 201, 0, 234, 138, 153,
+	110, 150, 95, 106, 0, 136, 179, 209, 213, 537,
+	467, 476, 119, 474, 211, 189, 251, 510, 191, 210,
+	157, 240, 202, 250, 260, 261, 237, 258, 267, 227,
+	98, 236, 248, 115, 222, 0, 0, 0, 100, 246,
+	233, 168, 147, 148, 99, 0, 207, 124, 132, 121,
+	181, 243, 244, 120, 270, 107, 257, 102, 108, 256,
+	175, 239, 247, 169, 162, 101, 245, 167, 161, 152,
+	128, 140, 199, 159, 200, 141, 172, 171, 173, 0,
+	446, 0, 231, 254, 271, 112, 462, 238, 264, 266,
+	0, 203, 113, 133, 127, 198, 131, 174, 109, 143,
+	228, 151, 158, 206, 269, 188, 212, 116, 253, 229,
+	458, 461, 456, 457, 505, 506, 552, 553, 554, 530,
+	452, 0, 459, 460, 0, 535, 542, 543, 509, 94,
+	103, 155, 268, 204, 130, 255, 442, 455, 123, 465,
+	0, 0, 478, 483, 484, 496, 498, 499, 500, 501,
+	508, 515, 516, 518, 524, 525, 526, 527, 532, 539,
+	558, 96, 97, 104, 111, 117, 122, 126, 129, 135,
+	139, 142, 144, 145, 146, 149, 160, 163, 164, 165,
+	166, 176, 177, 178, 180, 183, 184, 185, 186, 187,
+	190, 192, 193, 194, 195, 196, 197, 205, 208, 214,
+	215, 216, 217, 218, 220, 221, 223, 224, 225, 226,
+	232, 235, 241, 242, 252, 259, 262, 137, 249, 263,
+	0, 265, 105, 118, 219, 546, 534, 0, 491, 549,
+	464, 481, 557, 482, 485, 522, 449, 504, 182, 479,
+	0, 468, 444, 475, 445, 466, 493, 125, 497, 463,
+	536, 507, 548, 154, 0, 469, 555, 156, 513, 0,
+	230, 170, 0, 0, 0, 495, 538, 502, 531, 490,
+	523, 454, 512, 550, 480, 520, 551, 0, 0, 0,
+	91, 92, 93, 0, 0, 0, 0, 0, 0, 0,
+	0, 114, 0, 517, 545, 477, 519, 521, 559, 443,
+	514, 0, 447, 450, 556, 541, 472, 473, 0, 0,
+	0, 0, 0, 0, 0, 494, 503, 528, 488, 0,
+	0, 0, 0, 0, 0, 1347, 0, 470, 0, 511,
+	0, 0, 0, 451, 448, 0, 0, 0, 0, 492,
+	0, 0, 0, 453, 0, 471, 529, 0, 441, 134,
+	533, 540, 489, 290, 544, 487, 486, 547, 201, 0,
+	234, 138, 153, 110, 150, 95, 106, 0, 136, 179,
+	209, 213, 537, 467, 476, 119, 474, 211, 189, 251,
+	510, 191, 210, 157, 240, 202, 250, 260, 261, 237,
+	258, 267, 227, 98, 236, 248, 115, 222, 0, 0,
+	0, 100, 246, 233, 168, 147, 148, 99, 0, 207,
+	124, 132, 121, 181, 243, 244, 120, 270, 107, 257,
+	102, 108, 256, 175, 239, 247, 169, 162, 101, 245,
+	167, 161, 152, 128, 140, 199, 159, 200, 141, 172,
+	171, 173, 0, 446, 0, 231, 254, 271, 112, 462,
+	238, 264, 266, 0, 203, 113, 133, 127, 198, 131,
+	174, 109, 143, 228, 151, 158, 206, 269, 188, 212,
+	116, 253, 229, 458, 461, 456, 457, 505, 506, 552,
+	553, 554, 530, 452, 0, 459, 460, 0, 535, 542,
+	543, 509, 94, 103, 155, 268, 204, 130, 255, 442,
+	455, 123, 465, 0, 0, 478, 483, 484, 496, 498,
+	499, 500, 501, 508, 515, 516, 518, 524, 525, 526,
+	527, 532, 539, 558, 96, 97, 104, 111, 117, 122,
+	126, 129, 135, 139, 142, 144, 145, 146, 149, 160,
+	163, 164, 165, 166, 176, 177, 178, 180, 183, 184,
+	185, 186, 187, 190, 192, 193, 194, 195, 196, 197,
+	205, 208, 214, 215, 216, 217, 218, 220, 221, 223,
+	224, 225, 226, 232, 235, 241, 242, 252, 259, 262,
+	137, 249, 263, 0, 265, 105, 118, 219, 546, 534,
+	0, 491, 549, 464, 481, 557, 482, 485, 522, 449,
+	504, 182, 479, 0, 468, 444, 475, 445, 466, 493,
+	125, 497, 463, 536, 507, 548, 154, 0, 469, 555,
+	156, 513, 0, 230, 170, 0, 0, 0, 495, 538,
+	502, 531, 490, 523, 454, 512, 550, 480, 520, 551,
 	0, 0, 0, 91, 92, 93, 0, 0, 0, 0,
-	0, 0, 0, 0, 113, 0, 512, 540, 472, 514,
-	516, 554, 438, 509, 0, 442, 445, 551, 536, 467,
-	468, 0, 0, 0, 0, 0, 0, 0, 489, 498,
-	523, 483, 0, 0, 0, 0, 0, 0, 1337, 0,
-	465, 0, 506, 0, 0, 0, 446, 443, 0, 0,
-	0, 0, 487, 0, 0, 0, 448, 0, 466, 524,
-	0, 436, 132, 528, 535, 484, 285, 539, 482, 481,
-	542, 199, 0, 231, 136, 151, 109, 148, 95, 105,
-	0, 134, 177, 207, 211, 532, 462, 471, 117, 469,
-	209, 187, 248, 505, 189, 208, 155, 237, 200, 247,
-	257, 258, 234, 255, 263, 224, 98, 233, 245, 114,
-	219, 0, 0, 0, 100, 243, 230, 166, 145, 146,
-	99, 0, 205, 122, 130, 119, 179, 240, 241, 118,
-	266, 106, 254, 102, 107, 253, 173, 236, 244, 167,
-	160, 101, 242, 165, 159, 150, 126, 138, 197, 157,
-	198, 139, 170, 169, 171, 0, 441, 0, 228, 251,
-	267, 111, 457, 235, 261, 262, 0, 201, 112, 131,
-	125, 196, 129, 172, 108, 141, 225, 149, 156, 204,
-	265, 186, 210, 115, 250, 226, 453, 456, 451, 452,
-	500, 501, 547, 548, 549, 525, 447, 0, 454, 455,
-	0, 530, 537, 538, 504, 94, 103, 153, 264, 202,
-	128, 252, 437, 450, 121, 460, 0, 0, 473, 478,
-	479, 491, 493, 494, 495, 496, 503, 510, 511, 513,
-	519, 520, 521, 522, 527, 534, 553, 96, 97, 104,
-	110, 116, 120, 124, 127, 133, 137, 140, 142, 143,
-	144, 147, 158, 161, 162, 163, 164, 174, 175, 176,
-	178, 181, 182, 183, 184, 185, 188, 190, 191, 192,
-	193, 194, 195, 203, 206, 212, 213, 214, 215, 216,
-	217, 218, 220, 221, 222, 223, 229, 232, 238, 239,
-	249, 256, 259, 135, 246, 260, 541, 529, 0, 486,
-	544, 459, 476, 552, 477, 480, 517, 444, 499, 180,
-	474, 0, 463, 439, 470, 440, 461, 488, 123, 492,
-	458, 531, 502, 543, 152, 0, 464, 550, 154, 508,
-	0, 227, 168, 0, 0, 0, 490, 533, 497, 526,
-	485, 518, 449, 507, 545, 475, 515, 546, 0, 0,
-	0, 91, 92, 93, 0, 0, 0, 0, 0, 0,
-	0, 0, 113, 0, 512, 540, 472, 514, 516, 554,
-	438, 509, 0, 442, 445, 551, 536, 467, 468, 0,
-	0, 0, 0, 0, 0, 0, 489, 498, 523, 483,
-	0, 0, 0, 0, 0, 0, 1024, 0, 465, 0,
-	506, 0, 0, 0, 446, 443, 0, 0, 0, 0,
-	487, 0, 0, 0, 448, 0, 466, 524, 0, 436,
-	132, 528, 535, 484, 285, 539, 482, 481, 542, 199,
-	0, 231, 136, 151, 109, 148, 95, 105, 0, 134,
-	177, 207, 211, 532, 462, 471, 117, 469, 209, 187,
-	248, 505, 189, 208, 155, 237, 200, 247, 257, 258,
-	234, 255, 263, 224, 98, 233, 245, 114, 219, 0,
-	0, 0, 100, 243, 230, 166, 145, 146, 99, 0,
-	205, 122, 130, 119, 179, 240, 241, 118, 266, 106,
-	254, 102, 107, 253, 173, 236, 244, 167, 160, 101,
-	242, 165, 159, 150, 126, 138, 197, 157, 198, 139,
-	170, 169, 171, 0, 441, 0, 228, 251, 267, 111,
-	457, 235, 261, 262, 0, 201, 112, 131, 125, 196,
-	129, 172, 108, 141, 225, 149, 156, 204, 265, 186,
-	210, 115, 250, 226, 453, 456, 451, 452, 500, 501,
-	547, 548, 549, 525, 447, 0, 454, 455, 0, 530,
-	537, 538, 504, 94, 103, 153, 264, 202, 128, 252,
-	437, 450, 121, 460, 0, 0, 473, 478, 479, 491,
-	493, 494, 495, 496, 503, 510, 511, 513, 519, 520,
-	521, 522, 527, 534, 553, 96, 97, 104, 110, 116,
-	120, 124, 127, 133, 137, 140, 142, 143, 144, 147,
-	158, 161, 162, 163, 164, 174, 175, 176, 178, 181,
-	182, 183, 184, 185, 188, 190, 191, 192, 193, 194,
-	195, 203, 206, 212, 213, 214, 215, 216, 217, 218,
-	220, 221, 222, 223, 229, 232, 238, 239, 249, 256,
-	259, 135, 246, 260, 541, 529, 0, 486, 544, 459,
-	476, 552, 477, 480, 517, 444, 499, 180, 474, 0,
-	463, 439, 470, 440, 461, 488, 123, 492, 458, 531,
-	502, 543, 152, 0, 464, 550, 154, 508, 0, 227,
-	168, 0, 0, 0, 490, 533, 497, 526, 485, 518,
-	449, 507, 545, 475, 515, 546, 0, 0, 0, 91,
+	0, 0, 0, 0, 114, 0, 517, 545, 477, 519,
+	521, 559, 443, 514, 0, 447, 450, 556, 541, 472,
+	473, 0, 0, 0, 0, 0, 0, 0, 494, 503,
+	528, 488, 0, 0, 0, 0, 0, 0, 1034, 0,
+	470, 0, 511, 0, 0, 0, 451, 448, 0, 0,
+	0, 0, 492, 0, 0, 0, 453, 0, 471, 529,
+	0, 441, 134, 533, 540, 489, 290, 544, 487, 486,
+	547, 201, 0, 234, 138, 153, 110, 150, 95, 106,
+	0, 136, 179, 209, 213, 537, 467, 476, 119, 474,
+	211, 189, 251, 510, 191, 210, 157, 240, 202, 250,
+	260, 261, 237, 258, 267, 227, 98, 236, 248, 115,
+	222, 0, 0, 0, 100, 246, 233, 168, 147, 148,
+	99, 0, 207, 124, 132, 121, 181, 243, 244, 120,
+	270, 107, 257, 102, 108, 256, 175, 239, 247, 169,
+	162, 101, 245, 167, 161, 152, 128, 140, 199, 159,
+	200, 141, 172, 171, 173, 0, 446, 0, 231, 254,
+	271, 112, 462, 238, 264, 266, 0, 203, 113, 133,
+	127, 198, 131, 174, 109, 143, 228, 151, 158, 206,
+	269, 188, 212, 116, 253, 229, 458, 461, 456, 457,
+	505, 506, 552, 553, 554, 530, 452, 0, 459, 460,
+	0, 535, 542, 543, 509, 94, 103, 155, 268, 204,
+	130, 255, 442, 455, 123, 465, 0, 0, 478, 483,
+	484, 496, 498, 499, 500, 501, 508, 515, 516, 518,
+	524, 525, 526, 527, 532, 539, 558, 96, 97, 104,
+	111, 117, 122, 126, 129, 135, 139, 142, 144, 145,
+	146, 149, 160, 163, 164, 165, 166, 176, 177, 178,
+	180, 183, 184, 185, 186, 187, 190, 192, 193, 194,
+	195, 196, 197, 205, 208, 214, 215, 216, 217, 218,
+	220, 221, 223, 224, 225, 226, 232, 235, 241, 242,
+	252, 259, 262, 137, 249, 263, 0, 265, 105, 118,
+	219, 546, 534, 0, 491, 549, 464, 481, 557, 482,
+	485, 522, 449, 504, 182, 479, 0, 468, 444, 475,
+	445, 466, 493, 125, 497, 463, 536, 507, 548, 154,
+	0, 469, 555, 156, 513, 0, 230, 170, 0, 0,
+	0, 495, 538, 502, 531, 490, 523, 454, 512, 550,
+	480, 520, 551, 0, 0, 0, 91, 92, 93, 0,
+	0, 0, 0, 0, 0, 0, 0, 114, 0, 517,
+	545, 477, 519, 521, 559, 443, 514, 0, 447, 450,
+	556, 541, 472, 473, 0, 0, 0, 0, 0, 0,
+	0, 494, 503, 528, 488, 0, 0, 0, 0, 0,
+	0, 998, 0, 470, 0, 511, 0, 0, 0, 451,
+	448, 0, 0, 0, 0, 492, 0, 0, 0, 453,
+	0, 471, 529, 0, 441, 134, 533, 540, 489, 290,
+	544, 487, 486, 547, 201, 0, 234, 138, 153, 110,
+	150, 95, 106, 0, 136, 179, 209, 213, 537, 467,
+	476, 119, 474, 211, 189, 251, 510, 191, 210, 157,
+	240, 202, 250, 260, 261, 237, 258, 267, 227, 98,
+	236, 248, 115, 222, 0, 0, 0, 100, 246, 233,
+	168, 147, 148, 99, 0, 207, 124, 132, 121, 181,
+	243, 244, 120, 270, 107, 257, 102, 108, 256, 175,
+	239, 247, 169, 162, 101, 245, 167, 161, 152, 128,
+	140, 199, 159, 200, 141, 172, 171, 173, 0, 446,
+	0, 231, 254, 271, 112, 462, 238, 264, 266, 0,
+	203, 113, 133, 127, 198, 131, 174, 109, 143, 228,
+	151, 158, 206, 269, 188, 212, 116, 253, 229, 458,
+	461, 456, 457, 505, 506, 552, 553, 554, 530, 452,
+	0, 459, 460, 0, 535, 542, 543, 509, 94, 103,
+	155, 268, 204, 130, 255, 442, 455, 123, 465, 0,
+	0, 478, 483, 484, 496, 498, 499, 500, 501, 508,
+	515, 516, 518, 524, 525, 526, 527, 532, 539, 558,
+	96, 97, 104, 111, 117, 122, 126, 129, 135, 139,
+	142, 144, 145, 146, 149, 160, 163, 164, 165, 166,
+	176, 177, 178, 180, 183, 184, 185, 186, 187, 190,
+	192, 193, 194, 195, 196, 197, 205, 208, 214, 215,
+	216, 217, 218, 220, 221, 223, 224, 225, 226, 232,
+	235, 241, 242, 252, 259, 262, 137, 249, 263, 0,
+	265, 105, 118, 219, 546, 534, 0, 491, 549, 464,
+	481, 557, 482, 485, 522, 449, 504, 182, 479, 0,
+	468, 444, 475, 445, 466, 493, 125, 497, 463, 536,
+	507, 548, 154, 0, 469, 555, 156, 513, 0, 230,
+	170, 0, 0, 0, 495, 538, 502, 531, 490, 523,
+	454, 512, 550, 480, 520, 551, 0, 0, 0, 91,
 	92, 93, 0, 0, 0, 0, 0, 0, 0, 0,
-	113, 0, 512, 540, 472, 514, 516, 554, 438, 509,
-	0, 442, 445, 551, 536, 467, 468, 0, 0, 0,
-	0, 0, 0, 0, 489, 498, 523, 483, 0, 0,
-	0, 0, 0, 0, 988, 0, 465, 0, 506, 0,
-	0, 0, 446, 443, 0, 0, 0, 0, 487, 0,
-	0, 0, 448, 0, 466, 524, 0, 436, 132, 528,
-	535, 484, 285, 539, 482, 481, 542, 199, 0, 231,
-	136, 151, 109, 148, 95, 105, 0, 134, 177, 207,
-	211, 532, 462, 471, 117, 469, 209, 187, 248, 505,
-	189, 208, 155, 237, 200, 247, 257, 258, 234, 255,
-	263, 224, 98, 233, 245, 114, 219, 0, 0, 0,
-	100, 243, 230, 166, 145, 146, 99, 0, 205, 122,
-	130, 119, 179, 240, 241, 118, 266, 106, 254, 102,
-	107, 253, 173, 236, 244, 167, 160, 101, 242, 165,
-	159, 150, 126, 138, 197, 157, 198, 139, 170, 169,
-	171, 0, 441, 0, 228, 251, 267, 111, 457, 235,
-	261, 262, 0, 201, 112, 131, 125, 196, 129, 172,
-	108, 141, 225, 149, 156, 204, 265, 186, 210, 115,
-	250, 226, 453, 456, 451, 452, 500, 501, 547, 548,
-	549, 525, 447, 0, 454, 455, 0, 530, 537, 538,
-	504, 94, 103, 153, 264, 202, 128, 252, 437, 450,
-	121, 460, 0, 0, 473, 478, 479, 491, 493, 494,
-	495, 496, 503, 510, 511, 513, 519, 520, 521, 522,
-	527, 534, 553, 96, 97, 104, 110, 116, 120, 124,
-	127, 133, 137, 140, 142, 143, 144, 147, 158, 161,
-	162, 163, 164, 174, 175, 176, 178, 181, 182, 183,
-	184, 185, 188, 190, 191, 192, 193, 194, 195, 203,
-	206, 212, 213, 214, 215, 216, 217, 218, 220, 221,
-	222, 223, 229, 232, 238, 239, 249, 256, 259, 135,
-	246, 260, 541, 529, 0, 486, 544, 459, 476, 552,
-	477, 480, 517, 444, 499, 180, 474, 0, 463, 439,
-	470, 440, 461, 488, 123, 492, 458, 531, 502, 543,
-	152, 0, 464, 550, 154, 508, 0, 227, 168, 0,
-	0, 0, 490, 533, 497, 526, 485, 518, 449, 507,
-	545, 475, 515, 546, 0, 0, 0, 91, 92, 93,
-	0, 0, 0, 0, 0, 0, 0, 0, 113, 0,
-	512, 540, 472, 514, 516, 554, 438, 509, 0, 442,
-	445, 551, 536, 467, 468, 0, 0, 0, 0, 0,
-	0, 0, 489, 498, 523, 483, 0, 0, 0, 0,
-	0, 0, 0, 0, 465, 0, 506, 0, 0, 0,
-	446, 443, 0, 0, 0, 0, 487, 0, 0, 0,
-	448, 0, 466, 524, 0, 436, 132, 528, 535, 484,
-	285, 539, 482, 481, 542, 199, 0, 231, 136, 151,
-	109, 148, 95, 105, 0, 134, 177, 207, 211, 532,
-	462, 471, 117, 469, 209, 187, 248, 505, 189, 208,
-	155, 237, 200, 247, 257, 258, 234, 255, 263, 224,
-	98, 233, 245, 114, 219, 0, 0, 0, 100, 243,
-	230, 166, 145, 146, 99, 0, 205, 122, 130, 119,
-	179, 240, 241, 118, 266, 106, 254, 102, 107, 253,
-	173, 236, 244, 167, 160, 101, 242, 165, 159, 150,
-	126, 138, 197, 157, 198, 139, 170, 169, 171, 0,
-	441, 0, 228, 251, 267, 111, 457, 235, 261, 262,
-	0, 201, 112, 131, 125, 196, 129, 172, 108, 141,
-	225, 149, 156, 204, 265, 186, 210, 115, 250, 226,
-	453, 456, 451, 452, 500, 501, 547, 548, 549, 525,
-	447, 0, 454, 455, 0, 530, 537, 538, 504, 94,
-	103, 153, 264, 202, 128, 252, 437, 450, 121, 460,
-	0, 0, 473, 478, 479, 491, 493, 494, 495, 496,
-	503, 510, 511, 513, 519, 520, 521, 522, 527, 534,
-	553, 96, 97, 104, 110, 116, 120, 124, 127, 133,
-	137, 140, 142, 143, 144, 147, 158, 161, 162, 163,
-	164, 174, 175, 176, 178, 181, 182, 183, 184, 185,
-	188, 190, 191, 192, 193, 194, 195, 203, 206, 212,
-	213, 214, 215, 216, 217, 218, 220, 221, 222, 223,
-	229, 232, 238, 239, 249, 256, 259, 135, 246, 260,
-	541, 529, 0, 486, 544, 459, 476, 552, 477, 480,
-	517, 444, 499, 180, 474, 0, 463, 439, 470, 440,
-	461, 488, 123, 492, 458, 531, 502, 543, 152, 0,
-	464, 550, 154, 508, 0, 227, 168, 0, 0, 0,
-	490, 533, 497, 526, 485, 518, 449, 507, 545, 475,
-	515, 546, 0, 0, 0, 91, 92, 93, 0, 0,
-	0, 0, 0, 0, 0, 0, 113, 0, 512, 540,
-	472, 514, 516, 554, 438, 509, 0, 442, 445, 551,
-	536, 467, 468, 0, 0, 0, 0, 0, 0, 0,
-	489, 498, 523, 483, 0, 0, 0, 0, 0, 0,
-	0, 0, 465, 0, 506, 0, 0, 0, 446, 443,
-	0, 0, 0, 0, 487, 0, 0, 0, 448, 0,
-	466, 524, 0, 436, 132, 528, 535, 484, 285, 539,
-	482, 481, 542, 199, 0, 231, 136, 151, 109, 148,
-	95, 105, 0, 134, 177, 207, 211, 532, 462, 471,
-	117, 469, 209, 187, 248, 505, 189, 208, 155, 237,
-	200, 247, 257, 258, 234, 255, 263, 224, 98, 233,
-	245, 114, 219, 0, 0, 0, 100, 243, 230, 166,
-	145, 146, 99, 0, 205, 122, 130, 119, 179, 240,
-	241, 118, 266, 106, 254, 102, 434, 253, 173, 236,
-	244, 167, 160, 101, 242, 165, 159, 150, 126, 138,
-	197, 157, 198, 139, 170, 169, 171, 0, 441, 0,
-	228, 251, 267, 111, 457, 235, 261, 262, 0, 201,
-	112, 131, 125, 196, 129, 435, 433, 428, 427, 149,
-	156, 204, 265, 186, 210, 115, 250, 226, 453, 456,
-	451, 452, 500, 501, 547, 548, 549, 525, 447, 0,
-	454, 455, 0, 530, 537, 538, 504, 94, 103, 153,
-	264, 202, 128, 252, 437, 450, 121, 460, 0, 0,
-	473, 478, 479, 491, 493, 494, 495, 496, 503, 510,
-	511, 513, 519, 520, 521, 522, 527, 534, 553, 96,
-	97, 104, 110, 116, 120, 124, 127, 133, 137, 140,
-	142, 143, 144, 147, 158, 161, 162, 163, 164, 174,
-	175, 176, 178, 181, 182, 183, 184, 185, 188, 190,
-	191, 192, 193, 194, 195, 203, 206, 212, 213, 214,
-	215, 216, 217, 218, 220, 221, 222, 223, 229, 232,
-	238, 239, 249, 256, 259, 135, 246, 260, 541, 529,
-	0, 486, 544, 459, 476, 552, 477, 480, 517, 444,
-	499, 180, 474, 0, 463, 439, 470, 440, 461, 488,
-	123, 492, 458, 531, 502, 543, 152, 0, 464, 550,
-	154, 508, 0, 227, 168, 0, 0, 0, 490, 533,
-	497, 526, 485, 518, 449, 507, 545, 475, 515, 546,
-	0, 0, 0, 91, 92, 93, 0, 0, 0, 0,
-	0, 0, 0, 0, 113, 0, 512, 540, 472, 514,
-	516, 554, 438, 509, 0, 442, 445, 551, 536, 467,
-	468, 0, 0, 0, 0, 0, 0, 0, 489, 498,
-	523, 483, 0, 0, 0, 0, 0, 0, 0, 0,
-	465, 0, 506, 0, 0, 0, 446, 443, 0, 0,
-	0, 0, 487, 0, 0, 0, 448, 0, 466, 524,
-	0, 436, 132, 528, 535, 484, 285, 539, 482, 481,
-	542, 199, 0, 231, 136, 151, 109, 148, 95, 105,
-	0, 134, 177, 207, 211, 532, 462, 471, 117, 469,
-	209, 187, 248, 505, 189, 208, 155, 237, 200, 247,
-	257, 258, 234, 255, 263, 224, 98, 233, 765, 114,
-	219, 0, 0, 0, 100, 243, 230, 166, 145, 146,
-	99, 0, 205, 122, 130, 119, 179, 240, 241, 118,
-	266, 106, 254, 102, 434, 253, 173, 236, 244, 167,
-	160, 101, 242, 165, 159, 150, 126, 138, 197, 157,
-	198, 139, 170, 169, 171, 0, 441, 0, 228, 251,
-	267, 111, 457, 235, 261, 262, 0, 201, 112, 131,
-	125, 196, 129, 435, 433, 428, 427, 149, 156, 204,
-	265, 186, 210, 115, 250, 226, 453, 456, 451, 452,
-	500, 501, 547, 548, 549, 525, 447, 0, 454, 455,
-	0, 530, 537, 538, 504, 94, 103, 153, 264, 202,
-	128, 252, 437, 450, 121, 460, 0, 0, 473, 478,
-	479, 491, 493, 494, 495, 496, 503, 510, 511, 513,
-	519, 520, 521, 522, 527, 534, 553, 96, 97, 104,
-	110, 116, 120, 124, 127, 133, 137, 140, 142, 143,
-	144, 147, 158, 161, 162, 163, 164, 174, 175, 176,
-	178, 181, 182, 183, 184, 185, 188, 190, 191, 192,
-	193, 194, 195, 203, 206, 212, 213, 214, 215, 216,
-	217, 218, 220, 221, 222, 223, 229, 232, 238, 239,
-	249, 256, 259, 135, 246, 260, 541, 529, 0, 486,
-	544, 459, 476, 552, 477, 480, 517, 444, 499, 180,
-	474, 0, 463, 439, 470, 440, 461, 488, 123, 492,
-	458, 531, 502, 543, 152, 0, 464, 550, 154, 508,
-	0, 227, 168, 0, 0, 0, 490, 533, 497, 526,
-	485, 518, 449, 507, 545, 475, 515, 546, 0, 0,
-	0, 91, 92, 93, 0, 0, 0, 0, 0, 0,
-	0, 0, 113, 0, 512, 540, 472, 514, 516, 554,
-	438, 509, 0, 442, 445, 551, 536, 467, 468, 0,
-	0, 0, 0, 0, 0, 0, 489, 498, 523, 483,
-	0, 0, 0, 0, 0, 0, 0, 0, 465, 0,
-	506, 0, 0, 0, 446, 443, 0, 0, 0, 0,
-	487, 0, 0, 0, 448, 0, 466, 524, 0, 436,
-	132, 528, 535, 484, 285, 539, 482, 481, 542, 199,
-	0, 231, 136, 151, 109, 148, 95, 105, 0, 134,
-	177, 207, 211, 532, 462, 471, 117, 469, 209, 187,
-	248, 505, 189, 208, 155, 237, 200, 247, 257, 258,
-	234, 255, 263, 224, 98, 233, 425, 114, 219, 0,
-	0, 0, 100, 243, 230, 166, 145, 146, 99, 0,
-	205, 122, 130, 119, 179, 240, 241, 118, 266, 106,
-	254, 102, 434, 253, 173, 236, 244, 167, 160, 101,
-	242, 165, 159, 150, 126, 138, 197, 157, 198, 139,
-	170, 169, 171, 0, 441, 0, 228, 251, 267, 111,
-	457, 235, 261, 262, 0, 201, 112, 131, 125, 196,
-	129, 435, 433, 428, 427, 149, 156, 204, 265, 186,
-	210, 115, 250, 226, 453, 456, 451, 452, 500, 501,
-	547, 548, 549, 525, 447, 0, 454, 455, 0, 530,
-	537, 538, 504, 94, 103, 153, 264, 202, 128, 252,
-	437, 450, 121, 460, 0, 0, 473, 478, 479, 491,
-	493, 494, 495, 496, 503, 510, 511, 513, 519, 520,
-	521, 522, 527, 534, 553, 96, 97, 104, 110, 116,
-	120, 124, 127, 133, 137, 140, 142, 143, 144, 147,
-	158, 161, 162, 163, 164, 174, 175, 176, 178, 181,
-	182, 183, 184, 185, 188, 190, 191, 192, 193, 194,
-	195, 203, 206, 212, 213, 214, 215, 216, 217, 218,
-	220, 221, 222, 223, 229, 232, 238, 239, 249, 256,
-	259, 135, 246, 260, 180, 0, 0, 928, 0, 329,
-	0, 0, 0, 123, 0, 328, 0, 0, 0, 152,
-	0, 929, 372, 154, 0, 0, 227, 168, 0, 0,
-	0, 0, 0, 363, 364, 0, 0, 0, 0, 0,
-	0, 0, 0, 57, 0, 0, 91, 92, 93, 350,
-	349, 352, 353, 354, 355, 0, 0, 113, 351, 356,
-	357, 358, 0, 0, 0, 0, 326, 343, 0, 371,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 340,
-	341, 412, 0, 0, 0, 386, 0, 342, 0, 0,
-	335, 336, 338, 337, 339, 344, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 132, 385, 0, 0, 285,
-	0, 0, 383, 0, 199, 0, 231, 136, 151, 109,
-	148, 95, 105, 0, 134, 177, 207, 211, 0, 0,
-	0, 117, 0, 209, 187, 248, 0, 189, 208, 155,
-	237, 200, 247, 257, 258, 234, 255, 263, 224, 98,
-	233, 245, 114, 219, 0, 0, 0, 100, 243, 230,
-	166, 145, 146, 99, 0, 205, 122, 130, 119, 179,
-	240, 241, 118, 266, 106, 254, 102, 107, 253, 173,
-	236, 244, 167, 160, 101, 242, 165, 159, 150, 126,
-	138, 197, 157, 198, 139, 170, 169, 171, 0, 0,
-	0, 228, 251, 267, 111, 0, 235, 261, 262, 0,
-	201, 112, 131, 125, 196, 129, 172, 108, 141, 225,
-	149, 156, 204, 265, 186, 210, 115, 250, 226, 373,
-	384, 379, 380, 377, 378, 376, 375, 374, 387, 365,
-	366, 367, 368, 370, 0, 381, 382, 369, 94, 103,
-	153, 264, 202, 128, 252, 0, 0, 121, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	96, 97, 104, 110, 116, 120, 124, 127, 133, 137,
-	140, 142, 143, 144, 147, 158, 161, 162, 163, 164,
-	174, 175, 176, 178, 181, 182, 183, 184, 185, 188,
-	190, 191, 192, 193, 194, 195, 203, 206, 212, 213,
-	214, 215, 216, 217, 218, 220, 221, 222, 223, 229,
-	232, 238, 239, 249, 256, 259, 135, 246, 260, 180,
-	0, 0, 0, 0, 329, 0, 0, 0, 123, 0,
-	328, 0, 0, 0, 152, 0, 0, 372, 154, 0,
-	0, 227, 168, 0, 0, 0, 0, 0, 363, 364,
-	0, 0, 0, 0, 0, 0, 1031, 0, 57, 0,
-	0, 91, 92, 93, 350, 349, 352, 353, 354, 355,
-	0, 0, 113, 351, 356, 357, 358, 1032, 0, 0,
-	0, 326, 343, 0, 371, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 340, 341, 0, 0, 0, 0,
-	386, 0, 342, 0, 0, 335, 336, 338, 337, 339,
-	344, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	132, 385, 0, 0, 285, 0, 0, 383, 0, 199,
-	0, 231, 136, 151, 109, 148, 95, 105, 0, 134,
-	177, 207, 211, 0, 0, 0, 117, 0, 209, 187,
-	248, 0, 189, 208, 155, 237, 200, 247, 257, 258,
-	234, 255, 263, 224, 98, 233, 245, 114, 219, 0,
-	0, 0, 100, 243, 230, 166, 145, 146, 99, 0,
-	205, 122, 130, 119, 179, 240, 241, 118, 266, 106,
-	254, 102, 107, 253, 173, 236, 244, 167, 160, 101,
-	242, 165, 159, 150, 126, 138, 197, 157, 198, 139,
-	170, 169, 171, 0, 0, 0, 228, 251, 267, 111,
-	0, 235, 261, 262, 0, 201, 112, 131, 125, 196,
-	129, 172, 108, 141, 225, 149, 156, 204, 265, 186,
-	210, 115, 250, 226, 373, 384, 379, 380, 377, 378,
-	376, 375, 374, 387, 365, 366, 367, 368, 370, 0,
-	381, 382, 369, 94, 103, 153, 264, 202, 128, 252,
-	0, 0, 121, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 96, 97, 104, 110, 116,
-	120, 124, 127, 133, 137, 140, 142, 143, 144, 147,
-	158, 161, 162, 163, 164, 174, 175, 176, 178, 181,
-	182, 183, 184, 185, 188, 190, 191, 192, 193, 194,
-	195, 203, 206, 212, 213, 214, 215, 216, 217, 218,
-	220, 221, 222, 223, 229, 232, 238, 239, 249, 256,
-	259, 135, 246, 260, 180, 0, 0, 0, 0, 329,
-	0, 0, 0, 123, 0, 328, 0, 0, 0, 152,
-	0, 0, 372, 154, 0, 0, 227, 168, 0, 0,
-	0, 0, 0, 363, 364, 0, 0, 0, 0, 0,
-	0, 0, 0, 57, 0, 400, 91, 92, 93, 350,
-	349, 352, 353, 354, 355, 0, 0, 113, 351, 356,
-	357, 358, 0, 0, 0, 0, 326, 343, 0, 371,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 340,
-	341, 0, 0, 0, 0, 386, 0, 342, 0, 0,
-	335, 336, 338, 337, 339, 344, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 132, 385, 0, 0, 285,
-	0, 0, 383, 0, 199, 0, 231, 136, 151, 109,
-	148, 95, 105, 0, 134, 177, 207, 211, 0, 0,
-	0, 117, 0, 209, 187, 248, 0, 189, 208, 155,
-	237, 200, 247, 257, 258, 234, 255, 263, 224, 98,
-	233, 245, 114, 219, 0, 0, 0, 100, 243, 230,
-	166, 145, 146, 99, 0, 205, 122, 130, 119, 179,
-	240, 241, 118, 266, 106, 254, 102, 107, 253, 173,
-	236, 244, 167, 160, 101, 242, 165, 159, 150, 126,
-	138, 197, 157, 198, 139, 170, 169, 171, 0, 0,
-	0, 228, 251, 267, 111, 0, 235, 261, 262, 0,
-	201, 112, 131, 125, 196, 129, 172, 108, 141, 225,
-	149, 156, 204, 265, 186, 210, 115, 250, 226, 373,
-	384, 379, 380, 377, 378, 376, 375, 374, 387, 365,
-	366, 367, 368, 370, 0, 381, 382, 369, 94, 103,
-	153, 264, 202, 128, 252, 0, 0, 121, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	96, 97, 104, 110, 116, 120, 124, 127, 133, 137,
-	140, 142, 143, 144, 147, 158, 161, 162, 163, 164,
-	174, 175, 176, 178, 181, 182, 183, 184, 185, 188,
-	190, 191, 192, 193, 194, 195, 203, 206, 212, 213,
-	214, 215, 216, 217, 218, 220, 221, 222, 223, 229,
-	232, 238, 239, 249, 256, 259, 135, 246, 260, 180,
-	0, 0, 0, 0, 329, 0, 0, 0, 123, 0,
-	328, 0, 0, 0, 152, 0, 0, 372, 154, 0,
-	0, 227, 168, 0, 0, 0, 0, 0, 363, 364,
-	0, 0, 0, 0, 0, 0, 0, 0, 57, 0,
-	0, 91, 92, 93, 350, 349, 352, 353, 354, 355,
-	0, 0, 113, 351, 356, 357, 358, 0, 0, 0,
-	0, 326, 343, 0, 371, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 340, 341, 412, 0, 0, 0,
-	386, 0, 342, 0, 0, 335, 336, 338, 337, 339,
-	344, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	132, 385, 0, 0, 285, 0, 0, 383, 0, 199,
-	0, 231, 136, 151, 109, 148, 95, 105, 0, 134,
-	177, 207, 211, 0, 0, 0, 117, 0, 209, 187,
-	248, 0, 189, 208, 155, 237, 200, 247, 257, 258,
-	234, 255, 263, 224, 98, 233, 245, 114, 219, 0,
-	0, 0, 100, 243, 230, 166, 145, 146, 99, 0,
-	205, 122, 130, 119, 179, 240, 241, 118, 266, 106,
-	254, 102, 107, 253, 173, 236, 244, 167, 160, 101,
-	242, 165, 159, 150, 126, 138, 197, 157, 198, 139,
-	170, 169, 171, 0, 0, 0, 228, 251, 267, 111,
-	0, 235, 261, 262, 0, 201, 112, 131, 125, 196,
-	129, 172, 108, 141, 225, 149, 156, 204, 265, 186,
-	210, 115, 250, 226, 373, 384, 379, 380, 377, 378,
-	376, 375, 374, 387, 365, 366, 367, 368, 370, 0,
-	381, 382, 369, 94, 103, 153, 264, 202, 128, 252,
-	0, 0, 121, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 96, 97, 104, 110, 116,
-	120, 124, 127, 133, 137, 140, 142, 143, 144, 147,
-	158, 161, 162, 163, 164, 174, 175, 176, 178, 181,
-	182, 183, 184, 185, 188, 190, 191, 192, 193, 194,
-	195, 203, 206, 212, 213, 214, 215, 216, 217, 218,
-	220, 221, 222, 223, 229, 232, 238, 239, 249, 256,
-	259, 135, 246, 260, 180, 0, 0, 0, 0, 329,
-	0, 0, 0, 123, 0, 328, 0, 0, 0, 152,
-	0, 0, 372, 154, 0, 0, 227, 168, 0, 0,
-	0, 0, 0, 363, 364, 0, 0, 0, 0, 0,
-	0, 0, 0, 57, 0, 0, 91, 92, 93, 350,
-	946, 352, 353, 354, 355, 0, 0, 113, 351, 356,
-	357, 358, 0, 0, 0, 0, 326, 343, 0, 371,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 340,
-	341, 412, 0, 0, 0, 386, 0, 342, 0, 0,
-	335, 336, 338, 337, 339, 344, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 132, 385, 0, 0, 285,
-	0, 0, 383, 0, 199, 0, 231, 136, 151, 109,
-	148, 95, 105, 0, 134, 177, 207, 211, 0, 0,
-	0, 117, 0, 209, 187, 248, 0, 189, 208, 155,
-	237, 200, 247, 257, 258, 234, 255, 263, 224, 98,
-	233, 245, 114, 219, 0, 0, 0, 100, 243, 230,
-	166, 145, 146, 99, 0, 205, 122, 130, 119, 179,
-	240, 241, 118, 266, 106, 254, 102, 107, 253, 173,
-	236, 244, 167, 160, 101, 242, 165, 159, 150, 126,
-	138, 197, 157, 198, 139, 170, 169, 171, 0, 0,
-	0, 228, 251, 267, 111, 0, 235, 261, 262, 0,
-	201, 112, 131, 125, 196, 129, 172, 108, 141, 225,
-	149, 156, 204, 265, 186, 210, 115, 250, 226, 373,
-	384, 379, 380, 377, 378, 376, 375, 374, 387, 365,
-	366, 367, 368, 370, 0, 381, 382, 369, 94, 103,
-	153, 264, 202, 128, 252, 0, 0, 121, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	96, 97, 104, 110, 116, 120, 124, 127, 133, 137,
-	140, 142, 143, 144, 147, 158, 161, 162, 163, 164,
-	174, 175, 176, 178, 181, 182, 183, 184, 185, 188,
-	190, 191, 192, 193, 194, 195, 203, 206, 212, 213,
-	214, 215, 216, 217, 218, 220, 221, 222, 223, 229,
-	232, 238, 239, 249, 256, 259, 135, 246, 260, 180,
-	0, 0, 0, 0, 329, 0, 0, 0, 123, 0,
-	328, 0, 0, 0, 152, 0, 0, 372, 154, 0,
-	0, 227, 168, 0, 0, 0, 0, 0, 363, 364,
-	0, 0, 0, 0, 0, 0, 0, 0, 57, 0,
-	0, 91, 92, 93, 350, 943, 352, 353, 354, 355,
-	0, 0, 113, 351, 356, 357, 358, 0, 0, 0,
-	0, 326, 343, 0, 371, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 340, 341, 412, 0, 0, 0,
-	386, 0, 342, 0, 0, 335, 336, 338, 337, 339,
-	344, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	132, 385, 0, 0, 285, 0, 0, 383, 0, 199,
-	0, 231, 136, 151, 109, 148, 95, 105, 0, 134,
-	177, 207, 211, 0, 0, 0, 117, 0, 209, 187,
-	248, 0, 189, 208, 155, 237, 200, 247, 257, 258,
-	234, 255, 263, 224, 98, 233, 245, 114, 219, 0,
-	0, 0, 100, 243, 230, 166, 145, 146, 99, 0,
-	205, 122, 130, 119, 179, 240, 241, 118, 266, 106,
-	254, 102, 107, 253, 173, 236, 244, 167, 160, 101,
-	242, 165, 159, 150, 126, 138, 197, 157, 198, 139,
-	170, 169, 171, 0, 0, 0, 228, 251, 267, 111,
-	0, 235, 261, 262, 0, 201, 112, 131, 125, 196,
-	129, 172, 108, 141, 225, 149, 156, 204, 265, 186,
-	210, 115, 250, 226, 373, 384, 379, 380, 377, 378,
-	376, 375, 374, 387, 365, 366, 367, 368, 370, 0,
-	381, 382, 369, 94, 103, 153, 264, 202, 128, 252,
-	0, 0, 121, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 96, 97, 104, 110, 116,
-	120, 124, 127, 133, 137, 140, 142, 143, 144, 147,
-	158, 161, 162, 163, 164, 174, 175, 176, 178, 181,
-	182, 183, 184, 185, 188, 190, 191, 192, 193, 194,
-	195, 203, 206, 212, 213, 214, 215, 216, 217, 218,
-	220, 221, 222, 223, 229, 232, 238, 239, 249, 256,
-	259, 135, 246, 260, 393, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 180, 0, 0, 0,
-	0, 329, 0, 0, 0, 123, 0, 328, 0, 0,
-	0, 152, 0, 0, 372, 154, 0, 0, 227, 168,
-	0, 0, 0, 0, 0, 363, 364, 0, 0, 0,
+	114, 0, 517, 545, 477, 519, 521, 559, 443, 514,
+	0, 447, 450, 556, 541, 472, 473, 0, 0, 0,
+	0, 0, 0, 0, 494, 503, 528, 488, 0, 0,
+	0, 0, 0, 0, 0, 0, 470, 0, 511, 0,
+	0, 0, 451, 448, 0, 0, 0, 0, 492, 0,
+	0, 0, 453, 0, 471, 529, 0, 441, 134, 533,
+	540, 489, 290, 544, 487, 486, 547, 201, 0, 234,
+	138, 153, 110, 150, 95, 106, 0, 136, 179, 209,
+	213, 537, 467, 476, 119, 474, 211, 189, 251, 510,
+	191, 210, 157, 240, 202, 250, 260, 261, 237, 258,
+	267, 227, 98, 236, 248, 115, 222, 0, 0, 0,
+	100, 246, 233, 168, 147, 148, 99, 0, 207, 124,
+	132, 121, 181, 243, 244, 120, 270, 107, 257, 102,
+	108, 256, 175, 239, 247, 169, 162, 101, 245, 167,
+	161, 152, 128, 140, 199, 159, 200, 141, 172, 171,
+	173, 0, 446, 0, 231, 254, 271, 112, 462, 238,
+	264, 266, 0, 203, 113, 133, 127, 198, 131, 174,
+	109, 143, 228, 151, 158, 206, 269, 188, 212, 116,
+	253, 229, 458, 461, 456, 457, 505, 506, 552, 553,
+	554, 530, 452, 0, 459, 460, 0, 535, 542, 543,
+	509, 94, 103, 155, 268, 204, 130, 255, 442, 455,
+	123, 465, 0, 0, 478, 483, 484, 496, 498, 499,
+	500, 501, 508, 515, 516, 518, 524, 525, 526, 527,
+	532, 539, 558, 96, 97, 104, 111, 117, 122, 126,
+	129, 135, 139, 142, 144, 145, 146, 149, 160, 163,
+	164, 165, 166, 176, 177, 178, 180, 183, 184, 185,
+	186, 187, 190, 192, 193, 194, 195, 196, 197, 205,
+	208, 214, 215, 216, 217, 218, 220, 221, 223, 224,
+	225, 226, 232, 235, 241, 242, 252, 259, 262, 137,
+	249, 263, 0, 265, 105, 118, 219, 546, 534, 0,
+	491, 549, 464, 481, 557, 482, 485, 522, 449, 504,
+	182, 479, 0, 468, 444, 475, 445, 466, 493, 125,
+	497, 463, 536, 507, 548, 154, 0, 469, 555, 156,
+	513, 0, 230, 170, 0, 0, 0, 495, 538, 502,
+	531, 490, 523, 454, 512, 550, 480, 520, 551, 0,
+	0, 0, 91, 92, 93, 0, 0, 0, 0, 0,
+	0, 0, 0, 114, 0, 517, 545, 477, 519, 521,
+	559, 443, 514, 0, 447, 450, 556, 541, 472, 473,
+	0, 0, 0, 0, 0, 0, 0, 494, 503, 528,
+	488, 0, 0, 0, 0, 0, 0, 0, 0, 470,
+	0, 511, 0, 0, 0, 451, 448, 0, 0, 0,
+	0, 492, 0, 0, 0, 453, 0, 471, 529, 0,
+	441, 134, 533, 540, 489, 290, 544, 487, 486, 547,
+	201, 0, 234, 138, 153, 110, 150, 95, 106, 0,
+	136, 179, 209, 213, 537, 467, 476, 119, 474, 211,
+	189, 251, 510, 191, 210, 157, 240, 202, 250, 260,
+	261, 237, 258, 267, 227, 98, 236, 248, 115, 222,
+	0, 0, 0, 100, 246, 233, 168, 147, 148, 99,
+	0, 207, 124, 132, 121, 181, 243, 244, 120, 270,
+	107, 257, 102, 439, 256, 175, 239, 247, 169, 162,
+	101, 245, 167, 161, 152, 128, 140, 199, 159, 200,
+	141, 172, 171, 173, 0, 446, 0, 231, 254, 271,
+	112, 462, 238, 264, 266, 0, 203, 113, 133, 127,
+	198, 131, 440, 438, 433, 432, 151, 158, 206, 269,
+	188, 212, 116, 253, 229, 458, 461, 456, 457, 505,
+	506, 552, 553, 554, 530, 452, 0, 459, 460, 0,
+	535, 542, 543, 509, 94, 103, 155, 268, 204, 130,
+	255, 442, 455, 123, 465, 0, 0, 478, 483, 484,
+	496, 498, 499, 500, 501, 508, 515, 516, 518, 524,
+	525, 526, 527, 532, 539, 558, 96, 97, 104, 111,
+	117, 122, 126, 129, 135, 139, 142, 144, 145, 146,
+	149, 160, 163, 164, 165, 166, 176, 177, 178, 180,
+	183, 184, 185, 186, 187, 190, 192, 193, 194, 195,
+	196, 197, 205, 208, 214, 215, 216, 217, 218, 220,
+	221, 223, 224, 225, 226, 232, 235, 241, 242, 252,
+	259, 262, 137, 249, 263, 0, 265, 105, 118, 219,
+	546, 534, 0, 491, 549, 464, 481, 557, 482, 485,
+	522, 449, 504, 182, 479, 0, 468, 444, 475, 445,
+	466, 493, 125, 497, 463, 536, 507, 548, 154, 0,
+	469, 555, 156, 513, 0, 230, 170, 0, 0, 0,
+	495, 538, 502, 531, 490, 523, 454, 512, 550, 480,
+	520, 551, 0, 0, 0, 91, 92, 93, 0, 0,
+	0, 0, 0, 0, 0, 0, 114, 0, 517, 545,
+	477, 519, 521, 559, 443, 514, 0, 447, 450, 556,
+	541, 472, 473, 0, 0, 0, 0, 0, 0, 0,
+	494, 503, 528, 488, 0, 0, 0, 0, 0, 0,
+	0, 0, 470, 0, 511, 0, 0, 0, 451, 448,
+	0, 0, 0, 0, 492, 0, 0, 0, 453, 0,
+	471, 529, 0, 441, 134, 533, 540, 489, 290, 544,
+	487, 486, 547, 201, 0, 234, 138, 153, 110, 150,
+	95, 106, 0, 136, 179, 209, 213, 537, 467, 476,
+	119, 474, 211, 189, 251, 510, 191, 210, 157, 240,
+	202, 250, 260, 261, 237, 258, 267, 227, 98, 236,
+	771, 115, 222, 0, 0, 0, 100, 246, 233, 168,
+	147, 148, 99, 0, 207, 124, 132, 121, 181, 243,
+	244, 120, 270, 107, 257, 102, 439, 256, 175, 239,
+	247, 169, 162, 101, 245, 167, 161, 152, 128, 140,
+	199, 159, 200, 141, 172, 171, 173, 0, 446, 0,
+	231, 254, 271, 112, 462, 238, 264, 266, 0, 203,
+	113, 133, 127, 198, 131, 440, 438, 433, 432, 151,
+	158, 206, 269, 188, 212, 116, 253, 229, 458, 461,
+	456, 457, 505, 506, 552, 553, 554, 530, 452, 0,
+	459, 460, 0, 535, 542, 543, 509, 94, 103, 155,
+	268, 204, 130, 255, 442, 455, 123, 465, 0, 0,
+	478, 483, 484, 496, 498, 499, 500, 501, 508, 515,
+	516, 518, 524, 525, 526, 527, 532, 539, 558, 96,
+	97, 104, 111, 117, 122, 126, 129, 135, 139, 142,
+	144, 145, 146, 149, 160, 163, 164, 165, 166, 176,
+	177, 178, 180, 183, 184, 185, 186, 187, 190, 192,
+	193, 194, 195, 196, 197, 205, 208, 214, 215, 216,
+	217, 218, 220, 221, 223, 224, 225, 226, 232, 235,
+	241, 242, 252, 259, 262, 137, 249, 263, 0, 265,
+	105, 118, 219, 546, 534, 0, 491, 549, 464, 481,
+	557, 482, 485, 522, 449, 504, 182, 479, 0, 468,
+	444, 475, 445, 466, 493, 125, 497, 463, 536, 507,
+	548, 154, 0, 469, 555, 156, 513, 0, 230, 170,
+	0, 0, 0, 495, 538, 502, 531, 490, 523, 454,
+	512, 550, 480, 520, 551, 0, 0, 0, 91, 92,
+	93, 0, 0, 0, 0, 0, 0, 0, 0, 114,
+	0, 517, 545, 477, 519, 521, 559, 443, 514, 0,
+	447, 450, 556, 541, 472, 473, 0, 0, 0, 0,
+	0, 0, 0, 494, 503, 528, 488, 0, 0, 0,
+	0, 0, 0, 0, 0, 470, 0, 511, 0, 0,
+	0, 451, 448, 0, 0, 0, 0, 492, 0, 0,
+	0, 453, 0, 471, 529, 0, 441, 134, 533, 540,
+	489, 290, 544, 487, 486, 547, 201, 0, 234, 138,
+	153, 110, 150, 95, 106, 0, 136, 179, 209, 213,
+	537, 467, 476, 119, 474, 211, 189, 251, 510, 191,
+	210, 157, 240, 202, 250, 260, 261, 237, 258, 267,
+	227, 98, 236, 430, 115, 222, 0, 0, 0, 100,
+	246, 233, 168, 147, 148, 99, 0, 207, 124, 132,
+	121, 181, 243, 244, 120, 270, 107, 257, 102, 439,
+	256, 175, 239, 247, 169, 162, 101, 245, 167, 161,
+	152, 128, 140, 199, 159, 200, 141, 172, 171, 173,
+	0, 446, 0, 231, 254, 271, 112, 462, 238, 264,
+	266, 0, 203, 113, 133, 127, 198, 131, 440, 438,
+	433, 432, 151, 158, 206, 269, 188, 212, 116, 253,
+	229, 458, 461, 456, 457, 505, 506, 552, 553, 554,
+	530, 452, 0, 459, 460, 0, 535, 542, 543, 509,
+	94, 103, 155, 268, 204, 130, 255, 442, 455, 123,
+	465, 0, 0, 478, 483, 484, 496, 498, 499, 500,
+	501, 508, 515, 516, 518, 524, 525, 526, 527, 532,
+	539, 558, 96, 97, 104, 111, 117, 122, 126, 129,
+	135, 139, 142, 144, 145, 146, 149, 160, 163, 164,
+	165, 166, 176, 177, 178, 180, 183, 184, 185, 186,
+	187, 190, 192, 193, 194, 195, 196, 197, 205, 208,
+	214, 215, 216, 217, 218, 220, 221, 223, 224, 225,
+	226, 232, 235, 241, 242, 252, 259, 262, 137, 249,
+	263, 0, 265, 105, 118, 219, 182, 0, 0, 938,
+	0, 334, 0, 0, 0, 125, 0, 333, 0, 0,
+	0, 154, 0, 939, 377, 156, 0, 0, 230, 170,
+	0, 0, 0, 0, 0, 368, 369, 0, 0, 0,
 	0, 0, 0, 0, 0, 57, 0, 0, 91, 92,
-	93, 350, 349, 352, 353, 354, 355, 0, 0, 113,
-	351, 356, 357, 358, 0, 0, 0, 0, 326, 343,
-	0, 371, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 340, 341, 0, 0, 0, 0, 386, 0, 342,
-	0, 0, 335, 336, 338, 337, 339, 344, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 132, 385, 0,
-	0, 285, 0, 0, 383, 0, 199, 0, 231, 136,
-	151, 109, 148, 95, 105, 0, 134, 177, 207, 211,
-	0, 0, 0, 117, 0, 209, 187, 248, 0, 189,
-	208, 155, 237, 200, 247, 257, 258, 234, 255, 263,
-	224, 98, 233, 245, 114, 219, 0, 0, 0, 100,
-	243, 230, 166, 145, 146, 99, 0, 205, 122, 130,
-	119, 179, 240, 241, 118, 266, 106, 254, 102, 107,
-	253, 173, 236, 244, 167, 160, 101, 242, 165, 159,
-	150, 126, 138, 197, 157, 198, 139, 170, 169, 171,
-	0, 0, 0, 228, 251, 267, 111, 0, 235, 261,
-	262, 0, 201, 112, 131, 125, 196, 129, 172, 108,
-	141, 225, 149, 156, 204, 265, 186, 210, 115, 250,
-	226, 373, 384, 379, 380, 377, 378, 376, 375, 374,
-	387, 365, 366, 367, 368, 370, 0, 381, 382, 369,
-	94, 103, 153, 264, 202, 128, 252, 0, 0, 121,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 96, 97, 104, 110, 116, 120, 124, 127,
-	133, 137, 140, 142, 143, 144, 147, 158, 161, 162,
-	163, 164, 174, 175, 176, 178, 181, 182, 183, 184,
-	185, 188, 190, 191, 192, 193, 194, 195, 203, 206,
-	212, 213, 214, 215, 216, 217, 218, 220, 221, 222,
-	223, 229, 232, 238, 239, 249, 256, 259, 135, 246,
-	260, 180, 0, 0, 0, 0, 329, 0, 0, 0,
-	123, 0, 328, 0, 0, 0, 152, 0, 0, 372,
-	154, 0, 0, 227, 168, 0, 0, 0, 0, 0,
-	363, 364, 0, 0, 0, 0, 0, 0, 0, 0,
-	57, 0, 0, 91, 92, 93, 350, 349, 352, 353,
-	354, 355, 0, 0, 113, 351, 356, 357, 358, 0,
-	0, 0, 0, 326, 343, 0, 371, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 340, 341, 0, 0,
-	0, 0, 386, 0, 342, 0, 0, 335, 336, 338,
-	337, 339, 344, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 132, 385, 0, 0, 285, 0, 0, 383,
-	0, 199, 0, 231, 136, 151, 109, 148, 95, 105,
-	0, 134, 177, 207, 211, 0, 0, 0, 117, 0,
-	209, 187, 248, 0, 189, 208, 155, 237, 200, 247,
-	257, 258, 234, 255, 263, 224, 98, 233, 245, 114,
-	219, 0, 0, 0, 100, 243, 230, 166, 145, 146,
-	99, 0, 205, 122, 130, 119, 179, 240, 241, 118,
-	266, 106, 254, 102, 107, 253, 173, 236, 244, 167,
-	160, 101, 242, 165, 159, 150, 126, 138, 197, 157,
-	198, 139, 170, 169, 171, 0, 0, 0, 228, 251,
-	267, 111, 0, 235, 261, 262, 0, 201, 112, 131,
-	125, 196, 129, 172, 108, 141, 225, 149, 156, 204,
-	265, 186, 210, 115, 250, 226, 373, 384, 379, 380,
-	377, 378, 376, 375, 374, 387, 365, 366, 367, 368,
-	370, 0, 381, 382, 369, 94, 103, 153, 264, 202,
-	128, 252, 0, 0, 121, 0, 0, 0, 0, 0,
+	93, 355, 354, 357, 358, 359, 360, 0, 0, 114,
+	356, 361, 362, 363, 0, 0, 0, 0, 331, 348,
+	0, 376, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 345, 346, 417, 0, 0, 0, 391, 0, 347,
+	0, 0, 340, 341, 343, 342, 344, 349, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 134, 390, 0,
+	0, 290, 0, 0, 388, 0, 201, 0, 234, 138,
+	153, 110, 150, 95, 106, 0, 136, 179, 209, 213,
+	0, 0, 0, 119, 0, 211, 189, 251, 0, 191,
+	210, 157, 240, 202, 250, 260, 261, 237, 258, 267,
+	227, 98, 236, 248, 115, 222, 0, 0, 0, 100,
+	246, 233, 168, 147, 148, 99, 0, 207, 124, 132,
+	121, 181, 243, 244, 120, 270, 107, 257, 102, 108,
+	256, 175, 239, 247, 169, 162, 101, 245, 167, 161,
+	152, 128, 140, 199, 159, 200, 141, 172, 171, 173,
+	0, 0, 0, 231, 254, 271, 112, 0, 238, 264,
+	266, 0, 203, 113, 133, 127, 198, 131, 174, 109,
+	143, 228, 151, 158, 206, 269, 188, 212, 116, 253,
+	229, 378, 389, 384, 385, 382, 383, 381, 380, 379,
+	392, 370, 371, 372, 373, 375, 0, 386, 387, 374,
+	94, 103, 155, 268, 204, 130, 255, 0, 0, 123,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 96, 97, 104, 111, 117, 122, 126, 129,
+	135, 139, 142, 144, 145, 146, 149, 160, 163, 164,
+	165, 166, 176, 177, 178, 180, 183, 184, 185, 186,
+	187, 190, 192, 193, 194, 195, 196, 197, 205, 208,
+	214, 215, 216, 217, 218, 220, 221, 223, 224, 225,
+	226, 232, 235, 241, 242, 252, 259, 262, 137, 249,
+	263, 182, 265, 105, 118, 219, 334, 0, 0, 0,
+	125, 0, 333, 0, 0, 0, 154, 0, 0, 377,
+	156, 0, 0, 230, 170, 0, 0, 0, 0, 0,
+	368, 369, 0, 0, 0, 0, 0, 0, 1041, 0,
+	57, 0, 0, 91, 92, 93, 355, 354, 357, 358,
+	359, 360, 0, 0, 114, 356, 361, 362, 363, 1042,
+	0, 0, 0, 331, 348, 0, 376, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 345, 346, 0, 0,
+	0, 0, 391, 0, 347, 0, 0, 340, 341, 343,
+	342, 344, 349, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 134, 390, 0, 0, 290, 0, 0, 388,
+	0, 201, 0, 234, 138, 153, 110, 150, 95, 106,
+	0, 136, 179, 209, 213, 0, 0, 0, 119, 0,
+	211, 189, 251, 0, 191, 210, 157, 240, 202, 250,
+	260, 261, 237, 258, 267, 227, 98, 236, 248, 115,
+	222, 0, 0, 0, 100, 246, 233, 168, 147, 148,
+	99, 0, 207, 124, 132, 121, 181, 243, 244, 120,
+	270, 107, 257, 102, 108, 256, 175, 239, 247, 169,
+	162, 101, 245, 167, 161, 152, 128, 140, 199, 159,
+	200, 141, 172, 171, 173, 0, 0, 0, 231, 254,
+	271, 112, 0, 238, 264, 266, 0, 203, 113, 133,
+	127, 198, 131, 174, 109, 143, 228, 151, 158, 206,
+	269, 188, 212, 116, 253, 229, 378, 389, 384, 385,
+	382, 383, 381, 380, 379, 392, 370, 371, 372, 373,
+	375, 0, 386, 387, 374, 94, 103, 155, 268, 204,
+	130, 255, 0, 0, 123, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 96, 97, 104,
-	110, 116, 120, 124, 127, 133, 137, 140, 142, 143,
-	144, 147, 158, 161, 162, 163, 164, 174, 175, 176,
-	178, 181, 182, 183, 184, 185, 188, 190, 191, 192,
-	193, 194, 195, 203, 206, 212, 213, 214, 215, 216,
-	217, 218, 220, 221, 222, 223, 229, 232, 238, 239,
-	249, 256, 259, 135, 246, 260, 180, 0, 0, 0,
-	0, 0, 0, 0, 0, 123, 0, 0, 0, 0,
-	0, 152, 0, 0, 372, 154, 0, 0, 227, 168,
-	0, 0, 0, 0, 0, 363, 364, 0, 0, 0,
-	0, 0, 0, 0, 0, 57, 0, 0, 91, 92,
-	93, 350, 349, 352, 353, 354, 355, 0, 0, 113,
-	351, 356, 357, 358, 0, 0, 0, 0, 0, 343,
-	0, 371, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 340, 341, 0, 0, 0, 0, 386, 0, 342,
-	0, 0, 335, 336, 338, 337, 339, 344, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 132, 385, 0,
-	0, 285, 0, 0, 383, 0, 199, 0, 231, 136,
-	151, 109, 148, 95, 105, 0, 134, 177, 207, 211,
-	0, 0, 0, 117, 0, 209, 187, 248, 1599, 189,
-	208, 155, 237, 200, 247, 257, 258, 234, 255, 263,
-	224, 98, 233, 245, 114, 219, 0, 0, 0, 100,
-	243, 230, 166, 145, 146, 99, 0, 205, 122, 130,
-	119, 179, 240, 241, 118, 266, 106, 254, 102, 107,
-	253, 173, 236, 244, 167, 160, 101, 242, 165, 159,
-	150, 126, 138, 197, 157, 198, 139, 170, 169, 171,
-	0, 0, 0, 228, 251, 267, 111, 0, 235, 261,
-	262, 0, 201, 112, 131, 125, 196, 129, 172, 108,
-	141, 225, 149, 156, 204, 265, 186, 210, 115, 250,
-	226, 373, 384, 379, 380, 377, 378, 376, 375, 374,
-	387, 365, 366, 367, 368, 370, 0, 381, 382, 369,
-	94, 103, 153, 264, 202, 128, 252, 0, 0, 121,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 96, 97, 104, 110, 116, 120, 124, 127,
-	133, 137, 140, 142, 143, 144, 147, 158, 161, 162,
-	163, 164, 174, 175, 176, 178, 181, 182, 183, 184,
-	185, 188, 190, 191, 192, 193, 194, 195, 203, 206,
-	212, 213, 214, 215, 216, 217, 218, 220, 221, 222,
-	223, 229, 232, 238, 239, 249, 256, 259, 135, 246,
-	260, 180, 0, 0, 0, 0, 0, 0, 0, 0,
-	123, 0, 0, 0, 0, 0, 152, 0, 0, 372,
-	154, 0, 0, 227, 168, 0, 0, 0, 0, 0,
-	363, 364, 0, 0, 0, 0, 0, 0, 0, 0,
-	57, 0, 400, 91, 92, 93, 350, 349, 352, 353,
-	354, 355, 0, 0, 113, 351, 356, 357, 358, 0,
-	0, 0, 0, 0, 343, 0, 371, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 340, 341, 0, 0,
-	0, 0, 386, 0, 342, 0, 0, 335, 336, 338,
-	337, 339, 344, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 132, 385, 0, 0, 285, 0, 0, 383,
-	0, 199, 0, 231, 136, 151, 109, 148, 95, 105,
-	0, 134, 177, 207, 211, 0, 0, 0, 117, 0,
-	209, 187, 248, 0, 189, 208, 155, 237, 200, 247,
-	257, 258, 234, 255, 263, 224, 98, 233, 245, 114,
-	219, 0, 0, 0, 100, 243, 230, 166, 145, 146,
-	99, 0, 205, 122, 130, 119, 179, 240, 241, 118,
-	266, 106, 254, 102, 107, 253, 173, 236, 244, 167,
-	160, 101, 242, 165, 159, 150, 126, 138, 197, 157,
-	198, 139, 170, 169, 171, 0, 0, 0, 228, 251,
-	267, 111, 0, 235, 261, 262, 0, 201, 112, 131,
-	125, 196, 129, 172, 108, 141, 225, 149, 156, 204,
-	265, 186, 210, 115, 250, 226, 373, 384, 379, 380,
-	377, 378, 376, 375, 374, 387, 365, 366, 367, 368,
-	370, 0, 381, 382, 369, 94, 103, 153, 264, 202,
-	128, 252, 0, 0, 121, 0, 0, 0, 0, 0,
+	111, 117, 122, 126, 129, 135, 139, 142, 144, 145,
+	146, 149, 160, 163, 164, 165, 166, 176, 177, 178,
+	180, 183, 184, 185, 186, 187, 190, 192, 193, 194,
+	195, 196, 197, 205, 208, 214, 215, 216, 217, 218,
+	220, 221, 223, 224, 225, 226, 232, 235, 241, 242,
+	252, 259, 262, 137, 249, 263, 182, 265, 105, 118,
+	219, 334, 0, 0, 0, 125, 0, 333, 0, 0,
+	0, 154, 0, 0, 377, 156, 0, 0, 230, 170,
+	0, 0, 0, 0, 0, 368, 369, 0, 0, 0,
+	0, 0, 0, 0, 0, 57, 0, 405, 91, 92,
+	93, 355, 354, 357, 358, 359, 360, 0, 0, 114,
+	356, 361, 362, 363, 0, 0, 0, 0, 331, 348,
+	0, 376, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 345, 346, 0, 0, 0, 0, 391, 0, 347,
+	0, 0, 340, 341, 343, 342, 344, 349, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 134, 390, 0,
+	0, 290, 0, 0, 388, 0, 201, 0, 234, 138,
+	153, 110, 150, 95, 106, 0, 136, 179, 209, 213,
+	0, 0, 0, 119, 0, 211, 189, 251, 0, 191,
+	210, 157, 240, 202, 250, 260, 261, 237, 258, 267,
+	227, 98, 236, 248, 115, 222, 0, 0, 0, 100,
+	246, 233, 168, 147, 148, 99, 0, 207, 124, 132,
+	121, 181, 243, 244, 120, 270, 107, 257, 102, 108,
+	256, 175, 239, 247, 169, 162, 101, 245, 167, 161,
+	152, 128, 140, 199, 159, 200, 141, 172, 171, 173,
+	0, 0, 0, 231, 254, 271, 112, 0, 238, 264,
+	266, 0, 203, 113, 133, 127, 198, 131, 174, 109,
+	143, 228, 151, 158, 206, 269, 188, 212, 116, 253,
+	229, 378, 389, 384, 385, 382, 383, 381, 380, 379,
+	392, 370, 371, 372, 373, 375, 0, 386, 387, 374,
+	94, 103, 155, 268, 204, 130, 255, 0, 0, 123,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 96, 97, 104, 111, 117, 122, 126, 129,
+	135, 139, 142, 144, 145, 146, 149, 160, 163, 164,
+	165, 166, 176, 177, 178, 180, 183, 184, 185, 186,
+	187, 190, 192, 193, 194, 195, 196, 197, 205, 208,
+	214, 215, 216, 217, 218, 220, 221, 223, 224, 225,
+	226, 232, 235, 241, 242, 252, 259, 262, 137, 249,
+	263, 182, 265, 105, 118, 219, 334, 0, 0, 0,
+	125, 0, 333, 0, 0, 0, 154, 0, 0, 377,
+	156, 0, 0, 230, 170, 0, 0, 0, 0, 0,
+	368, 369, 0, 0, 0, 0, 0, 0, 0, 0,
+	57, 0, 0, 91, 92, 93, 355, 354, 357, 358,
+	359, 360, 0, 0, 114, 356, 361, 362, 363, 0,
+	0, 0, 0, 331, 348, 0, 376, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 345, 346, 417, 0,
+	0, 0, 391, 0, 347, 0, 0, 340, 341, 343,
+	342, 344, 349, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 134, 390, 0, 0, 290, 0, 0, 388,
+	0, 201, 0, 234, 138, 153, 110, 150, 95, 106,
+	0, 136, 179, 209, 213, 0, 0, 0, 119, 0,
+	211, 189, 251, 0, 191, 210, 157, 240, 202, 250,
+	260, 261, 237, 258, 267, 227, 98, 236, 248, 115,
+	222, 0, 0, 0, 100, 246, 233, 168, 147, 148,
+	99, 0, 207, 124, 132, 121, 181, 243, 244, 120,
+	270, 107, 257, 102, 108, 256, 175, 239, 247, 169,
+	162, 101, 245, 167, 161, 152, 128, 140, 199, 159,
+	200, 141, 172, 171, 173, 0, 0, 0, 231, 254,
+	271, 112, 0, 238, 264, 266, 0, 203, 113, 133,
+	127, 198, 131, 174, 109, 143, 228, 151, 158, 206,
+	269, 188, 212, 116, 253, 229, 378, 389, 384, 385,
+	382, 383, 381, 380, 379, 392, 370, 371, 372, 373,
+	375, 0, 386, 387, 374, 94, 103, 155, 268, 204,
+	130, 255, 0, 0, 123, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 96, 97, 104,
-	110, 116, 120, 124, 127, 133, 137, 140, 142, 143,
-	144, 147, 158, 161, 162, 163, 164, 174, 175, 176,
-	178, 181, 182, 183, 184, 185, 188, 190, 191, 192,
-	193, 194, 195, 203, 206, 212, 213, 214, 215, 216,
-	217, 218, 220, 221, 222, 223, 229, 232, 238, 239,
-	249, 256, 259, 135, 246, 260, 180, 0, 0, 0,
-	0, 0, 0, 0, 0, 123, 0, 0, 0, 0,
-	0, 152, 0, 0, 372, 154, 0, 0, 227, 168,
-	0, 0, 0, 0, 0, 363, 364, 0, 0, 0,
+	111, 117, 122, 126, 129, 135, 139, 142, 144, 145,
+	146, 149, 160, 163, 164, 165, 166, 176, 177, 178,
+	180, 183, 184, 185, 186, 187, 190, 192, 193, 194,
+	195, 196, 197, 205, 208, 214, 215, 216, 217, 218,
+	220, 221, 223, 224, 225, 226, 232, 235, 241, 242,
+	252, 259, 262, 137, 249, 263, 182, 265, 105, 118,
+	219, 334, 0, 0, 0, 125, 0, 333, 0, 0,
+	0, 154, 0, 0, 377, 156, 0, 0, 230, 170,
+	0, 0, 0, 0, 0, 368, 369, 0, 0, 0,
 	0, 0, 0, 0, 0, 57, 0, 0, 91, 92,
-	93, 350, 349, 352, 353, 354, 355, 0, 0, 113,
-	351, 356, 357, 358, 0, 0, 0, 0, 0, 343,
-	0, 371, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 340, 341, 0, 0, 0, 0, 386, 0, 342,
-	0, 0, 335, 336, 338, 337, 339, 344, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 132, 385, 0,
-	0, 285, 0, 0, 383, 0, 199, 0, 231, 136,
-	151, 109, 148, 95, 105, 0, 134, 177, 207, 211,
-	0, 0, 0, 117, 0, 209, 187, 248, 0, 189,
-	208, 155, 237, 200, 247, 257, 258, 234, 255, 263,
-	224, 98, 233, 245, 114, 219, 0, 0, 0, 100,
-	243, 230, 166, 145, 146, 99, 0, 205, 122, 130,
-	119, 179, 240, 241, 118, 266, 106, 254, 102, 107,
-	253, 173, 236, 244, 167, 160, 101, 242, 165, 159,
-	150, 126, 138, 197, 157, 198, 139, 170, 169, 171,
-	0, 0, 0, 228, 251, 267, 111, 0, 235, 261,
-	262, 0, 201, 112, 131, 125, 196, 129, 172, 108,
-	141, 225, 149, 156, 204, 265, 186, 210, 115, 250,
-	226, 373, 384, 379, 380, 377, 378, 376, 375, 374,
-	387, 365, 366, 367, 368, 370, 0, 381, 382, 369,
-	94, 103, 153, 264, 202, 128, 252, 0, 0, 121,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 96, 97, 104, 110, 116, 120, 124, 127,
-	133, 137, 140, 142, 143, 144, 147, 158, 161, 162,
-	163, 164, 174, 175, 176, 178, 181, 182, 183, 184,
-	185, 188, 190, 191, 192, 193, 194, 195, 203, 206,
-	212, 213, 214, 215, 216, 217, 218, 220, 221, 222,
-	223, 229, 232, 238, 239, 249, 256, 259, 135, 246,
-	260, 180, 0, 0, 0, 0, 0, 0, 0, 0,
-	123, 0, 0, 0, 0, 0, 152, 0, 0, 0,
-	154, 0, 0, 227, 168, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 91, 92, 93, 0, 0, 0, 0,
-	0, 0, 0, 0, 113, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 645, 644, 654, 655, 647, 648, 649, 650,
-	651, 652, 653, 646, 0, 0, 656, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 132, 0, 0, 0, 285, 0, 0, 0,
-	0, 199, 0, 231, 136, 151, 109, 148, 95, 105,
-	0, 134, 177, 207, 211, 0, 0, 0, 117, 0,
-	209, 187, 248, 0, 189, 208, 155, 237, 200, 247,
-	257, 258, 234, 255, 263, 224, 98, 233, 245, 114,
-	219, 0, 0, 0, 100, 243, 230, 166, 145, 146,
-	99, 0, 205, 122, 130, 119, 179, 240, 241, 118,
-	266, 106, 254, 102, 107, 253, 173, 236, 244, 167,
-	160, 101, 242, 165, 159, 150, 126, 138, 197, 157,
-	198, 139, 170, 169, 171, 0, 0, 0, 228, 251,
-	267, 111, 0, 235, 261, 262, 0, 201, 112, 131,
-	125, 196, 129, 172, 108, 141, 225, 149, 156, 204,
-	265, 186, 210, 115, 250, 226, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 94, 103, 153, 264, 202,
-	128, 252, 0, 0, 121, 0, 0, 0, 0, 0,
+	93, 355, 956, 357, 358, 359, 360, 0, 0, 114,
+	356, 361, 362, 363, 0, 0, 0, 0, 331, 348,
+	0, 376, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 345, 346, 417, 0, 0, 0, 391, 0, 347,
+	0, 0, 340, 341, 343, 342, 344, 349, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 134, 390, 0,
+	0, 290, 0, 0, 388, 0, 201, 0, 234, 138,
+	153, 110, 150, 95, 106, 0, 136, 179, 209, 213,
+	0, 0, 0, 119, 0, 211, 189, 251, 0, 191,
+	210, 157, 240, 202, 250, 260, 261, 237, 258, 267,
+	227, 98, 236, 248, 115, 222, 0, 0, 0, 100,
+	246, 233, 168, 147, 148, 99, 0, 207, 124, 132,
+	121, 181, 243, 244, 120, 270, 107, 257, 102, 108,
+	256, 175, 239, 247, 169, 162, 101, 245, 167, 161,
+	152, 128, 140, 199, 159, 200, 141, 172, 171, 173,
+	0, 0, 0, 231, 254, 271, 112, 0, 238, 264,
+	266, 0, 203, 113, 133, 127, 198, 131, 174, 109,
+	143, 228, 151, 158, 206, 269, 188, 212, 116, 253,
+	229, 378, 389, 384, 385, 382, 383, 381, 380, 379,
+	392, 370, 371, 372, 373, 375, 0, 386, 387, 374,
+	94, 103, 155, 268, 204, 130, 255, 0, 0, 123,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 96, 97, 104, 111, 117, 122, 126, 129,
+	135, 139, 142, 144, 145, 146, 149, 160, 163, 164,
+	165, 166, 176, 177, 178, 180, 183, 184, 185, 186,
+	187, 190, 192, 193, 194, 195, 196, 197, 205, 208,
+	214, 215, 216, 217, 218, 220, 221, 223, 224, 225,
+	226, 232, 235, 241, 242, 252, 259, 262, 137, 249,
+	263, 182, 265, 105, 118, 219, 334, 0, 0, 0,
+	125, 0, 333, 0, 0, 0, 154, 0, 0, 377,
+	156, 0, 0, 230, 170, 0, 0, 0, 0, 0,
+	368, 369, 0, 0, 0, 0, 0, 0, 0, 0,
+	57, 0, 0, 91, 92, 93, 355, 953, 357, 358,
+	359, 360, 0, 0, 114, 356, 361, 362, 363, 0,
+	0, 0, 0, 331, 348, 0, 376, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 345, 346, 417, 0,
+	0, 0, 391, 0, 347, 0, 0, 340, 341, 343,
+	342, 344, 349, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 134, 390, 0, 0, 290, 0, 0, 388,
+	0, 201, 0, 234, 138, 153, 110, 150, 95, 106,
+	0, 136, 179, 209, 213, 0, 0, 0, 119, 0,
+	211, 189, 251, 0, 191, 210, 157, 240, 202, 250,
+	260, 261, 237, 258, 267, 227, 98, 236, 248, 115,
+	222, 0, 0, 0, 100, 246, 233, 168, 147, 148,
+	99, 0, 207, 124, 132, 121, 181, 243, 244, 120,
+	270, 107, 257, 102, 108, 256, 175, 239, 247, 169,
+	162, 101, 245, 167, 161, 152, 128, 140, 199, 159,
+	200, 141, 172, 171, 173, 0, 0, 0, 231, 254,
+	271, 112, 0, 238, 264, 266, 0, 203, 113, 133,
+	127, 198, 131, 174, 109, 143, 228, 151, 158, 206,
+	269, 188, 212, 116, 253, 229, 378, 389, 384, 385,
+	382, 383, 381, 380, 379, 392, 370, 371, 372, 373,
+	375, 0, 386, 387, 374, 94, 103, 155, 268, 204,
+	130, 255, 0, 0, 123, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 96, 97, 104,
-	110, 116, 120, 124, 127, 133, 137, 140, 142, 143,
-	144, 147, 158, 161, 162, 163, 164, 174, 175, 176,
-	178, 181, 182, 183, 184, 185, 188, 190, 191, 192,
-	193, 194, 195, 203, 206, 212, 213, 214, 215, 216,
-	217, 218, 220, 221, 222, 223, 229, 232, 238, 239,
-	249, 256, 259, 135, 246, 260, 180, 0, 0, 0,
-	740, 0, 0, 0, 0, 123, 0, 0, 0, 0,
-	0, 152, 0, 0, 0, 154, 0, 0, 227, 168,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 91, 92,
-	93, 0, 742, 0, 0, 0, 0, 0, 0, 113,
-	0, 0, 0, 0, 0, 634, 635, 633, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 636, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 132, 0, 0,
-	0, 285, 0, 0, 0, 0, 199, 0, 231, 136,
-	151, 109, 148, 95, 105, 0, 134, 177, 207, 211,
-	0, 0, 0, 117, 0, 209, 187, 248, 0, 189,
-	208, 155, 237, 200, 247, 257, 258, 234, 255, 263,
-	224, 98, 233, 245, 114, 219, 0, 0, 0, 100,
-	243, 230, 166, 145, 146, 99, 0, 205, 122, 130,
-	119, 179, 240, 241, 118, 266, 106, 254, 102, 107,
-	253, 173, 236, 244, 167, 160, 101, 242, 165, 159,
-	150, 126, 138, 197, 157, 198, 139, 170, 169, 171,
-	0, 0, 0, 228, 251, 267, 111, 0, 235, 261,
-	262, 0, 201, 112, 131, 125, 196, 129, 172, 108,
-	141, 225, 149, 156, 204, 265, 186, 210, 115, 250,
-	226, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	94, 103, 153, 264, 202, 128, 252, 0, 0, 121,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 96, 97, 104, 110, 116, 120, 124, 127,
-	133, 137, 140, 142, 143, 144, 147, 158, 161, 162,
-	163, 164, 174, 175, 176, 178, 181, 182, 183, 184,
-	185, 188, 190, 191, 192, 193, 194, 195, 203, 206,
-	212, 213, 214, 215, 216, 217, 218, 220, 221, 222,
-	223, 229, 232, 238, 239, 249, 256, 259, 135, 246,
-	260, 180, 0, 0, 0, 0, 0, 0, 0, 0,
-	123, 0, 0, 0, 0, 0, 152, 0, 0, 0,
-	154, 0, 0, 227, 168, 0, 0, 0, 0, 0,
+	111, 117, 122, 126, 129, 135, 139, 142, 144, 145,
+	146, 149, 160, 163, 164, 165, 166, 176, 177, 178,
+	180, 183, 184, 185, 186, 187, 190, 192, 193, 194,
+	195, 196, 197, 205, 208, 214, 215, 216, 217, 218,
+	220, 221, 223, 224, 225, 226, 232, 235, 241, 242,
+	252, 259, 262, 137, 249, 263, 398, 265, 105, 118,
+	219, 0, 0, 0, 0, 0, 0, 0, 182, 0,
+	0, 0, 0, 334, 0, 0, 0, 125, 0, 333,
+	0, 0, 0, 154, 0, 0, 377, 156, 0, 0,
+	230, 170, 0, 0, 0, 0, 0, 368, 369, 0,
+	0, 0, 0, 0, 0, 0, 0, 57, 0, 0,
+	91, 92, 93, 355, 354, 357, 358, 359, 360, 0,
+	0, 114, 356, 361, 362, 363, 0, 0, 0, 0,
+	331, 348, 0, 376, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 345, 346, 0, 0, 0, 0, 391,
+	0, 347, 0, 0, 340, 341, 343, 342, 344, 349,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 134,
+	390, 0, 0, 290, 0, 0, 388, 0, 201, 0,
+	234, 138, 153, 110, 150, 95, 106, 0, 136, 179,
+	209, 213, 0, 0, 0, 119, 0, 211, 189, 251,
+	0, 191, 210, 157, 240, 202, 250, 260, 261, 237,
+	258, 267, 227, 98, 236, 248, 115, 222, 0, 0,
+	0, 100, 246, 233, 168, 147, 148, 99, 0, 207,
+	124, 132, 121, 181, 243, 244, 120, 270, 107, 257,
+	102, 108, 256, 175, 239, 247, 169, 162, 101, 245,
+	167, 161, 152, 128, 140, 199, 159, 200, 141, 172,
+	171, 173, 0, 0, 0, 231, 254, 271, 112, 0,
+	238, 264, 266, 0, 203, 113, 133, 127, 198, 131,
+	174, 109, 143, 228, 151, 158, 206, 269, 188, 212,
+	116, 253, 229, 378, 389, 384, 385, 382, 383, 381,
+	380, 379, 392, 370, 371, 372, 373, 375, 0, 386,
+	387, 374, 94, 103, 155, 268, 204, 130, 255, 0,
+	0, 123, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 96, 97, 104, 111, 117, 122,
+	126, 129, 135, 139, 142, 144, 145, 146, 149, 160,
+	163, 164, 165, 166, 176, 177, 178, 180, 183, 184,
+	185, 186, 187, 190, 192, 193, 194, 195, 196, 197,
+	205, 208, 214, 215, 216, 217, 218, 220, 221, 223,
+	224, 225, 226, 232, 235, 241, 242, 252, 259, 262,
+	137, 249, 263, 182, 265, 105, 118, 219, 334, 0,
+	0, 0, 125, 0, 333, 0, 0, 0, 154, 0,
+	0, 377, 156, 0, 0, 230, 170, 0, 0, 0,
+	0, 0, 368, 369, 0, 0, 0, 0, 0, 0,
+	0, 0, 57, 0, 0, 91, 92, 93, 355, 354,
+	357, 358, 359, 360, 0, 0, 114, 356, 361, 362,
+	363, 0, 0, 0, 0, 331, 348, 0, 376, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 345, 346,
+	0, 0, 0, 0, 391, 0, 347, 0, 0, 340,
+	341, 343, 342, 344, 349, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 134, 390, 0, 0, 290, 0,
+	0, 388, 0, 201, 0, 234, 138, 153, 110, 150,
+	95, 106, 0, 136, 179, 209, 213, 0, 0, 0,
+	119, 0, 211, 189, 251, 0, 191, 210, 157, 240,
+	202, 250, 260, 261, 237, 258, 267, 227, 98, 236,
+	248, 115, 222, 0, 0, 0, 100, 246, 233, 168,
+	147, 148, 99, 0, 207, 124, 132, 121, 181, 243,
+	244, 120, 270, 107, 257, 102, 108, 256, 175, 239,
+	247, 169, 162, 101, 245, 167, 161, 152, 128, 140,
+	199, 159, 200, 141, 172, 171, 173, 0, 0, 0,
+	231, 254, 271, 112, 0, 238, 264, 266, 0, 203,
+	113, 133, 127, 198, 131, 174, 109, 143, 228, 151,
+	158, 206, 269, 188, 212, 116, 253, 229, 378, 389,
+	384, 385, 382, 383, 381, 380, 379, 392, 370, 371,
+	372, 373, 375, 0, 386, 387, 374, 94, 103, 155,
+	268, 204, 130, 255, 0, 0, 123, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 91, 92, 93, 0, 0, 0, 0,
-	0, 0, 0, 0, 113, 0, 0, 0, 0, 0,
-	83, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 96,
+	97, 104, 111, 117, 122, 126, 129, 135, 139, 142,
+	144, 145, 146, 149, 160, 163, 164, 165, 166, 176,
+	177, 178, 180, 183, 184, 185, 186, 187, 190, 192,
+	193, 194, 195, 196, 197, 205, 208, 214, 215, 216,
+	217, 218, 220, 221, 223, 224, 225, 226, 232, 235,
+	241, 242, 252, 259, 262, 137, 249, 263, 182, 265,
+	105, 118, 219, 0, 0, 0, 0, 125, 0, 0,
+	0, 0, 0, 154, 0, 0, 377, 156, 0, 0,
+	230, 170, 0, 0, 0, 0, 0, 368, 369, 0,
+	0, 0, 0, 0, 0, 0, 0, 57, 0, 0,
+	91, 92, 93, 355, 354, 357, 358, 359, 360, 0,
+	0, 114, 356, 361, 362, 363, 0, 0, 0, 0,
+	0, 348, 0, 376, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 345, 346, 0, 0, 0, 0, 391,
+	0, 347, 0, 0, 340, 341, 343, 342, 344, 349,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 134,
+	390, 0, 0, 290, 0, 0, 388, 0, 201, 0,
+	234, 138, 153, 110, 150, 95, 106, 0, 136, 179,
+	209, 213, 0, 0, 0, 119, 0, 211, 189, 251,
+	1609, 191, 210, 157, 240, 202, 250, 260, 261, 237,
+	258, 267, 227, 98, 236, 248, 115, 222, 0, 0,
+	0, 100, 246, 233, 168, 147, 148, 99, 0, 207,
+	124, 132, 121, 181, 243, 244, 120, 270, 107, 257,
+	102, 108, 256, 175, 239, 247, 169, 162, 101, 245,
+	167, 161, 152, 128, 140, 199, 159, 200, 141, 172,
+	171, 173, 0, 0, 0, 231, 254, 271, 112, 0,
+	238, 264, 266, 0, 203, 113, 133, 127, 198, 131,
+	174, 109, 143, 228, 151, 158, 206, 269, 188, 212,
+	116, 253, 229, 378, 389, 384, 385, 382, 383, 381,
+	380, 379, 392, 370, 371, 372, 373, 375, 0, 386,
+	387, 374, 94, 103, 155, 268, 204, 130, 255, 0,
+	0, 123, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 96, 97, 104, 111, 117, 122,
+	126, 129, 135, 139, 142, 144, 145, 146, 149, 160,
+	163, 164, 165, 166, 176, 177, 178, 180, 183, 184,
+	185, 186, 187, 190, 192, 193, 194, 195, 196, 197,
+	205, 208, 214, 215, 216, 217, 218, 220, 221, 223,
+	224, 225, 226, 232, 235, 241, 242, 252, 259, 262,
+	137, 249, 263, 182, 265, 105, 118, 219, 0, 0,
+	0, 0, 125, 0, 0, 0, 0, 0, 154, 0,
+	0, 377, 156, 0, 0, 230, 170, 0, 0, 0,
+	0, 0, 368, 369, 0, 0, 0, 0, 0, 0,
+	0, 0, 57, 0, 405, 91, 92, 93, 355, 354,
+	357, 358, 359, 360, 0, 0, 114, 356, 361, 362,
+	363, 0, 0, 0, 0, 0, 348, 0, 376, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 345, 346,
+	0, 0, 0, 0, 391, 0, 347, 0, 0, 340,
+	341, 343, 342, 344, 349, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 134, 390, 0, 0, 290, 0,
+	0, 388, 0, 201, 0, 234, 138, 153, 110, 150,
+	95, 106, 0, 136, 179, 209, 213, 0, 0, 0,
+	119, 0, 211, 189, 251, 0, 191, 210, 157, 240,
+	202, 250, 260, 261, 237, 258, 267, 227, 98, 236,
+	248, 115, 222, 0, 0, 0, 100, 246, 233, 168,
+	147, 148, 99, 0, 207, 124, 132, 121, 181, 243,
+	244, 120, 270, 107, 257, 102, 108, 256, 175, 239,
+	247, 169, 162, 101, 245, 167, 161, 152, 128, 140,
+	199, 159, 200, 141, 172, 171, 173, 0, 0, 0,
+	231, 254, 271, 112, 0, 238, 264, 266, 0, 203,
+	113, 133, 127, 198, 131, 174, 109, 143, 228, 151,
+	158, 206, 269, 188, 212, 116, 253, 229, 378, 389,
+	384, 385, 382, 383, 381, 380, 379, 392, 370, 371,
+	372, 373, 375, 0, 386, 387, 374, 94, 103, 155,
+	268, 204, 130, 255, 0, 0, 123, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 96,
+	97, 104, 111, 117, 122, 126, 129, 135, 139, 142,
+	144, 145, 146, 149, 160, 163, 164, 165, 166, 176,
+	177, 178, 180, 183, 184, 185, 186, 187, 190, 192,
+	193, 194, 195, 196, 197, 205, 208, 214, 215, 216,
+	217, 218, 220, 221, 223, 224, 225, 226, 232, 235,
+	241, 242, 252, 259, 262, 137, 249, 263, 182, 265,
+	105, 118, 219, 0, 0, 0, 0, 125, 0, 0,
+	0, 0, 0, 154, 0, 0, 377, 156, 0, 0,
+	230, 170, 0, 0, 0, 0, 0, 368, 369, 0,
+	0, 0, 0, 0, 0, 0, 0, 57, 0, 0,
+	91, 92, 93, 355, 354, 357, 358, 359, 360, 0,
+	0, 114, 356, 361, 362, 363, 0, 0, 0, 0,
+	0, 348, 0, 376, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 345, 346, 0, 0, 0, 0, 391,
+	0, 347, 0, 0, 340, 341, 343, 342, 344, 349,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 134,
+	390, 0, 0, 290, 0, 0, 388, 0, 201, 0,
+	234, 138, 153, 110, 150, 95, 106, 0, 136, 179,
+	209, 213, 0, 0, 0, 119, 0, 211, 189, 251,
+	0, 191, 210, 157, 240, 202, 250, 260, 261, 237,
+	258, 267, 227, 98, 236, 248, 115, 222, 0, 0,
+	0, 100, 246, 233, 168, 147, 148, 99, 0, 207,
+	124, 132, 121, 181, 243, 244, 120, 270, 107, 257,
+	102, 108, 256, 175, 239, 247, 169, 162, 101, 245,
+	167, 161, 152, 128, 140, 199, 159, 200, 141, 172,
+	171, 173, 0, 0, 0, 231, 254, 271, 112, 0,
+	238, 264, 266, 0, 203, 113, 133, 127, 198, 131,
+	174, 109, 143, 228, 151, 158, 206, 269, 188, 212,
+	116, 253, 229, 378, 389, 384, 385, 382, 383, 381,
+	380, 379, 392, 370, 371, 372, 373, 375, 0, 386,
+	387, 374, 94, 103, 155, 268, 204, 130, 255, 0,
+	0, 123, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 96, 97, 104, 111, 117, 122,
+	126, 129, 135, 139, 142, 144, 145, 146, 149, 160,
+	163, 164, 165, 166, 176, 177, 178, 180, 183, 184,
+	185, 186, 187, 190, 192, 193, 194, 195, 196, 197,
+	205, 208, 214, 215, 216, 217, 218, 220, 221, 223,
+	224, 225, 226, 232, 235, 241, 242, 252, 259, 262,
+	137, 249, 263, 182, 265, 105, 118, 219, 0, 0,
+	0, 0, 125, 0, 0, 0, 0, 0, 154, 0,
+	0, 0, 156, 0, 0, 230, 170, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 91, 92, 93, 0, 0,
+	0, 0, 0, 0, 0, 0, 114, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 132, 85, 86, 0, 82, 0, 0, 0,
-	87, 199, 0, 231, 136, 151, 109, 148, 95, 105,
-	0, 134, 177, 207, 211, 0, 0, 0, 117, 0,
-	209, 187, 248, 0, 189, 208, 155, 237, 200, 247,
-	257, 258, 234, 255, 263, 224, 98, 233, 245, 114,
-	219, 0, 0, 0, 100, 243, 230, 166, 145, 146,
-	99, 0, 205, 122, 130, 119, 179, 240, 241, 118,
-	266, 106, 254, 102, 107, 253, 173, 236, 244, 167,
-	160, 101, 242, 165, 159, 150, 126, 138, 197, 157,
-	198, 139, 170, 169, 171, 0, 0, 0, 228, 251,
-	267, 111, 0, 235, 261, 262, 0, 201, 112, 131,
-	125, 196, 129, 172, 108, 141, 225, 149, 156, 204,
-	265, 186, 210, 115, 250, 226, 0, 84, 0, 0,
+	0, 0, 0, 0, 651, 650, 660, 661, 653, 654,
+	655, 656, 657, 658, 659, 652, 0, 0, 662, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 94, 103, 153, 264, 202,
-	128, 252, 0, 0, 121, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 134, 0, 0, 0, 290, 0,
+	0, 0, 0, 201, 0, 234, 138, 153, 110, 150,
+	95, 106, 0, 136, 179, 209, 213, 0, 0, 0,
+	119, 0, 211, 189, 251, 0, 191, 210, 157, 240,
+	202, 250, 260, 261, 237, 258, 267, 227, 98, 236,
+	248, 115, 222, 0, 0, 0, 100, 246, 233, 168,
+	147, 148, 99, 0, 207, 124, 132, 121, 181, 243,
+	244, 120, 270, 107, 257, 102, 108, 256, 175, 239,
+	247, 169, 162, 101, 245, 167, 161, 152, 128, 140,
+	199, 159, 200, 141, 172, 171, 173, 0, 0, 0,
+	231, 254, 271, 112, 0, 238, 264, 266, 0, 203,
+	113, 133, 127, 198, 131, 174, 109, 143, 228, 151,
+	158, 206, 269, 188, 212, 116, 253, 229, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 96, 97, 104,
-	110, 116, 120, 124, 127, 133, 137, 140, 142, 143,
-	144, 147, 158, 161, 162, 163, 164, 174, 175, 176,
-	178, 181, 182, 183, 184, 185, 188, 190, 191, 192,
-	193, 194, 195, 203, 206, 212, 213, 214, 215, 216,
-	217, 218, 220, 221, 222, 223, 229, 232, 238, 239,
-	249, 256, 259, 135, 246, 260, 180, 0, 0, 0,
-	1014, 0, 0, 0, 0, 123, 0, 0, 0, 0,
-	0, 152, 0, 0, 0, 154, 0, 0, 227, 168,
+	0, 0, 0, 0, 0, 0, 0, 94, 103, 155,
+	268, 204, 130, 255, 0, 0, 123, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 91, 92,
-	93, 0, 1016, 0, 0, 0, 0, 0, 0, 113,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 96,
+	97, 104, 111, 117, 122, 126, 129, 135, 139, 142,
+	144, 145, 146, 149, 160, 163, 164, 165, 166, 176,
+	177, 178, 180, 183, 184, 185, 186, 187, 190, 192,
+	193, 194, 195, 196, 197, 205, 208, 214, 215, 216,
+	217, 218, 220, 221, 223, 224, 225, 226, 232, 235,
+	241, 242, 252, 259, 262, 137, 249, 263, 0, 265,
+	105, 118, 219, 182, 0, 0, 0, 746, 0, 0,
+	0, 0, 125, 0, 0, 0, 0, 0, 154, 0,
+	0, 0, 156, 0, 0, 230, 170, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 91, 92, 93, 0, 748,
+	0, 0, 0, 0, 0, 0, 114, 0, 0, 0,
+	0, 0, 640, 641, 639, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	642, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 134, 0, 0, 0, 290, 0,
+	0, 0, 0, 201, 0, 234, 138, 153, 110, 150,
+	95, 106, 0, 136, 179, 209, 213, 0, 0, 0,
+	119, 0, 211, 189, 251, 0, 191, 210, 157, 240,
+	202, 250, 260, 261, 237, 258, 267, 227, 98, 236,
+	248, 115, 222, 0, 0, 0, 100, 246, 233, 168,
+	147, 148, 99, 0, 207, 124, 132, 121, 181, 243,
+	244, 120, 270, 107, 257, 102, 108, 256, 175, 239,
+	247, 169, 162, 101, 245, 167, 161, 152, 128, 140,
+	199, 159, 200, 141, 172, 171, 173, 0, 0, 0,
+	231, 254, 271, 112, 0, 238, 264, 266, 0, 203,
+	113, 133, 127, 198, 131, 174, 109, 143, 228, 151,
+	158, 206, 269, 188, 212, 116, 253, 229, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 94, 103, 155,
+	268, 204, 130, 255, 0, 0, 123, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 96,
+	97, 104, 111, 117, 122, 126, 129, 135, 139, 142,
+	144, 145, 146, 149, 160, 163, 164, 165, 166, 176,
+	177, 178, 180, 183, 184, 185, 186, 187, 190, 192,
+	193, 194, 195, 196, 197, 205, 208, 214, 215, 216,
+	217, 218, 220, 221, 223, 224, 225, 226, 232, 235,
+	241, 242, 252, 259, 262, 137, 249, 263, 182, 265,
+	105, 118, 219, 0, 0, 0, 0, 125, 0, 0,
+	0, 0, 0, 154, 0, 0, 0, 156, 0, 0,
+	230, 170, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	91, 92, 93, 0, 0, 0, 0, 0, 0, 0,
+	0, 114, 0, 0, 0, 0, 0, 83, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 134,
+	85, 86, 0, 82, 0, 0, 0, 87, 201, 0,
+	234, 138, 153, 110, 150, 95, 106, 0, 136, 179,
+	209, 213, 0, 0, 0, 119, 0, 211, 189, 251,
+	0, 191, 210, 157, 240, 202, 250, 260, 261, 237,
+	258, 267, 227, 98, 236, 248, 115, 222, 0, 0,
+	0, 100, 246, 233, 168, 147, 148, 99, 0, 207,
+	124, 132, 121, 181, 243, 244, 120, 270, 107, 257,
+	102, 108, 256, 175, 239, 247, 169, 162, 101, 245,
+	167, 161, 152, 128, 140, 199, 159, 200, 141, 172,
+	171, 173, 0, 0, 0, 231, 254, 271, 112, 0,
+	238, 264, 266, 0, 203, 113, 133, 127, 198, 131,
+	174, 109, 143, 228, 151, 158, 206, 269, 188, 212,
+	116, 253, 229, 0, 84, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 94, 103, 155, 268, 204, 130, 255, 0,
+	0, 123, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 96, 97, 104, 111, 117, 122,
+	126, 129, 135, 139, 142, 144, 145, 146, 149, 160,
+	163, 164, 165, 166, 176, 177, 178, 180, 183, 184,
+	185, 186, 187, 190, 192, 193, 194, 195, 196, 197,
+	205, 208, 214, 215, 216, 217, 218, 220, 221, 223,
+	224, 225, 226, 232, 235, 241, 242, 252, 259, 262,
+	137, 249, 263, 0, 265, 105, 118, 219, 182, 0,
+	0, 0, 1024, 0, 0, 0, 0, 125, 0, 0,
+	0, 0, 0, 154, 0, 0, 0, 156, 0, 0,
+	230, 170, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	91, 92, 93, 0, 1026, 0, 0, 0, 0, 0,
+	0, 114, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 134,
+	0, 0, 0, 290, 0, 0, 0, 0, 201, 0,
+	234, 138, 153, 110, 150, 95, 106, 0, 136, 179,
+	209, 213, 0, 0, 0, 119, 0, 211, 189, 251,
+	0, 191, 210, 157, 240, 202, 250, 260, 261, 237,
+	258, 267, 227, 98, 236, 248, 115, 222, 0, 0,
+	0, 100, 246, 233, 168, 147, 148, 99, 0, 207,
+	124, 132, 121, 181, 243, 244, 120, 270, 107, 257,
+	102, 108, 256, 175, 239, 247, 169, 162, 101, 245,
+	167, 161, 152, 128, 140, 199, 159, 200, 141, 172,
+	171, 173, 0, 0, 0, 231, 254, 271, 112, 0,
+	238, 264, 266, 0, 203, 113, 133, 127, 198, 131,
+	174, 109, 143, 228, 151, 158, 206, 269, 188, 212,
+	116, 253, 229, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 94, 103, 155, 268, 204, 130, 255, 0,
+	0, 123, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 96, 97, 104, 111, 117, 122,
+	126, 129, 135, 139, 142, 144, 145, 146, 149, 160,
+	163, 164, 165, 166, 176, 177, 178, 180, 183, 184,
+	185, 186, 187, 190, 192, 193, 194, 195, 196, 197,
+	205, 208, 214, 215, 216, 217, 218, 220, 221, 223,
+	224, 225, 226, 232, 235, 241, 242, 252, 259, 262,
+	137, 249, 263, 29, 265, 105, 118, 219, 0, 0,
+	0, 0, 0, 0, 0, 182, 0, 0, 0, 0,
+	0, 0, 0, 0, 125, 0, 0, 0, 0, 0,
+	154, 0, 0, 0, 156, 0, 0, 230, 170, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 57, 0, 0, 91, 92, 93,
+	0, 0, 0, 0, 0, 0, 0, 0, 114, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 134, 0, 0, 0,
+	290, 0, 0, 0, 0, 201, 0, 234, 138, 153,
+	110, 150, 95, 106, 0, 136, 179, 209, 213, 0,
+	0, 0, 119, 0, 211, 189, 251, 0, 191, 210,
+	157, 240, 202, 250, 260, 261, 237, 258, 267, 227,
+	98, 236, 248, 115, 222, 0, 0, 0, 100, 246,
+	233, 168, 147, 148, 99, 0, 207, 124, 132, 121,
+	181, 243, 244, 120, 270, 107, 257, 102, 108, 256,
+	175, 239, 247, 169, 162, 101, 245, 167, 161, 152,
+	128, 140, 199, 159, 200, 141, 172, 171, 173, 0,
+	0, 0, 231, 254, 271, 112, 0, 238, 264, 266,
+	0, 203, 113, 133, 127, 198, 131, 174, 109, 143,
+	228, 151, 158, 206, 269, 188, 212, 116, 253, 229,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 94,
+	103, 155, 268, 204, 130, 255, 0, 0, 123, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 132, 0, 0,
-	0, 285, 0, 0, 0, 0, 199, 0, 231, 136,
-	151, 109, 148, 95, 105, 0, 134, 177, 207, 211,
-	0, 0, 0, 117, 0, 209, 187, 248, 0, 189,
-	208, 155, 237, 200, 247, 257, 258, 234, 255, 263,
-	224, 98, 233, 245, 114, 219, 0, 0, 0, 100,
-	243, 230, 166, 145, 146, 99, 0, 205, 122, 130,
-	119, 179, 240, 241, 118, 266, 106, 254, 102, 107,
-	253, 173, 236, 244, 167, 160, 101, 242, 165, 159,
-	150, 126, 138, 197, 157, 198, 139, 170, 169, 171,
-	0, 0, 0, 228, 251, 267, 111, 0, 235, 261,
-	262, 0, 201, 112, 131, 125, 196, 129, 172, 108,
-	141, 225, 149, 156, 204, 265, 186, 210, 115, 250,
-	226, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 96, 97, 104, 111, 117, 122, 126, 129, 135,
+	139, 142, 144, 145, 146, 149, 160, 163, 164, 165,
+	166, 176, 177, 178, 180, 183, 184, 185, 186, 187,
+	190, 192, 193, 194, 195, 196, 197, 205, 208, 214,
+	215, 216, 217, 218, 220, 221, 223, 224, 225, 226,
+	232, 235, 241, 242, 252, 259, 262, 137, 249, 263,
+	0, 265, 105, 118, 219, 182, 0, 0, 0, 1024,
+	0, 0, 0, 0, 125, 0, 0, 0, 0, 0,
+	154, 0, 0, 0, 156, 0, 0, 230, 170, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	94, 103, 153, 264, 202, 128, 252, 0, 0, 121,
+	0, 0, 0, 0, 0, 0, 0, 91, 92, 93,
+	0, 1026, 0, 0, 0, 0, 0, 0, 114, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 96, 97, 104, 110, 116, 120, 124, 127,
-	133, 137, 140, 142, 143, 144, 147, 158, 161, 162,
-	163, 164, 174, 175, 176, 178, 181, 182, 183, 184,
-	185, 188, 190, 191, 192, 193, 194, 195, 203, 206,
-	212, 213, 214, 215, 216, 217, 218, 220, 221, 222,
-	223, 229, 232, 238, 239, 249, 256, 259, 135, 246,
-	260, 29, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 180, 0, 0, 0, 0, 0, 0,
-	0, 0, 123, 0, 0, 0, 0, 0, 152, 0,
-	0, 0, 154, 0, 0, 227, 168, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 57, 0, 0, 91, 92, 93, 0, 0,
-	0, 0, 0, 0, 0, 0, 113, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 134, 0, 0, 0,
+	290, 0, 0, 0, 0, 201, 0, 234, 138, 153,
+	110, 150, 95, 106, 0, 136, 179, 209, 213, 0,
+	0, 0, 119, 0, 211, 189, 251, 0, 1022, 210,
+	157, 240, 202, 250, 260, 261, 237, 258, 267, 227,
+	98, 236, 248, 115, 222, 0, 0, 0, 100, 246,
+	233, 168, 147, 148, 99, 0, 207, 124, 132, 121,
+	181, 243, 244, 120, 270, 107, 257, 102, 108, 256,
+	175, 239, 247, 169, 162, 101, 245, 167, 161, 152,
+	128, 140, 199, 159, 200, 141, 172, 171, 173, 0,
+	0, 0, 231, 254, 271, 112, 0, 238, 264, 266,
+	0, 203, 113, 133, 127, 198, 131, 174, 109, 143,
+	228, 151, 158, 206, 269, 188, 212, 116, 253, 229,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 94,
+	103, 155, 268, 204, 130, 255, 0, 0, 123, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 132, 0, 0, 0, 285, 0,
-	0, 0, 0, 199, 0, 231, 136, 151, 109, 148,
-	95, 105, 0, 134, 177, 207, 211, 0, 0, 0,
-	117, 0, 209, 187, 248, 0, 189, 208, 155, 237,
-	200, 247, 257, 258, 234, 255, 263, 224, 98, 233,
-	245, 114, 219, 0, 0, 0, 100, 243, 230, 166,
-	145, 146, 99, 0, 205, 122, 130, 119, 179, 240,
-	241, 118, 266, 106, 254, 102, 107, 253, 173, 236,
-	244, 167, 160, 101, 242, 165, 159, 150, 126, 138,
-	197, 157, 198, 139, 170, 169, 171, 0, 0, 0,
-	228, 251, 267, 111, 0, 235, 261, 262, 0, 201,
-	112, 131, 125, 196, 129, 172, 108, 141, 225, 149,
-	156, 204, 265, 186, 210, 115, 250, 226, 0, 0,
+	0, 96, 97, 104, 111, 117, 122, 126, 129, 135,
+	139, 142, 144, 145, 146, 149, 160, 163, 164, 165,
+	166, 176, 177, 178, 180, 183, 184, 185, 186, 187,
+	190, 192, 193, 194, 195, 196, 197, 205, 208, 214,
+	215, 216, 217, 218, 220, 221, 223, 224, 225, 226,
+	232, 235, 241, 242, 252, 259, 262, 137, 249, 263,
+	182, 265, 105, 118, 219, 0, 0, 0, 0, 125,
+	0, 0, 0, 0, 0, 154, 0, 0, 0, 156,
+	0, 0, 230, 170, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 94, 103, 153,
-	264, 202, 128, 252, 0, 0, 121, 0, 0, 0,
+	0, 0, 91, 92, 93, 0, 0, 990, 0, 0,
+	991, 0, 0, 114, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 96,
-	97, 104, 110, 116, 120, 124, 127, 133, 137, 140,
-	142, 143, 144, 147, 158, 161, 162, 163, 164, 174,
-	175, 176, 178, 181, 182, 183, 184, 185, 188, 190,
-	191, 192, 193, 194, 195, 203, 206, 212, 213, 214,
-	215, 216, 217, 218, 220, 221, 222, 223, 229, 232,
-	238, 239, 249, 256, 259, 135, 246, 260, 180, 0,
-	0, 0, 1014, 0, 0, 0, 0, 123, 0, 0,
-	0, 0, 0, 152, 0, 0, 0, 154, 0, 0,
-	227, 168, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	91, 92, 93, 0, 1016, 0, 0, 0, 0, 0,
-	0, 113, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 132,
-	0, 0, 0, 285, 0, 0, 0, 0, 199, 0,
-	231, 136, 151, 109, 148, 95, 105, 0, 134, 177,
-	207, 211, 0, 0, 0, 117, 0, 209, 187, 248,
-	0, 1012, 208, 155, 237, 200, 247, 257, 258, 234,
-	255, 263, 224, 98, 233, 245, 114, 219, 0, 0,
-	0, 100, 243, 230, 166, 145, 146, 99, 0, 205,
-	122, 130, 119, 179, 240, 241, 118, 266, 106, 254,
-	102, 107, 253, 173, 236, 244, 167, 160, 101, 242,
-	165, 159, 150, 126, 138, 197, 157, 198, 139, 170,
-	169, 171, 0, 0, 0, 228, 251, 267, 111, 0,
-	235, 261, 262, 0, 201, 112, 131, 125, 196, 129,
-	172, 108, 141, 225, 149, 156, 204, 265, 186, 210,
-	115, 250, 226, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 94, 103, 153, 264, 202, 128, 252, 0,
-	0, 121, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 96, 97, 104, 110, 116, 120,
-	124, 127, 133, 137, 140, 142, 143, 144, 147, 158,
-	161, 162, 163, 164, 174, 175, 176, 178, 181, 182,
-	183, 184, 185, 188, 190, 191, 192, 193, 194, 195,
-	203, 206, 212, 213, 214, 215, 216, 217, 218, 220,
-	221, 222, 223, 229, 232, 238, 239, 249, 256, 259,
-	135, 246, 260, 180, 0, 0, 0, 0, 0, 0,
-	0, 0, 123, 0, 0, 0, 0, 0, 152, 0,
-	0, 0, 154, 0, 0, 227, 168, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 91, 92, 93, 0, 0,
-	980, 0, 0, 981, 0, 0, 113, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 134, 0, 0, 0, 290, 0, 0, 0, 0,
+	201, 0, 234, 138, 153, 110, 150, 95, 106, 0,
+	136, 179, 209, 213, 0, 0, 0, 119, 0, 211,
+	189, 251, 0, 191, 210, 157, 240, 202, 250, 260,
+	261, 237, 258, 267, 227, 98, 236, 248, 115, 222,
+	0, 0, 0, 100, 246, 233, 168, 147, 148, 99,
+	0, 207, 124, 132, 121, 181, 243, 244, 120, 270,
+	107, 257, 102, 108, 256, 175, 239, 247, 169, 162,
+	101, 245, 167, 161, 152, 128, 140, 199, 159, 200,
+	141, 172, 171, 173, 0, 0, 0, 231, 254, 271,
+	112, 0, 238, 264, 266, 0, 203, 113, 133, 127,
+	198, 131, 174, 109, 143, 228, 151, 158, 206, 269,
+	188, 212, 116, 253, 229, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 94, 103, 155, 268, 204, 130,
+	255, 0, 0, 123, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 132, 0, 0, 0, 285, 0,
-	0, 0, 0, 199, 0, 231, 136, 151, 109, 148,
-	95, 105, 0, 134, 177, 207, 211, 0, 0, 0,
-	117, 0, 209, 187, 248, 0, 189, 208, 155, 237,
-	200, 247, 257, 258, 234, 255, 263, 224, 98, 233,
-	245, 114, 219, 0, 0, 0, 100, 243, 230, 166,
-	145, 146, 99, 0, 205, 122, 130, 119, 179, 240,
-	241, 118, 266, 106, 254, 102, 107, 253, 173, 236,
-	244, 167, 160, 101, 242, 165, 159, 150, 126, 138,
-	197, 157, 198, 139, 170, 169, 171, 0, 0, 0,
-	228, 251, 267, 111, 0, 235, 261, 262, 0, 201,
-	112, 131, 125, 196, 129, 172, 108, 141, 225, 149,
-	156, 204, 265, 186, 210, 115, 250, 226, 0, 0,
+	0, 0, 0, 0, 0, 0, 96, 97, 104, 111,
+	117, 122, 126, 129, 135, 139, 142, 144, 145, 146,
+	149, 160, 163, 164, 165, 166, 176, 177, 178, 180,
+	183, 184, 185, 186, 187, 190, 192, 193, 194, 195,
+	196, 197, 205, 208, 214, 215, 216, 217, 218, 220,
+	221, 223, 224, 225, 226, 232, 235, 241, 242, 252,
+	259, 262, 137, 249, 263, 182, 265, 105, 118, 219,
+	0, 0, 0, 0, 125, 0, 782, 0, 0, 0,
+	154, 0, 0, 0, 156, 0, 0, 230, 170, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 94, 103, 153,
-	264, 202, 128, 252, 0, 0, 121, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 91, 92, 93,
+	0, 781, 0, 0, 0, 0, 0, 0, 114, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 96,
-	97, 104, 110, 116, 120, 124, 127, 133, 137, 140,
-	142, 143, 144, 147, 158, 161, 162, 163, 164, 174,
-	175, 176, 178, 181, 182, 183, 184, 185, 188, 190,
-	191, 192, 193, 194, 195, 203, 206, 212, 213, 214,
-	215, 216, 217, 218, 220, 221, 222, 223, 229, 232,
-	238, 239, 249, 256, 259, 135, 246, 260, 180, 0,
-	0, 0, 0, 0, 0, 0, 0, 123, 0, 776,
-	0, 0, 0, 152, 0, 0, 0, 154, 0, 0,
-	227, 168, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	91, 92, 93, 0, 775, 0, 0, 0, 0, 0,
-	0, 113, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 132,
-	0, 0, 0, 285, 0, 0, 0, 0, 199, 0,
-	231, 136, 151, 109, 148, 95, 105, 0, 134, 177,
-	207, 211, 0, 0, 0, 117, 0, 209, 187, 248,
-	0, 189, 208, 155, 237, 200, 247, 257, 258, 234,
-	255, 263, 224, 98, 233, 245, 114, 219, 0, 0,
-	0, 100, 243, 230, 166, 145, 146, 99, 0, 205,
-	122, 130, 119, 179, 240, 241, 118, 266, 106, 254,
-	102, 107, 253, 173, 236, 244, 167, 160, 101, 242,
-	165, 159, 150, 126, 138, 197, 157, 198, 139, 170,
-	169, 171, 0, 0, 0, 228, 251, 267, 111, 0,
-	235, 261, 262, 0, 201, 112, 131, 125, 196, 129,
-	172, 108, 141, 225, 149, 156, 204, 265, 186, 210,
-	115, 250, 226, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 94, 103, 153, 264, 202, 128, 252, 0,
-	0, 121, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 96, 97, 104, 110, 116, 120,
-	124, 127, 133, 137, 140, 142, 143, 144, 147, 158,
-	161, 162, 163, 164, 174, 175, 176, 178, 181, 182,
-	183, 184, 185, 188, 190, 191, 192, 193, 194, 195,
-	203, 206, 212, 213, 214, 215, 216, 217, 218, 220,
-	221, 222, 223, 229, 232, 238, 239, 249, 256, 259,
-	135, 246, 260, 180, 0, 0, 0, 0, 0, 0,
-	0, 0, 123, 0, 0, 0, 0, 0, 152, 0,
-	0, 0, 154, 0, 0, 227, 168, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 400, 91, 92, 93, 0, 0,
-	0, 0, 0, 0, 0, 0, 113, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 132, 0, 0, 0, 285, 0,
-	0, 0, 0, 199, 0, 231, 136, 151, 109, 148,
-	95, 105, 0, 134, 177, 207, 211, 0, 0, 0,
-	117, 0, 209, 187, 248, 0, 189, 208, 155, 237,
-	200, 247, 257, 258, 234, 255, 263, 224, 98, 233,
-	245, 114, 219, 0, 0, 0, 100, 243, 230, 166,
-	145, 146, 99, 0, 205, 122, 130, 119, 179, 240,
-	241, 118, 266, 106, 254, 102, 107, 253, 173, 236,
-	244, 167, 160, 101, 242, 165, 159, 150, 126, 138,
-	197, 157, 198, 139, 170, 169, 171, 0, 0, 0,
-	228, 251, 267, 111, 0, 235, 261, 262, 0, 201,
-	112, 131, 125, 196, 129, 172, 108, 141, 225, 149,
-	156, 204, 265, 186, 210, 115, 250, 226, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 94, 103, 153,
-	264, 202, 128, 252, 0, 0, 121, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 96,
-	97, 104, 110, 116, 120, 124, 127, 133, 137, 140,
-	142, 143, 144, 147, 158, 161, 162, 163, 164, 174,
-	175, 176, 178, 181, 182, 183, 184, 185, 188, 190,
-	191, 192, 193, 194, 195, 203, 206, 212, 213, 214,
-	215, 216, 217, 218, 220, 221, 222, 223, 229, 232,
-	238, 239, 249, 256, 259, 135, 246, 260, 180, 0,
-	0, 0, 0, 0, 0, 0, 0, 123, 0, 0,
-	0, 0, 0, 152, 0, 0, 0, 154, 0, 0,
-	227, 168, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 57, 0, 0,
-	91, 92, 93, 0, 0, 0, 0, 0, 0, 0,
-	0, 113, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 134, 0, 0, 0,
+	290, 0, 0, 0, 0, 201, 0, 234, 138, 153,
+	110, 150, 95, 106, 0, 136, 179, 209, 213, 0,
+	0, 0, 119, 0, 211, 189, 251, 0, 191, 210,
+	157, 240, 202, 250, 260, 261, 237, 258, 267, 227,
+	98, 236, 248, 115, 222, 0, 0, 0, 100, 246,
+	233, 168, 147, 148, 99, 0, 207, 124, 132, 121,
+	181, 243, 244, 120, 270, 107, 257, 102, 108, 256,
+	175, 239, 247, 169, 162, 101, 245, 167, 161, 152,
+	128, 140, 199, 159, 200, 141, 172, 171, 173, 0,
+	0, 0, 231, 254, 271, 112, 0, 238, 264, 266,
+	0, 203, 113, 133, 127, 198, 131, 174, 109, 143,
+	228, 151, 158, 206, 269, 188, 212, 116, 253, 229,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 132,
-	0, 0, 0, 285, 0, 0, 0, 0, 199, 0,
-	231, 136, 151, 109, 148, 95, 105, 0, 134, 177,
-	207, 211, 0, 0, 0, 117, 0, 209, 187, 248,
-	0, 189, 208, 155, 237, 200, 247, 257, 258, 234,
-	255, 263, 224, 98, 233, 245, 114, 219, 0, 0,
-	0, 100, 243, 230, 166, 145, 146, 99, 0, 205,
-	122, 130, 119, 179, 240, 241, 118, 266, 106, 254,
-	102, 107, 253, 173, 236, 244, 167, 160, 101, 242,
-	165, 159, 150, 126, 138, 197, 157, 198, 139, 170,
-	169, 171, 0, 0, 0, 228, 251, 267, 111, 0,
-	235, 261, 262, 0, 201, 112, 131, 125, 196, 129,
-	172, 108, 141, 225, 149, 156, 204, 265, 186, 210,
-	115, 250, 226, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 94,
+	103, 155, 268, 204, 130, 255, 0, 0, 123, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 94, 103, 153, 264, 202, 128, 252, 0,
-	0, 121, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 96, 97, 104, 110, 116, 120,
-	124, 127, 133, 137, 140, 142, 143, 144, 147, 158,
-	161, 162, 163, 164, 174, 175, 176, 178, 181, 182,
-	183, 184, 185, 188, 190, 191, 192, 193, 194, 195,
-	203, 206, 212, 213, 214, 215, 216, 217, 218, 220,
-	221, 222, 223, 229, 232, 238, 239, 249, 256, 259,
-	135, 246, 260, 180, 0, 0, 0, 0, 0, 0,
-	0, 0, 123, 0, 0, 0, 0, 0, 152, 0,
-	0, 0, 154, 0, 0, 227, 168, 0, 0, 0,
+	0, 96, 97, 104, 111, 117, 122, 126, 129, 135,
+	139, 142, 144, 145, 146, 149, 160, 163, 164, 165,
+	166, 176, 177, 178, 180, 183, 184, 185, 186, 187,
+	190, 192, 193, 194, 195, 196, 197, 205, 208, 214,
+	215, 216, 217, 218, 220, 221, 223, 224, 225, 226,
+	232, 235, 241, 242, 252, 259, 262, 137, 249, 263,
+	182, 265, 105, 118, 219, 0, 0, 0, 0, 125,
+	0, 0, 0, 0, 0, 154, 0, 0, 0, 156,
+	0, 0, 230, 170, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 91, 92, 93, 0, 1016,
-	0, 0, 0, 0, 0, 0, 113, 0, 0, 0,
+	0, 405, 91, 92, 93, 0, 0, 0, 0, 0,
+	0, 0, 0, 114, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 132, 0, 0, 0, 285, 0,
-	0, 0, 0, 199, 0, 231, 136, 151, 109, 148,
-	95, 105, 0, 134, 177, 207, 211, 0, 0, 0,
-	117, 0, 209, 187, 248, 0, 189, 208, 155, 237,
-	200, 247, 257, 258, 234, 255, 263, 224, 98, 233,
-	245, 114, 219, 0, 0, 0, 100, 243, 230, 166,
-	145, 146, 99, 0, 205, 122, 130, 119, 179, 240,
-	241, 118, 266, 106, 254, 102, 107, 253, 173, 236,
-	244, 167, 160, 101, 242, 165, 159, 150, 126, 138,
-	197, 157, 198, 139, 170, 169, 171, 0, 0, 0,
-	228, 251, 267, 111, 0, 235, 261, 262, 0, 201,
-	112, 131, 125, 196, 129, 172, 108, 141, 225, 149,
-	156, 204, 265, 186, 210, 115, 250, 226, 0, 0,
+	0, 134, 0, 0, 0, 290, 0, 0, 0, 0,
+	201, 0, 234, 138, 153, 110, 150, 95, 106, 0,
+	136, 179, 209, 213, 0, 0, 0, 119, 0, 211,
+	189, 251, 0, 191, 210, 157, 240, 202, 250, 260,
+	261, 237, 258, 267, 227, 98, 236, 248, 115, 222,
+	0, 0, 0, 100, 246, 233, 168, 147, 148, 99,
+	0, 207, 124, 132, 121, 181, 243, 244, 120, 270,
+	107, 257, 102, 108, 256, 175, 239, 247, 169, 162,
+	101, 245, 167, 161, 152, 128, 140, 199, 159, 200,
+	141, 172, 171, 173, 0, 0, 0, 231, 254, 271,
+	112, 0, 238, 264, 266, 0, 203, 113, 133, 127,
+	198, 131, 174, 109, 143, 228, 151, 158, 206, 269,
+	188, 212, 116, 253, 229, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 94, 103, 153,
-	264, 202, 128, 252, 0, 0, 121, 0, 0, 0,
+	0, 0, 0, 0, 94, 103, 155, 268, 204, 130,
+	255, 0, 0, 123, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 96,
-	97, 104, 110, 116, 120, 124, 127, 133, 137, 140,
-	142, 143, 144, 147, 158, 161, 162, 163, 164, 174,
-	175, 176, 178, 181, 182, 183, 184, 185, 188, 190,
-	191, 192, 193, 194, 195, 203, 206, 212, 213, 214,
-	215, 216, 217, 218, 220, 221, 222, 223, 229, 232,
-	238, 239, 249, 256, 259, 135, 246, 260, 180, 0,
-	0, 0, 0, 0, 0, 0, 0, 123, 0, 0,
-	0, 0, 0, 152, 0, 0, 0, 154, 0, 0,
-	227, 168, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	91, 92, 93, 0, 742, 0, 0, 0, 0, 0,
-	0, 113, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 132,
-	0, 0, 0, 285, 0, 0, 0, 0, 199, 0,
-	231, 136, 151, 109, 148, 95, 105, 0, 134, 177,
-	207, 211, 0, 0, 0, 117, 0, 209, 187, 248,
-	0, 189, 208, 155, 237, 200, 247, 257, 258, 234,
-	255, 263, 224, 98, 233, 245, 114, 219, 0, 0,
-	0, 100, 243, 230, 166, 145, 146, 99, 0, 205,
-	122, 130, 119, 179, 240, 241, 118, 266, 106, 254,
-	102, 107, 253, 173, 236, 244, 167, 160, 101, 242,
-	165, 159, 150, 126, 138, 197, 157, 198, 139, 170,
-	169, 171, 0, 0, 0, 228, 251, 267, 111, 0,
-	235, 261, 262, 0, 201, 112, 131, 125, 196, 129,
-	172, 108, 141, 225, 149, 156, 204, 265, 186, 210,
-	115, 250, 226, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 94, 103, 153, 264, 202, 128, 252, 0,
-	0, 121, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 96, 97, 104, 110, 116, 120,
-	124, 127, 133, 137, 140, 142, 143, 144, 147, 158,
-	161, 162, 163, 164, 174, 175, 176, 178, 181, 182,
-	183, 184, 185, 188, 190, 191, 192, 193, 194, 195,
-	203, 206, 212, 213, 214, 215, 216, 217, 218, 220,
-	221, 222, 223, 229, 232, 238, 239, 249, 256, 259,
-	135, 246, 260, 180, 0, 0, 0, 0, 0, 0,
-	0, 745, 123, 0, 0, 0, 0, 0, 152, 0,
-	0, 0, 154, 0, 0, 227, 168, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 96, 97, 104, 111,
+	117, 122, 126, 129, 135, 139, 142, 144, 145, 146,
+	149, 160, 163, 164, 165, 166, 176, 177, 178, 180,
+	183, 184, 185, 186, 187, 190, 192, 193, 194, 195,
+	196, 197, 205, 208, 214, 215, 216, 217, 218, 220,
+	221, 223, 224, 225, 226, 232, 235, 241, 242, 252,
+	259, 262, 137, 249, 263, 182, 265, 105, 118, 219,
+	0, 0, 0, 0, 125, 0, 0, 0, 0, 0,
+	154, 0, 0, 0, 156, 0, 0, 230, 170, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 91, 92, 93, 0, 0,
-	0, 0, 0, 0, 0, 0, 113, 0, 0, 0,
+	0, 0, 0, 0, 57, 0, 0, 91, 92, 93,
+	0, 0, 0, 0, 0, 0, 0, 0, 114, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 132, 0, 0, 0, 285, 0,
-	0, 0, 0, 199, 0, 231, 136, 151, 109, 148,
-	95, 105, 0, 134, 177, 207, 211, 0, 0, 0,
-	117, 0, 209, 187, 248, 0, 189, 208, 155, 237,
-	200, 247, 257, 258, 234, 255, 263, 224, 98, 233,
-	245, 114, 219, 0, 0, 0, 100, 243, 230, 166,
-	145, 146, 99, 0, 205, 122, 130, 119, 179, 240,
-	241, 118, 266, 106, 254, 102, 107, 253, 173, 236,
-	244, 167, 160, 101, 242, 165, 159, 150, 126, 138,
-	197, 157, 198, 139, 170, 169, 171, 0, 0, 0,
-	228, 251, 267, 111, 0, 235, 261, 262, 0, 201,
-	112, 131, 125, 196, 129, 172, 108, 141, 225, 149,
-	156, 204, 265, 186, 210, 115, 250, 226, 0, 0,
+	0, 0, 0, 0, 0, 0, 134, 0, 0, 0,
+	290, 0, 0, 0, 0, 201, 0, 234, 138, 153,
+	110, 150, 95, 106, 0, 136, 179, 209, 213, 0,
+	0, 0, 119, 0, 211, 189, 251, 0, 191, 210,
+	157, 240, 202, 250, 260, 261, 237, 258, 267, 227,
+	98, 236, 248, 115, 222, 0, 0, 0, 100, 246,
+	233, 168, 147, 148, 99, 0, 207, 124, 132, 121,
+	181, 243, 244, 120, 270, 107, 257, 102, 108, 256,
+	175, 239, 247, 169, 162, 101, 245, 167, 161, 152,
+	128, 140, 199, 159, 200, 141, 172, 171, 173, 0,
+	0, 0, 231, 254, 271, 112, 0, 238, 264, 266,
+	0, 203, 113, 133, 127, 198, 131, 174, 109, 143,
+	228, 151, 158, 206, 269, 188, 212, 116, 253, 229,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 94, 103, 153,
-	264, 202, 128, 252, 0, 0, 121, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 94,
+	103, 155, 268, 204, 130, 255, 0, 0, 123, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 96,
-	97, 104, 110, 116, 120, 124, 127, 133, 137, 140,
-	142, 143, 144, 147, 158, 161, 162, 163, 164, 174,
-	175, 176, 178, 181, 182, 183, 184, 185, 188, 190,
-	191, 192, 193, 194, 195, 203, 206, 212, 213, 214,
-	215, 216, 217, 218, 220, 221, 222, 223, 229, 232,
-	238, 239, 249, 256, 259, 135, 246, 260, 180, 0,
-	0, 0, 0, 0, 0, 0, 0, 123, 0, 0,
-	0, 0, 0, 152, 0, 0, 0, 154, 0, 0,
-	227, 168, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	91, 92, 93, 0, 623, 0, 0, 0, 0, 0,
-	0, 113, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 132,
-	0, 0, 0, 285, 0, 0, 0, 0, 199, 0,
-	231, 136, 151, 109, 148, 95, 105, 0, 134, 177,
-	207, 211, 0, 0, 0, 117, 0, 209, 187, 248,
-	0, 189, 208, 155, 237, 200, 247, 257, 258, 234,
-	255, 263, 224, 98, 233, 245, 114, 219, 0, 0,
-	0, 100, 243, 230, 166, 145, 146, 99, 0, 205,
-	122, 130, 119, 179, 240, 241, 118, 266, 106, 254,
-	102, 107, 253, 173, 236, 244, 167, 160, 101, 242,
-	165, 159, 150, 126, 138, 197, 157, 198, 139, 170,
-	169, 171, 0, 0, 0, 228, 251, 267, 111, 0,
-	235, 261, 262, 0, 201, 112, 131, 125, 196, 129,
-	172, 108, 141, 225, 149, 156, 204, 265, 186, 210,
-	115, 250, 226, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 94, 103, 153, 264, 202, 128, 252, 0,
-	0, 121, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 96, 97, 104, 110, 116, 120,
-	124, 127, 133, 137, 140, 142, 143, 144, 147, 158,
-	161, 162, 163, 164, 174, 175, 176, 178, 181, 182,
-	183, 184, 185, 188, 190, 191, 192, 193, 194, 195,
-	203, 206, 212, 213, 214, 215, 216, 217, 218, 220,
-	221, 222, 223, 229, 232, 238, 239, 249, 256, 259,
-	135, 246, 260, 417, 0, 0, 0, 0, 0, 0,
-	180, 0, 0, 0, 0, 0, 0, 0, 0, 123,
-	0, 0, 0, 0, 0, 152, 0, 0, 0, 154,
-	0, 0, 227, 168, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 91, 92, 93, 0, 0, 0, 0, 0,
-	0, 0, 0, 113, 0, 0, 0, 0, 0, 0,
+	0, 96, 97, 104, 111, 117, 122, 126, 129, 135,
+	139, 142, 144, 145, 146, 149, 160, 163, 164, 165,
+	166, 176, 177, 178, 180, 183, 184, 185, 186, 187,
+	190, 192, 193, 194, 195, 196, 197, 205, 208, 214,
+	215, 216, 217, 218, 220, 221, 223, 224, 225, 226,
+	232, 235, 241, 242, 252, 259, 262, 137, 249, 263,
+	182, 265, 105, 118, 219, 0, 0, 0, 0, 125,
+	0, 0, 0, 0, 0, 154, 0, 0, 0, 156,
+	0, 0, 230, 170, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 91, 92, 93, 0, 1026, 0, 0, 0,
+	0, 0, 0, 114, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 132, 0, 0, 0, 285, 0, 0, 0, 0,
-	199, 0, 231, 136, 151, 109, 148, 95, 105, 0,
-	134, 177, 207, 211, 0, 0, 0, 117, 0, 209,
-	187, 248, 0, 189, 208, 155, 237, 200, 247, 257,
-	258, 234, 255, 263, 224, 98, 233, 245, 114, 219,
-	0, 0, 0, 100, 243, 230, 166, 145, 146, 99,
-	0, 205, 122, 130, 119, 179, 240, 241, 118, 266,
-	106, 254, 102, 107, 253, 173, 236, 244, 167, 160,
-	101, 242, 165, 159, 150, 126, 138, 197, 157, 198,
-	139, 170, 169, 171, 0, 0, 0, 228, 251, 267,
-	111, 0, 235, 261, 262, 0, 201, 112, 131, 125,
-	196, 129, 172, 108, 141, 225, 149, 156, 204, 265,
-	186, 210, 115, 250, 226, 0, 0, 0, 0, 0,
+	0, 134, 0, 0, 0, 290, 0, 0, 0, 0,
+	201, 0, 234, 138, 153, 110, 150, 95, 106, 0,
+	136, 179, 209, 213, 0, 0, 0, 119, 0, 211,
+	189, 251, 0, 191, 210, 157, 240, 202, 250, 260,
+	261, 237, 258, 267, 227, 98, 236, 248, 115, 222,
+	0, 0, 0, 100, 246, 233, 168, 147, 148, 99,
+	0, 207, 124, 132, 121, 181, 243, 244, 120, 270,
+	107, 257, 102, 108, 256, 175, 239, 247, 169, 162,
+	101, 245, 167, 161, 152, 128, 140, 199, 159, 200,
+	141, 172, 171, 173, 0, 0, 0, 231, 254, 271,
+	112, 0, 238, 264, 266, 0, 203, 113, 133, 127,
+	198, 131, 174, 109, 143, 228, 151, 158, 206, 269,
+	188, 212, 116, 253, 229, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 94, 103, 153, 264, 202, 128,
-	252, 0, 0, 121, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 94, 103, 155, 268, 204, 130,
+	255, 0, 0, 123, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 96, 97, 104, 110,
-	116, 120, 124, 127, 133, 137, 140, 142, 143, 144,
-	147, 158, 161, 162, 163, 164, 174, 175, 176, 178,
-	181, 182, 183, 184, 185, 188, 190, 191, 192, 193,
-	194, 195, 203, 206, 212, 213, 214, 215, 216, 217,
-	218, 220, 221, 222, 223, 229, 232, 238, 239, 249,
-	256, 259, 135, 246, 260, 180, 0, 0, 0, 0,
-	0, 0, 0, 0, 123, 0, 0, 0, 0, 0,
-	152, 0, 0, 0, 154, 0, 0, 227, 168, 0,
+	0, 0, 0, 0, 0, 0, 96, 97, 104, 111,
+	117, 122, 126, 129, 135, 139, 142, 144, 145, 146,
+	149, 160, 163, 164, 165, 166, 176, 177, 178, 180,
+	183, 184, 185, 186, 187, 190, 192, 193, 194, 195,
+	196, 197, 205, 208, 214, 215, 216, 217, 218, 220,
+	221, 223, 224, 225, 226, 232, 235, 241, 242, 252,
+	259, 262, 137, 249, 263, 182, 265, 105, 118, 219,
+	0, 0, 0, 0, 125, 0, 0, 0, 0, 0,
+	154, 0, 0, 0, 156, 0, 0, 230, 170, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 91, 92, 93,
-	0, 0, 0, 0, 0, 0, 0, 0, 113, 0,
+	0, 748, 0, 0, 0, 0, 0, 0, 114, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 317, 0, 132, 0, 0, 0,
-	285, 0, 0, 0, 0, 199, 0, 231, 136, 151,
-	109, 148, 95, 105, 0, 134, 177, 207, 211, 0,
-	0, 0, 117, 0, 209, 187, 248, 0, 189, 208,
-	155, 237, 200, 247, 257, 258, 234, 255, 263, 224,
-	98, 233, 245, 114, 219, 0, 0, 0, 100, 243,
-	230, 166, 145, 146, 99, 0, 205, 122, 130, 119,
-	179, 240, 241, 118, 266, 106, 254, 102, 107, 253,
-	173, 236, 244, 167, 160, 101, 242, 165, 159, 150,
-	126, 138, 197, 157, 198, 139, 170, 169, 171, 0,
-	0, 0, 228, 251, 267, 111, 0, 235, 261, 262,
-	0, 201, 112, 131, 125, 196, 129, 172, 108, 141,
-	225, 149, 156, 204, 265, 186, 210, 115, 250, 226,
+	0, 0, 0, 0, 0, 0, 134, 0, 0, 0,
+	290, 0, 0, 0, 0, 201, 0, 234, 138, 153,
+	110, 150, 95, 106, 0, 136, 179, 209, 213, 0,
+	0, 0, 119, 0, 211, 189, 251, 0, 191, 210,
+	157, 240, 202, 250, 260, 261, 237, 258, 267, 227,
+	98, 236, 248, 115, 222, 0, 0, 0, 100, 246,
+	233, 168, 147, 148, 99, 0, 207, 124, 132, 121,
+	181, 243, 244, 120, 270, 107, 257, 102, 108, 256,
+	175, 239, 247, 169, 162, 101, 245, 167, 161, 152,
+	128, 140, 199, 159, 200, 141, 172, 171, 173, 0,
+	0, 0, 231, 254, 271, 112, 0, 238, 264, 266,
+	0, 203, 113, 133, 127, 198, 131, 174, 109, 143,
+	228, 151, 158, 206, 269, 188, 212, 116, 253, 229,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 94,
-	103, 153, 264, 202, 128, 252, 0, 0, 121, 0,
+	103, 155, 268, 204, 130, 255, 0, 0, 123, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 96, 97, 104, 110, 116, 120, 124, 127, 133,
-	137, 140, 142, 143, 144, 147, 158, 161, 162, 163,
-	164, 174, 175, 176, 178, 181, 182, 183, 184, 185,
-	188, 190, 191, 192, 193, 194, 195, 203, 206, 212,
-	213, 214, 215, 216, 217, 218, 220, 221, 222, 223,
-	229, 232, 238, 239, 249, 256, 259, 316, 246, 260,
-	180, 0, 0, 0, 0, 0, 0, 0, 0, 123,
-	0, 0, 0, 0, 0, 152, 0, 0, 0, 154,
-	0, 0, 227, 168, 0, 0, 0, 0, 0, 0,
+	0, 96, 97, 104, 111, 117, 122, 126, 129, 135,
+	139, 142, 144, 145, 146, 149, 160, 163, 164, 165,
+	166, 176, 177, 178, 180, 183, 184, 185, 186, 187,
+	190, 192, 193, 194, 195, 196, 197, 205, 208, 214,
+	215, 216, 217, 218, 220, 221, 223, 224, 225, 226,
+	232, 235, 241, 242, 252, 259, 262, 137, 249, 263,
+	182, 265, 105, 118, 219, 0, 0, 0, 751, 125,
+	0, 0, 0, 0, 0, 154, 0, 0, 0, 156,
+	0, 0, 230, 170, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 91, 92, 93, 0, 0, 0, 0, 0,
-	0, 0, 0, 113, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 114, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 132, 0, 280, 0, 285, 0, 0, 0, 0,
-	199, 0, 231, 136, 151, 109, 148, 95, 105, 0,
-	134, 177, 207, 211, 0, 0, 0, 117, 0, 209,
-	187, 248, 0, 189, 208, 155, 237, 200, 247, 257,
-	258, 234, 255, 263, 224, 98, 233, 245, 114, 219,
-	0, 0, 0, 100, 243, 230, 166, 145, 146, 99,
-	0, 205, 122, 130, 119, 179, 240, 241, 118, 266,
-	106, 254, 102, 107, 253, 173, 236, 244, 167, 160,
-	101, 242, 165, 159, 150, 126, 138, 197, 157, 198,
-	139, 170, 169, 171, 0, 0, 0, 228, 251, 267,
-	111, 0, 235, 261, 262, 0, 201, 112, 131, 125,
-	196, 129, 172, 108, 141, 225, 149, 156, 204, 265,
-	186, 210, 115, 250, 226, 0, 0, 0, 0, 0,
+	0, 134, 0, 0, 0, 290, 0, 0, 0, 0,
+	201, 0, 234, 138, 153, 110, 150, 95, 106, 0,
+	136, 179, 209, 213, 0, 0, 0, 119, 0, 211,
+	189, 251, 0, 191, 210, 157, 240, 202, 250, 260,
+	261, 237, 258, 267, 227, 98, 236, 248, 115, 222,
+	0, 0, 0, 100, 246, 233, 168, 147, 148, 99,
+	0, 207, 124, 132, 121, 181, 243, 244, 120, 270,
+	107, 257, 102, 108, 256, 175, 239, 247, 169, 162,
+	101, 245, 167, 161, 152, 128, 140, 199, 159, 200,
+	141, 172, 171, 173, 0, 0, 0, 231, 254, 271,
+	112, 0, 238, 264, 266, 0, 203, 113, 133, 127,
+	198, 131, 174, 109, 143, 228, 151, 158, 206, 269,
+	188, 212, 116, 253, 229, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 94, 103, 153, 264, 202, 128,
-	252, 0, 0, 121, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 94, 103, 155, 268, 204, 130,
+	255, 0, 0, 123, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 96, 97, 104, 110,
-	116, 120, 124, 127, 133, 137, 140, 142, 143, 144,
-	147, 158, 161, 162, 163, 164, 174, 175, 176, 178,
-	181, 182, 183, 184, 185, 188, 190, 191, 192, 193,
-	194, 195, 203, 206, 212, 213, 214, 215, 216, 217,
-	218, 220, 221, 222, 223, 229, 232, 238, 239, 249,
-	256, 259, 135, 246, 260, 180, 0, 0, 0, 0,
-	0, 0, 0, 0, 123, 0, 0, 0, 0, 0,
-	152, 0, 0, 0, 154, 0, 0, 227, 168, 0,
+	0, 0, 0, 0, 0, 0, 96, 97, 104, 111,
+	117, 122, 126, 129, 135, 139, 142, 144, 145, 146,
+	149, 160, 163, 164, 165, 166, 176, 177, 178, 180,
+	183, 184, 185, 186, 187, 190, 192, 193, 194, 195,
+	196, 197, 205, 208, 214, 215, 216, 217, 218, 220,
+	221, 223, 224, 225, 226, 232, 235, 241, 242, 252,
+	259, 262, 137, 249, 263, 182, 265, 105, 118, 219,
+	0, 0, 0, 0, 125, 0, 0, 0, 0, 0,
+	154, 0, 0, 0, 156, 0, 0, 230, 170, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 91, 92, 93,
-	0, 0, 0, 0, 0, 0, 0, 0, 113, 0,
+	0, 629, 0, 0, 0, 0, 0, 0, 114, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 132, 0, 0, 0,
-	285, 0, 0, 0, 0, 199, 0, 231, 136, 151,
-	109, 148, 95, 105, 0, 134, 177, 207, 211, 0,
-	0, 0, 117, 0, 209, 187, 248, 0, 189, 208,
-	155, 237, 200, 247, 257, 258, 234, 255, 263, 224,
-	98, 233, 245, 114, 219, 0, 0, 0, 100, 243,
-	230, 166, 145, 146, 99, 0, 205, 122, 130, 119,
-	179, 240, 241, 118, 266, 106, 254, 102, 107, 253,
-	173, 236, 244, 167, 160, 101, 242, 165, 159, 150,
-	126, 138, 197, 157, 198, 139, 170, 169, 171, 0,
-	0, 0, 228, 251, 267, 111, 0, 235, 261, 262,
-	0, 201, 112, 131, 125, 196, 129, 172, 108, 141,
-	225, 149, 156, 204, 265, 186, 210, 115, 250, 226,
+	0, 0, 0, 0, 0, 0, 134, 0, 0, 0,
+	290, 0, 0, 0, 0, 201, 0, 234, 138, 153,
+	110, 150, 95, 106, 0, 136, 179, 209, 213, 0,
+	0, 0, 119, 0, 211, 189, 251, 0, 191, 210,
+	157, 240, 202, 250, 260, 261, 237, 258, 267, 227,
+	98, 236, 248, 115, 222, 0, 0, 0, 100, 246,
+	233, 168, 147, 148, 99, 0, 207, 124, 132, 121,
+	181, 243, 244, 120, 270, 107, 257, 102, 108, 256,
+	175, 239, 247, 169, 162, 101, 245, 167, 161, 152,
+	128, 140, 199, 159, 200, 141, 172, 171, 173, 0,
+	0, 0, 231, 254, 271, 112, 0, 238, 264, 266,
+	0, 203, 113, 133, 127, 198, 131, 174, 109, 143,
+	228, 151, 158, 206, 269, 188, 212, 116, 253, 229,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 94,
-	103, 153, 264, 202, 128, 252, 0, 0, 121, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 96, 97, 104, 110, 116, 120, 124, 127, 133,
-	137, 140, 142, 143, 144, 147, 158, 161, 162, 163,
-	164, 174, 175, 176, 178, 181, 182, 183, 184, 185,
-	188, 190, 191, 192, 193, 194, 195, 203, 206, 212,
-	213, 214, 215, 216, 217, 218, 220, 221, 222, 223,
-	229, 232, 238, 239, 249, 256, 259, 135, 246, 260,
+	103, 155, 268, 204, 130, 255, 0, 0, 123, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 96, 97, 104, 111, 117, 122, 126, 129, 135,
+	139, 142, 144, 145, 146, 149, 160, 163, 164, 165,
+	166, 176, 177, 178, 180, 183, 184, 185, 186, 187,
+	190, 192, 193, 194, 195, 196, 197, 205, 208, 214,
+	215, 216, 217, 218, 220, 221, 223, 224, 225, 226,
+	232, 235, 241, 242, 252, 259, 262, 137, 249, 263,
+	422, 265, 105, 118, 219, 0, 0, 182, 0, 0,
+	0, 0, 0, 0, 0, 0, 125, 0, 0, 0,
+	0, 0, 154, 0, 0, 0, 156, 0, 0, 230,
+	170, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 91,
+	92, 93, 0, 0, 0, 0, 0, 0, 0, 0,
+	114, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 134, 0,
+	0, 0, 290, 0, 0, 0, 0, 201, 0, 234,
+	138, 153, 110, 150, 95, 106, 0, 136, 179, 209,
+	213, 0, 0, 0, 119, 0, 211, 189, 251, 0,
+	191, 210, 157, 240, 202, 250, 260, 261, 237, 258,
+	267, 227, 98, 236, 248, 115, 222, 0, 0, 0,
+	100, 246, 233, 168, 147, 148, 99, 0, 207, 124,
+	132, 121, 181, 243, 244, 120, 270, 107, 257, 102,
+	108, 256, 175, 239, 247, 169, 162, 101, 245, 167,
+	161, 152, 128, 140, 199, 159, 200, 141, 172, 171,
+	173, 0, 0, 0, 231, 254, 271, 112, 0, 238,
+	264, 266, 0, 203, 113, 133, 127, 198, 131, 174,
+	109, 143, 228, 151, 158, 206, 269, 188, 212, 116,
+	253, 229, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 94, 103, 155, 268, 204, 130, 255, 0, 0,
+	123, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 96, 97, 104, 111, 117, 122, 126,
+	129, 135, 139, 142, 144, 145, 146, 149, 160, 163,
+	164, 165, 166, 176, 177, 178, 180, 183, 184, 185,
+	186, 187, 190, 192, 193, 194, 195, 196, 197, 205,
+	208, 214, 215, 216, 217, 218, 220, 221, 223, 224,
+	225, 226, 232, 235, 241, 242, 252, 259, 262, 137,
+	249, 263, 182, 265, 105, 118, 219, 0, 0, 0,
+	0, 125, 0, 0, 0, 0, 0, 154, 0, 0,
+	0, 156, 0, 0, 230, 170, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 91, 92, 93, 0, 0, 0,
+	0, 0, 0, 0, 0, 114, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 322, 0, 134, 0, 0, 0, 290, 0, 0,
+	0, 0, 201, 0, 234, 138, 153, 110, 150, 95,
+	106, 0, 136, 179, 209, 213, 0, 0, 0, 119,
+	0, 211, 189, 251, 0, 191, 210, 157, 240, 202,
+	250, 260, 261, 237, 258, 267, 227, 98, 236, 248,
+	115, 222, 0, 0, 0, 100, 246, 233, 168, 147,
+	148, 99, 0, 207, 124, 132, 121, 181, 243, 244,
+	120, 270, 107, 257, 102, 108, 256, 175, 239, 247,
+	169, 162, 101, 245, 167, 161, 152, 128, 140, 199,
+	159, 200, 141, 172, 171, 173, 0, 0, 0, 231,
+	254, 271, 112, 0, 238, 264, 266, 0, 203, 113,
+	133, 127, 198, 131, 174, 109, 143, 228, 151, 158,
+	206, 269, 188, 212, 116, 253, 229, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 94, 103, 155, 268,
+	204, 130, 255, 0, 0, 123, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 96, 97,
+	104, 111, 117, 122, 126, 129, 135, 139, 142, 144,
+	145, 146, 149, 160, 163, 164, 165, 166, 176, 177,
+	178, 180, 183, 184, 185, 186, 187, 190, 192, 193,
+	194, 195, 196, 197, 205, 208, 214, 215, 216, 217,
+	218, 220, 221, 223, 224, 225, 226, 232, 235, 241,
+	242, 252, 259, 262, 321, 249, 263, 182, 265, 105,
+	118, 219, 0, 0, 0, 0, 125, 0, 0, 0,
+	0, 0, 154, 0, 0, 0, 156, 0, 0, 230,
+	170, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 91,
+	92, 93, 0, 0, 0, 0, 0, 0, 0, 0,
+	114, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 134, 0,
+	285, 0, 290, 0, 0, 0, 0, 201, 0, 234,
+	138, 153, 110, 150, 95, 106, 0, 136, 179, 209,
+	213, 0, 0, 0, 119, 0, 211, 189, 251, 0,
+	191, 210, 157, 240, 202, 250, 260, 261, 237, 258,
+	267, 227, 98, 236, 248, 115, 222, 0, 0, 0,
+	100, 246, 233, 168, 147, 148, 99, 0, 207, 124,
+	132, 121, 181, 243, 244, 120, 270, 107, 257, 102,
+	108, 256, 175, 239, 247, 169, 162, 101, 245, 167,
+	161, 152, 128, 140, 199, 159, 200, 141, 172, 171,
+	173, 0, 0, 0, 231, 254, 271, 112, 0, 238,
+	264, 266, 0, 203, 113, 133, 127, 198, 131, 174,
+	109, 143, 228, 151, 158, 206, 269, 188, 212, 116,
+	253, 229, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 94, 103, 155, 268, 204, 130, 255, 0, 0,
+	123, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 96, 97, 104, 111, 117, 122, 126,
+	129, 135, 139, 142, 144, 145, 146, 149, 160, 163,
+	164, 165, 166, 176, 177, 178, 180, 183, 184, 185,
+	186, 187, 190, 192, 193, 194, 195, 196, 197, 205,
+	208, 214, 215, 216, 217, 218, 220, 221, 223, 224,
+	225, 226, 232, 235, 241, 242, 252, 259, 262, 137,
+	249, 263, 182, 265, 105, 118, 219, 0, 0, 0,
+	0, 125, 0, 0, 0, 0, 0, 154, 0, 0,
+	0, 156, 0, 0, 230, 170, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 91, 92, 93, 0, 0, 0,
+	0, 0, 0, 0, 0, 114, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 134, 0, 0, 0, 290, 0, 0,
+	0, 0, 201, 0, 234, 138, 153, 110, 150, 95,
+	106, 0, 136, 179, 209, 213, 0, 0, 0, 119,
+	0, 211, 189, 251, 0, 191, 210, 157, 240, 202,
+	250, 260, 261, 237, 258, 267, 227, 98, 236, 248,
+	115, 222, 0, 0, 0, 100, 246, 233, 168, 147,
+	148, 99, 0, 207, 124, 132, 121, 181, 243, 244,
+	120, 270, 107, 257, 102, 108, 256, 175, 239, 247,
+	169, 162, 101, 245, 167, 161, 152, 128, 140, 199,
+	159, 200, 141, 172, 171, 173, 0, 0, 0, 231,
+	254, 271, 112, 0, 238, 264, 266, 0, 203, 113,
+	133, 127, 198, 131, 174, 109, 143, 228, 151, 158,
+	206, 269, 188, 212, 116, 253, 229, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 94, 103, 155, 268,
+	204, 130, 255, 0, 0, 123, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 96, 97,
+	104, 111, 117, 122, 126, 129, 135, 139, 142, 144,
+	145, 146, 149, 160, 163, 164, 165, 166, 176, 177,
+	178, 180, 183, 184, 185, 186, 187, 190, 192, 193,
+	194, 195, 196, 197, 205, 208, 214, 215, 216, 217,
+	218, 220, 221, 223, 224, 225, 226, 232, 235, 241,
+	242, 252, 259, 262, 137, 249, 263, 0, 265, 105,
+	118, 219,
 }
 var yyPact = [...]int{
 
-	195, -1000, -274, 1086, -1000, -1000, -1000, -1000, -1000, -1000,
+	2107, -1000, -284, 1008, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1028, 882, -1000,
-	-1000, -1000, -1000, -1000, -1000, 274, 12123, 2, 134, 43,
-	16972, 133, 1708, 17317, -1000, 7, -1000, 4, 17317, 14,
-	16627, -1000, -1000, -88, -94, -1000, 10053, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 833, 1013, 1022, 1026, 737,
-	1089, -1000, 8661, 105, 105, 16282, 7281, -1000, -1000, 483,
-	17317, 127, 17317, -149, 97, 97, 97, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 955, 774, -1000,
+	-1000, -1000, -1000, -1000, -1000, 256, 11720, -30, 137, 10,
+	16579, 135, 314, 16924, -1000, 26, -1000, 18, 16924, 22,
+	16234, -1000, -1000, -45, -52, -1000, 9645, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 751, 939, 935, 945, 509,
+	923, -1000, 8253, 99, 99, 15889, 6868, -1000, -1000, 298,
+	16924, 127, 16924, -126, 94, 94, 94, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2686,26 +2656,24 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 132, 17317,
-	600, 600, 261, -1000, 17317, 92, 600, 92, 92, 92,
-	17317, -1000, 179, -1000, -1000, -1000, 17317, 600, 954, 316,
-	129, 4775, -1000, 197, -1000, 4775, 44, 4775, -49, 1052,
-	28, -37, -1000, 4775, -1000, -1000, -1000, -1000, -1000, -1000,
-	115, -1000, -1000, 17317, 15930, 142, 272, -1000, -1000, -1000,
-	-1000, -1000, -1000, 544, 563, -1000, 10053, 585, 738, 738,
-	-1000, -1000, 146, -1000, -1000, 11088, 11088, 11088, 11088, 11088,
-	11088, 11088, 11088, 11088, 11088, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 738,
-	173, -1000, 9708, 738, 738, 738, 738, 738, 738, 738,
-	738, 10053, 738, 738, 738, 738, 738, 738, 738, 738,
-	738, 738, 738, 738, 738, 738, 738, 738, -1000, -1000,
-	1028, -1000, 882, -1000, -1000, -1000, 974, 10053, 10053, 1028,
-	-1000, 934, 8661, -1000, -1000, 946, -1000, -1000, -1000, -1000,
-	356, 1063, -1000, 11778, 170, 15585, 14550, 17317, 758, 742,
-	-1000, -1000, 169, 773, 6923, -75, -1000, -1000, -1000, 268,
-	13860, -1000, -1000, -1000, 953, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 132, 16924, 479, 479, 245, 435, -1000, 16924,
+	93, 479, 93, 93, 93, 16924, -1000, 171, -1000, -1000,
+	-1000, 16924, 479, 896, 309, 90, 4327, -1000, 198, -1000,
+	4327, 35, 4327, -53, 979, 36, 12, -1000, 4327, -1000,
+	-1000, -1000, -1000, -1000, -1000, 115, -1000, -1000, 16924, 15537,
+	129, 262, -1000, -1000, -1000, -1000, -1000, -1000, 573, 399,
+	-1000, 9645, 1898, 533, 533, -1000, -1000, 157, -1000, -1000,
+	10680, 10680, 10680, 10680, 10680, 10680, 10680, 10680, 10680, 10680,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 533, 170, -1000, 9300, 533, 533,
+	533, 533, 533, 533, 533, 533, 9645, 533, 533, 533,
+	533, 533, 533, 533, 533, 533, 533, 533, 533, 533,
+	533, 533, 533, -1000, -1000, 955, -1000, 774, -1000, -1000,
+	-1000, 892, 9645, 9645, 955, -1000, 852, 8253, -1000, -1000,
+	899, -1000, -1000, -1000, -1000, 319, 989, -1000, 11375, 168,
+	15192, 14157, 16924, 690, 615, -1000, -1000, 167, 718, 6505,
+	-84, -1000, -1000, -1000, 261, 13467, -1000, -1000, -1000, 891,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2715,223 +2683,215 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 730, 17317, -1000, 2568, -1000,
-	600, 4775, 117, 600, 301, 600, 17317, 17317, 4775, 4775,
-	4775, 45, 75, 63, 17317, 752, 112, 17317, 993, 887,
-	17317, 600, 600, -1000, 6207, -1000, 4775, 316, -1000, 471,
-	10053, 4775, 4775, 4775, 17317, 4775, 4775, -1000, -1000, -1000,
-	315, -1000, -1000, -1000, -1000, 4775, 4775, -1000, 1058, 296,
-	-1000, -1000, -1000, -1000, 10053, 227, -1000, 881, -1000, 10,
-	-1000, -1000, -1000, -1000, -1000, 1086, -1000, -1000, -1000, -125,
-	-1000, -1000, 10053, 10053, 10053, 10053, 635, 214, 11088, 354,
-	339, 11088, 11088, 11088, 11088, 11088, 11088, 11088, 11088, 11088,
-	11088, 11088, 11088, 11088, 11088, 11088, 639, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 600, -1000, 1078, 841, 841,
-	189, 189, 189, 189, 189, 189, 189, 189, 189, 11433,
-	7626, 6207, 737, 715, 1028, 8661, 8661, 10053, 10053, 9351,
-	9006, 8661, 973, 293, 563, 17317, -1000, -1000, 10743, -1000,
-	-1000, -1000, -1000, -1000, 429, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 17317, 17317, 8661, 8661, 8661, 8661, 8661, 1022,
-	737, 946, -1000, 1073, 210, 698, 750, -1000, 477, 1022,
-	13515, 746, -1000, 946, -1000, -1000, -1000, 17317, -1000, -1000,
-	15240, -1000, -1000, 5849, 58, 17317, -1000, 581, 909, -1000,
-	-1000, -1000, 995, 12825, 13170, 58, 687, 14550, 17317, -1000,
-	-1000, 14550, 17317, 5491, 6565, -75, -1000, 6565, 723, -1000,
-	-122, -107, 7971, 188, -1000, -1000, -1000, -1000, 4417, 490,
-	532, 347, -79, -1000, -1000, -1000, 783, -1000, 783, 783,
-	783, 783, -29, -29, -29, -29, -1000, -1000, -1000, -1000,
-	-1000, 836, 832, -1000, 783, 783, 783, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 830, 830, 830, 798, 798,
-	824, -1000, 17317, 4775, 991, 4775, -1000, 83, -1000, -1000,
-	-1000, 17317, 17317, 17317, 17317, 17317, 141, 17317, 17317, 749,
-	-1000, 17317, 4775, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 563, -1000, -1000, -1000, -1000, -1000, -1000, 17317, -1000,
-	-1000, -1000, -1000, 17317, 316, 17317, 17317, 563, -1000, 453,
-	17317, 17317, -1000, -1000, -1000, -1000, -1000, 563, 214, 481,
-	308, -1000, -1000, 515, -1000, -1000, 2150, -1000, -1000, -1000,
-	-1000, 354, 11088, 11088, 11088, 424, 2150, 2102, 546, 1170,
-	189, 346, 346, 190, 190, 190, 190, 190, 387, 387,
-	-1000, -1000, -1000, 429, -1000, -1000, -1000, 429, 8661, 8661,
-	748, 738, 166, -1000, 833, -1000, -1000, 1022, 689, 689,
-	649, 383, 271, 1056, 689, 269, 1054, 689, 689, 8661,
-	-1000, -1000, 314, -1000, 10053, 429, -1000, 165, -1000, 406,
-	741, 726, 689, 429, 429, 689, 689, 974, -1000, -1000,
-	931, 10053, 10053, 10053, -1000, -1000, -1000, 974, 1043, -1000,
-	941, 938, 1051, 8661, 14550, 946, -1000, -1000, -1000, 163,
-	755, 738, -1000, 17317, 14550, 14550, 14550, 14550, 14550, -1000,
-	914, 901, -1000, 900, 899, 918, 17317, -1000, 713, 737,
-	12825, 178, 738, -1000, 14895, -1000, -1000, 1051, 14550, 793,
-	-1000, 793, -1000, 159, -1000, -1000, 723, -75, -69, -1000,
-	-1000, -1000, -1000, 563, -1000, 657, 720, 4059, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 815, 600, -1000, 982, 223,
-	250, 600, 981, -1000, -1000, -1000, 957, -1000, 312, -81,
-	-1000, -1000, 412, -29, -29, -1000, -1000, 188, 952, 188,
-	188, 188, 440, 440, -1000, -1000, -1000, -1000, 399, -1000,
-	-1000, -1000, 393, -1000, 878, 17317, 4775, -1000, -1000, -1000,
-	-1000, 302, 302, 225, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 56, 587, -1000, -1000, -1000,
-	-1000, 1, 33, 109, -1000, 4775, -1000, 296, 296, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 424,
-	2150, 2069, -1000, 11088, 11088, -1000, -1000, 689, 689, 8661,
-	6207, 1028, 974, -1000, -1000, 203, 639, 203, 11088, 11088,
-	-1000, 11088, 11088, -1000, -163, 571, 251, -1000, 10053, 463,
-	-1000, 6207, -1000, 11088, 11088, -1000, -1000, -1000, -1000, -1000,
-	-1000, 929, 563, 563, -1000, -1000, 17317, -1000, -1000, -1000,
-	-1000, 1044, 10053, -1000, 667, -1000, 5133, 862, 17317, 738,
-	1086, 12825, 17317, 813, -1000, 252, 909, 823, 848, 772,
-	-1000, -1000, -1000, -1000, 898, -1000, 890, -1000, -1000, -1000,
-	-1000, -1000, 737, -1000, 126, 125, 123, 17317, -1000, 1028,
-	793, -1000, -1000, 200, -1000, -1000, -130, -136, -1000, -1000,
-	-1000, 4417, -1000, 4417, 17317, 73, -1000, 600, 600, -1000,
-	-1000, -1000, 811, 847, 11088, -1000, -1000, -1000, 525, 188,
-	188, -1000, 305, -1000, -1000, -1000, 681, -1000, 678, 586,
-	675, 17317, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 17317, -1000, -1000, -1000, -1000, -1000, 17317, -172, 600,
-	17317, 17317, 17317, 17317, -1000, 316, 316, -1000, 11088, 2150,
-	2150, -1000, -1000, 429, -1000, 1022, -1000, 429, 783, 783,
-	-1000, 783, 798, -1000, 783, -7, 783, -8, 429, 429,
-	2034, 1908, 1875, 922, 738, -157, -1000, 563, 10053, -1000,
-	1197, 757, -1000, -1000, 1047, 1025, 563, -1000, -1000, 985,
-	519, 491, -1000, -1000, 8316, 671, 154, 664, -1000, 1028,
-	17317, 10053, -1000, -1000, 10053, 790, -1000, 10053, -1000, -1000,
-	-1000, 1028, 738, 738, 738, 664, 1022, -1000, -1000, -1000,
-	-1000, 4059, -1000, 653, -1000, 783, -1000, -1000, -1000, 17317,
-	-70, 1069, 2150, -1000, -1000, -1000, -1000, -1000, -29, 434,
-	-29, 379, -1000, 368, 4775, -1000, -1000, -1000, -1000, 987,
-	-1000, 6207, -1000, -1000, 775, 774, -1000, -1000, -1000, -1000,
-	2150, -1000, 974, -1000, -1000, 135, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 11088, 11088, 11088, 11088, 11088, 1022,
-	421, 563, 11088, 11088, -1000, 10053, 10053, 978, -1000, 738,
-	-1000, 880, 17317, 17317, -1000, 17317, 1022, -1000, 563, 563,
-	17317, 563, 14205, 17317, 17317, 12468, -1000, 183, 17317, -1000,
-	612, -1000, 213, -1000, -131, 188, -1000, 188, 506, 494,
-	-1000, 738, 576, -1000, 246, 17317, 17317, -1000, -1000, -1000,
-	406, 406, 406, 406, 57, 429, -1000, 406, 406, 563,
-	544, 1067, -1000, 738, 1086, 153, -1000, -1000, -1000, 610,
-	566, -1000, 566, 566, 178, 183, -1000, 600, 245, 418,
-	-1000, 68, 17317, 337, 976, -1000, 959, -1000, -1000, -1000,
-	-1000, -1000, 55, 6207, 4417, 541, -1000, -1000, -1000, -1000,
-	-1000, 429, 54, -175, -1000, -1000, -1000, 17317, 491, 17317,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 359, -1000, -1000,
-	17317, -1000, -1000, 384, -1000, -1000, 488, -1000, 17317, -1000,
-	-1000, 587, -1000, 928, -170, -181, 478, -1000, -1000, 756,
-	-1000, -1000, 55, 937, -172, -1000, 921, -1000, 17317, -1000,
-	52, -1000, -173, 480, 46, -179, 846, 738, -182, 845,
-	-1000, 1062, 10398, -1000, -1000, 1066, 193, 193, 406, 429,
-	-1000, -1000, -1000, 77, 403, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	641, 16924, -1000, 1666, -1000, 479, 4327, 119, 479, 302,
+	479, 16924, 16924, 4327, 4327, 4327, 46, 76, 72, 16924,
+	-269, 717, 107, 16924, 932, 802, 16924, 479, 479, -1000,
+	5779, -1000, 4327, 309, -1000, 431, 9645, 4327, 4327, 4327,
+	16924, 4327, 4327, -1000, -1000, -1000, 342, -1000, -1000, -1000,
+	-1000, 4327, 4327, -1000, 987, 318, -1000, -1000, -1000, -1000,
+	9645, 226, -1000, 801, -1000, 21, -1000, -1000, -1000, -1000,
+	-1000, 1008, -1000, -1000, -1000, -124, -1000, -1000, 9645, 9645,
+	9645, 9645, 485, 228, 10680, 492, 220, 10680, 10680, 10680,
+	10680, 10680, 10680, 10680, 10680, 10680, 10680, 10680, 10680, 10680,
+	10680, 10680, 558, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 479, -1000, 988, 728, 728, 181, 181, 181, 181,
+	181, 181, 181, 181, 181, 11025, 7218, 5779, 509, 632,
+	955, 8253, 8253, 9645, 9645, 8943, 8598, 8253, 898, 280,
+	399, 16924, -1000, -1000, 10335, -1000, -1000, -1000, -1000, -1000,
+	442, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 16924, 16924,
+	8253, 8253, 8253, 8253, 8253, 935, 509, 899, -1000, 995,
+	214, 440, 709, -1000, 347, 935, 13122, 651, -1000, 899,
+	-1000, -1000, -1000, 16924, -1000, -1000, 14847, -1000, -1000, 5416,
+	57, 16924, -1000, 704, 855, -1000, -1000, -1000, 934, 12427,
+	12777, 57, 659, 14157, 16924, -1000, -1000, 14157, 16924, 5053,
+	6142, -84, -1000, 6142, 691, -1000, -82, -90, 7563, 176,
+	-1000, -1000, -1000, -1000, 3964, 349, 511, 373, -38, -1000,
+	-1000, -1000, 725, -1000, 725, 725, 725, 725, -11, -11,
+	-11, -11, -1000, -1000, -1000, -1000, -1000, 767, 750, -1000,
+	725, 725, 725, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 742, 742, 742, 727, 727, 780, -1000, 16924, 4327,
+	930, 4327, -1000, 84, -1000, -1000, -1000, 16924, 16924, 16924,
+	16924, 16924, 144, -1000, -1000, -1000, -1000, 16924, 16924, 708,
+	-1000, 16924, 4327, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 399, -1000, -1000, -1000, -1000, -1000, -1000, 16924, -1000,
+	-1000, -1000, -1000, 16924, 309, 16924, 16924, 399, -1000, 429,
+	16924, 16924, -1000, -1000, -1000, -1000, -1000, 399, 228, 334,
+	356, -1000, -1000, 463, -1000, -1000, 2396, -1000, -1000, -1000,
+	-1000, 492, 10680, 10680, 10680, 421, 2396, 2369, 929, 1653,
+	181, 570, 570, 185, 185, 185, 185, 185, 294, 294,
+	-1000, -1000, -1000, 442, -1000, -1000, -1000, 442, 8253, 8253,
+	700, 533, 164, -1000, 751, -1000, -1000, 935, 626, 626,
+	396, 532, 266, 986, 626, 259, 985, 626, 626, 8253,
+	-1000, -1000, 306, -1000, 9645, 442, -1000, 163, -1000, 622,
+	697, 692, 626, 442, 442, 626, 626, 892, -1000, -1000,
+	849, 9645, 9645, 9645, -1000, -1000, -1000, 892, 953, -1000,
+	860, 859, 976, 8253, 14157, 899, -1000, -1000, -1000, 161,
+	676, 533, -1000, 16924, 14157, 14157, 14157, 14157, 14157, -1000,
+	826, 822, -1000, 824, 823, 865, 16924, -1000, 630, 509,
+	12427, 179, 533, -1000, 14502, -1000, -1000, 976, 14157, 538,
+	-1000, 538, -1000, 153, -1000, -1000, 691, -84, -93, -1000,
+	-1000, -1000, -1000, 399, -1000, 588, 687, 3601, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 732, 479, -1000, 920, 222,
+	200, 479, 918, -1000, -1000, -1000, 893, -1000, 305, -40,
+	-1000, -1000, 379, -11, -11, -1000, -1000, 176, 888, 176,
+	176, 176, 424, 424, -1000, -1000, -1000, -1000, 376, -1000,
+	-1000, -1000, 362, -1000, 800, 16924, 4327, -1000, -1000, -1000,
+	-1000, 555, 555, 229, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 56, 776, -1000, -1000, -1000,
+	-1000, 25, 44, 102, -1000, 4327, -1000, 318, 318, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 421,
+	2396, 2082, -1000, 10680, 10680, -1000, -1000, 626, 626, 8253,
+	5779, 955, 892, -1000, -1000, 112, 558, 112, 10680, 10680,
+	-1000, 10680, 10680, -1000, -139, 698, 268, -1000, 9645, 354,
+	-1000, 5779, -1000, 10680, 10680, -1000, -1000, -1000, -1000, -1000,
+	-1000, 842, 399, 399, -1000, -1000, 16924, -1000, -1000, -1000,
+	-1000, 961, 9645, -1000, 684, -1000, 4690, 799, 16924, 533,
+	1008, 12427, 16924, 639, -1000, 257, 855, 748, 792, 609,
+	-1000, -1000, -1000, -1000, 816, -1000, 815, -1000, -1000, -1000,
+	-1000, -1000, 509, -1000, 126, 122, 121, 16924, -1000, 955,
+	538, -1000, -1000, 194, -1000, -1000, -92, -103, -1000, -1000,
+	-1000, 3964, -1000, 3964, 16924, 74, -1000, 479, 479, -1000,
+	-1000, -1000, 730, 791, 10680, -1000, -1000, -1000, 473, 176,
+	176, -1000, 265, -1000, -1000, -1000, 610, -1000, 608, 681,
+	586, 16924, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 16924, -1000, -1000, -1000, -1000, -1000, 16924, -147, 479,
+	16924, 16924, 16924, 16924, -1000, 309, 309, -1000, 10680, 2396,
+	2396, -1000, -1000, 442, -1000, 935, -1000, 442, 725, 725,
+	-1000, 725, 727, -1000, 725, 11, 725, 7, 442, 442,
+	2275, 2235, 1991, 1600, 533, -134, -1000, 399, 9645, -1000,
+	1582, 877, -1000, -1000, 957, 941, 399, -1000, -1000, 922,
+	656, 563, -1000, -1000, 7908, 581, 151, 579, -1000, 955,
+	16924, 9645, -1000, -1000, 9645, 726, -1000, 9645, -1000, -1000,
+	-1000, 955, 533, 533, 533, 579, 935, -1000, -1000, -1000,
+	-1000, 3601, -1000, 576, -1000, 725, -1000, -1000, -1000, 16924,
+	-34, 994, 2396, -1000, -1000, -1000, -1000, -1000, -11, 420,
+	-11, 355, -1000, 330, 4327, -1000, -1000, -1000, -1000, 924,
+	-1000, 5779, -1000, -1000, 724, 777, -1000, -1000, -1000, -1000,
+	2396, -1000, 892, -1000, -1000, 134, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 10680, 10680, 10680, 10680, 10680, 935,
+	416, 399, 10680, 10680, -1000, 9645, 9645, 910, -1000, 533,
+	-1000, 702, 16924, 16924, -1000, 16924, 935, -1000, 399, 399,
+	16924, 399, 13812, 16924, 16924, 12070, -1000, 169, 16924, -1000,
+	566, -1000, 219, -1000, -149, 176, -1000, 176, 457, 453,
+	-1000, 533, 660, -1000, 248, 16924, 16924, -1000, -1000, -1000,
+	622, 622, 622, 622, 66, 442, -1000, 622, 622, 399,
+	573, 993, -1000, 533, 1008, 145, -1000, -1000, -1000, 536,
+	527, -1000, 527, 527, 179, 169, -1000, 479, 240, 393,
+	-1000, 69, 16924, 312, 909, -1000, 900, -1000, -1000, -1000,
+	-1000, -1000, 55, 5779, 3964, 523, -1000, -1000, -1000, -1000,
+	-1000, 442, 78, -158, -1000, -1000, -1000, 16924, 563, 16924,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 328, -1000, -1000,
+	16924, -1000, -1000, 383, -1000, -1000, 520, -1000, 16924, -1000,
+	-1000, 776, -1000, 837, -145, -161, 556, -1000, -1000, 720,
+	-1000, -1000, 55, 858, -147, -1000, 834, -1000, 16924, -1000,
+	52, -1000, -148, 505, 50, -159, 790, 533, -175, 775,
+	-1000, 983, 9990, -1000, -1000, 992, 197, 197, 622, 442,
+	-1000, -1000, -1000, 75, 409, -1000, -1000, -1000, -1000, -1000,
 	-1000,
 }
 var yyPgo = [...]int{
 
-	0, 1343, 1341, 29, 67, 68, 1339, 1338, 1334, 94,
-	93, 91, 1332, 1330, 1329, 1328, 1327, 1326, 1324, 1323,
-	1322, 1321, 1318, 1317, 1316, 1315, 1311, 1309, 1308, 1295,
-	1289, 1287, 86, 1286, 84, 1266, 1265, 1261, 1259, 1252,
-	1251, 1250, 1249, 45, 261, 33, 61, 1248, 60, 1632,
-	1247, 64, 55, 59, 1246, 28, 1243, 1241, 85, 1240,
-	1237, 53, 1236, 1233, 522, 1231, 78, 1230, 11, 48,
-	1229, 1227, 1226, 1225, 82, 276, 1224, 1223, 14, 1221,
-	1220, 108, 1219, 62, 31, 13, 32, 24, 1217, 66,
-	1216, 6, 1215, 65, 1214, 1213, 1212, 1210, 47, 1208,
-	63, 1207, 40, 23, 1205, 12, 73, 27, 19, 7,
-	1202, 1201, 18, 72, 52, 69, 1199, 1198, 446, 1194,
-	1188, 46, 1186, 1185, 1182, 16, 1178, 100, 388, 1176,
-	1174, 1172, 1171, 38, 1044, 1814, 22, 70, 1170, 1169,
-	1168, 2602, 42, 58, 17, 1167, 1162, 1161, 54, 39,
-	50, 402, 1160, 35, 1159, 1158, 1157, 1156, 1152, 1151,
-	1150, 105, 1149, 1148, 1146, 89, 34, 1144, 1143, 71,
-	20, 1142, 1140, 1139, 56, 74, 1138, 1137, 57, 1134,
-	1128, 25, 1123, 1122, 1119, 1118, 1115, 26, 10, 1114,
-	15, 1113, 9, 1112, 21, 1111, 4, 1109, 8, 1101,
-	3, 0, 1099, 5, 36, 1, 1098, 2, 1096, 1094,
-	1361, 1136, 79, 1092, 92,
+	0, 1277, 1276, 19, 66, 65, 1274, 1269, 1268, 94,
+	93, 85, 1266, 1264, 1262, 1261, 1260, 1257, 1252, 1247,
+	1243, 1241, 1238, 1237, 1236, 1235, 1234, 1233, 1232, 1231,
+	1230, 1223, 91, 1222, 74, 1221, 1214, 1204, 1199, 1198,
+	1196, 1191, 1189, 35, 184, 50, 58, 1188, 57, 1752,
+	1186, 70, 62, 71, 1183, 40, 1182, 1179, 90, 1178,
+	1177, 56, 1176, 1174, 367, 1172, 68, 1171, 7, 36,
+	1170, 1168, 1167, 1166, 78, 161, 1165, 1163, 13, 1161,
+	1160, 86, 1159, 63, 32, 10, 24, 18, 1158, 61,
+	1155, 8, 1154, 60, 1153, 1152, 1150, 1149, 87, 1147,
+	59, 1146, 15, 11, 1145, 17, 73, 31, 21, 14,
+	1143, 1142, 25, 72, 47, 69, 1141, 1139, 533, 1136,
+	1134, 1133, 52, 1129, 1128, 1125, 42, 1122, 101, 369,
+	1121, 1120, 1118, 1117, 55, 1054, 2009, 114, 82, 1116,
+	1115, 1112, 2121, 48, 46, 23, 1107, 1104, 1102, 39,
+	30, 38, 471, 1094, 34, 1093, 1092, 1090, 1089, 1088,
+	1087, 1086, 119, 1083, 1082, 1081, 26, 28, 1079, 1073,
+	64, 29, 1069, 1063, 1059, 54, 67, 1058, 1056, 53,
+	1055, 1051, 22, 1050, 1049, 1047, 1045, 1044, 33, 12,
+	1041, 16, 1028, 9, 1027, 27, 1026, 4, 1024, 6,
+	1022, 3, 0, 1019, 5, 45, 1, 1018, 2, 1016,
+	1015, 1650, 235, 75, 1014, 79,
 }
 var yyR1 = [...]int{
 
-	0, 208, 209, 209, 1, 1, 1, 1, 1, 1,
+	0, 209, 210, 210, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 201,
-	201, 201, 20, 3, 3, 3, 3, 2, 2, 8,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 202,
+	202, 202, 20, 3, 3, 3, 3, 2, 2, 8,
 	4, 5, 5, 9, 9, 35, 35, 10, 11, 11,
-	11, 11, 212, 212, 58, 58, 59, 59, 106, 106,
+	11, 11, 213, 213, 58, 58, 59, 59, 106, 106,
 	12, 13, 13, 115, 115, 114, 114, 114, 116, 116,
-	116, 116, 151, 151, 14, 14, 14, 14, 14, 14,
-	14, 203, 203, 202, 200, 200, 199, 199, 198, 21,
-	183, 185, 185, 184, 184, 184, 184, 175, 154, 154,
-	154, 154, 157, 157, 155, 155, 155, 155, 155, 155,
-	155, 155, 155, 156, 156, 156, 156, 156, 158, 158,
-	158, 158, 158, 159, 159, 159, 159, 159, 159, 159,
-	159, 159, 159, 159, 159, 159, 159, 159, 160, 160,
-	160, 160, 160, 160, 160, 160, 174, 174, 161, 161,
-	169, 169, 170, 170, 170, 167, 167, 168, 168, 171,
-	171, 171, 163, 163, 164, 164, 172, 172, 165, 165,
-	165, 166, 166, 166, 173, 173, 173, 173, 173, 162,
-	162, 176, 176, 193, 193, 192, 192, 192, 182, 182,
-	189, 189, 189, 189, 189, 179, 179, 179, 180, 180,
-	178, 178, 181, 181, 191, 191, 190, 177, 177, 194,
-	194, 194, 194, 206, 207, 205, 205, 205, 205, 205,
-	186, 186, 186, 187, 187, 187, 188, 188, 188, 15,
+	116, 116, 152, 152, 14, 14, 14, 14, 14, 14,
+	14, 204, 204, 203, 201, 201, 200, 200, 199, 21,
+	184, 186, 186, 185, 185, 185, 185, 176, 155, 155,
+	155, 155, 158, 158, 156, 156, 156, 156, 156, 156,
+	156, 156, 156, 157, 157, 157, 157, 157, 159, 159,
+	159, 159, 159, 160, 160, 160, 160, 160, 160, 160,
+	160, 160, 160, 160, 160, 160, 160, 160, 161, 161,
+	161, 161, 161, 161, 161, 161, 175, 175, 162, 162,
+	170, 170, 171, 171, 171, 168, 168, 169, 169, 172,
+	172, 172, 164, 164, 165, 165, 173, 173, 166, 166,
+	166, 167, 167, 167, 174, 174, 174, 174, 174, 163,
+	163, 177, 177, 194, 194, 193, 193, 193, 183, 183,
+	190, 190, 190, 190, 190, 180, 180, 180, 181, 181,
+	179, 179, 182, 182, 192, 192, 191, 178, 178, 195,
+	195, 195, 195, 207, 208, 206, 206, 206, 206, 206,
+	187, 187, 187, 188, 188, 188, 189, 189, 189, 15,
 	15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
-	15, 15, 15, 15, 15, 15, 204, 204, 204, 204,
-	204, 204, 204, 204, 204, 204, 204, 204, 204, 204,
-	197, 195, 195, 196, 196, 16, 22, 22, 17, 17,
-	17, 17, 17, 18, 18, 23, 24, 24, 24, 24,
+	15, 15, 15, 15, 15, 15, 15, 120, 120, 120,
+	205, 205, 205, 205, 205, 205, 205, 205, 205, 205,
+	205, 205, 205, 205, 198, 196, 196, 197, 197, 16,
+	22, 22, 17, 17, 17, 17, 17, 18, 18, 23,
 	24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
 	24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
-	24, 24, 24, 122, 122, 124, 124, 120, 120, 123,
-	123, 121, 121, 121, 125, 125, 125, 126, 126, 152,
-	152, 152, 25, 25, 27, 27, 28, 29, 29, 146,
-	146, 147, 147, 30, 31, 36, 36, 36, 36, 36,
-	36, 38, 38, 38, 7, 7, 7, 7, 37, 37,
-	37, 6, 6, 26, 26, 26, 26, 19, 213, 32,
-	33, 33, 34, 34, 34, 40, 40, 40, 39, 39,
-	39, 45, 45, 47, 47, 47, 47, 47, 48, 48,
-	48, 48, 48, 48, 44, 44, 46, 46, 46, 46,
-	138, 138, 138, 137, 137, 50, 50, 51, 51, 52,
-	52, 53, 53, 53, 90, 67, 67, 105, 105, 107,
-	107, 54, 54, 54, 54, 55, 55, 56, 56, 57,
-	57, 145, 145, 144, 144, 144, 143, 143, 60, 60,
-	60, 62, 61, 61, 61, 61, 63, 63, 65, 65,
-	64, 64, 66, 68, 68, 68, 68, 68, 69, 69,
-	49, 49, 49, 49, 49, 49, 49, 49, 119, 119,
-	71, 71, 70, 70, 70, 70, 70, 70, 70, 70,
-	70, 70, 82, 82, 82, 82, 82, 82, 72, 72,
-	72, 72, 72, 72, 72, 43, 43, 83, 83, 83,
-	89, 84, 84, 75, 75, 75, 75, 75, 75, 75,
+	24, 24, 24, 24, 24, 24, 24, 123, 123, 125,
+	125, 121, 121, 124, 124, 122, 122, 122, 126, 126,
+	126, 127, 127, 153, 153, 153, 25, 25, 27, 27,
+	28, 29, 29, 147, 147, 148, 148, 30, 31, 36,
+	36, 36, 36, 36, 36, 38, 38, 38, 7, 7,
+	7, 7, 37, 37, 37, 6, 6, 26, 26, 26,
+	26, 19, 214, 32, 33, 33, 34, 34, 34, 40,
+	40, 40, 39, 39, 39, 45, 45, 47, 47, 47,
+	47, 47, 48, 48, 48, 48, 48, 48, 44, 44,
+	46, 46, 46, 46, 139, 139, 139, 138, 138, 50,
+	50, 51, 51, 52, 52, 53, 53, 53, 90, 67,
+	67, 105, 105, 107, 107, 54, 54, 54, 54, 55,
+	55, 56, 56, 57, 57, 146, 146, 145, 145, 145,
+	144, 144, 60, 60, 60, 62, 61, 61, 61, 61,
+	63, 63, 65, 65, 64, 64, 66, 68, 68, 68,
+	68, 68, 69, 69, 49, 49, 49, 49, 49, 49,
+	49, 49, 119, 119, 71, 71, 70, 70, 70, 70,
+	70, 70, 70, 70, 70, 70, 82, 82, 82, 82,
+	82, 82, 72, 72, 72, 72, 72, 72, 72, 43,
+	43, 83, 83, 83, 89, 84, 84, 75, 75, 75,
+	75, 75, 75, 75, 75, 75, 75, 75, 75, 75,
 	75, 75, 75, 75, 75, 75, 75, 75, 75, 75,
 	75, 75, 75, 75, 75, 75, 75, 75, 75, 75,
-	75, 75, 75, 75, 75, 75, 75, 79, 79, 79,
-	79, 77, 77, 77, 77, 77, 77, 77, 77, 77,
-	77, 77, 77, 77, 78, 78, 78, 78, 78, 78,
+	75, 79, 79, 79, 79, 77, 77, 77, 77, 77,
+	77, 77, 77, 77, 77, 77, 77, 77, 78, 78,
 	78, 78, 78, 78, 78, 78, 78, 78, 78, 78,
-	214, 214, 81, 80, 80, 80, 80, 80, 80, 80,
-	41, 41, 41, 41, 41, 150, 150, 153, 153, 153,
-	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
-	94, 94, 42, 42, 92, 92, 93, 95, 95, 91,
-	91, 91, 74, 74, 74, 74, 74, 74, 74, 74,
-	76, 76, 76, 96, 96, 97, 97, 98, 98, 99,
-	99, 100, 101, 101, 101, 102, 102, 102, 102, 103,
-	103, 103, 73, 73, 73, 73, 104, 104, 104, 104,
-	108, 108, 85, 85, 87, 87, 86, 88, 109, 109,
-	112, 110, 110, 113, 113, 113, 113, 113, 111, 111,
-	111, 140, 140, 140, 117, 117, 127, 127, 128, 128,
-	118, 118, 129, 129, 129, 129, 129, 129, 129, 129,
-	129, 129, 130, 130, 130, 131, 131, 132, 132, 132,
-	139, 139, 135, 135, 136, 136, 141, 141, 142, 142,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 134,
+	78, 78, 78, 78, 215, 215, 81, 80, 80, 80,
+	80, 80, 80, 80, 41, 41, 41, 41, 41, 151,
+	151, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 94, 94, 42, 42, 92, 92,
+	93, 95, 95, 91, 91, 91, 74, 74, 74, 74,
+	74, 74, 74, 74, 76, 76, 76, 96, 96, 97,
+	97, 98, 98, 99, 99, 100, 101, 101, 101, 102,
+	102, 102, 102, 103, 103, 103, 73, 73, 73, 73,
+	104, 104, 104, 104, 108, 108, 85, 85, 87, 87,
+	86, 88, 109, 109, 112, 110, 110, 113, 113, 113,
+	113, 113, 111, 111, 111, 141, 141, 141, 117, 117,
+	128, 128, 129, 129, 118, 118, 130, 130, 130, 130,
+	130, 130, 130, 130, 130, 130, 131, 131, 131, 132,
+	132, 133, 133, 133, 140, 140, 136, 136, 137, 137,
+	142, 142, 143, 143, 134, 134, 134, 134, 134, 134,
 	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
 	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
 	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
@@ -2943,13 +2903,25 @@ var yyR1 = [...]int{
 	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
 	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
 	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
-	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
-	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
-	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
-	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
-	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
-	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
-	134, 134, 134, 134, 210, 211, 148, 149, 149, 149,
+	134, 134, 134, 135, 135, 135, 135, 135, 135, 135,
+	135, 135, 135, 135, 135, 135, 135, 135, 135, 135,
+	135, 135, 135, 135, 135, 135, 135, 135, 135, 135,
+	135, 135, 135, 135, 135, 135, 135, 135, 135, 135,
+	135, 135, 135, 135, 135, 135, 135, 135, 135, 135,
+	135, 135, 135, 135, 135, 135, 135, 135, 135, 135,
+	135, 135, 135, 135, 135, 135, 135, 135, 135, 135,
+	135, 135, 135, 135, 135, 135, 135, 135, 135, 135,
+	135, 135, 135, 135, 135, 135, 135, 135, 135, 135,
+	135, 135, 135, 135, 135, 135, 135, 135, 135, 135,
+	135, 135, 135, 135, 135, 135, 135, 135, 135, 135,
+	135, 135, 135, 135, 135, 135, 135, 135, 135, 135,
+	135, 135, 135, 135, 135, 135, 135, 135, 135, 135,
+	135, 135, 135, 135, 135, 135, 135, 135, 135, 135,
+	135, 135, 135, 135, 135, 135, 135, 135, 135, 135,
+	135, 135, 135, 135, 135, 135, 135, 135, 135, 135,
+	135, 135, 135, 135, 135, 135, 135, 135, 135, 135,
+	135, 135, 135, 135, 135, 135, 135, 135, 135, 135,
+	135, 135, 211, 212, 149, 150, 150, 150,
 }
 var yyR2 = [...]int{
 
@@ -2977,52 +2949,52 @@ var yyR2 = [...]int{
 	11, 11, 12, 3, 3, 1, 1, 2, 2, 2,
 	0, 1, 3, 1, 2, 3, 1, 1, 1, 6,
 	7, 7, 7, 7, 4, 5, 4, 4, 7, 5,
-	5, 5, 12, 7, 5, 9, 1, 1, 1, 1,
+	5, 5, 12, 7, 5, 9, 4, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	7, 1, 3, 8, 8, 3, 3, 5, 4, 6,
-	5, 4, 4, 3, 2, 3, 4, 4, 3, 4,
-	4, 4, 4, 4, 4, 3, 2, 7, 2, 3,
-	4, 3, 7, 5, 4, 2, 4, 4, 3, 3,
-	5, 2, 3, 1, 1, 0, 1, 0, 1, 1,
-	1, 0, 2, 2, 0, 2, 2, 0, 2, 0,
-	1, 1, 2, 1, 1, 2, 1, 1, 5, 0,
-	1, 0, 1, 2, 3, 0, 3, 3, 3, 3,
-	1, 1, 1, 1, 1, 1, 1, 1, 0, 1,
-	1, 3, 3, 2, 2, 3, 3, 2, 0, 2,
-	0, 2, 1, 2, 2, 0, 1, 1, 0, 1,
-	1, 0, 1, 0, 1, 2, 3, 4, 1, 1,
-	1, 1, 1, 1, 1, 3, 1, 2, 3, 5,
-	0, 1, 2, 1, 1, 0, 2, 1, 3, 1,
-	1, 1, 3, 3, 3, 3, 7, 1, 3, 1,
-	3, 4, 4, 4, 3, 2, 4, 0, 1, 0,
-	2, 0, 1, 0, 1, 2, 1, 1, 1, 2,
-	2, 1, 2, 3, 2, 3, 2, 2, 2, 1,
-	1, 3, 3, 0, 5, 4, 5, 5, 0, 2,
-	1, 3, 3, 3, 2, 3, 1, 2, 0, 3,
-	1, 1, 3, 3, 4, 4, 5, 3, 4, 5,
-	6, 2, 1, 2, 1, 2, 1, 2, 1, 1,
-	1, 1, 1, 1, 1, 0, 2, 1, 1, 1,
-	3, 1, 3, 1, 1, 1, 1, 1, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 2, 2, 2, 2, 2, 2, 2,
-	2, 2, 3, 1, 1, 1, 1, 4, 5, 5,
-	6, 4, 4, 6, 6, 6, 8, 8, 8, 8,
-	9, 8, 5, 4, 2, 2, 2, 2, 2, 2,
-	2, 2, 2, 2, 2, 2, 2, 2, 8, 8,
-	0, 2, 3, 4, 4, 4, 4, 4, 4, 4,
-	0, 3, 4, 7, 3, 1, 1, 2, 3, 3,
-	1, 2, 2, 1, 2, 1, 2, 2, 1, 2,
-	0, 1, 0, 2, 1, 2, 4, 0, 2, 1,
-	3, 5, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 2, 2, 0, 3, 0, 2, 0, 3, 1,
-	3, 2, 0, 1, 1, 0, 2, 4, 4, 0,
-	2, 4, 2, 1, 5, 4, 1, 3, 3, 5,
-	0, 5, 1, 3, 1, 2, 3, 1, 1, 3,
-	3, 1, 3, 3, 3, 3, 3, 2, 1, 2,
-	1, 1, 1, 1, 1, 1, 0, 2, 0, 3,
-	0, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 0, 1, 1, 1, 1, 0, 1, 1,
-	0, 2, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 7, 1, 3, 8, 8, 3,
+	3, 5, 4, 6, 5, 4, 4, 3, 2, 3,
+	4, 4, 3, 4, 4, 4, 4, 4, 4, 3,
+	2, 7, 2, 3, 4, 3, 7, 5, 4, 2,
+	4, 4, 3, 3, 5, 2, 3, 1, 1, 0,
+	1, 0, 1, 1, 1, 0, 2, 2, 0, 2,
+	2, 0, 2, 0, 1, 1, 2, 1, 1, 2,
+	1, 1, 5, 0, 1, 0, 1, 2, 3, 0,
+	3, 3, 3, 3, 1, 1, 1, 1, 1, 1,
+	1, 1, 0, 1, 1, 3, 3, 2, 2, 3,
+	3, 2, 0, 2, 0, 2, 1, 2, 2, 0,
+	1, 1, 0, 1, 1, 0, 1, 0, 1, 2,
+	3, 4, 1, 1, 1, 1, 1, 1, 1, 3,
+	1, 2, 3, 5, 0, 1, 2, 1, 1, 0,
+	2, 1, 3, 1, 1, 1, 3, 3, 3, 3,
+	7, 1, 3, 1, 3, 4, 4, 4, 3, 2,
+	4, 0, 1, 0, 2, 0, 1, 0, 1, 2,
+	1, 1, 1, 2, 2, 1, 2, 3, 2, 3,
+	2, 2, 2, 1, 1, 3, 3, 0, 5, 4,
+	5, 5, 0, 2, 1, 3, 3, 3, 2, 3,
+	1, 2, 0, 3, 1, 1, 3, 3, 4, 4,
+	5, 3, 4, 5, 6, 2, 1, 2, 1, 2,
+	1, 2, 1, 1, 1, 1, 1, 1, 1, 0,
+	2, 1, 1, 1, 3, 1, 3, 1, 1, 1,
+	1, 1, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 2, 2, 2,
+	2, 2, 2, 2, 2, 2, 3, 1, 1, 1,
+	1, 4, 5, 5, 6, 4, 4, 6, 6, 6,
+	8, 8, 8, 8, 9, 8, 5, 4, 2, 2,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+	2, 2, 8, 8, 0, 2, 3, 4, 4, 4,
+	4, 4, 4, 4, 0, 3, 4, 7, 3, 1,
+	1, 2, 3, 3, 1, 2, 2, 1, 2, 1,
+	2, 2, 1, 2, 0, 1, 0, 2, 1, 2,
+	4, 0, 2, 1, 3, 5, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 2, 2, 0, 3, 0,
+	2, 0, 3, 1, 3, 2, 0, 1, 1, 0,
+	2, 4, 4, 0, 2, 4, 2, 1, 5, 4,
+	1, 3, 3, 5, 0, 5, 1, 3, 1, 2,
+	3, 1, 1, 3, 3, 1, 3, 3, 3, 3,
+	3, 2, 1, 2, 1, 1, 1, 1, 1, 1,
+	0, 2, 0, 3, 0, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 0, 1, 1, 1,
+	1, 0, 1, 1, 0, 2, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -3052,336 +3024,339 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 0, 0, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 0, 0, 1, 1,
 }
 var yyChk = [...]int{
 
-	-1000, -208, -1, -3, -8, -9, -10, -11, -12, -13,
+	-1000, -209, -1, -3, -8, -9, -10, -11, -12, -13,
 	-14, -15, -16, -17, -18, -23, -24, -25, -27, -28,
-	-29, -30, -31, -6, -26, -19, -20, -4, -210, 6,
+	-29, -30, -31, -6, -26, -19, -20, -4, -211, 6,
 	7, -35, 9, 10, 30, -21, 123, 124, 126, 125,
 	159, 127, 152, 53, 173, 174, 176, 177, 178, 179,
 	-38, 157, 158, 31, 32, 129, 34, 57, 8, 262,
-	154, 153, 25, -209, 364, -34, 5, -98, 15, -3,
-	-32, -213, -32, -32, -32, -32, -32, -183, -185, 57,
-	96, -132, 133, 77, 254, 130, 131, 137, -135, -201,
-	-134, 60, 61, 62, 272, 145, 304, 305, 173, 187,
-	181, 208, 200, 273, 306, 146, 198, 201, 241, 143,
-	307, 228, 235, 71, 176, 250, 308, 155, 196, 192,
-	309, 281, 190, 27, 310, 237, 213, 311, 277, 239,
-	191, 236, 129, 312, 148, 360, 141, 313, 214, 218,
-	314, 242, 315, 316, 317, 185, 186, 318, 144, 244,
-	212, 142, 33, 274, 37, 163, 245, 216, 319, 211,
-	207, 320, 321, 322, 323, 210, 184, 206, 41, 220,
-	219, 221, 240, 203, 324, 325, 326, 149, 327, 193,
-	18, 328, 329, 330, 331, 332, 248, 158, 333, 161,
-	334, 335, 336, 337, 338, 339, 238, 215, 217, 138,
-	165, 234, 276, 340, 246, 189, 341, 150, 162, 157,
-	249, 151, 342, 343, 344, 345, 346, 347, 348, 177,
-	349, 350, 351, 352, 172, 243, 252, 40, 225, 353,
-	183, 140, 354, 174, 169, 230, 204, 164, 355, 356,
-	194, 195, 209, 182, 205, 175, 361, 166, 159, 357,
-	251, 226, 278, 202, 199, 170, 358, 167, 168, 359,
-	362, 231, 232, 171, 275, 247, 197, 227, -118, 133,
-	254, 130, 232, 135, 131, 131, 132, 133, 254, 130,
-	131, -64, -141, -201, -134, 133, 131, 114, 201, 241,
-	123, 229, 237, -124, 238, 165, -152, 131, -120, 228,
-	231, 232, 171, -201, 239, 243, 242, 233, -141, 175,
-	-146, 180, -135, 178, -64, -36, 360, 127, -148, -148,
-	230, 230, -148, -84, -49, -70, 80, -75, 29, 23,
-	-74, -71, -91, -88, -89, 114, 115, 117, 116, 118,
-	103, 104, 111, 81, 119, -79, -77, -78, -80, 64,
-	63, 72, 65, 66, 67, 68, 73, 74, 75, -135,
-	-141, -86, -210, 47, 48, 263, 264, 265, 266, 271,
-	267, 83, 36, 253, 261, 260, 259, 257, 258, 255,
-	256, 269, 270, 136, 254, 130, 109, 262, -201, -134,
-	-5, -4, -210, 6, 20, 21, -102, 17, 16, -211,
-	59, -40, -47, 42, 43, -48, 21, 35, 46, 44,
-	-33, -46, 105, -49, -141, -118, -118, 11, -58, -59,
-	-64, -66, -141, -110, -151, 175, -113, 243, 242, -136,
-	-111, -135, -133, 241, 201, 240, 128, 279, 79, 22,
-	24, 223, 82, 114, 16, 83, 113, 263, 123, 51,
-	280, 255, 256, 253, 265, 266, 254, 229, 29, 10,
-	282, 25, 153, 21, 35, 107, 125, 86, 87, 156,
-	23, 154, 75, 285, 19, 54, 11, 13, 286, 287,
-	14, 136, 135, 98, 132, 49, 8, 119, 26, 95,
-	45, 288, 28, 289, 290, 291, 292, 47, 96, 17,
-	257, 258, 31, 293, 271, 160, 109, 52, 38, 80,
-	294, 295, 73, 296, 76, 55, 77, 15, 50, 297,
-	298, 299, 300, 97, 126, 262, 48, 301, 130, 6,
-	268, 30, 152, 46, 302, 131, 85, 269, 270, 134,
-	74, 5, 137, 32, 9, 53, 56, 259, 260, 261,
-	36, 84, 12, 303, 78, -184, 96, -175, -201, -64,
-	132, -64, 262, -128, 136, -128, -128, 131, -64, -201,
-	-201, 123, 125, 128, 55, -22, -64, -127, 136, -201,
-	-127, -127, -127, -64, 120, -64, -201, 30, -125, 96,
-	12, 254, -201, 165, 131, 166, 133, -149, -210, -136,
-	-179, 132, 33, 144, -149, 169, 170, -149, -123, -122,
-	235, 236, 230, 234, 12, 170, 230, 168, -149, 134,
-	-135, -37, -135, 64, -7, -3, -10, -9, -11, 88,
-	-148, -148, 58, 79, 77, 78, 95, -49, -72, 98,
-	80, 96, 97, 82, 100, 99, 110, 103, 104, 105,
-	106, 107, 108, 109, 101, 102, 113, 88, 89, 90,
-	91, 92, 93, 94, -119, -210, -89, -210, 121, 122,
-	-75, -75, -75, -75, -75, -75, -75, -75, -75, -75,
-	-210, 120, -2, -84, -4, -210, -210, -210, -210, -210,
-	-210, -210, -210, -94, -49, -210, -214, -81, -210, -214,
-	-81, -214, -81, -214, -210, -214, -81, -214, -81, -214,
-	-214, -81, -210, -210, -210, -210, -210, -210, -210, -98,
-	-3, -32, -103, 19, 31, -49, -99, -100, -49, -98,
-	38, -44, -46, -48, 42, 43, 70, 11, -138, -137,
-	22, -135, 64, 120, -65, 26, -64, -51, -52, -53,
-	-54, -67, -90, -210, -64, -64, -58, -212, 58, 11,
-	56, -212, 58, 120, 58, 175, -113, -151, -115, -114,
-	244, 246, 88, -140, -135, 64, 29, 30, 59, 58,
-	-64, -154, -157, -159, -158, -160, -155, -156, 198, 199,
-	114, 202, 204, 205, 206, 207, 208, 209, 210, 211,
-	212, 213, 30, 155, 194, 195, 196, 197, 214, 215,
-	216, 217, 218, 219, 220, 221, 181, 200, 273, 182,
-	183, 184, 185, 186, 187, 189, 190, 191, 192, 193,
-	-201, -149, 133, -201, 80, -201, -64, -64, -149, -149,
-	-149, 167, 167, 131, 131, 172, -64, 58, 134, -58,
-	23, 55, -64, -201, -201, -142, -141, -133, -149, -125,
-	64, -49, -149, -149, -149, -64, -149, -149, -180, 11,
-	98, -149, -149, 11, -121, 11, 98, -49, -126, 96,
-	55, -147, 178, 212, 361, 362, 363, -49, -49, -49,
+	154, 153, 25, -210, 368, -34, 5, -98, 15, -3,
+	-32, -214, -32, -32, -32, -32, -32, -184, -186, 57,
+	96, -133, 133, 77, 254, 130, 131, 137, -136, -202,
+	-135, 60, 61, 62, 272, 145, 304, 305, 173, 187,
+	181, 208, 200, 273, 306, 365, 146, 198, 201, 241,
+	143, 307, 228, 235, 71, 176, 250, 308, 366, 155,
+	196, 192, 309, 281, 190, 27, 310, 237, 213, 311,
+	277, 239, 191, 236, 129, 312, 148, 360, 141, 313,
+	214, 218, 314, 242, 315, 316, 317, 185, 186, 318,
+	144, 244, 212, 142, 33, 274, 37, 163, 245, 216,
+	319, 211, 207, 320, 321, 322, 323, 210, 184, 206,
+	41, 220, 219, 221, 240, 203, 324, 325, 326, 149,
+	327, 193, 18, 328, 329, 330, 331, 332, 248, 158,
+	333, 161, 334, 335, 336, 337, 338, 339, 238, 215,
+	217, 138, 165, 234, 276, 340, 246, 189, 341, 150,
+	162, 157, 249, 151, 342, 343, 344, 345, 346, 367,
+	347, 348, 177, 349, 350, 351, 352, 172, 243, 252,
+	40, 225, 353, 183, 140, 354, 174, 169, 230, 204,
+	164, 355, 356, 194, 195, 209, 182, 205, 175, 361,
+	166, 159, 357, 251, 226, 278, 202, 199, 170, 358,
+	167, 168, 359, 362, 231, 364, 232, 171, 275, 247,
+	197, 227, -118, 133, 254, 130, 232, 364, 135, 131,
+	131, 132, 133, 254, 130, 131, -64, -142, -202, -135,
+	133, 131, 114, 201, 241, 123, 229, 237, -125, 238,
+	165, -153, 131, -121, 228, 231, 232, 171, -202, 239,
+	243, 242, 233, -142, 175, -147, 180, -136, 178, -64,
+	-36, 360, 127, -149, -149, 230, 230, -149, -84, -49,
+	-70, 80, -75, 29, 23, -74, -71, -91, -88, -89,
+	114, 115, 117, 116, 118, 103, 104, 111, 81, 119,
+	-79, -77, -78, -80, 64, 63, 72, 65, 66, 67,
+	68, 73, 74, 75, -136, -142, -86, -211, 47, 48,
+	263, 264, 265, 266, 271, 267, 83, 36, 253, 261,
+	260, 259, 257, 258, 255, 256, 269, 270, 136, 254,
+	130, 109, 262, -202, -135, -5, -4, -211, 6, 20,
+	21, -102, 17, 16, -212, 59, -40, -47, 42, 43,
+	-48, 21, 35, 46, 44, -33, -46, 105, -49, -142,
+	-118, -118, 11, -58, -59, -64, -66, -142, -110, -152,
+	175, -113, 243, 242, -137, -111, -136, -134, 241, 201,
+	240, 128, 279, 79, 22, 24, 223, 82, 114, 16,
+	83, 113, 263, 123, 51, 280, 255, 256, 253, 265,
+	266, 254, 229, 29, 10, 282, 25, 153, 21, 35,
+	107, 125, 86, 87, 156, 23, 154, 75, 285, 19,
+	54, 11, 13, 286, 287, 14, 136, 135, 98, 132,
+	49, 8, 119, 26, 95, 45, 288, 28, 289, 290,
+	291, 292, 47, 96, 17, 257, 258, 31, 293, 271,
+	160, 109, 52, 38, 80, 294, 295, 73, 296, 76,
+	55, 77, 15, 50, 297, 298, 299, 300, 97, 126,
+	262, 48, 301, 130, 6, 268, 30, 152, 46, 302,
+	131, 85, 269, 270, 134, 74, 5, 137, 32, 9,
+	53, 56, 259, 260, 261, 36, 84, 12, 303, 78,
+	-185, 96, -176, -202, -64, 132, -64, 262, -129, 136,
+	-129, -129, 131, -64, -202, -202, 123, 125, 128, 55,
+	64, -22, -64, -128, 136, -202, -128, -128, -128, -64,
+	120, -64, -202, 30, -126, 96, 12, 254, -202, 165,
+	131, 166, 133, -150, -211, -137, -180, 132, 33, 144,
+	-150, 169, 170, -150, -124, -123, 235, 236, 230, 234,
+	12, 170, 230, 168, -150, 134, -136, -37, -136, 64,
+	-7, -3, -10, -9, -11, 88, -149, -149, 58, 79,
+	77, 78, 95, -49, -72, 98, 80, 96, 97, 82,
+	100, 99, 110, 103, 104, 105, 106, 107, 108, 109,
+	101, 102, 113, 88, 89, 90, 91, 92, 93, 94,
+	-119, -211, -89, -211, 121, 122, -75, -75, -75, -75,
+	-75, -75, -75, -75, -75, -75, -211, 120, -2, -84,
+	-4, -211, -211, -211, -211, -211, -211, -211, -211, -94,
+	-49, -211, -215, -81, -211, -215, -81, -215, -81, -215,
+	-211, -215, -81, -215, -81, -215, -215, -81, -211, -211,
+	-211, -211, -211, -211, -211, -98, -3, -32, -103, 19,
+	31, -49, -99, -100, -49, -98, 38, -44, -46, -48,
+	42, 43, 70, 11, -139, -138, 22, -136, 64, 120,
+	-65, 26, -64, -51, -52, -53, -54, -67, -90, -211,
+	-64, -64, -58, -213, 58, 11, 56, -213, 58, 120,
+	58, 175, -113, -152, -115, -114, 244, 246, 88, -141,
+	-136, 64, 29, 30, 59, 58, -64, -155, -158, -160,
+	-159, -161, -156, -157, 198, 199, 114, 202, 204, 205,
+	206, 207, 208, 209, 210, 211, 212, 213, 30, 155,
+	194, 195, 196, 197, 214, 215, 216, 217, 218, 219,
+	220, 221, 181, 200, 273, 182, 183, 184, 185, 186,
+	187, 189, 190, 191, 192, 193, -202, -150, 133, -202,
+	80, -202, -64, -64, -150, -150, -150, 167, 167, 131,
+	131, 172, -64, -120, 365, 366, 367, 58, 134, -58,
+	23, 55, -64, -202, -202, -143, -142, -134, -150, -126,
+	64, -49, -150, -150, -150, -64, -150, -150, -181, 11,
+	98, -150, -150, 11, -122, 11, 98, -49, -127, 96,
+	55, -148, 178, 212, 361, 362, 363, -49, -49, -49,
 	-49, -82, 73, 80, 74, 75, -75, -83, -86, -89,
 	69, 98, 96, 97, 82, -75, -75, -75, -75, -75,
 	-75, -75, -75, -75, -75, -75, -75, -75, -75, -75,
-	-150, -201, 64, -201, -74, -74, -135, -45, 21, 35,
-	-44, -136, -142, -133, -34, -211, -211, -98, -44, -44,
+	-151, -202, 64, -202, -74, -74, -136, -45, 21, 35,
+	-44, -137, -143, -134, -34, -212, -212, -98, -44, -44,
 	-49, -49, -91, 64, -44, -91, 64, -44, -44, -39,
-	21, 35, -92, -93, 84, -91, -135, -141, -211, -75,
-	-135, -135, -44, -45, -45, -44, -44, -102, -211, 9,
-	98, 58, 18, 58, -101, 24, 25, -102, -76, -135,
-	65, 68, -50, 58, 11, -48, -64, -137, 105, -142,
+	21, 35, -92, -93, 84, -91, -136, -142, -212, -75,
+	-136, -136, -44, -45, -45, -44, -44, -102, -212, 9,
+	98, 58, 18, 58, -101, 24, 25, -102, -76, -136,
+	65, 68, -50, 58, 11, -48, -64, -138, 105, -143,
 	-106, 161, -64, 30, 58, -60, -62, -61, -63, 45,
-	49, 51, 46, 47, 48, 52, -145, 22, -51, -3,
-	-210, -144, 161, -143, 22, -141, 64, -106, 56, -51,
-	-64, -51, -66, -141, 105, -113, -115, 58, 245, 247,
-	248, 55, 76, -49, -166, 113, -186, -187, -188, -136,
-	64, 65, -175, -176, -177, -189, 147, -194, 138, 140,
-	137, -178, 148, 132, 28, 59, -171, 73, 80, -167,
-	226, -161, 57, -161, -161, -161, -161, -165, 201, -165,
-	-165, -165, 57, 57, -161, -161, -161, -169, 57, -169,
-	-169, -170, 57, -170, -139, 56, -64, -149, 23, -149,
-	-129, 128, 125, 126, -197, 124, 223, 201, 71, 29,
-	15, 263, 161, 278, -201, 162, -64, -64, -64, -64,
-	-64, 128, 125, -64, -64, -64, -149, -64, -64, -125,
-	-141, -141, 64, -64, -135, 73, 74, 75, -83, -75,
-	-75, -75, -43, 156, 79, -211, -211, -44, -44, -210,
-	120, -5, -102, -211, -211, 58, 56, 22, 11, 11,
-	-211, 11, 11, -211, -211, -44, -95, -93, 86, -49,
-	-211, 120, -211, 58, 58, -211, -211, -211, -211, -211,
+	49, 51, 46, 47, 48, 52, -146, 22, -51, -3,
+	-211, -145, 161, -144, 22, -142, 64, -106, 56, -51,
+	-64, -51, -66, -142, 105, -113, -115, 58, 245, 247,
+	248, 55, 76, -49, -167, 113, -187, -188, -189, -137,
+	64, 65, -176, -177, -178, -190, 147, -195, 138, 140,
+	137, -179, 148, 132, 28, 59, -172, 73, 80, -168,
+	226, -162, 57, -162, -162, -162, -162, -166, 201, -166,
+	-166, -166, 57, 57, -162, -162, -162, -170, 57, -170,
+	-170, -171, 57, -171, -140, 56, -64, -150, 23, -150,
+	-130, 128, 125, 126, -198, 124, 223, 201, 71, 29,
+	15, 263, 161, 278, -202, 162, -64, -64, -64, -64,
+	-64, 128, 125, -64, -64, -64, -150, -64, -64, -126,
+	-142, -142, 64, -64, -136, 73, 74, 75, -83, -75,
+	-75, -75, -43, 156, 79, -212, -212, -44, -44, -211,
+	120, -5, -102, -212, -212, 58, 56, 22, 11, 11,
+	-212, 11, 11, -212, -212, -44, -95, -93, 86, -49,
+	-212, 120, -212, 58, 58, -212, -212, -212, -212, -212,
 	-103, 40, -49, -49, -100, -103, -117, 19, 11, 36,
 	36, -69, 12, -46, -51, -48, 120, -73, 30, 36,
-	-3, -210, -210, -109, -112, -91, -52, -53, -53, -52,
-	-53, 45, 45, 45, 50, 45, 50, 45, -61, -141,
-	-211, -211, -3, -68, 53, 135, 54, -210, -143, -69,
-	-51, -69, -69, 120, -114, -116, 249, 246, 252, -201,
-	64, 58, -188, 88, 57, -201, 28, -178, -178, -181,
-	-201, -181, 28, -163, 29, 73, -168, 227, 65, -165,
-	-165, -166, 30, -166, -166, -166, -174, 64, -174, 65,
-	65, 55, -135, -149, -148, -204, 143, 139, 147, 148,
+	-3, -211, -211, -109, -112, -91, -52, -53, -53, -52,
+	-53, 45, 45, 45, 50, 45, 50, 45, -61, -142,
+	-212, -212, -3, -68, 53, 135, 54, -211, -144, -69,
+	-51, -69, -69, 120, -114, -116, 249, 246, 252, -202,
+	64, 58, -189, 88, 57, -202, 28, -179, -179, -182,
+	-202, -182, 28, -164, 29, 73, -169, 227, 65, -166,
+	-166, -167, 30, -167, -167, -167, -175, 64, -175, 65,
+	65, 55, -136, -150, -149, -205, 143, 139, 147, 148,
 	141, 60, 61, 62, 132, 28, 138, 140, 161, 137,
-	-204, -130, -131, 134, 22, 132, 28, 161, -203, 56,
-	167, 223, 167, 134, -149, -121, -121, -43, 79, -75,
-	-75, -211, -211, -45, -136, -98, -103, -153, 114, 198,
-	155, 196, 192, 212, 203, 225, 194, 226, -150, -153,
-	-75, -75, -75, -75, 272, -98, 87, -49, 85, -136,
+	-205, -131, -132, 134, 22, 132, 28, 161, -204, 56,
+	167, 223, 167, 134, -150, -122, -122, -43, 79, -75,
+	-75, -212, -212, -45, -137, -98, -103, -154, 114, 198,
+	155, 196, 192, 212, 203, 225, 194, 226, -151, -154,
+	-75, -75, -75, -75, 272, -98, 87, -49, 85, -137,
 	-75, -75, 41, -64, -96, 13, -49, 105, -108, 55,
-	-109, -85, -87, -86, -210, -104, -135, -107, -135, -69,
+	-109, -85, -87, -86, -211, -104, -136, -107, -136, -69,
 	58, 88, -56, -55, 55, 56, -57, 55, -55, 45,
-	45, -211, 132, 132, 132, -107, -98, -69, 246, 250,
-	251, -187, -188, -191, -190, -135, -194, -181, -181, 57,
-	-164, 55, -75, 59, -166, -166, -201, 114, 59, 58,
-	59, 58, 59, 58, -64, -148, -148, -64, -148, -135,
-	-200, 275, -202, -201, -135, -135, -135, -64, -125, -125,
-	-75, -211, -102, -211, -161, -161, -161, -170, -161, 186,
-	-161, 186, -211, -211, 19, 19, 19, 19, -210, -42,
+	45, -212, 132, 132, 132, -107, -98, -69, 246, 250,
+	251, -188, -189, -192, -191, -136, -195, -182, -182, 57,
+	-165, 55, -75, 59, -167, -167, -202, 114, 59, 58,
+	59, 58, 59, 58, -64, -149, -149, -64, -149, -136,
+	-201, 275, -203, -202, -136, -136, -136, -64, -126, -126,
+	-75, -212, -102, -212, -162, -162, -162, -171, -162, 186,
+	-162, 186, -212, -212, 19, 19, 19, 19, -211, -42,
 	268, -49, 58, 58, -97, 14, 16, 27, -108, 58,
-	-211, -211, 58, 120, -211, 58, -98, -112, -49, -49,
-	57, -49, -210, -210, -210, -211, -102, 59, 58, -161,
-	-105, -135, -172, 223, 9, -165, 64, -165, 65, 65,
-	-149, 26, -199, -198, -136, 57, 56, -103, -165, -201,
+	-212, -212, 58, 120, -212, 58, -98, -112, -49, -49,
+	57, -49, -211, -211, -211, -212, -102, 59, 58, -162,
+	-105, -136, -173, 223, 9, -166, 64, -166, 65, 65,
+	-150, 26, -200, -199, -137, 57, 56, -103, -166, -202,
 	-75, -75, -75, -75, -75, -102, 64, -75, -75, -49,
-	-84, 28, -87, 36, -3, -135, -135, -135, -102, -105,
-	-105, -211, -105, -105, -144, -193, -192, 56, 142, 71,
-	-190, 59, 58, -173, 138, 28, 137, -78, -166, -166,
-	59, 59, -210, 58, 88, -105, -64, -211, -211, -211,
-	-211, -41, 98, 275, -211, -211, -211, 9, -85, 120,
-	59, -211, -211, -211, -68, -192, -201, -182, 88, 64,
-	150, -135, -162, 71, 28, 28, -195, -196, 161, -198,
-	-188, 59, -211, 273, 52, 276, -109, -135, 65, -64,
-	64, -211, 58, -135, -203, 41, 274, 277, 57, -196,
-	36, -200, 41, -105, 163, 275, 59, 164, 276, -206,
-	-207, 55, -210, 277, -207, 55, 10, 9, -75, 160,
-	-205, 151, 146, 149, 30, -205, -211, -211, 145, 29,
+	-84, 28, -87, 36, -3, -136, -136, -136, -102, -105,
+	-105, -212, -105, -105, -145, -194, -193, 56, 142, 71,
+	-191, 59, 58, -174, 138, 28, 137, -78, -167, -167,
+	59, 59, -211, 58, 88, -105, -64, -212, -212, -212,
+	-212, -41, 98, 275, -212, -212, -212, 9, -85, 120,
+	59, -212, -212, -212, -68, -193, -202, -183, 88, 64,
+	150, -136, -163, 71, 28, 28, -196, -197, 161, -199,
+	-189, 59, -212, 273, 52, 276, -109, -136, 65, -64,
+	64, -212, 58, -136, -204, 41, 274, 277, 57, -197,
+	36, -201, 41, -105, 163, 275, 59, 164, 276, -207,
+	-208, 55, -211, 277, -208, 55, 10, 9, -75, 160,
+	-206, 151, 146, 149, 30, -206, -212, -212, 145, 29,
 	73,
 }
 var yyDef = [...]int{
 
 	28, -2, 2, 4, 5, 6, 7, 8, 9, 10,
 	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
-	21, 22, 23, 24, 25, 26, 27, 617, 0, 358,
-	358, 358, 358, 358, 358, 0, 687, 670, 0, 0,
-	0, 0, -2, 323, 324, 0, 326, -2, 0, 0,
-	335, 996, 996, 0, 0, 996, 0, 994, 45, 46,
-	341, 342, 343, 1, 3, 0, 362, 625, 0, 0,
-	-2, 360, 0, 670, 670, 0, 0, 74, 75, 0,
-	0, 0, 982, 0, 668, 668, 668, 688, 689, 692,
-	693, 29, 30, 31, 819, 820, 821, 822, 823, 824,
-	825, 826, 827, 828, 829, 830, 831, 832, 833, 834,
-	835, 836, 837, 838, 839, 840, 841, 842, 843, 844,
-	845, 846, 847, 848, 849, 850, 851, 852, 853, 854,
-	855, 856, 857, 858, 859, 860, 861, 862, 863, 864,
-	865, 866, 867, 868, 869, 870, 871, 872, 873, 874,
-	875, 876, 877, 878, 879, 880, 881, 882, 883, 884,
-	885, 886, 887, 888, 889, 890, 891, 892, 893, 894,
-	895, 896, 897, 898, 899, 900, 901, 902, 903, 904,
-	905, 906, 907, 908, 909, 910, 911, 912, 913, 914,
-	915, 916, 917, 918, 919, 920, 921, 922, 923, 924,
-	925, 926, 927, 928, 929, 930, 931, 932, 933, 934,
-	935, 936, 937, 938, 939, 940, 941, 942, 943, 944,
-	945, 946, 947, 948, 949, 950, 951, 952, 953, 954,
-	955, 956, 957, 958, 959, 960, 961, 962, 963, 964,
-	965, 966, 967, 968, 969, 970, 971, 972, 973, 974,
-	975, 976, 977, 978, 979, 980, 981, 983, 984, 985,
-	986, 987, 988, 989, 990, 991, 992, 993, 0, 0,
-	0, 0, 0, 671, 0, 666, 0, 666, 666, 666,
-	0, 274, 440, 696, 697, 982, 0, 0, 0, 314,
-	0, 997, 286, 0, 288, 997, 0, 997, 0, 295,
-	0, 0, 301, 997, 306, 320, 321, 308, 322, 325,
-	0, 330, 333, 0, 348, 0, 860, 340, 353, 354,
-	996, 996, 357, 32, 491, 450, 0, 456, 458, 0,
-	493, 494, 495, 496, 497, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 523, 524, 525, 526, 602,
-	603, 604, 605, 606, 607, 608, 609, 460, 461, 599,
-	0, 647, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 590, 0, 560, 560, 560, 560, 560, 560, 560,
-	560, 0, 0, 0, 0, 0, 0, 0, -2, -2,
-	617, 41, 0, 358, 363, 364, 629, 0, 0, 617,
-	995, 0, 0, -2, -2, 374, 380, 381, 382, 383,
-	359, 0, 386, 390, 0, 0, 0, 0, 0, 0,
-	54, 56, 440, 60, 0, 970, 651, -2, -2, 0,
-	0, 694, 695, -2, 832, -2, 700, 701, 702, 703,
-	704, 705, 706, 707, 708, 709, 710, 711, 712, 713,
-	714, 715, 716, 717, 718, 719, 720, 721, 722, 723,
-	724, 725, 726, 727, 728, 729, 730, 731, 732, 733,
-	734, 735, 736, 737, 738, 739, 740, 741, 742, 743,
-	744, 745, 746, 747, 748, 749, 750, 751, 752, 753,
-	754, 755, 756, 757, 758, 759, 760, 761, 762, 763,
-	764, 765, 766, 767, 768, 769, 770, 771, 772, 773,
-	774, 775, 776, 777, 778, 779, 780, 781, 782, 783,
-	784, 785, 786, 787, 788, 789, 790, 791, 792, 793,
-	794, 795, 796, 797, 798, 799, 800, 801, 802, 803,
-	804, 805, 806, 807, 808, 809, 810, 811, 812, 813,
-	814, 815, 816, 817, 818, 0, 0, 93, 0, 91,
-	0, 997, 0, 0, 0, 0, 0, 0, 997, 997,
-	997, 0, 0, 0, 0, 265, 0, 0, 0, 0,
-	0, 0, 0, 273, 0, 275, 997, 314, 278, 0,
-	0, 997, 997, 997, 0, 997, 997, 285, 998, 999,
-	0, 195, 196, 197, 289, 997, 997, 291, 0, 311,
-	309, 310, 303, 304, 0, 317, 298, 299, 302, 331,
-	334, 351, 349, 350, 352, 344, 345, 346, 347, 0,
-	355, 356, 0, 0, 0, 0, 0, 454, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 478, 479, 480,
-	481, 482, 483, 484, 457, 0, 471, 0, 0, 0,
-	513, 514, 515, 516, 517, 518, 519, 520, 521, 0,
-	371, 0, 0, 0, 617, 0, 0, 0, 0, 0,
-	0, 0, 368, 0, 591, 0, 544, 552, 0, 545,
-	553, 546, 554, 547, 0, 548, 555, 549, 556, 550,
-	551, 557, 0, 0, 0, 371, 371, 0, 0, 625,
-	0, 373, 33, 0, 0, 626, 618, 619, 622, 625,
-	0, 395, 384, 375, 378, 379, 361, 0, 387, 391,
-	0, 393, 394, 0, 58, 0, 439, 0, 397, 399,
-	400, 401, 421, 0, 423, -2, 0, 0, 0, 52,
-	53, 0, 0, 0, 0, 970, 657, 0, 62, 63,
-	0, 0, 0, 171, 661, 662, 663, 659, 220, 0,
-	0, 159, 155, 99, 100, 101, 148, 103, 148, 148,
-	148, 148, 168, 168, 168, 168, 131, 132, 133, 134,
-	135, 0, 0, 118, 148, 148, 148, 122, 138, 139,
-	140, 141, 142, 143, 144, 145, 104, 105, 106, 107,
-	108, 109, 110, 111, 112, 150, 150, 150, 152, 152,
-	690, 77, 0, 997, 0, 997, 89, 0, 234, 236,
-	237, 0, 0, 0, 0, 0, 0, 0, 0, 268,
-	667, 0, 997, 271, 272, 441, 698, 699, 276, 277,
-	315, 316, 279, 280, 281, 282, 283, 284, 0, 198,
-	199, 290, 294, 0, 314, 0, 0, 296, 297, 0,
-	0, 0, 332, 336, 337, 338, 339, 492, 451, 452,
-	453, 455, 472, 0, 474, 476, 462, 463, 487, 488,
-	489, 0, 0, 0, 0, 485, 467, 0, 498, 499,
-	500, 501, 502, 503, 504, 505, 506, 507, 508, 509,
-	512, 575, 576, 0, 510, 511, 522, 0, 0, 0,
-	372, 600, 0, -2, 0, 490, 646, 625, 0, 0,
-	0, 0, 495, 602, 0, 495, 602, 0, 0, 0,
-	369, 370, 597, 594, 0, 0, 599, 0, 561, 0,
-	0, 0, 0, 0, 0, 0, 0, 629, 42, 630,
-	0, 0, 0, 0, 621, 623, 624, 629, 0, 610,
-	0, 0, 448, 0, 0, 376, 39, 392, 388, 0,
-	0, 0, 438, 0, 0, 0, 0, 0, 0, 428,
-	0, 0, 431, 0, 0, 0, 0, 422, 0, 0,
-	0, 443, 914, 424, 0, 426, 427, 448, 0, 448,
-	55, 448, 57, 0, 442, 652, 61, 0, 0, 66,
-	67, 653, 654, 655, 656, 0, 90, 221, 223, 226,
+	21, 22, 23, 24, 25, 26, 27, 621, 0, 362,
+	362, 362, 362, 362, 362, 0, 691, 674, 0, 0,
+	0, 0, -2, 327, 328, 0, 330, -2, 0, 0,
+	339, 1004, 1004, 0, 0, 1004, 0, 1002, 45, 46,
+	345, 346, 347, 1, 3, 0, 366, 629, 0, 0,
+	-2, 364, 0, 674, 674, 0, 0, 74, 75, 0,
+	0, 0, 989, 0, 672, 672, 672, 692, 693, 696,
+	697, 29, 30, 31, 823, 824, 825, 826, 827, 828,
+	829, 830, 831, 832, 833, 834, 835, 836, 837, 838,
+	839, 840, 841, 842, 843, 844, 845, 846, 847, 848,
+	849, 850, 851, 852, 853, 854, 855, 856, 857, 858,
+	859, 860, 861, 862, 863, 864, 865, 866, 867, 868,
+	869, 870, 871, 872, 873, 874, 875, 876, 877, 878,
+	879, 880, 881, 882, 883, 884, 885, 886, 887, 888,
+	889, 890, 891, 892, 893, 894, 895, 896, 897, 898,
+	899, 900, 901, 902, 903, 904, 905, 906, 907, 908,
+	909, 910, 911, 912, 913, 914, 915, 916, 917, 918,
+	919, 920, 921, 922, 923, 924, 925, 926, 927, 928,
+	929, 930, 931, 932, 933, 934, 935, 936, 937, 938,
+	939, 940, 941, 942, 943, 944, 945, 946, 947, 948,
+	949, 950, 951, 952, 953, 954, 955, 956, 957, 958,
+	959, 960, 961, 962, 963, 964, 965, 966, 967, 968,
+	969, 970, 971, 972, 973, 974, 975, 976, 977, 978,
+	979, 980, 981, 982, 983, 984, 985, 986, 987, 988,
+	990, 991, 992, 993, 994, 995, 996, 997, 998, 999,
+	1000, 1001, 0, 0, 0, 0, 0, 0, 675, 0,
+	670, 0, 670, 670, 670, 0, 278, 444, 700, 701,
+	989, 0, 0, 0, 318, 0, 1005, 290, 0, 292,
+	1005, 0, 1005, 0, 299, 0, 0, 305, 1005, 310,
+	324, 325, 312, 326, 329, 0, 334, 337, 0, 352,
+	0, 866, 344, 357, 358, 1004, 1004, 361, 32, 495,
+	454, 0, 460, 462, 0, 497, 498, 499, 500, 501,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	527, 528, 529, 530, 606, 607, 608, 609, 610, 611,
+	612, 613, 464, 465, 603, 0, 651, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 594, 0, 564, 564,
+	564, 564, 564, 564, 564, 564, 0, 0, 0, 0,
+	0, 0, 0, -2, -2, 621, 41, 0, 362, 367,
+	368, 633, 0, 0, 621, 1003, 0, 0, -2, -2,
+	378, 384, 385, 386, 387, 363, 0, 390, 394, 0,
+	0, 0, 0, 0, 0, 54, 56, 444, 60, 0,
+	977, 655, -2, -2, 0, 0, 698, 699, -2, 837,
+	-2, 704, 705, 706, 707, 708, 709, 710, 711, 712,
+	713, 714, 715, 716, 717, 718, 719, 720, 721, 722,
+	723, 724, 725, 726, 727, 728, 729, 730, 731, 732,
+	733, 734, 735, 736, 737, 738, 739, 740, 741, 742,
+	743, 744, 745, 746, 747, 748, 749, 750, 751, 752,
+	753, 754, 755, 756, 757, 758, 759, 760, 761, 762,
+	763, 764, 765, 766, 767, 768, 769, 770, 771, 772,
+	773, 774, 775, 776, 777, 778, 779, 780, 781, 782,
+	783, 784, 785, 786, 787, 788, 789, 790, 791, 792,
+	793, 794, 795, 796, 797, 798, 799, 800, 801, 802,
+	803, 804, 805, 806, 807, 808, 809, 810, 811, 812,
+	813, 814, 815, 816, 817, 818, 819, 820, 821, 822,
+	0, 0, 93, 0, 91, 0, 1005, 0, 0, 0,
+	0, 0, 0, 1005, 1005, 1005, 0, 0, 0, 0,
+	0, 269, 0, 0, 0, 0, 0, 0, 0, 277,
+	0, 279, 1005, 318, 282, 0, 0, 1005, 1005, 1005,
+	0, 1005, 1005, 289, 1006, 1007, 0, 195, 196, 197,
+	293, 1005, 1005, 295, 0, 315, 313, 314, 307, 308,
+	0, 321, 302, 303, 306, 335, 338, 355, 353, 354,
+	356, 348, 349, 350, 351, 0, 359, 360, 0, 0,
+	0, 0, 0, 458, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 482, 483, 484, 485, 486, 487, 488,
+	461, 0, 475, 0, 0, 0, 517, 518, 519, 520,
+	521, 522, 523, 524, 525, 0, 375, 0, 0, 0,
+	621, 0, 0, 0, 0, 0, 0, 0, 372, 0,
+	595, 0, 548, 556, 0, 549, 557, 550, 558, 551,
+	0, 552, 559, 553, 560, 554, 555, 561, 0, 0,
+	0, 375, 375, 0, 0, 629, 0, 377, 33, 0,
+	0, 630, 622, 623, 626, 629, 0, 399, 388, 379,
+	382, 383, 365, 0, 391, 395, 0, 397, 398, 0,
+	58, 0, 443, 0, 401, 403, 404, 405, 425, 0,
+	427, -2, 0, 0, 0, 52, 53, 0, 0, 0,
+	0, 977, 661, 0, 62, 63, 0, 0, 0, 171,
+	665, 666, 667, 663, 220, 0, 0, 159, 155, 99,
+	100, 101, 148, 103, 148, 148, 148, 148, 168, 168,
+	168, 168, 131, 132, 133, 134, 135, 0, 0, 118,
+	148, 148, 148, 122, 138, 139, 140, 141, 142, 143,
+	144, 145, 104, 105, 106, 107, 108, 109, 110, 111,
+	112, 150, 150, 150, 152, 152, 694, 77, 0, 1005,
+	0, 1005, 89, 0, 234, 236, 237, 0, 0, 0,
+	0, 0, 0, 246, 247, 248, 249, 0, 0, 272,
+	671, 0, 1005, 275, 276, 445, 702, 703, 280, 281,
+	319, 320, 283, 284, 285, 286, 287, 288, 0, 198,
+	199, 294, 298, 0, 318, 0, 0, 300, 301, 0,
+	0, 0, 336, 340, 341, 342, 343, 496, 455, 456,
+	457, 459, 476, 0, 478, 480, 466, 467, 491, 492,
+	493, 0, 0, 0, 0, 489, 471, 0, 502, 503,
+	504, 505, 506, 507, 508, 509, 510, 511, 512, 513,
+	516, 579, 580, 0, 514, 515, 526, 0, 0, 0,
+	376, 604, 0, -2, 0, 494, 650, 629, 0, 0,
+	0, 0, 499, 606, 0, 499, 606, 0, 0, 0,
+	373, 374, 601, 598, 0, 0, 603, 0, 565, 0,
+	0, 0, 0, 0, 0, 0, 0, 633, 42, 634,
+	0, 0, 0, 0, 625, 627, 628, 633, 0, 614,
+	0, 0, 452, 0, 0, 380, 39, 396, 392, 0,
+	0, 0, 442, 0, 0, 0, 0, 0, 0, 432,
+	0, 0, 435, 0, 0, 0, 0, 426, 0, 0,
+	0, 447, 920, 428, 0, 430, 431, 452, 0, 452,
+	55, 452, 57, 0, 446, 656, 61, 0, 0, 66,
+	67, 657, 658, 659, 660, 0, 90, 221, 223, 226,
 	227, 228, 94, 95, 96, 0, 0, 208, 0, 0,
 	202, 202, 0, 200, 201, 92, 162, 160, 0, 157,
 	156, 102, 0, 168, 168, 125, 126, 171, 0, 171,
 	171, 171, 0, 0, 119, 120, 121, 113, 0, 114,
-	115, 116, 0, 117, 0, 0, 997, 79, 669, 80,
-	996, 0, 0, 682, 235, 672, 673, 674, 675, 676,
-	677, 678, 679, 680, 681, 0, 81, 239, 241, 240,
-	244, 0, 0, 0, 266, 997, 270, 311, 311, 293,
-	312, 313, 318, 300, 328, 473, 475, 477, 464, 485,
-	468, 0, 465, 0, 0, 459, 527, 0, 0, 371,
-	0, 617, 629, 531, 532, 0, 0, 0, 0, 0,
-	568, 0, 0, 569, 0, 617, 0, 595, 0, 0,
-	543, 0, 562, 0, 0, 563, 564, 565, 566, 567,
-	35, 0, 627, 628, 620, 34, 0, 664, 665, 611,
-	612, 613, 0, 385, 396, 377, 0, 640, 0, 0,
-	633, 0, 0, 448, 648, 0, 398, 417, 419, 0,
-	414, 429, 430, 432, 0, 434, 0, 436, 437, 402,
-	403, 404, 0, 405, 0, 0, 0, 0, 425, 617,
-	448, 50, 51, 0, 64, 65, 0, 0, 71, 172,
+	115, 116, 0, 117, 0, 0, 1005, 79, 673, 80,
+	1004, 0, 0, 686, 235, 676, 677, 678, 679, 680,
+	681, 682, 683, 684, 685, 0, 81, 239, 241, 240,
+	244, 0, 0, 0, 270, 1005, 274, 315, 315, 297,
+	316, 317, 322, 304, 332, 477, 479, 481, 468, 489,
+	472, 0, 469, 0, 0, 463, 531, 0, 0, 375,
+	0, 621, 633, 535, 536, 0, 0, 0, 0, 0,
+	572, 0, 0, 573, 0, 621, 0, 599, 0, 0,
+	547, 0, 566, 0, 0, 567, 568, 569, 570, 571,
+	35, 0, 631, 632, 624, 34, 0, 668, 669, 615,
+	616, 617, 0, 389, 400, 381, 0, 644, 0, 0,
+	637, 0, 0, 452, 652, 0, 402, 421, 423, 0,
+	418, 433, 434, 436, 0, 438, 0, 440, 441, 406,
+	407, 408, 0, 409, 0, 0, 0, 0, 429, 621,
+	452, 50, 51, 0, 64, 65, 0, 0, 71, 172,
 	173, 0, 224, 0, 0, 0, 190, 202, 202, 193,
 	203, 194, 0, 164, 0, 161, 98, 158, 0, 171,
 	171, 127, 0, 128, 129, 130, 0, 146, 0, 0,
-	0, 0, 691, 78, 229, 996, 246, 247, 248, 249,
-	250, 251, 252, 253, 254, 255, 256, 257, 258, 259,
-	996, 0, 996, 683, 684, 685, 686, 0, 84, 0,
-	0, 0, 0, 0, 269, 314, 314, 466, 0, 486,
-	469, 528, 529, 0, 601, 625, 37, 0, 148, 148,
-	580, 148, 152, 583, 148, 585, 148, 588, 0, 0,
-	0, 0, 0, 0, 0, 592, 542, 598, 0, 600,
-	0, 0, 631, 36, 615, 0, 449, 389, 43, 0,
-	640, 632, 642, 644, 0, 0, 636, 0, 409, 617,
-	0, 0, 411, 418, 0, 0, 412, 0, 413, 433,
-	435, -2, 0, 0, 0, 0, 625, 49, 68, 69,
+	0, 0, 695, 78, 229, 1004, 250, 251, 252, 253,
+	254, 255, 256, 257, 258, 259, 260, 261, 262, 263,
+	1004, 0, 1004, 687, 688, 689, 690, 0, 84, 0,
+	0, 0, 0, 0, 273, 318, 318, 470, 0, 490,
+	473, 532, 533, 0, 605, 629, 37, 0, 148, 148,
+	584, 148, 152, 587, 148, 589, 148, 592, 0, 0,
+	0, 0, 0, 0, 0, 596, 546, 602, 0, 604,
+	0, 0, 635, 36, 619, 0, 453, 393, 43, 0,
+	644, 636, 646, 648, 0, 0, 640, 0, 413, 621,
+	0, 0, 415, 422, 0, 0, 416, 0, 417, 437,
+	439, -2, 0, 0, 0, 0, 629, 49, 68, 69,
 	70, 222, 225, 0, 204, 148, 207, 191, 192, 0,
 	166, 0, 163, 149, 123, 124, 169, 170, 168, 0,
-	168, 0, 153, 0, 997, 230, 231, 232, 233, 0,
-	238, 0, 82, 83, 0, 0, 243, 267, 287, 292,
-	470, 530, 629, 533, 577, 168, 581, 582, 584, 586,
-	587, 589, 535, 534, 0, 0, 0, 0, 0, 625,
-	0, 596, 0, 0, 40, 0, 0, 0, 44, 0,
-	645, 0, 0, 0, 59, 0, 625, 649, 650, 415,
-	0, 420, 0, 0, 0, 423, 48, 182, 0, 206,
-	0, 407, 174, 167, 0, 171, 147, 171, 0, 0,
-	76, 0, 85, 86, 0, 0, 0, 38, 578, 579,
-	0, 0, 0, 0, 570, 0, 593, 0, 0, 616,
-	614, 0, 643, 0, 635, 638, 637, 410, 47, 0,
-	0, 445, 0, 0, 443, 181, 183, 0, 188, 0,
+	168, 0, 153, 0, 1005, 230, 231, 232, 233, 0,
+	238, 0, 82, 83, 0, 0, 243, 271, 291, 296,
+	474, 534, 633, 537, 581, 168, 585, 586, 588, 590,
+	591, 593, 539, 538, 0, 0, 0, 0, 0, 629,
+	0, 600, 0, 0, 40, 0, 0, 0, 44, 0,
+	649, 0, 0, 0, 59, 0, 629, 653, 654, 419,
+	0, 424, 0, 0, 0, 427, 48, 182, 0, 206,
+	0, 411, 174, 167, 0, 171, 147, 171, 0, 0,
+	76, 0, 85, 86, 0, 0, 0, 38, 582, 583,
+	0, 0, 0, 0, 574, 0, 597, 0, 0, 620,
+	618, 0, 647, 0, 639, 642, 641, 414, 47, 0,
+	0, 449, 0, 0, 447, 181, 183, 0, 188, 0,
 	205, 0, 0, 179, 0, 176, 178, 165, 136, 137,
-	151, 154, 0, 0, 0, 0, 245, 536, 538, 537,
-	539, 0, 0, 0, 541, 558, 559, 0, 634, 0,
-	416, 444, 446, 447, 406, 184, 185, 0, 189, 187,
-	0, 408, 97, 0, 175, 177, 0, 261, 0, 87,
-	88, 81, 540, 0, 0, 0, 641, 639, 186, 0,
-	180, 260, 0, 0, 84, 571, 0, 574, 0, 262,
-	0, 242, 572, 0, 0, 0, 209, 0, 0, 210,
-	211, 0, 0, 573, 212, 0, 0, 0, 0, 0,
-	213, 215, 216, 0, 0, 214, 263, 264, 217, 218,
+	151, 154, 0, 0, 0, 0, 245, 540, 542, 541,
+	543, 0, 0, 0, 545, 562, 563, 0, 638, 0,
+	420, 448, 450, 451, 410, 184, 185, 0, 189, 187,
+	0, 412, 97, 0, 175, 177, 0, 265, 0, 87,
+	88, 81, 544, 0, 0, 0, 645, 643, 186, 0,
+	180, 264, 0, 0, 84, 575, 0, 578, 0, 266,
+	0, 242, 576, 0, 0, 0, 209, 0, 0, 210,
+	211, 0, 0, 577, 212, 0, 0, 0, 0, 0,
+	213, 215, 216, 0, 0, 214, 267, 268, 217, 218,
 	219,
 }
 var yyTok1 = [...]int{
@@ -3391,7 +3366,7 @@ var yyTok1 = [...]int{
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 81, 3, 3, 3, 108, 100, 3,
 	57, 59, 105, 103, 58, 104, 120, 106, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 364,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 368,
 	89, 88, 90, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
@@ -3447,7 +3422,8 @@ var yyTok3 = [...]int{
 	57670, 345, 57671, 346, 57672, 347, 57673, 348, 57674, 349,
 	57675, 350, 57676, 351, 57677, 352, 57678, 353, 57679, 354,
 	57680, 355, 57681, 356, 57682, 357, 57683, 358, 57684, 359,
-	57685, 360, 57686, 361, 57687, 362, 57688, 363, 0,
+	57685, 360, 57686, 361, 57687, 362, 57688, 363, 57689, 364,
+	57690, 365, 57691, 366, 57692, 367, 0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -3789,59 +3765,59 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:337
+//line sql.y:340
 		{
 			setParseTree(yylex, yyDollar[1].statement)
 		}
 	case 2:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:342
+//line sql.y:345
 		{
 		}
 	case 3:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:343
+//line sql.y:346
 		{
 		}
 	case 4:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:347
+//line sql.y:350
 		{
 			yyVAL.statement = yyDollar[1].selStmt
 		}
 	case 28:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:374
+//line sql.y:377
 		{
 			setParseTree(yylex, nil)
 		}
 	case 29:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:380
+//line sql.y:383
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].bytes), NoAt)
 		}
 	case 30:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:384
+//line sql.y:387
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].bytes), SingleAt)
 		}
 	case 31:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:388
+//line sql.y:391
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].bytes), DoubleAt)
 		}
 	case 32:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:394
+//line sql.y:397
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
 	case 33:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:400
+//line sql.y:403
 		{
 			sel := yyDollar[1].selStmt.(*Select)
 			sel.OrderBy = yyDollar[2].orderBy
@@ -3851,25 +3827,25 @@ yydefault:
 		}
 	case 34:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:408
+//line sql.y:411
 		{
 			yyVAL.selStmt = &Union{FirstStatement: &ParenSelect{Select: yyDollar[2].selStmt}, OrderBy: yyDollar[4].orderBy, Limit: yyDollar[5].limit, Lock: yyDollar[6].str}
 		}
 	case 35:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:412
+//line sql.y:415
 		{
 			yyVAL.selStmt = Unionize(yyDollar[1].selStmt, yyDollar[3].selStmt, yyDollar[2].str, yyDollar[4].orderBy, yyDollar[5].limit, yyDollar[6].str)
 		}
 	case 36:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:416
+//line sql.y:419
 		{
 			yyVAL.selStmt = NewSelect(Comments(yyDollar[2].bytes2), SelectExprs{Nextval{Expr: yyDollar[5].expr}}, []string{yyDollar[3].str} /*options*/, TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}}, nil /*where*/, nil /*groupBy*/, nil /*having*/)
 		}
 	case 37:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:439
+//line sql.y:442
 		{
 			sel := yyDollar[1].selStmt.(*Select)
 			sel.OrderBy = yyDollar[2].orderBy
@@ -3879,37 +3855,37 @@ yydefault:
 		}
 	case 38:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:447
+//line sql.y:450
 		{
 			yyVAL.selStmt = Unionize(yyDollar[1].selStmt, yyDollar[3].selStmt, yyDollar[2].str, yyDollar[4].orderBy, yyDollar[5].limit, yyDollar[6].str)
 		}
 	case 39:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:453
+//line sql.y:456
 		{
 			yyVAL.statement = &Stream{Comments: Comments(yyDollar[2].bytes2), SelectExpr: yyDollar[3].selectExpr, Table: yyDollar[5].tableName}
 		}
 	case 40:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:461
+//line sql.y:464
 		{
 			yyVAL.selStmt = NewSelect(Comments(yyDollar[2].bytes2), yyDollar[4].selectExprs /*SelectExprs*/, yyDollar[3].strs /*options*/, yyDollar[5].tableExprs /*from*/, NewWhere(WhereStr, yyDollar[6].expr), GroupBy(yyDollar[7].exprs), NewWhere(HavingStr, yyDollar[8].expr))
 		}
 	case 41:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:467
+//line sql.y:470
 		{
 			yyVAL.selStmt = yyDollar[1].selStmt
 		}
 	case 42:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:471
+//line sql.y:474
 		{
 			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
 		}
 	case 43:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:478
+//line sql.y:481
 		{
 			// insert_data returns a *Insert pre-filled with Columns & Values
 			ins := yyDollar[6].ins
@@ -3923,7 +3899,7 @@ yydefault:
 		}
 	case 44:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:490
+//line sql.y:493
 		{
 			cols := make(Columns, 0, len(yyDollar[7].updateExprs))
 			vals := make(ValTuple, 0, len(yyDollar[8].updateExprs))
@@ -3935,186 +3911,186 @@ yydefault:
 		}
 	case 45:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:502
+//line sql.y:505
 		{
 			yyVAL.str = InsertStr
 		}
 	case 46:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:506
+//line sql.y:509
 		{
 			yyVAL.str = ReplaceStr
 		}
 	case 47:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:512
+//line sql.y:515
 		{
 			yyVAL.statement = &Update{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, TableExprs: yyDollar[4].tableExprs, Exprs: yyDollar[6].updateExprs, Where: NewWhere(WhereStr, yyDollar[7].expr), OrderBy: yyDollar[8].orderBy, Limit: yyDollar[9].limit}
 		}
 	case 48:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:518
+//line sql.y:521
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[4].tableName}}, Partitions: yyDollar[5].partitions, Where: NewWhere(WhereStr, yyDollar[6].expr), OrderBy: yyDollar[7].orderBy, Limit: yyDollar[8].limit}
 		}
 	case 49:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:522
+//line sql.y:525
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[4].tableNames, TableExprs: yyDollar[6].tableExprs, Where: NewWhere(WhereStr, yyDollar[7].expr)}
 		}
 	case 50:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:526
+//line sql.y:529
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[3].tableNames, TableExprs: yyDollar[5].tableExprs, Where: NewWhere(WhereStr, yyDollar[6].expr)}
 		}
 	case 51:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:530
+//line sql.y:533
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[3].tableNames, TableExprs: yyDollar[5].tableExprs, Where: NewWhere(WhereStr, yyDollar[6].expr)}
 		}
 	case 52:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:535
+//line sql.y:538
 		{
 		}
 	case 53:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:536
+//line sql.y:539
 		{
 		}
 	case 54:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:540
+//line sql.y:543
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
 		}
 	case 55:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:544
+//line sql.y:547
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
 		}
 	case 56:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:550
+//line sql.y:553
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
 		}
 	case 57:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:554
+//line sql.y:557
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
 		}
 	case 58:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:559
+//line sql.y:562
 		{
 			yyVAL.partitions = nil
 		}
 	case 59:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:563
+//line sql.y:566
 		{
 			yyVAL.partitions = yyDollar[3].partitions
 		}
 	case 60:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:569
+//line sql.y:572
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[3].setExprs}
 		}
 	case 61:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:575
+//line sql.y:578
 		{
 			yyVAL.statement = &SetTransaction{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Characteristics: yyDollar[5].characteristics}
 		}
 	case 62:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:579
+//line sql.y:582
 		{
 			yyVAL.statement = &SetTransaction{Comments: Comments(yyDollar[2].bytes2), Characteristics: yyDollar[4].characteristics}
 		}
 	case 63:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:585
+//line sql.y:588
 		{
 			yyVAL.characteristics = []Characteristic{yyDollar[1].characteristic}
 		}
 	case 64:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:589
+//line sql.y:592
 		{
 			yyVAL.characteristics = append(yyVAL.characteristics, yyDollar[3].characteristic)
 		}
 	case 65:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:595
+//line sql.y:598
 		{
 			yyVAL.characteristic = &IsolationLevel{Level: string(yyDollar[3].str)}
 		}
 	case 66:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:599
+//line sql.y:602
 		{
 			yyVAL.characteristic = &AccessMode{Mode: TxReadWrite}
 		}
 	case 67:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:603
+//line sql.y:606
 		{
 			yyVAL.characteristic = &AccessMode{Mode: TxReadOnly}
 		}
 	case 68:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:609
+//line sql.y:612
 		{
 			yyVAL.str = RepeatableRead
 		}
 	case 69:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:613
+//line sql.y:616
 		{
 			yyVAL.str = ReadCommitted
 		}
 	case 70:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:617
+//line sql.y:620
 		{
 			yyVAL.str = ReadUncommitted
 		}
 	case 71:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:621
+//line sql.y:624
 		{
 			yyVAL.str = Serializable
 		}
 	case 72:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:627
+//line sql.y:630
 		{
 			yyVAL.str = SessionStr
 		}
 	case 73:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:631
+//line sql.y:634
 		{
 			yyVAL.str = GlobalStr
 		}
 	case 74:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:637
+//line sql.y:640
 		{
 			yyDollar[1].ddl.TableSpec = yyDollar[2].TableSpec
 			yyVAL.statement = yyDollar[1].ddl
 		}
 	case 75:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:642
+//line sql.y:645
 		{
 			// Create table [name] like [name]
 			yyDollar[1].ddl.OptLike = yyDollar[2].optLike
@@ -4122,139 +4098,139 @@ yydefault:
 		}
 	case 76:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:648
+//line sql.y:651
 		{
 			// Change this to an alter statement
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[7].tableName}
 		}
 	case 77:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:653
+//line sql.y:656
 		{
 			yyVAL.statement = &DDL{Action: CreateStr, Table: yyDollar[3].tableName.ToViewName()}
 		}
 	case 78:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:657
+//line sql.y:660
 		{
 			yyVAL.statement = &DDL{Action: CreateStr, Table: yyDollar[5].tableName.ToViewName()}
 		}
 	case 79:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:661
+//line sql.y:664
 		{
 			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].colIdent.String())}
 		}
 	case 80:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:665
+//line sql.y:668
 		{
 			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].colIdent.String())}
 		}
 	case 81:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:670
+//line sql.y:673
 		{
 			yyVAL.colIdent = NewColIdent("")
 		}
 	case 82:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:674
+//line sql.y:677
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
 	case 83:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:680
+//line sql.y:683
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
 	case 84:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:685
+//line sql.y:688
 		{
 			var v []VindexParam
 			yyVAL.vindexParams = v
 		}
 	case 85:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:690
+//line sql.y:693
 		{
 			yyVAL.vindexParams = yyDollar[2].vindexParams
 		}
 	case 86:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:696
+//line sql.y:699
 		{
 			yyVAL.vindexParams = make([]VindexParam, 0, 4)
 			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[1].vindexParam)
 		}
 	case 87:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:701
+//line sql.y:704
 		{
 			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[3].vindexParam)
 		}
 	case 88:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:707
+//line sql.y:710
 		{
 			yyVAL.vindexParam = VindexParam{Key: yyDollar[1].colIdent, Val: yyDollar[3].str}
 		}
 	case 89:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:713
+//line sql.y:716
 		{
 			yyVAL.ddl = &DDL{Action: CreateStr, Table: yyDollar[4].tableName}
 			setDDL(yylex, yyVAL.ddl)
 		}
 	case 90:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:720
+//line sql.y:723
 		{
 			yyVAL.TableSpec = yyDollar[2].TableSpec
 			yyVAL.TableSpec.Options = yyDollar[4].str
 		}
 	case 91:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:727
+//line sql.y:730
 		{
 			yyVAL.optLike = &OptLike{LikeTable: yyDollar[2].tableName}
 		}
 	case 92:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:731
+//line sql.y:734
 		{
 			yyVAL.optLike = &OptLike{LikeTable: yyDollar[3].tableName}
 		}
 	case 93:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:737
+//line sql.y:740
 		{
 			yyVAL.TableSpec = &TableSpec{}
 			yyVAL.TableSpec.AddColumn(yyDollar[1].columnDefinition)
 		}
 	case 94:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:742
+//line sql.y:745
 		{
 			yyVAL.TableSpec.AddColumn(yyDollar[3].columnDefinition)
 		}
 	case 95:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:746
+//line sql.y:749
 		{
 			yyVAL.TableSpec.AddIndex(yyDollar[3].indexDefinition)
 		}
 	case 96:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:750
+//line sql.y:753
 		{
 			yyVAL.TableSpec.AddConstraint(yyDollar[3].constraintDefinition)
 		}
 	case 97:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:756
+//line sql.y:759
 		{
 			yyDollar[2].columnType.NotNull = yyDollar[3].boolVal
 			yyDollar[2].columnType.Default = yyDollar[4].optVal
@@ -4266,7 +4242,7 @@ yydefault:
 		}
 	case 98:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:767
+//line sql.y:770
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Unsigned = yyDollar[2].boolVal
@@ -4274,74 +4250,74 @@ yydefault:
 		}
 	case 102:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:778
+//line sql.y:781
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Length = yyDollar[2].literal
 		}
 	case 103:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:783
+//line sql.y:786
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 		}
 	case 104:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:789
+//line sql.y:792
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 105:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:793
+//line sql.y:796
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 106:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:797
+//line sql.y:800
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 107:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:801
+//line sql.y:804
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 108:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:805
+//line sql.y:808
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 109:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:809
+//line sql.y:812
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 110:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:813
+//line sql.y:816
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 111:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:817
+//line sql.y:820
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 112:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:821
+//line sql.y:824
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 113:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:827
+//line sql.y:830
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -4349,7 +4325,7 @@ yydefault:
 		}
 	case 114:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:833
+//line sql.y:836
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -4357,7 +4333,7 @@ yydefault:
 		}
 	case 115:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:839
+//line sql.y:842
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -4365,7 +4341,7 @@ yydefault:
 		}
 	case 116:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:845
+//line sql.y:848
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -4373,7 +4349,7 @@ yydefault:
 		}
 	case 117:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:851
+//line sql.y:854
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -4381,206 +4357,206 @@ yydefault:
 		}
 	case 118:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:859
+//line sql.y:862
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 119:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:863
+//line sql.y:866
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
 	case 120:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:867
+//line sql.y:870
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
 	case 121:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:871
+//line sql.y:874
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
 	case 122:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:875
+//line sql.y:878
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 123:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:881
+//line sql.y:884
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
 	case 124:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:885
+//line sql.y:888
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
 	case 125:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:889
+//line sql.y:892
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
 	case 126:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:893
+//line sql.y:896
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
 	case 127:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:897
+//line sql.y:900
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
 	case 128:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:901
+//line sql.y:904
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
 	case 129:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:905
+//line sql.y:908
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
 	case 130:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:909
+//line sql.y:912
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
 	case 131:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:913
+//line sql.y:916
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 132:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:917
+//line sql.y:920
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 133:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:921
+//line sql.y:924
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 134:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:925
+//line sql.y:928
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 135:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:929
+//line sql.y:932
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 136:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:933
+//line sql.y:936
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
 	case 137:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:938
+//line sql.y:941
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
 	case 138:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:944
+//line sql.y:947
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 139:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:948
+//line sql.y:951
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 140:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:952
+//line sql.y:955
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 141:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:956
+//line sql.y:959
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 142:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:960
+//line sql.y:963
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 143:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:964
+//line sql.y:967
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 144:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:968
+//line sql.y:971
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 145:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:972
+//line sql.y:975
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 146:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:978
+//line sql.y:981
 		{
 			yyVAL.strs = make([]string, 0, 4)
 			yyVAL.strs = append(yyVAL.strs, "'"+string(yyDollar[1].bytes)+"'")
 		}
 	case 147:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:983
+//line sql.y:986
 		{
 			yyVAL.strs = append(yyDollar[1].strs, "'"+string(yyDollar[3].bytes)+"'")
 		}
 	case 148:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:988
+//line sql.y:991
 		{
 			yyVAL.literal = nil
 		}
 	case 149:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:992
+//line sql.y:995
 		{
 			yyVAL.literal = NewIntLiteral(yyDollar[2].bytes)
 		}
 	case 150:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:997
+//line sql.y:1000
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
 	case 151:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1001
+//line sql.y:1004
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].bytes),
@@ -4589,13 +4565,13 @@ yydefault:
 		}
 	case 152:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1009
+//line sql.y:1012
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
 	case 153:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1013
+//line sql.y:1016
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].bytes),
@@ -4603,7 +4579,7 @@ yydefault:
 		}
 	case 154:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1019
+//line sql.y:1022
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].bytes),
@@ -4612,508 +4588,508 @@ yydefault:
 		}
 	case 155:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1027
+//line sql.y:1030
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
 	case 156:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1031
+//line sql.y:1034
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
 	case 157:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1036
+//line sql.y:1039
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
 	case 158:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1040
+//line sql.y:1043
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
 	case 159:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1046
+//line sql.y:1049
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
 	case 160:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1050
+//line sql.y:1053
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
 	case 161:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1054
+//line sql.y:1057
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
 	case 162:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1059
+//line sql.y:1062
 		{
 			yyVAL.optVal = nil
 		}
 	case 163:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1063
+//line sql.y:1066
 		{
 			yyVAL.optVal = yyDollar[2].expr
 		}
 	case 164:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1068
+//line sql.y:1071
 		{
 			yyVAL.optVal = nil
 		}
 	case 165:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1072
+//line sql.y:1075
 		{
 			yyVAL.optVal = yyDollar[3].expr
 		}
 	case 166:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1077
+//line sql.y:1080
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
 	case 167:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1081
+//line sql.y:1084
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
 	case 168:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1086
+//line sql.y:1089
 		{
 			yyVAL.str = ""
 		}
 	case 169:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1090
+//line sql.y:1093
 		{
 			yyVAL.str = string(yyDollar[3].colIdent.String())
 		}
 	case 170:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1094
+//line sql.y:1097
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
 	case 171:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1099
+//line sql.y:1102
 		{
 			yyVAL.str = ""
 		}
 	case 172:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1103
+//line sql.y:1106
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
 	case 173:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1107
+//line sql.y:1110
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
 	case 174:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1112
+//line sql.y:1115
 		{
 			yyVAL.colKeyOpt = colKeyNone
 		}
 	case 175:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1116
+//line sql.y:1119
 		{
 			yyVAL.colKeyOpt = colKeyPrimary
 		}
 	case 176:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1120
+//line sql.y:1123
 		{
 			yyVAL.colKeyOpt = colKey
 		}
 	case 177:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1124
+//line sql.y:1127
 		{
 			yyVAL.colKeyOpt = colKeyUniqueKey
 		}
 	case 178:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1128
+//line sql.y:1131
 		{
 			yyVAL.colKeyOpt = colKeyUnique
 		}
 	case 179:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1133
+//line sql.y:1136
 		{
 			yyVAL.literal = nil
 		}
 	case 180:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1137
+//line sql.y:1140
 		{
 			yyVAL.literal = NewStrLiteral(yyDollar[2].bytes)
 		}
 	case 181:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1143
+//line sql.y:1146
 		{
 			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns, Options: yyDollar[5].indexOptions}
 		}
 	case 182:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1147
+//line sql.y:1150
 		{
 			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns}
 		}
 	case 183:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1153
+//line sql.y:1156
 		{
 			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption}
 		}
 	case 184:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1157
+//line sql.y:1160
 		{
 			yyVAL.indexOptions = append(yyVAL.indexOptions, yyDollar[2].indexOption)
 		}
 	case 185:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1163
+//line sql.y:1166
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Using: string(yyDollar[2].colIdent.String())}
 		}
 	case 186:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1167
+//line sql.y:1170
 		{
 			// should not be string
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
 	case 187:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1172
+//line sql.y:1175
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewStrLiteral(yyDollar[2].bytes)}
 		}
 	case 188:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1178
+//line sql.y:1181
 		{
 			yyVAL.str = ""
 		}
 	case 189:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1182
+//line sql.y:1185
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 190:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1188
+//line sql.y:1191
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
 		}
 	case 191:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1192
+//line sql.y:1195
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Spatial: true, Unique: false}
 		}
 	case 192:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1196
+//line sql.y:1199
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Unique: true}
 		}
 	case 193:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1200
+//line sql.y:1203
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes), Name: NewColIdent(yyDollar[2].str), Unique: true}
 		}
 	case 194:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1204
+//line sql.y:1207
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(yyDollar[2].str), Unique: false}
 		}
 	case 195:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1210
+//line sql.y:1213
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 196:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1214
+//line sql.y:1217
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 197:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1218
+//line sql.y:1221
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 198:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1225
+//line sql.y:1228
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 199:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1229
+//line sql.y:1232
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 200:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1235
+//line sql.y:1238
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 201:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1239
+//line sql.y:1242
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 202:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1244
+//line sql.y:1247
 		{
 			yyVAL.str = ""
 		}
 	case 203:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1248
+//line sql.y:1251
 		{
 			yyVAL.str = string(yyDollar[1].colIdent.String())
 		}
 	case 204:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1254
+//line sql.y:1257
 		{
 			yyVAL.indexColumns = []*IndexColumn{yyDollar[1].indexColumn}
 		}
 	case 205:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1258
+//line sql.y:1261
 		{
 			yyVAL.indexColumns = append(yyVAL.indexColumns, yyDollar[3].indexColumn)
 		}
 	case 206:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1264
+//line sql.y:1267
 		{
 			yyVAL.indexColumn = &IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].literal}
 		}
 	case 207:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1270
+//line sql.y:1273
 		{
 			yyVAL.constraintDefinition = &ConstraintDefinition{Name: string(yyDollar[2].colIdent.String()), Details: yyDollar[3].constraintInfo}
 		}
 	case 208:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1274
+//line sql.y:1277
 		{
 			yyVAL.constraintDefinition = &ConstraintDefinition{Details: yyDollar[1].constraintInfo}
 		}
 	case 209:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:1281
+//line sql.y:1284
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns}
 		}
 	case 210:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:1285
+//line sql.y:1288
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction}
 		}
 	case 211:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:1289
+//line sql.y:1292
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnUpdate: yyDollar[11].ReferenceAction}
 		}
 	case 212:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:1293
+//line sql.y:1296
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction, OnUpdate: yyDollar[12].ReferenceAction}
 		}
 	case 213:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1299
+//line sql.y:1302
 		{
 			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
 		}
 	case 214:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1305
+//line sql.y:1308
 		{
 			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
 		}
 	case 215:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1311
+//line sql.y:1314
 		{
 			yyVAL.ReferenceAction = Restrict
 		}
 	case 216:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1315
+//line sql.y:1318
 		{
 			yyVAL.ReferenceAction = Cascade
 		}
 	case 217:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1319
+//line sql.y:1322
 		{
 			yyVAL.ReferenceAction = NoAction
 		}
 	case 218:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1323
+//line sql.y:1326
 		{
 			yyVAL.ReferenceAction = SetDefault
 		}
 	case 219:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1327
+//line sql.y:1330
 		{
 			yyVAL.ReferenceAction = SetNull
 		}
 	case 220:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1332
+//line sql.y:1335
 		{
 			yyVAL.str = ""
 		}
 	case 221:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1336
+//line sql.y:1339
 		{
 			yyVAL.str = " " + string(yyDollar[1].str)
 		}
 	case 222:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1340
+//line sql.y:1343
 		{
 			yyVAL.str = string(yyDollar[1].str) + ", " + string(yyDollar[3].str)
 		}
 	case 223:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1348
+//line sql.y:1351
 		{
 			yyVAL.str = yyDollar[1].str
 		}
 	case 224:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1352
+//line sql.y:1355
 		{
 			yyVAL.str = yyDollar[1].str + " " + yyDollar[2].str
 		}
 	case 225:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1356
+//line sql.y:1359
 		{
 			yyVAL.str = yyDollar[1].str + "=" + yyDollar[3].str
 		}
 	case 226:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1362
+//line sql.y:1365
 		{
 			yyVAL.str = yyDollar[1].colIdent.String()
 		}
 	case 227:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1366
+//line sql.y:1369
 		{
 			yyVAL.str = "'" + string(yyDollar[1].bytes) + "'"
 		}
 	case 228:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1370
+//line sql.y:1373
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 229:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1376
+//line sql.y:1379
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
 	case 230:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1380
+//line sql.y:1383
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
 	case 231:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1384
+//line sql.y:1387
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
 	case 232:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1388
+//line sql.y:1391
 		{
 			// Change this to a rename statement
 			yyVAL.statement = &DDL{Action: RenameStr, FromTables: TableNames{yyDollar[4].tableName}, ToTables: TableNames{yyDollar[7].tableName}}
 		}
 	case 233:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1393
+//line sql.y:1396
 		{
 			// Rename an index can just be an alter
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
 	case 234:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1398
+//line sql.y:1401
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName.ToViewName()}
 		}
 	case 235:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1402
+//line sql.y:1405
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, PartitionSpec: yyDollar[5].partSpec}
 		}
 	case 236:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1406
+//line sql.y:1409
 		{
 			yyVAL.statement = &DBDDL{Action: AlterStr, DBName: string(yyDollar[3].colIdent.String())}
 		}
 	case 237:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1410
+//line sql.y:1413
 		{
 			yyVAL.statement = &DBDDL{Action: AlterStr, DBName: string(yyDollar[3].colIdent.String())}
 		}
 	case 238:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1414
+//line sql.y:1417
 		{
 			yyVAL.statement = &DDL{
 				Action: CreateVindexStr,
@@ -5127,7 +5103,7 @@ yydefault:
 		}
 	case 239:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1426
+//line sql.y:1429
 		{
 			yyVAL.statement = &DDL{
 				Action: DropVindexStr,
@@ -5139,19 +5115,19 @@ yydefault:
 		}
 	case 240:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1436
+//line sql.y:1439
 		{
 			yyVAL.statement = &DDL{Action: AddVschemaTableStr, Table: yyDollar[5].tableName}
 		}
 	case 241:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1440
+//line sql.y:1443
 		{
 			yyVAL.statement = &DDL{Action: DropVschemaTableStr, Table: yyDollar[5].tableName}
 		}
 	case 242:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:1444
+//line sql.y:1447
 		{
 			yyVAL.statement = &DDL{
 				Action: AddColVindexStr,
@@ -5166,7 +5142,7 @@ yydefault:
 		}
 	case 243:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1457
+//line sql.y:1460
 		{
 			yyVAL.statement = &DDL{
 				Action: DropColVindexStr,
@@ -5178,13 +5154,13 @@ yydefault:
 		}
 	case 244:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1467
+//line sql.y:1470
 		{
 			yyVAL.statement = &DDL{Action: AddSequenceStr, Table: yyDollar[5].tableName}
 		}
 	case 245:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:1471
+//line sql.y:1474
 		{
 			yyVAL.statement = &DDL{
 				Action: AddAutoIncStr,
@@ -5195,59 +5171,83 @@ yydefault:
 				},
 			}
 		}
-	case 260:
+	case 246:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1485
+		{
+			yyVAL.statement = &AlterMigration{UUID: string(yyDollar[3].bytes), Type: yyDollar[4].str}
+		}
+	case 247:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1491
+		{
+			yyVAL.str = CancelMigrationStr
+		}
+	case 248:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1495
+		{
+			yyVAL.str = CutOverMigrationStr
+		}
+	case 249:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1499
+		{
+			yyVAL.str = RetryMigrationStr
+		}
+	case 264:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1500
+//line sql.y:1521
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: ReorganizeStr, Name: yyDollar[3].colIdent, Definitions: yyDollar[6].partDefs}
 		}
-	case 261:
+	case 265:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1506
+//line sql.y:1527
 		{
 			yyVAL.partDefs = []*PartitionDefinition{yyDollar[1].partDef}
 		}
-	case 262:
+	case 266:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1510
+//line sql.y:1531
 		{
 			yyVAL.partDefs = append(yyDollar[1].partDefs, yyDollar[3].partDef)
 		}
-	case 263:
+	case 267:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:1516
+//line sql.y:1537
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[7].expr}
 		}
-	case 264:
+	case 268:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:1520
+//line sql.y:1541
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true}
 		}
-	case 265:
+	case 269:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1526
+//line sql.y:1547
 		{
 			yyVAL.statement = yyDollar[3].ddl
 		}
-	case 266:
+	case 270:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1532
+//line sql.y:1553
 		{
 			yyVAL.ddl = &DDL{Action: RenameStr, FromTables: TableNames{yyDollar[1].tableName}, ToTables: TableNames{yyDollar[3].tableName}}
 		}
-	case 267:
+	case 271:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1536
+//line sql.y:1557
 		{
 			yyVAL.ddl = yyDollar[1].ddl
 			yyVAL.ddl.FromTables = append(yyVAL.ddl.FromTables, yyDollar[3].tableName)
 			yyVAL.ddl.ToTables = append(yyVAL.ddl.ToTables, yyDollar[5].tableName)
 		}
-	case 268:
+	case 272:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1544
+//line sql.y:1565
 		{
 			var exists bool
 			if yyDollar[3].byt != 0 {
@@ -5255,16 +5255,16 @@ yydefault:
 			}
 			yyVAL.statement = &DDL{Action: DropStr, FromTables: yyDollar[4].tableNames, IfExists: exists}
 		}
-	case 269:
+	case 273:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1552
+//line sql.y:1573
 		{
 			// Change this to an alter statement
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[5].tableName}
 		}
-	case 270:
+	case 274:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1557
+//line sql.y:1578
 		{
 			var exists bool
 			if yyDollar[3].byt != 0 {
@@ -5272,145 +5272,145 @@ yydefault:
 			}
 			yyVAL.statement = &DDL{Action: DropStr, FromTables: TableNames{yyDollar[4].tableName.ToViewName()}, IfExists: exists}
 		}
-	case 271:
+	case 275:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1565
+//line sql.y:1586
 		{
 			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].colIdent.String())}
 		}
-	case 272:
+	case 276:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1569
+//line sql.y:1590
 		{
 			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].colIdent.String())}
 		}
-	case 273:
+	case 277:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1575
+//line sql.y:1596
 		{
 			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[3].tableName}
 		}
-	case 274:
+	case 278:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1579
+//line sql.y:1600
 		{
 			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[2].tableName}
 		}
-	case 275:
+	case 279:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1584
+//line sql.y:1605
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 276:
+	case 280:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1590
+//line sql.y:1611
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].colIdent.String())}
 		}
-	case 277:
+	case 281:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1595
+//line sql.y:1616
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[4].showFilter}
 			yyVAL.statement = &Show{Type: CharsetStr, ShowTablesOpt: showTablesOpt}
 		}
-	case 278:
+	case 282:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1600
+//line sql.y:1621
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[3].showFilter}
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), ShowTablesOpt: showTablesOpt}
 		}
-	case 279:
+	case 283:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1605
+//line sql.y:1626
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 280:
+	case 284:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1610
+//line sql.y:1631
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].colIdent.String())}
 		}
-	case 281:
+	case 285:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1614
+//line sql.y:1635
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 282:
+	case 286:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1618
+//line sql.y:1639
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Table: yyDollar[4].tableName}
 		}
-	case 283:
+	case 287:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1622
+//line sql.y:1643
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 284:
+	case 288:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1626
+//line sql.y:1647
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 285:
+	case 289:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1630
+//line sql.y:1651
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 286:
+	case 290:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1634
+//line sql.y:1655
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 287:
+	case 291:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1638
+//line sql.y:1659
 		{
 			showTablesOpt := &ShowTablesOpt{DbName: yyDollar[6].str, Filter: yyDollar[7].showFilter}
 			yyVAL.statement = &Show{Extended: string(yyDollar[2].str), Type: string(yyDollar[3].str), ShowTablesOpt: showTablesOpt, OnTable: yyDollar[5].tableName}
 		}
-	case 288:
+	case 292:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1643
+//line sql.y:1664
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 289:
+	case 293:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1647
+//line sql.y:1668
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 290:
+	case 294:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1651
+//line sql.y:1672
 		{
 			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
 		}
-	case 291:
+	case 295:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1655
+//line sql.y:1676
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 292:
+	case 296:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1659
+//line sql.y:1680
 		{
 			showTablesOpt := &ShowTablesOpt{Full: yyDollar[2].str, DbName: yyDollar[6].str, Filter: yyDollar[7].showFilter}
 			yyVAL.statement = &Show{Type: string(yyDollar[3].str), ShowTablesOpt: showTablesOpt, OnTable: yyDollar[5].tableName}
 		}
-	case 293:
+	case 297:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1664
+//line sql.y:1685
 		{
 			// this is ugly, but I couldn't find a better way for now
 			if yyDollar[3].str == "processlist" {
@@ -5420,845 +5420,845 @@ yydefault:
 				yyVAL.statement = &Show{Type: yyDollar[3].str, ShowTablesOpt: showTablesOpt}
 			}
 		}
-	case 294:
+	case 298:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1674
+//line sql.y:1695
 		{
 			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
 		}
-	case 295:
+	case 299:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1678
+//line sql.y:1699
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 296:
+	case 300:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1682
+//line sql.y:1703
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), ShowCollationFilterOpt: yyDollar[4].expr}
 		}
-	case 297:
+	case 301:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1686
+//line sql.y:1707
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[4].showFilter}
 			yyVAL.statement = &Show{Scope: string(yyDollar[2].bytes), Type: string(yyDollar[3].bytes), ShowTablesOpt: showTablesOpt}
 		}
-	case 298:
+	case 302:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1691
+//line sql.y:1712
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 299:
+	case 303:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1695
+//line sql.y:1716
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 300:
+	case 304:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1699
+//line sql.y:1720
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), OnTable: yyDollar[5].tableName}
 		}
-	case 301:
+	case 305:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1703
+//line sql.y:1724
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 302:
+	case 306:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1717
+//line sql.y:1738
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].colIdent.String())}
 		}
-	case 303:
+	case 307:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1723
+//line sql.y:1744
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 304:
+	case 308:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1727
+//line sql.y:1748
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 305:
+	case 309:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1733
+//line sql.y:1754
 		{
 			yyVAL.str = ""
 		}
-	case 306:
+	case 310:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1737
+//line sql.y:1758
 		{
 			yyVAL.str = "extended "
 		}
-	case 307:
+	case 311:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1743
+//line sql.y:1764
 		{
 			yyVAL.str = ""
 		}
-	case 308:
+	case 312:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1747
+//line sql.y:1768
 		{
 			yyVAL.str = "full "
 		}
-	case 309:
+	case 313:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1753
+//line sql.y:1774
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 310:
+	case 314:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1757
+//line sql.y:1778
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 311:
+	case 315:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1763
+//line sql.y:1784
 		{
 			yyVAL.str = ""
 		}
-	case 312:
+	case 316:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1767
+//line sql.y:1788
 		{
 			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 313:
+	case 317:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1771
+//line sql.y:1792
 		{
 			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 314:
+	case 318:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1777
+//line sql.y:1798
 		{
 			yyVAL.showFilter = nil
 		}
-	case 315:
+	case 319:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1781
+//line sql.y:1802
 		{
 			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
 		}
-	case 316:
+	case 320:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1785
+//line sql.y:1806
 		{
 			yyVAL.showFilter = &ShowFilter{Filter: yyDollar[2].expr}
 		}
-	case 317:
+	case 321:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1791
+//line sql.y:1812
 		{
 			yyVAL.showFilter = nil
 		}
-	case 318:
+	case 322:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1795
+//line sql.y:1816
 		{
 			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
 		}
-	case 319:
+	case 323:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1801
+//line sql.y:1822
 		{
 			yyVAL.str = ""
 		}
-	case 320:
+	case 324:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1805
+//line sql.y:1826
 		{
 			yyVAL.str = SessionStr
 		}
-	case 321:
+	case 325:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1809
+//line sql.y:1830
 		{
 			yyVAL.str = GlobalStr
 		}
-	case 322:
+	case 326:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1815
+//line sql.y:1836
 		{
 			yyVAL.statement = &Use{DBName: yyDollar[2].tableIdent}
 		}
-	case 323:
+	case 327:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1819
+//line sql.y:1840
 		{
 			yyVAL.statement = &Use{DBName: TableIdent{v: ""}}
 		}
-	case 324:
+	case 328:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1825
+//line sql.y:1846
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 325:
+	case 329:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1829
+//line sql.y:1850
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 326:
+	case 330:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1835
+//line sql.y:1856
 		{
 			yyVAL.statement = &Commit{}
 		}
-	case 327:
+	case 331:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1841
+//line sql.y:1862
 		{
 			yyVAL.statement = &Rollback{}
 		}
-	case 328:
+	case 332:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1845
+//line sql.y:1866
 		{
 			yyVAL.statement = &SRollback{Name: yyDollar[5].colIdent}
 		}
-	case 329:
+	case 333:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1850
+//line sql.y:1871
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 330:
+	case 334:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1852
+//line sql.y:1873
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 331:
+	case 335:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1855
+//line sql.y:1876
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 332:
+	case 336:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1857
+//line sql.y:1878
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 333:
+	case 337:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1862
+//line sql.y:1883
 		{
 			yyVAL.statement = &Savepoint{Name: yyDollar[2].colIdent}
 		}
-	case 334:
+	case 338:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1868
+//line sql.y:1889
 		{
 			yyVAL.statement = &Release{Name: yyDollar[3].colIdent}
 		}
-	case 335:
+	case 339:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1873
+//line sql.y:1894
 		{
 			yyVAL.str = ""
 		}
-	case 336:
+	case 340:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1877
+//line sql.y:1898
 		{
 			yyVAL.str = JSONStr
 		}
-	case 337:
+	case 341:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1881
+//line sql.y:1902
 		{
 			yyVAL.str = TreeStr
 		}
-	case 338:
+	case 342:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1885
+//line sql.y:1906
 		{
 			yyVAL.str = VitessStr
 		}
-	case 339:
+	case 343:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1889
+//line sql.y:1910
 		{
 			yyVAL.str = TraditionalStr
 		}
-	case 340:
+	case 344:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1893
+//line sql.y:1914
 		{
 			yyVAL.str = AnalyzeStr
 		}
-	case 341:
+	case 345:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1899
+//line sql.y:1920
 		{
 			yyVAL.bytes = yyDollar[1].bytes
 		}
-	case 342:
+	case 346:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1903
+//line sql.y:1924
 		{
 			yyVAL.bytes = yyDollar[1].bytes
 		}
-	case 343:
+	case 347:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1907
+//line sql.y:1928
 		{
 			yyVAL.bytes = yyDollar[1].bytes
 		}
-	case 344:
+	case 348:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1913
+//line sql.y:1934
 		{
 			yyVAL.statement = yyDollar[1].selStmt
 		}
-	case 345:
+	case 349:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1917
+//line sql.y:1938
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 346:
+	case 350:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1921
+//line sql.y:1942
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 347:
+	case 351:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1925
+//line sql.y:1946
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 348:
+	case 352:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1930
+//line sql.y:1951
 		{
 			yyVAL.str = ""
 		}
-	case 349:
+	case 353:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1934
+//line sql.y:1955
 		{
 			yyVAL.str = ""
 		}
-	case 350:
+	case 354:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1938
+//line sql.y:1959
 		{
 			yyVAL.str = ""
 		}
-	case 351:
+	case 355:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1944
+//line sql.y:1965
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 352:
+	case 356:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1948
+//line sql.y:1969
 		{
 			yyVAL.statement = &Explain{Type: yyDollar[2].str, Statement: yyDollar[3].statement}
 		}
-	case 353:
+	case 357:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1954
+//line sql.y:1975
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 354:
+	case 358:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1958
+//line sql.y:1979
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 355:
+	case 359:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1962
+//line sql.y:1983
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 356:
+	case 360:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1966
+//line sql.y:1987
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 357:
+	case 361:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1972
+//line sql.y:1993
 		{
 			yyVAL.statement = &DDL{Action: FlushStr}
 		}
-	case 358:
+	case 362:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1976
+//line sql.y:1997
 		{
 			setAllowComments(yylex, true)
 		}
-	case 359:
+	case 363:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1980
+//line sql.y:2001
 		{
 			yyVAL.bytes2 = yyDollar[2].bytes2
 			setAllowComments(yylex, false)
 		}
-	case 360:
+	case 364:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1986
+//line sql.y:2007
 		{
 			yyVAL.bytes2 = nil
 		}
-	case 361:
+	case 365:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1990
+//line sql.y:2011
 		{
 			yyVAL.bytes2 = append(yyDollar[1].bytes2, yyDollar[2].bytes)
 		}
-	case 362:
+	case 366:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1996
+//line sql.y:2017
 		{
 			yyVAL.str = UnionStr
 		}
-	case 363:
+	case 367:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2000
+//line sql.y:2021
 		{
 			yyVAL.str = UnionAllStr
 		}
-	case 364:
+	case 368:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2004
+//line sql.y:2025
 		{
 			yyVAL.str = UnionDistinctStr
 		}
-	case 365:
+	case 369:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2009
+//line sql.y:2030
 		{
 			yyVAL.str = ""
 		}
-	case 366:
+	case 370:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2013
+//line sql.y:2034
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 367:
+	case 371:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2017
+//line sql.y:2038
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 368:
+	case 372:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2022
+//line sql.y:2043
 		{
 			yyVAL.str = ""
 		}
-	case 369:
+	case 373:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2026
+//line sql.y:2047
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 370:
+	case 374:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2030
+//line sql.y:2051
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 371:
+	case 375:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2035
+//line sql.y:2056
 		{
 			yyVAL.selectExprs = nil
 		}
-	case 372:
+	case 376:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2039
+//line sql.y:2060
 		{
 			yyVAL.selectExprs = yyDollar[1].selectExprs
 		}
-	case 373:
+	case 377:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2044
+//line sql.y:2065
 		{
 			yyVAL.strs = nil
 		}
-	case 374:
+	case 378:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2048
+//line sql.y:2069
 		{
 			yyVAL.strs = []string{yyDollar[1].str}
 		}
-	case 375:
+	case 379:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2052
+//line sql.y:2073
 		{ // TODO: This is a hack since I couldn't get it to work in a nicer way. I got 'conflicts: 8 shift/reduce'
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str}
 		}
-	case 376:
+	case 380:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2056
+//line sql.y:2077
 		{
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str, yyDollar[3].str}
 		}
-	case 377:
+	case 381:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2060
+//line sql.y:2081
 		{
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str, yyDollar[3].str, yyDollar[4].str}
 		}
-	case 378:
+	case 382:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2066
+//line sql.y:2087
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 379:
+	case 383:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2070
+//line sql.y:2091
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 380:
+	case 384:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2074
+//line sql.y:2095
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 381:
+	case 385:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2078
+//line sql.y:2099
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 382:
+	case 386:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2082
+//line sql.y:2103
 		{
 			yyVAL.str = StraightJoinHint
 		}
-	case 383:
+	case 387:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2086
+//line sql.y:2107
 		{
 			yyVAL.str = SQLCalcFoundRowsStr
 		}
-	case 384:
+	case 388:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2092
+//line sql.y:2113
 		{
 			yyVAL.selectExprs = SelectExprs{yyDollar[1].selectExpr}
 		}
-	case 385:
+	case 389:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2096
+//line sql.y:2117
 		{
 			yyVAL.selectExprs = append(yyVAL.selectExprs, yyDollar[3].selectExpr)
 		}
-	case 386:
+	case 390:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2102
+//line sql.y:2123
 		{
 			yyVAL.selectExpr = &StarExpr{}
 		}
-	case 387:
+	case 391:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2106
+//line sql.y:2127
 		{
 			yyVAL.selectExpr = &AliasedExpr{Expr: yyDollar[1].expr, As: yyDollar[2].colIdent}
 		}
-	case 388:
+	case 392:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2110
+//line sql.y:2131
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
 		}
-	case 389:
+	case 393:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2114
+//line sql.y:2135
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
 		}
-	case 390:
+	case 394:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2119
+//line sql.y:2140
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 391:
+	case 395:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2123
+//line sql.y:2144
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 392:
+	case 396:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2127
+//line sql.y:2148
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 394:
+	case 398:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2134
+//line sql.y:2155
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 395:
+	case 399:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2139
+//line sql.y:2160
 		{
 			yyVAL.tableExprs = TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}
 		}
-	case 396:
+	case 400:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2143
+//line sql.y:2164
 		{
 			yyVAL.tableExprs = yyDollar[2].tableExprs
 		}
-	case 397:
+	case 401:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2149
+//line sql.y:2170
 		{
 			yyVAL.tableExprs = TableExprs{yyDollar[1].tableExpr}
 		}
-	case 398:
+	case 402:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2153
+//line sql.y:2174
 		{
 			yyVAL.tableExprs = append(yyVAL.tableExprs, yyDollar[3].tableExpr)
 		}
-	case 401:
+	case 405:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2163
+//line sql.y:2184
 		{
 			yyVAL.tableExpr = yyDollar[1].aliasedTableName
 		}
-	case 402:
+	case 406:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2167
+//line sql.y:2188
 		{
 			yyVAL.tableExpr = &AliasedTableExpr{Expr: yyDollar[1].subquery, As: yyDollar[3].tableIdent}
 		}
-	case 403:
+	case 407:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2171
+//line sql.y:2192
 		{
 			yyVAL.tableExpr = &ParenTableExpr{Exprs: yyDollar[2].tableExprs}
 		}
-	case 404:
+	case 408:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2177
+//line sql.y:2198
 		{
 			yyVAL.subquery = &Subquery{yyDollar[2].selStmt}
 		}
-	case 405:
+	case 409:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2183
+//line sql.y:2204
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHints}
 		}
-	case 406:
+	case 410:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2187
+//line sql.y:2208
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, Partitions: yyDollar[4].partitions, As: yyDollar[6].tableIdent, Hints: yyDollar[7].indexHints}
 		}
-	case 407:
+	case 411:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2193
+//line sql.y:2214
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 408:
+	case 412:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2197
+//line sql.y:2218
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 409:
+	case 413:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2203
+//line sql.y:2224
 		{
 			yyVAL.partitions = Partitions{yyDollar[1].colIdent}
 		}
-	case 410:
+	case 414:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2207
+//line sql.y:2228
 		{
 			yyVAL.partitions = append(yyVAL.partitions, yyDollar[3].colIdent)
 		}
-	case 411:
+	case 415:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2220
+//line sql.y:2241
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 412:
+	case 416:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2224
+//line sql.y:2245
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 413:
+	case 417:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2228
+//line sql.y:2249
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 414:
+	case 418:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2232
+//line sql.y:2253
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr}
 		}
-	case 415:
+	case 419:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2238
+//line sql.y:2259
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 416:
+	case 420:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2240
+//line sql.y:2261
 		{
 			yyVAL.joinCondition = JoinCondition{Using: yyDollar[3].columns}
 		}
-	case 417:
+	case 421:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2244
+//line sql.y:2265
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 418:
+	case 422:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2246
+//line sql.y:2267
 		{
 			yyVAL.joinCondition = yyDollar[1].joinCondition
 		}
-	case 419:
+	case 423:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2250
+//line sql.y:2271
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 420:
+	case 424:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2252
+//line sql.y:2273
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 421:
+	case 425:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2255
+//line sql.y:2276
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 422:
+	case 426:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2257
+//line sql.y:2278
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 423:
+	case 427:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2260
+//line sql.y:2281
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 424:
+	case 428:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2264
+//line sql.y:2285
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 425:
+	case 429:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2268
+//line sql.y:2289
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 427:
+	case 431:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2275
+//line sql.y:2296
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 428:
+	case 432:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2281
+//line sql.y:2302
 		{
 			yyVAL.str = JoinStr
 		}
-	case 429:
+	case 433:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2285
+//line sql.y:2306
 		{
 			yyVAL.str = JoinStr
 		}
-	case 430:
+	case 434:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2289
+//line sql.y:2310
 		{
 			yyVAL.str = JoinStr
 		}
-	case 431:
+	case 435:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2295
+//line sql.y:2316
 		{
 			yyVAL.str = StraightJoinStr
 		}
-	case 432:
+	case 436:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2301
+//line sql.y:2322
 		{
 			yyVAL.str = LeftJoinStr
 		}
-	case 433:
+	case 437:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2305
+//line sql.y:2326
 		{
 			yyVAL.str = LeftJoinStr
 		}
-	case 434:
+	case 438:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2309
+//line sql.y:2330
 		{
 			yyVAL.str = RightJoinStr
 		}
-	case 435:
+	case 439:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2313
+//line sql.y:2334
 		{
 			yyVAL.str = RightJoinStr
 		}
-	case 436:
+	case 440:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2319
+//line sql.y:2340
 		{
 			yyVAL.str = NaturalJoinStr
 		}
-	case 437:
+	case 441:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2323
+//line sql.y:2344
 		{
 			if yyDollar[2].str == LeftJoinStr {
 				yyVAL.str = NaturalLeftJoinStr
@@ -6266,489 +6266,489 @@ yydefault:
 				yyVAL.str = NaturalRightJoinStr
 			}
 		}
-	case 438:
+	case 442:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2333
+//line sql.y:2354
 		{
 			yyVAL.tableName = yyDollar[2].tableName
 		}
-	case 439:
+	case 443:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2337
+//line sql.y:2358
 		{
 			yyVAL.tableName = yyDollar[1].tableName
 		}
-	case 440:
+	case 444:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2343
+//line sql.y:2364
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 441:
+	case 445:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2347
+//line sql.y:2368
 		{
 			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
 		}
-	case 442:
+	case 446:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2353
+//line sql.y:2374
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 443:
+	case 447:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2358
+//line sql.y:2379
 		{
 			yyVAL.indexHints = nil
 		}
-	case 444:
+	case 448:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2362
+//line sql.y:2383
 		{
 			yyVAL.indexHints = &IndexHints{Type: UseStr, Indexes: yyDollar[4].columns}
 		}
-	case 445:
+	case 449:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2366
+//line sql.y:2387
 		{
 			yyVAL.indexHints = &IndexHints{Type: UseStr}
 		}
-	case 446:
+	case 450:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2370
+//line sql.y:2391
 		{
 			yyVAL.indexHints = &IndexHints{Type: IgnoreStr, Indexes: yyDollar[4].columns}
 		}
-	case 447:
+	case 451:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2374
+//line sql.y:2395
 		{
 			yyVAL.indexHints = &IndexHints{Type: ForceStr, Indexes: yyDollar[4].columns}
 		}
-	case 448:
+	case 452:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2379
+//line sql.y:2400
 		{
 			yyVAL.expr = nil
 		}
-	case 449:
+	case 453:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2383
+//line sql.y:2404
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 450:
+	case 454:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2389
+//line sql.y:2410
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 451:
+	case 455:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2393
+//line sql.y:2414
 		{
 			yyVAL.expr = &AndExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 452:
+	case 456:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2397
+//line sql.y:2418
 		{
 			yyVAL.expr = &OrExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 453:
+	case 457:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2401
+//line sql.y:2422
 		{
 			yyVAL.expr = &XorExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 454:
+	case 458:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2405
+//line sql.y:2426
 		{
 			yyVAL.expr = &NotExpr{Expr: yyDollar[2].expr}
 		}
-	case 455:
+	case 459:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2409
+//line sql.y:2430
 		{
 			yyVAL.expr = &IsExpr{Operator: yyDollar[3].str, Expr: yyDollar[1].expr}
 		}
-	case 456:
+	case 460:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2413
+//line sql.y:2434
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 457:
+	case 461:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2417
+//line sql.y:2438
 		{
 			yyVAL.expr = &Default{ColName: yyDollar[2].str}
 		}
-	case 458:
+	case 462:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2423
+//line sql.y:2444
 		{
 			yyVAL.str = ""
 		}
-	case 459:
+	case 463:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2427
+//line sql.y:2448
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 460:
+	case 464:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2433
+//line sql.y:2454
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 461:
+	case 465:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2437
+//line sql.y:2458
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 462:
+	case 466:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2443
+//line sql.y:2464
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: yyDollar[2].str, Right: yyDollar[3].expr}
 		}
-	case 463:
+	case 467:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2447
+//line sql.y:2468
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: InStr, Right: yyDollar[3].colTuple}
 		}
-	case 464:
+	case 468:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2451
+//line sql.y:2472
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotInStr, Right: yyDollar[4].colTuple}
 		}
-	case 465:
+	case 469:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2455
+//line sql.y:2476
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: LikeStr, Right: yyDollar[3].expr, Escape: yyDollar[4].expr}
 		}
-	case 466:
+	case 470:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2459
+//line sql.y:2480
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotLikeStr, Right: yyDollar[4].expr, Escape: yyDollar[5].expr}
 		}
-	case 467:
+	case 471:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2463
+//line sql.y:2484
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: RegexpStr, Right: yyDollar[3].expr}
 		}
-	case 468:
+	case 472:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2467
+//line sql.y:2488
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotRegexpStr, Right: yyDollar[4].expr}
 		}
-	case 469:
+	case 473:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2471
+//line sql.y:2492
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: BetweenStr, From: yyDollar[3].expr, To: yyDollar[5].expr}
 		}
-	case 470:
+	case 474:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2475
+//line sql.y:2496
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: NotBetweenStr, From: yyDollar[4].expr, To: yyDollar[6].expr}
 		}
-	case 471:
+	case 475:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2479
+//line sql.y:2500
 		{
 			yyVAL.expr = &ExistsExpr{Subquery: yyDollar[2].subquery}
 		}
-	case 472:
+	case 476:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2485
+//line sql.y:2506
 		{
 			yyVAL.str = IsNullStr
 		}
-	case 473:
+	case 477:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2489
+//line sql.y:2510
 		{
 			yyVAL.str = IsNotNullStr
 		}
-	case 474:
+	case 478:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2493
+//line sql.y:2514
 		{
 			yyVAL.str = IsTrueStr
 		}
-	case 475:
+	case 479:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2497
+//line sql.y:2518
 		{
 			yyVAL.str = IsNotTrueStr
 		}
-	case 476:
+	case 480:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2501
+//line sql.y:2522
 		{
 			yyVAL.str = IsFalseStr
 		}
-	case 477:
+	case 481:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2505
+//line sql.y:2526
 		{
 			yyVAL.str = IsNotFalseStr
 		}
-	case 478:
+	case 482:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2511
+//line sql.y:2532
 		{
 			yyVAL.str = EqualStr
 		}
-	case 479:
+	case 483:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2515
+//line sql.y:2536
 		{
 			yyVAL.str = LessThanStr
 		}
-	case 480:
+	case 484:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2519
+//line sql.y:2540
 		{
 			yyVAL.str = GreaterThanStr
 		}
-	case 481:
+	case 485:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2523
+//line sql.y:2544
 		{
 			yyVAL.str = LessEqualStr
 		}
-	case 482:
+	case 486:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2527
+//line sql.y:2548
 		{
 			yyVAL.str = GreaterEqualStr
 		}
-	case 483:
+	case 487:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2531
+//line sql.y:2552
 		{
 			yyVAL.str = NotEqualStr
 		}
-	case 484:
+	case 488:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2535
+//line sql.y:2556
 		{
 			yyVAL.str = NullSafeEqualStr
 		}
-	case 485:
+	case 489:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2540
+//line sql.y:2561
 		{
 			yyVAL.expr = nil
 		}
-	case 486:
+	case 490:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2544
+//line sql.y:2565
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 487:
+	case 491:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2550
+//line sql.y:2571
 		{
 			yyVAL.colTuple = yyDollar[1].valTuple
 		}
-	case 488:
+	case 492:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2554
+//line sql.y:2575
 		{
 			yyVAL.colTuple = yyDollar[1].subquery
 		}
-	case 489:
+	case 493:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2558
+//line sql.y:2579
 		{
 			yyVAL.colTuple = ListArg(yyDollar[1].bytes)
 		}
-	case 490:
+	case 494:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2564
+//line sql.y:2585
 		{
 			yyVAL.subquery = &Subquery{yyDollar[2].selStmt}
 		}
-	case 491:
+	case 495:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2570
+//line sql.y:2591
 		{
 			yyVAL.exprs = Exprs{yyDollar[1].expr}
 		}
-	case 492:
+	case 496:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2574
+//line sql.y:2595
 		{
 			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
 		}
-	case 493:
+	case 497:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2580
+//line sql.y:2601
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 494:
+	case 498:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2584
+//line sql.y:2605
 		{
 			yyVAL.expr = yyDollar[1].boolVal
 		}
-	case 495:
+	case 499:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2588
+//line sql.y:2609
 		{
 			yyVAL.expr = yyDollar[1].colName
 		}
-	case 496:
+	case 500:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2592
+//line sql.y:2613
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 497:
+	case 501:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2596
+//line sql.y:2617
 		{
 			yyVAL.expr = yyDollar[1].subquery
 		}
-	case 498:
+	case 502:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2600
+//line sql.y:2621
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitAndStr, Right: yyDollar[3].expr}
 		}
-	case 499:
+	case 503:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2604
+//line sql.y:2625
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitOrStr, Right: yyDollar[3].expr}
 		}
-	case 500:
+	case 504:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2608
+//line sql.y:2629
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitXorStr, Right: yyDollar[3].expr}
 		}
-	case 501:
+	case 505:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2612
+//line sql.y:2633
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: PlusStr, Right: yyDollar[3].expr}
 		}
-	case 502:
+	case 506:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2616
+//line sql.y:2637
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MinusStr, Right: yyDollar[3].expr}
 		}
-	case 503:
+	case 507:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2620
+//line sql.y:2641
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MultStr, Right: yyDollar[3].expr}
 		}
-	case 504:
+	case 508:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2624
+//line sql.y:2645
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: DivStr, Right: yyDollar[3].expr}
 		}
-	case 505:
+	case 509:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2628
+//line sql.y:2649
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: IntDivStr, Right: yyDollar[3].expr}
 		}
-	case 506:
+	case 510:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2632
+//line sql.y:2653
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
 		}
-	case 507:
+	case 511:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2636
+//line sql.y:2657
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
 		}
-	case 508:
+	case 512:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2640
+//line sql.y:2661
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftLeftStr, Right: yyDollar[3].expr}
 		}
-	case 509:
+	case 513:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2644
+//line sql.y:2665
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftRightStr, Right: yyDollar[3].expr}
 		}
-	case 510:
+	case 514:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2648
+//line sql.y:2669
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONExtractOp, Right: yyDollar[3].expr}
 		}
-	case 511:
+	case 515:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2652
+//line sql.y:2673
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONUnquoteExtractOp, Right: yyDollar[3].expr}
 		}
-	case 512:
+	case 516:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2656
+//line sql.y:2677
 		{
 			yyVAL.expr = &CollateExpr{Expr: yyDollar[1].expr, Charset: yyDollar[3].str}
 		}
-	case 513:
+	case 517:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2660
+//line sql.y:2681
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BinaryStr, Expr: yyDollar[2].expr}
 		}
-	case 514:
+	case 518:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2664
+//line sql.y:2685
 		{
 			yyVAL.expr = &UnaryExpr{Operator: UBinaryStr, Expr: yyDollar[2].expr}
 		}
-	case 515:
+	case 519:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2668
+//line sql.y:2689
 		{
 			yyVAL.expr = &UnaryExpr{Operator: Utf8Str, Expr: yyDollar[2].expr}
 		}
-	case 516:
+	case 520:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2672
+//line sql.y:2693
 		{
 			yyVAL.expr = &UnaryExpr{Operator: Utf8mb4Str, Expr: yyDollar[2].expr}
 		}
-	case 517:
+	case 521:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2676
+//line sql.y:2697
 		{
 			yyVAL.expr = &UnaryExpr{Operator: Latin1Str, Expr: yyDollar[2].expr}
 		}
-	case 518:
+	case 522:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2680
+//line sql.y:2701
 		{
 			if num, ok := yyDollar[2].expr.(*Literal); ok && num.Type == IntVal {
 				yyVAL.expr = num
@@ -6756,9 +6756,9 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UPlusStr, Expr: yyDollar[2].expr}
 			}
 		}
-	case 519:
+	case 523:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2688
+//line sql.y:2709
 		{
 			if num, ok := yyDollar[2].expr.(*Literal); ok && num.Type == IntVal {
 				// Handle double negative
@@ -6772,21 +6772,21 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UMinusStr, Expr: yyDollar[2].expr}
 			}
 		}
-	case 520:
+	case 524:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2702
+//line sql.y:2723
 		{
 			yyVAL.expr = &UnaryExpr{Operator: TildaStr, Expr: yyDollar[2].expr}
 		}
-	case 521:
+	case 525:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2706
+//line sql.y:2727
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BangStr, Expr: yyDollar[2].expr}
 		}
-	case 522:
+	case 526:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2710
+//line sql.y:2731
 		{
 			// This rule prevents the usage of INTERVAL
 			// as a function. If support is needed for that,
@@ -6794,497 +6794,497 @@ yydefault:
 			// will be non-trivial because of grammar conflicts.
 			yyVAL.expr = &IntervalExpr{Expr: yyDollar[2].expr, Unit: yyDollar[3].colIdent.String()}
 		}
-	case 527:
+	case 531:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2728
+//line sql.y:2749
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs}
 		}
-	case 528:
+	case 532:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2732
+//line sql.y:2753
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs}
 		}
-	case 529:
+	case 533:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2736
+//line sql.y:2757
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs}
 		}
-	case 530:
+	case 534:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2740
+//line sql.y:2761
 		{
 			yyVAL.expr = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprs}
 		}
-	case 531:
+	case 535:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2750
+//line sql.y:2771
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 532:
+	case 536:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2754
+//line sql.y:2775
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 533:
+	case 537:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2758
+//line sql.y:2779
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 534:
+	case 538:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2762
+//line sql.y:2783
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 535:
+	case 539:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2766
+//line sql.y:2787
 		{
 			yyVAL.expr = &ConvertUsingExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].str}
 		}
-	case 536:
+	case 540:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2770
+//line sql.y:2791
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 537:
+	case 541:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2774
+//line sql.y:2795
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 538:
+	case 542:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2778
+//line sql.y:2799
 		{
 			yyVAL.expr = &SubstrExpr{StrVal: NewStrLiteral(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 539:
+	case 543:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2782
+//line sql.y:2803
 		{
 			yyVAL.expr = &SubstrExpr{StrVal: NewStrLiteral(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 540:
+	case 544:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2786
+//line sql.y:2807
 		{
 			yyVAL.expr = &MatchExpr{Columns: yyDollar[3].selectExprs, Expr: yyDollar[7].expr, Option: yyDollar[8].str}
 		}
-	case 541:
+	case 545:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2790
+//line sql.y:2811
 		{
 			yyVAL.expr = &GroupConcatExpr{Distinct: yyDollar[3].str, Exprs: yyDollar[4].selectExprs, OrderBy: yyDollar[5].orderBy, Separator: yyDollar[6].str, Limit: yyDollar[7].limit}
 		}
-	case 542:
+	case 546:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2794
+//line sql.y:2815
 		{
 			yyVAL.expr = &CaseExpr{Expr: yyDollar[2].expr, Whens: yyDollar[3].whens, Else: yyDollar[4].expr}
 		}
-	case 543:
+	case 547:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2798
+//line sql.y:2819
 		{
 			yyVAL.expr = &ValuesFuncExpr{Name: yyDollar[3].colName}
 		}
-	case 544:
+	case 548:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2808
+//line sql.y:2829
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_timestamp")}
 		}
-	case 545:
+	case 549:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2812
+//line sql.y:2833
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_timestamp")}
 		}
-	case 546:
+	case 550:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2816
+//line sql.y:2837
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_time")}
 		}
-	case 547:
+	case 551:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2821
+//line sql.y:2842
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_date")}
 		}
-	case 548:
+	case 552:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2826
+//line sql.y:2847
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtime")}
 		}
-	case 549:
+	case 553:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2831
+//line sql.y:2852
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtimestamp")}
 		}
-	case 550:
+	case 554:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2837
+//line sql.y:2858
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_date")}
 		}
-	case 551:
+	case 555:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2842
+//line sql.y:2863
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_time")}
 		}
-	case 552:
+	case 556:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2847
+//line sql.y:2868
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("current_timestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 553:
+	case 557:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2851
+//line sql.y:2872
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("utc_timestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 554:
+	case 558:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2855
+//line sql.y:2876
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("utc_time"), Fsp: yyDollar[2].expr}
 		}
-	case 555:
+	case 559:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2860
+//line sql.y:2881
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("localtime"), Fsp: yyDollar[2].expr}
 		}
-	case 556:
+	case 560:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2865
+//line sql.y:2886
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("localtimestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 557:
+	case 561:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2870
+//line sql.y:2891
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("current_time"), Fsp: yyDollar[2].expr}
 		}
-	case 558:
+	case 562:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2874
+//line sql.y:2895
 		{
 			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampadd"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
 		}
-	case 559:
+	case 563:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2878
+//line sql.y:2899
 		{
 			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampdiff"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
 		}
-	case 562:
+	case 566:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2888
+//line sql.y:2909
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 563:
+	case 567:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2898
+//line sql.y:2919
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 564:
+	case 568:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2902
+//line sql.y:2923
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 565:
+	case 569:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2906
+//line sql.y:2927
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("schema"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 566:
+	case 570:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2910
+//line sql.y:2931
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 567:
+	case 571:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2914
+//line sql.y:2935
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 568:
+	case 572:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2918
+//line sql.y:2939
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 569:
+	case 573:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2922
+//line sql.y:2943
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 570:
+	case 574:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2928
+//line sql.y:2949
 		{
 			yyVAL.str = ""
 		}
-	case 571:
+	case 575:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2932
+//line sql.y:2953
 		{
 			yyVAL.str = BooleanModeStr
 		}
-	case 572:
+	case 576:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2936
+//line sql.y:2957
 		{
 			yyVAL.str = NaturalLanguageModeStr
 		}
-	case 573:
+	case 577:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2940
+//line sql.y:2961
 		{
 			yyVAL.str = NaturalLanguageModeWithQueryExpansionStr
 		}
-	case 574:
+	case 578:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2944
+//line sql.y:2965
 		{
 			yyVAL.str = QueryExpansionStr
 		}
-	case 575:
+	case 579:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2950
+//line sql.y:2971
 		{
 			yyVAL.str = string(yyDollar[1].colIdent.String())
 		}
-	case 576:
+	case 580:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2954
+//line sql.y:2975
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 577:
+	case 581:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2960
+//line sql.y:2981
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 578:
+	case 582:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2964
+//line sql.y:2985
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal, Charset: yyDollar[3].str, Operator: CharacterSetStr}
 		}
-	case 579:
+	case 583:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2968
+//line sql.y:2989
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal, Charset: string(yyDollar[3].colIdent.String())}
 		}
-	case 580:
+	case 584:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2972
+//line sql.y:2993
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 581:
+	case 585:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2976
+//line sql.y:2997
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 582:
+	case 586:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2980
+//line sql.y:3001
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 			yyVAL.convertType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.convertType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 583:
+	case 587:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2986
+//line sql.y:3007
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 584:
+	case 588:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2990
+//line sql.y:3011
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 585:
+	case 589:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2994
+//line sql.y:3015
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 586:
+	case 590:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2998
+//line sql.y:3019
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 587:
+	case 591:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3002
+//line sql.y:3023
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 588:
+	case 592:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3006
+//line sql.y:3027
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 589:
+	case 593:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3010
+//line sql.y:3031
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 590:
+	case 594:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3015
+//line sql.y:3036
 		{
 			yyVAL.expr = nil
 		}
-	case 591:
+	case 595:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3019
+//line sql.y:3040
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 592:
+	case 596:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3024
+//line sql.y:3045
 		{
 			yyVAL.str = string("")
 		}
-	case 593:
+	case 597:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3028
+//line sql.y:3049
 		{
 			yyVAL.str = " separator '" + string(yyDollar[2].bytes) + "'"
 		}
-	case 594:
+	case 598:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3034
+//line sql.y:3055
 		{
 			yyVAL.whens = []*When{yyDollar[1].when}
 		}
-	case 595:
+	case 599:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3038
+//line sql.y:3059
 		{
 			yyVAL.whens = append(yyDollar[1].whens, yyDollar[2].when)
 		}
-	case 596:
+	case 600:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3044
+//line sql.y:3065
 		{
 			yyVAL.when = &When{Cond: yyDollar[2].expr, Val: yyDollar[4].expr}
 		}
-	case 597:
+	case 601:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3049
+//line sql.y:3070
 		{
 			yyVAL.expr = nil
 		}
-	case 598:
+	case 602:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3053
+//line sql.y:3074
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 599:
+	case 603:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3059
+//line sql.y:3080
 		{
 			yyVAL.colName = &ColName{Name: yyDollar[1].colIdent}
 		}
-	case 600:
+	case 604:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3063
+//line sql.y:3084
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
 		}
-	case 601:
+	case 605:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3067
+//line sql.y:3088
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
 		}
-	case 602:
+	case 606:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3073
+//line sql.y:3094
 		{
 			yyVAL.expr = NewStrLiteral(yyDollar[1].bytes)
 		}
-	case 603:
+	case 607:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3077
+//line sql.y:3098
 		{
 			yyVAL.expr = NewHexLiteral(yyDollar[1].bytes)
 		}
-	case 604:
+	case 608:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3081
+//line sql.y:3102
 		{
 			yyVAL.expr = NewBitLiteral(yyDollar[1].bytes)
 		}
-	case 605:
+	case 609:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3085
+//line sql.y:3106
 		{
 			yyVAL.expr = NewIntLiteral(yyDollar[1].bytes)
 		}
-	case 606:
+	case 610:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3089
+//line sql.y:3110
 		{
 			yyVAL.expr = NewFloatLiteral(yyDollar[1].bytes)
 		}
-	case 607:
+	case 611:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3093
+//line sql.y:3114
 		{
 			yyVAL.expr = NewHexNumLiteral(yyDollar[1].bytes)
 		}
-	case 608:
+	case 612:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3097
+//line sql.y:3118
 		{
 			yyVAL.expr = NewArgument(yyDollar[1].bytes)
 		}
-	case 609:
+	case 613:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3101
+//line sql.y:3122
 		{
 			yyVAL.expr = &NullVal{}
 		}
-	case 610:
+	case 614:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3107
+//line sql.y:3128
 		{
 			// TODO(sougou): Deprecate this construct.
 			if yyDollar[1].colIdent.Lowered() != "value" {
@@ -7293,225 +7293,225 @@ yydefault:
 			}
 			yyVAL.expr = NewIntLiteral([]byte("1"))
 		}
-	case 611:
+	case 615:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3116
+//line sql.y:3137
 		{
 			yyVAL.expr = NewIntLiteral(yyDollar[1].bytes)
 		}
-	case 612:
+	case 616:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3120
+//line sql.y:3141
 		{
 			yyVAL.expr = NewArgument(yyDollar[1].bytes)
 		}
-	case 613:
+	case 617:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3125
+//line sql.y:3146
 		{
 			yyVAL.exprs = nil
 		}
-	case 614:
+	case 618:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3129
+//line sql.y:3150
 		{
 			yyVAL.exprs = yyDollar[3].exprs
 		}
-	case 615:
+	case 619:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3134
+//line sql.y:3155
 		{
 			yyVAL.expr = nil
 		}
-	case 616:
+	case 620:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3138
+//line sql.y:3159
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 617:
+	case 621:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3143
+//line sql.y:3164
 		{
 			yyVAL.orderBy = nil
 		}
-	case 618:
+	case 622:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3147
+//line sql.y:3168
 		{
 			yyVAL.orderBy = yyDollar[3].orderBy
 		}
-	case 619:
+	case 623:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3153
+//line sql.y:3174
 		{
 			yyVAL.orderBy = OrderBy{yyDollar[1].order}
 		}
-	case 620:
+	case 624:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3157
+//line sql.y:3178
 		{
 			yyVAL.orderBy = append(yyDollar[1].orderBy, yyDollar[3].order)
 		}
-	case 621:
+	case 625:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3163
+//line sql.y:3184
 		{
 			yyVAL.order = &Order{Expr: yyDollar[1].expr, Direction: yyDollar[2].str}
 		}
-	case 622:
+	case 626:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3168
+//line sql.y:3189
 		{
 			yyVAL.str = AscScr
 		}
-	case 623:
+	case 627:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3172
+//line sql.y:3193
 		{
 			yyVAL.str = AscScr
 		}
-	case 624:
+	case 628:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3176
+//line sql.y:3197
 		{
 			yyVAL.str = DescScr
 		}
-	case 625:
+	case 629:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3181
+//line sql.y:3202
 		{
 			yyVAL.limit = nil
 		}
-	case 626:
+	case 630:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3185
+//line sql.y:3206
 		{
 			yyVAL.limit = &Limit{Rowcount: yyDollar[2].expr}
 		}
-	case 627:
+	case 631:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3189
+//line sql.y:3210
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[2].expr, Rowcount: yyDollar[4].expr}
 		}
-	case 628:
+	case 632:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3193
+//line sql.y:3214
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[4].expr, Rowcount: yyDollar[2].expr}
 		}
-	case 629:
+	case 633:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3198
+//line sql.y:3219
 		{
 			yyVAL.str = ""
 		}
-	case 630:
+	case 634:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3202
+//line sql.y:3223
 		{
 			yyVAL.str = ForUpdateStr
 		}
-	case 631:
+	case 635:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3206
+//line sql.y:3227
 		{
 			yyVAL.str = ShareModeStr
 		}
-	case 632:
+	case 636:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3219
+//line sql.y:3240
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[2].values}
 		}
-	case 633:
+	case 637:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3223
+//line sql.y:3244
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[1].selStmt}
 		}
-	case 634:
+	case 638:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3227
+//line sql.y:3248
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].values}
 		}
-	case 635:
+	case 639:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3231
+//line sql.y:3252
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[4].selStmt}
 		}
-	case 636:
+	case 640:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3237
+//line sql.y:3258
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 637:
+	case 641:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3241
+//line sql.y:3262
 		{
 			yyVAL.columns = Columns{yyDollar[3].colIdent}
 		}
-	case 638:
+	case 642:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3245
+//line sql.y:3266
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 639:
+	case 643:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3249
+//line sql.y:3270
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[5].colIdent)
 		}
-	case 640:
+	case 644:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3254
+//line sql.y:3275
 		{
 			yyVAL.updateExprs = nil
 		}
-	case 641:
+	case 645:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3258
+//line sql.y:3279
 		{
 			yyVAL.updateExprs = yyDollar[5].updateExprs
 		}
-	case 642:
+	case 646:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3264
+//line sql.y:3285
 		{
 			yyVAL.values = Values{yyDollar[1].valTuple}
 		}
-	case 643:
+	case 647:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3268
+//line sql.y:3289
 		{
 			yyVAL.values = append(yyDollar[1].values, yyDollar[3].valTuple)
 		}
-	case 644:
+	case 648:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3274
+//line sql.y:3295
 		{
 			yyVAL.valTuple = yyDollar[1].valTuple
 		}
-	case 645:
+	case 649:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3278
+//line sql.y:3299
 		{
 			yyVAL.valTuple = ValTuple{}
 		}
-	case 646:
+	case 650:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3284
+//line sql.y:3305
 		{
 			yyVAL.valTuple = ValTuple(yyDollar[2].exprs)
 		}
-	case 647:
+	case 651:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3290
+//line sql.y:3311
 		{
 			if len(yyDollar[1].valTuple) == 1 {
 				yyVAL.expr = yyDollar[1].valTuple[0]
@@ -7519,319 +7519,319 @@ yydefault:
 				yyVAL.expr = yyDollar[1].valTuple
 			}
 		}
-	case 648:
+	case 652:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3300
+//line sql.y:3321
 		{
 			yyVAL.updateExprs = UpdateExprs{yyDollar[1].updateExpr}
 		}
-	case 649:
+	case 653:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3304
+//line sql.y:3325
 		{
 			yyVAL.updateExprs = append(yyDollar[1].updateExprs, yyDollar[3].updateExpr)
 		}
-	case 650:
+	case 654:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3310
+//line sql.y:3331
 		{
 			yyVAL.updateExpr = &UpdateExpr{Name: yyDollar[1].colName, Expr: yyDollar[3].expr}
 		}
-	case 651:
+	case 655:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3316
+//line sql.y:3337
 		{
 			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
 		}
-	case 652:
+	case 656:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3320
+//line sql.y:3341
 		{
 			yyVAL.setExprs = append(yyDollar[1].setExprs, yyDollar[3].setExpr)
 		}
-	case 653:
+	case 657:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3326
+//line sql.y:3347
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: NewStrLiteral([]byte("on"))}
 		}
-	case 654:
+	case 658:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3330
+//line sql.y:3351
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: NewStrLiteral([]byte("off"))}
 		}
-	case 655:
+	case 659:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3334
+//line sql.y:3355
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: yyDollar[3].expr}
 		}
-	case 656:
+	case 660:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3338
+//line sql.y:3359
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Expr: yyDollar[2].expr}
 		}
-	case 657:
+	case 661:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3342
+//line sql.y:3363
 		{
 			yyDollar[2].setExpr.Scope = yyDollar[1].str
 			yyVAL.setExpr = yyDollar[2].setExpr
 		}
-	case 659:
+	case 663:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3350
+//line sql.y:3371
 		{
 			yyVAL.bytes = []byte("charset")
 		}
-	case 661:
+	case 665:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3357
+//line sql.y:3378
 		{
 			yyVAL.expr = NewStrLiteral([]byte(yyDollar[1].colIdent.String()))
 		}
-	case 662:
+	case 666:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3361
+//line sql.y:3382
 		{
 			yyVAL.expr = NewStrLiteral(yyDollar[1].bytes)
 		}
-	case 663:
+	case 667:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3365
+//line sql.y:3386
 		{
 			yyVAL.expr = &Default{}
 		}
-	case 666:
+	case 670:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3374
+//line sql.y:3395
 		{
 			yyVAL.byt = 0
 		}
-	case 667:
+	case 671:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3376
+//line sql.y:3397
 		{
 			yyVAL.byt = 1
 		}
-	case 668:
+	case 672:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3379
+//line sql.y:3400
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 669:
+	case 673:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3381
+//line sql.y:3402
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 670:
+	case 674:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3384
+//line sql.y:3405
 		{
 			yyVAL.str = ""
 		}
-	case 671:
+	case 675:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3386
+//line sql.y:3407
 		{
 			yyVAL.str = IgnoreStr
 		}
-	case 672:
+	case 676:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3390
+//line sql.y:3411
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 673:
+	case 677:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3392
+//line sql.y:3413
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 674:
+	case 678:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3394
+//line sql.y:3415
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 675:
+	case 679:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3396
+//line sql.y:3417
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 676:
+	case 680:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3398
+//line sql.y:3419
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 677:
+	case 681:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3400
+//line sql.y:3421
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 678:
+	case 682:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3402
+//line sql.y:3423
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 679:
+	case 683:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3404
+//line sql.y:3425
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 680:
+	case 684:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3406
+//line sql.y:3427
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 681:
+	case 685:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3408
+//line sql.y:3429
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 682:
+	case 686:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3411
+//line sql.y:3432
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 683:
+	case 687:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3413
+//line sql.y:3434
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 684:
+	case 688:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3415
+//line sql.y:3436
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 685:
+	case 689:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3419
+//line sql.y:3440
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 686:
+	case 690:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3421
+//line sql.y:3442
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 687:
+	case 691:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3424
+//line sql.y:3445
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 688:
+	case 692:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3426
+//line sql.y:3447
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 689:
+	case 693:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3428
+//line sql.y:3449
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 690:
+	case 694:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3431
+//line sql.y:3452
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 691:
+	case 695:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3433
+//line sql.y:3454
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 692:
+	case 696:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3437
+//line sql.y:3458
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 693:
+	case 697:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3441
+//line sql.y:3462
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 695:
+	case 699:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3448
+//line sql.y:3469
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 696:
+	case 700:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3454
+//line sql.y:3475
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].colIdent.String()))
 		}
-	case 697:
+	case 701:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3458
+//line sql.y:3479
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 699:
+	case 703:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3465
+//line sql.y:3486
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 994:
+	case 1002:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3785
+//line sql.y:3810
 		{
 			if incNesting(yylex) {
 				yylex.Error("max nesting level reached")
 				return 1
 			}
 		}
-	case 995:
+	case 1003:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3794
+//line sql.y:3819
 		{
 			decNesting(yylex)
 		}
-	case 996:
+	case 1004:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3799
+//line sql.y:3824
 		{
 			skipToEnd(yylex)
 		}
-	case 997:
+	case 1005:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3804
+//line sql.y:3829
 		{
 			skipToEnd(yylex)
 		}
-	case 998:
+	case 1006:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3808
+//line sql.y:3833
 		{
 			skipToEnd(yylex)
 		}
-	case 999:
+	case 1007:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3812
+//line sql.y:3837
 		{
 			skipToEnd(yylex)
 		}
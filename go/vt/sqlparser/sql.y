@@ -217,6 +217,9 @@ func skipToEnd(yylex interface{}) {
 // Explain tokens
 %token <bytes> FORMAT TREE VITESS TRADITIONAL
 
+// Online DDL tokens
+%token <bytes> VITESS_MIGRATION CANCEL CUTOVER RETRY
+
 %type <statement> command
 %type <selStmt> simple_select select_statement base_select union_rhs
 %type <statement> explain_statement explainable_statement
@@ -280,7 +283,7 @@ func skipToEnd(yylex interface{}) {
 %type <characteristics> transaction_chars
 %type <str> isolation_level
 %type <bytes> for_from
-%type <str> ignore_opt default_opt
+%type <str> ignore_opt default_opt alter_migration_type
 %type <str> full_opt from_database_opt tables_or_processlist columns_or_fields extended_opt
 %type <showFilter> like_or_where_opt like_opt
 %type <byt> exists_opt
@@ -1478,6 +1481,24 @@ alter_statement:
         },
     }
   }
+| ALTER VITESS_MIGRATION STRING alter_migration_type
+  {
+    $$ = &AlterMigration{UUID: string($3), Type: $4}
+  }
+
+alter_migration_type:
+  CANCEL
+  {
+    $$ = CancelMigrationStr
+  }
+| CUTOVER
+  {
+    $$ = CutOverMigrationStr
+  }
+| RETRY
+  {
+    $$ = RetryMigrationStr
+  }
 
 alter_object_type:
   CHECK
@@ -3615,6 +3636,7 @@ non_reserved_keyword:
 | BOOL
 | BOOLEAN
 | BUCKETS
+| CANCEL
 | CASCADE
 | CHAR
 | CHARACTER
@@ -3627,6 +3649,7 @@ non_reserved_keyword:
 | COMMIT
 | COMMITTED
 | COMPONENT
+| CUTOVER
 | DATE
 | DATETIME
 | DECIMAL
@@ -3727,6 +3750,7 @@ non_reserved_keyword:
 | RESPECT
 | RESTART
 | RETAIN
+| RETRY
 | REUSE
 | ROLE
 | ROLLBACK
@@ -3773,6 +3797,7 @@ non_reserved_keyword:
 | VISIBLE
 | VITESS
 | VITESS_METADATA
+| VITESS_MIGRATION
 | VSCHEMA
 | WARNINGS
 | WITH
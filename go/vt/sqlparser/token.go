@@ -112,6 +112,7 @@ var keywords = map[string]int{
 	"both":                UNUSED,
 	"by":                  BY,
 	"call":                UNUSED,
+	"cancel":              CANCEL,
 	"cascade":             CASCADE,
 	"case":                CASE,
 	"cast":                CAST,
@@ -140,6 +141,7 @@ var keywords = map[string]int{
 	"current_timestamp":   CURRENT_TIMESTAMP,
 	"current_user":        UNUSED,
 	"cursor":              UNUSED,
+	"cutover":             CUTOVER,
 	"database":            DATABASE,
 	"databases":           DATABASES,
 	"day_hour":            UNUSED,
@@ -318,6 +320,7 @@ var keywords = map[string]int{
 	"require":             UNUSED,
 	"resignal":            UNUSED,
 	"restrict":            RESTRICT,
+	"retry":               RETRY,
 	"return":              UNUSED,
 	"revoke":              UNUSED,
 	"right":               RIGHT,
@@ -402,6 +405,7 @@ var keywords = map[string]int{
 	"view":                VIEW,
 	"vitess":              VITESS,
 	"vitess_metadata":     VITESS_METADATA,
+	"vitess_migration":    VITESS_MIGRATION,
 	"vschema":             VSCHEMA,
 	"warnings":            WARNINGS,
 	"when":                WHEN,
@@ -0,0 +1,338 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/callinfo"
+)
+
+var _ Conn = (*AuditConn)(nil)
+
+// maxAuditDiffSize is the maximum product of the number of lines of
+// the old and new contents of a file for which we compute a minimal
+// diff. Above it, the diff has all the old and new lines.
+const maxAuditDiffSize = 1 << 20
+
+// auditProcess identifies this process in the AuditEvents.
+var auditProcess = func() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+	return fmt.Sprintf("%v@%v", filepath.Base(os.Args[0]), hostname)
+}()
+
+// AuditEvent describes a mutation of the topology.
+type AuditEvent struct {
+	// Time is when the operation started.
+	Time time.Time
+
+	// Cell and Path identify the file or directory.
+	Cell string
+	Path string
+
+	// Operation is Create, Update, Delete, Lock or Unlock.
+	Operation string
+
+	// Caller is the effective or immediate caller ID of the
+	// operation, if any.
+	Caller string `json:",omitempty"`
+
+	// CallInfo describes the RPC the operation is done for, if any.
+	CallInfo string `json:",omitempty"`
+
+	// Process is the binary and host that did the operation.
+	Process string
+
+	// Diff is the line diff of the old and new contents of the
+	// file, decoded to text for protobuf files. Removed lines start
+	// with '-' and added lines with '+'.
+	Diff string `json:",omitempty"`
+
+	// LockContents is the description of a Lock.
+	LockContents string `json:",omitempty"`
+
+	// Error is set if the operation failed.
+	Error string `json:",omitempty"`
+}
+
+// Logf formats the event as a line of JSON, for streamlog.
+func (e *AuditEvent) Logf(w io.Writer, params url.Values) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(data, '\n'))
+	return err
+}
+
+// The AuditConn is a wrapper for a Conn that records an AuditEvent in
+// its sinks for every mutation: Create, Update, Delete, Lock and Unlock.
+// To compute the diffs, Update and Delete read the file first, unless
+// -topo_audit_diffs is false. Without a version, the file could change
+// between the read and the mutation, so the recorded diff is a best
+// effort.
+type AuditConn struct {
+	cell  string
+	conn  Conn
+	sinks []AuditSink
+	diffs bool
+}
+
+// NewAuditConn returns an AuditConn
+func NewAuditConn(cell string, conn Conn, sinks []AuditSink) *AuditConn {
+	return &AuditConn{
+		cell:  cell,
+		conn:  conn,
+		sinks: sinks,
+		diffs: *auditDiffs,
+	}
+}
+
+// newEvent returns an AuditEvent for an operation starting now.
+func (ac *AuditConn) newEvent(ctx context.Context, operation, nodePath string) *AuditEvent {
+	e := &AuditEvent{
+		Time:      time.Now(),
+		Cell:      ac.cell,
+		Path:      nodePath,
+		Operation: operation,
+		Process:   auditProcess,
+	}
+	if ef := callerid.EffectiveCallerIDFromContext(ctx); ef != nil && ef.Principal != "" {
+		e.Caller = ef.Principal
+	} else if im := callerid.ImmediateCallerIDFromContext(ctx); im != nil && im.Username != "" {
+		e.Caller = im.Username
+	}
+	if ci, ok := callinfo.FromContext(ctx); ok {
+		e.CallInfo = ci.Text()
+	}
+	return e
+}
+
+// record sends the event to all the sinks.
+func (ac *AuditConn) record(e *AuditEvent, err error) {
+	if err != nil {
+		e.Error = err.Error()
+	}
+	for _, sink := range ac.sinks {
+		sink.Record(e)
+	}
+}
+
+// currentContents returns the contents of a file before a mutation,
+// or nil if it can't be read.
+func (ac *AuditConn) currentContents(ctx context.Context, filePath string) []byte {
+	contents, _, err := ac.conn.Get(ctx, filePath)
+	if err != nil {
+		return nil
+	}
+	return contents
+}
+
+// ListDir is part of the Conn interface
+func (ac *AuditConn) ListDir(ctx context.Context, dirPath string, full bool) ([]DirEntry, error) {
+	return ac.conn.ListDir(ctx, dirPath, full)
+}
+
+// Create is part of the Conn interface
+func (ac *AuditConn) Create(ctx context.Context, filePath string, contents []byte) (Version, error) {
+	e := ac.newEvent(ctx, "Create", filePath)
+	res, err := ac.conn.Create(ctx, filePath, contents)
+	e.Diff = auditDiff(filePath, nil, contents)
+	ac.record(e, err)
+	return res, err
+}
+
+// Update is part of the Conn interface
+func (ac *AuditConn) Update(ctx context.Context, filePath string, contents []byte, version Version) (Version, error) {
+	e := ac.newEvent(ctx, "Update", filePath)
+	if !ac.diffs {
+		res, err := ac.conn.Update(ctx, filePath, contents, version)
+		ac.record(e, err)
+		return res, err
+	}
+	old := ac.currentContents(ctx, filePath)
+	res, err := ac.conn.Update(ctx, filePath, contents, version)
+	e.Diff = auditDiff(filePath, old, contents)
+	ac.record(e, err)
+	return res, err
+}
+
+// Get is part of the Conn interface
+func (ac *AuditConn) Get(ctx context.Context, filePath string) ([]byte, Version, error) {
+	return ac.conn.Get(ctx, filePath)
+}
+
+// Delete is part of the Conn interface
+func (ac *AuditConn) Delete(ctx context.Context, filePath string, version Version) error {
+	e := ac.newEvent(ctx, "Delete", filePath)
+	if !ac.diffs {
+		err := ac.conn.Delete(ctx, filePath, version)
+		ac.record(e, err)
+		return err
+	}
+	old := ac.currentContents(ctx, filePath)
+	err := ac.conn.Delete(ctx, filePath, version)
+	e.Diff = auditDiff(filePath, old, nil)
+	ac.record(e, err)
+	return err
+}
+
+// Lock is part of the Conn interface
+func (ac *AuditConn) Lock(ctx context.Context, dirPath, contents string) (LockDescriptor, error) {
+	e := ac.newEvent(ctx, "Lock", dirPath)
+	e.LockContents = contents
+	res, err := ac.conn.Lock(ctx, dirPath, contents)
+	ac.record(e, err)
+	if err != nil {
+		return res, err
+	}
+	return &auditLockDescriptor{
+		ac:       ac,
+		dirPath:  dirPath,
+		contents: contents,
+		ld:       res,
+	}, nil
+}
+
+// Watch is part of the Conn interface
+func (ac *AuditConn) Watch(ctx context.Context, filePath string) (current *WatchData, changes <-chan *WatchData, cancel CancelFunc) {
+	return ac.conn.Watch(ctx, filePath)
+}
+
+// NewMasterParticipation is part of the Conn interface
+func (ac *AuditConn) NewMasterParticipation(name, id string) (MasterParticipation, error) {
+	return ac.conn.NewMasterParticipation(name, id)
+}
+
+// Close is part of the Conn interface
+func (ac *AuditConn) Close() {
+	ac.conn.Close()
+}
+
+// auditLockDescriptor records the Unlock of a lock taken through an
+// AuditConn.
+type auditLockDescriptor struct {
+	ac       *AuditConn
+	dirPath  string
+	contents string
+	ld       LockDescriptor
+}
+
+// Check is part of the LockDescriptor interface.
+func (ald *auditLockDescriptor) Check(ctx context.Context) error {
+	return ald.ld.Check(ctx)
+}
+
+// Unlock is part of the LockDescriptor interface.
+func (ald *auditLockDescriptor) Unlock(ctx context.Context) error {
+	e := ald.ac.newEvent(ctx, "Unlock", ald.dirPath)
+	e.LockContents = ald.contents
+	err := ald.ld.Unlock(ctx)
+	ald.ac.record(e, err)
+	return err
+}
+
+// auditDiff returns the diff between the old and new contents of a
+// file. Either can be nil if the file didn't or doesn't exist.
+func auditDiff(filePath string, oldContents, newContents []byte) string {
+	return diffLines(auditLines(filePath, oldContents), auditLines(filePath, newContents))
+}
+
+// auditLines returns the lines of the contents of a file, decoded to
+// text if it is a protobuf file.
+func auditLines(filePath string, contents []byte) []string {
+	if len(contents) == 0 {
+		return nil
+	}
+	text := string(contents)
+	if p := ProtoForFile(filePath); p != nil && proto.Unmarshal(contents, p) == nil {
+		text = proto.MarshalTextString(p)
+	} else if !utf8.Valid(contents) {
+		return []string{fmt.Sprintf("<%v bytes of binary data>", len(contents))}
+	}
+	text = strings.TrimRight(text, "\n")
+	if text == "" {
+		return nil
+	}
+	return strings.Split(text, "\n")
+}
+
+// diffLines returns a minimal line diff between a and b, with the
+// removed lines prefixed by "- " and the added lines by "+ ". Common
+// lines are omitted.
+func diffLines(a, b []string) string {
+	var buf strings.Builder
+	if len(a)*len(b) > maxAuditDiffSize {
+		for _, line := range a {
+			fmt.Fprintf(&buf, "- %v\n", line)
+		}
+		for _, line := range b {
+			fmt.Fprintf(&buf, "+ %v\n", line)
+		}
+		return buf.String()
+	}
+
+	// lcs[i][j] is the length of the longest common subsequence
+	// of a[i:] and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			switch {
+			case a[i] == b[j]:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			i++
+			j++
+		case i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]):
+			fmt.Fprintf(&buf, "- %v\n", a[i])
+			i++
+		default:
+			fmt.Fprintf(&buf, "+ %v\n", b[j])
+			j++
+		}
+	}
+	return buf.String()
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestDiffLines(t *testing.T) {
+	testcases := []struct {
+		a, b string
+		want string
+	}{{
+		a:    "",
+		b:    "",
+		want: "",
+	}, {
+		a:    "a\nb",
+		b:    "",
+		want: "- a\n- b\n",
+	}, {
+		a:    "",
+		b:    "a\nb",
+		want: "+ a\n+ b\n",
+	}, {
+		a:    "a\nb\nc",
+		b:    "a\nb\nc",
+		want: "",
+	}, {
+		a:    "a\nb\nc",
+		b:    "a\nx\nc",
+		want: "- b\n+ x\n",
+	}, {
+		a:    "a\nb\nc\nd",
+		b:    "b\nc\ne\nd\nf",
+		want: "- a\n+ e\n+ f\n",
+	}}
+	for _, tc := range testcases {
+		var a, b []string
+		if tc.a != "" {
+			a = strings.Split(tc.a, "\n")
+		}
+		if tc.b != "" {
+			b = strings.Split(tc.b, "\n")
+		}
+		assert.Equal(t, tc.want, diffLines(a, b), "diffLines(%q, %q)", tc.a, tc.b)
+	}
+}
+
+func TestAuditDiff(t *testing.T) {
+	oldKs, err := proto.Marshal(&topodatapb.Keyspace{ShardingColumnName: "col1"})
+	assert.NoError(t, err)
+	newKs, err := proto.Marshal(&topodatapb.Keyspace{ShardingColumnName: "col2"})
+	assert.NoError(t, err)
+	assert.Equal(t, "- sharding_column_name: \"col1\"\n+ sharding_column_name: \"col2\"\n", auditDiff("/keyspaces/ks/Keyspace", oldKs, newKs))
+
+	// Other files are diffed as text, or summarized if binary.
+	assert.Equal(t, "+ line\n", auditDiff("/some/file", nil, []byte("line\n")))
+	assert.Equal(t, "- <2 bytes of binary data>\n", auditDiff("/some/file", []byte{0xff, 0xfe}, nil))
+}
@@ -0,0 +1,302 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"math/rand"
+	"os"
+	"path"
+	"strings"
+	"sync"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/streamlog"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+var (
+	// auditSinksFlag lists the sinks of the audit log. If empty,
+	// the topology mutations aren't audited.
+	auditSinksFlag = flag.String("topo_audit_sinks", "", "comma-separated list of sinks for the audit log of the topology mutations done by this process, 'topo', 'streamlog' and 'file' are built in. The 'topo' sink stores the events in the global topology, where vtctld shows the ones of all the processes. The mutations are not audited if empty.")
+
+	// auditDiffs is true if the events of Update and Delete have
+	// a diff.
+	auditDiffs = flag.Bool("topo_audit_diffs", true, "if true, the topology audit events of updates and deletes have the diff of the file. It costs a read of the file before each update and delete.")
+
+	// auditLogFile is the file used by the 'file' audit sink.
+	auditLogFile = flag.String("topo_audit_log_file", "", "file the 'file' topology audit sink appends JSON events to")
+
+	// auditHistorySize is the number of events kept by the
+	// 'topo' audit sink.
+	auditHistorySize = flag.Int("topo_audit_history_size", 100, "number of recent topology audit events kept in the global topology by the 'topo' sink, for display in vtctld")
+
+	// AuditLogger receives the events of the 'streamlog' audit sink.
+	AuditLogger = streamlog.New("TopoAudit", 20)
+
+	// auditSinkFactories has the registered AuditSinkFactory objects.
+	auditSinkFactories = make(map[string]AuditSinkFactory)
+
+	// serveAuditLogsOnce registers the /debug/topo_audit handler
+	// the first time the 'streamlog' sink is used.
+	serveAuditLogsOnce sync.Once
+
+	// auditFileSink is the 'file' sink, opened the first time
+	// it's used.
+	auditFileSinkMu sync.Mutex
+	auditFileSink   *fileAuditSink
+)
+
+// auditQueueSize is the number of events the 'topo' audit sink
+// can queue. Events are dropped if the queue is full.
+const auditQueueSize = 1000
+
+// AuditSink receives the AuditEvents of the topology mutations.
+type AuditSink interface {
+	// Record is called synchronously after each mutation, so it
+	// shouldn't block for long.
+	Record(event *AuditEvent)
+}
+
+// AuditSinkFactory returns the AuditSink to use for a Server.
+// globalCell is the connection to the global cell of the Server,
+// without auditing, for sinks that store the events in the topology.
+// If the AuditSink is also an io.Closer, it is closed with the Server.
+type AuditSinkFactory func(globalCell Conn) (AuditSink, error)
+
+// RegisterAuditSinkFactory registers an AuditSinkFactory, to be used
+// when its name is in the -topo_audit_sinks flag. If a sink with that
+// name already exists, it log.Fatals out.
+func RegisterAuditSinkFactory(name string, factory AuditSinkFactory) {
+	if auditSinkFactories[name] != nil {
+		log.Fatalf("Duplicate topo.AuditSinkFactory registration for %v", name)
+	}
+	auditSinkFactories[name] = factory
+}
+
+// auditSinksFromFlags returns the AuditSinks listed in the
+// -topo_audit_sinks flag.
+func auditSinksFromFlags(globalCell Conn) ([]AuditSink, error) {
+	if *auditSinksFlag == "" {
+		return nil, nil
+	}
+	var sinks []AuditSink
+	for _, name := range strings.Split(*auditSinksFlag, ",") {
+		factory, ok := auditSinkFactories[name]
+		if !ok {
+			return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "unknown topo audit sink %v", name)
+		}
+		sink, err := factory(globalCell)
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "cannot create topo audit sink %v", name)
+		}
+		sinks = append(sinks, sink)
+	}
+	return sinks, nil
+}
+
+// streamlogAuditSink sends the events to AuditLogger.
+type streamlogAuditSink struct{}
+
+// Record is part of the AuditSink interface.
+func (streamlogAuditSink) Record(event *AuditEvent) {
+	AuditLogger.Send(event)
+}
+
+// topoAuditSink stores the events in the AuditPath directory of
+// the global cell, one file per event, and removes the oldest ones
+// beyond -topo_audit_history_size. The files are written in the
+// background, not to slow down the mutations.
+type topoAuditSink struct {
+	conn Conn
+
+	// mu protects closed, events is closed by Close.
+	mu     sync.Mutex
+	closed bool
+	events chan *AuditEvent
+
+	// done is closed when all the events are written.
+	done chan struct{}
+}
+
+func newTopoAuditSink(conn Conn) *topoAuditSink {
+	s := &topoAuditSink{
+		conn:   conn,
+		events: make(chan *AuditEvent, auditQueueSize),
+		done:   make(chan struct{}),
+	}
+	go s.run()
+	return s
+}
+
+// Record is part of the AuditSink interface.
+func (s *topoAuditSink) Record(event *AuditEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+	select {
+	case s.events <- event:
+	default:
+		log.Errorf("topo audit sink queue is full, dropping event %v %v/%v", event.Operation, event.Cell, event.Path)
+	}
+}
+
+// Close writes the queued events, and stops the sink.
+func (s *topoAuditSink) Close() error {
+	s.mu.Lock()
+	if !s.closed {
+		s.closed = true
+		close(s.events)
+	}
+	s.mu.Unlock()
+	<-s.done
+	return nil
+}
+
+func (s *topoAuditSink) run() {
+	defer close(s.done)
+	for event := range s.events {
+		s.write(event)
+		// Prune once the queue is drained, not after each event
+		// of a burst.
+		if len(s.events) == 0 {
+			s.prune()
+		}
+	}
+}
+
+func (s *topoAuditSink) write(event *AuditEvent) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		log.Errorf("cannot marshal topo audit event %v: %v", event, err)
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), *RemoteOperationTimeout)
+	defer cancel()
+	// The names sort in time order. The random part avoids
+	// collisions between processes.
+	name := fmt.Sprintf("%019d-%08x", event.Time.UnixNano(), rand.Uint32())
+	if _, err := s.conn.Create(ctx, path.Join(AuditPath, name), data); err != nil {
+		log.Errorf("cannot store topo audit event %v %v/%v: %v", event.Operation, event.Cell, event.Path, err)
+	}
+}
+
+// prune removes the oldest events beyond -topo_audit_history_size.
+// Other processes may remove the same ones.
+func (s *topoAuditSink) prune() {
+	ctx, cancel := context.WithTimeout(context.Background(), *RemoteOperationTimeout)
+	defer cancel()
+	entries, err := s.conn.ListDir(ctx, AuditPath, false)
+	if err != nil {
+		if !IsErrType(err, NoNode) {
+			log.Errorf("cannot list topo audit events: %v", err)
+		}
+		return
+	}
+	for i := 0; i < len(entries)-*auditHistorySize; i++ {
+		if err := s.conn.Delete(ctx, path.Join(AuditPath, entries[i].Name), nil); err != nil && !IsErrType(err, NoNode) {
+			log.Errorf("cannot remove topo audit event %v: %v", entries[i].Name, err)
+		}
+	}
+}
+
+// RecentAuditEvents returns the most recent events stored in the global
+// cell by the 'topo' audit sinks of all the processes, oldest first.
+func (ts *Server) RecentAuditEvents(ctx context.Context) ([]*AuditEvent, error) {
+	entries, err := ts.globalCell.ListDir(ctx, AuditPath, false)
+	switch {
+	case IsErrType(err, NoNode):
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+	if extra := len(entries) - *auditHistorySize; extra > 0 {
+		entries = entries[extra:]
+	}
+	events := make([]*AuditEvent, 0, len(entries))
+	for _, entry := range entries {
+		data, _, err := ts.globalCell.Get(ctx, path.Join(AuditPath, entry.Name))
+		switch {
+		case IsErrType(err, NoNode):
+			// Pruned in the meantime.
+			continue
+		case err != nil:
+			return nil, err
+		}
+		event := &AuditEvent{}
+		if err := json.Unmarshal(data, event); err != nil {
+			return nil, vterrors.Wrapf(err, "invalid topo audit event %v", entry.Name)
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// fileAuditSink appends the events to a file, as JSON lines.
+type fileAuditSink struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// Record is part of the AuditSink interface.
+func (s *fileAuditSink) Record(event *AuditEvent) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		log.Errorf("cannot marshal topo audit event %v: %v", event, err)
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err := s.file.Write(append(data, '\n')); err != nil {
+		log.Errorf("cannot write topo audit event to %v: %v", s.file.Name(), err)
+	}
+}
+
+func init() {
+	RegisterAuditSinkFactory("topo", func(globalCell Conn) (AuditSink, error) {
+		return newTopoAuditSink(globalCell), nil
+	})
+	RegisterAuditSinkFactory("streamlog", func(Conn) (AuditSink, error) {
+		serveAuditLogsOnce.Do(func() {
+			AuditLogger.ServeLogs("/debug/topo_audit", streamlog.GetFormatter(AuditLogger))
+		})
+		return streamlogAuditSink{}, nil
+	})
+	RegisterAuditSinkFactory("file", func(Conn) (AuditSink, error) {
+		auditFileSinkMu.Lock()
+		defer auditFileSinkMu.Unlock()
+		if auditFileSink != nil {
+			return auditFileSink, nil
+		}
+		if *auditLogFile == "" {
+			return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "topo_audit_log_file must be set for the 'file' topo audit sink")
+		}
+		f, err := os.OpenFile(*auditLogFile, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+		if err != nil {
+			return nil, err
+		}
+		auditFileSink = &fileAuditSink{file: f}
+		return auditFileSink, nil
+	})
+}
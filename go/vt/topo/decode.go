@@ -0,0 +1,55 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"path"
+
+	"github.com/golang/protobuf/proto"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+// ProtoForFile returns an empty protobuf of the type stored in the
+// topology files with the given path, based on its name. It returns
+// nil if the file isn't a known protobuf file.
+func ProtoForFile(filePath string) proto.Message {
+	switch path.Base(filePath) {
+	case CellInfoFile:
+		return new(topodatapb.CellInfo)
+	case CellsAliasFile:
+		return new(topodatapb.CellsAlias)
+	case KeyspaceFile:
+		return new(topodatapb.Keyspace)
+	case ShardFile:
+		return new(topodatapb.Shard)
+	case VSchemaFile:
+		return new(vschemapb.Keyspace)
+	case ShardReplicationFile:
+		return new(topodatapb.ShardReplication)
+	case TabletFile:
+		return new(topodatapb.Tablet)
+	case SrvVSchemaFile:
+		return new(vschemapb.SrvVSchema)
+	case SrvKeyspaceFile:
+		return new(topodatapb.SrvKeyspace)
+	case RoutingRulesFile:
+		return new(vschemapb.RoutingRules)
+	}
+	return nil
+}
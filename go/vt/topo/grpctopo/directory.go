@@ -0,0 +1,46 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpctopo
+
+import (
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo"
+
+	topoproxypb "vitess.io/vitess/go/vt/proto/topoproxy"
+)
+
+// ListDir is part of the topo.Conn interface.
+func (s *Server) ListDir(ctx context.Context, dirPath string, full bool) ([]topo.DirEntry, error) {
+	resp, err := s.c.ListDir(ctx, &topoproxypb.ListDirRequest{
+		Cell: s.cell,
+		Path: dirPath,
+		Full: full,
+	})
+	if err != nil {
+		return nil, convertError(err, dirPath)
+	}
+	var result []topo.DirEntry
+	for _, e := range resp.Entries {
+		result = append(result, topo.DirEntry{
+			Name:      e.Name,
+			Type:      topo.DirEntryType(e.Type),
+			Ephemeral: e.Ephemeral,
+		})
+	}
+	return result, nil
+}
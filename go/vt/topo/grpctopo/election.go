@@ -0,0 +1,118 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpctopo
+
+import (
+	"sync"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo"
+
+	topoproxypb "vitess.io/vitess/go/vt/proto/topoproxy"
+)
+
+// NewMasterParticipation is part of the topo.Server interface
+func (s *Server) NewMasterParticipation(name, id string) (topo.MasterParticipation, error) {
+	return &grpcMasterParticipation{
+		s:    s,
+		name: name,
+		id:   id,
+	}, nil
+}
+
+// grpcMasterParticipation implements topo.MasterParticipation.
+//
+// The proxy takes part in the election as long as the stream is open.
+type grpcMasterParticipation struct {
+	// s is our parent grpc topo Server
+	s *Server
+
+	// name is the name of this MasterParticipation
+	name string
+
+	// id is the process's current id.
+	id string
+
+	// mu protects the following fields.
+	mu sync.Mutex
+	// stopped is set when Stop is called.
+	stopped bool
+	// cancel cancels the current stream, if any.
+	cancel context.CancelFunc
+}
+
+// WaitForMastership is part of the topo.MasterParticipation interface.
+func (mp *grpcMasterParticipation) WaitForMastership() (context.Context, error) {
+	mp.mu.Lock()
+	if mp.stopped {
+		mp.mu.Unlock()
+		return nil, topo.NewError(topo.Interrupted, "mastership")
+	}
+	// The returned context is canceled when the stream ends.
+	masterCtx, masterCancel := context.WithCancel(context.Background())
+	mp.cancel = masterCancel
+	mp.mu.Unlock()
+
+	stream, err := mp.s.c.WaitForMastership(masterCtx, &topoproxypb.WaitForMastershipRequest{
+		Cell: mp.s.cell,
+		Name: mp.name,
+		Id:   mp.id,
+	})
+	if err != nil {
+		masterCancel()
+		return nil, convertError(err, mp.name)
+	}
+	if _, err := stream.Recv(); err != nil {
+		masterCancel()
+		return nil, convertError(err, mp.name)
+	}
+
+	// We are the master until the stream ends.
+	go func() {
+		for {
+			if _, err := stream.Recv(); err != nil {
+				masterCancel()
+				return
+			}
+		}
+	}()
+	return masterCtx, nil
+}
+
+// Stop is part of the topo.MasterParticipation interface
+func (mp *grpcMasterParticipation) Stop() {
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+	mp.stopped = true
+	if mp.cancel != nil {
+		mp.cancel()
+	}
+}
+
+// GetCurrentMasterID is part of the topo.MasterParticipation interface
+func (mp *grpcMasterParticipation) GetCurrentMasterID(ctx context.Context) (string, error) {
+	resp, err := mp.s.c.GetCurrentMasterID(ctx, &topoproxypb.GetCurrentMasterIDRequest{
+		Cell: mp.s.cell,
+		Name: mp.name,
+		Id:   mp.id,
+	})
+	if err != nil {
+		return "", convertError(err, mp.name)
+	}
+	return resp.MasterId, nil
+}
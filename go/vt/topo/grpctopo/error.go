@@ -0,0 +1,56 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpctopo
+
+import (
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// convertError converts a gRPC error into a topo error. The topo proxy
+// sends the topo errors with their own gRPC code.
+func convertError(err error, nodePath string) error {
+	if err == nil {
+		return nil
+	}
+
+	switch err {
+	case context.Canceled:
+		return topo.NewError(topo.Interrupted, nodePath)
+	case context.DeadlineExceeded:
+		return topo.NewError(topo.Timeout, nodePath)
+	}
+
+	switch status.Code(err) {
+	case codes.NotFound:
+		return topo.NewError(topo.NoNode, nodePath)
+	case codes.AlreadyExists:
+		return topo.NewError(topo.NodeExists, nodePath)
+	case codes.Aborted:
+		return topo.NewError(topo.BadVersion, nodePath)
+	case codes.DeadlineExceeded:
+		return topo.NewError(topo.Timeout, nodePath)
+	case codes.Canceled:
+		return topo.NewError(topo.Interrupted, nodePath)
+	default:
+		return vterrors.FromGRPC(err)
+	}
+}
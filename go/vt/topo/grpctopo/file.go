@@ -0,0 +1,82 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpctopo
+
+import (
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo"
+
+	topoproxypb "vitess.io/vitess/go/vt/proto/topoproxy"
+)
+
+// Create is part of the topo.Conn interface.
+func (s *Server) Create(ctx context.Context, filePath string, contents []byte) (topo.Version, error) {
+	resp, err := s.c.Create(ctx, &topoproxypb.CreateRequest{
+		Cell:     s.cell,
+		Path:     filePath,
+		Contents: contents,
+	})
+	if err != nil {
+		return nil, convertError(err, filePath)
+	}
+	return GRPCVersion(resp.Version), nil
+}
+
+// Update is part of the topo.Conn interface.
+func (s *Server) Update(ctx context.Context, filePath string, contents []byte, version topo.Version) (topo.Version, error) {
+	req := &topoproxypb.UpdateRequest{
+		Cell:     s.cell,
+		Path:     filePath,
+		Contents: contents,
+	}
+	if version != nil {
+		req.Version = version.String()
+	}
+	resp, err := s.c.Update(ctx, req)
+	if err != nil {
+		return nil, convertError(err, filePath)
+	}
+	return GRPCVersion(resp.Version), nil
+}
+
+// Get is part of the topo.Conn interface.
+func (s *Server) Get(ctx context.Context, filePath string) ([]byte, topo.Version, error) {
+	resp, err := s.c.Get(ctx, &topoproxypb.GetRequest{
+		Cell: s.cell,
+		Path: filePath,
+	})
+	if err != nil {
+		return nil, nil, convertError(err, filePath)
+	}
+	return resp.Contents, GRPCVersion(resp.Version), nil
+}
+
+// Delete is part of the topo.Conn interface.
+func (s *Server) Delete(ctx context.Context, filePath string, version topo.Version) error {
+	req := &topoproxypb.DeleteRequest{
+		Cell: s.cell,
+		Path: filePath,
+	}
+	if version != nil {
+		req.Version = version.String()
+	}
+	if _, err := s.c.Delete(ctx, req); err != nil {
+		return convertError(err, filePath)
+	}
+	return nil
+}
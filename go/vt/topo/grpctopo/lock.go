@@ -0,0 +1,128 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpctopo
+
+import (
+	"sync"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	topoproxypb "vitess.io/vitess/go/vt/proto/topoproxy"
+	topoproxyservicepb "vitess.io/vitess/go/vt/proto/topoproxyservice"
+)
+
+// grpcLockDescriptor implements topo.LockDescriptor. The proxy holds
+// the lock as long as the stream is open.
+type grpcLockDescriptor struct {
+	dirPath string
+	cancel  context.CancelFunc
+
+	// mu serializes the requests on the stream.
+	mu     sync.Mutex
+	stream topoproxyservicepb.TopoProxy_LockClient
+	// unlocked is set once Unlock was called.
+	unlocked bool
+}
+
+// Lock is part of the topo.Conn interface.
+func (s *Server) Lock(ctx context.Context, dirPath, contents string) (topo.LockDescriptor, error) {
+	// The stream outlives ctx, it is canceled by Unlock.
+	lockCtx, lockCancel := context.WithCancel(context.Background())
+	stream, err := s.c.Lock(lockCtx)
+	if err != nil {
+		lockCancel()
+		return nil, convertError(err, dirPath)
+	}
+	ld := &grpcLockDescriptor{
+		dirPath: dirPath,
+		cancel:  lockCancel,
+		stream:  stream,
+	}
+
+	// Wait until we get the lock, or ctx is done.
+	done := make(chan error, 1)
+	go func() {
+		done <- ld.call(&topoproxypb.LockRequest{
+			Action:   topoproxypb.LockRequest_LOCK,
+			Cell:     s.cell,
+			Path:     dirPath,
+			Contents: contents,
+		})
+	}()
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		// Canceling the stream releases the lock if the proxy
+		// got it in the meantime.
+		lockCancel()
+		return nil, convertError(ctx.Err(), dirPath)
+	}
+	if err != nil {
+		lockCancel()
+		return nil, err
+	}
+	return ld, nil
+}
+
+// call sends a request on the stream, and waits for its response.
+func (ld *grpcLockDescriptor) call(req *topoproxypb.LockRequest) error {
+	ld.mu.Lock()
+	defer ld.mu.Unlock()
+	if ld.unlocked {
+		return vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "lock on %v was already released", ld.dirPath)
+	}
+	if err := ld.stream.Send(req); err != nil {
+		return convertError(ld.recvError(err), ld.dirPath)
+	}
+	if _, err := ld.stream.Recv(); err != nil {
+		return convertError(err, ld.dirPath)
+	}
+	return nil
+}
+
+// recvError returns the error that made Send fail: gRPC returns
+// io.EOF from Send when the stream ended, and the actual error from
+// Recv.
+func (ld *grpcLockDescriptor) recvError(err error) error {
+	if _, rerr := ld.stream.Recv(); rerr != nil {
+		return rerr
+	}
+	return err
+}
+
+// Check is part of the topo.LockDescriptor interface.
+func (ld *grpcLockDescriptor) Check(ctx context.Context) error {
+	return ld.call(&topoproxypb.LockRequest{
+		Action: topoproxypb.LockRequest_CHECK,
+	})
+}
+
+// Unlock is part of the topo.LockDescriptor interface.
+func (ld *grpcLockDescriptor) Unlock(ctx context.Context) error {
+	err := ld.call(&topoproxypb.LockRequest{
+		Action: topoproxypb.LockRequest_UNLOCK,
+	})
+	ld.mu.Lock()
+	ld.unlocked = true
+	ld.mu.Unlock()
+	ld.cancel()
+	return err
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package grpctopo implements topo.Server as a client of the topo proxy
+service (go/vt/topo/topoproxy), which serves the reads from a cache and
+forwards the changes to the actual topo server.
+
+The global server address is the address of the proxy. The proxy serves
+all the cells: the cells are configured in the topo server as usual, and
+the proxy connects to their server address and root, which are ignored
+here. The roots of the proxy are used, so the global root is ignored too.
+
+We follow these conventions within this package:
+
+  - Call convertError(err) on any errors returned from the gRPC calls.
+    Functions defined in this package can be assumed to have already
+    converted errors as necessary.
+*/
+package grpctopo
+
+import (
+	"flag"
+
+	"google.golang.org/grpc"
+
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/topo"
+
+	topoproxyservicepb "vitess.io/vitess/go/vt/proto/topoproxyservice"
+)
+
+var (
+	proxyAddress = flag.String("topo_grpc_proxy_address", "", "address of the topo proxy serving the cells, defaults to the global topo server address")
+
+	cert = flag.String("topo_grpc_cert", "", "the cert to use to connect to the topo proxy")
+	key  = flag.String("topo_grpc_key", "", "the key to use to connect to the topo proxy")
+	ca   = flag.String("topo_grpc_ca", "", "the server ca to use to validate the topo proxy when connecting")
+	name = flag.String("topo_grpc_server_name", "", "the server name to use to validate the topo proxy certificate")
+)
+
+// Factory is the grpc topo.Factory implementation.
+type Factory struct{}
+
+// HasGlobalReadOnlyCell is part of the topo.Factory interface.
+func (f Factory) HasGlobalReadOnlyCell(serverAddr, root string) bool {
+	return false
+}
+
+// Create is part of the topo.Factory interface.
+func (f Factory) Create(cell, serverAddr, root string) (topo.Conn, error) {
+	if cell != topo.GlobalCell {
+		// serverAddr is the address of the topo server of the
+		// cell, which the proxy connects to.
+		serverAddr = *proxyAddress
+		if serverAddr == "" {
+			serverAddr = flag.Lookup("topo_global_server_address").Value.String()
+		}
+	}
+	return NewServer(serverAddr, cell)
+}
+
+// Server is the implementation of topo.Server for the topo proxy.
+type Server struct {
+	cc *grpc.ClientConn
+	c  topoproxyservicepb.TopoProxyClient
+
+	// cell is the name of the cell served by this client.
+	cell string
+}
+
+// Close implements topo.Server.Close.
+// It will nil out the client, so any attempt to
+// re-use this server will panic.
+func (s *Server) Close() {
+	s.cc.Close()
+	s.cc = nil
+	s.c = nil
+}
+
+// NewServer returns a new grpctopo.Server for a cell, connected to the
+// topo proxy at serverAddr.
+func NewServer(serverAddr, cell string) (*Server, error) {
+	opt, err := grpcclient.SecureDialOption(*cert, *key, *ca, *name)
+	if err != nil {
+		return nil, err
+	}
+	cc, err := grpcclient.Dial(serverAddr, grpcclient.FailFast(false), opt)
+	if err != nil {
+		return nil, err
+	}
+	return &Server{
+		cc:   cc,
+		c:    topoproxyservicepb.NewTopoProxyClient(cc),
+		cell: cell,
+	}, nil
+}
+
+func init() {
+	topo.RegisterFactory("grpc", Factory{})
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpctopo
+
+import (
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/topo/test"
+	"vitess.io/vitess/go/vt/topo/topoproxy"
+
+	topoproxyservicepb "vitess.io/vitess/go/vt/proto/topoproxyservice"
+)
+
+func TestGRPCTopo(t *testing.T) {
+	defer func(saved string) { *proxyAddress = saved }(*proxyAddress)
+
+	var servers []*grpc.Server
+	defer func() {
+		for _, server := range servers {
+			server.Stop()
+		}
+	}()
+	newServer := func() *topo.Server {
+		// Each test uses its own topo proxy, in front of its
+		// own memorytopo.
+		listener, err := net.Listen("tcp", "localhost:0")
+		if err != nil {
+			t.Fatalf("Cannot listen: %v", err)
+		}
+		server := grpc.NewServer()
+		topoproxyservicepb.RegisterTopoProxyServer(server, topoproxy.NewServer(memorytopo.NewServer(test.LocalCellName)))
+		go server.Serve(listener)
+		servers = append(servers, server)
+
+		// The cells are served by the same proxy.
+		*proxyAddress = listener.Addr().String()
+		ts, err := topo.OpenServer("grpc", listener.Addr().String(), "")
+		if err != nil {
+			t.Fatalf("OpenServer() failed: %v", err)
+		}
+		return ts
+	}
+
+	// Run the TopoServerTestSuite tests.
+	test.TopoServerTestSuite(t, func() *topo.Server {
+		return newServer()
+	})
+}
@@ -0,0 +1,27 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpctopo
+
+// GRPCVersion is the version of a file, as returned by the topo proxy:
+// the String() value of the version of the topo server.
+// It implements topo.Version.
+type GRPCVersion string
+
+// String is part of the topo.Version interface.
+func (v GRPCVersion) String() string {
+	return string(v)
+}
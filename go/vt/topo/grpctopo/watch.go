@@ -0,0 +1,84 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpctopo
+
+import (
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo"
+
+	topoproxypb "vitess.io/vitess/go/vt/proto/topoproxy"
+)
+
+// Watch is part of the topo.Conn interface.
+func (s *Server) Watch(ctx context.Context, filePath string) (*topo.WatchData, <-chan *topo.WatchData, topo.CancelFunc) {
+	// The stream outlives ctx, it is canceled by the returned
+	// cancel function.
+	watchCtx, watchCancel := context.WithCancel(context.Background())
+	stream, err := s.c.Watch(watchCtx, &topoproxypb.WatchRequest{
+		Cell: s.cell,
+		Path: filePath,
+	})
+	if err != nil {
+		watchCancel()
+		return &topo.WatchData{Err: convertError(err, filePath)}, nil, nil
+	}
+
+	// The first response is the current value, or the error.
+	initial := make(chan struct{})
+	var resp *topoproxypb.WatchResponse
+	go func() {
+		resp, err = stream.Recv()
+		close(initial)
+	}()
+	select {
+	case <-initial:
+	case <-ctx.Done():
+		watchCancel()
+		<-initial
+		return &topo.WatchData{Err: convertError(ctx.Err(), filePath)}, nil, nil
+	}
+	if err != nil {
+		watchCancel()
+		return &topo.WatchData{Err: convertError(err, filePath)}, nil, nil
+	}
+	wd := &topo.WatchData{
+		Contents: resp.Contents,
+		Version:  GRPCVersion(resp.Version),
+	}
+
+	notifications := make(chan *topo.WatchData, 10)
+	go func() {
+		defer close(notifications)
+
+		for {
+			resp, err := stream.Recv()
+			if err != nil {
+				// The stream ends with the error ending
+				// the watch, or Canceled if we canceled it.
+				notifications <- &topo.WatchData{Err: convertError(err, filePath)}
+				return
+			}
+			notifications <- &topo.WatchData{
+				Contents: resp.Contents,
+				Version:  GRPCVersion(resp.Version),
+			}
+		}
+	}()
+
+	return wd, notifications, topo.CancelFunc(watchCancel)
+}
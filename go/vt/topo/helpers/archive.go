@@ -0,0 +1,251 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helpers
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// ArchiveFormatVersion is the version of the archive format written
+// by ExportTopo. ReadArchive refuses archives with a newer version.
+const ArchiveFormatVersion = 1
+
+// Archive is a point-in-time snapshot of the topology: the files of
+// the global cell and of the other cells. It is saved as indented
+// JSON, with the protobuf-encoded files converted to JSON, so it
+// can be read and compared with regular tools.
+type Archive struct {
+	// FormatVersion is the ArchiveFormatVersion the archive was
+	// written with.
+	FormatVersion int `json:"format_version"`
+
+	// Created is when the export started.
+	Created time.Time `json:"created"`
+
+	// Cells maps the cell names (topo.GlobalCell for the global
+	// cell) to their files, sorted by path.
+	Cells map[string][]*ArchiveFile `json:"cells"`
+}
+
+// ArchiveFile is a file in an Archive. Exactly one of Proto, Text
+// or Binary is used to store its contents.
+type ArchiveFile struct {
+	// Path is the path of the file in its cell, like "/keyspaces/ks/Keyspace".
+	Path string `json:"path"`
+
+	// Version is the topo version of the file when it was exported.
+	// It is only informative, as versions are specific to a
+	// topology server.
+	Version string `json:"version,omitempty"`
+
+	// Proto is the JSON representation of a protobuf-encoded
+	// file. The type of the protobuf is implied by the file name.
+	Proto json.RawMessage `json:"proto,omitempty"`
+
+	// Text is the contents of a file that isn't a known protobuf,
+	// but is valid UTF-8.
+	Text string `json:"text,omitempty"`
+
+	// Binary is the contents of any other file.
+	Binary []byte `json:"binary,omitempty"`
+}
+
+// newArchiveFile returns the ArchiveFile for the given topology file.
+// Protobuf files are stored as JSON, unless the conversion would lose
+// data (like fields unknown to this binary).
+func newArchiveFile(filePath string, data []byte, version topo.Version) *ArchiveFile {
+	af := &ArchiveFile{
+		Path: filePath,
+	}
+	if version != nil {
+		af.Version = version.String()
+	}
+	if p := topo.ProtoForFile(filePath); p != nil && proto.Unmarshal(data, p) == nil {
+		if js, err := new(jsonpb.Marshaler).MarshalToString(p); err == nil {
+			decoded := topo.ProtoForFile(filePath)
+			if jsonpb.UnmarshalString(js, decoded) == nil && proto.Equal(p, decoded) {
+				af.Proto = json.RawMessage(js)
+				return af
+			}
+		}
+	}
+	if utf8.Valid(data) {
+		af.Text = string(data)
+		return af
+	}
+	af.Binary = data
+	return af
+}
+
+// Data returns the contents of the file, as stored in the topology.
+func (af *ArchiveFile) Data() ([]byte, error) {
+	switch {
+	case af.Proto != nil:
+		p := topo.ProtoForFile(af.Path)
+		if p == nil {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "file %v has protobuf contents, but isn't a known protobuf file", af.Path)
+		}
+		if err := jsonpb.UnmarshalString(string(af.Proto), p); err != nil {
+			return nil, vterrors.Wrapf(err, "cannot decode %v", af.Path)
+		}
+		return proto.Marshal(p)
+	case af.Binary != nil:
+		return af.Binary, nil
+	}
+	return []byte(af.Text), nil
+}
+
+// sameContents returns true if the two versions of a topology file
+// have the same contents. Protobuf files are compared after decoding,
+// as equal protobufs may have different encodings.
+func sameContents(filePath string, left, right []byte) bool {
+	l := topo.ProtoForFile(filePath)
+	r := topo.ProtoForFile(filePath)
+	if l != nil && proto.Unmarshal(left, l) == nil && proto.Unmarshal(right, r) == nil {
+		return proto.Equal(l, r)
+	}
+	return string(left) == string(right)
+}
+
+// ExportTopo returns an Archive of the given cells. If cells is
+// empty, the global cell and all the cells it knows about are
+// exported. Ephemeral files, like the ones used for locks and master
+// elections, are skipped.
+//
+// The files are read one by one, so the archive is only consistent
+// if the topology isn't modified during the export.
+func ExportTopo(ctx context.Context, ts *topo.Server, cells []string) (*Archive, error) {
+	a := &Archive{
+		FormatVersion: ArchiveFormatVersion,
+		Created:       time.Now().UTC(),
+		Cells:         make(map[string][]*ArchiveFile),
+	}
+	if len(cells) == 0 {
+		names, err := ts.GetCellInfoNames(ctx)
+		if err != nil {
+			return nil, vterrors.Wrap(err, "GetCellInfoNames")
+		}
+		cells = append([]string{topo.GlobalCell}, names...)
+	}
+	for _, cell := range cells {
+		conn, err := ts.ConnForCell(ctx, cell)
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "ConnForCell(%v)", cell)
+		}
+		files := []*ArchiveFile{}
+		if err := exportDir(ctx, conn, "/", &files); err != nil {
+			return nil, vterrors.Wrapf(err, "cannot export cell %v", cell)
+		}
+		a.Cells[cell] = files
+	}
+	return a, nil
+}
+
+// exportDir recursively adds the files in dirPath to files.
+func exportDir(ctx context.Context, conn topo.Conn, dirPath string, files *[]*ArchiveFile) error {
+	entries, err := conn.ListDir(ctx, dirPath, true /*full*/)
+	switch {
+	case err == nil:
+	case topo.IsErrType(err, topo.NoNode):
+		// Empty cell, or directory removed since we listed its parent.
+		return nil
+	default:
+		return vterrors.Wrapf(err, "ListDir(%v)", dirPath)
+	}
+
+	for _, entry := range entries {
+		if entry.Ephemeral {
+			continue
+		}
+		entryPath := path.Join(dirPath, entry.Name)
+		if entry.Type == topo.TypeDirectory {
+			if err := exportDir(ctx, conn, entryPath, files); err != nil {
+				return err
+			}
+			continue
+		}
+		data, version, err := conn.Get(ctx, entryPath)
+		switch {
+		case err == nil:
+			*files = append(*files, newArchiveFile(entryPath, data, version))
+		case topo.IsErrType(err, topo.NoNode):
+			// Removed since we listed the directory.
+		default:
+			return vterrors.Wrapf(err, "Get(%v)", entryPath)
+		}
+	}
+	return nil
+}
+
+// WriteArchive saves an Archive in the given file.
+func WriteArchive(a *Archive, fileName string) error {
+	data, err := json.MarshalIndent(a, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(fileName, append(data, '\n'), 0644)
+}
+
+// ReadArchive loads an Archive saved by WriteArchive.
+func ReadArchive(fileName string) (*Archive, error) {
+	data, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+	a := &Archive{}
+	if err := json.Unmarshal(data, a); err != nil {
+		return nil, vterrors.Wrapf(err, "cannot parse archive %v", fileName)
+	}
+	if a.FormatVersion < 1 || a.FormatVersion > ArchiveFormatVersion {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "archive %v has unsupported format version %v", fileName, a.FormatVersion)
+	}
+	for cell, files := range a.Cells {
+		sort.Slice(files, func(i, j int) bool { return files[i].Path < files[j].Path })
+		a.Cells[cell] = files
+	}
+	return a, nil
+}
+
+// matchesPaths returns true if filePath is one of paths, or in one of
+// their sub-directories. All files match an empty list of paths.
+func matchesPaths(filePath string, paths []string) bool {
+	if len(paths) == 0 {
+		return true
+	}
+	for _, p := range paths {
+		p = path.Clean("/" + p)
+		if p == "/" || filePath == p || strings.HasPrefix(filePath, p+"/") {
+			return true
+		}
+	}
+	return false
+}
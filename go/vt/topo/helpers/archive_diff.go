@@ -0,0 +1,110 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helpers
+
+import (
+	"sort"
+)
+
+// FileDiff is a file that differs between two topologies.
+type FileDiff struct {
+	// Cell and Path identify the file.
+	Cell string
+	Path string
+
+	// Left and Right are the contents of the file on each side, or
+	// nil if the file doesn't exist there.
+	Left  []byte
+	Right []byte
+}
+
+// DiffArchives compares two archives, and returns the files that
+// differ, sorted by cell and path. To compare an archive with a live
+// topology, export the topology with ExportTopo first.
+func DiffArchives(left, right *Archive) ([]*FileDiff, error) {
+	cellSet := make(map[string]bool)
+	for cell := range left.Cells {
+		cellSet[cell] = true
+	}
+	for cell := range right.Cells {
+		cellSet[cell] = true
+	}
+	cells := make([]string, 0, len(cellSet))
+	for cell := range cellSet {
+		cells = append(cells, cell)
+	}
+	sort.Strings(cells)
+
+	var result []*FileDiff
+	for _, cell := range cells {
+		leftFiles, err := archiveCellData(left, cell)
+		if err != nil {
+			return nil, err
+		}
+		rightFiles, err := archiveCellData(right, cell)
+		if err != nil {
+			return nil, err
+		}
+
+		paths := make([]string, 0, len(leftFiles)+len(rightFiles))
+		for p := range leftFiles {
+			paths = append(paths, p)
+		}
+		for p := range rightFiles {
+			if _, ok := leftFiles[p]; !ok {
+				paths = append(paths, p)
+			}
+		}
+		sort.Strings(paths)
+
+		for _, p := range paths {
+			l, inLeft := leftFiles[p]
+			r, inRight := rightFiles[p]
+			if inLeft && inRight && sameContents(p, l, r) {
+				continue
+			}
+			// Make sure missing files are nil, and existing
+			// empty files are not.
+			fd := &FileDiff{
+				Cell: cell,
+				Path: p,
+			}
+			if inLeft {
+				fd.Left = append([]byte{}, l...)
+			}
+			if inRight {
+				fd.Right = append([]byte{}, r...)
+			}
+			result = append(result, fd)
+		}
+	}
+	return result, nil
+}
+
+// archiveCellData returns the contents of the files of a cell in an
+// archive, by path.
+func archiveCellData(a *Archive, cell string) (map[string][]byte, error) {
+	result := make(map[string][]byte)
+	for _, af := range a.Cells[cell] {
+		data, err := af.Data()
+		if err != nil {
+			return nil, err
+		}
+		result[af.Path] = data
+	}
+	return result, nil
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helpers
+
+import (
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// RestoreOptions are the parameters of RestoreArchive.
+type RestoreOptions struct {
+	// Cell is the cell to restore, from the files the archive has
+	// for the same cell.
+	Cell string
+
+	// Paths restricts the restore to these files and directories.
+	// All the files of the cell are restored if it is empty.
+	Paths []string
+
+	// Force overwrites the files that have different contents in
+	// the topology. Without it, nothing is written if there is any
+	// such conflict.
+	Force bool
+
+	// DryRun only computes what would be done, without writing
+	// anything.
+	DryRun bool
+}
+
+// RestoreResult lists the files RestoreArchive wrote, or would write
+// in DryRun mode.
+type RestoreResult struct {
+	// Created are the files that didn't exist in the topology.
+	Created []string
+
+	// Overwritten are the conflicting files replaced because of
+	// RestoreOptions.Force.
+	Overwritten []string
+
+	// Conflicts are the files with different contents in the
+	// topology, left alone.
+	Conflicts []string
+
+	// Unchanged are the files that already had the archived
+	// contents.
+	Unchanged []string
+}
+
+// RestoreArchive writes files of an archive back to the topology.
+// Files that only exist in the topology are not removed. Writes are
+// conditional on the topology files not changing while the restore
+// runs: if they do, the restore stops with an error, and the files
+// already written are listed in the returned result.
+func RestoreArchive(ctx context.Context, ts *topo.Server, a *Archive, opts RestoreOptions) (*RestoreResult, error) {
+	files, ok := a.Cells[opts.Cell]
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "archive has no cell %v", opts.Cell)
+	}
+	conn, err := ts.ConnForCell(ctx, opts.Cell)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "ConnForCell(%v)", opts.Cell)
+	}
+
+	// First pass: compare the archived files with the topology.
+	type write struct {
+		path    string
+		data    []byte
+		version topo.Version // nil to create the file
+	}
+	var writes []write
+	result := &RestoreResult{}
+	for _, af := range files {
+		if !matchesPaths(af.Path, opts.Paths) {
+			continue
+		}
+		data, err := af.Data()
+		if err != nil {
+			return nil, err
+		}
+		current, version, err := conn.Get(ctx, af.Path)
+		switch {
+		case topo.IsErrType(err, topo.NoNode):
+			result.Created = append(result.Created, af.Path)
+			writes = append(writes, write{path: af.Path, data: data})
+		case err != nil:
+			return nil, vterrors.Wrapf(err, "Get(%v)", af.Path)
+		case sameContents(af.Path, current, data):
+			result.Unchanged = append(result.Unchanged, af.Path)
+		case opts.Force:
+			result.Overwritten = append(result.Overwritten, af.Path)
+			writes = append(writes, write{path: af.Path, data: data, version: version})
+		default:
+			result.Conflicts = append(result.Conflicts, af.Path)
+		}
+	}
+	if len(result.Created)+len(result.Overwritten)+len(result.Conflicts)+len(result.Unchanged) == 0 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "no file to restore in cell %v matches %v", opts.Cell, opts.Paths)
+	}
+	if len(result.Conflicts) > 0 {
+		return result, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "%v file(s) have different contents in cell %v, not restoring anything: %v", len(result.Conflicts), opts.Cell, result.Conflicts)
+	}
+	if opts.DryRun {
+		return result, nil
+	}
+
+	// Second pass: write the files.
+	done := &RestoreResult{
+		Unchanged: result.Unchanged,
+	}
+	for _, w := range writes {
+		if w.version == nil {
+			if _, err := conn.Create(ctx, w.path, w.data); err != nil {
+				return done, vterrors.Wrapf(err, "Create(%v)", w.path)
+			}
+			done.Created = append(done.Created, w.path)
+			continue
+		}
+		if _, err := conn.Update(ctx, w.path, w.data, w.version); err != nil {
+			return done, vterrors.Wrapf(err, "Update(%v)", w.path)
+		}
+		done.Overwritten = append(done.Overwritten, w.path)
+	}
+	return done, nil
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helpers
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestArchive(t *testing.T) {
+	ctx := context.Background()
+	fromTS, toTS := createSetup(ctx, t)
+
+	// Add a text and a binary file, they are archived as is.
+	conn, err := fromTS.ConnForCell(ctx, topo.GlobalCell)
+	require.NoError(t, err)
+	_, err = conn.Create(ctx, "/dir/text", []byte("some text"))
+	require.NoError(t, err)
+	_, err = conn.Create(ctx, "/dir/binary", []byte{0xff, 0x00, 0xfe})
+	require.NoError(t, err)
+
+	// Export to a file, and read it back.
+	a, err := ExportTopo(ctx, fromTS, nil)
+	require.NoError(t, err)
+	dir, err := ioutil.TempDir("", "archive_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	fileName := path.Join(dir, "topo.json")
+	require.NoError(t, WriteArchive(a, fileName))
+	a, err = ReadArchive(fileName)
+	require.NoError(t, err)
+	assert.Equal(t, ArchiveFormatVersion, a.FormatVersion)
+
+	files := make(map[string]*ArchiveFile)
+	for cell, cellFiles := range a.Cells {
+		for _, af := range cellFiles {
+			files[cell+":"+af.Path] = af
+		}
+	}
+	assert.Contains(t, files, "test_cell:/tablets/test_cell-0000000123/Tablet")
+	assert.Contains(t, files, "test_cell:/keyspaces/test_keyspace/shards/0/ShardReplication")
+	require.Contains(t, files, "global:/keyspaces/test_keyspace/Keyspace")
+	assert.NotNil(t, files["global:/keyspaces/test_keyspace/Keyspace"].Proto)
+	require.Contains(t, files, "global:/RoutingRules")
+	assert.Contains(t, string(files["global:/RoutingRules"].Proto), `"fromTable"`)
+	require.Contains(t, files, "global:/dir/text")
+	assert.Equal(t, "some text", files["global:/dir/text"].Text)
+	require.Contains(t, files, "global:/dir/binary")
+	assert.Equal(t, []byte{0xff, 0x00, 0xfe}, files["global:/dir/binary"].Binary)
+
+	// An empty topology only differs by the files it misses.
+	live, err := ExportTopo(ctx, toTS, nil)
+	require.NoError(t, err)
+	diffs, err := DiffArchives(a, live)
+	require.NoError(t, err)
+	require.NotEmpty(t, diffs)
+	for _, fd := range diffs {
+		assert.NotNil(t, fd.Left, "%v:%v", fd.Cell, fd.Path)
+		assert.Nil(t, fd.Right, "%v:%v", fd.Cell, fd.Path)
+	}
+
+	// A dry run doesn't write anything.
+	result, err := RestoreArchive(ctx, toTS, a, RestoreOptions{Cell: topo.GlobalCell, DryRun: true})
+	require.NoError(t, err)
+	assert.Contains(t, result.Created, "/keyspaces/test_keyspace/Keyspace")
+	_, err = toTS.GetKeyspace(ctx, "test_keyspace")
+	assert.True(t, topo.IsErrType(err, topo.NoNode), "got %v", err)
+
+	// Restore everything, both topologies are then the same.
+	for _, cell := range []string{topo.GlobalCell, "test_cell"} {
+		_, err := RestoreArchive(ctx, toTS, a, RestoreOptions{Cell: cell})
+		require.NoError(t, err)
+	}
+	live, err = ExportTopo(ctx, toTS, nil)
+	require.NoError(t, err)
+	diffs, err = DiffArchives(a, live)
+	require.NoError(t, err)
+	assert.Empty(t, diffs)
+	tablets, err := toTS.GetTabletsByCell(ctx, "test_cell")
+	require.NoError(t, err)
+	assert.Len(t, tablets, 2)
+
+	// Modified files are conflicts.
+	toConn, err := toTS.ConnForCell(ctx, topo.GlobalCell)
+	require.NoError(t, err)
+	data, err := proto.Marshal(&topodatapb.Keyspace{ShardingColumnName: "modified"})
+	require.NoError(t, err)
+	_, err = toConn.Update(ctx, "/keyspaces/test_keyspace/Keyspace", data, nil)
+	require.NoError(t, err)
+	live, err = ExportTopo(ctx, toTS, []string{topo.GlobalCell})
+	require.NoError(t, err)
+	diffs, err = DiffArchives(a, live)
+	require.NoError(t, err)
+	require.Len(t, diffs, len(a.Cells["test_cell"])+1)
+	assert.Equal(t, "/keyspaces/test_keyspace/Keyspace", diffs[0].Path)
+	assert.NotNil(t, diffs[0].Left)
+	assert.NotNil(t, diffs[0].Right)
+
+	opts := RestoreOptions{
+		Cell:  topo.GlobalCell,
+		Paths: []string{"/keyspaces/test_keyspace"},
+	}
+	result, err = RestoreArchive(ctx, toTS, a, opts)
+	require.Error(t, err)
+	assert.Equal(t, []string{"/keyspaces/test_keyspace/Keyspace"}, result.Conflicts)
+	ki, err := toTS.GetKeyspace(ctx, "test_keyspace")
+	require.NoError(t, err)
+	assert.Equal(t, "modified", ki.ShardingColumnName)
+
+	opts.Force = true
+	result, err = RestoreArchive(ctx, toTS, a, opts)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/keyspaces/test_keyspace/Keyspace"}, result.Overwritten)
+	assert.Empty(t, result.Created)
+	assert.NotEmpty(t, result.Unchanged)
+	ki, err = toTS.GetKeyspace(ctx, "test_keyspace")
+	require.NoError(t, err)
+	assert.Equal(t, "", ki.ShardingColumnName)
+
+	// Paths that match nothing are an error.
+	opts.Paths = []string{"/keyspaces/test"}
+	_, err = RestoreArchive(ctx, toTS, a, opts)
+	assert.Error(t, err)
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqltopo
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo"
+)
+
+// ListDir is part of the topo.Conn interface.
+func (s *Server) ListDir(ctx context.Context, dirPath string, full bool) ([]topo.DirEntry, error) {
+	nodePath := path.Join(s.root, dirPath) + "/"
+	if nodePath == "//" {
+		// Special case where s.root is "/", dirPath is empty,
+		// we would end up with "//". in that case, we want "/".
+		nodePath = "/"
+	}
+
+	// Select the paths starting with nodePath. We don't use LIKE, as
+	// '_' and '%' are valid in paths: the paths starting with
+	// "dir/" are the ones between "dir/" and "dir0", as '0'
+	// follows '/'.
+	upperBound := nodePath[:len(nodePath)-1] + "0"
+	qr, err := s.exec(ctx, nodePath, fmt.Sprintf("SELECT path FROM topo_files WHERE path >= %v AND path < %v ORDER BY path", encodeValue([]byte(nodePath)), encodeValue([]byte(upperBound))))
+	if err != nil {
+		return nil, err
+	}
+	if len(qr.Rows) == 0 {
+		// No file starts with this prefix, means the directory
+		// doesn't exist.
+		return nil, topo.NewError(topo.NoNode, nodePath)
+	}
+
+	prefixLen := len(nodePath)
+	var result []topo.DirEntry
+	for _, row := range qr.Rows {
+		p := row[0].ToString()[prefixLen:]
+
+		// Keep only the part until the first '/'.
+		t := topo.TypeFile
+		if i := strings.Index(p, "/"); i >= 0 {
+			p = p[:i]
+			t = topo.TypeDirectory
+		}
+
+		// Remove duplicates, add to list. Locks are in their own
+		// table, so there are no ephemeral entries.
+		if len(result) == 0 || result[len(result)-1].Name != p {
+			e := topo.DirEntry{
+				Name: p,
+			}
+			if full {
+				e.Type = t
+			}
+			result = append(result, e)
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqltopo
+
+import (
+	"fmt"
+	"path"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo"
+)
+
+// NewMasterParticipation is part of the topo.Server interface
+func (s *Server) NewMasterParticipation(name, id string) (topo.MasterParticipation, error) {
+	return &mysqlMasterParticipation{
+		s:    s,
+		name: name,
+		id:   id,
+		stop: make(chan struct{}),
+		done: make(chan struct{}),
+	}, nil
+}
+
+// mysqlMasterParticipation implements topo.MasterParticipation.
+//
+// The master holds the lock row of the election path, with its id as
+// the contents.
+type mysqlMasterParticipation struct {
+	// s is our parent mysql topo Server
+	s *Server
+
+	// name is the name of this MasterParticipation
+	name string
+
+	// id is the process's current id.
+	id string
+
+	// stop is a channel closed when Stop is called.
+	stop chan struct{}
+
+	// done is a channel closed when we're done processing the Stop
+	done chan struct{}
+}
+
+// WaitForMastership is part of the topo.MasterParticipation interface.
+func (mp *mysqlMasterParticipation) WaitForMastership() (context.Context, error) {
+	// If Stop was already called, mp.done is closed, so we are interrupted.
+	select {
+	case <-mp.done:
+		return nil, topo.NewError(topo.Interrupted, "mastership")
+	default:
+	}
+
+	electionPath := path.Join(electionsPath, mp.name)
+	var ld *mysqlLockDescriptor
+
+	// We use a cancelable context here. If stop is closed, or if we
+	// lose the lease, we just cancel that context.
+	lockCtx, lockCancel := context.WithCancel(context.Background())
+	go func() {
+		<-mp.stop
+		if ld != nil {
+			if err := ld.Unlock(context.Background()); err != nil {
+				log.Errorf("failed to unlock electionPath %v: %v", electionPath, err)
+			}
+		}
+		lockCancel()
+		close(mp.done)
+	}()
+
+	// Try to get the mastership, by getting a lock.
+	var err error
+	ld, err = mp.s.lock(lockCtx, electionPath, mp.id, lockCancel)
+	if err != nil {
+		// It can be that we were interrupted.
+		return nil, err
+	}
+
+	// We got the lock. Return the lockContext. If Stop() is called,
+	// it will cancel the lockCtx, and cancel the returned context.
+	return lockCtx, nil
+}
+
+// Stop is part of the topo.MasterParticipation interface
+func (mp *mysqlMasterParticipation) Stop() {
+	close(mp.stop)
+	<-mp.done
+}
+
+// GetCurrentMasterID is part of the topo.MasterParticipation interface
+func (mp *mysqlMasterParticipation) GetCurrentMasterID(ctx context.Context) (string, error) {
+	electionPath := path.Join(mp.s.root, electionsPath, mp.name, locksPath)
+
+	qr, err := mp.s.exec(ctx, electionPath, fmt.Sprintf("SELECT contents FROM topo_locks WHERE path = %v AND expiry >= UNIX_TIMESTAMP()", encodeValue([]byte(electionPath))))
+	if err != nil {
+		return "", err
+	}
+	if len(qr.Rows) == 0 {
+		// Nobody holds the lock, means nobody is the master.
+		return "", nil
+	}
+	return qr.Rows[0][0].ToString(), nil
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqltopo
+
+import (
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/topo"
+)
+
+// convertError converts a MySQL or context error into a topo error.
+func convertError(err error, nodePath string) error {
+	if err == nil {
+		return nil
+	}
+
+	if sqlErr, ok := err.(*mysql.SQLError); ok {
+		switch sqlErr.Number() {
+		case mysql.ERDupEntry:
+			return topo.NewError(topo.NodeExists, nodePath)
+		case mysql.ERLockWaitTimeout, mysql.ERQueryInterrupted:
+			return topo.NewError(topo.Timeout, nodePath)
+		}
+		return err
+	}
+
+	switch err {
+	case context.Canceled:
+		return topo.NewError(topo.Interrupted, nodePath)
+	case context.DeadlineExceeded:
+		return topo.NewError(topo.Timeout, nodePath)
+	default:
+		return err
+	}
+}
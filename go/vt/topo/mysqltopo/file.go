@@ -0,0 +1,125 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqltopo
+
+import (
+	"fmt"
+	"path"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo"
+)
+
+// Create is part of the topo.Conn interface.
+func (s *Server) Create(ctx context.Context, filePath string, contents []byte) (topo.Version, error) {
+	nodePath := path.Join(s.root, filePath)
+
+	version, err := s.nextVersion(ctx, nodePath)
+	if err != nil {
+		return nil, err
+	}
+	// The primary key makes the insert fail if the file exists,
+	// convertError turns it into NodeExists.
+	if _, err := s.exec(ctx, nodePath, fmt.Sprintf("INSERT INTO topo_files (path, data, version) VALUES (%v, %v, %v)", encodeValue([]byte(nodePath)), encodeValue(contents), version)); err != nil {
+		return nil, err
+	}
+	return version, nil
+}
+
+// Update is part of the topo.Conn interface.
+func (s *Server) Update(ctx context.Context, filePath string, contents []byte, version topo.Version) (topo.Version, error) {
+	nodePath := path.Join(s.root, filePath)
+
+	newVersion, err := s.nextVersion(ctx, nodePath)
+	if err != nil {
+		return nil, err
+	}
+
+	if version != nil {
+		// Only update the file if its version is what we expect.
+		qr, err := s.exec(ctx, nodePath, fmt.Sprintf("UPDATE topo_files SET data = %v, version = %v WHERE path = %v AND version = %v", encodeValue(contents), newVersion, encodeValue([]byte(nodePath)), int64(version.(MySQLVersion))))
+		if err != nil {
+			return nil, err
+		}
+		if qr.RowsAffected == 0 {
+			return nil, s.missingOrBadVersion(ctx, nodePath)
+		}
+		return newVersion, nil
+	}
+
+	// No version specified. We can create or update the file.
+	if _, err := s.exec(ctx, nodePath, fmt.Sprintf("INSERT INTO topo_files (path, data, version) VALUES (%v, %v, %v) ON DUPLICATE KEY UPDATE data = VALUES(data), version = VALUES(version)", encodeValue([]byte(nodePath)), encodeValue(contents), newVersion)); err != nil {
+		return nil, err
+	}
+	return newVersion, nil
+}
+
+// Get is part of the topo.Conn interface.
+func (s *Server) Get(ctx context.Context, filePath string) ([]byte, topo.Version, error) {
+	nodePath := path.Join(s.root, filePath)
+	return s.get(ctx, nodePath)
+}
+
+// get returns the contents and version of the file at the full path
+// nodePath.
+func (s *Server) get(ctx context.Context, nodePath string) ([]byte, topo.Version, error) {
+	qr, err := s.exec(ctx, nodePath, fmt.Sprintf("SELECT data, version FROM topo_files WHERE path = %v", encodeValue([]byte(nodePath))))
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(qr.Rows) != 1 {
+		return nil, nil, topo.NewError(topo.NoNode, nodePath)
+	}
+	version, err := qr.Rows[0][1].ToInt64()
+	if err != nil {
+		return nil, nil, err
+	}
+	return qr.Rows[0][0].ToBytes(), MySQLVersion(version), nil
+}
+
+// Delete is part of the topo.Conn interface.
+func (s *Server) Delete(ctx context.Context, filePath string, version topo.Version) error {
+	nodePath := path.Join(s.root, filePath)
+
+	query := fmt.Sprintf("DELETE FROM topo_files WHERE path = %v", encodeValue([]byte(nodePath)))
+	if version != nil {
+		query += fmt.Sprintf(" AND version = %v", int64(version.(MySQLVersion)))
+	}
+	qr, err := s.exec(ctx, nodePath, query)
+	if err != nil {
+		return err
+	}
+	if qr.RowsAffected == 0 {
+		if version != nil {
+			return s.missingOrBadVersion(ctx, nodePath)
+		}
+		return topo.NewError(topo.NoNode, nodePath)
+	}
+	return nil
+}
+
+// missingOrBadVersion returns the error of a conditional change that
+// didn't change any row: either the file doesn't exist, or its version
+// isn't the expected one.
+func (s *Server) missingOrBadVersion(ctx context.Context, nodePath string) error {
+	_, _, err := s.get(ctx, nodePath)
+	if err != nil {
+		return err
+	}
+	return topo.NewError(topo.BadVersion, nodePath)
+}
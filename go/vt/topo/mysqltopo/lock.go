@@ -0,0 +1,196 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqltopo
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"path"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// mysqlLockDescriptor implements topo.LockDescriptor.
+type mysqlLockDescriptor struct {
+	s        *Server
+	nodePath string
+	owner    string
+
+	// stop is closed by Unlock, to stop refreshing the lease.
+	stop chan struct{}
+	// done is closed when the lease isn't refreshed any more.
+	done chan struct{}
+	// unlockOnce makes sure Unlock only stops the refresh once.
+	unlockOnce sync.Once
+}
+
+// Lock is part of the topo.Conn interface.
+func (s *Server) Lock(ctx context.Context, dirPath, contents string) (topo.LockDescriptor, error) {
+	// We list the directory first to make sure it exists.
+	if _, err := s.ListDir(ctx, dirPath, false /*full*/); err != nil {
+		// We need to return the right error codes, like
+		// topo.ErrNoNode and topo.ErrInterrupted, and the
+		// easiest way to do this is to return convertError(err).
+		// It may lose some of the context, if this is an issue,
+		// maybe logging the error would work here.
+		return nil, convertError(err, dirPath)
+	}
+
+	return s.lock(ctx, dirPath, contents, nil)
+}
+
+// lock is used by both Lock() and master election. It takes the lock
+// row of nodePath, waiting until its current owner releases it or lets
+// it expire. onLost, if set, is called if the lease is lost while the
+// lock is held.
+func (s *Server) lock(ctx context.Context, nodePath, contents string, onLost func()) (*mysqlLockDescriptor, error) {
+	nodePath = path.Join(s.root, nodePath, locksPath)
+
+	ownerBytes := make([]byte, 16)
+	if _, err := rand.Read(ownerBytes); err != nil {
+		return nil, vterrors.Wrap(err, "cannot generate lock owner")
+	}
+	owner := hex.EncodeToString(ownerBytes)
+
+	for {
+		// Insert our row, or take over the existing one if it
+		// expired. The expiry is assigned last, as the other
+		// assignments need its old value.
+		expired := "expiry < UNIX_TIMESTAMP()"
+		if _, err := s.exec(ctx, nodePath, fmt.Sprintf("INSERT INTO topo_locks (path, owner, contents, expiry) VALUES (%v, %v, %v, UNIX_TIMESTAMP() + %v) ON DUPLICATE KEY UPDATE owner = IF(%v, VALUES(owner), owner), contents = IF(%v, VALUES(contents), contents), expiry = IF(%v, VALUES(expiry), expiry)",
+			encodeValue([]byte(nodePath)), encodeValue([]byte(owner)), encodeValue([]byte(contents)), *leaseTTL, expired, expired, expired)); err != nil {
+			return nil, err
+		}
+		held, err := s.lockHeld(ctx, nodePath, owner)
+		if err != nil {
+			// We may have taken the lock, release it so it
+			// isn't left behind for *leaseTTL seconds.
+			if _, derr := s.deleteLock(context.Background(), nodePath, owner); derr != nil {
+				log.Warningf("failed to release the lock on %v, may have left it behind: %v", nodePath, derr)
+			}
+			return nil, err
+		}
+		if held {
+			ld := &mysqlLockDescriptor{
+				s:        s,
+				nodePath: nodePath,
+				owner:    owner,
+				stop:     make(chan struct{}),
+				done:     make(chan struct{}),
+			}
+			go ld.refresh(onLost)
+			return ld, nil
+		}
+
+		// Someone else holds the lock, try again later.
+		select {
+		case <-ctx.Done():
+			return nil, convertError(ctx.Err(), nodePath)
+		case <-time.After(*pollInterval):
+		}
+	}
+}
+
+// lockHeld returns true if owner holds the unexpired lock on nodePath.
+func (s *Server) lockHeld(ctx context.Context, nodePath, owner string) (bool, error) {
+	qr, err := s.exec(ctx, nodePath, fmt.Sprintf("SELECT 1 FROM topo_locks WHERE path = %v AND owner = %v AND expiry >= UNIX_TIMESTAMP()", encodeValue([]byte(nodePath)), encodeValue([]byte(owner))))
+	if err != nil {
+		return false, err
+	}
+	return len(qr.Rows) == 1, nil
+}
+
+// deleteLock deletes the lock on nodePath if owner holds it, and
+// returns true if it did.
+func (s *Server) deleteLock(ctx context.Context, nodePath, owner string) (bool, error) {
+	qr, err := s.exec(ctx, nodePath, fmt.Sprintf("DELETE FROM topo_locks WHERE path = %v AND owner = %v", encodeValue([]byte(nodePath)), encodeValue([]byte(owner))))
+	if err != nil {
+		return false, err
+	}
+	return qr.RowsAffected == 1, nil
+}
+
+// refresh pushes the expiry of the lease back, until Unlock is called
+// or the lease is lost.
+func (ld *mysqlLockDescriptor) refresh(onLost func()) {
+	defer close(ld.done)
+
+	interval := time.Duration(*leaseTTL) * time.Second / 3
+	for {
+		select {
+		case <-ld.stop:
+			return
+		case <-time.After(interval):
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), interval)
+		_, err := ld.s.exec(ctx, ld.nodePath, fmt.Sprintf("UPDATE topo_locks SET expiry = UNIX_TIMESTAMP() + %v WHERE path = %v AND owner = %v", *leaseTTL, encodeValue([]byte(ld.nodePath)), encodeValue([]byte(ld.owner))))
+		if err == nil {
+			err = ld.Check(ctx)
+		}
+		cancel()
+		if err != nil {
+			if topo.IsErrType(err, topo.NoNode) {
+				log.Errorf("lost the lock on %v: %v", ld.nodePath, err)
+				if onLost != nil {
+					onLost()
+				}
+				return
+			}
+			// The lease may still be ours, try again at the
+			// next refresh.
+			log.Warningf("failed to refresh the lock on %v: %v", ld.nodePath, err)
+		}
+	}
+}
+
+// Check is part of the topo.LockDescriptor interface.
+func (ld *mysqlLockDescriptor) Check(ctx context.Context) error {
+	held, err := ld.s.lockHeld(ctx, ld.nodePath, ld.owner)
+	if err != nil {
+		return err
+	}
+	if !held {
+		return topo.NewError(topo.NoNode, ld.nodePath)
+	}
+	return nil
+}
+
+// Unlock is part of the topo.LockDescriptor interface.
+func (ld *mysqlLockDescriptor) Unlock(ctx context.Context) error {
+	ld.unlockOnce.Do(func() {
+		close(ld.stop)
+	})
+	<-ld.done
+
+	deleted, err := ld.s.deleteLock(ctx, ld.nodePath, ld.owner)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "lock %v is not held any more", ld.nodePath)
+	}
+	return nil
+}
@@ -0,0 +1,225 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package mysqltopo implements topo.Server with a MySQL database as the
+backend, for deployments that don't want to run a separate consensus
+service.
+
+The server address is either host:port, or the path of a unix socket.
+All the cells served by the same database share these tables, each cell
+using its own root:
+
+  - topo_files contains the files, with their data and version.
+  - topo_locks contains the locks and master election leases. A lock
+    row is owned until its expiry, which its owner keeps pushing back.
+  - topo_version contains the sequence the file versions come from.
+
+We follow these conventions within this package:
+
+  - Call convertError(err) on any errors returned from the MySQL
+    connections. Functions defined in this package can be assumed to
+    have already converted errors as necessary.
+*/
+package mysqltopo
+
+import (
+	"bytes"
+	"flag"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqlescape"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/dbconnpool"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+var (
+	mysqlUser     = flag.String("topo_mysql_user", "", "user to connect to the mysql topo server")
+	mysqlPassword = flag.String("topo_mysql_password", "", "password to connect to the mysql topo server")
+	mysqlDatabase = flag.String("topo_mysql_database", "_vt_topo", "database containing the mysql topo server tables, created if it doesn't exist")
+	poolSize      = flag.Int("topo_mysql_pool_size", 10, "size of the connection pool to the mysql topo server")
+	leaseTTL      = flag.Int("topo_mysql_lease_ttl", 30, "Lease TTL in seconds for locks and master election. The owner keeps pushing the lease expiry back while it holds the lock.")
+	pollInterval  = flag.Duration("topo_mysql_poll_interval", time.Second, "how often watches poll for file changes, and locks are tried again when held by someone else")
+)
+
+// maxRows is the maximum number of rows a query can return. ListDir
+// reads all the paths under the directory.
+const maxRows = 1 << 20
+
+// schema contains the statements creating the tables.
+var schema = []string{
+	`CREATE TABLE IF NOT EXISTS topo_files (
+  path VARBINARY(767) NOT NULL,
+  data LONGBLOB NOT NULL,
+  version BIGINT NOT NULL,
+  PRIMARY KEY (path)
+) ENGINE=InnoDB`,
+	`CREATE TABLE IF NOT EXISTS topo_locks (
+  path VARBINARY(767) NOT NULL,
+  owner VARBINARY(64) NOT NULL,
+  contents LONGBLOB NOT NULL,
+  expiry BIGINT NOT NULL,
+  PRIMARY KEY (path)
+) ENGINE=InnoDB`,
+	`CREATE TABLE IF NOT EXISTS topo_version (
+  id INT NOT NULL,
+  value BIGINT NOT NULL,
+  PRIMARY KEY (id)
+) ENGINE=InnoDB`,
+	`INSERT IGNORE INTO topo_version (id, value) VALUES (0, 0)`,
+}
+
+// Factory is the mysql topo.Factory implementation.
+type Factory struct{}
+
+// HasGlobalReadOnlyCell is part of the topo.Factory interface.
+func (f Factory) HasGlobalReadOnlyCell(serverAddr, root string) bool {
+	return false
+}
+
+// Create is part of the topo.Factory interface.
+func (f Factory) Create(cell, serverAddr, root string) (topo.Conn, error) {
+	return NewServer(serverAddr, root)
+}
+
+// Server is the implementation of topo.Server for MySQL.
+type Server struct {
+	// pool is the pool of connections to the database.
+	pool *dbconnpool.ConnectionPool
+
+	// root is the root path for this client.
+	root string
+}
+
+// Close implements topo.Server.Close.
+// It will nil out the pool, so any attempt to
+// re-use this server will panic.
+func (s *Server) Close() {
+	s.pool.Close()
+	s.pool = nil
+}
+
+// connParams returns the parameters to connect to serverAddr, a
+// host:port or a unix socket path.
+func connParams(serverAddr, dbName string) (*mysql.ConnParams, error) {
+	cp := &mysql.ConnParams{
+		Uname:   *mysqlUser,
+		Pass:    *mysqlPassword,
+		DbName:  dbName,
+		Charset: "utf8mb4",
+	}
+	if strings.HasPrefix(serverAddr, "/") {
+		cp.UnixSocket = serverAddr
+		return cp, nil
+	}
+	host, portStr, err := net.SplitHostPort(serverAddr)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "invalid mysql topo server address %v, expected host:port or a unix socket path", serverAddr)
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "invalid port in mysql topo server address %v", serverAddr)
+	}
+	cp.Host = host
+	cp.Port = port
+	return cp, nil
+}
+
+// NewServer returns a new mysqltopo.Server. It creates the database
+// and the tables if they don't exist.
+func NewServer(serverAddr, root string) (*Server, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	cp, err := connParams(serverAddr, "")
+	if err != nil {
+		return nil, err
+	}
+	conn, err := mysql.Connect(ctx, cp)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if _, err := conn.ExecuteFetch("CREATE DATABASE IF NOT EXISTS "+sqlescape.EscapeID(*mysqlDatabase), 0, false); err != nil {
+		return nil, err
+	}
+	if _, err := conn.ExecuteFetch("USE "+sqlescape.EscapeID(*mysqlDatabase), 0, false); err != nil {
+		return nil, err
+	}
+	for _, query := range schema {
+		if _, err := conn.ExecuteFetch(query, 0, false); err != nil {
+			return nil, err
+		}
+	}
+
+	cp.DbName = *mysqlDatabase
+	pool := dbconnpool.NewConnectionPool("", *poolSize, 0, 0)
+	pool.Open(dbconfigs.New(cp))
+	return &Server{
+		pool: pool,
+		root: root,
+	}, nil
+}
+
+// exec runs a query on a connection of the pool.
+// Errors returned are converted to topo errors.
+func (s *Server) exec(ctx context.Context, nodePath, query string) (*sqltypes.Result, error) {
+	conn, err := s.pool.Get(ctx)
+	if err != nil {
+		if ctx.Err() != nil {
+			// The pool returns its own error when the context
+			// is done while waiting for a connection.
+			return nil, convertError(ctx.Err(), nodePath)
+		}
+		return nil, convertError(err, nodePath)
+	}
+	defer conn.Recycle()
+	qr, err := conn.ExecuteFetch(query, maxRows, false)
+	if err != nil {
+		return nil, convertError(err, nodePath)
+	}
+	return qr, nil
+}
+
+// nextVersion returns a new version from the topo_version sequence.
+func (s *Server) nextVersion(ctx context.Context, nodePath string) (MySQLVersion, error) {
+	qr, err := s.exec(ctx, nodePath, "UPDATE topo_version SET value = LAST_INSERT_ID(value + 1) WHERE id = 0")
+	if err != nil {
+		return 0, err
+	}
+	return MySQLVersion(qr.InsertID), nil
+}
+
+// encodeValue quotes a binary value in a query.
+func encodeValue(value []byte) string {
+	buf := &bytes.Buffer{}
+	buf.WriteString("_binary")
+	sqltypes.MakeTrusted(sqltypes.VarBinary, value).EncodeSQL(buf)
+	return buf.String()
+}
+
+func init() {
+	topo.RegisterFactory("mysql", Factory{})
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqltopo
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vttestpb "vitess.io/vitess/go/vt/proto/vttest"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/test"
+	"vitess.io/vitess/go/vt/vttest"
+)
+
+var mysqlParams mysql.ConnParams
+
+// serverAddr returns the address of the test MySQL.
+func serverAddr() string {
+	if mysqlParams.UnixSocket != "" {
+		return mysqlParams.UnixSocket
+	}
+	return fmt.Sprintf("%v:%v", mysqlParams.Host, mysqlParams.Port)
+}
+
+func TestMySQLTopo(t *testing.T) {
+	testIndex := 0
+	newServer := func() *topo.Server {
+		// Each test will use its own sub-directories.
+		testRoot := fmt.Sprintf("/test-%v", testIndex)
+		testIndex++
+
+		// Create the server on the new root.
+		ts, err := topo.OpenServer("mysql", serverAddr(), path.Join(testRoot, topo.GlobalCell))
+		if err != nil {
+			t.Fatalf("OpenServer() failed: %v", err)
+		}
+
+		// Create the CellInfo.
+		if err := ts.CreateCellInfo(context.Background(), test.LocalCellName, &topodatapb.CellInfo{
+			ServerAddress: serverAddr(),
+			Root:          path.Join(testRoot, test.LocalCellName),
+		}); err != nil {
+			t.Fatalf("CreateCellInfo() failed: %v", err)
+		}
+
+		return ts
+	}
+
+	// Run the TopoServerTestSuite tests.
+	test.TopoServerTestSuite(t, func() *topo.Server {
+		return newServer()
+	})
+
+	// Run mysql-specific tests.
+	ts := newServer()
+	testLockExpiry(t, ts)
+	ts.Close()
+}
+
+// testLockExpiry tests an expired lock can be taken by someone else.
+func testLockExpiry(t *testing.T, ts *topo.Server) {
+	ctx := context.Background()
+	keyspacePath := path.Join(topo.KeyspacesPath, "test_keyspace")
+	if err := ts.CreateKeyspace(ctx, "test_keyspace", &topodatapb.Keyspace{}); err != nil {
+		t.Fatalf("CreateKeyspace: %v", err)
+	}
+
+	conn, err := ts.ConnForCell(ctx, topo.GlobalCell)
+	if err != nil {
+		t.Fatalf("ConnForCell failed: %v", err)
+	}
+	lockDescriptor, err := conn.Lock(ctx, keyspacePath, "expiring")
+	if err != nil {
+		t.Fatalf("Lock failed: %v", err)
+	}
+
+	// Make the lease expire, as if its owner went away.
+	ld := lockDescriptor.(*mysqlLockDescriptor)
+	if _, err := ld.s.exec(ctx, ld.nodePath, fmt.Sprintf("UPDATE topo_locks SET expiry = UNIX_TIMESTAMP() - 1 WHERE path = %v", encodeValue([]byte(ld.nodePath)))); err != nil {
+		t.Fatalf("expiring the lock failed: %v", err)
+	}
+	if err := lockDescriptor.Check(ctx); !topo.IsErrType(err, topo.NoNode) {
+		t.Errorf("Check(expired lock) returned %v, expected NoNode", err)
+	}
+
+	// Someone else can take the lock now, and the previous owner
+	// can't release it.
+	lockDescriptor2, err := conn.Lock(ctx, keyspacePath, "taking over")
+	if err != nil {
+		t.Fatalf("Lock(expired lock) failed: %v", err)
+	}
+	if err := lockDescriptor.Unlock(ctx); err == nil {
+		t.Errorf("Unlock(expired lock) worked")
+	}
+	if err := lockDescriptor2.Check(ctx); err != nil {
+		t.Errorf("Check(): %v", err)
+	}
+	if err := lockDescriptor2.Unlock(ctx); err != nil {
+		t.Errorf("Unlock(): %v", err)
+	}
+}
+
+func TestMain(m *testing.M) {
+	flag.Parse()
+
+	exitCode := func() int {
+		// Launch MySQL.
+		cfg := vttest.Config{
+			Topology: &vttestpb.VTTestTopology{
+				Keyspaces: []*vttestpb.Keyspace{
+					{
+						Name: "vttest",
+						Shards: []*vttestpb.Shard{
+							{
+								Name:           "0",
+								DbNameOverride: "vttest",
+							},
+						},
+					},
+				},
+			},
+			OnlyMySQL: true,
+		}
+		defer os.RemoveAll(cfg.SchemaDir)
+		cluster := vttest.LocalCluster{
+			Config: cfg,
+		}
+		if err := cluster.Setup(); err != nil {
+			fmt.Fprintf(os.Stderr, "could not launch mysql: %v\n", err)
+			return 1
+		}
+		defer cluster.TearDown()
+
+		mysqlParams = cluster.MySQLConnParams()
+		*mysqlUser = mysqlParams.Uname
+		*mysqlPassword = mysqlParams.Pass
+
+		return m.Run()
+	}()
+	os.Exit(exitCode)
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqltopo
+
+import (
+	"path"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo"
+)
+
+// Watch is part of the topo.Conn interface. MySQL doesn't notify of
+// changes, so we poll the file every *pollInterval.
+func (s *Server) Watch(ctx context.Context, filePath string) (*topo.WatchData, <-chan *topo.WatchData, topo.CancelFunc) {
+	nodePath := path.Join(s.root, filePath)
+
+	// Get the initial version of the file.
+	contents, version, err := s.get(ctx, nodePath)
+	if err != nil {
+		return &topo.WatchData{Err: err}, nil, nil
+	}
+	wd := &topo.WatchData{
+		Contents: contents,
+		Version:  version,
+	}
+
+	// The polling context is canceled by the returned cancel function.
+	watchCtx, watchCancel := context.WithCancel(context.Background())
+	notifications := make(chan *topo.WatchData, 10)
+	go func() {
+		defer close(notifications)
+
+		for {
+			select {
+			case <-watchCtx.Done():
+				notifications <- &topo.WatchData{Err: convertError(watchCtx.Err(), nodePath)}
+				return
+			case <-time.After(*pollInterval):
+			}
+
+			newContents, newVersion, err := s.get(watchCtx, nodePath)
+			if err != nil {
+				// The file was deleted, the watch was
+				// canceled, or we can't reach MySQL. In
+				// all cases the watch is over.
+				notifications <- &topo.WatchData{Err: err}
+				return
+			}
+			if newVersion == version {
+				continue
+			}
+			contents, version = newContents, newVersion
+			notifications <- &topo.WatchData{
+				Contents: contents,
+				Version:  version,
+			}
+		}
+	}()
+
+	return wd, notifications, topo.CancelFunc(watchCancel)
+}
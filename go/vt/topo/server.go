@@ -32,6 +32,10 @@ and the topo2topo cmd binary), and writing to two topo servers at the same
 time (using helpers/tee.go). This is to facilitate migrations between
 topo servers.
 
+The mutations done by a process can be recorded in an audit log, with
+the -topo_audit_sinks flag (see AuditConn in audit_conn.go). The 'topo'
+sink stores the events in the global cell, for all the processes.
+
 There are two test sub-packages associated with this code:
 - test/ contains a test suite that is run against all of our implementations.
   It just performs a bunch of common topo server activities (create, list,
@@ -45,6 +49,7 @@ package topo
 import (
 	"flag"
 	"fmt"
+	"io"
 	"sync"
 
 	"golang.org/x/net/context"
@@ -85,6 +90,7 @@ const (
 	ShardsPath       = "shards"
 	TabletsPath      = "tablets"
 	MetadataPath     = "metadata"
+	AuditPath        = "audit"
 )
 
 // Factory is a factory interface to create Conn objects.
@@ -128,6 +134,10 @@ type Server struct {
 	// It is set at construction time.
 	factory Factory
 
+	// auditSinks receive the AuditEvents of the mutations, if
+	// auditing is enabled. It is set at construction time.
+	auditSinks []AuditSink
+
 	// mu protects the following fields.
 	mu sync.Mutex
 	// cells contains clients configured to talk to a list of
@@ -182,6 +192,14 @@ func NewWithFactory(factory Factory, serverAddress, root string) (*Server, error
 		return nil, err
 	}
 	conn = NewStatsConn(GlobalCell, conn)
+	auditSinks, err := auditSinksFromFlags(conn)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	if len(auditSinks) > 0 {
+		conn = NewAuditConn(GlobalCell, conn, auditSinks)
+	}
 
 	var connReadOnly Conn
 	if factory.HasGlobalReadOnlyCell(serverAddress, root) {
@@ -198,6 +216,7 @@ func NewWithFactory(factory Factory, serverAddress, root string) (*Server, error
 		globalCell:         conn,
 		globalReadOnlyCell: connReadOnly,
 		factory:            factory,
+		auditSinks:         auditSinks,
 		cells:              make(map[string]Conn),
 	}, nil
 }
@@ -268,6 +287,9 @@ func (ts *Server) ConnForCell(ctx context.Context, cell string) (Conn, error) {
 	switch {
 	case err == nil:
 		conn = NewStatsConn(cell, conn)
+		if len(ts.auditSinks) > 0 {
+			conn = NewAuditConn(cell, conn, ts.auditSinks)
+		}
 		ts.cells[cell] = conn
 		return conn, nil
 	case IsErrType(err, NoNode):
@@ -309,6 +331,11 @@ func GetAliasByCell(ctx context.Context, ts *Server, cell string) string {
 // Close will close all connections to underlying topo Server.
 // It will nil all member variables, so any further access will panic.
 func (ts *Server) Close() {
+	for _, sink := range ts.auditSinks {
+		if closer, ok := sink.(io.Closer); ok {
+			closer.Close()
+		}
+	}
 	ts.globalCell.Close()
 	if ts.globalReadOnlyCell != ts.globalCell {
 		ts.globalReadOnlyCell.Close()
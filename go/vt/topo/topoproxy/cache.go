@@ -0,0 +1,291 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topoproxy
+
+import (
+	"flag"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo"
+)
+
+var (
+	idleTimeout = flag.Duration("topo_proxy_cache_idle_timeout", 10*time.Minute, "how long the topo proxy keeps watching a file nobody read or watched")
+
+	// watchBufferSize is the number of changes buffered for each
+	// watcher. A watcher falling further behind is stopped.
+	watchBufferSize = 100
+
+	getCounts = stats.NewCountersWithSingleLabel("TopoProxyGets", "Get calls served by the topo proxy, by where they were served from", "Source")
+)
+
+// cellCache caches the files of a cell. Each cached file is kept fresh
+// by a single Watch on the topo server, shared by all the Get and
+// Watch calls of the proxy for this file.
+type cellCache struct {
+	conn topo.Conn
+
+	// mu protects the following fields, and the fields of the entries.
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+	// lastSweep is when idle entries were last looked for.
+	lastSweep time.Time
+}
+
+// cacheEntry is a file watched on the topo server.
+type cacheEntry struct {
+	// ready is closed when the initial value of the Watch is known.
+	ready chan struct{}
+	// err is the error of the initial Watch. It is set before ready
+	// is closed.
+	err error
+	// cancel stops the Watch.
+	cancel topo.CancelFunc
+
+	contents []byte
+	version  topo.Version
+	// dirty is set when the file was changed through the proxy, and
+	// the Watch may not have caught up yet. Reads go to the topo server
+	// until the cache has the same version as the topo server.
+	dirty bool
+	// done is set when the Watch ended.
+	done bool
+	// lastAccess is when the entry was last read.
+	lastAccess time.Time
+	// watchers are the channels of the Watch calls of the proxy.
+	watchers map[chan *topo.WatchData]bool
+}
+
+func newCellCache(conn topo.Conn) *cellCache {
+	return &cellCache{
+		conn:      conn,
+		entries:   make(map[string]*cacheEntry),
+		lastSweep: time.Now(),
+	}
+}
+
+// entry returns the entry of filePath, watching the file if it isn't
+// already. It returns the error of the initial Watch if the file
+// can't be watched, e.g. topo.NoNode if it doesn't exist.
+func (c *cellCache) entry(ctx context.Context, filePath string) (*cacheEntry, error) {
+	c.mu.Lock()
+	c.sweepLocked()
+	e, ok := c.entries[filePath]
+	if !ok {
+		e = &cacheEntry{
+			ready:    make(chan struct{}),
+			watchers: make(map[chan *topo.WatchData]bool),
+		}
+		c.entries[filePath] = e
+		go c.watch(filePath, e)
+	}
+	e.lastAccess = time.Now()
+	c.mu.Unlock()
+
+	select {
+	case <-e.ready:
+	case <-ctx.Done():
+		return nil, convertContextError(ctx.Err(), filePath)
+	}
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e, nil
+}
+
+// watch starts the Watch of an entry, and updates the entry with its
+// changes until it ends.
+func (c *cellCache) watch(filePath string, e *cacheEntry) {
+	// The Watch is shared by all the callers, so it doesn't use
+	// the context of the first one.
+	current, changes, cancel := c.conn.Watch(context.Background(), filePath)
+
+	c.mu.Lock()
+	if current.Err != nil {
+		// Most likely the file doesn't exist. Don't cache that,
+		// it may be created any time.
+		e.err = current.Err
+		e.done = true
+		c.removeLocked(filePath, e)
+	} else {
+		e.contents = current.Contents
+		e.version = current.Version
+		e.cancel = cancel
+	}
+	close(e.ready)
+	c.mu.Unlock()
+	if current.Err != nil {
+		return
+	}
+
+	for wd := range changes {
+		c.mu.Lock()
+		if wd.Err == nil {
+			e.contents = wd.Contents
+			e.version = wd.Version
+		} else {
+			// The Watch is over, because the file was
+			// deleted, or we stopped it, or of an error.
+			// The next call will watch it again.
+			e.done = true
+			c.removeLocked(filePath, e)
+		}
+		for w := range e.watchers {
+			select {
+			case w <- wd:
+			default:
+				// The watcher isn't keeping up, stop it.
+				log.Warningf("topo proxy watcher of %v is too slow, stopping it", filePath)
+				delete(e.watchers, w)
+				close(w)
+			}
+		}
+		if e.done {
+			for w := range e.watchers {
+				close(w)
+			}
+			e.watchers = nil
+		}
+		c.mu.Unlock()
+	}
+}
+
+// removeLocked removes the entry of filePath from the cache, if it is
+// still e. c.mu must be held.
+func (c *cellCache) removeLocked(filePath string, e *cacheEntry) {
+	if c.entries[filePath] == e {
+		delete(c.entries, filePath)
+	}
+}
+
+// sweepLocked stops watching the files nobody used for idleTimeout.
+// c.mu must be held.
+func (c *cellCache) sweepLocked() {
+	now := time.Now()
+	if now.Sub(c.lastSweep) < *idleTimeout/10 {
+		return
+	}
+	c.lastSweep = now
+	for filePath, e := range c.entries {
+		if len(e.watchers) == 0 && e.cancel != nil && now.Sub(e.lastAccess) > *idleTimeout {
+			delete(c.entries, filePath)
+			e.cancel()
+		}
+	}
+}
+
+// get returns the contents and version of a file, from the cache if
+// it is up to date.
+func (c *cellCache) get(ctx context.Context, filePath string) ([]byte, topo.Version, error) {
+	e, err := c.entry(ctx, filePath)
+	if err != nil {
+		if topo.IsErrType(err, topo.NoNode) {
+			getCounts.Add("TopoServer", 1)
+		}
+		return nil, nil, err
+	}
+
+	c.mu.Lock()
+	if !e.dirty && !e.done {
+		contents, version := e.contents, e.version
+		c.mu.Unlock()
+		getCounts.Add("Cache", 1)
+		return contents, version, nil
+	}
+	c.mu.Unlock()
+
+	getCounts.Add("TopoServer", 1)
+	contents, version, err := c.conn.Get(ctx, filePath)
+	if err != nil {
+		return nil, nil, err
+	}
+	c.mu.Lock()
+	if e.version.String() == version.String() {
+		// The Watch caught up with the changes.
+		e.dirty = false
+	}
+	c.mu.Unlock()
+	return contents, version, nil
+}
+
+// subscribe returns the current value of a file, and a channel
+// receiving its changes. The channel is closed after an error, or if
+// the receiver can't keep up. unsubscribe must be called when done.
+func (c *cellCache) subscribe(ctx context.Context, filePath string) (current *topo.WatchData, changes <-chan *topo.WatchData, unsubscribe func(), err error) {
+	for {
+		e, err := c.entry(ctx, filePath)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		c.mu.Lock()
+		if e.done {
+			// The Watch just ended, start a new one.
+			c.mu.Unlock()
+			continue
+		}
+		w := make(chan *topo.WatchData, watchBufferSize)
+		e.watchers[w] = true
+		current = &topo.WatchData{
+			Contents: e.contents,
+			Version:  e.version,
+		}
+		dirty := e.dirty
+		c.mu.Unlock()
+
+		unsubscribe = func() {
+			c.mu.Lock()
+			defer c.mu.Unlock()
+			if e.watchers[w] {
+				delete(e.watchers, w)
+				close(w)
+			}
+			e.lastAccess = time.Now()
+		}
+
+		if dirty {
+			// The cached value may be older than a change
+			// made through the proxy, which the caller may
+			// expect to see first.
+			contents, version, err := c.conn.Get(ctx, filePath)
+			if err != nil {
+				unsubscribe()
+				return nil, nil, nil, err
+			}
+			current = &topo.WatchData{
+				Contents: contents,
+				Version:  version,
+			}
+		}
+		return current, w, unsubscribe, nil
+	}
+}
+
+// invalidate is called when a file is changed through the proxy, so
+// the next reads don't return a value older than the change.
+func (c *cellCache) invalidate(filePath string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.entries[filePath]; ok {
+		e.dirty = true
+	}
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topoproxy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+)
+
+// waitForContents waits until the cache returns contents for filePath.
+func waitForContents(t *testing.T, c *cellCache, filePath, contents string) {
+	ctx := context.Background()
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		got, _, err := c.get(ctx, filePath)
+		require.NoError(t, err)
+		if string(got) == contents {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("cache still returns %q for %v, expected %q", got, filePath, contents)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestCellCache(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	conn, err := ts.ConnForCell(ctx, "cell1")
+	require.NoError(t, err)
+	c := newCellCache(conn)
+
+	// Missing files aren't cached.
+	_, _, err = c.get(ctx, "file")
+	assert.True(t, topo.IsErrType(err, topo.NoNode), "got %v", err)
+	assert.Empty(t, c.entries)
+
+	// The first Get watches the file, the next ones are served
+	// from the cache.
+	version, err := conn.Create(ctx, "file", []byte("a"))
+	require.NoError(t, err)
+	contents, gotVersion, err := c.get(ctx, "file")
+	require.NoError(t, err)
+	assert.Equal(t, "a", string(contents))
+	assert.Equal(t, version.String(), gotVersion.String())
+	hits := getCounts.Counts()["Cache"]
+	_, _, err = c.get(ctx, "file")
+	require.NoError(t, err)
+	assert.Equal(t, hits+1, getCounts.Counts()["Cache"])
+
+	// Changes made directly on the topo server are eventually seen.
+	_, err = conn.Update(ctx, "file", []byte("b"), nil)
+	require.NoError(t, err)
+	waitForContents(t, c, "file", "b")
+
+	// Changes made through the proxy are seen right away.
+	_, err = conn.Update(ctx, "file", []byte("c"), nil)
+	require.NoError(t, err)
+	c.invalidate("file")
+	contents, _, err = c.get(ctx, "file")
+	require.NoError(t, err)
+	assert.Equal(t, "c", string(contents))
+
+	// Watchers get the changes, and the deletion.
+	current, changes, unsubscribe, err := c.subscribe(ctx, "file")
+	require.NoError(t, err)
+	defer unsubscribe()
+	assert.Equal(t, "c", string(current.Contents))
+	_, err = conn.Update(ctx, "file", []byte("d"), nil)
+	require.NoError(t, err)
+	for wd := range changes {
+		require.NoError(t, wd.Err)
+		if string(wd.Contents) == "d" {
+			break
+		}
+	}
+	require.NoError(t, conn.Delete(ctx, "file", nil))
+	for wd := range changes {
+		if wd.Err != nil {
+			assert.True(t, topo.IsErrType(wd.Err, topo.NoNode), "got %v", wd.Err)
+			break
+		}
+	}
+	_, ok := <-changes
+	assert.False(t, ok, "changes should be closed after the deletion")
+	_, _, err = c.get(ctx, "file")
+	assert.True(t, topo.IsErrType(err, topo.NoNode), "got %v", err)
+}
+
+func TestCellCacheSlowWatcher(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	conn, err := ts.ConnForCell(ctx, "cell1")
+	require.NoError(t, err)
+	c := newCellCache(conn)
+
+	_, err = conn.Create(ctx, "file", []byte("0"))
+	require.NoError(t, err)
+	_, changes, unsubscribe, err := c.subscribe(ctx, "file")
+	require.NoError(t, err)
+	defer unsubscribe()
+
+	// A watcher not reading its changes is stopped, without
+	// blocking the others.
+	defer func(saved int) { watchBufferSize = saved }(watchBufferSize)
+	for i := 0; i < watchBufferSize+10; i++ {
+		_, err = conn.Update(ctx, "file", []byte("x"), nil)
+		require.NoError(t, err)
+	}
+	waitForContents(t, c, "file", "x")
+	closed := false
+	for !closed {
+		select {
+		case _, ok := <-changes:
+			closed = !ok
+		case <-time.After(10 * time.Second):
+			t.Fatalf("slow watcher wasn't stopped")
+		}
+	}
+}
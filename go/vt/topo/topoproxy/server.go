@@ -0,0 +1,381 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package topoproxy contains the topo proxy service. It serves the topo.Conn
+API of all the cells over gRPC, to the processes using the grpc topo
+implementation (go/vt/topo/grpctopo). The Get and Watch calls are served
+from a cache, kept fresh with a single Watch on the topo server per file,
+so many processes reading the same files don't hammer the topo server.
+The other calls are forwarded to the topo server.
+
+The cache is eventually consistent with the topo server, except for the
+changes made through the proxy, which the following reads see.
+*/
+package topoproxy
+
+import (
+	"sync"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	topoproxypb "vitess.io/vitess/go/vt/proto/topoproxy"
+	topoproxyservicepb "vitess.io/vitess/go/vt/proto/topoproxyservice"
+)
+
+// Server is the gRPC server of the topo proxy.
+type Server struct {
+	ts *topo.Server
+
+	// mu protects caches.
+	mu sync.Mutex
+	// caches has the cache of each cell, by name.
+	caches map[string]*cellCache
+}
+
+// NewServer returns a new topo proxy Server for the topo server.
+func NewServer(ts *topo.Server) *Server {
+	return &Server{
+		ts:     ts,
+		caches: make(map[string]*cellCache),
+	}
+}
+
+// StartServer registers the topo proxy Server for RPCs.
+func StartServer(s *grpc.Server, ts *topo.Server) {
+	topoproxyservicepb.RegisterTopoProxyServer(s, NewServer(ts))
+}
+
+// cache returns the cache of a cell.
+func (s *Server) cache(ctx context.Context, cell string) (*cellCache, error) {
+	conn, err := s.ts.ConnForCell(ctx, cell)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, ok := s.caches[cell]
+	if !ok {
+		c = newCellCache(conn)
+		s.caches[cell] = c
+	}
+	return c, nil
+}
+
+// resolveVersion returns the topo.Version of a file matching the
+// version sent by a client, to pass to the topo server.
+func resolveVersion(ctx context.Context, conn topo.Conn, filePath, version string) (topo.Version, error) {
+	if version == "" {
+		return nil, nil
+	}
+	_, v, err := conn.Get(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	if v.String() != version {
+		return nil, topo.NewError(topo.BadVersion, filePath)
+	}
+	return v, nil
+}
+
+// Get is part of the topoproxyservicepb.TopoProxyServer interface.
+func (s *Server) Get(ctx context.Context, req *topoproxypb.GetRequest) (_ *topoproxypb.GetResponse, err error) {
+	defer servenv.HandlePanic("topoproxy", &err)
+	c, err := s.cache(ctx, req.Cell)
+	if err != nil {
+		return nil, toGRPC(err)
+	}
+	contents, version, err := c.get(ctx, req.Path)
+	if err != nil {
+		return nil, toGRPC(err)
+	}
+	return &topoproxypb.GetResponse{
+		Contents: contents,
+		Version:  version.String(),
+	}, nil
+}
+
+// Create is part of the topoproxyservicepb.TopoProxyServer interface.
+func (s *Server) Create(ctx context.Context, req *topoproxypb.CreateRequest) (_ *topoproxypb.CreateResponse, err error) {
+	defer servenv.HandlePanic("topoproxy", &err)
+	c, err := s.cache(ctx, req.Cell)
+	if err != nil {
+		return nil, toGRPC(err)
+	}
+	defer c.invalidate(req.Path)
+	version, err := c.conn.Create(ctx, req.Path, req.Contents)
+	if err != nil {
+		return nil, toGRPC(err)
+	}
+	return &topoproxypb.CreateResponse{
+		Version: version.String(),
+	}, nil
+}
+
+// Update is part of the topoproxyservicepb.TopoProxyServer interface.
+func (s *Server) Update(ctx context.Context, req *topoproxypb.UpdateRequest) (_ *topoproxypb.UpdateResponse, err error) {
+	defer servenv.HandlePanic("topoproxy", &err)
+	c, err := s.cache(ctx, req.Cell)
+	if err != nil {
+		return nil, toGRPC(err)
+	}
+	version, err := resolveVersion(ctx, c.conn, req.Path, req.Version)
+	if err != nil {
+		return nil, toGRPC(err)
+	}
+	defer c.invalidate(req.Path)
+	version, err = c.conn.Update(ctx, req.Path, req.Contents, version)
+	if err != nil {
+		return nil, toGRPC(err)
+	}
+	return &topoproxypb.UpdateResponse{
+		Version: version.String(),
+	}, nil
+}
+
+// Delete is part of the topoproxyservicepb.TopoProxyServer interface.
+func (s *Server) Delete(ctx context.Context, req *topoproxypb.DeleteRequest) (_ *topoproxypb.DeleteResponse, err error) {
+	defer servenv.HandlePanic("topoproxy", &err)
+	c, err := s.cache(ctx, req.Cell)
+	if err != nil {
+		return nil, toGRPC(err)
+	}
+	version, err := resolveVersion(ctx, c.conn, req.Path, req.Version)
+	if err != nil {
+		return nil, toGRPC(err)
+	}
+	defer c.invalidate(req.Path)
+	if err := c.conn.Delete(ctx, req.Path, version); err != nil {
+		return nil, toGRPC(err)
+	}
+	return &topoproxypb.DeleteResponse{}, nil
+}
+
+// ListDir is part of the topoproxyservicepb.TopoProxyServer interface.
+// Directories aren't cached.
+func (s *Server) ListDir(ctx context.Context, req *topoproxypb.ListDirRequest) (_ *topoproxypb.ListDirResponse, err error) {
+	defer servenv.HandlePanic("topoproxy", &err)
+	conn, err := s.ts.ConnForCell(ctx, req.Cell)
+	if err != nil {
+		return nil, toGRPC(err)
+	}
+	entries, err := conn.ListDir(ctx, req.Path, req.Full)
+	if err != nil {
+		return nil, toGRPC(err)
+	}
+	response := &topoproxypb.ListDirResponse{
+		Entries: make([]*topoproxypb.DirEntry, 0, len(entries)),
+	}
+	for _, e := range entries {
+		response.Entries = append(response.Entries, &topoproxypb.DirEntry{
+			Name:      e.Name,
+			Type:      topoproxypb.DirEntry_Type(e.Type),
+			Ephemeral: e.Ephemeral,
+		})
+	}
+	return response, nil
+}
+
+// Watch is part of the topoproxyservicepb.TopoProxyServer interface.
+func (s *Server) Watch(req *topoproxypb.WatchRequest, stream topoproxyservicepb.TopoProxy_WatchServer) (err error) {
+	defer servenv.HandlePanic("topoproxy", &err)
+	ctx := stream.Context()
+	c, err := s.cache(ctx, req.Cell)
+	if err != nil {
+		return toGRPC(err)
+	}
+	current, changes, unsubscribe, err := c.subscribe(ctx, req.Path)
+	if err != nil {
+		return toGRPC(err)
+	}
+	defer unsubscribe()
+
+	if err := stream.Send(&topoproxypb.WatchResponse{
+		Contents: current.Contents,
+		Version:  current.Version.String(),
+	}); err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return toGRPC(convertContextError(ctx.Err(), req.Path))
+		case wd, ok := <-changes:
+			if !ok {
+				return status.Errorf(codes.ResourceExhausted, "watch of %v fell behind", req.Path)
+			}
+			if wd.Err != nil {
+				return toGRPC(wd.Err)
+			}
+			if err := stream.Send(&topoproxypb.WatchResponse{
+				Contents: wd.Contents,
+				Version:  wd.Version.String(),
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Lock is part of the topoproxyservicepb.TopoProxyServer interface.
+// The lock is released when the stream ends.
+func (s *Server) Lock(stream topoproxyservicepb.TopoProxy_LockServer) (err error) {
+	defer servenv.HandlePanic("topoproxy", &err)
+	ctx := stream.Context()
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	if req.Action != topoproxypb.LockRequest_LOCK {
+		return status.Errorf(codes.InvalidArgument, "the first Lock request must take the lock, got %v", req.Action)
+	}
+	conn, err := s.ts.ConnForCell(ctx, req.Cell)
+	if err != nil {
+		return toGRPC(err)
+	}
+	ld, err := conn.Lock(ctx, req.Path, req.Contents)
+	if err != nil {
+		return toGRPC(err)
+	}
+	locked := true
+	defer func() {
+		if locked {
+			// The client went away, or Check failed.
+			ld.Unlock(context.Background())
+		}
+	}()
+	if err := stream.Send(&topoproxypb.LockResponse{}); err != nil {
+		return err
+	}
+
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		switch req.Action {
+		case topoproxypb.LockRequest_CHECK:
+			if err := ld.Check(ctx); err != nil {
+				return toGRPC(err)
+			}
+		case topoproxypb.LockRequest_UNLOCK:
+			locked = false
+			if err := ld.Unlock(ctx); err != nil {
+				return toGRPC(err)
+			}
+		default:
+			return status.Errorf(codes.InvalidArgument, "the lock is already taken")
+		}
+		if err := stream.Send(&topoproxypb.LockResponse{}); err != nil {
+			return err
+		}
+		if !locked {
+			return nil
+		}
+	}
+}
+
+// WaitForMastership is part of the topoproxyservicepb.TopoProxyServer
+// interface. The participation stops when the stream ends.
+func (s *Server) WaitForMastership(req *topoproxypb.WaitForMastershipRequest, stream topoproxyservicepb.TopoProxy_WaitForMastershipServer) (err error) {
+	defer servenv.HandlePanic("topoproxy", &err)
+	ctx := stream.Context()
+	conn, err := s.ts.ConnForCell(ctx, req.Cell)
+	if err != nil {
+		return toGRPC(err)
+	}
+	mp, err := conn.NewMasterParticipation(req.Name, req.Id)
+	if err != nil {
+		return toGRPC(err)
+	}
+	// Stop interrupts WaitForMastership, or ends the mastership.
+	go func() {
+		<-ctx.Done()
+		mp.Stop()
+	}()
+
+	masterCtx, err := mp.WaitForMastership()
+	if err != nil {
+		return toGRPC(err)
+	}
+	if err := stream.Send(&topoproxypb.WaitForMastershipResponse{}); err != nil {
+		return err
+	}
+	<-masterCtx.Done()
+	return toGRPC(topo.NewError(topo.Interrupted, "mastership"))
+}
+
+// GetCurrentMasterID is part of the topoproxyservicepb.TopoProxyServer
+// interface.
+func (s *Server) GetCurrentMasterID(ctx context.Context, req *topoproxypb.GetCurrentMasterIDRequest) (_ *topoproxypb.GetCurrentMasterIDResponse, err error) {
+	defer servenv.HandlePanic("topoproxy", &err)
+	conn, err := s.ts.ConnForCell(ctx, req.Cell)
+	if err != nil {
+		return nil, toGRPC(err)
+	}
+	mp, err := conn.NewMasterParticipation(req.Name, req.Id)
+	if err != nil {
+		return nil, toGRPC(err)
+	}
+	masterID, err := mp.GetCurrentMasterID(ctx)
+	if err != nil {
+		return nil, toGRPC(err)
+	}
+	return &topoproxypb.GetCurrentMasterIDResponse{
+		MasterId: masterID,
+	}, nil
+}
+
+// toGRPC converts an error to a gRPC error. The topo errors the clients
+// act upon get their own codes, which the grpc topo implementation
+// converts back.
+func toGRPC(err error) error {
+	if err == nil {
+		return nil
+	}
+	var code codes.Code
+	switch {
+	case topo.IsErrType(err, topo.NoNode):
+		code = codes.NotFound
+	case topo.IsErrType(err, topo.NodeExists):
+		code = codes.AlreadyExists
+	case topo.IsErrType(err, topo.BadVersion):
+		code = codes.Aborted
+	case topo.IsErrType(err, topo.Timeout):
+		code = codes.DeadlineExceeded
+	case topo.IsErrType(err, topo.Interrupted):
+		code = codes.Canceled
+	default:
+		return vterrors.ToGRPC(err)
+	}
+	return status.Error(code, err.Error())
+}
+
+// convertContextError converts a context error to a topo error.
+func convertContextError(err error, nodePath string) error {
+	if err == context.DeadlineExceeded {
+		return topo.NewError(topo.Timeout, nodePath)
+	}
+	return topo.NewError(topo.Interrupted, nodePath)
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topotests
+
+import (
+	"flag"
+	"sync"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// memoryAuditSink keeps the events it receives.
+type memoryAuditSink struct {
+	mu     sync.Mutex
+	events []*topo.AuditEvent
+}
+
+func (s *memoryAuditSink) Record(event *topo.AuditEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, event)
+}
+
+// last returns the last event, and forgets about all of them.
+func (s *memoryAuditSink) last(t *testing.T) *topo.AuditEvent {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	require.NotEmpty(t, s.events)
+	e := s.events[len(s.events)-1]
+	s.events = nil
+	return e
+}
+
+func TestAuditConn(t *testing.T) {
+	ctx := callerid.NewContext(context.Background(), callerid.NewEffectiveCallerID("alice", "", ""), nil)
+	ts := memorytopo.NewServer("cell1")
+	conn, err := ts.ConnForCell(ctx, topo.GlobalCell)
+	require.NoError(t, err)
+	sink := &memoryAuditSink{}
+	ac := topo.NewAuditConn(topo.GlobalCell, conn, []topo.AuditSink{sink})
+
+	ksPath := "/keyspaces/ks/Keyspace"
+	data, err := proto.Marshal(&topodatapb.Keyspace{ShardingColumnName: "col1"})
+	require.NoError(t, err)
+
+	// Create records the new contents, and the caller.
+	_, err = ac.Create(ctx, ksPath, data)
+	require.NoError(t, err)
+	e := sink.last(t)
+	assert.Equal(t, "Create", e.Operation)
+	assert.Equal(t, topo.GlobalCell, e.Cell)
+	assert.Equal(t, ksPath, e.Path)
+	assert.Equal(t, "alice", e.Caller)
+	assert.NotEmpty(t, e.Process)
+	assert.Equal(t, "+ sharding_column_name: \"col1\"\n", e.Diff)
+	assert.Empty(t, e.Error)
+
+	// Failed mutations are recorded too.
+	_, err = ac.Create(ctx, ksPath, data)
+	require.Error(t, err)
+	e = sink.last(t)
+	assert.Equal(t, "Create", e.Operation)
+	assert.NotEmpty(t, e.Error)
+
+	// Update records the diff.
+	newData, err := proto.Marshal(&topodatapb.Keyspace{ShardingColumnName: "col2"})
+	require.NoError(t, err)
+	_, err = ac.Update(ctx, ksPath, newData, nil)
+	require.NoError(t, err)
+	e = sink.last(t)
+	assert.Equal(t, "Update", e.Operation)
+	assert.Equal(t, "- sharding_column_name: \"col1\"\n+ sharding_column_name: \"col2\"\n", e.Diff)
+
+	// Lock and Unlock record the lock contents.
+	ld, err := ac.Lock(ctx, "/keyspaces/ks", "some action")
+	require.NoError(t, err)
+	e = sink.last(t)
+	assert.Equal(t, "Lock", e.Operation)
+	assert.Equal(t, "/keyspaces/ks", e.Path)
+	assert.Equal(t, "some action", e.LockContents)
+	require.NoError(t, ld.Unlock(ctx))
+	e = sink.last(t)
+	assert.Equal(t, "Unlock", e.Operation)
+	assert.Equal(t, "some action", e.LockContents)
+
+	// Delete records the old contents.
+	require.NoError(t, ac.Delete(ctx, ksPath, nil))
+	e = sink.last(t)
+	assert.Equal(t, "Delete", e.Operation)
+	assert.Equal(t, "- sharding_column_name: \"col2\"\n", e.Diff)
+
+	// Reads aren't recorded.
+	_, _, err = ac.Get(ctx, ksPath)
+	require.Error(t, err)
+	_, err = ac.ListDir(ctx, "/", false)
+	require.NoError(t, err)
+	assert.Empty(t, sink.events)
+}
+
+func TestAuditConnWithoutDiffs(t *testing.T) {
+	defer flag.Set("topo_audit_diffs", "true")
+	flag.Set("topo_audit_diffs", "false")
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	conn, err := ts.ConnForCell(ctx, topo.GlobalCell)
+	require.NoError(t, err)
+	sink := &memoryAuditSink{}
+	ac := topo.NewAuditConn(topo.GlobalCell, conn, []topo.AuditSink{sink})
+
+	// Update and Delete don't read the file to compute a diff.
+	_, err = ac.Create(ctx, "/file", []byte("a"))
+	require.NoError(t, err)
+	sink.last(t)
+	_, err = ac.Update(ctx, "/file", []byte("b"), nil)
+	require.NoError(t, err)
+	e := sink.last(t)
+	assert.Equal(t, "Update", e.Operation)
+	assert.Empty(t, e.Diff)
+	require.NoError(t, ac.Delete(ctx, "/file", nil))
+	e = sink.last(t)
+	assert.Equal(t, "Delete", e.Operation)
+	assert.Empty(t, e.Diff)
+}
+
+func TestAuditSinksFlag(t *testing.T) {
+	defer func() {
+		flag.Set("topo_audit_sinks", "")
+		flag.Set("topo_audit_history_size", "100")
+	}()
+
+	_, factory := memorytopo.NewServerAndFactory("cell1")
+	flag.Set("topo_audit_sinks", "unknown")
+	_, err := topo.NewWithFactory(factory, "", "")
+	assert.Error(t, err)
+
+	// With the topo sink, the mutations of all the cells, done by
+	// all the processes, are stored in the global cell.
+	flag.Set("topo_audit_sinks", "topo")
+	ts1, err := topo.NewWithFactory(factory, "", "")
+	require.NoError(t, err)
+	ts2, err := topo.NewWithFactory(factory, "", "")
+	require.NoError(t, err)
+	ctx := context.Background()
+	require.NoError(t, ts1.CreateKeyspace(ctx, "ks", &topodatapb.Keyspace{}))
+	require.NoError(t, ts2.CreateTablet(ctx, &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "cell1", Uid: 1},
+		Keyspace: "ks",
+		Shard:    "0",
+	}))
+	// Closing the servers writes the queued events.
+	ts1.Close()
+	ts2.Close()
+
+	flag.Set("topo_audit_sinks", "")
+	reader, err := topo.NewWithFactory(factory, "", "")
+	require.NoError(t, err)
+	defer reader.Close()
+	events, err := reader.RecentAuditEvents(ctx)
+	require.NoError(t, err)
+	found := make(map[string]bool)
+	for _, e := range events {
+		found[e.Cell+":"+e.Operation+":"+e.Path] = true
+	}
+	assert.True(t, found["global:Create:keyspaces/ks/Keyspace"], "got %v", found)
+	assert.True(t, found["cell1:Create:tablets/cell1-0000000001/Tablet"], "got %v", found)
+
+	// Only the most recent events are kept.
+	flag.Set("topo_audit_sinks", "topo")
+	flag.Set("topo_audit_history_size", "2")
+	ts, err := topo.NewWithFactory(factory, "", "")
+	require.NoError(t, err)
+	for _, name := range []string{"ks1", "ks2", "ks3"} {
+		require.NoError(t, ts.CreateKeyspace(ctx, name, &topodatapb.Keyspace{}))
+	}
+	ts.Close()
+	events, err = reader.RecentAuditEvents(ctx)
+	require.NoError(t, err)
+	var paths []string
+	for _, e := range events {
+		paths = append(paths, e.Path)
+	}
+	assert.Equal(t, []string{"keyspaces/ks2/Keyspace", "keyspaces/ks3/Keyspace"}, paths)
+}
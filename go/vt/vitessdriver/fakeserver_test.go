@@ -139,7 +139,11 @@ func (f *fakeVTGateService) ResolveTransaction(ctx context.Context, dtid string)
 	return nil
 }
 
-func (f *fakeVTGateService) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, send func([]*binlogdatapb.VEvent) error) error {
+func (f *fakeVTGateService) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, streamID string, send func([]*binlogdatapb.VEvent) error) error {
+	return nil
+}
+
+func (f *fakeVTGateService) VStreamCopyTables(ctx context.Context, streamID, keyspace string, tables []string) error {
 	return nil
 }
 
@@ -0,0 +1,289 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtcdc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// AvroEncoder encodes envelopes in the Confluent wire format: a zero
+// magic byte, the 4 byte big endian ID of the schema in a schema
+// registry, and the binary encoded datum. The schemas are registered
+// under the <topic>-key and <topic>-value subjects, and are also
+// returned in the messages in their parsing canonical form.
+type AvroEncoder struct {
+	registry SchemaRegistry
+
+	mu sync.Mutex
+	// schemas caches the schemas of each topic. They are computed
+	// and registered again when a FIELD event changes the fields
+	// of a table.
+	schemas map[string]*avroSchemas
+}
+
+var _ Encoder = (*AvroEncoder)(nil)
+
+type avroSchemas struct {
+	fields      []*querypb.Field
+	key         string
+	keyPrefix   []byte
+	value       string
+	valuePrefix []byte
+}
+
+// NewAvroEncoder returns a new AvroEncoder that registers
+// its schemas in registry.
+func NewAvroEncoder(registry SchemaRegistry) *AvroEncoder {
+	return &AvroEncoder{
+		registry: registry,
+		schemas:  make(map[string]*avroSchemas),
+	}
+}
+
+var avroTypes = map[int]string{
+	kindInt32:   "int",
+	kindInt64:   "long",
+	kindFloat32: "float",
+	kindFloat64: "double",
+	kindBytes:   "bytes",
+	kindString:  "string",
+}
+
+// Encode implements Encoder.
+func (enc *AvroEncoder) Encode(env *Envelope) (*Message, error) {
+	schemas, err := enc.getSchemas(env)
+	if err != nil {
+		return nil, err
+	}
+
+	var value avroWriter
+	value.buf = append(value.buf, schemas.valuePrefix...)
+	for _, row := range [][]sqltypes.Value{env.Before, env.After} {
+		if row == nil {
+			value.long(0)
+			continue
+		}
+		value.long(1)
+		if err := value.row(env.Fields, row, false); err != nil {
+			return nil, err
+		}
+	}
+	src := env.Source
+	value.str(src.Version)
+	value.str(src.Connector)
+	value.str(src.Name)
+	value.long(src.TsMs)
+	value.str(src.Snapshot)
+	value.str(src.Keyspace)
+	value.str(src.Table)
+	value.str(src.Vgtid)
+	value.str(env.Op)
+	value.long(env.TsMs)
+
+	msg := &Message{
+		Topic:       env.Topic,
+		Value:       value.buf,
+		ValueSchema: schemas.value,
+	}
+	if schemas.key != "" {
+		var key avroWriter
+		key.buf = append(key.buf, schemas.keyPrefix...)
+		if err := key.row(env.Fields, env.keyRow(), true); err != nil {
+			return nil, err
+		}
+		msg.Key = key.buf
+		msg.KeySchema = schemas.key
+	}
+	return msg, nil
+}
+
+func (enc *AvroEncoder) getSchemas(env *Envelope) (*avroSchemas, error) {
+	enc.mu.Lock()
+	defer enc.mu.Unlock()
+
+	schemas, ok := enc.schemas[env.Topic]
+	if ok && sameFields(schemas.fields, env.Fields) {
+		return schemas, nil
+	}
+	ns := avroNamespace(env.Topic)
+	schemas = &avroSchemas{
+		fields: env.Fields,
+		value:  avroValueSchema(ns, env.Fields),
+	}
+	var err error
+	if schemas.valuePrefix, err = enc.register(env.Topic+"-value", schemas.value); err != nil {
+		return nil, err
+	}
+	if hasKey(env.Fields) {
+		schemas.key = avroRecordSchema(ns+".Key", env.Fields, true)
+		if schemas.keyPrefix, err = enc.register(env.Topic+"-key", schemas.key); err != nil {
+			return nil, err
+		}
+	}
+	enc.schemas[env.Topic] = schemas
+	return schemas, nil
+}
+
+// register registers a schema, and returns the header of the
+// messages encoded with it.
+func (enc *AvroEncoder) register(subject, schema string) ([]byte, error) {
+	id, err := enc.registry.Register(subject, schema)
+	if err != nil {
+		return nil, err
+	}
+	prefix := make([]byte, 5)
+	binary.BigEndian.PutUint32(prefix[1:], uint32(id))
+	return prefix, nil
+}
+
+func sameFields(a, b []*querypb.Field) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Name != b[i].Name || a[i].Type != b[i].Type || a[i].Flags != b[i].Flags {
+			return false
+		}
+	}
+	return true
+}
+
+// avroValueSchema returns the schema of the envelope in parsing canonical form.
+func avroValueSchema(ns string, fields []*querypb.Field) string {
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, `{"name":"%s.Envelope","type":"record","fields":[`, ns)
+	fmt.Fprintf(buf, `{"name":"before","type":["null",%s]},`, avroRecordSchema(ns+".Value", fields, false))
+	fmt.Fprintf(buf, `{"name":"after","type":["null","%s.Value"]},`, ns)
+	buf.WriteString(`{"name":"source","type":{"name":"io.debezium.connector.vitess.Source","type":"record","fields":[`)
+	buf.WriteString(`{"name":"version","type":"string"},{"name":"connector","type":"string"},{"name":"name","type":"string"},`)
+	buf.WriteString(`{"name":"ts_ms","type":"long"},{"name":"snapshot","type":"string"},{"name":"keyspace","type":"string"},`)
+	buf.WriteString(`{"name":"table","type":"string"},{"name":"vgtid","type":"string"}]}},`)
+	buf.WriteString(`{"name":"op","type":"string"},{"name":"ts_ms","type":"long"}]}`)
+	return buf.String()
+}
+
+// avroRecordSchema returns the schema of the columns of a table in parsing canonical form.
+func avroRecordSchema(name string, fields []*querypb.Field, keyOnly bool) string {
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, `{"name":"%s","type":"record","fields":[`, name)
+	first := true
+	for _, field := range fields {
+		if keyOnly && !isKey(field) {
+			continue
+		}
+		if !first {
+			buf.WriteByte(',')
+		}
+		first = false
+		typ := avroTypes[columnKind(field.Type)]
+		if isOptional(field) {
+			fmt.Fprintf(buf, `{"name":"%s","type":["null","%s"]}`, avroName(field.Name), typ)
+		} else {
+			fmt.Fprintf(buf, `{"name":"%s","type":"%s"}`, avroName(field.Name), typ)
+		}
+	}
+	buf.WriteString("]}")
+	return buf.String()
+}
+
+// avroNamespace converts a topic to an Avro namespace.
+func avroNamespace(topic string) string {
+	parts := strings.Split(topic, ".")
+	for i, part := range parts {
+		parts[i] = avroName(part)
+	}
+	return strings.Join(parts, ".")
+}
+
+// avroName replaces the characters that are not allowed in Avro names.
+func avroName(name string) string {
+	b := []byte(name)
+	for i, c := range b {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_') {
+			b[i] = '_'
+		}
+	}
+	if len(b) == 0 || (b[0] >= '0' && b[0] <= '9') {
+		return "_" + string(b)
+	}
+	return string(b)
+}
+
+// avroWriter appends Avro binary encoded values to buf.
+type avroWriter struct {
+	buf []byte
+}
+
+func (w *avroWriter) long(v int64) {
+	var b [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(b[:], v)
+	w.buf = append(w.buf, b[:n]...)
+}
+
+func (w *avroWriter) bytes(v []byte) {
+	w.long(int64(len(v)))
+	w.buf = append(w.buf, v...)
+}
+
+func (w *avroWriter) str(v string) {
+	w.long(int64(len(v)))
+	w.buf = append(w.buf, v...)
+}
+
+func (w *avroWriter) row(fields []*querypb.Field, row []sqltypes.Value, keyOnly bool) error {
+	for i, field := range fields {
+		if keyOnly && !isKey(field) {
+			continue
+		}
+		v, err := columnValue(field.Type, row[i])
+		if err != nil {
+			return fmt.Errorf("column %s: %v", field.Name, err)
+		}
+		if isOptional(field) {
+			if v == nil {
+				w.long(0)
+				continue
+			}
+			w.long(1)
+		} else if v == nil {
+			return fmt.Errorf("column %s: null value in a not null column", field.Name)
+		}
+		switch v := v.(type) {
+		case int64:
+			w.long(v)
+		case float32:
+			w.buf = append(w.buf, 0, 0, 0, 0)
+			binary.LittleEndian.PutUint32(w.buf[len(w.buf)-4:], math.Float32bits(v))
+		case float64:
+			w.buf = append(w.buf, 0, 0, 0, 0, 0, 0, 0, 0)
+			binary.LittleEndian.PutUint64(w.buf[len(w.buf)-8:], math.Float64bits(v))
+		case []byte:
+			w.bytes(v)
+		case string:
+			w.str(v)
+		}
+	}
+	return nil
+}
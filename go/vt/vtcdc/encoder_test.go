@@ -0,0 +1,161 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtcdc
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+func testEnvelope(op string) *Envelope {
+	env := &Envelope{
+		Topic:  "src.ks.t1",
+		Fields: testFields,
+		Source: &Source{
+			Version:   Version,
+			Connector: "vitess",
+			Name:      "src",
+			TsMs:      10000,
+			Snapshot:  "false",
+			Keyspace:  "ks",
+			Table:     "t1",
+			Vgtid:     "{}",
+		},
+		Op:   op,
+		TsMs: 20000,
+	}
+	before := []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NULL}
+	after := []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewVarChar("a")}
+	switch op {
+	case OpCreate:
+		env.After = after
+	case OpUpdate:
+		env.Before, env.After = before, after
+	case OpDelete:
+		env.Before = before
+	}
+	return env
+}
+
+func TestJSONEncoder(t *testing.T) {
+	msg, err := (&JSONEncoder{}).Encode(testEnvelope(OpUpdate))
+	require.NoError(t, err)
+	assert.Equal(t, "src.ks.t1", msg.Topic)
+	assert.Equal(t, `{"id":1}`, string(msg.Key))
+	want := `{"before":{"id":1,"name":null},"after":{"id":1,"name":"a"},` +
+		`"source":{"version":"1.0","connector":"vitess","name":"src","ts_ms":10000,"snapshot":"false","keyspace":"ks","table":"t1","vgtid":"{}"},` +
+		`"op":"u","ts_ms":20000}`
+	assert.Equal(t, want, string(msg.Value))
+
+	msg, err = (&JSONEncoder{}).Encode(testEnvelope(OpDelete))
+	require.NoError(t, err)
+	assert.Equal(t, `{"id":1}`, string(msg.Key))
+	assert.Contains(t, string(msg.Value), `"after":null`)
+}
+
+func TestJSONEncoderSchemas(t *testing.T) {
+	msg, err := (&JSONEncoder{Schemas: true}).Encode(testEnvelope(OpCreate))
+	require.NoError(t, err)
+	wantKey := `{"schema":{"type":"struct","fields":[{"type":"int64","optional":false,"field":"id"}],"optional":false,"name":"src.ks.t1.Key"},` +
+		`"payload":{"id":1}}`
+	assert.Equal(t, wantKey, string(msg.Key))
+
+	var value struct {
+		Schema  *connectSchema
+		Payload *jsonPayload
+	}
+	require.NoError(t, json.Unmarshal(msg.Value, &value))
+	assert.Equal(t, "src.ks.t1.Envelope", value.Schema.Name)
+	require.Len(t, value.Schema.Fields, 5)
+	assert.Equal(t, &connectSchema{
+		Type: "struct",
+		Fields: []*connectSchema{
+			{Type: "int64", Field: "id"},
+			{Type: "string", Optional: true, Field: "name"},
+		},
+		Optional: true,
+		Name:     "src.ks.t1.Value",
+		Field:    "after",
+	}, value.Schema.Fields[1])
+	assert.Equal(t, "c", value.Payload.Op)
+	assert.Nil(t, value.Payload.Before)
+}
+
+func TestJSONEncoderNoKey(t *testing.T) {
+	env := testEnvelope(OpCreate)
+	env.Fields = testFields[1:]
+	env.After = env.After[1:]
+	msg, err := (&JSONEncoder{}).Encode(env)
+	require.NoError(t, err)
+	assert.Nil(t, msg.Key)
+}
+
+func TestAvroEncoder(t *testing.T) {
+	registry := newTestRegistry()
+	enc := NewAvroEncoder(registry)
+	msg, err := enc.Encode(testEnvelope(OpUpdate))
+	require.NoError(t, err)
+
+	assert.Equal(t, `{"name":"src.ks.t1.Key","type":"record","fields":[{"name":"id","type":"long"}]}`, msg.KeySchema)
+	assert.Equal(t, msg.KeySchema, registry.subjects["src.ks.t1-key"])
+	assert.Equal(t, msg.ValueSchema, registry.subjects["src.ks.t1-value"])
+	// Magic byte, then the schema ID.
+	assert.Equal(t, byte(0), msg.Key[0])
+	assert.Equal(t, registry.ids[msg.KeySchema], int32(binary.BigEndian.Uint32(msg.Key[1:5])))
+	// id=1 zigzag encoded.
+	assert.Equal(t, []byte{0x02}, msg.Key[5:])
+
+	assert.Equal(t, byte(0), msg.Value[0])
+	assert.Equal(t, registry.ids[msg.ValueSchema], int32(binary.BigEndian.Uint32(msg.Value[1:5])))
+	want := []byte{
+		// before: branch 1, id=1, name: branch 0 (null).
+		0x02, 0x02, 0x00,
+		// after: branch 1, id=1, name: branch 1, "a".
+		0x02, 0x02, 0x02, 0x02, 'a',
+	}
+	assert.Equal(t, want, msg.Value[5:5+len(want)])
+	// The datum ends with op="u" and ts_ms=20000.
+	assert.Equal(t, []byte{0x02, 'u', 0xc0, 0xb8, 0x02}, msg.Value[len(msg.Value)-5:])
+
+	// The schemas are cached until the fields change.
+	cached := enc.schemas["src.ks.t1"]
+	_, err = enc.Encode(testEnvelope(OpCreate))
+	require.NoError(t, err)
+	assert.True(t, cached == enc.schemas["src.ks.t1"])
+
+	env := testEnvelope(OpDelete)
+	env.Fields = testFields[1:]
+	env.Before = env.Before[1:]
+	msg, err = enc.Encode(env)
+	require.NoError(t, err)
+	assert.Nil(t, msg.Key)
+	assert.Empty(t, msg.KeySchema)
+	assert.False(t, cached == enc.schemas["src.ks.t1"])
+	assert.Equal(t, registry.ids[msg.ValueSchema], int32(binary.BigEndian.Uint32(msg.Value[1:5])))
+	assert.Len(t, registry.ids, 3)
+}
+
+func TestAvroName(t *testing.T) {
+	assert.Equal(t, "my_db.t_1", avroNamespace("my-db.t$1"))
+	assert.Equal(t, "_1a", avroName("1a"))
+}
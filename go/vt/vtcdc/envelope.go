@@ -0,0 +1,312 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtcdc
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"vitess.io/vitess/go/sqltypes"
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// Debezium operation codes.
+const (
+	OpCreate = "c"
+	OpUpdate = "u"
+	OpDelete = "d"
+	OpRead   = "r"
+)
+
+// Version is reported as source.version in every envelope.
+const Version = "1.0"
+
+// Envelope is a Debezium style change event for one row.
+type Envelope struct {
+	// Topic is <name>.<keyspace>.<table>.
+	Topic string
+	// Fields are the columns of the table.
+	Fields []*querypb.Field
+	// Before is nil for inserts and snapshot reads.
+	Before []sqltypes.Value
+	// After is nil for deletes.
+	After  []sqltypes.Value
+	Source *Source
+	Op     string
+	// TsMs is the time at which vtcdc processed the event.
+	TsMs int64
+}
+
+// Source describes where a change comes from.
+type Source struct {
+	Version   string `json:"version"`
+	Connector string `json:"connector"`
+	Name      string `json:"name"`
+	// TsMs is the binlog timestamp of the change.
+	TsMs     int64  `json:"ts_ms"`
+	Snapshot string `json:"snapshot"`
+	Keyspace string `json:"keyspace"`
+	Table    string `json:"table"`
+	// Vgtid is the JSON encoded VGtid of the transaction.
+	Vgtid string `json:"vgtid"`
+}
+
+// transaction is a set of envelopes that must be written together
+// with the VGtid that follows them.
+type transaction struct {
+	envelopes []*Envelope
+	vgtid     *binlogdatapb.VGtid
+}
+
+// converter converts the events of a VStream into transactions.
+type converter struct {
+	name string
+	now  func() time.Time
+
+	// fields contains the last FIELD event of each table,
+	// keyed by the qualified table name.
+	fields map[string][]*querypb.Field
+
+	pending []*Envelope
+	vgtid   *binlogdatapb.VGtid
+}
+
+func newConverter(name string, now func() time.Time) *converter {
+	return &converter{
+		name:   name,
+		now:    now,
+		fields: make(map[string][]*querypb.Field),
+	}
+}
+
+// add processes a list of events, and returns the transactions they complete.
+// A transaction is complete when a COMMIT, DDL or OTHER event follows a VGTID.
+func (c *converter) add(events []*binlogdatapb.VEvent) ([]*transaction, error) {
+	var txs []*transaction
+	for _, event := range events {
+		switch event.Type {
+		case binlogdatapb.VEventType_FIELD:
+			c.fields[event.FieldEvent.TableName] = event.FieldEvent.Fields
+		case binlogdatapb.VEventType_ROW:
+			if err := c.addRows(event); err != nil {
+				return nil, err
+			}
+		case binlogdatapb.VEventType_VGTID:
+			c.vgtid = event.Vgtid
+		case binlogdatapb.VEventType_COMMIT, binlogdatapb.VEventType_DDL, binlogdatapb.VEventType_OTHER:
+			if c.vgtid == nil {
+				continue
+			}
+			tx, err := c.flush()
+			if err != nil {
+				return nil, err
+			}
+			txs = append(txs, tx)
+		}
+	}
+	return txs, nil
+}
+
+func (c *converter) addRows(event *binlogdatapb.VEvent) error {
+	fields, ok := c.fields[event.RowEvent.TableName]
+	if !ok {
+		return fmt.Errorf("no field event received for table %s", event.RowEvent.TableName)
+	}
+	keyspace, table := splitTableName(event.RowEvent.TableName)
+	for _, change := range event.RowEvent.RowChanges {
+		env := &Envelope{
+			Topic:  c.name + "." + keyspace + "." + table,
+			Fields: fields,
+			Source: &Source{
+				Version:   Version,
+				Connector: "vitess",
+				Name:      c.name,
+				TsMs:      event.Timestamp * 1000,
+				Snapshot:  "false",
+				Keyspace:  keyspace,
+				Table:     table,
+			},
+		}
+		switch {
+		case change.Before == nil:
+			env.Op = OpCreate
+			env.After = sqltypes.MakeRowTrusted(fields, change.After)
+		case change.After == nil:
+			env.Op = OpDelete
+			env.Before = sqltypes.MakeRowTrusted(fields, change.Before)
+		default:
+			env.Op = OpUpdate
+			env.Before = sqltypes.MakeRowTrusted(fields, change.Before)
+			env.After = sqltypes.MakeRowTrusted(fields, change.After)
+		}
+		c.pending = append(c.pending, env)
+	}
+	return nil
+}
+
+// flush completes the pending transaction. Inserts into tables that are
+// still being copied according to the VGtid are snapshot reads.
+func (c *converter) flush() (*transaction, error) {
+	vgtid, err := (&jsonpb.Marshaler{OrigName: true}).MarshalToString(c.vgtid)
+	if err != nil {
+		return nil, err
+	}
+	tsMs := c.now().UnixNano() / int64(time.Millisecond)
+	for _, env := range c.pending {
+		env.Source.Vgtid = vgtid
+		env.TsMs = tsMs
+		if env.Op == OpCreate && isCopying(c.vgtid, env.Source.Keyspace, env.Source.Table) {
+			env.Op = OpRead
+			env.Source.Snapshot = "true"
+		}
+	}
+	tx := &transaction{
+		envelopes: c.pending,
+		vgtid:     c.vgtid,
+	}
+	c.pending = nil
+	c.vgtid = nil
+	return tx, nil
+}
+
+// isCopying returns true if a shard of the keyspace has a lastpk for the table.
+func isCopying(vgtid *binlogdatapb.VGtid, keyspace, table string) bool {
+	for _, sgtid := range vgtid.ShardGtids {
+		if sgtid.Keyspace != keyspace {
+			continue
+		}
+		for _, tablePK := range sgtid.TablePKs {
+			if tablePK.TableName == table {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// splitTableName splits the keyspace.table names sent by vtgate.
+func splitTableName(name string) (keyspace, table string) {
+	if i := strings.IndexByte(name, '.'); i >= 0 {
+		return name[:i], name[i+1:]
+	}
+	return "", name
+}
+
+// isKey returns true if the field is part of the primary key.
+func isKey(field *querypb.Field) bool {
+	return field.Flags&uint32(querypb.MySqlFlag_PRI_KEY_FLAG) != 0
+}
+
+// isOptional returns true if the column can be null.
+func isOptional(field *querypb.Field) bool {
+	return field.Flags&uint32(querypb.MySqlFlag_NOT_NULL_FLAG) == 0
+}
+
+// Column kinds. They determine the schema type and
+// the Go value of a column in the encoded envelopes.
+const (
+	kindInt32 = iota
+	kindInt64
+	kindFloat32
+	kindFloat64
+	kindBytes
+	kindString
+)
+
+// columnKind returns the kind of a column. Unsigned bigints don't fit
+// an int64 and are encoded as strings, like decimals and temporal types.
+func columnKind(typ querypb.Type) int {
+	switch typ {
+	case sqltypes.Int8, sqltypes.Uint8, sqltypes.Int16, sqltypes.Uint16, sqltypes.Int24, sqltypes.Uint24, sqltypes.Int32, sqltypes.Year:
+		return kindInt32
+	case sqltypes.Uint32, sqltypes.Int64:
+		return kindInt64
+	case sqltypes.Float32:
+		return kindFloat32
+	case sqltypes.Float64:
+		return kindFloat64
+	case sqltypes.Bit:
+		return kindBytes
+	}
+	if sqltypes.IsBinary(typ) {
+		return kindBytes
+	}
+	return kindString
+}
+
+// columnValue converts a value to the Go value of its column kind.
+func columnValue(typ querypb.Type, v sqltypes.Value) (interface{}, error) {
+	if v.IsNull() {
+		return nil, nil
+	}
+	switch columnKind(typ) {
+	case kindInt32, kindInt64:
+		return strconv.ParseInt(v.ToString(), 10, 64)
+	case kindFloat32:
+		f, err := strconv.ParseFloat(v.ToString(), 32)
+		return float32(f), err
+	case kindFloat64:
+		return strconv.ParseFloat(v.ToString(), 64)
+	case kindBytes:
+		return v.ToBytes(), nil
+	}
+	return v.ToString(), nil
+}
+
+// rowValues returns the values of a row keyed by column name.
+// Only the key columns are returned if keyOnly is set.
+func rowValues(fields []*querypb.Field, row []sqltypes.Value, keyOnly bool) (map[string]interface{}, error) {
+	if row == nil {
+		return nil, nil
+	}
+	values := make(map[string]interface{}, len(fields))
+	for i, field := range fields {
+		if keyOnly && !isKey(field) {
+			continue
+		}
+		v, err := columnValue(field.Type, row[i])
+		if err != nil {
+			return nil, fmt.Errorf("column %s: %v", field.Name, err)
+		}
+		values[field.Name] = v
+	}
+	return values, nil
+}
+
+// keyRow returns the row that identifies the changed row: the after
+// image, or the before image for deletes.
+func (env *Envelope) keyRow() []sqltypes.Value {
+	if env.After != nil {
+		return env.After
+	}
+	return env.Before
+}
+
+// hasKey returns true if the table has a primary key.
+func hasKey(fields []*querypb.Field) bool {
+	for _, field := range fields {
+		if isKey(field) {
+			return true
+		}
+	}
+	return false
+}
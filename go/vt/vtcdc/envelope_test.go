@@ -0,0 +1,220 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtcdc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var testFields = []*querypb.Field{{
+	Name:  "id",
+	Type:  sqltypes.Int64,
+	Flags: uint32(querypb.MySqlFlag_PRI_KEY_FLAG | querypb.MySqlFlag_NOT_NULL_FLAG),
+}, {
+	Name: "name",
+	Type: sqltypes.VarChar,
+}}
+
+func testNow() time.Time {
+	return time.Unix(1000, 0)
+}
+
+func fieldEvent(table string) *binlogdatapb.VEvent {
+	return &binlogdatapb.VEvent{
+		Type: binlogdatapb.VEventType_FIELD,
+		FieldEvent: &binlogdatapb.FieldEvent{
+			TableName: table,
+			Fields:    testFields,
+		},
+	}
+}
+
+func rowEvent(table string, changes ...*binlogdatapb.RowChange) *binlogdatapb.VEvent {
+	return &binlogdatapb.VEvent{
+		Type:      binlogdatapb.VEventType_ROW,
+		Timestamp: 10,
+		RowEvent: &binlogdatapb.RowEvent{
+			TableName:  table,
+			RowChanges: changes,
+		},
+	}
+}
+
+func testRow(values ...string) *querypb.Row {
+	var vals []sqltypes.Value
+	for _, v := range values {
+		if v == "null" {
+			vals = append(vals, sqltypes.NULL)
+			continue
+		}
+		vals = append(vals, sqltypes.NewVarChar(v))
+	}
+	return sqltypes.RowToProto3(vals)
+}
+
+func vgtidEvent(gtid string, tablePKs ...string) *binlogdatapb.VEvent {
+	sgtid := &binlogdatapb.ShardGtid{
+		Keyspace: "ks",
+		Shard:    "0",
+		Gtid:     gtid,
+	}
+	for _, table := range tablePKs {
+		sgtid.TablePKs = append(sgtid.TablePKs, &binlogdatapb.TableLastPK{TableName: table})
+	}
+	return &binlogdatapb.VEvent{
+		Type:  binlogdatapb.VEventType_VGTID,
+		Vgtid: &binlogdatapb.VGtid{ShardGtids: []*binlogdatapb.ShardGtid{sgtid}},
+	}
+}
+
+func commitEvent() *binlogdatapb.VEvent {
+	return &binlogdatapb.VEvent{Type: binlogdatapb.VEventType_COMMIT}
+}
+
+func TestConverter(t *testing.T) {
+	c := newConverter("src", testNow)
+
+	txs, err := c.add([]*binlogdatapb.VEvent{
+		fieldEvent("ks.t1"),
+		rowEvent("ks.t1",
+			&binlogdatapb.RowChange{After: testRow("1", "a")},
+			&binlogdatapb.RowChange{Before: testRow("1", "a"), After: testRow("1", "null")},
+			&binlogdatapb.RowChange{Before: testRow("1", "null")},
+		),
+		vgtidEvent("pos1"),
+		commitEvent(),
+	})
+	require.NoError(t, err)
+	require.Len(t, txs, 1)
+	tx := txs[0]
+	assert.Equal(t, "pos1", tx.vgtid.ShardGtids[0].Gtid)
+	require.Len(t, tx.envelopes, 3)
+
+	var ops []string
+	for _, env := range tx.envelopes {
+		ops = append(ops, env.Op)
+		assert.Equal(t, "src.ks.t1", env.Topic)
+		assert.Equal(t, int64(1000000), env.TsMs)
+		assert.Equal(t, &Source{
+			Version:   Version,
+			Connector: "vitess",
+			Name:      "src",
+			TsMs:      10000,
+			Snapshot:  "false",
+			Keyspace:  "ks",
+			Table:     "t1",
+			Vgtid:     `{"shard_gtids":[{"keyspace":"ks","shard":"0","gtid":"pos1"}]}`,
+		}, env.Source)
+	}
+	assert.Equal(t, []string{OpCreate, OpUpdate, OpDelete}, ops)
+	assert.Nil(t, tx.envelopes[0].Before)
+	assert.Equal(t, "a", tx.envelopes[0].After[1].ToString())
+	assert.True(t, tx.envelopes[1].After[1].IsNull())
+	assert.Nil(t, tx.envelopes[2].After)
+	assert.Equal(t, "1", tx.envelopes[2].keyRow()[0].ToString())
+}
+
+func TestConverterSnapshot(t *testing.T) {
+	c := newConverter("src", testNow)
+
+	// Rows of a table that is still being copied are snapshot reads.
+	txs, err := c.add([]*binlogdatapb.VEvent{
+		fieldEvent("ks.t1"),
+		rowEvent("ks.t1", &binlogdatapb.RowChange{After: testRow("1", "a")}),
+		vgtidEvent("pos1", "t1"),
+		commitEvent(),
+		rowEvent("ks.t1", &binlogdatapb.RowChange{After: testRow("2", "b")}),
+		vgtidEvent("pos2"),
+		commitEvent(),
+	})
+	require.NoError(t, err)
+	require.Len(t, txs, 2)
+	assert.Equal(t, OpRead, txs[0].envelopes[0].Op)
+	assert.Equal(t, "true", txs[0].envelopes[0].Source.Snapshot)
+	assert.Equal(t, OpCreate, txs[1].envelopes[0].Op)
+	assert.Equal(t, "false", txs[1].envelopes[0].Source.Snapshot)
+}
+
+func TestConverterPartial(t *testing.T) {
+	c := newConverter("src", testNow)
+
+	// A transaction is only complete once its VGTID is received.
+	txs, err := c.add([]*binlogdatapb.VEvent{
+		fieldEvent("ks.t1"),
+		rowEvent("ks.t1", &binlogdatapb.RowChange{After: testRow("1", "a")}),
+	})
+	require.NoError(t, err)
+	assert.Empty(t, txs)
+
+	txs, err = c.add([]*binlogdatapb.VEvent{
+		vgtidEvent("pos1"),
+		commitEvent(),
+	})
+	require.NoError(t, err)
+	require.Len(t, txs, 1)
+	assert.Len(t, txs[0].envelopes, 1)
+
+	_, err = c.add([]*binlogdatapb.VEvent{
+		rowEvent("ks.t2", &binlogdatapb.RowChange{After: testRow("1", "a")}),
+	})
+	assert.EqualError(t, err, "no field event received for table ks.t2")
+}
+
+func TestColumnValue(t *testing.T) {
+	testcases := []struct {
+		typ  querypb.Type
+		in   sqltypes.Value
+		want interface{}
+	}{{
+		typ:  sqltypes.Int32,
+		in:   sqltypes.NewInt32(-5),
+		want: int64(-5),
+	}, {
+		typ:  sqltypes.Uint64,
+		in:   sqltypes.NewUint64(18446744073709551615),
+		want: "18446744073709551615",
+	}, {
+		typ:  sqltypes.Float64,
+		in:   sqltypes.NewFloat64(1.5),
+		want: 1.5,
+	}, {
+		typ:  sqltypes.Decimal,
+		in:   sqltypes.TestValue(sqltypes.Decimal, "1.10"),
+		want: "1.10",
+	}, {
+		typ:  sqltypes.VarBinary,
+		in:   sqltypes.NewVarBinary("ab"),
+		want: []byte("ab"),
+	}, {
+		typ:  sqltypes.VarChar,
+		in:   sqltypes.NULL,
+		want: nil,
+	}}
+	for _, tcase := range testcases {
+		got, err := columnValue(tcase.typ, tcase.in)
+		require.NoError(t, err)
+		assert.Equal(t, tcase.want, got, "%v", tcase.in)
+	}
+}
@@ -0,0 +1,254 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtcdc
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sync"
+
+	"github.com/golang/protobuf/jsonpb"
+	"golang.org/x/net/context"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+// The file and stdout sinks write one JSON object per line. Messages are
+// written as {"topic":...,"key":...,"value":...}. A key or value that is
+// not valid JSON, such as an Avro datum, is written as a base64 string.
+// Every transaction is followed by a {"vgtid":...} checkpoint line.
+//
+// The address of the stdout sink is optional. If set, it's the name of a
+// file where the VGtid of the last transaction is saved once its lines are
+// written, and the stream resumes from it. The last transaction may then
+// be written again after a crash.
+
+func init() {
+	RegisterSink("file", func(address string) (Sink, error) {
+		return newFileSink(address)
+	})
+	RegisterSink("stdout", func(address string) (Sink, error) {
+		return &writerSink{w: os.Stdout, checkpoint: address}, nil
+	})
+}
+
+type lineMessage struct {
+	Topic       string      `json:"topic"`
+	Key         interface{} `json:"key"`
+	Value       interface{} `json:"value"`
+	KeySchema   string      `json:"key_schema,omitempty"`
+	ValueSchema string      `json:"value_schema,omitempty"`
+}
+
+type lineCheckpoint struct {
+	Vgtid json.RawMessage `json:"vgtid"`
+}
+
+// lineValue returns the value to write for a key or value.
+func lineValue(b []byte) interface{} {
+	if b == nil {
+		return nil
+	}
+	if json.Valid(b) {
+		return json.RawMessage(b)
+	}
+	return b
+}
+
+// marshalLines returns the lines for the messages of a transaction.
+func marshalLines(msgs []*Message, vgtid *binlogdatapb.VGtid) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	for _, msg := range msgs {
+		line, err := json.Marshal(&lineMessage{
+			Topic:       msg.Topic,
+			Key:         lineValue(msg.Key),
+			Value:       lineValue(msg.Value),
+			KeySchema:   msg.KeySchema,
+			ValueSchema: msg.ValueSchema,
+		})
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+	vgtidJSON, err := (&jsonpb.Marshaler{OrigName: true}).MarshalToString(vgtid)
+	if err != nil {
+		return nil, err
+	}
+	line, err := json.Marshal(&lineCheckpoint{Vgtid: json.RawMessage(vgtidJSON)})
+	if err != nil {
+		return nil, err
+	}
+	buf.Write(line)
+	buf.WriteByte('\n')
+	return buf.Bytes(), nil
+}
+
+// writerSink writes to an io.Writer. If checkpoint is set, the
+// VGtid of the last transaction is saved in the checkpoint file.
+type writerSink struct {
+	mu         sync.Mutex
+	w          io.Writer
+	checkpoint string
+}
+
+func (ws *writerSink) Write(ctx context.Context, msgs []*Message, vgtid *binlogdatapb.VGtid) error {
+	lines, err := marshalLines(msgs, vgtid)
+	if err != nil {
+		return err
+	}
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	if _, err := ws.w.Write(lines); err != nil {
+		return err
+	}
+	if ws.checkpoint == "" {
+		return nil
+	}
+	vgtidJSON, err := (&jsonpb.Marshaler{OrigName: true}).MarshalToString(vgtid)
+	if err != nil {
+		return err
+	}
+	// The file is replaced atomically, so that it always has a VGtid.
+	tmp := ws.checkpoint + ".tmp"
+	if err := ioutil.WriteFile(tmp, []byte(vgtidJSON), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, ws.checkpoint)
+}
+
+func (ws *writerSink) LastVGtid(ctx context.Context) (*binlogdatapb.VGtid, error) {
+	if ws.checkpoint == "" {
+		return nil, nil
+	}
+	data, err := ioutil.ReadFile(ws.checkpoint)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	vgtid := &binlogdatapb.VGtid{}
+	if err := jsonpb.Unmarshal(bytes.NewReader(data), vgtid); err != nil {
+		return nil, fmt.Errorf("invalid checkpoint file %s: %v", ws.checkpoint, err)
+	}
+	return vgtid, nil
+}
+
+func (ws *writerSink) Close() error {
+	return nil
+}
+
+// fileSink appends to a file. A transaction is stored once its
+// checkpoint line is synced: when the file is opened, anything after
+// the last checkpoint is truncated, and the stream is resumed from it.
+type fileSink struct {
+	mu   sync.Mutex
+	file *os.File
+	// offset is the end of the last checkpoint.
+	offset    int64
+	lastVGtid *binlogdatapb.VGtid
+}
+
+func newFileSink(name string) (*fileSink, error) {
+	file, err := os.OpenFile(name, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, err
+	}
+	fs := &fileSink{file: file}
+	if err := fs.recover(); err != nil {
+		file.Close()
+		return nil, err
+	}
+	return fs, nil
+}
+
+// recover finds the last checkpoint, and truncates the file after it.
+func (fs *fileSink) recover() error {
+	var offset, end int64
+	var lastCheckpoint []byte
+	r := bufio.NewReader(fs.file)
+	for {
+		line, err := r.ReadBytes('\n')
+		if err == io.EOF {
+			// A partial line is the end of an incomplete write.
+			break
+		}
+		if err != nil {
+			return err
+		}
+		end += int64(len(line))
+		var checkpoint lineCheckpoint
+		if json.Unmarshal(line, &checkpoint) == nil && checkpoint.Vgtid != nil {
+			offset = end
+			lastCheckpoint = checkpoint.Vgtid
+		}
+	}
+	if lastCheckpoint != nil {
+		fs.lastVGtid = &binlogdatapb.VGtid{}
+		if err := jsonpb.Unmarshal(bytes.NewReader(lastCheckpoint), fs.lastVGtid); err != nil {
+			return err
+		}
+	}
+	fs.offset = offset
+	return fs.truncate()
+}
+
+// truncate removes what was written after the last checkpoint.
+func (fs *fileSink) truncate() error {
+	if err := fs.file.Truncate(fs.offset); err != nil {
+		return err
+	}
+	_, err := fs.file.Seek(fs.offset, io.SeekStart)
+	return err
+}
+
+func (fs *fileSink) Write(ctx context.Context, msgs []*Message, vgtid *binlogdatapb.VGtid) error {
+	lines, err := marshalLines(msgs, vgtid)
+	if err != nil {
+		return err
+	}
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	if _, err := fs.file.Write(lines); err != nil {
+		fs.truncate()
+		return err
+	}
+	if err := fs.file.Sync(); err != nil {
+		fs.truncate()
+		return err
+	}
+	fs.offset += int64(len(lines))
+	fs.lastVGtid = vgtid
+	return nil
+}
+
+func (fs *fileSink) LastVGtid(ctx context.Context) (*binlogdatapb.VGtid, error) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	return fs.lastVGtid, nil
+}
+
+func (fs *fileSink) Close() error {
+	return fs.file.Close()
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtcdc
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+func testVGtid(gtid string) *binlogdatapb.VGtid {
+	return &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: "ks",
+			Shard:    "0",
+			Gtid:     gtid,
+		}},
+	}
+}
+
+func TestFileSink(t *testing.T) {
+	ctx := context.Background()
+	dir, err := ioutil.TempDir("", "vtcdc")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	name := path.Join(dir, "sink.log")
+
+	sink, err := NewSink("file", name)
+	require.NoError(t, err)
+	vgtid, err := sink.LastVGtid(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, vgtid)
+
+	msgs := []*Message{{
+		Topic: "src.ks.t1",
+		Key:   []byte(`{"id":1}`),
+		Value: []byte(`{"op":"c"}`),
+	}, {
+		Topic:       "src.ks.t2",
+		Value:       []byte{0xc3, 0x01},
+		ValueSchema: `"null"`,
+	}}
+	require.NoError(t, sink.Write(ctx, msgs, testVGtid("pos1")))
+	require.NoError(t, sink.Write(ctx, nil, testVGtid("pos2")))
+	require.NoError(t, sink.Close())
+
+	want := `{"topic":"src.ks.t1","key":{"id":1},"value":{"op":"c"}}
+{"topic":"src.ks.t2","key":null,"value":"wwE=","value_schema":"\"null\""}
+{"vgtid":{"shard_gtids":[{"keyspace":"ks","shard":"0","gtid":"pos1"}]}}
+{"vgtid":{"shard_gtids":[{"keyspace":"ks","shard":"0","gtid":"pos2"}]}}
+`
+	got, err := ioutil.ReadFile(name)
+	require.NoError(t, err)
+	assert.Equal(t, want, string(got))
+
+	// Simulate a write that was interrupted before its checkpoint.
+	f, err := os.OpenFile(name, os.O_APPEND|os.O_WRONLY, 0644)
+	require.NoError(t, err)
+	_, err = f.WriteString("{\"topic\":\"src.ks.t1\",\"key\":{\"id\":2},\"value\":{}}\n{\"vgt")
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	sink, err = NewSink("file", name)
+	require.NoError(t, err)
+	defer sink.Close()
+	vgtid, err = sink.LastVGtid(ctx)
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(testVGtid("pos2"), vgtid), "got %v", vgtid)
+	got, err = ioutil.ReadFile(name)
+	require.NoError(t, err)
+	assert.Equal(t, want, string(got))
+
+	require.NoError(t, sink.Write(ctx, msgs[:1], testVGtid("pos3")))
+	got, err = ioutil.ReadFile(name)
+	require.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(got, []byte(want)))
+	assert.True(t, bytes.HasSuffix(got, []byte(`"gtid":"pos3"}]}}`+"\n")))
+}
+
+func TestWriterSink(t *testing.T) {
+	buf := &bytes.Buffer{}
+	sink := &writerSink{w: buf}
+	require.NoError(t, sink.Write(context.Background(), nil, testVGtid("pos1")))
+	assert.Equal(t, `{"vgtid":{"shard_gtids":[{"keyspace":"ks","shard":"0","gtid":"pos1"}]}}`+"\n", buf.String())
+
+	_, err := NewSink("kafka", "")
+	assert.EqualError(t, err, "no sink registered for name kafka, available sinks: [file stdout]")
+}
+
+func TestWriterSinkCheckpoint(t *testing.T) {
+	ctx := context.Background()
+	dir, err := ioutil.TempDir("", "vtcdc")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	name := path.Join(dir, "checkpoint")
+
+	buf := &bytes.Buffer{}
+	sink := &writerSink{w: buf, checkpoint: name}
+	vgtid, err := sink.LastVGtid(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, vgtid)
+
+	require.NoError(t, sink.Write(ctx, nil, testVGtid("pos1")))
+	require.NoError(t, sink.Write(ctx, nil, testVGtid("pos2")))
+	assert.Equal(t, 2, bytes.Count(buf.Bytes(), []byte("\n")))
+
+	// A new sink resumes from the last checkpoint.
+	sink = &writerSink{w: &bytes.Buffer{}, checkpoint: name}
+	vgtid, err = sink.LastVGtid(ctx)
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(testVGtid("pos2"), vgtid), "got %v", vgtid)
+
+	require.NoError(t, ioutil.WriteFile(name, []byte("pos2"), 0644))
+	_, err = sink.LastVGtid(ctx)
+	assert.Contains(t, err.Error(), "invalid checkpoint file")
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtcdc
+
+import (
+	"encoding/json"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// JSONEncoder encodes envelopes the way the Kafka Connect JSON
+// converter encodes Debezium events.
+type JSONEncoder struct {
+	// Schemas wraps keys and values in a schema/payload object,
+	// like the converter does with schemas.enable=true.
+	Schemas bool
+}
+
+var _ Encoder = (*JSONEncoder)(nil)
+
+// connectSchema is a Kafka Connect schema.
+type connectSchema struct {
+	Type     string           `json:"type"`
+	Fields   []*connectSchema `json:"fields,omitempty"`
+	Optional bool             `json:"optional"`
+	Name     string           `json:"name,omitempty"`
+	Field    string           `json:"field,omitempty"`
+}
+
+type jsonMessage struct {
+	Schema  *connectSchema `json:"schema"`
+	Payload interface{}    `json:"payload"`
+}
+
+type jsonPayload struct {
+	Before map[string]interface{} `json:"before"`
+	After  map[string]interface{} `json:"after"`
+	Source *Source                `json:"source"`
+	Op     string                 `json:"op"`
+	TsMs   int64                  `json:"ts_ms"`
+}
+
+var connectTypes = map[int]string{
+	kindInt32:   "int32",
+	kindInt64:   "int64",
+	kindFloat32: "float",
+	kindFloat64: "double",
+	kindBytes:   "bytes",
+	kindString:  "string",
+}
+
+// Encode implements Encoder.
+func (enc *JSONEncoder) Encode(env *Envelope) (*Message, error) {
+	before, err := rowValues(env.Fields, env.Before, false)
+	if err != nil {
+		return nil, err
+	}
+	after, err := rowValues(env.Fields, env.After, false)
+	if err != nil {
+		return nil, err
+	}
+	value, err := enc.marshal(enc.valueSchema(env), &jsonPayload{
+		Before: before,
+		After:  after,
+		Source: env.Source,
+		Op:     env.Op,
+		TsMs:   env.TsMs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	msg := &Message{
+		Topic: env.Topic,
+		Value: value,
+	}
+	if hasKey(env.Fields) {
+		key, err := rowValues(env.Fields, env.keyRow(), true)
+		if err != nil {
+			return nil, err
+		}
+		if msg.Key, err = enc.marshal(columnsSchema(env.Fields, true, env.Topic+".Key", false), key); err != nil {
+			return nil, err
+		}
+	}
+	return msg, nil
+}
+
+func (enc *JSONEncoder) marshal(schema *connectSchema, payload interface{}) ([]byte, error) {
+	if !enc.Schemas {
+		return json.Marshal(payload)
+	}
+	return json.Marshal(&jsonMessage{
+		Schema:  schema,
+		Payload: payload,
+	})
+}
+
+func (enc *JSONEncoder) valueSchema(env *Envelope) *connectSchema {
+	if !enc.Schemas {
+		return nil
+	}
+	before := columnsSchema(env.Fields, false, env.Topic+".Value", true)
+	before.Field = "before"
+	after := columnsSchema(env.Fields, false, env.Topic+".Value", true)
+	after.Field = "after"
+	return &connectSchema{
+		Type: "struct",
+		Fields: []*connectSchema{
+			before,
+			after,
+			sourceSchema(),
+			{Type: "string", Field: "op"},
+			{Type: "int64", Optional: true, Field: "ts_ms"},
+		},
+		Name: env.Topic + ".Envelope",
+	}
+}
+
+// columnsSchema returns the schema of the columns of a table.
+func columnsSchema(fields []*querypb.Field, keyOnly bool, name string, optional bool) *connectSchema {
+	schema := &connectSchema{
+		Type:     "struct",
+		Optional: optional,
+		Name:     name,
+	}
+	for _, field := range fields {
+		if keyOnly && !isKey(field) {
+			continue
+		}
+		schema.Fields = append(schema.Fields, &connectSchema{
+			Type:     connectTypes[columnKind(field.Type)],
+			Optional: isOptional(field),
+			Field:    field.Name,
+		})
+	}
+	return schema
+}
+
+func sourceSchema() *connectSchema {
+	return &connectSchema{
+		Type: "struct",
+		Fields: []*connectSchema{
+			{Type: "string", Field: "version"},
+			{Type: "string", Field: "connector"},
+			{Type: "string", Field: "name"},
+			{Type: "int64", Field: "ts_ms"},
+			{Type: "string", Optional: true, Field: "snapshot"},
+			{Type: "string", Field: "keyspace"},
+			{Type: "string", Field: "table"},
+			{Type: "string", Field: "vgtid"},
+		},
+		Name:  "io.debezium.connector.vitess.Source",
+		Field: "source",
+	}
+}
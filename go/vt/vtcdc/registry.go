@@ -0,0 +1,94 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtcdc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// registryContentType is the content type of the requests and
+// responses of the REST API of the Confluent schema registry.
+const registryContentType = "application/vnd.schemaregistry.v1+json"
+
+// registryTimeout bounds a request to the schema registry.
+const registryTimeout = 30 * time.Second
+
+// SchemaRegistry assigns IDs to Avro schemas. The Avro messages
+// reference their schemas by these IDs.
+type SchemaRegistry interface {
+	// Register registers the schema under the subject, if it's not
+	// registered yet, and returns its ID.
+	Register(subject, schema string) (int32, error)
+}
+
+// RegistryClient is a SchemaRegistry backed by a Confluent
+// compatible schema registry.
+type RegistryClient struct {
+	url    string
+	client *http.Client
+}
+
+var _ SchemaRegistry = (*RegistryClient)(nil)
+
+// NewRegistryClient returns a client of the schema registry at url,
+// e.g. http://registry-host:8081.
+func NewRegistryClient(url string) *RegistryClient {
+	return &RegistryClient{
+		url:    strings.TrimRight(url, "/"),
+		client: &http.Client{Timeout: registryTimeout},
+	}
+}
+
+// Register implements SchemaRegistry.
+func (rc *RegistryClient) Register(subject, schema string) (int32, error) {
+	body, err := json.Marshal(map[string]string{"schema": schema})
+	if err != nil {
+		return 0, err
+	}
+	resp, err := rc.client.Post(fmt.Sprintf("%s/subjects/%s/versions", rc.url, url.PathEscape(subject)), registryContentType, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return 0, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		var registryErr struct {
+			Message string `json:"message"`
+		}
+		if json.Unmarshal(data, &registryErr) != nil || registryErr.Message == "" {
+			registryErr.Message = string(data)
+		}
+		return 0, fmt.Errorf("cannot register the schema of %s: %s: %s", subject, resp.Status, registryErr.Message)
+	}
+	var result struct {
+		ID int32 `json:"id"`
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return 0, fmt.Errorf("cannot register the schema of %s: %v", subject, err)
+	}
+	return result.ID, nil
+}
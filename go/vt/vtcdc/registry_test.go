@@ -0,0 +1,79 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtcdc
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testRegistry is an in-memory SchemaRegistry.
+type testRegistry struct {
+	ids      map[string]int32
+	subjects map[string]string
+}
+
+func newTestRegistry() *testRegistry {
+	return &testRegistry{
+		ids:      make(map[string]int32),
+		subjects: make(map[string]string),
+	}
+}
+
+func (tr *testRegistry) Register(subject, schema string) (int32, error) {
+	tr.subjects[subject] = schema
+	id, ok := tr.ids[schema]
+	if !ok {
+		id = int32(len(tr.ids) + 1)
+		tr.ids[schema] = id
+	}
+	return id, nil
+}
+
+func TestRegistryClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", registryContentType)
+		if r.URL.Path != "/subjects/src.ks.t1-value/versions" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error_code":40401,"message":"Subject not found."}`))
+			return
+		}
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, registryContentType, r.Header.Get("Content-Type"))
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		var req map[string]string
+		require.NoError(t, json.Unmarshal(body, &req))
+		assert.Equal(t, `"string"`, req["schema"])
+		w.Write([]byte(`{"id":42}`))
+	}))
+	defer server.Close()
+
+	rc := NewRegistryClient(server.URL + "/")
+	id, err := rc.Register("src.ks.t1-value", `"string"`)
+	require.NoError(t, err)
+	assert.Equal(t, int32(42), id)
+
+	_, err = rc.Register("other", `"string"`)
+	assert.EqualError(t, err, "cannot register the schema of other: 404 Not Found: Subject not found.")
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtcdc
+
+import (
+	"fmt"
+	"sort"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/log"
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+// Message is an encoded envelope, in the shape of a Kafka record.
+type Message struct {
+	Topic string
+	// Key identifies the row within the topic.
+	// It is nil if the table has no primary key.
+	Key   []byte
+	Value []byte
+	// KeySchema and ValueSchema are set by encoders whose
+	// output can't be decoded without a schema.
+	KeySchema   string
+	ValueSchema string
+}
+
+// Encoder encodes envelopes into messages.
+type Encoder interface {
+	Encode(env *Envelope) (*Message, error)
+}
+
+// Sink stores messages along with the VGtid that follows them.
+// Resuming from the VGtid returned by LastVGtid must not lose
+// messages. A sink that stores the messages of a Write and its VGtid
+// atomically also doesn't produce duplicates. Otherwise, like the
+// stdout sink, it may write the last transaction again.
+type Sink interface {
+	// Write stores the messages of one transaction. The messages
+	// may be empty, in which case only the VGtid is stored.
+	Write(ctx context.Context, msgs []*Message, vgtid *binlogdatapb.VGtid) error
+
+	// LastVGtid returns the VGtid of the last Write, or nil if
+	// there is none.
+	LastVGtid(ctx context.Context) (*binlogdatapb.VGtid, error)
+
+	// Close releases the resources of the sink.
+	Close() error
+}
+
+// SinkFactory creates a sink. The meaning of address is specific to
+// the sink: it's a file name for the file sink.
+type SinkFactory func(address string) (Sink, error)
+
+var sinkFactories = make(map[string]SinkFactory)
+
+// RegisterSink is meant to be used by sink implementations to
+// self register. A Kafka producer, for instance, can be plugged in
+// by registering it from an init function.
+func RegisterSink(name string, factory SinkFactory) {
+	if _, ok := sinkFactories[name]; ok {
+		log.Warningf("Sink %s already exists, overwriting it", name)
+	}
+	sinkFactories[name] = factory
+}
+
+// NewSink creates a sink of a registered implementation.
+func NewSink(name, address string) (Sink, error) {
+	factory, ok := sinkFactories[name]
+	if !ok {
+		var names []string
+		for name := range sinkFactories {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("no sink registered for name %s, available sinks: %v", name, names)
+	}
+	return factory(address)
+}
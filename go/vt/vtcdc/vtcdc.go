@@ -0,0 +1,101 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package vtcdc converts the events of a VStream into Debezium style
+// change envelopes and writes them to a sink.
+//
+// Every row change becomes one message in the topic
+// <name>.<keyspace>.<table>, keyed by the primary key of the row.
+// The messages of a transaction are written together with the VGtid
+// that follows them, and a stream resumes from the last VGtid stored
+// by its sink. The file sink stores both atomically, so no change is lost
+// or written twice across restarts.
+//
+// The messages are in the shape of Kafka records, and the Avro encoder
+// uses the Confluent wire format, but no Kafka producer is built in:
+// one can be plugged in with RegisterSink.
+package vtcdc
+
+import (
+	"io"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/log"
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	"vitess.io/vitess/go/vt/vtgate/vtgateconn"
+)
+
+// Config is the configuration of a stream.
+type Config struct {
+	// Name is the logical name of the source. It's the prefix of
+	// the topics, and the source name of the envelopes.
+	Name       string
+	TabletType topodatapb.TabletType
+	// Vgtid is where the stream starts if the sink has no VGtid.
+	Vgtid  *binlogdatapb.VGtid
+	Filter *binlogdatapb.Filter
+	// StreamID is the name of the stream in vtgate, if set.
+	// It can be used to add tables to the stream while it runs.
+	StreamID string
+}
+
+// Run streams changes from vtgate into the sink. It returns when
+// the context is done, the stream ends or an error occurs.
+func Run(ctx context.Context, conn *vtgateconn.VTGateConn, cfg *Config, enc Encoder, sink Sink) error {
+	vgtid, err := sink.LastVGtid(ctx)
+	if err != nil {
+		return err
+	}
+	if vgtid == nil {
+		vgtid = cfg.Vgtid
+	} else {
+		log.Infof("Resuming from %v", vgtid)
+	}
+	reader, err := conn.NamedVStream(ctx, cfg.StreamID, cfg.TabletType, vgtid, cfg.Filter)
+	if err != nil {
+		return err
+	}
+	conv := newConverter(cfg.Name, time.Now)
+	for {
+		events, err := reader.Recv()
+		switch {
+		case err == io.EOF:
+			return nil
+		case err != nil:
+			return err
+		}
+		txs, err := conv.add(events)
+		if err != nil {
+			return err
+		}
+		for _, tx := range txs {
+			msgs := make([]*Message, 0, len(tx.envelopes))
+			for _, env := range tx.envelopes {
+				msg, err := enc.Encode(env)
+				if err != nil {
+					return err
+				}
+				msgs = append(msgs, msg)
+			}
+			if err := sink.Write(ctx, msgs, tx.vgtid); err != nil {
+				return err
+			}
+		}
+	}
+}
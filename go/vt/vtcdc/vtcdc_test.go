@@ -0,0 +1,121 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtcdc
+
+import (
+	"io"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	"vitess.io/vitess/go/vt/vtgate/fakerpcvtgateconn"
+	"vitess.io/vitess/go/vt/vtgate/vtgateconn"
+)
+
+// fakeConn streams a fixed list of events, and records its requests.
+type fakeConn struct {
+	*fakerpcvtgateconn.FakeVTGateConn
+	events   [][]*binlogdatapb.VEvent
+	vgtid    *binlogdatapb.VGtid
+	streamID string
+}
+
+func (fc *fakeConn) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, streamID string) (vtgateconn.VStreamReader, error) {
+	fc.vgtid = vgtid
+	fc.streamID = streamID
+	return &fakeReader{events: fc.events}, nil
+}
+
+type fakeReader struct {
+	events [][]*binlogdatapb.VEvent
+}
+
+func (fr *fakeReader) Recv() ([]*binlogdatapb.VEvent, error) {
+	if len(fr.events) == 0 {
+		return nil, io.EOF
+	}
+	events := fr.events[0]
+	fr.events = fr.events[1:]
+	return events, nil
+}
+
+// memorySink keeps the messages in memory.
+type memorySink struct {
+	msgs  [][]*Message
+	vgtid *binlogdatapb.VGtid
+}
+
+func (ms *memorySink) Write(ctx context.Context, msgs []*Message, vgtid *binlogdatapb.VGtid) error {
+	ms.msgs = append(ms.msgs, msgs)
+	ms.vgtid = vgtid
+	return nil
+}
+
+func (ms *memorySink) LastVGtid(ctx context.Context) (*binlogdatapb.VGtid, error) {
+	return ms.vgtid, nil
+}
+
+func (ms *memorySink) Close() error {
+	return nil
+}
+
+func TestRun(t *testing.T) {
+	ctx := context.Background()
+	fc := &fakeConn{
+		FakeVTGateConn: &fakerpcvtgateconn.FakeVTGateConn{},
+		events: [][]*binlogdatapb.VEvent{{
+			fieldEvent("ks.t1"),
+			rowEvent("ks.t1", &binlogdatapb.RowChange{After: testRow("1", "a")}),
+			vgtidEvent("pos1"),
+			commitEvent(),
+		}, {
+			vgtidEvent("pos2"),
+			commitEvent(),
+		}},
+	}
+	vtgateconn.RegisterDialer("vtcdc_test", func(context.Context, string) (vtgateconn.Impl, error) {
+		return fc, nil
+	})
+	conn, err := vtgateconn.DialProtocol(ctx, "vtcdc_test", "")
+	require.NoError(t, err)
+
+	cfg := &Config{
+		Name:     "src",
+		Vgtid:    testVGtid("current"),
+		StreamID: "s1",
+	}
+	sink := &memorySink{}
+	require.NoError(t, Run(ctx, conn, cfg, &JSONEncoder{}, sink))
+	assert.True(t, proto.Equal(cfg.Vgtid, fc.vgtid))
+	assert.Equal(t, "s1", fc.streamID)
+	require.Len(t, sink.msgs, 2)
+	require.Len(t, sink.msgs[0], 1)
+	assert.Equal(t, "src.ks.t1", sink.msgs[0][0].Topic)
+	assert.Equal(t, `{"id":1}`, string(sink.msgs[0][0].Key))
+	assert.Empty(t, sink.msgs[1])
+	assert.True(t, proto.Equal(testVGtid("pos2"), sink.vgtid))
+
+	// The stream resumes from the VGtid of the sink.
+	fc.events = nil
+	require.NoError(t, Run(ctx, conn, cfg, &JSONEncoder{}, sink))
+	assert.True(t, proto.Equal(testVGtid("pos2"), fc.vgtid))
+}
@@ -21,12 +21,17 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"strings"
+	"time"
 
 	"golang.org/x/net/context"
+	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl"
 	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/wrangler"
 )
 
@@ -45,7 +50,17 @@ func init() {
 		"RemoveBackup",
 		commandRemoveBackup,
 		"<keyspace/shard> <backup name>",
-		"Removes a backup for the BackupStorage."})
+		"Removes a backup for the BackupStorage. A backup that incremental backups were taken on top of can only be removed after them."})
+	addCommand("Shards", command{
+		"PruneBackups",
+		commandPruneBackups,
+		"[-keep_last=1] [-keep_daily=0] [-keep_weekly=0] [-min_age=0] [-dry_run] <keyspace/shard>",
+		"Removes the backups of a shard that the retention policy doesn't keep, and prints their names. A backup is kept if any rule keeps it: the most recent ones (-keep_last), the most recent one of each of the last days (-keep_daily) or weeks (-keep_weekly), or all the ones younger than -min_age. The most recent complete backup, and the backups incremental backups need, are always kept. The binlog backups whose transactions are all in every kept backup are removed too. With -dry_run, only prints what would be removed."})
+	addCommand("Shards", command{
+		"GetBackupVerification",
+		commandGetBackupVerification,
+		"<keyspace/shard> <backup name>",
+		"Prints the result of the last verification of a backup by vtbackup -verify, as JSON."})
 
 	addCommand("Tablets", command{
 		"Backup",
@@ -55,8 +70,8 @@ func init() {
 	addCommand("Tablets", command{
 		"RestoreFromBackup",
 		commandRestoreFromBackup,
-		"<tablet alias>",
-		"Stops mysqld and restores the data from the latest backup."})
+		"[-restore_to_time=time] [-restore_to_pos=position] <tablet alias>",
+		"Stops mysqld and restores the data from the latest backup. With -restore_to_time (in UTC, in RFC3339 time format, e.g. 2006-01-02T15:04:05+00:00) or -restore_to_pos (e.g. MySQL56/<gtid set>), restores the latest backup before that point, and replays the binlog backups up to it. The tablet then stays DRAINED, with replication stopped."})
 }
 
 func commandBackup(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
@@ -197,6 +212,49 @@ func commandListBackups(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 	return nil
 }
 
+func commandPruneBackups(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	keepLast := subFlags.Int("keep_last", 1, "Keeps this many of the most recent complete backups")
+	keepDaily := subFlags.Int("keep_daily", 0, "Keeps the most recent complete backup of each of this many days, today included (UTC)")
+	keepWeekly := subFlags.Int("keep_weekly", 0, "Keeps the most recent complete backup of each of this many weeks starting on Monday, this week included (UTC)")
+	minAge := subFlags.Duration("min_age", 0, "Keeps all the backups younger than this")
+	dryRun := subFlags.Bool("dry_run", false, "Only prints the backups that would be removed")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("action PruneBackups requires <keyspace/shard>")
+	}
+	if *keepLast < 0 || *keepDaily < 0 || *keepWeekly < 0 || *minAge < 0 {
+		return fmt.Errorf("PruneBackups retention flags can't be negative")
+	}
+
+	keyspace, shard, err := topoproto.ParseKeyspaceShard(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	bucket := fmt.Sprintf("%v/%v", keyspace, shard)
+
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return err
+	}
+	defer bs.Close()
+	policy := mysqlctl.RetentionPolicy{
+		KeepLast:   *keepLast,
+		KeepDaily:  *keepDaily,
+		KeepWeekly: *keepWeekly,
+		MinAge:     *minAge,
+	}
+	pruned, err := mysqlctl.PruneBackups(ctx, bs, bucket, policy, time.Now(), *dryRun, wr.Logger())
+	if err != nil {
+		return err
+	}
+	for _, name := range pruned {
+		wr.Logger().Printf("%v\n", name)
+	}
+	return nil
+}
+
 func commandRemoveBackup(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	if err := subFlags.Parse(args); err != nil {
 		return err
@@ -217,16 +275,70 @@ func commandRemoveBackup(ctx context.Context, wr *wrangler.Wrangler, subFlags *f
 		return err
 	}
 	defer bs.Close()
+
+	// Incremental backups can't be restored without their parents.
+	bhs, err := bs.ListBackups(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	if dependents := mysqlctl.FindDependentBackups(mysqlctl.GetBackupParents(ctx, bhs), name); len(dependents) > 0 {
+		return fmt.Errorf("backup %v is needed to restore the incremental backups %v, remove them first", name, strings.Join(dependents, ", "))
+	}
 	return bs.RemoveBackup(ctx, bucket, name)
 }
 
+func commandGetBackupVerification(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 2 {
+		return fmt.Errorf("action GetBackupVerification requires <keyspace/shard> <backup name>")
+	}
+
+	keyspace, shard, err := topoproto.ParseKeyspaceShard(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	name := subFlags.Arg(1)
+
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return err
+	}
+	defer bs.Close()
+
+	v, err := mysqlctl.GetBackupVerification(ctx, bs, keyspace, shard, name)
+	if err != nil {
+		return err
+	}
+	if v == nil {
+		return fmt.Errorf("backup %v/%v/%v was never verified", keyspace, shard, name)
+	}
+	return printJSON(wr.Logger(), v)
+}
+
 func commandRestoreFromBackup(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	restoreToTimeStr := subFlags.String("restore_to_time", "", "Restores the latest backup that finished at or before this time, and replays the binlog backups up to it, in RFC3339 format")
+	restoreToPos := subFlags.String("restore_to_pos", "", "Replays the binlog backups up to this replication position")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
 	if subFlags.NArg() != 1 {
 		return fmt.Errorf("the RestoreFromBackup command requires the <tablet alias> argument")
 	}
+	var restoreToTime time.Time
+	if *restoreToTimeStr != "" {
+		var err error
+		restoreToTime, err = time.Parse(time.RFC3339, *restoreToTimeStr)
+		if err != nil {
+			return vterrors.Wrapf(err, "invalid -restore_to_time %v", *restoreToTimeStr)
+		}
+	}
+	if *restoreToPos != "" {
+		if _, err := mysql.DecodePosition(*restoreToPos); err != nil {
+			return vterrors.Wrapf(err, "invalid -restore_to_pos %v", *restoreToPos)
+		}
+	}
 
 	tabletAlias, err := topoproto.ParseTabletAlias(subFlags.Arg(0))
 	if err != nil {
@@ -236,7 +348,7 @@ func commandRestoreFromBackup(ctx context.Context, wr *wrangler.Wrangler, subFla
 	if err != nil {
 		return err
 	}
-	stream, err := wr.TabletManagerClient().RestoreFromBackup(ctx, tabletInfo.Tablet)
+	stream, err := wr.TabletManagerClient().RestoreFromBackup(ctx, tabletInfo.Tablet, *restoreToPos, restoreToTime)
 	if err != nil {
 		return err
 	}
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"path"
+	"strings"
 
 	"github.com/golang/protobuf/jsonpb"
 
@@ -29,6 +30,7 @@ import (
 	"golang.org/x/net/context"
 
 	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/helpers"
 	"vitess.io/vitess/go/vt/wrangler"
 
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -53,6 +55,24 @@ func init() {
 		commandTopoCp,
 		"[-cell <cell>] [-to_topo] <src> <dst>",
 		"Copies a file from topo to local file structure, or the other way around"})
+
+	addCommand(topoGroupName, command{
+		"TopoExport",
+		commandTopoExport,
+		"[-cells <cell1>,<cell2>,...] <archive file>",
+		"Exports the files of the global cell and all cells, or only of the given cells, into a JSON archive file. Ephemeral files, like locks, are not exported."})
+
+	addCommand(topoGroupName, command{
+		"TopoDiff",
+		commandTopoDiff,
+		"[-cells <cell1>,<cell2>,...] <archive file> [<archive file>]",
+		"Displays the files that differ between two archive files, or between an archive file and the current topology. Fails if there are any differences."})
+
+	addCommand(topoGroupName, command{
+		"TopoRestore",
+		commandTopoRestore,
+		"[-cell <cell>] [-force] [-dry_run] <archive file> [<path>...]",
+		"Restores the files of a cell from an archive file, or only the files under the given paths. Files that exist with different contents are conflicts: nothing is restored unless -force is set. Files missing from the archive are left alone."})
 }
 
 // DecodeContent uses the filename to imply a type, and proto-decodes
@@ -175,6 +195,146 @@ func copyFileToTopo(ctx context.Context, ts *topo.Server, cell, from, to string)
 	return err
 }
 
+func commandTopoExport(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	cellsStr := subFlags.String("cells", "", "comma-separated list of cells to export, including 'global' for the global cell. Defaults to the global cell and all cells.")
+	subFlags.Parse(args)
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("TopoExport: need the archive file")
+	}
+	var cells []string
+	if *cellsStr != "" {
+		cells = strings.Split(*cellsStr, ",")
+	}
+	a, err := helpers.ExportTopo(ctx, wr.TopoServer(), cells)
+	if err != nil {
+		return err
+	}
+	return helpers.WriteArchive(a, subFlags.Arg(0))
+}
+
+func commandTopoDiff(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	cellsStr := subFlags.String("cells", "", "comma-separated list of cells to compare, including 'global' for the global cell. Defaults to all the cells of the archives.")
+	subFlags.Parse(args)
+	if subFlags.NArg() != 1 && subFlags.NArg() != 2 {
+		return fmt.Errorf("TopoDiff: need one or two archive files")
+	}
+	left, err := helpers.ReadArchive(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	var cells []string
+	if *cellsStr != "" {
+		cells = strings.Split(*cellsStr, ",")
+	}
+
+	var right *helpers.Archive
+	if subFlags.NArg() == 2 {
+		right, err = helpers.ReadArchive(subFlags.Arg(1))
+	} else {
+		// Compare with the cells of the archive that still exist.
+		liveCells := cells
+		if len(liveCells) == 0 {
+			var known []string
+			known, err = wr.TopoServer().GetKnownCells(ctx)
+			if err != nil {
+				return err
+			}
+			liveCells = []string{topo.GlobalCell}
+			for _, cell := range known {
+				if _, ok := left.Cells[cell]; ok {
+					liveCells = append(liveCells, cell)
+				}
+			}
+		}
+		right, err = helpers.ExportTopo(ctx, wr.TopoServer(), liveCells)
+	}
+	if err != nil {
+		return err
+	}
+	if len(cells) > 0 {
+		filterArchiveCells(left, cells)
+		filterArchiveCells(right, cells)
+	}
+
+	diffs, err := helpers.DiffArchives(left, right)
+	if err != nil {
+		return err
+	}
+	for _, fd := range diffs {
+		switch {
+		case fd.Right == nil:
+			wr.Logger().Printf("- %v:%v\n", fd.Cell, fd.Path)
+		case fd.Left == nil:
+			wr.Logger().Printf("+ %v:%v\n", fd.Cell, fd.Path)
+		default:
+			wr.Logger().Printf("~ %v:%v\n", fd.Cell, fd.Path)
+			printTopoDiffContents(wr, "-", fd.Path, fd.Left)
+			printTopoDiffContents(wr, "+", fd.Path, fd.Right)
+		}
+	}
+	if len(diffs) > 0 {
+		return fmt.Errorf("TopoDiff: %v file(s) differ", len(diffs))
+	}
+	return nil
+}
+
+// filterArchiveCells removes the cells not in the list from an archive.
+func filterArchiveCells(a *helpers.Archive, cells []string) {
+	keep := make(map[string]bool)
+	for _, cell := range cells {
+		keep[cell] = true
+	}
+	for cell := range a.Cells {
+		if !keep[cell] {
+			delete(a.Cells, cell)
+		}
+	}
+}
+
+// printTopoDiffContents displays the decoded contents of a file, with
+// each line prefixed.
+func printTopoDiffContents(wr *wrangler.Wrangler, prefix, filePath string, data []byte) {
+	decoded, err := DecodeContent(filePath, data, false)
+	if err != nil {
+		decoded = string(data)
+	}
+	for _, line := range strings.Split(strings.TrimRight(decoded, "\n"), "\n") {
+		wr.Logger().Printf("  %v %v\n", prefix, line)
+	}
+}
+
+func commandTopoRestore(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	cell := subFlags.String("cell", topo.GlobalCell, "topology cell to restore. Defaults to global cell.")
+	force := subFlags.Bool("force", false, "overwrite the files that have different contents in the topology.")
+	dryRun := subFlags.Bool("dry_run", false, "only display what would be restored.")
+	subFlags.Parse(args)
+	if subFlags.NArg() == 0 {
+		return fmt.Errorf("TopoRestore: need the archive file")
+	}
+	a, err := helpers.ReadArchive(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	result, err := helpers.RestoreArchive(ctx, wr.TopoServer(), a, helpers.RestoreOptions{
+		Cell:   *cell,
+		Paths:  subFlags.Args()[1:],
+		Force:  *force,
+		DryRun: *dryRun,
+	})
+	if result != nil {
+		for _, p := range result.Created {
+			wr.Logger().Printf("created %v\n", p)
+		}
+		for _, p := range result.Overwritten {
+			wr.Logger().Printf("overwritten %v\n", p)
+		}
+		for _, p := range result.Conflicts {
+			wr.Logger().Printf("conflict %v\n", p)
+		}
+	}
+	return err
+}
+
 type TopologyDecoder interface {
 	decode([]string, topo.Conn, context.Context, *wrangler.Wrangler, bool) error
 }
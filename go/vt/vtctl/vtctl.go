@@ -108,12 +108,14 @@ import (
 	hk "vitess.io/vitess/go/vt/hook"
 	"vitess.io/vitess/go/vt/key"
 	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/schema"
 	"vitess.io/vitess/go/vt/schemamanager"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/topotools"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/vtgateconn"
 	"vitess.io/vitess/go/vt/wrangler"
 
 	replicationdatapb "vitess.io/vitess/go/vt/proto/replicationdata"
@@ -315,7 +317,7 @@ var commands = []commandGroup{
 				"[-cells=<cells>] [-tablet_types=<source_tablet_types>] [-skip_schema_copy] <keyspace.workflow> <source_shards> <target_shards>",
 				"Start a Resharding process. Example: Reshard -cells='zone1,alias1' -tablet_types='master,replica,rdonly'  ks.workflow001 '0' '-80,80-'"},
 			{"MoveTables", commandMoveTables,
-				"[-cells=<cells>] [-tablet_types=<source_tablet_types>] -workflow=<workflow> <source_keyspace> <target_keyspace> <table_specs>",
+				"[-cells=<cells>] [-tablet_types=<source_tablet_types>] [-on_ddl=IGNORE|STOP|EXEC|EXEC_IGNORE|EXEC_COLUMNS] -workflow=<workflow> <source_keyspace> <target_keyspace> <table_specs>",
 				`Move table(s) to another keyspace, table_specs is a list of tables or the tables section of the vschema for the target keyspace. Example: '{"t1":{"column_vindexes": [{"column": "id1", "name": "hash"}]}, "t2":{"column_vindexes": [{"column": "id2", "name": "hash"}]}}'.  In the case of an unsharded target keyspace the vschema for each table may be empty. Example: '{"t1":{}, "t2":{}}'.`},
 			{"DropSources", commandDropSources,
 				"[-dry_run] [-rename_tables] <keyspace.workflow>",
@@ -328,7 +330,7 @@ var commands = []commandGroup{
 				`Externalize a backfilled vindex.`},
 			{"Materialize", commandMaterialize,
 				`<json_spec>, example : '{"workflow": "aaa", "source_keyspace": "source", "target_keyspace": "target", "table_settings": [{"target_table": "customer", "source_expression": "select * from customer", "create_ddl": "copy"}]}'`,
-				"Performs materialization based on the json spec. Is used directly to form VReplication rules, with an optional step to copy table structure/DDL."},
+				"Performs materialization based on the json spec. Is used directly to form VReplication rules, with an optional step to copy table structure/DDL. The optional on_ddl setting (IGNORE, STOP, EXEC, EXEC_IGNORE or EXEC_COLUMNS) controls what the streams do when they encounter a DDL. A source_expression can be an inner equi-join of two source tables, which are copied into _vt_join_<target_table>__<source_table> helper tables on the target."},
 			{"SplitClone", commandSplitClone,
 				"<keyspace> <from_shards> <to_shards>",
 				"Start the SplitClone process to perform horizontal resharding. Example: SplitClone ks '0' '-80,80-'"},
@@ -336,8 +338,8 @@ var commands = []commandGroup{
 				"<from_keyspace> <to_keyspace> <tables>",
 				"Start the VerticalSplitClone process to perform vertical resharding. Example: SplitClone from_ks to_ks 'a,/b.*/'"},
 			{"VDiff", commandVDiff,
-				"[-source_cell=<cell>] [-target_cell=<cell>] [-tablet_types=replica] [-filtered_replication_wait_time=30s] <keyspace.workflow>",
-				"Perform a diff of all tables in the workflow"},
+				"[-source_cell=<cell>] [-target_cell=<cell>] [-tablet_types=replica] [-filtered_replication_wait_time=30s] [-concurrency=1] [-chunks=1] [-resume] [-repair=record|dry_run|apply] [-repair_rate=0] [-repair_file=<path>] <keyspace.workflow> | show <keyspace.workflow>",
+				"Perform a diff of all tables in the workflow. The progress is recorded on the target: an interrupted diff can be continued with -resume, and 'VDiff show' reports the progress of the last diff. With -repair, the primary keys of the differing rows are recorded in _vt.vdiff_diff on the target, and the statements that repair the target are logged (dry_run) or applied (apply). With -repair_file, the statements are also written to that file."},
 			{"MigrateServedTypes", commandMigrateServedTypes,
 				"[-cells=c1,c2,...] [-reverse] [-skip-refresh-state] [-filtered_replication_wait_time=30s] [-reverse_replication=false] <keyspace/shard> <served tablet type>",
 				"Migrates a serving type from the source shard to the shards that it replicates to. This command also rebuilds the serving graph. The <keyspace/shard> argument can specify any of the shards involved in the migration."},
@@ -364,6 +366,12 @@ var commands = []commandGroup{
 				"Blocks until no new queries were observed on all tablets with the given tablet type in the specified keyspace. " +
 					" This can be used as sanity check to ensure that the tablets were drained after running vtctl MigrateServedTypes " +
 					" and vtgate is no longer using them. If -timeout is set, it fails when the timeout is reached."},
+			{"ListDistributedTransactions", commandListDistributedTransactions,
+				"[-json] <keyspace>",
+				"Lists the unresolved distributed (2PC) transactions that involve the keyspace, with their state, participants, and the shards where they are still prepared. The metadata is read from the masters of all shards."},
+			{"ConcludeDistributedTransaction", commandConcludeDistributedTransaction,
+				"-server <vtgate> commit|rollback <dtid>",
+				"Resolves a stuck distributed (2PC) transaction through the ResolveTransaction API of the vtgate server, which commits or rolls back all its participants, and deletes its metadata. Only the outcome already decided by the metadata manager is allowed: a transaction in the COMMIT state can only be committed, while one in the PREPARE or ROLLBACK state can only be rolled back."},
 		},
 	},
 	{
@@ -403,8 +411,11 @@ var commands = []commandGroup{
 				"[-exclude_tables=''] [-include-views] [-skip-no-master] <keyspace name>",
 				"Validates that the master schema from shard 0 matches the schema on all of the other tablets in the keyspace."},
 			{"ApplySchema", commandApplySchema,
-				"[-allow_long_unavailability] [-wait_replicas_timeout=10s] {-sql=<sql> || -sql-file=<filename>} <keyspace>",
-				"Applies the schema change to the specified keyspace on every master, running in parallel on all shards. The changes are then propagated to replicas via replication. If -allow_long_unavailability is set, schema changes affecting a large number of rows (and possibly incurring a longer period of unavailability) will not be rejected."},
+				"[-allow_long_unavailability] [-wait_replicas_timeout=10s] [-ddl_strategy=<strategy>] {-sql=<sql> || -sql-file=<filename>} <keyspace>",
+				"Applies the schema change to the specified keyspace on every master, running in parallel on all shards. The changes are then propagated to replicas via replication. If -allow_long_unavailability is set, schema changes affecting a large number of rows (and possibly incurring a longer period of unavailability) will not be rejected. With -ddl_strategy=online, ALTER TABLE statements are submitted as online migrations that the masters run in the background; add -postpone-cutover to the strategy to wait for an explicit cut-over."},
+			{"OnlineDDL", commandOnlineDDL,
+				"<keyspace> show|cancel|retry|cutover <uuid|all>",
+				"Operates on online schema migrations on all masters of the keyspace. show lists migrations, cancel stops pending migrations, retry requeues failed or cancelled migrations, and cutover completes migrations that were submitted with -postpone-cutover. Through vtgate, the same commands are issued with ALTER VITESS_MIGRATION '<uuid>' CANCEL|RETRY|CUTOVER."},
 			{"CopySchemaShard", commandCopySchemaShard,
 				"[-tables=<table1>,<table2>,...] [-exclude_tables=<table1>,<table2>,...] [-include-views] [-skip-verify] [-wait_replicas_timeout=10s] {<source keyspace/shard> || <source tablet alias>} <destination keyspace/shard>",
 				"Copies the schema from a source shard's master (or a specific tablet) to a destination shard. The schema is applied directly on the master of the destination shard, and it is propagated to the replicas through binlogs."},
@@ -477,8 +488,10 @@ var commands = []commandGroup{
 	{
 		"Workflow", []command{
 			{"Workflow", commandWorkflow,
-				"<ks.workflow> <action> --dry-run",
-				"Start/Stop/Delete/Show/ListAll Workflow on all target tablets in workflow. Example: Workflow merchant.morders Start",
+				"[-dry_run] [-keep_data] [-rename_tables] [-filtered_replication_wait_time=30s] <ks.workflow> <action>",
+				"Start/Stop/Delete/Show/ListAll Workflow on all target tablets in workflow. Example: Workflow merchant.morders Start\n" +
+					"Cancel aborts a MoveTables/Reshard workflow whose writes have not been switched, and Reverse-Rollback rolls back one whose writes have been switched.\n" +
+					"Both switch the traffic back to the sources and delete the streams. Unless -keep_data is set, the target tables or shards are removed.",
 			},
 		},
 	},
@@ -1894,6 +1907,7 @@ func commandMoveTables(ctx context.Context, wr *wrangler.Wrangler, subFlags *fla
 	workflow := subFlags.String("workflow", "", "Workflow name. Can be any descriptive string. Will be used to later migrate traffic via SwitchReads/SwitchWrites.")
 	cells := subFlags.String("cells", "", "Cell(s) or CellAlias(es) (comma-separated) to replicate from.")
 	tabletTypes := subFlags.String("tablet_types", "", "Source tablet types to replicate from (e.g. master, replica, rdonly). Defaults to -vreplication_tablet_type parameter value for the tablet, which has the default value of replica.")
+	onDDL := subFlags.String("on_ddl", "IGNORE", "What to do when a DDL is encountered on the source: IGNORE, STOP, EXEC, EXEC_IGNORE or EXEC_COLUMNS. EXEC_COLUMNS ignores DDLs, except the column additions and removals of tables copied as is, which are applied to the target.")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
@@ -1906,7 +1920,7 @@ func commandMoveTables(ctx context.Context, wr *wrangler.Wrangler, subFlags *fla
 	source := subFlags.Arg(0)
 	target := subFlags.Arg(1)
 	tableSpecs := subFlags.Arg(2)
-	return wr.MoveTables(ctx, *workflow, source, target, tableSpecs, *cells, *tabletTypes)
+	return wr.MoveTables(ctx, *workflow, source, target, tableSpecs, *cells, *tabletTypes, strings.ToUpper(*onDDL))
 }
 
 func commandCreateLookupVindex(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
@@ -1982,10 +1996,40 @@ func commandVDiff(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.Fla
 	tabletTypes := subFlags.String("tablet_types", "master,replica,rdonly", "Tablet types for source and target")
 	filteredReplicationWaitTime := subFlags.Duration("filtered_replication_wait_time", 30*time.Second, "Specifies the maximum time to wait, in seconds, for filtered replication to catch up on master migrations. The migration will be aborted on timeout.")
 	format := subFlags.String("format", "", "Format of report") //"json" or ""
+	concurrency := subFlags.Int("concurrency", 1, "Number of tables or primary key ranges to diff at the same time")
+	chunks := subFlags.Int("chunks", 1, "Number of primary key ranges of each table, for tables that have a single integer primary key column")
+	resume := subFlags.Bool("resume", false, "Continue the previous diff of the workflow, instead of starting over")
+	repair := subFlags.String("repair", "", "Record the differing rows (record), and generate (dry_run) or apply (apply) the statements that repair the target")
+	repairRate := subFlags.Int("repair_rate", 0, "Maximum number of repair statements applied per second, 0 for no limit")
+	repairFile := subFlags.String("repair_file", "", "File the repair statements are written to, with -repair=dry_run or apply")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
 
+	if subFlags.NArg() == 2 && subFlags.Arg(0) == "show" {
+		keyspace, workflow, err := splitKeyspaceWorkflow(subFlags.Arg(1))
+		if err != nil {
+			return err
+		}
+		progress, err := wr.VDiffShow(ctx, keyspace, workflow)
+		if err != nil {
+			return err
+		}
+		if *format == "json" {
+			return printJSON(wr.Logger(), progress)
+		}
+		for _, p := range progress {
+			eta := "unknown"
+			switch {
+			case p.State == "Completed":
+				eta = "done"
+			case p.ETA != 0:
+				eta = p.ETA.Round(time.Second).String()
+			}
+			wr.Logger().Printf("%v: %v, %d/%d chunks, %d/~%d rows, ETA %v: %+v\n", p.Table, p.State, p.CompletedChunks, p.Chunks, p.ProcessedRows, p.RowsEstimate, eta, p.DiffReport)
+		}
+		return nil
+	}
 	if subFlags.NArg() != 1 {
 		return fmt.Errorf("<keyspace.workflow> is required")
 	}
@@ -1994,7 +2038,7 @@ func commandVDiff(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.Fla
 		return err
 	}
 
-	_, err = wr.VDiff(ctx, keyspace, workflow, *sourceCell, *targetCell, *tabletTypes, *filteredReplicationWaitTime, *format)
+	_, err = wr.VDiff(ctx, keyspace, workflow, *sourceCell, *targetCell, *tabletTypes, *filteredReplicationWaitTime, *format, *concurrency, *chunks, *resume, *repair, *repairRate, *repairFile)
 	return err
 }
 
@@ -2214,6 +2258,78 @@ func commandFindAllShardsInKeyspace(ctx context.Context, wr *wrangler.Wrangler,
 	return printJSON(wr.Logger(), result)
 }
 
+func commandListDistributedTransactions(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	json := subFlags.Bool("json", false, "Output JSON instead of human-readable table")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace> argument is required for the ListDistributedTransactions command")
+	}
+	transactions, err := wr.ListDistributedTransactions(ctx, subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	if *json {
+		return printJSON(wr.Logger(), transactions)
+	}
+	qr := &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Name: "dtid", Type: sqltypes.VarChar},
+			{Name: "shard", Type: sqltypes.VarChar},
+			{Name: "state", Type: sqltypes.VarChar},
+			{Name: "created", Type: sqltypes.VarChar},
+			{Name: "participants", Type: sqltypes.VarChar},
+			{Name: "prepared", Type: sqltypes.VarChar},
+			{Name: "failed", Type: sqltypes.VarChar},
+		},
+	}
+	for _, dt := range transactions {
+		var participants []string
+		for _, p := range dt.Participants {
+			participants = append(participants, p.Keyspace+"/"+p.Shard)
+		}
+		qr.Rows = append(qr.Rows, []sqltypes.Value{
+			sqltypes.NewVarChar(dt.Dtid),
+			sqltypes.NewVarChar(dt.Shard),
+			sqltypes.NewVarChar(dt.State),
+			sqltypes.NewVarChar(dt.Created.Format(time.RFC3339)),
+			sqltypes.NewVarChar(strings.Join(participants, ",")),
+			sqltypes.NewVarChar(strings.Join(dt.Prepared, ",")),
+			sqltypes.NewVarChar(strings.Join(dt.Failed, ",")),
+		})
+	}
+	printQueryResult(loggerWriter{wr.Logger()}, qr)
+	return nil
+}
+
+func commandConcludeDistributedTransaction(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	server := subFlags.String("server", "", "VtGate server to connect to")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 2 {
+		return fmt.Errorf("the <commit|rollback> and <dtid> arguments are required for the ConcludeDistributedTransaction command")
+	}
+	if *server == "" {
+		return fmt.Errorf("the -server flag is required for the ConcludeDistributedTransaction command")
+	}
+	var commit bool
+	switch subFlags.Arg(0) {
+	case "commit":
+		commit = true
+	case "rollback":
+	default:
+		return fmt.Errorf("unknown action %v, expected commit or rollback", subFlags.Arg(0))
+	}
+	vtgateConn, err := vtgateconn.Dial(ctx, *server)
+	if err != nil {
+		return fmt.Errorf("error connecting to vtgate '%v': %v", *server, err)
+	}
+	defer vtgateConn.Close()
+	return wr.ConcludeDistributedTransaction(ctx, vtgateConn, subFlags.Arg(1), commit)
+}
+
 func commandValidate(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	pingTablets := subFlags.Bool("ping-tablets", false, "Indicates whether all tablets should be pinged during the validation process")
 	if err := subFlags.Parse(args); err != nil {
@@ -2399,6 +2515,7 @@ func commandApplySchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 	// for backwards compatibility
 	deprecatedTimeout := subFlags.Duration("wait_slave_timeout", wrangler.DefaultWaitReplicasTimeout, "DEPRECATED -- use -wait_replicas_timeout")
 	waitReplicasTimeout := subFlags.Duration("wait_replicas_timeout", wrangler.DefaultWaitReplicasTimeout, "The amount of time to wait for replicas to receive the schema change via replication.")
+	ddlStrategy := subFlags.String("ddl_strategy", string(schema.DDLStrategyDirect), "How ALTER TABLE statements are applied: 'direct' or 'online', optionally followed by '-postpone-cutover'")
 	if *deprecatedTimeout != wrangler.DefaultWaitReplicasTimeout {
 		*waitReplicasTimeout = *deprecatedTimeout
 	}
@@ -2419,6 +2536,9 @@ func commandApplySchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 	if *allowLongUnavailability {
 		executor.AllowBigSchemaChange()
 	}
+	if err := executor.SetDDLStrategy(*ddlStrategy); err != nil {
+		return err
+	}
 	return schemamanager.Run(
 		ctx,
 		schemamanager.NewPlainController(change, keyspace),
@@ -2426,6 +2546,37 @@ func commandApplySchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 	)
 }
 
+func commandOnlineDDL(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	json := subFlags.Bool("json", false, "Output JSON instead of human-readable table")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 3 {
+		return fmt.Errorf("the <keyspace>, <command> and <uuid|all> arguments are required for the OnlineDDL command")
+	}
+	keyspace, command, uuid := subFlags.Arg(0), subFlags.Arg(1), subFlags.Arg(2)
+	results, err := wr.OnlineDDL(ctx, keyspace, command, uuid)
+	if err != nil {
+		return err
+	}
+	if command != "show" {
+		for tablet, qr := range results {
+			wr.Logger().Printf("%s/%s: %d migration(s) affected\n", tablet.Keyspace, tablet.Shard, qr.RowsAffected)
+		}
+		return nil
+	}
+	qr := &sqltypes.Result{}
+	for _, result := range results {
+		qr.Fields = result.Fields
+		qr.Rows = append(qr.Rows, result.Rows...)
+	}
+	if *json {
+		return printJSON(wr.Logger(), qr)
+	}
+	printQueryResult(loggerWriter{wr.Logger()}, qr)
+	return nil
+}
+
 func commandCopySchemaShard(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	tables := subFlags.String("tables", "", "Specifies a comma-separated list of tables to copy. Each is either an exact match, or a regular expression of the form /regexp/")
 	excludeTables := subFlags.String("exclude_tables", "", "Specifies a comma-separated list of tables to exclude. Each is either an exact match, or a regular expression of the form /regexp/")
@@ -2942,11 +3093,14 @@ func queryResultForTabletResults(results map[*topo.TabletInfo]*sqltypes.Result)
 
 func commandWorkflow(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	dryRun := subFlags.Bool("dry_run", false, "Does a dry run of Workflow and only reports the final query and list of masters on which the operation will be applied")
+	keepData := subFlags.Bool("keep_data", false, "For cancel and reverse-rollback: keep the target tables or shards")
+	renameTables := subFlags.Bool("rename_tables", false, "For cancel and reverse-rollback: rename the target tables instead of dropping them")
+	filteredReplicationWaitTime := subFlags.Duration("filtered_replication_wait_time", 30*time.Second, "For reverse-rollback: the maximum time to wait for the reverse replication to catch up. The rollback is aborted on timeout.")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
 	if subFlags.NArg() != 2 {
-		return fmt.Errorf("usage: Workflow --dry-run keyspace.workflow start/stop/delete/list/list-all")
+		return fmt.Errorf("usage: Workflow --dry-run keyspace.workflow start/stop/delete/list/list-all/cancel/reverse-rollback")
 	}
 	keyspace := subFlags.Arg(0)
 	action := strings.ToLower(subFlags.Arg(1))
@@ -2967,6 +3121,27 @@ func commandWorkflow(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.
 		wr.Logger().Errorf("Keyspace %s not found", keyspace)
 	}
 
+	if action == "cancel" || action == "reverse-rollback" {
+		removalType := wrangler.DropTable
+		if *renameTables {
+			removalType = wrangler.RenameTable
+		}
+		var dryRunResults *[]string
+		if action == "cancel" {
+			dryRunResults, err = wr.CancelWorkflow(ctx, keyspace, workflow, removalType, *keepData, *dryRun)
+		} else {
+			dryRunResults, err = wr.RollbackWorkflow(ctx, keyspace, workflow, *filteredReplicationWaitTime, removalType, *keepData, *dryRun)
+		}
+		if err != nil {
+			return err
+		}
+		if *dryRun {
+			wr.Logger().Printf("Dry Run results for Workflow %s run at %s\nParameters: %s\n\n", action, time.RFC822, strings.Join(args, " "))
+			wr.Logger().Printf("%s\n", strings.Join(*dryRunResults, "\n"))
+		}
+		return nil
+	}
+
 	results, err := wr.WorkflowAction(ctx, workflow, keyspace, action, *dryRun)
 	if err != nil {
 		return err
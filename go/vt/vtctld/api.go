@@ -36,6 +36,7 @@ import (
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vtctl"
+	"vitess.io/vitess/go/vt/vtgate/vtgateconn"
 	"vitess.io/vitess/go/vt/vttablet/tmclient"
 	"vitess.io/vitess/go/vt/workflow"
 	"vitess.io/vitess/go/vt/wrangler"
@@ -51,6 +52,7 @@ var (
 	localCell        = flag.String("cell", "", "cell to use")
 	showTopologyCRUD = flag.Bool("vtctld_show_topology_crud", true, "Controls the display of the CRUD topology actions in the vtctld UI.")
 	proxyTablets     = flag.Bool("proxy_tablets", false, "Setting this true will make vtctld proxy the tablet status instead of redirecting to them")
+	vtgateAddress    = flag.String("vtctld_vtgate_address", "", "Address of the vtgate through which the vtctld UI resolves distributed transactions. If empty, transactions can only be listed.")
 )
 
 // This file implements a REST-style API for the vtctld web interface.
@@ -298,6 +300,47 @@ func initAPI(ctx context.Context, ts *topo.Server, actions *ActionRepository, re
 		return tablets, nil
 	})
 
+	// Distributed transactions
+	handleCollection("transactions", func(r *http.Request) (interface{}, error) {
+		// Valid requests: GET api/transactions/my_ks (list the unresolved transactions)
+		// Valid requests: POST api/transactions/<dtid>?action=commit|rollback
+		item := getItemPath(r.URL.Path)
+		if item == "" {
+			return nil, errors.New("a keyspace or a dtid is required in the URL")
+		}
+		logger := logutil.NewMemoryLogger()
+		wr := wrangler.New(logger, ts, tmClient)
+		switch r.Method {
+		case "GET":
+			return wr.ListDistributedTransactions(ctx, item)
+		case "POST":
+			if err := acl.CheckAccessHTTP(r, acl.ADMIN); err != nil {
+				return nil, err
+			}
+			if err := r.ParseForm(); err != nil {
+				return nil, err
+			}
+			action := r.FormValue("action")
+			if action != "commit" && action != "rollback" {
+				return nil, fmt.Errorf("a POST request must specify action=commit or action=rollback, got: %q", action)
+			}
+			if *vtgateAddress == "" {
+				return nil, errors.New("resolving distributed transactions requires the -vtctld_vtgate_address flag")
+			}
+			vtgateConn, err := vtgateconn.Dial(ctx, *vtgateAddress)
+			if err != nil {
+				return nil, fmt.Errorf("error connecting to vtgate '%v': %v", *vtgateAddress, err)
+			}
+			defer vtgateConn.Close()
+			if err := wr.ConcludeDistributedTransaction(ctx, vtgateConn, item, action == "commit"); err != nil {
+				return nil, err
+			}
+			return map[string]string{"Dtid": item, "Action": action, "Output": logger.String()}, nil
+		default:
+			return nil, fmt.Errorf("unsupported HTTP method: %v", r.Method)
+		}
+	})
+
 	// Shards
 	handleCollection("shards", func(r *http.Request) (interface{}, error) {
 		shardPath := getItemPath(r.URL.Path)
@@ -573,6 +616,11 @@ func initAPI(ctx context.Context, ts *topo.Server, actions *ActionRepository, re
 		return nil, fmt.Errorf("invalid target path: %q  expected path: ?keyspace=<keyspace>&cell=<cell>", targetPath)
 	})
 
+	// Topology mutations audited by the processes with the topo audit sink
+	handleCollection("topo_audit", func(r *http.Request) (interface{}, error) {
+		return ts.RecentAuditEvents(ctx)
+	})
+
 	// Vtctl Command
 	handleAPI("vtctl/", func(w http.ResponseWriter, r *http.Request) error {
 		if err := acl.CheckAccessHTTP(r, acl.ADMIN); err != nil {
@@ -646,6 +694,7 @@ func initAPI(ctx context.Context, ts *topo.Server, actions *ActionRepository, re
 		resp["showStatus"] = *enableRealtimeStats
 		resp["showTopologyCRUD"] = *showTopologyCRUD
 		resp["showWorkflows"] = *workflowManagerInit
+		resp["resolveTransactions"] = *vtgateAddress != ""
 		resp["workflows"] = workflow.AvailableFactories()
 		data, err := json.MarshalIndent(resp, "", "  ")
 		if err != nil {
@@ -0,0 +1,525 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// This file implements the comparisons and the scalar functions of the
+// expressions that are evaluated in the process against the rows of a
+// table: the filters of the vstreamer and the eval transforms of
+// vreplication.
+//
+// Collations are not supported. MySQL compares non-binary strings with
+// the collation of their column, which is usually case insensitive and
+// ignores trailing spaces, so a comparison between two such strings is
+// rejected by CheckComparable when the expression is built, instead of
+// being done byte by byte. Binary strings, numbers and temporal values
+// can be compared.
+
+// IsCollated returns true if MySQL compares values of type typ
+// using a collation.
+func IsCollated(typ querypb.Type) bool {
+	return sqltypes.IsText(typ) || typ == sqltypes.Enum || typ == sqltypes.Set
+}
+
+// CheckComparable returns an error if values of types t1 and t2 can't be
+// compared without a collation: when neither of them is a number, and
+// both are non-binary strings.
+func CheckComparable(t1, t2 querypb.Type) error {
+	if sqltypes.IsNumber(t1) || sqltypes.IsNumber(t2) {
+		return nil
+	}
+	if IsCollated(t1) && IsCollated(t2) {
+		return fmt.Errorf("cannot compare %v with %v: collations are not supported, one of the values must be binary", t1, t2)
+	}
+	return nil
+}
+
+// Compare compares two values that are not NULL. If one of them is a
+// number, the other one is converted to a number like in MySQL and the
+// comparison is numeric. Otherwise, the bytes are compared, if the types
+// are accepted by CheckComparable.
+func Compare(v1, v2 sqltypes.Value) (int, error) {
+	if sqltypes.IsNumber(v1.Type()) || sqltypes.IsNumber(v2.Type()) {
+		return NullsafeCompare(ToNumber(v1), ToNumber(v2))
+	}
+	if err := CheckComparable(v1.Type(), v2.Type()); err != nil {
+		return 0, err
+	}
+	return bytes.Compare(v1.Raw(), v2.Raw()), nil
+}
+
+var numericPrefixRegexp = regexp.MustCompile(`^\s*[-+]?[0-9]*\.?[0-9]*([eE][-+]?[0-9]+)?`)
+
+// ToNumber converts v to a number like MySQL does: numbers and NULL are
+// returned unchanged, and the numeric prefix of other values is parsed
+// as a float. Values without a numeric prefix are 0.
+func ToNumber(v sqltypes.Value) sqltypes.Value {
+	if v.IsNull() || sqltypes.IsNumber(v.Type()) {
+		return v
+	}
+	f, _ := strconv.ParseFloat(strings.TrimSpace(numericPrefixRegexp.FindString(v.ToString())), 64)
+	return sqltypes.NewFloat64(f)
+}
+
+// IsTrue returns true if v is a non-zero number, or a value whose
+// numeric prefix is not zero. NULL is not true.
+func IsTrue(v sqltypes.Value) bool {
+	if v.IsNull() {
+		return false
+	}
+	f, err := ToFloat64(ToNumber(v))
+	return err == nil && f != 0
+}
+
+// Mod returns the remainder of v1 divided by v2, or NULL if one of them
+// is NULL or v2 is 0. The result is an INT64 if both values are integers,
+// and a FLOAT64 otherwise.
+func Mod(v1, v2 sqltypes.Value) (sqltypes.Value, error) {
+	if v1.IsNull() || v2.IsNull() {
+		return sqltypes.NULL, nil
+	}
+	v1, v2 = ToNumber(v1), ToNumber(v2)
+	if v1.IsIntegral() && v2.IsIntegral() {
+		i1, err := ToInt64(v1)
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		i2, err := ToInt64(v2)
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		if i2 == 0 {
+			return sqltypes.NULL, nil
+		}
+		return sqltypes.NewInt64(i1 % i2), nil
+	}
+	f1, err := ToFloat64(v1)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	f2, err := ToFloat64(v2)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	if f2 == 0 {
+		return sqltypes.NULL, nil
+	}
+	return sqltypes.NewFloat64(math.Mod(f1, f2)), nil
+}
+
+// CompileLike converts a LIKE pattern to an anchored regular expression.
+// Strings are matched byte by byte, so the value must not be collated.
+func CompileLike(pattern string) (*regexp.Regexp, error) {
+	var buf strings.Builder
+	buf.WriteString("(?s)^")
+	escaped := false
+	for _, r := range pattern {
+		switch {
+		case escaped:
+			buf.WriteString(regexp.QuoteMeta(string(r)))
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '%':
+			buf.WriteString(".*")
+		case r == '_':
+			buf.WriteString(".")
+		default:
+			buf.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	buf.WriteString("$")
+	return regexp.Compile(buf.String())
+}
+
+// Func is a scalar function that can be evaluated in the process.
+type Func struct {
+	// MinArgs and MaxArgs are the allowed number of arguments.
+	// MaxArgs is -1 if there is no upper limit.
+	MinArgs, MaxArgs int
+	// Type returns the type of the result for arguments of argTypes.
+	Type func(argTypes []querypb.Type) querypb.Type
+	// Check, if set, returns an error if the function can't be
+	// evaluated for arguments of argTypes.
+	Check func(argTypes []querypb.Type) error
+	// Call evaluates the function. argTypes are the types of the
+	// arguments as passed to Type: the values can be NULL.
+	Call func(argTypes []querypb.Type, args []sqltypes.Value) (sqltypes.Value, error)
+}
+
+// Funcs are the scalar functions that can be evaluated in the process.
+// Unless specified otherwise, they return NULL if an argument is NULL.
+// Strings are made of bytes if they're binary, and of UTF-8 encoded
+// characters otherwise.
+var Funcs = map[string]Func{
+	// lower and upper don't change binary strings, like in MySQL.
+	"lower": {1, 1, stringType, nil, caseFunc(strings.ToLower)},
+	"lcase": {1, 1, stringType, nil, caseFunc(strings.ToLower)},
+	"upper": {1, 1, stringType, nil, caseFunc(strings.ToUpper)},
+	"ucase": {1, 1, stringType, nil, caseFunc(strings.ToUpper)},
+	// The trim functions remove spaces only.
+	"trim":  {1, 1, stringType, nil, stringFunc(func(s string) string { return strings.Trim(s, " ") })},
+	"ltrim": {1, 1, stringType, nil, stringFunc(func(s string) string { return strings.TrimLeft(s, " ") })},
+	"rtrim": {1, 1, stringType, nil, stringFunc(func(s string) string { return strings.TrimRight(s, " ") })},
+	"length": {1, 1, int64Type, nil, func(_ []querypb.Type, args []sqltypes.Value) (sqltypes.Value, error) {
+		if args[0].IsNull() {
+			return sqltypes.NULL, nil
+		}
+		return sqltypes.NewInt64(int64(len(args[0].Raw()))), nil
+	}},
+	"char_length": {1, 1, int64Type, nil, func(argTypes []querypb.Type, args []sqltypes.Value) (sqltypes.Value, error) {
+		if args[0].IsNull() {
+			return sqltypes.NULL, nil
+		}
+		return sqltypes.NewInt64(int64(len(chars(argTypes[0], args[0])))), nil
+	}},
+	"left":            {2, 2, firstStringType, nil, leftRight(true)},
+	"right":           {2, 2, firstStringType, nil, leftRight(false)},
+	"substr":          {2, 3, firstStringType, nil, substr},
+	"substring":       {2, 3, firstStringType, nil, substr},
+	"substring_index": {3, 3, firstStringType, nil, substringIndex},
+	"replace":         {3, 3, stringType, nil, replace},
+	"concat":          {1, -1, stringType, nil, concat},
+	"concat_ws":       {2, -1, stringType, nil, concatWS},
+	// ifnull and coalesce return the first argument that is not NULL.
+	"ifnull":   {2, 2, firstArgType, nil, coalesce},
+	"coalesce": {1, -1, firstArgType, nil, coalesce},
+	// if returns its second argument if the first one is true,
+	// and its third argument otherwise.
+	"if": {3, 3, func(argTypes []querypb.Type) querypb.Type { return firstArgType(argTypes[1:]) }, nil, ifFunc},
+	// nullif returns NULL if its arguments are equal, and its
+	// first argument otherwise.
+	"nullif": {2, 2, func(argTypes []querypb.Type) querypb.Type { return argTypes[0] }, func(argTypes []querypb.Type) error {
+		return CheckComparable(argTypes[0], argTypes[1])
+	}, nullif},
+	"mod": {2, 2, modType, nil, func(_ []querypb.Type, args []sqltypes.Value) (sqltypes.Value, error) {
+		return Mod(args[0], args[1])
+	}},
+	// The date functions accept dates, datetimes and timestamps,
+	// or strings in the same format.
+	"date":  {1, 1, dateType, nil, datePart(0)},
+	"year":  {1, 1, int64Type, nil, datePart(1)},
+	"month": {1, 1, int64Type, nil, datePart(2)},
+	"day":   {1, 1, int64Type, nil, datePart(3)},
+}
+
+// stringType returns VARBINARY if one of the arguments is binary,
+// and VARCHAR otherwise.
+func stringType(argTypes []querypb.Type) querypb.Type {
+	for _, typ := range argTypes {
+		if sqltypes.IsBinary(typ) {
+			return sqltypes.VarBinary
+		}
+	}
+	return sqltypes.VarChar
+}
+
+// firstStringType is the string type of the first argument.
+func firstStringType(argTypes []querypb.Type) querypb.Type {
+	return stringType(argTypes[:1])
+}
+
+func int64Type([]querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+func dateType([]querypb.Type) querypb.Type {
+	return sqltypes.Date
+}
+
+func firstArgType(argTypes []querypb.Type) querypb.Type {
+	for _, typ := range argTypes {
+		if typ != sqltypes.Null {
+			return typ
+		}
+	}
+	return sqltypes.Null
+}
+
+func modType(argTypes []querypb.Type) querypb.Type {
+	if sqltypes.IsIntegral(argTypes[0]) && sqltypes.IsIntegral(argTypes[1]) {
+		return sqltypes.Int64
+	}
+	return sqltypes.Float64
+}
+
+// chars returns the characters of a string value of type typ:
+// bytes if it's binary, and UTF-8 encoded runes otherwise.
+func chars(typ querypb.Type, v sqltypes.Value) []string {
+	raw := v.Raw()
+	var result []string
+	for len(raw) > 0 {
+		n := 1
+		if !sqltypes.IsBinary(typ) {
+			_, n = utf8.DecodeRune(raw)
+		}
+		result = append(result, string(raw[:n]))
+		raw = raw[n:]
+	}
+	return result
+}
+
+func toInt64(v sqltypes.Value) (int64, error) {
+	v = ToNumber(v)
+	if v.IsIntegral() {
+		return ToInt64(v)
+	}
+	f, err := ToFloat64(v)
+	return int64(math.Round(f)), err
+}
+
+func anyNull(args []sqltypes.Value) bool {
+	for _, arg := range args {
+		if arg.IsNull() {
+			return true
+		}
+	}
+	return false
+}
+
+func stringFunc(fn func(string) string) func([]querypb.Type, []sqltypes.Value) (sqltypes.Value, error) {
+	return func(argTypes []querypb.Type, args []sqltypes.Value) (sqltypes.Value, error) {
+		if args[0].IsNull() {
+			return sqltypes.NULL, nil
+		}
+		return sqltypes.MakeTrusted(stringType(argTypes), []byte(fn(args[0].ToString()))), nil
+	}
+}
+
+func caseFunc(fn func(string) string) func([]querypb.Type, []sqltypes.Value) (sqltypes.Value, error) {
+	return func(argTypes []querypb.Type, args []sqltypes.Value) (sqltypes.Value, error) {
+		if args[0].IsNull() {
+			return sqltypes.NULL, nil
+		}
+		typ := stringType(argTypes)
+		if sqltypes.IsBinary(typ) {
+			return sqltypes.MakeTrusted(typ, args[0].Raw()), nil
+		}
+		return sqltypes.MakeTrusted(typ, []byte(fn(args[0].ToString()))), nil
+	}
+}
+
+func leftRight(left bool) func([]querypb.Type, []sqltypes.Value) (sqltypes.Value, error) {
+	return func(argTypes []querypb.Type, args []sqltypes.Value) (sqltypes.Value, error) {
+		if anyNull(args) {
+			return sqltypes.NULL, nil
+		}
+		n, err := toInt64(args[1])
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		str := chars(argTypes[0], args[0])
+		switch {
+		case n <= 0:
+			str = nil
+		case n >= int64(len(str)):
+		case left:
+			str = str[:n]
+		default:
+			str = str[int64(len(str))-n:]
+		}
+		return sqltypes.MakeTrusted(firstStringType(argTypes), []byte(strings.Join(str, ""))), nil
+	}
+}
+
+// substr implements substr(str, pos[, len]). Positions start
+// at 1, and negative positions are counted from the end.
+func substr(argTypes []querypb.Type, args []sqltypes.Value) (sqltypes.Value, error) {
+	if anyNull(args) {
+		return sqltypes.NULL, nil
+	}
+	typ := firstStringType(argTypes)
+	str := chars(argTypes[0], args[0])
+	pos, err := toInt64(args[1])
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	switch {
+	case pos == 0:
+		return sqltypes.MakeTrusted(typ, []byte{}), nil
+	case pos > 0:
+		pos--
+	default:
+		pos += int64(len(str))
+	}
+	if pos < 0 || pos >= int64(len(str)) {
+		return sqltypes.MakeTrusted(typ, []byte{}), nil
+	}
+	end := int64(len(str))
+	if len(args) == 3 {
+		n, err := toInt64(args[2])
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		if n <= 0 {
+			return sqltypes.MakeTrusted(typ, []byte{}), nil
+		}
+		if pos+n < end {
+			end = pos + n
+		}
+	}
+	return sqltypes.MakeTrusted(typ, []byte(strings.Join(str[pos:end], ""))), nil
+}
+
+// substringIndex implements substring_index(str, delim, count).
+// The delimiter is matched byte by byte, like in MySQL.
+func substringIndex(argTypes []querypb.Type, args []sqltypes.Value) (sqltypes.Value, error) {
+	if anyNull(args) {
+		return sqltypes.NULL, nil
+	}
+	typ := firstStringType(argTypes)
+	count, err := toInt64(args[2])
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	delim := args[1].ToString()
+	if delim == "" || count == 0 {
+		return sqltypes.MakeTrusted(typ, []byte{}), nil
+	}
+	parts := strings.Split(args[0].ToString(), delim)
+	if count > 0 {
+		if count < int64(len(parts)) {
+			parts = parts[:count]
+		}
+	} else if -count < int64(len(parts)) {
+		parts = parts[int64(len(parts))+count:]
+	}
+	return sqltypes.MakeTrusted(typ, []byte(strings.Join(parts, delim))), nil
+}
+
+// replace implements replace(str, from, to). The search is done byte
+// by byte, like in MySQL, and an empty from leaves str unchanged.
+func replace(argTypes []querypb.Type, args []sqltypes.Value) (sqltypes.Value, error) {
+	if anyNull(args) {
+		return sqltypes.NULL, nil
+	}
+	str := args[0].Raw()
+	if from := args[1].Raw(); len(from) > 0 {
+		str = bytes.Replace(str, from, args[2].Raw(), -1)
+	}
+	return sqltypes.MakeTrusted(stringType(argTypes), str), nil
+}
+
+func concat(argTypes []querypb.Type, args []sqltypes.Value) (sqltypes.Value, error) {
+	if anyNull(args) {
+		return sqltypes.NULL, nil
+	}
+	var buf []byte
+	for _, arg := range args {
+		buf = append(buf, arg.Raw()...)
+	}
+	return sqltypes.MakeTrusted(stringType(argTypes), buf), nil
+}
+
+// concatWS implements concat_ws(separator, str, ...). It returns NULL
+// if the separator is NULL, and skips the other NULL arguments.
+func concatWS(argTypes []querypb.Type, args []sqltypes.Value) (sqltypes.Value, error) {
+	if args[0].IsNull() {
+		return sqltypes.NULL, nil
+	}
+	buf := []byte{}
+	separator := false
+	for _, arg := range args[1:] {
+		if arg.IsNull() {
+			continue
+		}
+		if separator {
+			buf = append(buf, args[0].Raw()...)
+		}
+		buf = append(buf, arg.Raw()...)
+		separator = true
+	}
+	return sqltypes.MakeTrusted(stringType(argTypes), buf), nil
+}
+
+// castResult gives v the type typ of the result of a function,
+// if the value can be represented as such.
+func castResult(v sqltypes.Value, typ querypb.Type) sqltypes.Value {
+	if val, err := Cast(v, typ); err == nil {
+		return val
+	}
+	return v
+}
+
+func coalesce(argTypes []querypb.Type, args []sqltypes.Value) (sqltypes.Value, error) {
+	for _, arg := range args {
+		if !arg.IsNull() {
+			return castResult(arg, firstArgType(argTypes)), nil
+		}
+	}
+	return sqltypes.NULL, nil
+}
+
+func ifFunc(argTypes []querypb.Type, args []sqltypes.Value) (sqltypes.Value, error) {
+	val := args[2]
+	if IsTrue(args[0]) {
+		val = args[1]
+	}
+	return castResult(val, firstArgType(argTypes[1:])), nil
+}
+
+func nullif(_ []querypb.Type, args []sqltypes.Value) (sqltypes.Value, error) {
+	if args[0].IsNull() || args[1].IsNull() {
+		return args[0], nil
+	}
+	cmp, err := Compare(args[0], args[1])
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	if cmp == 0 {
+		return sqltypes.NULL, nil
+	}
+	return args[0], nil
+}
+
+var dateRegexp = regexp.MustCompile(`^(\d{4})-(\d{2})-(\d{2})`)
+
+// datePart returns a function that extracts the date (part 0),
+// or the year, month or day (parts 1 to 3) of its argument.
+// It returns NULL if the argument is not a date.
+func datePart(part int) func([]querypb.Type, []sqltypes.Value) (sqltypes.Value, error) {
+	return func(_ []querypb.Type, args []sqltypes.Value) (sqltypes.Value, error) {
+		if args[0].IsNull() {
+			return sqltypes.NULL, nil
+		}
+		match := dateRegexp.FindStringSubmatch(args[0].ToString())
+		if match == nil {
+			return sqltypes.NULL, nil
+		}
+		if part == 0 {
+			return sqltypes.MakeTrusted(sqltypes.Date, []byte(match[0])), nil
+		}
+		n, err := strconv.ParseInt(match[part], 10, 64)
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		return sqltypes.NewInt64(n), nil
+	}
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestCompare(t *testing.T) {
+	testcases := []struct {
+		v1, v2 sqltypes.Value
+		out    int
+		err    string
+	}{{
+		v1:  sqltypes.NewInt64(10),
+		v2:  sqltypes.NewVarChar("9abc"),
+		out: 1,
+	}, {
+		v1:  sqltypes.NewVarBinary("abc"),
+		v2:  sqltypes.NewVarChar("abd"),
+		out: -1,
+	}, {
+		v1:  sqltypes.MakeTrusted(sqltypes.Date, []byte("2020-01-01")),
+		v2:  sqltypes.NewVarChar("2020-01-01"),
+		out: 0,
+	}, {
+		v1:  sqltypes.NewVarChar("abc"),
+		v2:  sqltypes.NewVarChar("ABC"),
+		err: "cannot compare VARCHAR with VARCHAR: collations are not supported, one of the values must be binary",
+	}}
+	for _, tcase := range testcases {
+		got, err := Compare(tcase.v1, tcase.v2)
+		if tcase.err != "" {
+			assert.EqualError(t, err, tcase.err)
+			continue
+		}
+		require.NoError(t, err)
+		assert.Equal(t, tcase.out, got, "Compare(%v, %v)", tcase.v1, tcase.v2)
+	}
+}
+
+func TestCheckComparable(t *testing.T) {
+	assert.NoError(t, CheckComparable(sqltypes.VarChar, sqltypes.Int64))
+	assert.NoError(t, CheckComparable(sqltypes.VarChar, sqltypes.VarBinary))
+	assert.NoError(t, CheckComparable(sqltypes.Null, sqltypes.Text))
+	assert.Error(t, CheckComparable(sqltypes.Char, sqltypes.VarChar))
+	assert.Error(t, CheckComparable(sqltypes.Enum, sqltypes.VarChar))
+}
+
+func TestIsTrue(t *testing.T) {
+	assert.True(t, IsTrue(sqltypes.NewInt64(-1)))
+	assert.True(t, IsTrue(sqltypes.NewVarChar(" 1abc")))
+	assert.False(t, IsTrue(sqltypes.NewVarChar("abc")))
+	assert.False(t, IsTrue(sqltypes.NewFloat64(0)))
+	assert.False(t, IsTrue(sqltypes.NULL))
+}
+
+func TestMod(t *testing.T) {
+	got, err := Mod(sqltypes.NewInt64(-7), sqltypes.NewInt64(2))
+	require.NoError(t, err)
+	assert.Equal(t, sqltypes.NewInt64(-1), got)
+	got, err = Mod(sqltypes.NewFloat64(7.5), sqltypes.NewInt64(2))
+	require.NoError(t, err)
+	assert.Equal(t, sqltypes.NewFloat64(1.5), got)
+	got, err = Mod(sqltypes.NewInt64(7), sqltypes.NewInt64(0))
+	require.NoError(t, err)
+	assert.Equal(t, sqltypes.NULL, got)
+}
+
+func TestFuncs(t *testing.T) {
+	testcases := []struct {
+		name string
+		args []sqltypes.Value
+		out  sqltypes.Value
+	}{{
+		name: "lower",
+		args: []sqltypes.Value{sqltypes.NewVarChar("AbC")},
+		out:  sqltypes.NewVarChar("abc"),
+	}, {
+		// lower and upper don't change binary strings.
+		name: "upper",
+		args: []sqltypes.Value{sqltypes.NewVarBinary("AbC")},
+		out:  sqltypes.NewVarBinary("AbC"),
+	}, {
+		// trim only removes spaces.
+		name: "trim",
+		args: []sqltypes.Value{sqltypes.NewVarChar(" \tx\n ")},
+		out:  sqltypes.NewVarChar("\tx\n"),
+	}, {
+		name: "rtrim",
+		args: []sqltypes.Value{sqltypes.NewVarChar(" x ")},
+		out:  sqltypes.NewVarChar(" x"),
+	}, {
+		name: "char_length",
+		args: []sqltypes.Value{sqltypes.NewVarChar("héllo")},
+		out:  sqltypes.NewInt64(5),
+	}, {
+		name: "char_length",
+		args: []sqltypes.Value{sqltypes.NewVarBinary("héllo")},
+		out:  sqltypes.NewInt64(6),
+	}, {
+		name: "left",
+		args: []sqltypes.Value{sqltypes.NewVarChar("héllo"), sqltypes.NewInt64(2)},
+		out:  sqltypes.NewVarChar("hé"),
+	}, {
+		name: "substr",
+		args: []sqltypes.Value{sqltypes.NewVarChar("Hello World"), sqltypes.NewInt64(-5), sqltypes.NewInt64(3)},
+		out:  sqltypes.NewVarChar("Wor"),
+	}, {
+		name: "substring_index",
+		args: []sqltypes.Value{sqltypes.NewVarChar("a.b.c"), sqltypes.NewVarChar("."), sqltypes.NewInt64(-2)},
+		out:  sqltypes.NewVarChar("b.c"),
+	}, {
+		name: "replace",
+		args: []sqltypes.Value{sqltypes.NewVarChar("hello"), sqltypes.NewVarChar("l"), sqltypes.NewVarChar("L")},
+		out:  sqltypes.NewVarChar("heLLo"),
+	}, {
+		// An empty search string leaves the string unchanged.
+		name: "replace",
+		args: []sqltypes.Value{sqltypes.NewVarChar("hello"), sqltypes.NewVarChar(""), sqltypes.NewVarChar("x")},
+		out:  sqltypes.NewVarChar("hello"),
+	}, {
+		name: "concat",
+		args: []sqltypes.Value{sqltypes.NewVarChar("a"), sqltypes.NewInt64(1)},
+		out:  sqltypes.NewVarChar("a1"),
+	}, {
+		name: "concat",
+		args: []sqltypes.Value{sqltypes.NewVarChar("a"), sqltypes.NULL},
+		out:  sqltypes.NULL,
+	}, {
+		name: "concat_ws",
+		args: []sqltypes.Value{sqltypes.NewVarChar("-"), sqltypes.NewInt64(7), sqltypes.NULL, sqltypes.NewInt64(2)},
+		out:  sqltypes.NewVarChar("7-2"),
+	}, {
+		name: "coalesce",
+		args: []sqltypes.Value{sqltypes.NULL, sqltypes.NewVarChar("x")},
+		out:  sqltypes.NewVarChar("x"),
+	}, {
+		name: "if",
+		args: []sqltypes.Value{sqltypes.NewVarChar("0"), sqltypes.NewVarChar("yes"), sqltypes.NewVarChar("no")},
+		out:  sqltypes.NewVarChar("no"),
+	}, {
+		name: "nullif",
+		args: []sqltypes.Value{sqltypes.NewInt64(7), sqltypes.NewVarChar("7")},
+		out:  sqltypes.NULL,
+	}, {
+		name: "year",
+		args: []sqltypes.Value{sqltypes.MakeTrusted(sqltypes.Datetime, []byte("2020-01-15 10:00:00"))},
+		out:  sqltypes.NewInt64(2020),
+	}}
+	for _, tcase := range testcases {
+		fn, ok := Funcs[tcase.name]
+		require.True(t, ok, tcase.name)
+		argTypes := make([]querypb.Type, len(tcase.args))
+		for i, arg := range tcase.args {
+			argTypes[i] = arg.Type()
+		}
+		got, err := fn.Call(argTypes, tcase.args)
+		require.NoError(t, err)
+		assert.Equal(t, tcase.out, got, "%s%v", tcase.name, tcase.args)
+	}
+}
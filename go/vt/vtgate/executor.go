@@ -50,6 +50,7 @@ import (
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
@@ -310,37 +311,88 @@ func (e *Executor) handleRollback(ctx context.Context, safeSession *SafeSession,
 func (e *Executor) handleSavepoint(ctx context.Context, safeSession *SafeSession, sql string, planType string, logStats *LogStats, nonTxResponse func(query string) (*sqltypes.Result, error), ignoreMaxMemoryRows bool) (*sqltypes.Result, error) {
 	execStart := time.Now()
 	logStats.PlanTime = execStart.Sub(logStats.StartTime)
-	logStats.ShardQueries = uint32(len(safeSession.ShardSessions))
-	e.updateQueryCounts(planType, "", "", int64(logStats.ShardQueries))
 	defer func() {
 		logStats.ExecuteTime = time.Since(execStart)
 	}()
 
-	if len(safeSession.ShardSessions) == 0 {
-		if safeSession.InTransaction() {
-			// Storing, as this needs to be executed just after starting transaction on the shard.
-			safeSession.StoreSavepoint(sql)
-			return &sqltypes.Result{}, nil
-		}
+	if !safeSession.InTransaction() {
+		e.updateQueryCounts(planType, "", "", 0)
 		return nonTxResponse(sql)
 	}
-	var rss []*srvtopo.ResolvedShard
-	for _, shardSession := range safeSession.ShardSessions {
-		rss = append(rss, &srvtopo.ResolvedShard{
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the shard sessions take part in savepoints. The pre and post sessions
+	// belong to the lookup vindexes, which keep their own commit order and are
+	// never rolled back partially.
+	var shardSessions, laterSessions []*vtgatepb.Session_ShardSession
+	switch stmt := stmt.(type) {
+	case *sqlparser.Savepoint:
+		for _, shardSession := range safeSession.ShardSessions {
+			if shardSession.TransactionId != 0 {
+				shardSessions = append(shardSessions, shardSession)
+			}
+		}
+		defer func() {
+			if err == nil {
+				safeSession.StoreSavepoint(stmt.Name.String())
+			}
+		}()
+	case *sqlparser.SRollback:
+		var ok bool
+		shardSessions, laterSessions, ok = safeSession.SavepointShardSessions(stmt.Name.String())
+		if !ok {
+			e.updateQueryCounts(planType, "", "", 0)
+			return nonTxResponse(sql)
+		}
+		defer func() {
+			if err == nil {
+				safeSession.RollbackToSavepoint(stmt.Name.String())
+			}
+		}()
+	case *sqlparser.Release:
+		var ok bool
+		shardSessions, _, ok = safeSession.SavepointShardSessions(stmt.Name.String())
+		if !ok {
+			e.updateQueryCounts(planType, "", "", 0)
+			return nonTxResponse(sql)
+		}
+		defer func() {
+			if err == nil {
+				safeSession.ReleaseSavepoint(stmt.Name.String())
+			}
+		}()
+	default:
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] unexpected savepoint statement: %T", stmt)
+	}
+	logStats.ShardQueries = uint32(len(shardSessions) + len(laterSessions))
+	e.updateQueryCounts(planType, "", "", int64(logStats.ShardQueries))
+
+	// The transactions that were opened after the savepoint have no
+	// record of it: they are rolled back entirely.
+	if len(laterSessions) != 0 {
+		if err = e.txConn.RollbackShardSessions(ctx, safeSession, laterSessions); err != nil {
+			return nil, err
+		}
+	}
+	if len(shardSessions) == 0 {
+		return &sqltypes.Result{}, nil
+	}
+	rss := make([]*srvtopo.ResolvedShard, len(shardSessions))
+	queries := make([]*querypb.BoundQuery, len(shardSessions))
+	for i, shardSession := range shardSessions {
+		rss[i] = &srvtopo.ResolvedShard{
 			Target:  shardSession.Target,
 			Gateway: e.resolver.resolver.GetGateway(),
-		})
-	}
-	queries := make([]*querypb.BoundQuery, len(rss))
-	for i := range rss {
+		}
 		queries[i] = &querypb.BoundQuery{Sql: sql}
 	}
 	qr, errs := e.ExecuteMultiShard(ctx, rss, queries, safeSession, false /*autocommit*/, ignoreMaxMemoryRows)
-	err := vterrors.Aggregate(errs)
-	if err != nil {
+	if err = vterrors.Aggregate(errs); err != nil {
 		return nil, err
 	}
-	safeSession.StoreSavepoint(sql)
 	return qr, nil
 }
 
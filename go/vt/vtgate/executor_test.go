@@ -2206,15 +2206,6 @@ func TestExecutorSavepointInTx(t *testing.T) {
 	_, err = exec(executor, session, "rollback")
 	require.NoError(t, err)
 	sbc1WantQueries := []*querypb.BoundQuery{{
-		Sql:           "savepoint a",
-		BindVariables: map[string]*querypb.BindVariable{},
-	}, {
-		Sql:           "rollback to a",
-		BindVariables: map[string]*querypb.BindVariable{},
-	}, {
-		Sql:           "release savepoint a",
-		BindVariables: map[string]*querypb.BindVariable{},
-	}, {
 		Sql:           "select id from user where id = 1",
 		BindVariables: map[string]*querypb.BindVariable{},
 	}, {
@@ -2228,39 +2219,97 @@ func TestExecutorSavepointInTx(t *testing.T) {
 		BindVariables: map[string]*querypb.BindVariable{},
 	}}
 
+	// sbc2 joined the transaction after the savepoints were released.
 	sbc2WantQueries := []*querypb.BoundQuery{{
+		Sql:           "select id from user where id = 3",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}}
+	utils.MustMatch(t, sbc1WantQueries, sbc1.Queries, "")
+	utils.MustMatch(t, sbc2WantQueries, sbc2.Queries, "")
+	testQueryLog(t, logChan, "TestExecute", "SAVEPOINT", "savepoint a", 0)
+	testQueryLog(t, logChan, "TestExecute", "SAVEPOINT_ROLLBACK", "rollback to a", 0)
+	testQueryLog(t, logChan, "TestExecute", "RELEASE", "release savepoint a", 0)
+	testQueryLog(t, logChan, "TestExecute", "SELECT", "select id from user where id = 1", 1)
+	testQueryLog(t, logChan, "TestExecute", "SAVEPOINT", "savepoint b", 1)
+	testQueryLog(t, logChan, "TestExecute", "SAVEPOINT_ROLLBACK", "rollback to b", 1)
+	testQueryLog(t, logChan, "TestExecute", "RELEASE", "release savepoint b", 1)
+	testQueryLog(t, logChan, "TestExecute", "SELECT", "select id from user where id = 3", 1)
+	testQueryLog(t, logChan, "TestExecute", "ROLLBACK", "rollback", 2)
+}
+
+func TestExecutorSavepointInterleavedShards(t *testing.T) {
+	executor, sbc1, sbc2, _ := createLegacyExecutorEnv()
+
+	session := NewSafeSession(&vtgatepb.Session{Autocommit: false, TargetString: "@master"})
+	for _, sql := range []string{
+		"select id from user where id = 1",
+		"savepoint a",
+		"select id from user where id = 3",
+		"savepoint b",
+	} {
+		_, err := exec(executor, session, sql)
+		require.NoError(t, err, sql)
+	}
+	require.Len(t, session.ShardSessions, 2)
+
+	// sbc2 joined after a: it is rolled back entirely, and b is forgotten.
+	_, err := exec(executor, session, "rollback to A")
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, sbc1.RollbackCount.Get())
+	assert.EqualValues(t, 1, sbc2.RollbackCount.Get())
+	require.Len(t, session.ShardSessions, 1)
+	assert.Equal(t, "-20", session.ShardSessions[0].Target.Shard)
+	_, err = exec(executor, session, "rollback to b")
+	require.EqualError(t, err, "SAVEPOINT does not exist: rollback to b (errno 1305) (sqlstate 42000)")
+
+	// sbc2 joins again. Releasing a only concerns sbc1.
+	for _, sql := range []string{
+		"select id from user where id = 3",
+		"savepoint c",
+		"release savepoint a",
+	} {
+		_, err := exec(executor, session, sql)
+		require.NoError(t, err, sql)
+	}
+	_, err = exec(executor, session, "rollback to c")
+	require.EqualError(t, err, "SAVEPOINT does not exist: rollback to c (errno 1305) (sqlstate 42000)")
+	assert.Empty(t, session.Savepoints)
+	require.Len(t, session.ShardSessions, 2)
+
+	sbc1WantQueries := []*querypb.BoundQuery{{
+		Sql:           "select id from user where id = 1",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}, {
 		Sql:           "savepoint a",
 		BindVariables: map[string]*querypb.BindVariable{},
 	}, {
-		Sql:           "rollback to a",
+		Sql:           "savepoint b",
 		BindVariables: map[string]*querypb.BindVariable{},
 	}, {
-		Sql:           "release savepoint a",
+		Sql:           "rollback to A",
 		BindVariables: map[string]*querypb.BindVariable{},
 	}, {
-		Sql:           "savepoint b",
+		Sql:           "savepoint c",
 		BindVariables: map[string]*querypb.BindVariable{},
 	}, {
-		Sql:           "rollback to b",
+		Sql:           "release savepoint a",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}}
+	sbc2WantQueries := []*querypb.BoundQuery{{
+		Sql:           "select id from user where id = 3",
 		BindVariables: map[string]*querypb.BindVariable{},
 	}, {
-		Sql:           "release savepoint b",
+		Sql:           "savepoint b",
 		BindVariables: map[string]*querypb.BindVariable{},
 	}, {
 		Sql:           "select id from user where id = 3",
 		BindVariables: map[string]*querypb.BindVariable{},
+	}, {
+		Sql:           "savepoint c",
+		BindVariables: map[string]*querypb.BindVariable{},
 	}}
 	utils.MustMatch(t, sbc1WantQueries, sbc1.Queries, "")
 	utils.MustMatch(t, sbc2WantQueries, sbc2.Queries, "")
-	testQueryLog(t, logChan, "TestExecute", "SAVEPOINT", "savepoint a", 0)
-	testQueryLog(t, logChan, "TestExecute", "SAVEPOINT_ROLLBACK", "rollback to a", 0)
-	testQueryLog(t, logChan, "TestExecute", "RELEASE", "release savepoint a", 0)
-	testQueryLog(t, logChan, "TestExecute", "SELECT", "select id from user where id = 1", 1)
-	testQueryLog(t, logChan, "TestExecute", "SAVEPOINT", "savepoint b", 1)
-	testQueryLog(t, logChan, "TestExecute", "SAVEPOINT_ROLLBACK", "rollback to b", 1)
-	testQueryLog(t, logChan, "TestExecute", "RELEASE", "release savepoint b", 1)
-	testQueryLog(t, logChan, "TestExecute", "SELECT", "select id from user where id = 3", 1)
-	testQueryLog(t, logChan, "TestExecute", "ROLLBACK", "rollback", 2)
 }
 
 func TestExecutorSavepointWithoutTx(t *testing.T) {
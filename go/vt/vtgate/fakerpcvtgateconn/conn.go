@@ -163,10 +163,15 @@ func (conn *FakeVTGateConn) ResolveTransaction(ctx context.Context, dtid string)
 }
 
 // VStream streams binlog events.
-func (conn *FakeVTGateConn) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter) (vtgateconn.VStreamReader, error) {
+func (conn *FakeVTGateConn) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, streamID string) (vtgateconn.VStreamReader, error) {
 	return nil, fmt.Errorf("NYI")
 }
 
+// VStreamCopyTables adds tables to a VStream.
+func (conn *FakeVTGateConn) VStreamCopyTables(ctx context.Context, streamID, keyspace string, tables []string) error {
+	return fmt.Errorf("NYI")
+}
+
 // Close please see vtgateconn.Impl.Close
 func (conn *FakeVTGateConn) Close() {
 }
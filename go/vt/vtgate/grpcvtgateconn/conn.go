@@ -183,12 +183,13 @@ func (a *vstreamAdapter) Recv() ([]*binlogdatapb.VEvent, error) {
 	return r.Events, nil
 }
 
-func (conn *vtgateConn) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter) (vtgateconn.VStreamReader, error) {
+func (conn *vtgateConn) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, streamID string) (vtgateconn.VStreamReader, error) {
 	req := &vtgatepb.VStreamRequest{
 		CallerId:   callerid.EffectiveCallerIDFromContext(ctx),
 		TabletType: tabletType,
 		Vgtid:      vgtid,
 		Filter:     filter,
+		StreamId:   streamID,
 	}
 	stream, err := conn.c.VStream(ctx, req)
 	if err != nil {
@@ -199,6 +200,17 @@ func (conn *vtgateConn) VStream(ctx context.Context, tabletType topodatapb.Table
 	}, nil
 }
 
+func (conn *vtgateConn) VStreamCopyTables(ctx context.Context, streamID, keyspace string, tables []string) error {
+	request := &vtgatepb.VStreamCopyTablesRequest{
+		CallerId: callerid.EffectiveCallerIDFromContext(ctx),
+		StreamId: streamID,
+		Keyspace: keyspace,
+		Tables:   tables,
+	}
+	_, err := conn.c.VStreamCopyTables(ctx, request)
+	return vterrors.FromGRPC(err)
+}
+
 func (conn *vtgateConn) Close() {
 	conn.cc.Close()
 }
@@ -211,7 +211,11 @@ func (f *fakeVTGateService) ResolveTransaction(ctx context.Context, dtid string)
 	return nil
 }
 
-func (f *fakeVTGateService) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, send func([]*binlogdatapb.VEvent) error) error {
+func (f *fakeVTGateService) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, streamID string, send func([]*binlogdatapb.VEvent) error) error {
+	panic("unimplemented")
+}
+
+func (f *fakeVTGateService) VStreamCopyTables(ctx context.Context, streamID, keyspace string, tables []string) error {
 	panic("unimplemented")
 }
 
@@ -197,6 +197,7 @@ func (vtg *VTGate) VStream(request *vtgatepb.VStreamRequest, stream vtgateservic
 		request.TabletType,
 		request.Vgtid,
 		request.Filter,
+		request.StreamId,
 		func(events []*binlogdatapb.VEvent) error {
 			return stream.Send(&vtgatepb.VStreamResponse{
 				Events: events,
@@ -205,6 +206,17 @@ func (vtg *VTGate) VStream(request *vtgatepb.VStreamRequest, stream vtgateservic
 	return vterrors.ToGRPC(vtgErr)
 }
 
+// VStreamCopyTables is the RPC version of vtgateservice.VTGateService method
+func (vtg *VTGate) VStreamCopyTables(ctx context.Context, request *vtgatepb.VStreamCopyTablesRequest) (response *vtgatepb.VStreamCopyTablesResponse, err error) {
+	defer vtg.server.HandlePanic(&err)
+	ctx = withCallerIDContext(ctx, request.CallerId)
+	vtgErr := vtg.server.VStreamCopyTables(ctx, request.StreamId, request.Keyspace, request.Tables)
+	if vtgErr == nil {
+		return &vtgatepb.VStreamCopyTablesResponse{}, nil
+	}
+	return nil, vterrors.ToGRPC(vtgErr)
+}
+
 func init() {
 	vtgate.RegisterVTGates = append(vtgate.RegisterVTGates, func(vtGate vtgateservice.VTGateService) {
 		if servenv.GRPCCheckServiceMap("vtgateservice") {
@@ -319,6 +319,8 @@ func createInstructionFor(query string, stmt sqlparser.Statement, vschema Contex
 			return buildVSchemaDDLPlan(stmt, vschema)
 		}
 		return buildDDLPlan(query, stmt, vschema)
+	case *sqlparser.AlterMigration:
+		return buildAlterMigrationPlan(query, vschema)
 	case *sqlparser.Use:
 		return buildUsePlan(stmt, vschema)
 	case *sqlparser.Explain:
@@ -3,7 +3,11 @@ package planbuilder
 import (
 	"vitess.io/vitess/go/vt/key"
 	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/engine"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
 func buildDDLPlan(sql string, in sqlparser.Statement, vschema ContextVSchema) (engine.Primitive, error) {
@@ -37,3 +41,27 @@ func buildVSchemaDDLPlan(stmt *sqlparser.DDL, vschema ContextVSchema) (engine.Pr
 		DDL:      stmt,
 	}, nil
 }
+
+// buildAlterMigrationPlan sends the statement to the masters of the
+// target keyspace. Each one updates its own migration.
+func buildAlterMigrationPlan(sql string, vschema ContextVSchema) (engine.Primitive, error) {
+	destination, keyspace, tabletType, err := vschema.TargetDestination("")
+	if err != nil {
+		return nil, err
+	}
+	if tabletType != topodatapb.TabletType_MASTER {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "ALTER VITESS_MIGRATION must target the master tablets")
+	}
+
+	if destination == nil {
+		destination = key.DestinationAllShards{}
+	}
+
+	return &engine.Send{
+		Keyspace:          keyspace,
+		TargetDestination: destination,
+		Query:             sql,
+		IsDML:             false,
+		SingleShardOnly:   false,
+	}, nil
+}
@@ -18,6 +18,7 @@ package vtgate
 
 import (
 	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/golang/protobuf/proto"
@@ -327,11 +328,98 @@ func (session *SafeSession) SetOptions(options *querypb.ExecuteOptions) {
 	session.Options = options
 }
 
-//StoreSavepoint stores the savepoint and release savepoint queries in the session
-func (session *SafeSession) StoreSavepoint(sql string) {
+//StoreSavepoint records a savepoint along with the shard transactions that
+//are currently open. A previous savepoint with the same name is replaced.
+func (session *SafeSession) StoreSavepoint(name string) {
 	session.mu.Lock()
 	defer session.mu.Unlock()
-	session.Savepoints = append(session.Savepoints, sql)
+	if idx := session.findSavepoint(name); idx != -1 {
+		session.Savepoints = append(session.Savepoints[:idx], session.Savepoints[idx+1:]...)
+	}
+	savepoint := &vtgatepb.Session_Savepoint{Name: name}
+	for _, shardSession := range session.ShardSessions {
+		if shardSession.TransactionId != 0 {
+			savepoint.ShardSessions = append(savepoint.ShardSessions, proto.Clone(shardSession).(*vtgatepb.Session_ShardSession))
+		}
+	}
+	session.Savepoints = append(session.Savepoints, savepoint)
+}
+
+//SavepointShardSessions splits the open shard transactions into the ones that
+//existed when the savepoint was created, and the ones that were opened after.
+//It returns false if the savepoint does not exist.
+func (session *SafeSession) SavepointShardSessions(name string) (existing, later []*vtgatepb.Session_ShardSession, ok bool) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	idx := session.findSavepoint(name)
+	if idx == -1 {
+		return nil, nil, false
+	}
+	savepoint := session.Savepoints[idx]
+	for _, shardSession := range session.ShardSessions {
+		if shardSession.TransactionId == 0 {
+			continue
+		}
+		if containsShardSession(savepoint.ShardSessions, shardSession) {
+			existing = append(existing, shardSession)
+		} else {
+			later = append(later, shardSession)
+		}
+	}
+	return existing, later, true
+}
+
+//RollbackToSavepoint forgets the savepoints that were created after the
+//named savepoint. The named savepoint is kept.
+func (session *SafeSession) RollbackToSavepoint(name string) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if idx := session.findSavepoint(name); idx != -1 {
+		session.Savepoints = session.Savepoints[:idx+1]
+	}
+}
+
+//ReleaseSavepoint forgets the named savepoint and the ones created after it.
+func (session *SafeSession) ReleaseSavepoint(name string) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if idx := session.findSavepoint(name); idx != -1 {
+		session.Savepoints = session.Savepoints[:idx]
+	}
+}
+
+//RemoveFinishedShardSessions removes the shard sessions that have
+//neither a transaction nor a reserved connection.
+func (session *SafeSession) RemoveFinishedShardSessions() {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	var sessions []*vtgatepb.Session_ShardSession
+	for _, shardSession := range session.ShardSessions {
+		if shardSession.TransactionId != 0 || shardSession.ReservedId != 0 {
+			sessions = append(sessions, shardSession)
+		}
+	}
+	session.ShardSessions = sessions
+}
+
+// findSavepoint must be called with the lock held.
+func (session *SafeSession) findSavepoint(name string) int {
+	for i, savepoint := range session.Savepoints {
+		// Savepoint names are case insensitive in MySQL.
+		if strings.EqualFold(savepoint.Name, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+func containsShardSession(sessions []*vtgatepb.Session_ShardSession, shardSession *vtgatepb.Session_ShardSession) bool {
+	for _, s := range sessions {
+		if proto.Equal(s.Target, shardSession.Target) && s.TransactionId == shardSession.TransactionId {
+			return true
+		}
+	}
+	return false
 }
 
 //InReservedConn returns true if the session needs to execute on a dedicated connection
@@ -222,6 +222,26 @@ func (txc *TxConn) Rollback(ctx context.Context, session *SafeSession) error {
 	return err
 }
 
+// RollbackShardSessions rolls back the transactions of the specified shard sessions,
+// and removes the sessions that are left with nothing open. The rest of the transaction
+// remains open. It is used to undo the shards that joined after a savepoint.
+func (txc *TxConn) RollbackShardSessions(ctx context.Context, session *SafeSession, shardSessions []*vtgatepb.Session_ShardSession) error {
+	defer session.RemoveFinishedShardSessions()
+	return txc.runSessions(ctx, shardSessions, func(ctx context.Context, s *vtgatepb.Session_ShardSession) error {
+		qs, err := txc.queryService(s.TabletAlias)
+		if err != nil {
+			return err
+		}
+		reservedID, err := qs.Rollback(ctx, s.Target, s.TransactionId)
+		if err != nil {
+			return err
+		}
+		s.TransactionId = 0
+		s.ReservedId = reservedID
+		return nil
+	})
+}
+
 //Release releases the reserved connection and/or rollbacks the transaction
 func (txc *TxConn) Release(ctx context.Context, session *SafeSession) error {
 	if !session.InTransaction() && !session.InReservedConn() {
@@ -19,6 +19,7 @@ package vtgate
 import (
 	"fmt"
 	"io"
+	"regexp"
 	"sync"
 
 	"github.com/golang/protobuf/proto"
@@ -37,6 +38,11 @@ type vstreamManager struct {
 	resolver *srvtopo.Resolver
 	toposerv srvtopo.Server
 	cell     string
+
+	// mu protects streams.
+	mu sync.Mutex
+	// streams contains the in-flight streams that were given a name.
+	streams map[string]*vstream
 }
 
 // vstream contains the metadata for one VStream request.
@@ -51,6 +57,10 @@ type vstream struct {
 	vgtid     *binlogdatapb.VGtid
 	send      func(events []*binlogdatapb.VEvent) error
 	journaler map[int64]*journalEvent
+	// restarts contains the cancel functions of the current tablet
+	// streams. A copyTables request uses them to restart the streams
+	// of a keyspace with the tables it added.
+	restarts map[*binlogdatapb.ShardGtid]context.CancelFunc
 
 	// err can only be set once.
 	once sync.Once
@@ -58,8 +68,11 @@ type vstream struct {
 
 	// Other input parameters
 	tabletType topodatapb.TabletType
-	filter     *binlogdatapb.Filter
 	resolver   *srvtopo.Resolver
+	filter     *binlogdatapb.Filter
+	// keyspaceRules are the rules added to the filter of the streams of
+	// a keyspace by copyTables requests. They're protected by mu.
+	keyspaceRules map[string][]*binlogdatapb.Rule
 
 	cancel context.CancelFunc
 	wg     sync.WaitGroup
@@ -76,10 +89,14 @@ func newVStreamManager(resolver *srvtopo.Resolver, serv srvtopo.Server, cell str
 		resolver: resolver,
 		toposerv: serv,
 		cell:     cell,
+		streams:  make(map[string]*vstream),
 	}
 }
 
-func (vsm *vstreamManager) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, send func(events []*binlogdatapb.VEvent) error) error {
+// VStream streams events from the shards in vgtid. If streamID is not
+// empty, the stream accepts CopyTables requests under that name while
+// it is in flight.
+func (vsm *vstreamManager) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, streamID string, send func(events []*binlogdatapb.VEvent) error) error {
 	vgtid, filter, err := vsm.resolveParams(ctx, tabletType, vgtid, filter)
 	if err != nil {
 		return err
@@ -91,10 +108,53 @@ func (vsm *vstreamManager) VStream(ctx context.Context, tabletType topodatapb.Ta
 		send:       send,
 		resolver:   vsm.resolver,
 		journaler:  make(map[int64]*journalEvent),
+		restarts:   make(map[*binlogdatapb.ShardGtid]context.CancelFunc),
+
+		keyspaceRules: make(map[string][]*binlogdatapb.Rule),
+	}
+	if streamID != "" {
+		if err := vsm.register(streamID, vs); err != nil {
+			return err
+		}
+		defer vsm.unregister(streamID)
 	}
 	return vs.stream(ctx)
 }
 
+// CopyTables adds tables of a keyspace to the in-flight stream named
+// streamID. Every shard stream of the keyspace is restarted from its
+// current position with the tables added to its TablePKs. The tablet
+// snapshots them in its copy phase while it keeps streaming the other
+// tables, and then streams their changes along with the rest.
+func (vsm *vstreamManager) CopyTables(ctx context.Context, streamID, keyspace string, tables []string) error {
+	if len(tables) == 0 {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "no tables specified")
+	}
+	vsm.mu.Lock()
+	vs, ok := vsm.streams[streamID]
+	vsm.mu.Unlock()
+	if !ok {
+		return vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "vstream %s not found", streamID)
+	}
+	return vs.copyTables(keyspace, tables)
+}
+
+func (vsm *vstreamManager) register(streamID string, vs *vstream) error {
+	vsm.mu.Lock()
+	defer vsm.mu.Unlock()
+	if _, ok := vsm.streams[streamID]; ok {
+		return vterrors.Errorf(vtrpcpb.Code_ALREADY_EXISTS, "vstream %s already exists", streamID)
+	}
+	vsm.streams[streamID] = vs
+	return nil
+}
+
+func (vsm *vstreamManager) unregister(streamID string) {
+	vsm.mu.Lock()
+	defer vsm.mu.Unlock()
+	delete(vsm.streams, streamID)
+}
+
 // resolveParams provides defaults for the inputs if they're not specified.
 func (vsm *vstreamManager) resolveParams(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter) (*binlogdatapb.VGtid, *binlogdatapb.Filter, error) {
 	if filter == nil {
@@ -213,14 +273,24 @@ func (vs *vstream) streamFromTablet(ctx context.Context, sgtid *binlogdatapb.Sha
 			// Unreachable.
 			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unexpected number or shards: %v", rss)
 		}
-		// Safe to access sgtid.Gtid here (because it can't change until streaming begins).
-		err = rss[0].Gateway.VStream(ctx, rss[0].Target, sgtid.Gtid, sgtid.TablePKs, vs.filter, func(events []*binlogdatapb.VEvent) error {
+		// sgtid.Gtid can't change until streaming begins, but a copyTables
+		// request can change the TablePKs and the filter at any time.
+		// So, we read them and register the restart under the lock.
+		tabletCtx, tabletCancel := context.WithCancel(ctx)
+		vs.mu.Lock()
+		gtid := sgtid.Gtid
+		tablePKs := make([]*binlogdatapb.TableLastPK, len(sgtid.TablePKs))
+		copy(tablePKs, sgtid.TablePKs)
+		filter := vs.keyspaceFilter(sgtid.Keyspace)
+		vs.restarts[sgtid] = tabletCancel
+		vs.mu.Unlock()
+		err = rss[0].Gateway.VStream(tabletCtx, rss[0].Target, gtid, tablePKs, filter, func(events []*binlogdatapb.VEvent) error {
 			// We received a valid event. Reset error count.
 			errCount = 0
 
 			select {
-			case <-ctx.Done():
-				return ctx.Err()
+			case <-tabletCtx.Done():
+				return tabletCtx.Err()
 			case <-journalDone:
 				// Unreachable.
 				// This can happen if a server misbehaves and does not end
@@ -282,12 +352,24 @@ func (vs *vstream) streamFromTablet(ctx context.Context, sgtid *binlogdatapb.Sha
 			}
 			return nil
 		})
+		restarted := ctx.Err() == nil && tabletCtx.Err() != nil
+		vs.mu.Lock()
+		delete(vs.restarts, sgtid)
+		vs.mu.Unlock()
+		tabletCancel()
+
 		// If stream was ended (by a journal event), return nil without checking for error.
 		select {
 		case <-journalDone:
 			return nil
 		default:
 		}
+		if restarted {
+			// Events that were not sent yet will be streamed again,
+			// because we restart from the last position we sent.
+			log.Infof("vstream for %s/%s restarting to copy tables", sgtid.Keyspace, sgtid.Shard)
+			continue
+		}
 		if err == nil {
 			// Unreachable.
 			err = vterrors.Errorf(vtrpcpb.Code_UNKNOWN, "vstream ended unexpectedly")
@@ -357,6 +439,81 @@ func (vs *vstream) sendAll(sgtid *binlogdatapb.ShardGtid, eventss [][]*binlogdat
 	return nil
 }
 
+// copyTables adds tables to the TablePKs of all the shards of the keyspace,
+// and to the filter if it does not match them yet. The shard streams are
+// then restarted to pick up the change.
+func (vs *vstream) copyTables(keyspace string, tables []string) error {
+	vs.mu.Lock()
+	defer vs.mu.Unlock()
+
+	var sgtids []*binlogdatapb.ShardGtid
+	for _, sgtid := range vs.vgtid.ShardGtids {
+		if sgtid.Keyspace == keyspace {
+			sgtids = append(sgtids, sgtid)
+		}
+	}
+	if len(sgtids) == 0 {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "keyspace %s is not in the stream: %v", keyspace, vs.vgtid)
+	}
+
+	for _, table := range tables {
+		if !filterMatches(vs.keyspaceFilter(keyspace), table) {
+			// The rule only goes to the streams of the keyspace, so the
+			// other keyspaces don't start streaming a table of the same name.
+			vs.keyspaceRules[keyspace] = append(vs.keyspaceRules[keyspace], &binlogdatapb.Rule{Match: table})
+		}
+	nextShard:
+		for _, sgtid := range sgtids {
+			for _, tablePK := range sgtid.TablePKs {
+				if tablePK.TableName == table {
+					// The table is already being copied.
+					continue nextShard
+				}
+			}
+			sgtid.TablePKs = append(sgtid.TablePKs, &binlogdatapb.TableLastPK{
+				TableName: table,
+			})
+		}
+	}
+	for _, sgtid := range sgtids {
+		// A stream that is not running will pick up the
+		// new tables when it is (re)started.
+		if cancel, ok := vs.restarts[sgtid]; ok {
+			cancel()
+		}
+	}
+	return nil
+}
+
+// keyspaceFilter returns the filter of the streams of the keyspace:
+// the filter of the request with the rules that copyTables requests
+// added for the keyspace. It must be called with mu held.
+func (vs *vstream) keyspaceFilter(keyspace string) *binlogdatapb.Filter {
+	rules := vs.keyspaceRules[keyspace]
+	if len(rules) == 0 {
+		return vs.filter
+	}
+	filter := proto.Clone(vs.filter).(*binlogdatapb.Filter)
+	filter.Rules = append(filter.Rules, rules...)
+	return filter
+}
+
+// filterMatches returns true if a rule of the filter matches the table.
+func filterMatches(filter *binlogdatapb.Filter, table string) bool {
+	for _, rule := range filter.Rules {
+		if rule.Match == table {
+			return true
+		}
+		if len(rule.Match) > 0 && rule.Match[0] == '/' {
+			result, err := regexp.MatchString(rule.Match[1:], table)
+			if err == nil && result {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // getJournalEvent returns a journalEvent. The caller has to wait on its done channel.
 // Once it closes, the caller has to return (end their stream).
 // The function has three parts:
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
@@ -89,7 +90,7 @@ func TestVStreamEvents(t *testing.T) {
 	}
 	ch := make(chan *binlogdatapb.VStreamResponse)
 	go func() {
-		err := vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, nil, func(events []*binlogdatapb.VEvent) error {
+		err := vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, nil, "", func(events []*binlogdatapb.VEvent) error {
 			ch <- &binlogdatapb.VStreamResponse{Events: events}
 			return nil
 		})
@@ -140,7 +141,7 @@ func TestVStreamChunks(t *testing.T) {
 			Gtid:     "pos",
 		}},
 	}
-	_ = vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, nil, func(events []*binlogdatapb.VEvent) error {
+	_ = vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, nil, "", func(events []*binlogdatapb.VEvent) error {
 		switch events[0].Type {
 		case binlogdatapb.VEventType_ROW:
 			if doneCounting {
@@ -265,7 +266,7 @@ func TestVStreamRetry(t *testing.T) {
 			Gtid:     "pos",
 		}},
 	}
-	err := vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, nil, func(events []*binlogdatapb.VEvent) error {
+	err := vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, nil, "", func(events []*binlogdatapb.VEvent) error {
 		count++
 		return nil
 	})
@@ -276,6 +277,85 @@ func TestVStreamRetry(t *testing.T) {
 	}
 }
 
+func TestVStreamCopyTables(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	name := "TestVStream"
+	name2 := "TestVStream2"
+	_ = createSandbox(name)
+	_ = createSandbox(name2)
+	hc := discovery.NewFakeHealthCheck()
+	vsm := newTestVStreamManager(hc, new(sandboxTopo), "aa")
+	sbc0 := hc.AddTestTablet("aa", "1.1.1.1", 1001, name, "-20", topodatapb.TabletType_MASTER, true, 1, nil)
+	sbc1 := hc.AddTestTablet("aa", "1.1.1.1", 1002, name2, "-20", topodatapb.TabletType_MASTER, true, 1, nil)
+
+	send0 := []*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_GTID, Gtid: "gtid01"},
+		{Type: binlogdatapb.VEventType_COMMIT},
+	}
+	sbc0.AddVStreamEvents(send0, nil)
+
+	vgtid := &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: name,
+			Shard:    "-20",
+			Gtid:     "pos",
+		}, {
+			Keyspace: name2,
+			Shard:    "-20",
+			Gtid:     "pos",
+		}},
+	}
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{Match: "t1"}},
+	}
+	ch := make(chan *binlogdatapb.VStreamResponse)
+	go func() {
+		_ = vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, filter, "s1", func(events []*binlogdatapb.VEvent) error {
+			ch <- &binlogdatapb.VStreamResponse{Events: events}
+			return nil
+		})
+	}()
+	<-ch
+	require.Eventually(t, func() bool {
+		return len(sbc1.VStreamRequests()) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	err := vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, filter, "s1", nil)
+	assert.EqualError(t, err, "vstream s1 already exists")
+	err = vsm.CopyTables(ctx, "s2", name, []string{"t2"})
+	assert.EqualError(t, err, "vstream s2 not found")
+	err = vsm.CopyTables(ctx, "s1", "ks2", []string{"t2"})
+	assert.Contains(t, fmt.Sprint(err), "keyspace ks2 is not in the stream")
+
+	require.NoError(t, vsm.CopyTables(ctx, "s1", name, []string{"t2"}))
+	require.Eventually(t, func() bool {
+		return len(sbc0.VStreamRequests()) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// The stream restarts from the last position it sent,
+	// with t2 to be copied and added to the filter.
+	got := sbc0.VStreamRequests()[1]
+	want := &binlogdatapb.VStreamRequest{
+		Target:   got.Target,
+		Position: "gtid01",
+		Filter: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{Match: "t1"}, {Match: "t2"}},
+		},
+		TableLastPKs: []*binlogdatapb.TableLastPK{{TableName: "t2"}},
+	}
+	if !proto.Equal(got, want) {
+		t.Errorf("VStream request:\n%v, want\n%v", got, want)
+	}
+
+	// The streams of the other keyspace are left alone.
+	time.Sleep(50 * time.Millisecond)
+	reqs := sbc1.VStreamRequests()
+	require.Len(t, reqs, 1)
+	assert.True(t, proto.Equal(filter, reqs[0].Filter), "filter of %s: %v", name2, reqs[0].Filter)
+}
+
 func TestVStreamHeartbeat(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -706,7 +786,7 @@ func TestVStreamJournalPartialMatch(t *testing.T) {
 			Gtid:     "pos1020",
 		}},
 	}
-	err := vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, nil, func(events []*binlogdatapb.VEvent) error {
+	err := vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, nil, "", func(events []*binlogdatapb.VEvent) error {
 		t.Errorf("unexpected events: %v", events)
 		return nil
 	})
@@ -735,7 +815,7 @@ func TestVStreamJournalPartialMatch(t *testing.T) {
 		}},
 	}
 	sbc2.AddVStreamEvents(send, nil)
-	err = vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, nil, func(events []*binlogdatapb.VEvent) error {
+	err = vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, nil, "", func(events []*binlogdatapb.VEvent) error {
 		t.Errorf("unexpected events: %v", events)
 		return nil
 	})
@@ -881,7 +961,7 @@ func newTestVStreamManager(hc discovery.HealthCheck, serv srvtopo.Server, cell s
 func startVStream(ctx context.Context, t *testing.T, vsm *vstreamManager, vgtid *binlogdatapb.VGtid) <-chan *binlogdatapb.VStreamResponse {
 	ch := make(chan *binlogdatapb.VStreamResponse)
 	go func() {
-		_ = vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, nil, func(events []*binlogdatapb.VEvent) error {
+		_ = vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, nil, "", func(events []*binlogdatapb.VEvent) error {
 			ch <- &binlogdatapb.VStreamResponse{Events: events}
 			return nil
 		})
@@ -400,8 +400,13 @@ handleError:
 }
 
 // VStream streams binlog events.
-func (vtg *VTGate) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, send func([]*binlogdatapb.VEvent) error) error {
-	return vtg.vsm.VStream(ctx, tabletType, vgtid, filter, send)
+func (vtg *VTGate) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, streamID string, send func([]*binlogdatapb.VEvent) error) error {
+	return vtg.vsm.VStream(ctx, tabletType, vgtid, filter, streamID, send)
+}
+
+// VStreamCopyTables adds tables to the in-flight VStream named streamID.
+func (vtg *VTGate) VStreamCopyTables(ctx context.Context, streamID, keyspace string, tables []string) error {
+	return vtg.vsm.CopyTables(ctx, streamID, keyspace, tables)
 }
 
 // GetGatewayCacheStatus returns a displayable version of the Gateway cache.
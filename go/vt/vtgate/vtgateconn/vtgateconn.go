@@ -74,7 +74,20 @@ type VStreamReader interface {
 
 // VStream streams binlog events.
 func (conn *VTGateConn) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter) (VStreamReader, error) {
-	return conn.impl.VStream(ctx, tabletType, vgtid, filter)
+	return conn.impl.VStream(ctx, tabletType, vgtid, filter, "")
+}
+
+// NamedVStream streams binlog events like VStream. The stream is named
+// streamID in vtgate, which lets VStreamCopyTables add tables to it.
+func (conn *VTGateConn) NamedVStream(ctx context.Context, streamID string, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter) (VStreamReader, error) {
+	return conn.impl.VStream(ctx, tabletType, vgtid, filter, streamID)
+}
+
+// VStreamCopyTables adds tables of a keyspace to the in-flight stream
+// started by NamedVStream. The existing rows of the tables are sent
+// first, followed by their changes.
+func (conn *VTGateConn) VStreamCopyTables(ctx context.Context, streamID, keyspace string, tables []string) error {
+	return conn.impl.VStreamCopyTables(ctx, streamID, keyspace, tables)
 }
 
 // VTGateSession exposes the V3 API to the clients.
@@ -132,8 +145,12 @@ type Impl interface {
 	// ResolveTransaction resolves the specified 2pc transaction.
 	ResolveTransaction(ctx context.Context, dtid string) error
 
-	// VStream streams binlogevents
-	VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter) (VStreamReader, error)
+	// VStream streams binlogevents. If streamID is set, the stream can be
+	// referred to by VStreamCopyTables.
+	VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, streamID string) (VStreamReader, error)
+
+	// VStreamCopyTables adds tables to an in-flight named VStream.
+	VStreamCopyTables(ctx context.Context, streamID, keyspace string, tables []string) error
 
 	// Close must be called for releasing resources.
 	Close()
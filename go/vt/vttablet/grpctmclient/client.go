@@ -820,13 +820,19 @@ func (e *restoreFromBackupStreamAdapter) Recv() (*logutilpb.Event, error) {
 }
 
 // RestoreFromBackup is part of the tmclient.TabletManagerClient interface.
-func (client *Client) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet) (logutil.EventStream, error) {
+func (client *Client) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet, restoreToPos string, restoreToTime time.Time) (logutil.EventStream, error) {
 	cc, c, err := client.dial(tablet)
 	if err != nil {
 		return nil, err
 	}
 
-	stream, err := c.RestoreFromBackup(ctx, &tabletmanagerdatapb.RestoreFromBackupRequest{})
+	request := &tabletmanagerdatapb.RestoreFromBackupRequest{
+		RestoreToPos: restoreToPos,
+	}
+	if !restoreToTime.IsZero() {
+		request.RestoreToTime = logutil.TimeToProto(restoreToTime)
+	}
+	stream, err := c.RestoreFromBackup(ctx, request)
 	if err != nil {
 		cc.Close()
 		return nil, err
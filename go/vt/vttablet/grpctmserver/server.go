@@ -466,7 +466,7 @@ func (s *server) RestoreFromBackup(request *tabletmanagerdatapb.RestoreFromBacku
 		})
 	})
 
-	return s.tm.RestoreFromBackup(ctx, logger)
+	return s.tm.RestoreFromBackup(ctx, logger, request.RestoreToPos, logutil.ProtoToTime(request.RestoreToTime))
 }
 
 // Deprecated
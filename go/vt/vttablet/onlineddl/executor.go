@@ -0,0 +1,573 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package onlineddl runs the online schema migrations that are recorded
+// in _vt.schema_migrations. A migration creates a shadow table with the
+// new schema, populates it with a vreplication stream that copies the
+// original table and then follows the binlogs, and finally swaps the
+// shadow table in place of the original.
+package onlineddl
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/timer"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/throttler"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/withddl"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+var (
+	migrationCheckInterval = flag.Duration("online_ddl_check_interval", 10*time.Second, "interval at which vttablet reviews pending online schema migrations")
+	cutOverTimeout         = flag.Duration("online_ddl_cutover_timeout", 10*time.Second, "maximum time the original table is locked while an online schema migration is cut over")
+	cutOverAttempts        = flag.Int("online_ddl_cutover_attempts", 5, "number of times vttablet tries to cut over an online schema migration, one attempt per check interval, before the migration fails")
+)
+
+var withDDL = withddl.New(schema.CreateSchemaMigrationsTable())
+
+// VReplicator is the subset of the vreplication Engine used
+// by the Executor.
+type VReplicator interface {
+	Exec(query string) (*sqltypes.Result, error)
+	WaitForPos(ctx context.Context, id int, pos string) error
+}
+
+// Executor runs the online schema migrations of a master tablet.
+// Only one migration runs at a time. The others stay queued.
+type Executor struct {
+	// mu synchronizes isOpen, ctx and cancel.
+	mu     sync.Mutex
+	isOpen bool
+	ctx    context.Context
+	cancel context.CancelFunc
+	ticks  *timer.Timer
+
+	vre             VReplicator
+	mysqld          mysqlctl.MysqlDaemon
+	keyspace        string
+	shard           string
+	dbClientFactory func() binlogplayer.DBClient
+	dbName          string
+
+	// cutOverAttempts counts the failed cut-over attempts of each
+	// migration. It is only used by the ticks, which do not overlap.
+	cutOverAttempts map[string]int
+}
+
+// migration is a row of _vt.schema_migrations.
+type migration struct {
+	uuid           string
+	table          string
+	sql            string
+	status         schema.OnlineDDLStatus
+	strategy       schema.DDLStrategySetting
+	vreplicationID int64
+}
+
+// NewExecutor creates a new Executor.
+// A nil vre means that the Executor is disabled.
+func NewExecutor(vre VReplicator, mysqld mysqlctl.MysqlDaemon) *Executor {
+	return &Executor{
+		vre:    vre,
+		mysqld: mysqld,
+		ticks:  timer.NewTimer(*migrationCheckInterval),
+
+		cutOverAttempts: make(map[string]int),
+	}
+}
+
+// InitDBConfig should be invoked after the db name is computed.
+func (e *Executor) InitDBConfig(keyspace, shard string, dbcfgs *dbconfigs.DBConfigs) {
+	e.keyspace = keyspace
+	e.shard = shard
+	// If we're already initilized, it's a test executor. Ignore the call.
+	if e.dbClientFactory != nil {
+		return
+	}
+	e.dbClientFactory = func() binlogplayer.DBClient {
+		return binlogplayer.NewDBClient(dbcfgs.DbaWithDB())
+	}
+	e.dbName = dbcfgs.DBName
+}
+
+// NewTestExecutor creates a new Executor for testing.
+func NewTestExecutor(vre VReplicator, mysqld mysqlctl.MysqlDaemon, keyspace, shard string, dbClientFactory func() binlogplayer.DBClient, dbName string) *Executor {
+	return &Executor{
+		vre:             vre,
+		mysqld:          mysqld,
+		ticks:           timer.NewTimer(*migrationCheckInterval),
+		keyspace:        keyspace,
+		shard:           shard,
+		dbClientFactory: dbClientFactory,
+		dbName:          dbName,
+		cutOverAttempts: make(map[string]int),
+	}
+}
+
+// Open starts reviewing migrations.
+func (e *Executor) Open() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.vre == nil || e.isOpen {
+		return
+	}
+	log.Infof("OnlineDDL Executor: opening")
+	e.ctx, e.cancel = context.WithCancel(context.Background())
+	e.ticks.Start(e.onMigrationCheckTick)
+	e.isOpen = true
+}
+
+// Close stops reviewing migrations. A running migration is
+// resumed by the next Open, possibly on another tablet.
+func (e *Executor) Close() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if !e.isOpen {
+		return
+	}
+	e.cancel()
+	e.ticks.Stop()
+	e.isOpen = false
+	log.Infof("OnlineDDL Executor: closed")
+}
+
+func (e *Executor) onMigrationCheckTick() {
+	e.mu.Lock()
+	if !e.isOpen {
+		e.mu.Unlock()
+		return
+	}
+	ctx := e.ctx
+	e.mu.Unlock()
+
+	dbClient := e.dbClientFactory()
+	if err := dbClient.Connect(); err != nil {
+		log.Errorf("OnlineDDL: could not connect to mysql: %v", err)
+		return
+	}
+	defer dbClient.Close()
+	if err := e.reviewMigrations(ctx, dbClient); err != nil {
+		log.Errorf("OnlineDDL: error reviewing migrations: %v", err)
+	}
+}
+
+// reviewMigrations advances the state of every pending migration.
+func (e *Executor) reviewMigrations(ctx context.Context, dbClient binlogplayer.DBClient) error {
+	query := fmt.Sprintf("select migration_uuid, mysql_table, migration_statement, strategy, options, migration_status, vreplication_id from %s where migration_status in ('%s', '%s', '%s', '%s', '%s') order by id",
+		schema.SchemaMigrationsTable,
+		schema.OnlineDDLStatusQueued, schema.OnlineDDLStatusRunning, schema.OnlineDDLStatusReady,
+		schema.OnlineDDLStatusCutOver, schema.OnlineDDLStatusCancel)
+	qr, err := withDDL.Exec(ctx, query, dbClient.ExecuteFetch)
+	if err != nil {
+		return err
+	}
+	var migrations []*migration
+	busy := false
+	for _, row := range sqltypes.ToNamedResult(qr).Rows {
+		m := &migration{
+			uuid:   row.AsString("migration_uuid", ""),
+			table:  row.AsString("mysql_table", ""),
+			sql:    row.AsString("migration_statement", ""),
+			status: schema.OnlineDDLStatus(row.AsString("migration_status", "")),
+			strategy: schema.DDLStrategySetting{
+				Strategy: schema.DDLStrategy(row.AsString("strategy", "")),
+				Options:  row.AsString("options", ""),
+			},
+			vreplicationID: row.AsInt64("vreplication_id", 0),
+		}
+		switch m.status {
+		case schema.OnlineDDLStatusRunning, schema.OnlineDDLStatusReady, schema.OnlineDDLStatusCutOver:
+			busy = true
+		}
+		migrations = append(migrations, m)
+	}
+
+	for _, m := range migrations {
+		var err error
+		switch m.status {
+		case schema.OnlineDDLStatusCancel:
+			err = e.cancelMigration(dbClient, m)
+		case schema.OnlineDDLStatusRunning:
+			err = e.reviewRunningMigration(ctx, dbClient, m)
+		case schema.OnlineDDLStatusCutOver:
+			err = e.tryCutOver(ctx, dbClient, m)
+		case schema.OnlineDDLStatusQueued:
+			if busy {
+				continue
+			}
+			busy = true
+			err = e.startMigration(dbClient, m)
+		}
+		if err != nil {
+			log.Errorf("OnlineDDL: migration %s failed: %v", m.uuid, err)
+			if ferr := e.failMigration(dbClient, m, err); ferr != nil {
+				return ferr
+			}
+		}
+	}
+	return nil
+}
+
+// startMigration creates and alters the shadow table, and launches the
+// vreplication stream that populates it.
+func (e *Executor) startMigration(dbClient binlogplayer.DBClient, m *migration) error {
+	log.Infof("OnlineDDL: starting migration %s: %s", m.uuid, m.sql)
+	if err := e.updateMigration(dbClient, m, "migration_status='%s', started_timestamp=now(), message=''", schema.OnlineDDLStatusRunning); err != nil {
+		return err
+	}
+	m.status = schema.OnlineDDLStatusRunning
+
+	shadow := schema.ShadowTableName(m.uuid)
+	alter, err := schema.RewriteAlterTable(m.sql, shadow)
+	if err != nil {
+		return err
+	}
+	queries := []string{
+		fmt.Sprintf("drop table if exists %s", escapeName(shadow)),
+		fmt.Sprintf("create table %s like %s", escapeName(shadow), escapeName(m.table)),
+		alter,
+	}
+	for _, query := range queries {
+		if _, err := dbClient.ExecuteFetch(query, 0); err != nil {
+			return err
+		}
+	}
+
+	columns, err := e.sharedColumns(dbClient, m.table, shadow)
+	if err != nil {
+		return err
+	}
+	if len(columns) == 0 {
+		return fmt.Errorf("table %s and its altered version have no columns in common", m.table)
+	}
+	qr, err := e.vre.Exec(e.createVReplicationQuery(m, columns))
+	if err != nil {
+		return err
+	}
+	m.vreplicationID = int64(qr.InsertID)
+	return e.updateMigration(dbClient, m, "vreplication_id=%d", m.vreplicationID)
+}
+
+// sharedColumns returns the columns of the original table that remain
+// in the altered table, in the order of the original table.
+func (e *Executor) sharedColumns(dbClient binlogplayer.DBClient, table, shadow string) ([]string, error) {
+	query := fmt.Sprintf("select table_name, column_name from information_schema.columns where table_schema=%s and table_name in (%s, %s) order by ordinal_position",
+		encodeString(e.dbName), encodeString(table), encodeString(shadow))
+	qr, err := dbClient.ExecuteFetch(query, 10000)
+	if err != nil {
+		return nil, err
+	}
+	var original []string
+	altered := make(map[string]bool)
+	for _, row := range qr.Rows {
+		name := row[1].ToString()
+		if row[0].ToString() == shadow {
+			altered[strings.ToLower(name)] = true
+		} else {
+			original = append(original, name)
+		}
+	}
+	var shared []string
+	for _, name := range original {
+		if altered[strings.ToLower(name)] {
+			shared = append(shared, name)
+		}
+	}
+	return shared, nil
+}
+
+func (e *Executor) createVReplicationQuery(m *migration, columns []string) string {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("select ")
+	for i, col := range columns {
+		if i != 0 {
+			buf.Myprintf(", ")
+		}
+		buf.Myprintf("%v", sqlparser.NewColIdent(col))
+	}
+	buf.Myprintf(" from %v", sqlparser.NewTableIdent(m.table))
+	source := &binlogdatapb.BinlogSource{
+		Keyspace: e.keyspace,
+		Shard:    e.shard,
+		Filter: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:  schema.ShadowTableName(m.uuid),
+				Filter: buf.String(),
+			}},
+		},
+	}
+	// The stream reads from this tablet's own binlogs, so it must
+	// target the master rather than the default vreplication source.
+	return fmt.Sprintf("insert into _vt.vreplication "+
+		"(workflow, source, pos, max_tps, max_replication_lag, tablet_types, time_updated, transaction_timestamp, state, db_name) "+
+		"values (%s, %s, '', %v, %v, 'MASTER', %v, 0, '%s', %s)",
+		encodeString(schema.WorkflowName(m.uuid)), encodeString(source.String()),
+		throttler.MaxRateModuleDisabled, throttler.ReplicationLagModuleDisabled, time.Now().Unix(),
+		binlogplayer.BlpRunning, encodeString(e.dbName))
+}
+
+// reviewRunningMigration checks whether the copy phase of a migration
+// is complete. If so, the migration is cut over, or waits for a cut-over
+// command if it was submitted with -postpone-cutover. A migration whose
+// stream is in the Error state fails.
+func (e *Executor) reviewRunningMigration(ctx context.Context, dbClient binlogplayer.DBClient, m *migration) error {
+	if m.vreplicationID == 0 {
+		return fmt.Errorf("migration is running without a vreplication stream")
+	}
+	qr, err := dbClient.ExecuteFetch(binlogplayer.ReadVReplicationStatus(uint32(m.vreplicationID)), 1)
+	if err != nil {
+		return err
+	}
+	if len(qr.Rows) == 0 {
+		return fmt.Errorf("vreplication stream %d not found", m.vreplicationID)
+	}
+	if qr.Rows[0][1].ToString() == binlogplayer.BlpError {
+		return fmt.Errorf("vreplication stream %d failed: %s", m.vreplicationID, qr.Rows[0][2].ToString())
+	}
+	if qr.Rows[0][0].ToString() == "" {
+		// The stream has not started yet.
+		return nil
+	}
+	qr, err = dbClient.ExecuteFetch(fmt.Sprintf("select count(*) from _vt.copy_state where vrepl_id=%d", m.vreplicationID), 1)
+	if err != nil {
+		return err
+	}
+	if len(qr.Rows) != 1 {
+		return fmt.Errorf("unexpected result from copy_state: %v", qr.Rows)
+	}
+	if pending, err := evalengine.ToInt64(qr.Rows[0][0]); err != nil || pending != 0 {
+		return err
+	}
+	if m.strategy.IsPostponeCutOver() {
+		log.Infof("OnlineDDL: migration %s is ready for cut-over", m.uuid)
+		return e.updateMigration(dbClient, m, "migration_status='%s', ready_timestamp=now()", schema.OnlineDDLStatusReady)
+	}
+	return e.tryCutOver(ctx, dbClient, m)
+}
+
+// noRetryError is a cut-over error that another attempt cannot fix.
+type noRetryError struct {
+	error
+}
+
+// tryCutOver cuts over a migration. A failed attempt leaves the
+// migration in its current state, so that the next tick tries again.
+// The error is only returned, and the migration fails, if the attempt
+// cannot be retried or if it was the last one allowed by
+// -online_ddl_cutover_attempts.
+func (e *Executor) tryCutOver(ctx context.Context, dbClient binlogplayer.DBClient, m *migration) error {
+	err := e.cutOver(ctx, dbClient, m)
+	if err == nil {
+		delete(e.cutOverAttempts, m.uuid)
+		return nil
+	}
+	if nerr, ok := err.(noRetryError); ok {
+		return nerr.error
+	}
+	e.cutOverAttempts[m.uuid]++
+	attempts := e.cutOverAttempts[m.uuid]
+	if attempts >= *cutOverAttempts {
+		return fmt.Errorf("cut-over failed after %d attempts: %v", attempts, err)
+	}
+	log.Warningf("OnlineDDL: cut-over attempt %d of migration %s failed, will retry: %v", attempts, m.uuid, err)
+	message := fmt.Sprintf("cut-over attempt %d of %d failed: %v", attempts, *cutOverAttempts, err)
+	return e.updateMigration(dbClient, m, "message=%s", encodeString(binlogplayer.MessageTruncate(message)))
+}
+
+// cutOver swaps the shadow table in place of the original table.
+// The original table is kept as schema.OldTableName.
+//
+// The original table is locked while the stream catches up. To make
+// sure that no write sneaks in between the unlock and the swap, the
+// RENAME is issued by a second connection before the unlock, and blocks
+// on the lock. A sentry table that occupies the target name of the
+// original table guarantees that the RENAME fails if anything goes wrong
+// before the sentry is dropped under the lock.
+func (e *Executor) cutOver(ctx context.Context, dbClient binlogplayer.DBClient, m *migration) error {
+	log.Infof("OnlineDDL: cutting over migration %s", m.uuid)
+	shadow := escapeName(schema.ShadowTableName(m.uuid))
+	old := escapeName(schema.OldTableName(m.uuid))
+	table := escapeName(m.table)
+	id := uint32(m.vreplicationID)
+
+	lockConn := e.dbClientFactory()
+	if err := lockConn.Connect(); err != nil {
+		return err
+	}
+	defer lockConn.Close()
+	renameConn := e.dbClientFactory()
+	if err := renameConn.Connect(); err != nil {
+		return err
+	}
+	defer renameConn.Close()
+
+	if _, err := lockConn.ExecuteFetch(fmt.Sprintf("create table if not exists %s (id int primary key)", old), 0); err != nil {
+		return err
+	}
+	if _, err := lockConn.ExecuteFetch(fmt.Sprintf("lock tables %s write, %s write", table, old), 0); err != nil {
+		return err
+	}
+	locked := true
+	renameDone := make(chan error, 1)
+	stopped := false
+	defer func() {
+		if !locked {
+			return
+		}
+		// Something failed before the sentry was dropped.
+		// Releasing the lock lets the RENAME fail.
+		lockConn.ExecuteFetch("unlock tables", 0)
+		<-renameDone
+		lockConn.ExecuteFetch(fmt.Sprintf("drop table if exists %s", old), 0)
+		if stopped {
+			e.vre.Exec(binlogplayer.StartVReplication(id))
+		}
+	}()
+
+	go func() {
+		_, err := renameConn.ExecuteFetch(fmt.Sprintf("rename table %s to %s, %s to %s", table, old, shadow, table), 0)
+		renameDone <- err
+	}()
+
+	ctx, cancel := context.WithTimeout(ctx, *cutOverTimeout)
+	defer cancel()
+	if err := e.waitForRenameBlocked(ctx, lockConn, m, renameDone); err != nil {
+		return err
+	}
+
+	pos, err := e.mysqld.MasterPosition()
+	if err != nil {
+		return err
+	}
+	if err := e.vre.WaitForPos(ctx, int(id), mysql.EncodePosition(pos)); err != nil {
+		return err
+	}
+	if _, err := e.vre.Exec(binlogplayer.StopVReplication(id, "stopped for online DDL cut-over")); err != nil {
+		return err
+	}
+	stopped = true
+	if _, err := lockConn.ExecuteFetch(fmt.Sprintf("drop table %s", old), 0); err != nil {
+		return err
+	}
+	// From here on, the RENAME is bound to run first.
+	locked = false
+	if _, err := lockConn.ExecuteFetch("unlock tables", 0); err != nil {
+		log.Warningf("OnlineDDL: unlock tables failed: %v", err)
+	}
+	if err := <-renameDone; err != nil {
+		// The sentry is gone, and yet the RENAME failed: the tables
+		// are not in the state the cut-over expects.
+		return noRetryError{err}
+	}
+
+	if _, err := e.vre.Exec(binlogplayer.DeleteVReplication(id)); err != nil {
+		log.Warningf("OnlineDDL: could not delete vreplication stream %d: %v", id, err)
+	}
+	log.Infof("OnlineDDL: migration %s complete", m.uuid)
+	return e.updateMigration(dbClient, m, "migration_status='%s', completed_timestamp=now(), message=''", schema.OnlineDDLStatusComplete)
+}
+
+// waitForRenameBlocked waits until the RENAME issued by cutOver
+// is queued behind the table lock.
+func (e *Executor) waitForRenameBlocked(ctx context.Context, lockConn binlogplayer.DBClient, m *migration, renameDone chan error) error {
+	query := fmt.Sprintf("select count(*) from information_schema.processlist where state like 'Waiting for table metadata lock' and info like %s",
+		encodeString("rename table%"+schema.ShadowTableName(m.uuid)+"%"))
+	for {
+		qr, err := lockConn.ExecuteFetch(query, 1)
+		if err != nil {
+			return err
+		}
+		if len(qr.Rows) == 1 {
+			if n, _ := evalengine.ToInt64(qr.Rows[0][0]); n > 0 {
+				return nil
+			}
+		}
+		select {
+		case err := <-renameDone:
+			// Make the result available to the deferred cleanup.
+			renameDone <- err
+			return fmt.Errorf("rename did not wait for the table lock: %v", err)
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for rename to be blocked: %v", ctx.Err())
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+}
+
+// cancelMigration stops a pending migration and drops its shadow table.
+func (e *Executor) cancelMigration(dbClient binlogplayer.DBClient, m *migration) error {
+	log.Infof("OnlineDDL: cancelling migration %s", m.uuid)
+	delete(e.cutOverAttempts, m.uuid)
+	if err := e.cleanupMigration(dbClient, m); err != nil {
+		return err
+	}
+	return e.updateMigration(dbClient, m, "migration_status='%s', message='cancelled by user'", schema.OnlineDDLStatusCancelled)
+}
+
+// failMigration records the failure of a migration and cleans up
+// after it, so that it can be retried.
+func (e *Executor) failMigration(dbClient binlogplayer.DBClient, m *migration, failure error) error {
+	delete(e.cutOverAttempts, m.uuid)
+	if err := e.cleanupMigration(dbClient, m); err != nil {
+		log.Errorf("OnlineDDL: cleanup of migration %s failed: %v", m.uuid, err)
+	}
+	return e.updateMigration(dbClient, m, "migration_status='%s', message=%s", schema.OnlineDDLStatusFailed, encodeString(binlogplayer.MessageTruncate(failure.Error())))
+}
+
+func (e *Executor) cleanupMigration(dbClient binlogplayer.DBClient, m *migration) error {
+	if m.vreplicationID != 0 {
+		if _, err := e.vre.Exec(binlogplayer.DeleteVReplication(uint32(m.vreplicationID))); err != nil {
+			return err
+		}
+	}
+	_, err := dbClient.ExecuteFetch(fmt.Sprintf("drop table if exists %s", escapeName(schema.ShadowTableName(m.uuid))), 0)
+	return err
+}
+
+// updateMigration sets the columns of a migration described by the
+// format string set.
+func (e *Executor) updateMigration(dbClient binlogplayer.DBClient, m *migration, set string, args ...interface{}) error {
+	query := fmt.Sprintf("update %s set %s where migration_uuid=%s",
+		schema.SchemaMigrationsTable, fmt.Sprintf(set, args...), encodeString(m.uuid))
+	_, err := dbClient.ExecuteFetch(query, 0)
+	return err
+}
+
+func escapeName(name string) string {
+	return sqlparser.String(sqlparser.NewTableIdent(name))
+}
+
+func encodeString(in string) string {
+	var buf strings.Builder
+	sqltypes.NewVarChar(in).EncodeSQL(&buf)
+	return buf.String()
+}
@@ -0,0 +1,364 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onlineddl
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/mysqlctl/fakemysqldaemon"
+	"vitess.io/vitess/go/vt/schema"
+)
+
+const testUUID = "6c5d4e8a_1f2b_11eb_9c1d_0a43f95f28a3"
+
+type fakeVReplicator struct {
+	queries []string
+	nextID  uint64
+	waitErr error
+}
+
+func (vr *fakeVReplicator) Exec(query string) (*sqltypes.Result, error) {
+	vr.queries = append(vr.queries, query)
+	return &sqltypes.Result{InsertID: vr.nextID}, nil
+}
+
+func (vr *fakeVReplicator) WaitForPos(ctx context.Context, id int, pos string) error {
+	return vr.waitErr
+}
+
+func newTestExecutor(t *testing.T) (*Executor, *binlogplayer.MockDBClient, *fakeVReplicator) {
+	dbClient := binlogplayer.NewMockDBClient(t)
+	vr := &fakeVReplicator{nextID: 7}
+	e := NewTestExecutor(vr, fakemysqldaemon.NewFakeMysqlDaemon(nil), "ks", "0", func() binlogplayer.DBClient { return dbClient }, "vt_ks")
+	return e, dbClient, vr
+}
+
+func migrationsResult(rows ...string) *sqltypes.Result {
+	return sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"migration_uuid|mysql_table|migration_statement|strategy|options|migration_status|vreplication_id",
+			"varchar|varchar|varchar|varchar|varchar|varchar|int64"),
+		rows...)
+}
+
+func TestStartMigration(t *testing.T) {
+	e, dbClient, vr := newTestExecutor(t)
+
+	dbClient.ExpectRequestRE("select migration_uuid.* from _vt.schema_migrations", migrationsResult(
+		testUUID+"|t|alter table t drop column c2, add column c3 int|online||queued|0",
+	), nil)
+	dbClient.ExpectRequest("update _vt.schema_migrations set migration_status='running', started_timestamp=now(), message='' where migration_uuid='"+testUUID+"'", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest("drop table if exists _"+testUUID+"_shadow", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest("create table _"+testUUID+"_shadow like t", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest("alter table _"+testUUID+"_shadow drop column c2, add column c3 int", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequestRE("select table_name, column_name from information_schema.columns where table_schema='vt_ks'", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("table_name|column_name", "varchar|varchar"),
+		"t|id",
+		"_"+testUUID+"_shadow|id",
+		"t|c1",
+		"_"+testUUID+"_shadow|c1",
+		"t|c2",
+		"_"+testUUID+"_shadow|c3",
+	), nil)
+	dbClient.ExpectRequest("update _vt.schema_migrations set vreplication_id=7 where migration_uuid='"+testUUID+"'", &sqltypes.Result{}, nil)
+
+	require.NoError(t, e.reviewMigrations(context.Background(), dbClient))
+	dbClient.Wait()
+
+	require.Len(t, vr.queries, 1)
+	assert.Contains(t, vr.queries[0], "'onlineddl_"+testUUID+"'")
+	assert.Contains(t, vr.queries[0], `keyspace:\"ks\" shard:\"0\" filter:<rules:<match:\"_`+testUUID+`_shadow\" filter:\"select id, c1 from t\" > > `)
+	assert.Contains(t, vr.queries[0], "'MASTER'")
+}
+
+func TestStartMigrationWaitsForRunning(t *testing.T) {
+	e, dbClient, vr := newTestExecutor(t)
+
+	// The migration is copying. The queued migration must wait.
+	dbClient.ExpectRequestRE("select migration_uuid.* from _vt.schema_migrations", migrationsResult(
+		"aaaaaaaa_1f2b_11eb_9c1d_0a43f95f28a3|t2|alter table t2 add column c int|online||queued|0",
+		testUUID+"|t|alter table t add column c int|online||running|7",
+	), nil)
+	dbClient.ExpectRequest("select pos, state, message from _vt.vreplication where id=7", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("pos|state|message", "varchar|varchar|varchar"),
+		"MariaDB/0-1-1083|Running|",
+	), nil)
+	dbClient.ExpectRequest("select count(*) from _vt.copy_state where vrepl_id=7", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("count(*)", "int64"),
+		"1",
+	), nil)
+
+	require.NoError(t, e.reviewMigrations(context.Background(), dbClient))
+	dbClient.Wait()
+	assert.Empty(t, vr.queries)
+}
+
+func TestPostponedCutOver(t *testing.T) {
+	e, dbClient, _ := newTestExecutor(t)
+
+	dbClient.ExpectRequestRE("select migration_uuid.* from _vt.schema_migrations", migrationsResult(
+		testUUID+"|t|alter table t add column c int|online|-postpone-cutover|running|7",
+	), nil)
+	dbClient.ExpectRequest("select pos, state, message from _vt.vreplication where id=7", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("pos|state|message", "varchar|varchar|varchar"),
+		"MariaDB/0-1-1083|Running|",
+	), nil)
+	dbClient.ExpectRequest("select count(*) from _vt.copy_state where vrepl_id=7", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("count(*)", "int64"),
+		"0",
+	), nil)
+	dbClient.ExpectRequest("update _vt.schema_migrations set migration_status='ready', ready_timestamp=now() where migration_uuid='"+testUUID+"'", &sqltypes.Result{}, nil)
+
+	require.NoError(t, e.reviewMigrations(context.Background(), dbClient))
+	dbClient.Wait()
+}
+
+func TestCancelMigration(t *testing.T) {
+	e, dbClient, vr := newTestExecutor(t)
+
+	dbClient.ExpectRequestRE("select migration_uuid.* from _vt.schema_migrations", migrationsResult(
+		testUUID+"|t|alter table t add column c int|online||cancel|7",
+	), nil)
+	dbClient.ExpectRequest("drop table if exists _"+testUUID+"_shadow", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest("update _vt.schema_migrations set migration_status='cancelled', message='cancelled by user' where migration_uuid='"+testUUID+"'", &sqltypes.Result{}, nil)
+
+	require.NoError(t, e.reviewMigrations(context.Background(), dbClient))
+	dbClient.Wait()
+	assert.Equal(t, []string{"delete from _vt.vreplication where id=7"}, vr.queries)
+}
+
+func TestFailMigration(t *testing.T) {
+	e, dbClient, vr := newTestExecutor(t)
+
+	dbClient.ExpectRequestRE("select migration_uuid.* from _vt.schema_migrations", migrationsResult(
+		testUUID+"|t|alter table t add column c int|online||queued|0",
+	), nil)
+	dbClient.ExpectRequestRE("update _vt.schema_migrations set migration_status='running'", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest("drop table if exists _"+testUUID+"_shadow", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest("create table _"+testUUID+"_shadow like t", nil, fmt.Errorf("table t does not exist"))
+	dbClient.ExpectRequest("drop table if exists _"+testUUID+"_shadow", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest("update _vt.schema_migrations set migration_status='failed', message='table t does not exist' where migration_uuid='"+testUUID+"'", &sqltypes.Result{}, nil)
+
+	require.NoError(t, e.reviewMigrations(context.Background(), dbClient))
+	dbClient.Wait()
+	assert.Empty(t, vr.queries)
+}
+
+func TestStreamErrorFailsMigration(t *testing.T) {
+	e, dbClient, vr := newTestExecutor(t)
+
+	dbClient.ExpectRequestRE("select migration_uuid.* from _vt.schema_migrations", migrationsResult(
+		testUUID+"|t|alter table t add column c int|online||running|7",
+	), nil)
+	dbClient.ExpectRequest("select pos, state, message from _vt.vreplication where id=7", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("pos|state|message", "varchar|varchar|varchar"),
+		"MariaDB/0-1-1083|Error|duplicate key",
+	), nil)
+	dbClient.ExpectRequest("drop table if exists _"+testUUID+"_shadow", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest("update _vt.schema_migrations set migration_status='failed', message='vreplication stream 7 failed: duplicate key' where migration_uuid='"+testUUID+"'", &sqltypes.Result{}, nil)
+
+	require.NoError(t, e.reviewMigrations(context.Background(), dbClient))
+	dbClient.Wait()
+	assert.Equal(t, []string{"delete from _vt.vreplication where id=7"}, vr.queries)
+}
+
+// cutOverLockClient is the connection that holds the table lock during
+// cut-over. Unlocking the tables releases the blocked RENAME.
+type cutOverLockClient struct {
+	*binlogplayer.MockDBClient
+	renameIssued chan struct{}
+	unlocked     chan struct{}
+	unlockOnce   sync.Once
+}
+
+func (dc *cutOverLockClient) ExecuteFetch(query string, maxrows int) (*sqltypes.Result, error) {
+	switch {
+	case query == "unlock tables":
+		dc.unlockOnce.Do(func() { close(dc.unlocked) })
+	case strings.Contains(query, "information_schema.processlist"):
+		// The RENAME shows up in the processlist once it is issued.
+		<-dc.renameIssued
+	}
+	return dc.MockDBClient.ExecuteFetch(query, maxrows)
+}
+
+// cutOverRenameClient is the connection that issues the RENAME.
+// The RENAME blocks on the table lock, and fails with renameErr.
+type cutOverRenameClient struct {
+	binlogplayer.DBClient
+	renameIssued chan struct{}
+	unlocked     chan struct{}
+	renameErr    error
+
+	query          string
+	issuedUnlocked bool
+}
+
+func (dc *cutOverRenameClient) Connect() error {
+	return nil
+}
+
+func (dc *cutOverRenameClient) Close() {
+}
+
+func (dc *cutOverRenameClient) ExecuteFetch(query string, maxrows int) (*sqltypes.Result, error) {
+	dc.query = query
+	select {
+	case <-dc.unlocked:
+		dc.issuedUnlocked = true
+	default:
+	}
+	close(dc.renameIssued)
+	<-dc.unlocked
+	return &sqltypes.Result{}, dc.renameErr
+}
+
+func newCutOverTestExecutor(t *testing.T, renameErr error) (*Executor, *cutOverLockClient, *cutOverRenameClient, *fakeVReplicator) {
+	renameIssued := make(chan struct{})
+	unlocked := make(chan struct{})
+	lockConn := &cutOverLockClient{
+		MockDBClient: binlogplayer.NewMockDBClient(t),
+		renameIssued: renameIssued,
+		unlocked:     unlocked,
+	}
+	renameConn := &cutOverRenameClient{
+		renameIssued: renameIssued,
+		unlocked:     unlocked,
+		renameErr:    renameErr,
+	}
+	conns := []binlogplayer.DBClient{lockConn, renameConn}
+	vr := &fakeVReplicator{}
+	e := NewTestExecutor(vr, fakemysqldaemon.NewFakeMysqlDaemon(nil), "ks", "0", func() binlogplayer.DBClient {
+		conn := conns[0]
+		conns = conns[1:]
+		return conn
+	}, "vt_ks")
+	return e, lockConn, renameConn, vr
+}
+
+func TestCutOver(t *testing.T) {
+	e, lockConn, renameConn, vr := newCutOverTestExecutor(t, nil)
+	dbClient := binlogplayer.NewMockDBClient(t)
+	m := &migration{uuid: testUUID, table: "t", status: schema.OnlineDDLStatusCutOver, vreplicationID: 7}
+
+	lockConn.ExpectRequest("create table if not exists _"+testUUID+"_old (id int primary key)", &sqltypes.Result{}, nil)
+	lockConn.ExpectRequest("lock tables t write, _"+testUUID+"_old write", &sqltypes.Result{}, nil)
+	lockConn.ExpectRequestRE("select count.* from information_schema.processlist where state like 'Waiting for table metadata lock' and info like 'rename table%_"+testUUID+"_shadow%'", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("count(*)", "int64"),
+		"1",
+	), nil)
+	lockConn.ExpectRequest("drop table _"+testUUID+"_old", &sqltypes.Result{}, nil)
+	lockConn.ExpectRequest("unlock tables", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest("update _vt.schema_migrations set migration_status='complete', completed_timestamp=now(), message='' where migration_uuid='"+testUUID+"'", &sqltypes.Result{}, nil)
+
+	require.NoError(t, e.cutOver(context.Background(), dbClient, m))
+	lockConn.Wait()
+	dbClient.Wait()
+
+	// The RENAME was issued by the second connection while the tables were locked.
+	assert.Equal(t, "rename table t to _"+testUUID+"_old, _"+testUUID+"_shadow to t", renameConn.query)
+	assert.False(t, renameConn.issuedUnlocked)
+	assert.Equal(t, []string{
+		"update _vt.vreplication set state='Stopped', message='stopped for online DDL cut-over' where id=7",
+		"delete from _vt.vreplication where id=7",
+	}, vr.queries)
+}
+
+func TestCutOverCatchUpFailure(t *testing.T) {
+	renameErr := fmt.Errorf("table '_%s_old' already exists", testUUID)
+	e, lockConn, renameConn, vr := newCutOverTestExecutor(t, renameErr)
+	vr.waitErr = fmt.Errorf("context deadline exceeded")
+	dbClient := binlogplayer.NewMockDBClient(t)
+	m := &migration{uuid: testUUID, table: "t", status: schema.OnlineDDLStatusCutOver, vreplicationID: 7}
+
+	lockConn.ExpectRequest("create table if not exists _"+testUUID+"_old (id int primary key)", &sqltypes.Result{}, nil)
+	lockConn.ExpectRequest("lock tables t write, _"+testUUID+"_old write", &sqltypes.Result{}, nil)
+	lockConn.ExpectRequestRE("select count.* from information_schema.processlist", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("count(*)", "int64"),
+		"1",
+	), nil)
+	// The sentry table is still in place when the tables are unlocked,
+	// so the RENAME fails and the original table stays untouched.
+	lockConn.ExpectRequest("unlock tables", &sqltypes.Result{}, nil)
+	lockConn.ExpectRequest("drop table if exists _"+testUUID+"_old", &sqltypes.Result{}, nil)
+
+	err := e.cutOver(context.Background(), dbClient, m)
+	require.EqualError(t, err, "context deadline exceeded")
+	lockConn.Wait()
+
+	assert.Equal(t, "rename table t to _"+testUUID+"_old, _"+testUUID+"_shadow to t", renameConn.query)
+	assert.Empty(t, vr.queries)
+}
+
+func expectFailedCutOver(lockConn *cutOverLockClient) {
+	lockConn.ExpectRequest("create table if not exists _"+testUUID+"_old (id int primary key)", &sqltypes.Result{}, nil)
+	lockConn.ExpectRequest("lock tables t write, _"+testUUID+"_old write", &sqltypes.Result{}, nil)
+	lockConn.ExpectRequestRE("select count.* from information_schema.processlist", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("count(*)", "int64"),
+		"1",
+	), nil)
+	lockConn.ExpectRequest("unlock tables", &sqltypes.Result{}, nil)
+	lockConn.ExpectRequest("drop table if exists _"+testUUID+"_old", &sqltypes.Result{}, nil)
+}
+
+func TestCutOverRetry(t *testing.T) {
+	e, lockConn, _, vr := newCutOverTestExecutor(t, fmt.Errorf("table '_%s_old' already exists", testUUID))
+	vr.waitErr = fmt.Errorf("context deadline exceeded")
+	dbClient := binlogplayer.NewMockDBClient(t)
+
+	dbClient.ExpectRequestRE("select migration_uuid.* from _vt.schema_migrations", migrationsResult(
+		testUUID+"|t|alter table t add column c int|online||cutover|7",
+	), nil)
+	expectFailedCutOver(lockConn)
+	// The migration is left in the cutover state for the next tick.
+	dbClient.ExpectRequest("update _vt.schema_migrations set message='cut-over attempt 1 of 5 failed: context deadline exceeded' where migration_uuid='"+testUUID+"'", &sqltypes.Result{}, nil)
+
+	require.NoError(t, e.reviewMigrations(context.Background(), dbClient))
+	lockConn.Wait()
+	dbClient.Wait()
+	assert.Empty(t, vr.queries)
+	assert.Equal(t, 1, e.cutOverAttempts[testUUID])
+}
+
+func TestCutOverLastAttemptFailsMigration(t *testing.T) {
+	e, lockConn, _, vr := newCutOverTestExecutor(t, fmt.Errorf("table '_%s_old' already exists", testUUID))
+	vr.waitErr = fmt.Errorf("context deadline exceeded")
+	e.cutOverAttempts[testUUID] = *cutOverAttempts - 1
+	dbClient := binlogplayer.NewMockDBClient(t)
+
+	dbClient.ExpectRequestRE("select migration_uuid.* from _vt.schema_migrations", migrationsResult(
+		testUUID+"|t|alter table t add column c int|online||cutover|7",
+	), nil)
+	expectFailedCutOver(lockConn)
+	dbClient.ExpectRequest("drop table if exists _"+testUUID+"_shadow", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest("update _vt.schema_migrations set migration_status='failed', message='cut-over failed after 5 attempts: context deadline exceeded' where migration_uuid='"+testUUID+"'", &sqltypes.Result{}, nil)
+
+	require.NoError(t, e.reviewMigrations(context.Background(), dbClient))
+	lockConn.Wait()
+	dbClient.Wait()
+	assert.Equal(t, []string{"delete from _vt.vreplication where id=7"}, vr.queries)
+	assert.NotContains(t, e.cutOverAttempts, testUUID)
+}
@@ -94,6 +94,12 @@ type SandboxConn struct {
 	VStreamEvents [][]*binlogdatapb.VEvent
 	VStreamErrors []error
 
+	// vstreamRequests stores the VStream requests received.
+	// It's protected by vstreamMu because tests read it while
+	// streams are running.
+	vstreamMu       sync.Mutex
+	vstreamRequests []*binlogdatapb.VStreamRequest
+
 	// transaction id generator
 	TransactionID sync2.AtomicInt64
 
@@ -393,8 +399,23 @@ func (sbc *SandboxConn) AddVStreamEvents(events []*binlogdatapb.VEvent, err erro
 	sbc.VStreamErrors = append(sbc.VStreamErrors, err)
 }
 
+// VStreamRequests returns the VStream requests received so far.
+func (sbc *SandboxConn) VStreamRequests() []*binlogdatapb.VStreamRequest {
+	sbc.vstreamMu.Lock()
+	defer sbc.vstreamMu.Unlock()
+	return append([]*binlogdatapb.VStreamRequest(nil), sbc.vstreamRequests...)
+}
+
 // VStream is part of the QueryService interface.
 func (sbc *SandboxConn) VStream(ctx context.Context, target *querypb.Target, startPos string, tablePKs []*binlogdatapb.TableLastPK, filter *binlogdatapb.Filter, send func([]*binlogdatapb.VEvent) error) error {
+	sbc.vstreamMu.Lock()
+	sbc.vstreamRequests = append(sbc.vstreamRequests, &binlogdatapb.VStreamRequest{
+		Target:       target,
+		Position:     startPos,
+		Filter:       filter,
+		TableLastPKs: tablePKs,
+	})
+	sbc.vstreamMu.Unlock()
 	if sbc.StartPos != "" && sbc.StartPos != startPos {
 		return fmt.Errorf("startPos(%v): %v, want %v", target, startPos, sbc.StartPos)
 	}
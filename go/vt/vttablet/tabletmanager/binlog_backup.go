@@ -0,0 +1,129 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"flag"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/timer"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+var (
+	binlogBackupInterval = flag.Duration("binlog_backup_interval", 0, "if greater than 0, a master tablet rotates its binlogs and uploads the closed binlog files to the backup storage at this interval, for point in time recovery with RestoreFromBackup -restore_to_time or -restore_to_pos")
+	binlogBackupTimeout  = flag.Duration("binlog_backup_timeout", 1*time.Hour, "timeout of the upload of one binlog file to the backup storage, see -binlog_backup_interval")
+
+	statsBinlogBackupFiles  = stats.NewCounter("BinlogBackupFiles", "Number of binlog files uploaded to the backup storage")
+	statsBinlogBackupErrors = stats.NewCounter("BinlogBackupErrors", "Number of failed binlog backup runs")
+)
+
+// binlogBackupManager periodically uploads the binlog files of
+// a master tablet to the backup storage. SetTabletType must be
+// called on every tablet type change.
+type binlogBackupManager struct {
+	ctx      context.Context
+	tm       *TabletManager
+	interval time.Duration
+	ticks    *timer.Timer
+
+	// lastPos is the master position at the last binlog rotation.
+	// It is only accessed by the timer goroutine.
+	lastPos mysql.Position
+}
+
+func newBinlogBackupManager(ctx context.Context, tm *TabletManager, interval time.Duration) *binlogBackupManager {
+	return &binlogBackupManager{
+		ctx:      ctx,
+		tm:       tm,
+		interval: interval,
+		ticks:    timer.NewTimer(interval),
+	}
+}
+
+func (bm *binlogBackupManager) SetTabletType(tabletType topodatapb.TabletType) {
+	if bm.interval == 0 {
+		return
+	}
+	if tabletType != topodatapb.TabletType_MASTER {
+		if bm.ticks.Running() {
+			log.Info("Binlog backups: stopping")
+			bm.ticks.Stop()
+		}
+		return
+	}
+	if bm.ticks.Running() {
+		return
+	}
+	log.Info("Binlog backups: starting")
+	bm.ticks.Start(bm.backup)
+}
+
+// Close stops the binlog backups.
+func (bm *binlogBackupManager) Close() {
+	bm.ticks.Stop()
+}
+
+func (bm *binlogBackupManager) backup() {
+	// The uploads are not bounded by the interval: the timer waits
+	// for the end of a run before starting the next one, and each
+	// binlog file has its own timeout.
+	ctx := bm.ctx
+
+	// Close the binlog file mysqld is writing to, so the transactions up
+	// to now get uploaded. This is skipped if there was no transaction,
+	// not to upload empty binlog files.
+	pos, err := bm.tm.MysqlDaemon.MasterPosition()
+	if err != nil {
+		statsBinlogBackupErrors.Add(1)
+		log.Errorf("Binlog backups: can't get master position: %v", err)
+		return
+	}
+	if !pos.Equal(bm.lastPos) {
+		if err := bm.tm.MysqlDaemon.ExecuteSuperQueryList(ctx, []string{"FLUSH BINARY LOGS"}); err != nil {
+			statsBinlogBackupErrors.Add(1)
+			log.Errorf("Binlog backups: can't rotate binlogs: %v", err)
+			return
+		}
+		bm.lastPos = pos
+	}
+
+	tablet := bm.tm.Tablet()
+	uploaded, err := mysqlctl.BackupBinlogs(ctx, mysqlctl.BinlogBackupParams{
+		Cnf:         bm.tm.Cnf,
+		Mysqld:      bm.tm.MysqlDaemon,
+		Logger:      logutil.NewConsoleLogger(),
+		Keyspace:    tablet.Keyspace,
+		Shard:       tablet.Shard,
+		TabletAlias: topoproto.TabletAliasString(tablet.Alias),
+		FileTimeout: *binlogBackupTimeout,
+	})
+	statsBinlogBackupFiles.Add(int64(uploaded))
+	if err != nil {
+		statsBinlogBackupErrors.Add(1)
+		log.Errorf("Binlog backups: %v", err)
+	}
+}
@@ -72,10 +72,14 @@ func (tm *TabletManager) RestoreData(ctx context.Context, logger logutil.Logger,
 	if tm.Cnf == nil {
 		return fmt.Errorf("cannot perform restore without my.cnf, please restart vttablet with a my.cnf file specified")
 	}
-	return tm.restoreDataLocked(ctx, logger, waitForBackupInterval, deleteBeforeRestore)
+	return tm.restoreDataLocked(ctx, logger, waitForBackupInterval, deleteBeforeRestore, mysql.Position{}, time.Time{})
 }
 
-func (tm *TabletManager) restoreDataLocked(ctx context.Context, logger logutil.Logger, waitForBackupInterval time.Duration, deleteBeforeRestore bool) error {
+// restoreDataLocked restores the latest backup. If restoreToPos or restoreToTime
+// is set, it is a point in time recovery: the binlog backups are replayed on top
+// of the latest backup before that point, and the tablet ends up DRAINED, not
+// replicating, as replicating would move it past that point.
+func (tm *TabletManager) restoreDataLocked(ctx context.Context, logger logutil.Logger, waitForBackupInterval time.Duration, deleteBeforeRestore bool, restoreToPos mysql.Position, restoreToTime time.Time) error {
 	tablet := tm.Tablet()
 	originalType := tablet.Type
 	if err := tm.tmState.ChangeTabletType(ctx, topodatapb.TabletType_RESTORE); err != nil {
@@ -115,7 +119,10 @@ func (tm *TabletManager) restoreDataLocked(ctx context.Context, logger logutil.L
 		Keyspace:            keyspace,
 		Shard:               tablet.Shard,
 		StartTime:           logutil.ProtoToTime(keyspaceInfo.SnapshotTime),
+		RestoreToPos:        restoreToPos,
+		RestoreToTime:       restoreToTime,
 	}
+	pointInTime := !restoreToPos.IsZero() || !restoreToTime.IsZero()
 
 	// Loop until a backup exists, unless we were told to give up immediately.
 	var backupManifest *mysqlctl.BackupManifest
@@ -153,7 +160,9 @@ func (tm *TabletManager) restoreDataLocked(ctx context.Context, logger logutil.L
 	case nil:
 		// Starting from here we won't be able to recover if we get stopped by a cancelled
 		// context. Thus we use the background context to get through to the finish.
-		if keyspaceInfo.KeyspaceType == topodatapb.KeyspaceType_NORMAL {
+		if pointInTime {
+			log.Infof("Restored to position %v, not starting replication", pos)
+		} else if keyspaceInfo.KeyspaceType == topodatapb.KeyspaceType_NORMAL {
 			// Reconnect to master only for "NORMAL" keyspaces
 			if err := tm.startReplication(context.Background(), pos, originalType); err != nil {
 				return err
@@ -183,6 +192,11 @@ func (tm *TabletManager) restoreDataLocked(ctx context.Context, logger logutil.L
 		}
 	}
 
+	// A point in time recovery is not serving up to date data.
+	if pointInTime && err == nil {
+		originalType = topodatapb.TabletType_DRAINED
+	}
+
 	// Change type back to original type if we're ok to serve.
 	return tm.tmState.ChangeTabletType(ctx, originalType)
 }
@@ -155,7 +155,7 @@ type RPCTM interface {
 
 	Backup(ctx context.Context, concurrency int, logger logutil.Logger, allowMaster bool) error
 
-	RestoreFromBackup(ctx context.Context, logger logutil.Logger) error
+	RestoreFromBackup(ctx context.Context, logger logutil.Logger, restoreToPos string, restoreToTime time.Time) error
 
 	// HandleRPCPanic is to be called in a defer statement in each
 	// RPC input point.
@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"golang.org/x/net/context"
+	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/mysqlctl"
 	"vitess.io/vitess/go/vt/topo/topoproto"
@@ -129,7 +130,17 @@ func (tm *TabletManager) Backup(ctx context.Context, concurrency int, logger log
 }
 
 // RestoreFromBackup deletes all local data and restores anew from the latest backup.
-func (tm *TabletManager) RestoreFromBackup(ctx context.Context, logger logutil.Logger) error {
+// If restoreToPos or restoreToTime is set, the binlog backups are replayed on top
+// of the latest backup before that point, up to it.
+func (tm *TabletManager) RestoreFromBackup(ctx context.Context, logger logutil.Logger, restoreToPos string, restoreToTime time.Time) error {
+	var pos mysql.Position
+	if restoreToPos != "" {
+		var err error
+		if pos, err = mysql.DecodePosition(restoreToPos); err != nil {
+			return vterrors.Wrapf(err, "invalid restore position %v", restoreToPos)
+		}
+	}
+
 	if err := tm.lock(ctx); err != nil {
 		return err
 	}
@@ -147,7 +158,7 @@ func (tm *TabletManager) RestoreFromBackup(ctx context.Context, logger logutil.L
 	l := logutil.NewTeeLogger(logutil.NewConsoleLogger(), logger)
 
 	// now we can run restore
-	err = tm.restoreDataLocked(ctx, l, 0 /* waitForBackupInterval */, true /* deleteBeforeRestore */)
+	err = tm.restoreDataLocked(ctx, l, 0 /* waitForBackupInterval */, true /* deleteBeforeRestore */, pos, restoreToTime)
 
 	// re-run health check to be sure to capture any replication delay
 	tm.QueryServiceControl.BroadcastHealth()
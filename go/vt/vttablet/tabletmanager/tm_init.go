@@ -60,6 +60,7 @@ import (
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/topotools"
+	"vitess.io/vitess/go/vt/vttablet/onlineddl"
 	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver"
 
@@ -127,6 +128,7 @@ type TabletManager struct {
 	QueryServiceControl tabletserver.Controller
 	UpdateStream        binlog.UpdateStreamControl
 	VREngine            *vreplication.Engine
+	OnlineDDLExecutor   *onlineddl.Executor
 
 	// tmState manages the TabletManager state.
 	tmState *tmState
@@ -134,6 +136,9 @@ type TabletManager struct {
 	// replManager manages replication.
 	replManager *replManager
 
+	// binlogBackups uploads the binlogs of a master tablet.
+	binlogBackups *binlogBackupManager
+
 	// tabletAlias is saved away from tablet for read-only access
 	tabletAlias *topodatapb.TabletAlias
 
@@ -231,6 +236,7 @@ func BuildTabletFromInput(alias *topodatapb.TabletAlias, port, grpcPort int32) (
 func (tm *TabletManager) Start(tablet *topodatapb.Tablet, healthCheckInterval time.Duration) error {
 	tm.DBConfigs.DBName = topoproto.TabletDbName(tablet)
 	tm.replManager = newReplManager(tm.BatchCtx, tm, healthCheckInterval)
+	tm.binlogBackups = newBinlogBackupManager(tm.BatchCtx, tm, *binlogBackupInterval)
 	tm.tabletAlias = tablet.Alias
 	tm.tmState = newTMState(tm, tablet)
 	tm.actionSema = sync2.NewSemaphore(1, 0)
@@ -281,6 +287,11 @@ func (tm *TabletManager) Start(tablet *topodatapb.Tablet, healthCheckInterval ti
 		servenv.OnTerm(tm.VREngine.Close)
 	}
 
+	if tm.OnlineDDLExecutor != nil {
+		tm.OnlineDDLExecutor.InitDBConfig(tablet.Keyspace, tablet.Shard, tm.DBConfigs)
+		servenv.OnTerm(tm.OnlineDDLExecutor.Close)
+	}
+
 	// The following initializations don't need to be done
 	// in any specific order.
 	tm.startShardSync()
@@ -317,6 +328,7 @@ func (tm *TabletManager) Close() {
 	// rather than registering it as an OnTerm hook so the shard sync loop keeps
 	// running during lame duck.
 	tm.stopShardSync()
+	tm.binlogBackups.Close()
 
 	// cleanup initialized fields in the tablet entry
 	f := func(tablet *topodatapb.Tablet) error {
@@ -347,11 +359,16 @@ func (tm *TabletManager) Stop() {
 	// Stop the shard sync loop and wait for it to exit. This needs to be done
 	// here in addition to in Close() because tests do not call Close().
 	tm.stopShardSync()
+	tm.binlogBackups.Close()
 
 	if tm.UpdateStream != nil {
 		tm.UpdateStream.Disable()
 	}
 
+	if tm.OnlineDDLExecutor != nil {
+		tm.OnlineDDLExecutor.Close()
+	}
+
 	if tm.VREngine != nil {
 		tm.VREngine.Close()
 	}
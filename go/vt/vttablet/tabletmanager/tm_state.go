@@ -223,6 +223,7 @@ func (ts *tmState) updateLocked(ctx context.Context) {
 	}
 
 	ts.tm.replManager.SetTabletType(ts.tablet.Type)
+	ts.tm.binlogBackups.SetTabletType(ts.tablet.Type)
 
 	if ts.tm.UpdateStream != nil {
 		if topo.IsRunningUpdateStream(ts.tablet.Type) {
@@ -240,6 +241,14 @@ func (ts *tmState) updateLocked(ctx context.Context) {
 		}
 	}
 
+	if ts.tm.OnlineDDLExecutor != nil {
+		if ts.tablet.Type == topodatapb.TabletType_MASTER {
+			ts.tm.OnlineDDLExecutor.Open()
+		} else {
+			ts.tm.OnlineDDLExecutor.Close()
+		}
+	}
+
 	// Open TabletServer last so that it advertises serving after all other services are up.
 	if reason == "" {
 		if err := ts.tm.QueryServiceControl.SetServingType(ts.tablet.Type, terTime, true, ""); err != nil {
@@ -60,6 +60,18 @@ const (
   table_name varbinary(128),
   lastpk varbinary(2000),
   primary key (vrepl_id, table_name))`
+
+	// createVReplicationLog creates the table that records notable
+	// events of the streams, like the DDLs they applied.
+	createVReplicationLog = `create table if not exists _vt.vreplication_log (
+  id bigint auto_increment,
+  vrepl_id int not null,
+  type varbinary(256) not null,
+  state varbinary(100) not null,
+  message text not null,
+  time_created bigint not null,
+  primary key (id),
+  key vrepl_id_idx (vrepl_id))`
 )
 
 var withDDL *withddl.WithDDL
@@ -67,7 +79,7 @@ var withDDL *withddl.WithDDL
 func init() {
 	allddls := append([]string{}, binlogplayer.CreateVReplicationTable()...)
 	allddls = append(allddls, binlogplayer.AlterVReplicationTable...)
-	allddls = append(allddls, createReshardingJournalTable, createCopyState)
+	allddls = append(allddls, createReshardingJournalTable, createCopyState, createVReplicationLog)
 	withDDL = withddl.New(allddls)
 }
 
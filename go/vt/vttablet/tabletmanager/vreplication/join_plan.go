@@ -0,0 +1,419 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"fmt"
+
+	"vitess.io/vitess/go/sqltypes"
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// A join between two source tables is materialized through helper tables.
+// Every source table of the join is copied as is into its helper table
+// on the target. When a row of a source table changes, the target rows
+// that have the join key of the row are deleted and derived again by
+// running the join against the helper tables.
+
+// JoinPlan re-derives the rows of the target table of a join
+// when a row of one of its source tables changes.
+type JoinPlan struct {
+	// TargetName is the target table of the join.
+	TargetName string
+	// KeyReferences are the join key columns of the source table.
+	KeyReferences []string
+	// DeleteBefore and DeleteAfter delete the target rows that
+	// have the join key of the before and the after row.
+	DeleteBefore *sqlparser.ParsedQuery
+	DeleteAfter  *sqlparser.ParsedQuery
+	// InsertBefore and InsertAfter derive the target rows that
+	// have the join key of the before and the after row.
+	InsertBefore *sqlparser.ParsedQuery
+	InsertAfter  *sqlparser.ParsedQuery
+}
+
+// JoinHelper describes a helper table of a materialized join.
+// It must have the same columns and primary key as the source table.
+type JoinHelper struct {
+	// Table is the name of the helper table.
+	Table string
+	// SourceTable is the source table copied into the helper table.
+	SourceTable string
+}
+
+// JoinHelperTable returns the name of the helper table
+// of a source table of the join materialized into targetTable.
+// The prefix keeps it apart from the helper tables of aggregates.
+func JoinHelperTable(targetTable, sourceTable string) string {
+	return fmt.Sprintf("_vt_join_%s__%s", targetTable, sourceTable)
+}
+
+// JoinHelpers returns the helper tables needed to materialize
+// the target table with the filter. It returns nil if the
+// filter is not a join.
+func JoinHelpers(targetTable, filter string) ([]*JoinHelper, error) {
+	sel, ok := joinSelect(filter)
+	if !ok {
+		return nil, nil
+	}
+	sides, err := analyzeJoin(sel)
+	if err != nil {
+		return nil, err
+	}
+	var helpers []*JoinHelper
+	for _, side := range sides {
+		helpers = append(helpers, &JoinHelper{
+			Table:       JoinHelperTable(targetTable, side.table),
+			SourceTable: side.table,
+		})
+	}
+	return helpers, nil
+}
+
+// joinSide is a source table of a join.
+type joinSide struct {
+	// table is the name of the source table.
+	table string
+	// alias is the name the query uses for the table.
+	alias sqlparser.TableIdent
+	// keys are the columns of the table in the join condition.
+	keys []sqlparser.ColIdent
+}
+
+// joinSelect returns the select statement of filter
+// if it's a join with an on condition.
+func joinSelect(filter string) (*sqlparser.Select, bool) {
+	stmt, err := sqlparser.Parse(filter)
+	if err != nil {
+		return nil, false
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok || len(sel.From) != 1 {
+		return nil, false
+	}
+	join, ok := sel.From[0].(*sqlparser.JoinTableExpr)
+	if !ok || join.Condition.On == nil {
+		return nil, false
+	}
+	return sel, true
+}
+
+// analyzeJoin validates that sel is an equi-join of two
+// tables, and returns the two sides of the join.
+func analyzeJoin(sel *sqlparser.Select) ([]*joinSide, error) {
+	join := sel.From[0].(*sqlparser.JoinTableExpr)
+	if join.Join != sqlparser.JoinStr {
+		return nil, fmt.Errorf("only inner joins are supported: %v", sqlparser.String(join))
+	}
+	if sel.Distinct || sel.GroupBy != nil || sel.Having != nil || sel.OrderBy != nil || sel.Limit != nil {
+		return nil, fmt.Errorf("unsupported clause in join: %v", sqlparser.String(sel))
+	}
+	var sides []*joinSide
+	for _, expr := range []sqlparser.TableExpr{join.LeftExpr, join.RightExpr} {
+		aliased, ok := expr.(*sqlparser.AliasedTableExpr)
+		if !ok {
+			return nil, fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+		}
+		tableName := sqlparser.GetTableName(aliased.Expr)
+		if tableName.IsEmpty() {
+			return nil, fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+		}
+		side := &joinSide{table: tableName.String(), alias: aliased.As}
+		if side.alias.IsEmpty() {
+			side.alias = tableName
+		}
+		sides = append(sides, side)
+	}
+	if sides[0].alias.String() == sides[1].alias.String() {
+		return nil, fmt.Errorf("tables of a join must have different names: %v", sqlparser.String(join))
+	}
+	findSide := func(col *sqlparser.ColName) (*joinSide, error) {
+		for _, side := range sides {
+			if col.Qualifier.Qualifier.IsEmpty() && col.Qualifier.Name.String() == side.alias.String() {
+				return side, nil
+			}
+		}
+		return nil, fmt.Errorf("column must be qualified by a table of the join: %v", sqlparser.String(col))
+	}
+
+	for _, cond := range sqlparser.SplitAndExpression(nil, join.Condition.On) {
+		cmp, ok := cond.(*sqlparser.ComparisonExpr)
+		if !ok || cmp.Operator != sqlparser.EqualStr {
+			return nil, fmt.Errorf("join condition must compare columns for equality: %v", sqlparser.String(cond))
+		}
+		left, lok := cmp.Left.(*sqlparser.ColName)
+		right, rok := cmp.Right.(*sqlparser.ColName)
+		if !lok || !rok {
+			return nil, fmt.Errorf("join condition must compare columns for equality: %v", sqlparser.String(cond))
+		}
+		lside, err := findSide(left)
+		if err != nil {
+			return nil, err
+		}
+		rside, err := findSide(right)
+		if err != nil {
+			return nil, err
+		}
+		if lside == rside {
+			return nil, fmt.Errorf("join condition must compare columns of both tables: %v", sqlparser.String(cond))
+		}
+		lside.keys = append(lside.keys, left.Name)
+		rside.keys = append(rside.keys, right.Name)
+	}
+
+	for _, selExpr := range sel.SelectExprs {
+		aliased, ok := selExpr.(*sqlparser.AliasedExpr)
+		if !ok {
+			return nil, fmt.Errorf("unexpected: %v", sqlparser.String(selExpr))
+		}
+		if _, ok := aliased.Expr.(*sqlparser.ColName); !ok && aliased.As.IsEmpty() {
+			return nil, fmt.Errorf("expression needs an alias: %v", sqlparser.String(aliased))
+		}
+	}
+	nodes := []sqlparser.SQLNode{sel.SelectExprs}
+	if sel.Where != nil {
+		nodes = append(nodes, sel.Where.Expr)
+	}
+	err := sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.ColName:
+			if _, err := findSide(node); err != nil {
+				return false, err
+			}
+		case *sqlparser.FuncExpr:
+			if node.IsAggregate() {
+				return false, fmt.Errorf("aggregates are not supported in joins: %v", sqlparser.String(node))
+			}
+			if node.Name.EqualString("in_keyrange") {
+				return false, fmt.Errorf("in_keyrange is not supported in joins: %v", sqlparser.String(node))
+			}
+		case *sqlparser.Subquery:
+			return false, fmt.Errorf("subqueries are not supported in joins: %v", sqlparser.String(node))
+		}
+		return true, nil
+	}, nodes...)
+	if err != nil {
+		return nil, err
+	}
+	return sides, nil
+}
+
+// buildJoinPlans builds the plans that materialize the join
+// into targetTable, one for each source table of the join.
+func buildJoinPlans(targetTable string, sel *sqlparser.Select, pkInfoMap map[string][]*PrimaryKeyInfo, copyState map[string]*sqltypes.Result) ([]*TablePlan, error) {
+	sides, err := analyzeJoin(sel)
+	if err != nil {
+		return nil, err
+	}
+	pkInfos, ok := pkInfoMap[targetTable]
+	if !ok {
+		return nil, fmt.Errorf("table %s not found in schema", targetTable)
+	}
+	// The target rows are identified by their primary key,
+	// whose values must be in the select list.
+	var pkCols []sqlparser.ColIdent
+	var pkExprs sqlparser.SelectExprs
+	for _, pkInfo := range pkInfos {
+		pkCol := sqlparser.NewColIdent(pkInfo.Name)
+		expr := findJoinExpr(sel, pkCol)
+		if expr == nil {
+			return nil, fmt.Errorf("primary key column %v not found in select list", pkInfo.Name)
+		}
+		pkCols = append(pkCols, pkCol)
+		pkExprs = append(pkExprs, &sqlparser.AliasedExpr{Expr: expr})
+	}
+
+	// The join runs against the helper tables, using
+	// the names of the query for the tables.
+	join := sel.From[0].(*sqlparser.JoinTableExpr)
+	helperJoin := &sqlparser.JoinTableExpr{
+		Join:      join.Join,
+		Condition: join.Condition,
+	}
+	helperExprs := make([]sqlparser.TableExpr, 0, 2)
+	for _, side := range sides {
+		helperExprs = append(helperExprs, &sqlparser.AliasedTableExpr{
+			Expr: sqlparser.TableName{Name: sqlparser.NewTableIdent(JoinHelperTable(targetTable, side.table))},
+			As:   side.alias,
+		})
+	}
+	helperJoin.LeftExpr, helperJoin.RightExpr = helperExprs[0], helperExprs[1]
+
+	var plans []*TablePlan
+	for _, side := range sides {
+		helperTable := JoinHelperTable(targetTable, side.table)
+		lastpk, ok := copyState[helperTable]
+		if ok && lastpk == nil {
+			// Don't replicate uncopied tables.
+			continue
+		}
+		jb := &joinPlanBuilder{
+			target:  sqlparser.NewTableIdent(targetTable),
+			sel:     sel,
+			join:    helperJoin,
+			pkCols:  pkCols,
+			pkExprs: pkExprs,
+			side:    side,
+		}
+		var keyrefs []string
+		for _, key := range side.keys {
+			keyrefs = append(keyrefs, key.String())
+		}
+		buf := sqlparser.NewTrackedBuffer(nil)
+		buf.Myprintf("select * from %v", sqlparser.NewTableIdent(side.table))
+		plans = append(plans, &TablePlan{
+			TargetName: helperTable,
+			SendRule: &binlogdatapb.Rule{
+				Match:  side.table,
+				Filter: buf.String(),
+			},
+			Lastpk: lastpk,
+			Join: &JoinPlan{
+				TargetName:    targetTable,
+				KeyReferences: keyrefs,
+				DeleteBefore:  jb.generateDelete("b_"),
+				DeleteAfter:   jb.generateDelete("a_"),
+				InsertBefore:  jb.generateInsert("b_"),
+				InsertAfter:   jb.generateInsert("a_"),
+			},
+		})
+	}
+	return plans, nil
+}
+
+// findJoinExpr returns the expression of the select list of a join
+// that's stored in the column col of the target table.
+func findJoinExpr(sel *sqlparser.Select, col sqlparser.ColIdent) sqlparser.Expr {
+	for _, selExpr := range sel.SelectExprs {
+		aliased := selExpr.(*sqlparser.AliasedExpr)
+		name := aliased.As
+		if name.IsEmpty() {
+			name = aliased.Expr.(*sqlparser.ColName).Name
+		}
+		if name.Equal(col) {
+			return aliased.Expr
+		}
+	}
+	return nil
+}
+
+// joinPlanBuilder builds the statements that
+// derive target rows for a side of the join.
+type joinPlanBuilder struct {
+	target  sqlparser.TableIdent
+	sel     *sqlparser.Select
+	join    *sqlparser.JoinTableExpr
+	pkCols  []sqlparser.ColIdent
+	pkExprs sqlparser.SelectExprs
+	side    *joinSide
+}
+
+// generateInsert generates the statement that inserts the target rows
+// of the join key. prefix is the prefix of the bind variables of the
+// row, a_ for the after row and b_ for the before row.
+func (jb *joinPlanBuilder) generateInsert(prefix string) *sqlparser.ParsedQuery {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("insert into %v(", jb.target)
+	separator := ""
+	for _, selExpr := range jb.sel.SelectExprs {
+		aliased := selExpr.(*sqlparser.AliasedExpr)
+		name := aliased.As
+		if name.IsEmpty() {
+			name = aliased.Expr.(*sqlparser.ColName).Name
+		}
+		buf.Myprintf("%s%v", separator, name)
+		separator = ","
+	}
+	buf.Myprintf(") select %v", jb.sel.SelectExprs)
+	jb.generateFromWhere(buf, prefix)
+	return buf.ParsedQuery()
+}
+
+// generateDelete generates the statement that deletes the target rows
+// of the join key.
+func (jb *joinPlanBuilder) generateDelete(prefix string) *sqlparser.ParsedQuery {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("delete from %v where (", jb.target)
+	separator := ""
+	for _, pkCol := range jb.pkCols {
+		buf.Myprintf("%s%v", separator, pkCol)
+		separator = ","
+	}
+	buf.Myprintf(") in (select %v", jb.pkExprs)
+	jb.generateFromWhere(buf, prefix)
+	buf.WriteString(")")
+	return buf.ParsedQuery()
+}
+
+// generateFromWhere generates the from and where clauses that
+// restrict the join of the helper tables to the join key.
+func (jb *joinPlanBuilder) generateFromWhere(buf *sqlparser.TrackedBuffer, prefix string) {
+	buf.Myprintf(" from %v where ", jb.join)
+	if jb.sel.Where != nil {
+		buf.Myprintf("(%v) and ", jb.sel.Where.Expr)
+	}
+	separator := ""
+	for _, key := range jb.side.keys {
+		buf.Myprintf("%s%v.%v=%a", separator, jb.side.alias, key, ":"+prefix+key.String())
+		separator = " and "
+	}
+}
+
+// apply applies the change of a row of a source table of the join.
+// applyRow applies the change to the helper table. The target rows of
+// the join key of the row are deleted before, and derived again after.
+func (jp *JoinPlan) apply(before, after bool, bindvars map[string]*querypb.BindVariable, applyRow func() (*sqltypes.Result, error), executor func(string) (*sqltypes.Result, error)) (*sqltypes.Result, error) {
+	keyChanged := before && after && jp.keyChanged(bindvars)
+	if before {
+		if _, err := execParsedQuery(jp.DeleteBefore, bindvars, executor); err != nil {
+			return nil, err
+		}
+	}
+	if after && (!before || keyChanged) {
+		if _, err := execParsedQuery(jp.DeleteAfter, bindvars, executor); err != nil {
+			return nil, err
+		}
+	}
+	qr, err := applyRow()
+	if err != nil {
+		return nil, err
+	}
+	if before && (!after || keyChanged) {
+		if _, err := execParsedQuery(jp.InsertBefore, bindvars, executor); err != nil {
+			return nil, err
+		}
+	}
+	if after {
+		if _, err := execParsedQuery(jp.InsertAfter, bindvars, executor); err != nil {
+			return nil, err
+		}
+	}
+	return qr, nil
+}
+
+func (jp *JoinPlan) keyChanged(bindvars map[string]*querypb.BindVariable) bool {
+	for _, keyref := range jp.KeyReferences {
+		v1, _ := sqltypes.BindVariableToValue(bindvars["b_"+keyref])
+		v2, _ := sqltypes.BindVariableToValue(bindvars["a_"+keyref])
+		if !valsEqual(v1, v2) {
+			return true
+		}
+	}
+	return false
+}
@@ -70,6 +70,10 @@ func (rp *ReplicatorPlan) buildExecutionPlan(fieldEvent *binlogdatapb.FieldEvent
 			trimmed.Name = strings.Trim(trimmed.Name, "`")
 			tplanv.Fields = append(tplanv.Fields, &trimmed)
 		}
+		var err error
+		if tplanv.Transforms, err = buildTransforms(fieldEvent.TableName, prelim.Transforms, tplanv.Fields); err != nil {
+			return nil, err
+		}
 		return &tplanv, nil
 	}
 	// select * construct was used. We need to use the field names.
@@ -78,6 +82,8 @@ func (rp *ReplicatorPlan) buildExecutionPlan(fieldEvent *binlogdatapb.FieldEvent
 		return nil, err
 	}
 	tplan.Fields = fieldEvent.Fields
+	tplan.Join = prelim.Join
+	tplan.RowTransforms = prelim.RowTransforms
 	return tplan, nil
 }
 
@@ -172,6 +178,25 @@ type TablePlan struct {
 	// PKReferences is used to check if an event changed
 	// a primary key column (row move).
 	PKReferences []string
+	// HelperInserts and HelperDeletes maintain the helper tables
+	// of the min, max, avg and count(distinct) aggregates. They
+	// respectively add the after values and remove the before
+	// values of a row.
+	HelperInserts []*sqlparser.ParsedQuery
+	HelperDeletes []*sqlparser.ParsedQuery
+	// RecomputeAfter and RecomputeBefore recompute the aggregates
+	// from the helper tables for the group of the after and the
+	// before row. They're nil if there are no helper tables.
+	RecomputeAfter  *sqlparser.ParsedQuery
+	RecomputeBefore *sqlparser.ParsedQuery
+	// Join is set if the table is a source table of a join.
+	// TargetName is then the helper table of the source table.
+	Join *JoinPlan
+	// Transforms compute the values of the columns that use transform
+	// or eval, and RowTransforms are applied to every row before it's
+	// bound. See transform.go.
+	Transforms    []*TransformPlan
+	RowTransforms []*RowTransformPlan
 }
 
 // MarshalJSON performs a custom JSON Marshalling.
@@ -186,6 +211,14 @@ func (tp *TablePlan) MarshalJSON() ([]byte, error) {
 		Update       *sqlparser.ParsedQuery `json:",omitempty"`
 		Delete       *sqlparser.ParsedQuery `json:",omitempty"`
 		PKReferences []string               `json:",omitempty"`
+
+		HelperInserts   []*sqlparser.ParsedQuery `json:",omitempty"`
+		HelperDeletes   []*sqlparser.ParsedQuery `json:",omitempty"`
+		RecomputeAfter  *sqlparser.ParsedQuery   `json:",omitempty"`
+		RecomputeBefore *sqlparser.ParsedQuery   `json:",omitempty"`
+		Join            *JoinPlan                `json:",omitempty"`
+		Transforms      []*TransformPlan         `json:",omitempty"`
+		RowTransforms   []*RowTransformPlan      `json:",omitempty"`
 	}{
 		TargetName:   tp.TargetName,
 		SendRule:     tp.SendRule.Match,
@@ -196,11 +229,23 @@ func (tp *TablePlan) MarshalJSON() ([]byte, error) {
 		Update:       tp.Update,
 		Delete:       tp.Delete,
 		PKReferences: tp.PKReferences,
+
+		HelperInserts:   tp.HelperInserts,
+		HelperDeletes:   tp.HelperDeletes,
+		RecomputeAfter:  tp.RecomputeAfter,
+		RecomputeBefore: tp.RecomputeBefore,
+		Join:            tp.Join,
+		Transforms:      tp.Transforms,
+		RowTransforms:   tp.RowTransforms,
 	}
 	return json.Marshal(&v)
 }
 
 func (tp *TablePlan) applyBulkInsert(rows *binlogdatapb.VStreamRowsResponse, executor func(string) (*sqltypes.Result, error)) (*sqltypes.Result, error) {
+	if tp.RecomputeAfter != nil || tp.Join != nil {
+		// The helper tables must be maintained row by row.
+		return tp.applyRowInserts(rows, executor)
+	}
 	bindvars := make(map[string]*querypb.BindVariable, len(tp.Fields))
 	var buf strings.Builder
 	if err := tp.BulkInsertFront.Append(&buf, nil, nil); err != nil {
@@ -209,61 +254,131 @@ func (tp *TablePlan) applyBulkInsert(rows *binlogdatapb.VStreamRowsResponse, exe
 	buf.WriteString(" values ")
 	separator := ""
 	for _, row := range rows.Rows {
-		vals := sqltypes.MakeRowTrusted(tp.Fields, row)
-		for i, field := range tp.Fields {
-			bindvars["a_"+field.Name] = sqltypes.ValueBindVariable(vals[i])
+		ok, err := tp.bindRow("a_", row, bindvars)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
 		}
 		buf.WriteString(separator)
 		separator = ", "
 		tp.BulkInsertValues.Append(&buf, bindvars, nil)
 	}
+	if separator == "" {
+		// All the rows were skipped by row transforms.
+		return &sqltypes.Result{}, nil
+	}
 	if tp.BulkInsertOnDup != nil {
 		tp.BulkInsertOnDup.Append(&buf, nil, nil)
 	}
 	return executor(buf.String())
 }
 
+// applyRowInserts applies the rows one at a time, for the plans
+// that can't use bulk inserts.
+func (tp *TablePlan) applyRowInserts(rows *binlogdatapb.VStreamRowsResponse, executor func(string) (*sqltypes.Result, error)) (*sqltypes.Result, error) {
+	result := &sqltypes.Result{}
+	for _, row := range rows.Rows {
+		qr, err := tp.applyChange(&binlogdatapb.RowChange{After: row}, executor)
+		if err != nil {
+			return nil, err
+		}
+		result.RowsAffected += qr.RowsAffected
+	}
+	return result, nil
+}
+
 func (tp *TablePlan) applyChange(rowChange *binlogdatapb.RowChange, executor func(string) (*sqltypes.Result, error)) (*sqltypes.Result, error) {
-	// MakeRowTrusted is needed here because Proto3ToResult is not convenient.
 	var before, after bool
+	var err error
 	bindvars := make(map[string]*querypb.BindVariable, len(tp.Fields))
 	if rowChange.Before != nil {
-		before = true
-		vals := sqltypes.MakeRowTrusted(tp.Fields, rowChange.Before)
-		for i, field := range tp.Fields {
-			bindvars["b_"+field.Name] = sqltypes.ValueBindVariable(vals[i])
+		if before, err = tp.bindRow("b_", rowChange.Before, bindvars); err != nil {
+			return nil, err
 		}
 	}
 	if rowChange.After != nil {
-		after = true
-		vals := sqltypes.MakeRowTrusted(tp.Fields, rowChange.After)
-		for i, field := range tp.Fields {
-			bindvars["a_"+field.Name] = sqltypes.ValueBindVariable(vals[i])
+		if after, err = tp.bindRow("a_", rowChange.After, bindvars); err != nil {
+			return nil, err
 		}
 	}
+	if !before && !after {
+		// The row was skipped by row transforms.
+		return &sqltypes.Result{}, nil
+	}
+	if tp.Join != nil {
+		return tp.Join.apply(before, after, bindvars, func() (*sqltypes.Result, error) {
+			return tp.applyRowChange(before, after, bindvars, executor)
+		}, executor)
+	}
+	return tp.applyRowChange(before, after, bindvars, executor)
+}
+
+func (tp *TablePlan) applyRowChange(before, after bool, bindvars map[string]*querypb.BindVariable, executor func(string) (*sqltypes.Result, error)) (*sqltypes.Result, error) {
 	switch {
 	case !before && after:
-		return execParsedQuery(tp.Insert, bindvars, executor)
+		return tp.applyInsert(bindvars, executor)
 	case before && !after:
-		if tp.Delete == nil {
-			return nil, nil
-		}
-		return execParsedQuery(tp.Delete, bindvars, executor)
+		return tp.applyDelete(bindvars, executor)
 	case before && after:
 		if !tp.pkChanged(bindvars) {
-			return execParsedQuery(tp.Update, bindvars, executor)
-		}
-		if tp.Delete != nil {
-			if _, err := execParsedQuery(tp.Delete, bindvars, executor); err != nil {
+			if err := execParsedQueries(tp.HelperDeletes, bindvars, executor); err != nil {
+				return nil, err
+			}
+			if err := execParsedQueries(tp.HelperInserts, bindvars, executor); err != nil {
 				return nil, err
 			}
+			return tp.applyRecompute(tp.Update, tp.RecomputeAfter, bindvars, executor)
 		}
-		return execParsedQuery(tp.Insert, bindvars, executor)
+		if _, err := tp.applyDelete(bindvars, executor); err != nil {
+			return nil, err
+		}
+		return tp.applyInsert(bindvars, executor)
 	}
 	// Unreachable.
 	return nil, nil
 }
 
+func (tp *TablePlan) applyInsert(bindvars map[string]*querypb.BindVariable, executor func(string) (*sqltypes.Result, error)) (*sqltypes.Result, error) {
+	if err := execParsedQueries(tp.HelperInserts, bindvars, executor); err != nil {
+		return nil, err
+	}
+	return tp.applyRecompute(tp.Insert, tp.RecomputeAfter, bindvars, executor)
+}
+
+func (tp *TablePlan) applyDelete(bindvars map[string]*querypb.BindVariable, executor func(string) (*sqltypes.Result, error)) (*sqltypes.Result, error) {
+	if tp.Delete == nil {
+		return nil, nil
+	}
+	if err := execParsedQueries(tp.HelperDeletes, bindvars, executor); err != nil {
+		return nil, err
+	}
+	return tp.applyRecompute(tp.Delete, tp.RecomputeBefore, bindvars, executor)
+}
+
+// applyRecompute executes pq, followed by recompute if it's set.
+// The result is the one of pq.
+func (tp *TablePlan) applyRecompute(pq, recompute *sqlparser.ParsedQuery, bindvars map[string]*querypb.BindVariable, executor func(string) (*sqltypes.Result, error)) (*sqltypes.Result, error) {
+	qr, err := execParsedQuery(pq, bindvars, executor)
+	if err != nil || recompute == nil {
+		return qr, err
+	}
+	if _, err := execParsedQuery(recompute, bindvars, executor); err != nil {
+		return nil, err
+	}
+	return qr, nil
+}
+
+func execParsedQueries(pqs []*sqlparser.ParsedQuery, bindvars map[string]*querypb.BindVariable, executor func(string) (*sqltypes.Result, error)) error {
+	for _, pq := range pqs {
+		if _, err := execParsedQuery(pq, bindvars, executor); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func execParsedQuery(pq *sqlparser.ParsedQuery, bindvars map[string]*querypb.BindVariable, executor func(string) (*sqltypes.Result, error)) (*sqltypes.Result, error) {
 	sql, err := pq.GenerateQuery(bindvars, nil)
 	if err != nil {
@@ -22,8 +22,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"vitess.io/vitess/go/sqltypes"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
 )
 
 type TestReplicatorPlan struct {
@@ -42,6 +44,21 @@ type TestTablePlan struct {
 	Update       string   `json:",omitempty"`
 	Delete       string   `json:",omitempty"`
 	PKReferences []string `json:",omitempty"`
+
+	HelperInserts   []string      `json:",omitempty"`
+	HelperDeletes   []string      `json:",omitempty"`
+	RecomputeAfter  string        `json:",omitempty"`
+	RecomputeBefore string        `json:",omitempty"`
+	Join            *TestJoinPlan `json:",omitempty"`
+}
+
+type TestJoinPlan struct {
+	TargetName    string
+	KeyReferences []string
+	DeleteBefore  string
+	DeleteAfter   string
+	InsertBefore  string
+	InsertAfter   string
 }
 
 func TestBuildPlayerPlan(t *testing.T) {
@@ -313,6 +330,134 @@ func TestBuildPlayerPlan(t *testing.T) {
 				},
 			},
 		},
+	}, {
+		// group by expression
+		input: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:  "t1",
+				Filter: "select c1 div 10 as c1, count(*) as cnt from t2 group by c1 div 10",
+			}},
+		},
+		plan: &TestReplicatorPlan{
+			VStreamFilter: &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{
+					Match:  "t2",
+					Filter: "select c1 from t2",
+				}},
+			},
+			TargetTables: []string{"t1"},
+			TablePlans: map[string]*TestTablePlan{
+				"t2": {
+					TargetName:   "t1",
+					SendRule:     "t2",
+					PKReferences: []string{"c1"},
+					InsertFront:  "insert into t1(c1,cnt)",
+					InsertValues: "(:a_c1 div 10,1)",
+					InsertOnDup:  "on duplicate key update cnt=cnt+1",
+					Insert:       "insert into t1(c1,cnt) values (:a_c1 div 10,1) on duplicate key update cnt=cnt+1",
+					Update:       "update t1 set cnt=cnt where c1=(:b_c1 div 10)",
+					Delete:       "update t1 set cnt=cnt-1 where c1=(:b_c1 div 10)",
+				},
+			},
+		},
+		planpk: &TestReplicatorPlan{
+			VStreamFilter: &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{
+					Match:  "t2",
+					Filter: "select c1, pk1, pk2 from t2",
+				}},
+			},
+			TargetTables: []string{"t1"},
+			TablePlans: map[string]*TestTablePlan{
+				"t2": {
+					TargetName:   "t1",
+					SendRule:     "t2",
+					PKReferences: []string{"c1", "pk1", "pk2"},
+					InsertFront:  "insert into t1(c1,cnt)",
+					InsertValues: "(:a_c1 div 10,1)",
+					InsertOnDup:  "on duplicate key update cnt=cnt+1",
+					Insert:       "insert into t1(c1,cnt) select :a_c1 div 10, 1 from dual where (:a_pk1,:a_pk2) <= (1,'aaa') on duplicate key update cnt=cnt+1",
+					Update:       "update t1 set cnt=cnt where c1=(:b_c1 div 10) and (:b_pk1,:b_pk2) <= (1,'aaa')",
+					Delete:       "update t1 set cnt=cnt-1 where c1=(:b_c1 div 10) and (:b_pk1,:b_pk2) <= (1,'aaa')",
+				},
+			},
+		},
+	}, {
+		// min, max, avg and count(distinct)
+		input: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:  "t1",
+				Filter: "select c1, c2, min(c3) as mn, count(distinct c3) as cd from t2 group by c1",
+			}},
+		},
+		plan: &TestReplicatorPlan{
+			VStreamFilter: &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{
+					Match:  "t2",
+					Filter: "select c1, c2, c3 from t2",
+				}},
+			},
+			TargetTables: []string{"t1"},
+			TablePlans: map[string]*TestTablePlan{
+				"t2": {
+					TargetName:   "t1",
+					SendRule:     "t2",
+					PKReferences: []string{"c1"},
+					InsertFront:  "insert into t1(c1,c2,mn,cd)",
+					InsertValues: "(:a_c1,:a_c2,null,null)",
+					InsertOnDup:  "on duplicate key update c2=values(c2), mn=null, cd=null",
+					Insert:       "insert into t1(c1,c2,mn,cd) values (:a_c1,:a_c2,null,null) on duplicate key update c2=values(c2), mn=null, cd=null",
+					Update:       "update t1 set c2=:a_c2, mn=null, cd=null where c1=:b_c1",
+					Delete:       "update t1 set c2=null, mn=null, cd=null where c1=:b_c1",
+					HelperInserts: []string{
+						"insert into _vt_agg_t1__mn(c1,val,cnt) select :a_c1, :a_c3, 1 from dual where :a_c3 is not null on duplicate key update cnt=cnt+1",
+						"insert into _vt_agg_t1__cd(c1,val,cnt) select :a_c1, :a_c3, 1 from dual where :a_c3 is not null on duplicate key update cnt=cnt+1",
+					},
+					HelperDeletes: []string{
+						"update _vt_agg_t1__mn set cnt=cnt-1 where c1=:b_c1 and val=:b_c3",
+						"delete from _vt_agg_t1__mn where c1=:b_c1 and val=:b_c3 and cnt=0",
+						"update _vt_agg_t1__cd set cnt=cnt-1 where c1=:b_c1 and val=:b_c3",
+						"delete from _vt_agg_t1__cd where c1=:b_c1 and val=:b_c3 and cnt=0",
+					},
+					RecomputeAfter:  "update t1 set mn=(select min(val) from _vt_agg_t1__mn where c1=:a_c1), cd=(select count(*) from _vt_agg_t1__cd where c1=:a_c1) where c1=:a_c1",
+					RecomputeBefore: "update t1 set mn=(select min(val) from _vt_agg_t1__mn where c1=:b_c1), cd=(select count(*) from _vt_agg_t1__cd where c1=:b_c1) where c1=:b_c1",
+				},
+			},
+		},
+		planpk: &TestReplicatorPlan{
+			VStreamFilter: &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{
+					Match:  "t2",
+					Filter: "select c1, c2, c3, pk1, pk2 from t2",
+				}},
+			},
+			TargetTables: []string{"t1"},
+			TablePlans: map[string]*TestTablePlan{
+				"t2": {
+					TargetName:   "t1",
+					SendRule:     "t2",
+					PKReferences: []string{"c1", "pk1", "pk2"},
+					InsertFront:  "insert into t1(c1,c2,mn,cd)",
+					InsertValues: "(:a_c1,:a_c2,null,null)",
+					InsertOnDup:  "on duplicate key update c2=values(c2), mn=null, cd=null",
+					Insert:       "insert into t1(c1,c2,mn,cd) select :a_c1, :a_c2, null, null from dual where (:a_pk1,:a_pk2) <= (1,'aaa') on duplicate key update c2=values(c2), mn=null, cd=null",
+					Update:       "update t1 set c2=:a_c2, mn=null, cd=null where c1=:b_c1 and (:b_pk1,:b_pk2) <= (1,'aaa')",
+					Delete:       "update t1 set c2=null, mn=null, cd=null where c1=:b_c1 and (:b_pk1,:b_pk2) <= (1,'aaa')",
+					HelperInserts: []string{
+						"insert into _vt_agg_t1__mn(c1,val,cnt) select :a_c1, :a_c3, 1 from dual where :a_c3 is not null and (:a_pk1,:a_pk2) <= (1,'aaa') on duplicate key update cnt=cnt+1",
+						"insert into _vt_agg_t1__cd(c1,val,cnt) select :a_c1, :a_c3, 1 from dual where :a_c3 is not null and (:a_pk1,:a_pk2) <= (1,'aaa') on duplicate key update cnt=cnt+1",
+					},
+					HelperDeletes: []string{
+						"update _vt_agg_t1__mn set cnt=cnt-1 where c1=:b_c1 and val=:b_c3 and (:b_pk1,:b_pk2) <= (1,'aaa')",
+						"delete from _vt_agg_t1__mn where c1=:b_c1 and val=:b_c3 and cnt=0",
+						"update _vt_agg_t1__cd set cnt=cnt-1 where c1=:b_c1 and val=:b_c3 and (:b_pk1,:b_pk2) <= (1,'aaa')",
+						"delete from _vt_agg_t1__cd where c1=:b_c1 and val=:b_c3 and cnt=0",
+					},
+					RecomputeAfter:  "update t1 set mn=(select min(val) from _vt_agg_t1__mn where c1=:a_c1), cd=(select count(*) from _vt_agg_t1__cd where c1=:a_c1) where c1=:a_c1",
+					RecomputeBefore: "update t1 set mn=(select min(val) from _vt_agg_t1__mn where c1=:b_c1), cd=(select count(*) from _vt_agg_t1__cd where c1=:b_c1) where c1=:b_c1",
+				},
+			},
+		},
 	}, {
 		input: &binlogdatapb.Filter{
 			Rules: []*binlogdatapb.Rule{{
@@ -645,7 +790,25 @@ func TestBuildPlayerPlan(t *testing.T) {
 				Filter: "select a from t1 group by a + 1",
 			}},
 		},
-		err: "unexpected: a + 1",
+		err: "group by expression does not match an expression in the select list: a + 1",
+	}, {
+		// min requires a group by
+		input: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:  "t1",
+				Filter: "select min(a) as b from t1",
+			}},
+		},
+		err: "min, max, avg and count(distinct) require a group by: b",
+	}, {
+		// no distinct for other aggregates
+		input: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:  "t1",
+				Filter: "select a, sum(distinct b) as b from t1 group by a",
+			}},
+		},
+		err: "unexpected: sum(distinct b)",
 	}, {
 		// group by does not reference alias
 		input: &binlogdatapb.Filter{
@@ -728,6 +891,38 @@ func TestBuildPlayerPlanNoDup(t *testing.T) {
 	}
 }
 
+func TestBuildPlayerPlanHelperCollision(t *testing.T) {
+	pkInfos := map[string][]*PrimaryKeyInfo{
+		"t1":    {{Name: "c1"}},
+		"t1__a": {{Name: "c1"}},
+	}
+	input := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "t1",
+			Filter: "select c1, max(c2) as a__mx from t group by c1",
+		}, {
+			Match:  "t1__a",
+			Filter: "select c1, max(c2) as mx from u group by c1",
+		}},
+	}
+	_, err := buildReplicatorPlan(input, pkInfos, nil)
+	want := "is also a helper table of"
+	if err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("buildReplicatorPlan err: %v, must contain: %v", err, want)
+	}
+
+	input.Rules[1] = &binlogdatapb.Rule{
+		Match:  "_vt_agg_t1__a__mx",
+		Filter: "select * from u",
+	}
+	pkInfos["_vt_agg_t1__a__mx"] = []*PrimaryKeyInfo{{Name: "c1"}}
+	_, err = buildReplicatorPlan(input, pkInfos, nil)
+	assert.EqualError(t, err, "helper table _vt_agg_t1__a__mx of t1 is also a target table")
+
+	// The helper tables of aggregates and joins don't collide.
+	assert.NotEqual(t, AggregateHelperTable("t1", "orders"), JoinHelperTable("t1", "orders"))
+}
+
 func TestBuildPlayerPlanExclude(t *testing.T) {
 	PrimaryKeyInfos := map[string][]*PrimaryKeyInfo{
 		"t1": {&PrimaryKeyInfo{Name: "c1"}},
@@ -765,3 +960,252 @@ func TestBuildPlayerPlanExclude(t *testing.T) {
 	wantPlan, _ := json.Marshal(want)
 	assert.Equal(t, string(gotPlan), string(wantPlan))
 }
+
+func TestAggregateHelpers(t *testing.T) {
+	helpers, err := AggregateHelpers("t1", "select c1 div 10 as g, max(c2) as mx, count(distinct c3) as cd, sum(c4) as s from t2 group by c1 div 10")
+	require.NoError(t, err)
+	want := []*AggregateHelper{{
+		Table:        "_vt_agg_t1__mx",
+		GroupColumns: []string{"g"},
+		SourceColumn: "c2",
+	}, {
+		Table:        "_vt_agg_t1__cd",
+		GroupColumns: []string{"g"},
+		SourceColumn: "c3",
+	}}
+	assert.Equal(t, want, helpers)
+
+	helpers, err = AggregateHelpers("t1", "select * from t2")
+	require.NoError(t, err)
+	assert.Nil(t, helpers)
+
+	_, err = AggregateHelpers("t1", "select max(c2) as mx from t2")
+	assert.EqualError(t, err, "min, max, avg and count(distinct) require a group by: mx")
+}
+
+func TestApplyChangeHelpers(t *testing.T) {
+	input := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "t1",
+			Filter: "select c1, max(c2) as mx from t2 group by c1",
+		}},
+	}
+	plan, err := buildReplicatorPlan(input, map[string][]*PrimaryKeyInfo{"t1": {{Name: "c1"}}}, nil)
+	require.NoError(t, err)
+	tp := plan.TablePlans["t2"]
+	tp.Fields = sqltypes.MakeTestFields("c1|c2", "int64|int64")
+
+	var queries []string
+	executor := func(query string) (*sqltypes.Result, error) {
+		queries = append(queries, query)
+		return &sqltypes.Result{RowsAffected: 1}, nil
+	}
+	before := sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(5)})
+	after := sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(7)})
+
+	_, err = tp.applyChange(&binlogdatapb.RowChange{Before: before, After: after}, executor)
+	require.NoError(t, err)
+	want := []string{
+		"update _vt_agg_t1__mx set cnt=cnt-1 where c1=1 and val=5",
+		"delete from _vt_agg_t1__mx where c1=1 and val=5 and cnt=0",
+		"insert into _vt_agg_t1__mx(c1,val,cnt) select 1, 7, 1 from dual where 7 is not null on duplicate key update cnt=cnt+1",
+		"update t1 set mx=null where c1=1",
+		"update t1 set mx=(select max(val) from _vt_agg_t1__mx where c1=1) where c1=1",
+	}
+	assert.Equal(t, want, queries)
+
+	queries = nil
+	_, err = tp.applyChange(&binlogdatapb.RowChange{Before: before}, executor)
+	require.NoError(t, err)
+	want = []string{
+		"update _vt_agg_t1__mx set cnt=cnt-1 where c1=1 and val=5",
+		"delete from _vt_agg_t1__mx where c1=1 and val=5 and cnt=0",
+		"update t1 set mx=null where c1=1",
+		"update t1 set mx=(select max(val) from _vt_agg_t1__mx where c1=1) where c1=1",
+	}
+	assert.Equal(t, want, queries)
+
+	queries = nil
+	qr, err := tp.applyBulkInsert(&binlogdatapb.VStreamRowsResponse{Rows: []*querypb.Row{before, after}}, executor)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), qr.RowsAffected)
+	assert.Equal(t, 6, len(queries))
+}
+
+func TestBuildPlayerPlanJoin(t *testing.T) {
+	pkInfos := map[string][]*PrimaryKeyInfo{
+		"t1":                  {{Name: "oid"}},
+		"_vt_join_t1__orders": {{Name: "id"}},
+		"_vt_join_t1__custs":  {{Name: "id"}},
+	}
+	input := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "t1",
+			Filter: "select o.id as oid, o.amount, c.name as cname from orders as o join custs as c on o.cid = c.id where c.active = 1",
+		}},
+	}
+	joinQuery := "from _vt_join_t1__orders as o join _vt_join_t1__custs as c on o.cid = c.id where (c.active = 1) and "
+	insertQuery := "insert into t1(oid,amount,cname) select o.id as oid, o.amount, c.name as cname " + joinQuery
+	deleteQuery := "delete from t1 where (oid) in (select o.id " + joinQuery
+	custsPlan := &TestTablePlan{
+		TargetName: "_vt_join_t1__custs",
+		SendRule:   "custs",
+		Join: &TestJoinPlan{
+			TargetName:    "t1",
+			KeyReferences: []string{"id"},
+			DeleteBefore:  deleteQuery + "c.id=:b_id)",
+			DeleteAfter:   deleteQuery + "c.id=:a_id)",
+			InsertBefore:  insertQuery + "c.id=:b_id",
+			InsertAfter:   insertQuery + "c.id=:a_id",
+		},
+	}
+	want := &TestReplicatorPlan{
+		VStreamFilter: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:  "orders",
+				Filter: "select * from orders",
+			}, {
+				Match:  "custs",
+				Filter: "select * from custs",
+			}},
+		},
+		TargetTables: []string{"_vt_join_t1__custs", "_vt_join_t1__orders"},
+		TablePlans: map[string]*TestTablePlan{
+			"orders": {
+				TargetName: "_vt_join_t1__orders",
+				SendRule:   "orders",
+				Join: &TestJoinPlan{
+					TargetName:    "t1",
+					KeyReferences: []string{"cid"},
+					DeleteBefore:  deleteQuery + "o.cid=:b_cid)",
+					DeleteAfter:   deleteQuery + "o.cid=:a_cid)",
+					InsertBefore:  insertQuery + "o.cid=:b_cid",
+					InsertAfter:   insertQuery + "o.cid=:a_cid",
+				},
+			},
+			"custs": custsPlan,
+		},
+	}
+	plan, err := buildReplicatorPlan(input, pkInfos, nil)
+	require.NoError(t, err)
+	gotPlan, _ := json.Marshal(plan)
+	wantPlan, _ := json.Marshal(want)
+	assert.Equal(t, string(wantPlan), string(gotPlan))
+
+	// Uncopied tables are not replicated.
+	copyState := map[string]*sqltypes.Result{
+		"_vt_join_t1__orders": nil,
+		"_vt_join_t1__custs": sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("id", "int64"),
+			"10",
+		),
+	}
+	want = &TestReplicatorPlan{
+		VStreamFilter: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:  "custs",
+				Filter: "select * from custs",
+			}},
+		},
+		TargetTables: []string{"_vt_join_t1__custs"},
+		TablePlans: map[string]*TestTablePlan{
+			"custs": custsPlan,
+		},
+	}
+	plan, err = buildReplicatorPlan(input, pkInfos, copyState)
+	require.NoError(t, err)
+	gotPlan, _ = json.Marshal(plan)
+	wantPlan, _ = json.Marshal(want)
+	assert.Equal(t, string(wantPlan), string(gotPlan))
+	assert.Equal(t, copyState["_vt_join_t1__custs"], plan.TargetTables["_vt_join_t1__custs"].Lastpk)
+
+	testcases := []struct {
+		filter string
+		err    string
+	}{{
+		filter: "select o.id as oid from orders as o left join custs as c on o.cid = c.id",
+		err:    "only inner joins are supported: orders as o left join custs as c on o.cid = c.id",
+	}, {
+		filter: "select id as oid from orders as o join custs as c on o.cid = c.id",
+		err:    "column must be qualified by a table of the join: id",
+	}, {
+		filter: "select o.id as oid from orders as o join custs as c on o.cid > c.id",
+		err:    "join condition must compare columns for equality: o.cid > c.id",
+	}, {
+		filter: "select o.id as oid from orders as o join custs as c on o.cid = o.id",
+		err:    "join condition must compare columns of both tables: o.cid = o.id",
+	}, {
+		filter: "select o.id as oid, count(*) as cnt from orders as o join custs as c on o.cid = c.id",
+		err:    "aggregates are not supported in joins: count(*)",
+	}, {
+		filter: "select o.id from orders as o join custs as c on o.cid = c.id",
+		err:    "primary key column oid not found in select list",
+	}}
+	for _, tcase := range testcases {
+		input.Rules[0].Filter = tcase.filter
+		_, err := buildReplicatorPlan(input, pkInfos, nil)
+		assert.EqualError(t, err, tcase.err, tcase.filter)
+	}
+}
+
+func TestApplyChangeJoin(t *testing.T) {
+	pkInfos := map[string][]*PrimaryKeyInfo{
+		"t1":                  {{Name: "oid"}},
+		"_vt_join_t1__orders": {{Name: "id"}},
+		"_vt_join_t1__custs":  {{Name: "id"}},
+	}
+	input := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "t1",
+			Filter: "select o.id as oid, c.name as cname from orders as o join custs as c on o.cid = c.id",
+		}},
+	}
+	plan, err := buildReplicatorPlan(input, pkInfos, nil)
+	require.NoError(t, err)
+	tp, err := plan.buildExecutionPlan(&binlogdatapb.FieldEvent{
+		TableName: "orders",
+		Fields:    sqltypes.MakeTestFields("id|cid", "int64|int64"),
+	})
+	require.NoError(t, err)
+
+	var queries []string
+	executor := func(query string) (*sqltypes.Result, error) {
+		queries = append(queries, query)
+		return &sqltypes.Result{RowsAffected: 1}, nil
+	}
+	joinQuery := "from _vt_join_t1__orders as o join _vt_join_t1__custs as c on o.cid = c.id where "
+	before := sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(5)})
+	after := sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(6)})
+
+	// The join key changed: the target rows of both keys are derived again.
+	_, err = tp.applyChange(&binlogdatapb.RowChange{Before: before, After: after}, executor)
+	require.NoError(t, err)
+	want := []string{
+		"delete from t1 where (oid) in (select o.id " + joinQuery + "o.cid=5)",
+		"delete from t1 where (oid) in (select o.id " + joinQuery + "o.cid=6)",
+		"update _vt_join_t1__orders set cid=6 where id=1",
+		"insert into t1(oid,cname) select o.id as oid, c.name as cname " + joinQuery + "o.cid=5",
+		"insert into t1(oid,cname) select o.id as oid, c.name as cname " + joinQuery + "o.cid=6",
+	}
+	assert.Equal(t, want, queries)
+
+	queries = nil
+	_, err = tp.applyChange(&binlogdatapb.RowChange{After: after}, executor)
+	require.NoError(t, err)
+	want = []string{
+		"delete from t1 where (oid) in (select o.id " + joinQuery + "o.cid=6)",
+		"insert into _vt_join_t1__orders(id,cid) values (1,6)",
+		"insert into t1(oid,cname) select o.id as oid, c.name as cname " + joinQuery + "o.cid=6",
+	}
+	assert.Equal(t, want, queries)
+
+	queries = nil
+	_, err = tp.applyChange(&binlogdatapb.RowChange{Before: before}, executor)
+	require.NoError(t, err)
+	want = []string{
+		"delete from t1 where (oid) in (select o.id " + joinQuery + "o.cid=5)",
+		"delete from _vt_join_t1__orders where id=1",
+		"insert into t1(oid,cname) select o.id as oid, c.name as cname " + joinQuery + "o.cid=5",
+	}
+	assert.Equal(t, want, queries)
+}
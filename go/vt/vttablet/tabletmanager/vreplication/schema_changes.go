@@ -0,0 +1,252 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// logTypeDDL is the type of the _vt.vreplication_log entries
+// of the DDLs that were applied to the target.
+const logTypeDDL = "DDL"
+
+// compatibleDDL returns the column clauses of statement if it's an ALTER
+// TABLE that only adds or drops columns of a source table that's copied
+// as is to the target. Such changes can be applied to the target without
+// affecting the other tables or the rows already copied. It returns
+// the target table name and the clauses, or nil if the DDL is not
+// compatible.
+func (vp *vplayer) compatibleDDL(statement string) (string, []string) {
+	stmt, err := sqlparser.Parse(statement)
+	if err != nil {
+		return "", nil
+	}
+	ddl, ok := stmt.(*sqlparser.DDL)
+	if !ok || ddl.Action != sqlparser.AlterStr {
+		return "", nil
+	}
+	tplan, ok := vp.replicatorPlan.TablePlans[ddl.Table.Name.String()]
+	if !ok || !isSelectStar(tplan.SendRule.Filter) {
+		return "", nil
+	}
+	specs := alterSpecs(statement)
+	if len(specs) == 0 {
+		return "", nil
+	}
+	var clauses []string
+	for _, spec := range specs {
+		columnClauses := columnClauses(spec)
+		if columnClauses == nil {
+			return "", nil
+		}
+		clauses = append(clauses, columnClauses...)
+	}
+	return tplan.TargetName, clauses
+}
+
+// applyCompatibleDDL applies the column additions and removals of
+// statement to the target, if it's a compatible DDL, and records them in
+// _vt.vreplication_log. A change that was already applied to the target,
+// for example by a schema change deployed to both sides, is skipped.
+func (vp *vplayer) applyCompatibleDDL(ctx context.Context, statement string) error {
+	targetTable, clauses := vp.compatibleDDL(statement)
+	if clauses == nil {
+		return nil
+	}
+	var applied []string
+	for _, clause := range clauses {
+		query := fmt.Sprintf("alter table %v %s", sqlparser.NewTableIdent(targetTable), clause)
+		if _, err := vp.vr.dbClient.ExecuteWithRetry(ctx, query); err != nil {
+			if sqlErr, ok := err.(*mysql.SQLError); ok && (sqlErr.Number() == mysql.ERDupFieldName || sqlErr.Number() == mysql.ERCantDropFieldOrKey) {
+				log.Infof("Skipping %s, it was already applied: %v", query, err)
+				continue
+			}
+			return err
+		}
+		applied = append(applied, query)
+	}
+	if len(applied) == 0 {
+		return nil
+	}
+	return vp.vr.insertLog(ctx, logTypeDDL, fmt.Sprintf("Applied %s for %s", strings.Join(applied, "; "), statement))
+}
+
+// isSelectStar returns true if the filter of a send rule copies
+// all the columns of the source table.
+func isSelectStar(filter string) bool {
+	stmt, err := sqlparser.Parse(filter)
+	if err != nil {
+		return false
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok || len(sel.SelectExprs) != 1 {
+		return false
+	}
+	_, ok = sel.SelectExprs[0].(*sqlparser.StarExpr)
+	return ok
+}
+
+// alterSpecs splits the specification of an ALTER TABLE into its
+// comma separated clauses. It works on the tokens of the statement,
+// so the commas and parentheses of strings, like in COMMENT 'a,b' or
+// ENUM('a,b'), and of quoted identifiers are not mistaken for separators.
+// It returns nil if the statement can't be tokenized.
+func alterSpecs(statement string) []string {
+	tkn := sqlparser.NewStringTokenizer(statement)
+	tkn.SkipSpecialComments = true
+	if typ, _ := scanToken(tkn); typ != sqlparser.ALTER {
+		return nil
+	}
+	typ, _ := scanToken(tkn)
+	if typ == sqlparser.IGNORE {
+		typ, _ = scanToken(tkn)
+	}
+	if typ != sqlparser.TABLE {
+		return nil
+	}
+	if typ, _ := scanToken(tkn); typ != sqlparser.ID {
+		return nil
+	}
+	// The lookahead character of the tokenizer is at Position-1.
+	start := tkn.Position - 1
+	if statement[start] == '.' {
+		if typ, _ := scanToken(tkn); typ != '.' {
+			return nil
+		}
+		if typ, _ := scanToken(tkn); typ != sqlparser.ID {
+			return nil
+		}
+		start = tkn.Position - 1
+	}
+	var specs []string
+	depth := 0
+	for {
+		typ, _ := scanToken(tkn)
+		switch typ {
+		case 0, ';':
+			end := tkn.Position - 1
+			if typ == ';' {
+				end = tkn.Position - 2
+			}
+			if spec := strings.TrimSpace(statement[start:end]); spec != "" {
+				specs = append(specs, spec)
+			}
+			return specs
+		case sqlparser.LEX_ERROR:
+			return nil
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				// The comma is right before the lookahead character.
+				specs = append(specs, strings.TrimSpace(statement[start:tkn.Position-2]))
+				start = tkn.Position - 1
+			}
+		}
+	}
+}
+
+// columnClauses returns the canonical column clauses of an ALTER TABLE
+// specification, if it only adds or drops columns. A specification that
+// adds several columns yields a clause per column. It returns nil if the
+// specification does something else, like adding an index.
+func columnClauses(spec string) []string {
+	tkn := sqlparser.NewStringTokenizer(spec)
+	tkn.SkipSpecialComments = true
+	action, _ := scanToken(tkn)
+	afterAction := tkn.Position - 1
+	typ, val := scanToken(tkn)
+	hasColumn := typ == sqlparser.COLUMN
+	switch action {
+	case sqlparser.ADD:
+		rest := spec[afterAction:]
+		if hasColumn {
+			rest = spec[tkn.Position-1:]
+		}
+		if !strings.HasPrefix(strings.TrimSpace(rest), "(") {
+			rest = "(" + rest + ")"
+		}
+		// The columns are validated by parsing them as the
+		// definition of a table.
+		stmt, err := sqlparser.Parse("create table t " + rest)
+		if err != nil {
+			return nil
+		}
+		ddl, ok := stmt.(*sqlparser.DDL)
+		if !ok || ddl.TableSpec == nil || len(ddl.TableSpec.Columns) == 0 || len(ddl.TableSpec.Indexes) != 0 || len(ddl.TableSpec.Constraints) != 0 {
+			return nil
+		}
+		var clauses []string
+		for _, col := range ddl.TableSpec.Columns {
+			if !isPlainDefault(col.Type.Default) {
+				return nil
+			}
+			clauses = append(clauses, "add column "+sqlparser.String(col))
+		}
+		return clauses
+	case sqlparser.DROP:
+		if hasColumn {
+			typ, val = scanToken(tkn)
+		}
+		// Without the COLUMN keyword, only an identifier designates a
+		// column. Keywords like INDEX or PRIMARY designate something else.
+		if typ != sqlparser.ID && !(hasColumn && len(val) != 0) {
+			return nil
+		}
+		if typ, _ := scanToken(tkn); typ != 0 {
+			return nil
+		}
+		return []string{"drop column " + sqlparser.String(sqlparser.NewColIdent(string(val)))}
+	}
+	return nil
+}
+
+// isPlainDefault returns true if the default value of a column is
+// absent, a literal, or one of the current time functions. The parser
+// drops the parentheses of an expression default like (concat('a', 'b')),
+// which MySQL requires, so such a column can't be added as parsed.
+func isPlainDefault(def sqlparser.Expr) bool {
+	switch def := def.(type) {
+	case nil, *sqlparser.Literal, *sqlparser.NullVal, sqlparser.BoolVal:
+		return true
+	case *sqlparser.FuncExpr:
+		switch def.Name.Lowered() {
+		case "current_timestamp", "now", "localtime", "localtimestamp":
+			return true
+		}
+	}
+	return false
+}
+
+// scanToken returns the next token of tkn that's not a comment.
+func scanToken(tkn *sqlparser.Tokenizer) (int, []byte) {
+	for {
+		typ, val := tkn.Scan()
+		if typ != sqlparser.COMMENT {
+			return typ, val
+		}
+	}
+}
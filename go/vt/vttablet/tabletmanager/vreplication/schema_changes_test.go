@@ -0,0 +1,114 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+func TestCompatibleDDL(t *testing.T) {
+	vp := &vplayer{
+		replicatorPlan: &ReplicatorPlan{
+			TablePlans: map[string]*TablePlan{
+				"t1": {
+					TargetName: "t1",
+					SendRule:   &binlogdatapb.Rule{Match: "t1", Filter: "select * from t1"},
+				},
+				"src": {
+					TargetName: "dst",
+					SendRule:   &binlogdatapb.Rule{Match: "src", Filter: "select * from src where in_keyrange('-80')"},
+				},
+				"t2": {
+					TargetName: "t2",
+					SendRule:   &binlogdatapb.Rule{Match: "t2", Filter: "select id, val from t2"},
+				},
+			},
+		},
+	}
+	testcases := []struct {
+		in      string
+		table   string
+		clauses []string
+	}{{
+		in:      "alter table t1 add column val varchar(128)",
+		table:   "t1",
+		clauses: []string{"add column val varchar(128)"},
+	}, {
+		in:      "ALTER TABLE `t1` ADD c1 int, DROP COLUMN c2, ADD COLUMN c3 decimal(10,2) default '1,2';",
+		table:   "t1",
+		clauses: []string{"add column c1 int", "drop column c2", "add column c3 decimal(10,2) default '1,2'"},
+	}, {
+		// Commas in strings and quoted identifiers don't separate clauses.
+		in:      "alter table t1 add column c1 varchar(10) comment 'a,b', add column c2 enum('x,y', 'z') default 'x,y', drop column c3",
+		table:   "t1",
+		clauses: []string{"add column c1 varchar(10) comment 'a,b'", "add column c2 enum('x,y', 'z') default 'x,y'", "drop column c3"},
+	}, {
+		in:      "alter table t1 add column c1 varchar(10) default 'it''s, (ok' comment \"a\\\",b\", drop `c,2`",
+		table:   "t1",
+		clauses: []string{"add column c1 varchar(10) default 'it\\'s, (ok' comment 'a\\\",b'", "drop column `c,2`"},
+	}, {
+		in:      "alter table t1 add column `a,b` set('p,q', ')') not null default 'p,q,)', add c2 int",
+		table:   "t1",
+		clauses: []string{"add column `a,b` set('p,q', ')') not null default 'p,q,)'", "add column c2 int"},
+	}, {
+		in:      "alter table t1 add column c1 datetime default current_timestamp, add c2 int default -1",
+		table:   "t1",
+		clauses: []string{"add column c1 datetime default current_timestamp()", "add column c2 int default -1"},
+	}, {
+		// The parentheses of an expression default are lost by the parser.
+		in: "alter table t1 add column c1 varchar(10) default (concat('a,', 'b')), drop c2",
+	}, {
+		in:      "alter table ks.src add column (a int, b int)",
+		table:   "dst",
+		clauses: []string{"add column a int", "add column b int"},
+	}, {
+		in:      "alter table t1 add column `key` int",
+		table:   "t1",
+		clauses: []string{"add column `key` int"},
+	}, {
+		in:      "alter ignore table t1 /* comment */ drop `val`, add c1 int",
+		table:   "t1",
+		clauses: []string{"drop column val", "add column c1 int"},
+	}, {
+		in: "alter table t1 add index val_idx(val)",
+	}, {
+		in: "alter table t1 add unique (val)",
+	}, {
+		in: "alter table t1 add column c1 int, add primary key (c1)",
+	}, {
+		in: "alter table t1 drop index val_idx",
+	}, {
+		in: "alter table t1 drop primary key",
+	}, {
+		in: "alter table t1 add column c1 int, modify c2 bigint",
+	}, {
+		in: "alter table t2 add column c1 int",
+	}, {
+		in: "alter table t3 add column c1 int",
+	}, {
+		in: "create table t4(id int)",
+	}}
+	for _, tcase := range testcases {
+		table, clauses := vp.compatibleDDL(tcase.in)
+		assert.Equal(t, tcase.table, table, tcase.in)
+		assert.Equal(t, tcase.clauses, clauses, tcase.in)
+	}
+}
@@ -49,6 +49,9 @@ type tablePlanBuilder struct {
 	pkCols     []*colExpr
 	lastpk     *sqltypes.Result
 	pkInfos    []*PrimaryKeyInfo
+	// transforms compute the columns that use transform or eval.
+	transforms    []*TransformPlan
+	rowTransforms []*RowTransformPlan
 }
 
 // colExpr describes the processing to be performed to
@@ -58,12 +61,18 @@ type colExpr struct {
 	// operation==opExpr: full expression is set
 	// operation==opCount: nothing is set.
 	// operation==opSum: for 'sum(a)', expr is set to 'a'.
+	// operation==opMin, opMax, opAvg, opCountDistinct: for 'min(a)',
+	// expr is set to 'a', and helper is set.
 	operation operation
 	// expr stores the expected field name from vstreamer and dictates
 	// the generated bindvar names, like a_col or b_col.
 	expr sqlparser.Expr
 	// references contains all the column names referenced in the expression.
 	references map[string]bool
+	// helper is the table that keeps the distinct values of expr for
+	// every group, along with the number of rows that have them.
+	// The aggregate is recomputed from it whenever a row changes.
+	helper sqlparser.TableIdent
 
 	isGrouped bool
 	isPK      bool
@@ -77,8 +86,106 @@ const (
 	opExpr = operation(iota)
 	opCount
 	opSum
+	opMin
+	opMax
+	opAvg
+	opCountDistinct
 )
 
+// helperOps are the operations that are maintained through a helper table.
+var helperOps = map[string]operation{
+	"min": opMin,
+	"max": opMax,
+	"avg": opAvg,
+}
+
+// usesHelper returns true if the aggregate is maintained through a helper table.
+func (cexpr *colExpr) usesHelper() bool {
+	switch cexpr.operation {
+	case opMin, opMax, opAvg, opCountDistinct:
+		return true
+	}
+	return false
+}
+
+// AggregateHelper describes a helper table of a materialized aggregate.
+// The table must have the group by columns of the target table, a val
+// column of the type of the source column, and a cnt bigint column.
+// Its primary key is the group by columns followed by val.
+type AggregateHelper struct {
+	// Table is the name of the helper table.
+	Table string
+	// GroupColumns are the group by columns of the target table.
+	GroupColumns []string
+	// SourceColumn is the source column the aggregate is computed on.
+	SourceColumn string
+}
+
+// usesHelpers returns true if the select list of sel
+// has aggregates that are maintained through helper tables.
+func usesHelpers(sel *sqlparser.Select) bool {
+	for _, selExpr := range sel.SelectExprs {
+		aliased, ok := selExpr.(*sqlparser.AliasedExpr)
+		if !ok {
+			continue
+		}
+		fexpr, ok := aliased.Expr.(*sqlparser.FuncExpr)
+		if !ok {
+			continue
+		}
+		if _, ok := helperOps[fexpr.Name.Lowered()]; ok || (fexpr.Distinct && fexpr.Name.Lowered() == "count") {
+			return true
+		}
+	}
+	return false
+}
+
+// AggregateHelperTable returns the name of the helper table of
+// the aggregate stored in column of the target table. The prefix
+// keeps it apart from the helper tables of joins.
+func AggregateHelperTable(targetTable, column string) string {
+	return fmt.Sprintf("_vt_agg_%s__%s", targetTable, column)
+}
+
+// AggregateHelpers returns the helper tables needed to materialize
+// the target table with the filter.
+func AggregateHelpers(targetTable, filter string) ([]*AggregateHelper, error) {
+	stmt, err := sqlparser.Parse(filter)
+	if err != nil {
+		return nil, err
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok || !usesHelpers(sel) {
+		return nil, nil
+	}
+	tpb := &tablePlanBuilder{
+		name:       sqlparser.NewTableIdent(targetTable),
+		sendSelect: &sqlparser.Select{},
+		selColumns: make(map[string]bool),
+	}
+	if err := tpb.analyzeExprs(sel.SelectExprs); err != nil {
+		return nil, err
+	}
+	if err := tpb.analyzeGroupBy(sel.GroupBy); err != nil {
+		return nil, err
+	}
+	var helpers []*AggregateHelper
+	for _, cexpr := range tpb.colExprs {
+		if !cexpr.usesHelper() {
+			continue
+		}
+		helper := &AggregateHelper{
+			Table:        cexpr.helper.String(),
+			SourceColumn: cexpr.expr.(*sqlparser.ColName).Name.String(),
+		}
+		for _, gexpr := range tpb.groupCols() {
+			helper.GroupColumns = append(helper.GroupColumns, gexpr.colName.String())
+		}
+		helpers = append(helpers, helper)
+	}
+	return helpers, nil
+}
+
 // insertType describes the type of insert statement to generate.
 // Please refer to TestBuildPlayerPlan for examples.
 type insertType int
@@ -122,6 +229,9 @@ func buildReplicatorPlan(filter *binlogdatapb.Filter, pkInfoMap map[string][]*Pr
 		TablePlans:    make(map[string]*TablePlan),
 		PKInfoMap:     pkInfoMap,
 	}
+	// helpers maps the helper tables to the target tables they're for.
+	helpers := make(map[string]string)
+	explicitTargets := make(map[string]bool)
 	for tableName := range pkInfoMap {
 		lastpk, ok := copyState[tableName]
 		if ok && lastpk == nil {
@@ -135,6 +245,27 @@ func buildReplicatorPlan(filter *binlogdatapb.Filter, pkInfoMap map[string][]*Pr
 		if rule == nil {
 			continue
 		}
+		if rule.Match == tableName {
+			explicitTargets[tableName] = true
+		}
+		if err := addHelperTables(helpers, tableName, rule.Filter); err != nil {
+			return nil, err
+		}
+		if sel, ok := joinSelect(rule.Filter); ok {
+			joinPlans, err := buildJoinPlans(tableName, sel, pkInfoMap, copyState)
+			if err != nil {
+				return nil, err
+			}
+			for _, tablePlan := range joinPlans {
+				if dup, ok := plan.TablePlans[tablePlan.SendRule.Match]; ok {
+					return nil, fmt.Errorf("more than one target for source table %s: %s and %s", tablePlan.SendRule.Match, dup.TargetName, tablePlan.TargetName)
+				}
+				plan.VStreamFilter.Rules = append(plan.VStreamFilter.Rules, tablePlan.SendRule)
+				plan.TargetTables[tablePlan.TargetName] = tablePlan
+				plan.TablePlans[tablePlan.SendRule.Match] = tablePlan
+			}
+			continue
+		}
 		tablePlan, err := buildTablePlan(tableName, rule.Filter, pkInfoMap, lastpk)
 		if err != nil {
 			return nil, err
@@ -150,9 +281,60 @@ func buildReplicatorPlan(filter *binlogdatapb.Filter, pkInfoMap map[string][]*Pr
 		plan.TargetTables[tableName] = tablePlan
 		plan.TablePlans[tablePlan.SendRule.Match] = tablePlan
 	}
+	for helper, tableName := range helpers {
+		if explicitTargets[helper] {
+			return nil, fmt.Errorf("helper table %s of %s is also a target table", helper, tableName)
+		}
+	}
 	return plan, nil
 }
 
+// HelperTables returns the names of the helper tables needed to
+// materialize the target table with the filter.
+func HelperTables(targetTable, filter string) ([]string, error) {
+	if _, ok := joinSelect(filter); ok {
+		helpers, err := JoinHelpers(targetTable, filter)
+		if err != nil {
+			return nil, err
+		}
+		var names []string
+		for _, helper := range helpers {
+			names = append(names, helper.Table)
+		}
+		return names, nil
+	}
+	helpers, err := AggregateHelpers(targetTable, filter)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, helper := range helpers {
+		names = append(names, helper.Table)
+	}
+	return names, nil
+}
+
+// addHelperTables adds the helper tables of the target table to helpers.
+// It returns an error if one of them is already the helper table of
+// another target table.
+func addHelperTables(helpers map[string]string, tableName, filter string) error {
+	if _, err := sqlparser.Parse(filter); err != nil {
+		// The filter is empty or a keyrange.
+		return nil
+	}
+	names, err := HelperTables(tableName, filter)
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		if other, ok := helpers[name]; ok && other != tableName {
+			return fmt.Errorf("helper table %s of %s is also a helper table of %s", name, tableName, other)
+		}
+		helpers[name] = tableName
+	}
+	return nil
+}
+
 // MatchTable is similar to tableMatches and buildPlan defined in vstreamer/planbuilder.go.
 func MatchTable(tableName string, filter *binlogdatapb.Filter) (*binlogdatapb.Rule, error) {
 	for _, rule := range filter.Rules {
@@ -196,6 +378,14 @@ func buildTablePlan(tableName, filter string, pkInfoMap map[string][]*PrimaryKey
 	sendRule := &binlogdatapb.Rule{
 		Match: fromTable,
 	}
+	rowTransforms, err := analyzeRowTransforms(sel)
+	if err != nil {
+		return nil, err
+	}
+	if rowTransforms != nil {
+		// The row transforms are applied here, not in the vstreamer.
+		query = sqlparser.String(sel)
+	}
 
 	if expr, ok := sel.SelectExprs[0].(*sqlparser.StarExpr); ok {
 		// If it's a "select *", we return a partial plan, and complete
@@ -208,9 +398,10 @@ func buildTablePlan(tableName, filter string, pkInfoMap map[string][]*PrimaryKey
 		}
 		sendRule.Filter = query
 		tablePlan := &TablePlan{
-			TargetName: tableName,
-			SendRule:   sendRule,
-			Lastpk:     lastpk,
+			TargetName:    tableName,
+			SendRule:      sendRule,
+			Lastpk:        lastpk,
+			RowTransforms: rowTransforms,
 		}
 		return tablePlan, nil
 	}
@@ -221,9 +412,10 @@ func buildTablePlan(tableName, filter string, pkInfoMap map[string][]*PrimaryKey
 			From:  sel.From,
 			Where: sel.Where,
 		},
-		selColumns: make(map[string]bool),
-		lastpk:     lastpk,
-		pkInfos:    pkInfoMap[tableName],
+		selColumns:    make(map[string]bool),
+		lastpk:        lastpk,
+		pkInfos:       pkInfoMap[tableName],
+		rowTransforms: rowTransforms,
 	}
 
 	if err := tpb.analyzeExprs(sel.SelectExprs); err != nil {
@@ -284,6 +476,12 @@ func (tpb *tablePlanBuilder) generate() *TablePlan {
 		Update:           tpb.generateUpdateStatement(),
 		Delete:           tpb.generateDeleteStatement(),
 		PKReferences:     pkrefs,
+		HelperInserts:    tpb.generateHelperInserts(),
+		HelperDeletes:    tpb.generateHelperDeletes(),
+		RecomputeAfter:   tpb.generateRecompute(bvAfter),
+		RecomputeBefore:  tpb.generateRecompute(bvBefore),
+		Transforms:       tpb.transforms,
+		RowTransforms:    tpb.rowTransforms,
 	}
 }
 
@@ -343,35 +541,43 @@ func (tpb *tablePlanBuilder) analyzeExpr(selExpr sqlparser.SelectExpr) (*colExpr
 		references: make(map[string]bool),
 	}
 	if expr, ok := aliased.Expr.(*sqlparser.FuncExpr); ok {
-		if expr.Distinct {
+		if ok, err := tpb.analyzeTransform(cexpr, expr); ok || err != nil {
+			return cexpr, err
+		}
+		if expr.Distinct && expr.Name.Lowered() != "count" {
 			return nil, fmt.Errorf("unexpected: %v", sqlparser.String(expr))
 		}
 		switch fname := expr.Name.Lowered(); fname {
 		case "count":
+			if expr.Distinct {
+				if err := tpb.analyzeAggregateColumn(cexpr, expr); err != nil {
+					return nil, err
+				}
+				cexpr.operation = opCountDistinct
+				if err := tpb.setHelper(cexpr); err != nil {
+					return nil, err
+				}
+				return cexpr, nil
+			}
 			if _, ok := expr.Exprs[0].(*sqlparser.StarExpr); !ok {
 				return nil, fmt.Errorf("only count(*) is supported: %v", sqlparser.String(expr))
 			}
 			cexpr.operation = opCount
 			return cexpr, nil
 		case "sum":
-			if len(expr.Exprs) != 1 {
-				return nil, fmt.Errorf("unexpected: %v", sqlparser.String(expr))
-			}
-			aInner, ok := expr.Exprs[0].(*sqlparser.AliasedExpr)
-			if !ok {
-				return nil, fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+			if err := tpb.analyzeAggregateColumn(cexpr, expr); err != nil {
+				return nil, err
 			}
-			innerCol, ok := aInner.Expr.(*sqlparser.ColName)
-			if !ok {
-				return nil, fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+			cexpr.operation = opSum
+			return cexpr, nil
+		case "min", "max", "avg":
+			if err := tpb.analyzeAggregateColumn(cexpr, expr); err != nil {
+				return nil, err
 			}
-			if !innerCol.Qualifier.IsEmpty() {
-				return nil, fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(innerCol))
+			cexpr.operation = helperOps[fname]
+			if err := tpb.setHelper(cexpr); err != nil {
+				return nil, err
 			}
-			cexpr.operation = opSum
-			cexpr.expr = innerCol
-			tpb.addCol(innerCol.Name)
-			cexpr.references[innerCol.Name.Lowered()] = true
 			return cexpr, nil
 		case "keyspace_id":
 			if len(expr.Exprs) != 0 {
@@ -408,6 +614,39 @@ func (tpb *tablePlanBuilder) analyzeExpr(selExpr sqlparser.SelectExpr) (*colExpr
 	return cexpr, nil
 }
 
+// analyzeAggregateColumn analyzes an aggregate function of a single column,
+// like sum(a), and sets the expr of cexpr to the column.
+func (tpb *tablePlanBuilder) analyzeAggregateColumn(cexpr *colExpr, expr *sqlparser.FuncExpr) error {
+	if len(expr.Exprs) != 1 {
+		return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+	}
+	aInner, ok := expr.Exprs[0].(*sqlparser.AliasedExpr)
+	if !ok {
+		return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+	}
+	innerCol, ok := aInner.Expr.(*sqlparser.ColName)
+	if !ok {
+		return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+	}
+	if !innerCol.Qualifier.IsEmpty() {
+		return fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(innerCol))
+	}
+	cexpr.expr = innerCol
+	tpb.addCol(innerCol.Name)
+	cexpr.references[innerCol.Name.Lowered()] = true
+	return nil
+}
+
+// setHelper sets the helper table of an aggregate of cexpr.
+func (tpb *tablePlanBuilder) setHelper(cexpr *colExpr) error {
+	name := AggregateHelperTable(tpb.name.String(), cexpr.colName.String())
+	if len(name) > 64 {
+		return fmt.Errorf("helper table name %s for %v is longer than 64 characters, use a shorter alias", name, cexpr.colName)
+	}
+	cexpr.helper = sqlparser.NewTableIdent(name)
+	return nil
+}
+
 // addCol adds the specified column to the send query
 // if it's not already present.
 func (tpb *tablePlanBuilder) addCol(ident sqlparser.ColIdent) {
@@ -423,16 +662,26 @@ func (tpb *tablePlanBuilder) addCol(ident sqlparser.ColIdent) {
 func (tpb *tablePlanBuilder) analyzeGroupBy(groupBy sqlparser.GroupBy) error {
 	if groupBy == nil {
 		// If there's no grouping, the it's an insertNormal.
+		for _, cexpr := range tpb.colExprs {
+			if cexpr.usesHelper() {
+				return fmt.Errorf("min, max, avg and count(distinct) require a group by: %v", cexpr.colName)
+			}
+		}
 		return nil
 	}
 	for _, expr := range groupBy {
-		colname, ok := expr.(*sqlparser.ColName)
-		if !ok {
-			return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
-		}
-		cexpr := tpb.findCol(colname.Name)
-		if cexpr == nil {
-			return fmt.Errorf("group by expression does not reference an alias in the select list: %v", sqlparser.String(expr))
+		var cexpr *colExpr
+		if colname, ok := expr.(*sqlparser.ColName); ok {
+			cexpr = tpb.findCol(colname.Name)
+			if cexpr == nil {
+				return fmt.Errorf("group by expression does not reference an alias in the select list: %v", sqlparser.String(expr))
+			}
+		} else {
+			// An expression must match an expression of the select list.
+			cexpr = tpb.findExpr(expr)
+			if cexpr == nil {
+				return fmt.Errorf("group by expression does not match an expression in the select list: %v", sqlparser.String(expr))
+			}
 		}
 		if cexpr.operation != opExpr {
 			return fmt.Errorf("group by expression is not allowed to reference an aggregate expression: %v", sqlparser.String(expr))
@@ -480,6 +729,27 @@ func (tpb *tablePlanBuilder) findCol(name sqlparser.ColIdent) *colExpr {
 	return nil
 }
 
+func (tpb *tablePlanBuilder) findExpr(expr sqlparser.Expr) *colExpr {
+	for _, cexpr := range tpb.colExprs {
+		if cexpr.operation == opExpr && sqlparser.String(cexpr.expr) == sqlparser.String(expr) {
+			return cexpr
+		}
+	}
+	return nil
+}
+
+// groupCols returns the grouped columns, which are also
+// the leading primary key columns of the helper tables.
+func (tpb *tablePlanBuilder) groupCols() []*colExpr {
+	var cexprs []*colExpr
+	for _, cexpr := range tpb.colExprs {
+		if cexpr.isGrouped {
+			cexprs = append(cexprs, cexpr)
+		}
+	}
+	return cexprs
+}
+
 func (tpb *tablePlanBuilder) generateInsertStatement() *sqlparser.ParsedQuery {
 	bvf := &bindvarFormatter{}
 	buf := sqlparser.NewTrackedBuffer(bvf.formatter)
@@ -528,6 +798,9 @@ func (tpb *tablePlanBuilder) generateValuesPart(buf *sqlparser.TrackedBuffer, bv
 		case opSum:
 			// NULL values must be treated as 0 for SUM.
 			buf.Myprintf("ifnull(%v, 0)", cexpr.expr)
+		default:
+			// The value is recomputed from the helper table.
+			buf.WriteString("null")
 		}
 	}
 	buf.Myprintf(")")
@@ -548,6 +821,8 @@ func (tpb *tablePlanBuilder) generateSelectPart(buf *sqlparser.TrackedBuffer, bv
 			buf.WriteString("1")
 		case opSum:
 			buf.Myprintf("ifnull(%v, 0)", cexpr.expr)
+		default:
+			buf.WriteString("null")
 		}
 	}
 	buf.WriteString(" from dual where ")
@@ -582,6 +857,9 @@ func (tpb *tablePlanBuilder) generateOnDupPart(buf *sqlparser.TrackedBuffer) *sq
 		case opSum:
 			buf.Myprintf("%v", cexpr.colName)
 			buf.Myprintf("+ifnull(values(%v), 0)", cexpr.colName)
+		default:
+			// Aggregates that use a helper table are recomputed later.
+			buf.WriteString("null")
 		}
 	}
 	return buf.ParsedQuery()
@@ -613,6 +891,8 @@ func (tpb *tablePlanBuilder) generateUpdateStatement() *sqlparser.ParsedQuery {
 			buf.Myprintf("-ifnull(%v, 0)", cexpr.expr)
 			bvf.mode = bvAfter
 			buf.Myprintf("+ifnull(%v, 0)", cexpr.expr)
+		default:
+			buf.WriteString("null")
 		}
 	}
 	tpb.generateWhere(buf, bvf)
@@ -643,6 +923,8 @@ func (tpb *tablePlanBuilder) generateDeleteStatement() *sqlparser.ParsedQuery {
 				buf.Myprintf("%v-1", cexpr.colName)
 			case opSum:
 				buf.Myprintf("%v-ifnull(%v, 0)", cexpr.colName, cexpr.expr)
+			default:
+				buf.WriteString("null")
 			}
 		}
 		tpb.generateWhere(buf, bvf)
@@ -652,6 +934,130 @@ func (tpb *tablePlanBuilder) generateDeleteStatement() *sqlparser.ParsedQuery {
 	return buf.ParsedQuery()
 }
 
+// generateHelperInserts generates the statements that add the value of
+// the after row to the helper tables. NULL values are not aggregated.
+func (tpb *tablePlanBuilder) generateHelperInserts() []*sqlparser.ParsedQuery {
+	var queries []*sqlparser.ParsedQuery
+	for _, cexpr := range tpb.colExprs {
+		if !cexpr.usesHelper() {
+			continue
+		}
+		bvf := &bindvarFormatter{mode: bvAfter}
+		buf := sqlparser.NewTrackedBuffer(bvf.formatter)
+		buf.Myprintf("insert into %v(", cexpr.helper)
+		for _, gexpr := range tpb.groupCols() {
+			buf.Myprintf("%v,", gexpr.colName)
+		}
+		buf.WriteString("val,cnt) select ")
+		for _, gexpr := range tpb.groupCols() {
+			buf.Myprintf("%v, ", gexpr.expr)
+		}
+		buf.Myprintf("%v, 1 from dual where %v is not null", cexpr.expr, cexpr.expr)
+		if tpb.lastpk != nil {
+			buf.WriteString(" and ")
+			tpb.generatePKConstraint(buf, bvf)
+		}
+		buf.WriteString(" on duplicate key update cnt=cnt+1")
+		queries = append(queries, buf.ParsedQuery())
+	}
+	return queries
+}
+
+// generateHelperDeletes generates the statements that remove the value
+// of the before row from the helper tables.
+func (tpb *tablePlanBuilder) generateHelperDeletes() []*sqlparser.ParsedQuery {
+	var queries []*sqlparser.ParsedQuery
+	for _, cexpr := range tpb.colExprs {
+		if !cexpr.usesHelper() {
+			continue
+		}
+		bvf := &bindvarFormatter{mode: bvBefore}
+		buf := sqlparser.NewTrackedBuffer(bvf.formatter)
+		buf.Myprintf("update %v set cnt=cnt-1", cexpr.helper)
+		tpb.generateHelperWhere(buf, cexpr)
+		if tpb.lastpk != nil {
+			buf.WriteString(" and ")
+			tpb.generatePKConstraint(buf, bvf)
+		}
+		queries = append(queries, buf.ParsedQuery())
+
+		buf = sqlparser.NewTrackedBuffer(bvf.formatter)
+		buf.Myprintf("delete from %v", cexpr.helper)
+		tpb.generateHelperWhere(buf, cexpr)
+		buf.WriteString(" and cnt=0")
+		queries = append(queries, buf.ParsedQuery())
+	}
+	return queries
+}
+
+// generateHelperWhere generates the where clause that matches the
+// value of cexpr in its helper table.
+func (tpb *tablePlanBuilder) generateHelperWhere(buf *sqlparser.TrackedBuffer, cexpr *colExpr) {
+	buf.WriteString(" where ")
+	for _, gexpr := range tpb.groupCols() {
+		buf.Myprintf("%v=", gexpr.colName)
+		if _, ok := gexpr.expr.(*sqlparser.ColName); ok {
+			buf.Myprintf("%v and ", gexpr.expr)
+		} else {
+			buf.Myprintf("(%v) and ", gexpr.expr)
+		}
+	}
+	buf.Myprintf("val=%v", cexpr.expr)
+}
+
+// generateRecompute generates the statement that recomputes the aggregates
+// of a group from the helper tables. mode specifies if the group is the one
+// of the before or the after row.
+func (tpb *tablePlanBuilder) generateRecompute(mode bindvarMode) *sqlparser.ParsedQuery {
+	bvf := &bindvarFormatter{mode: mode}
+	buf := sqlparser.NewTrackedBuffer(bvf.formatter)
+	buf.Myprintf("update %v set ", tpb.name)
+	separator := ""
+	for _, cexpr := range tpb.colExprs {
+		if !cexpr.usesHelper() {
+			continue
+		}
+		buf.Myprintf("%s%v=(select ", separator, cexpr.colName)
+		separator = ", "
+		switch cexpr.operation {
+		case opMin:
+			buf.WriteString("min(val)")
+		case opMax:
+			buf.WriteString("max(val)")
+		case opAvg:
+			buf.WriteString("sum(val*cnt)/sum(cnt)")
+		case opCountDistinct:
+			buf.WriteString("count(*)")
+		}
+		buf.Myprintf(" from %v where ", cexpr.helper)
+		gseparator := ""
+		for _, gexpr := range tpb.groupCols() {
+			buf.Myprintf("%s%v=", gseparator, gexpr.colName)
+			if _, ok := gexpr.expr.(*sqlparser.ColName); ok {
+				buf.Myprintf("%v", gexpr.expr)
+			} else {
+				buf.Myprintf("(%v)", gexpr.expr)
+			}
+			gseparator = " and "
+		}
+		buf.WriteString(")")
+	}
+	if separator == "" {
+		return nil
+	}
+	buf.WriteString(" where ")
+	separator = ""
+	for _, cexpr := range tpb.pkCols {
+		if _, ok := cexpr.expr.(*sqlparser.ColName); ok {
+			buf.Myprintf("%s%v=%v", separator, cexpr.colName, cexpr.expr)
+		} else {
+			buf.Myprintf("%s%v=(%v)", separator, cexpr.colName, cexpr.expr)
+		}
+		separator = " and "
+	}
+	return buf.ParsedQuery()
+}
+
 func (tpb *tablePlanBuilder) generateWhere(buf *sqlparser.TrackedBuffer, bvf *bindvarFormatter) {
 	buf.WriteString(" where ")
 	bvf.mode = bvBefore
@@ -0,0 +1,400 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"sync"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/vstreamer"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// Transformations let a vreplication rule change the data on its way
+// from the source to the target. They're referenced in the filter of
+// the rule, and applied in both the copy and the replication phase:
+//
+// transform('name', col1, col2, ...) computes a column by calling
+// the registered column transform with the values of the source columns:
+//   select id, transform('mask', ssn) as ssn from t
+//
+// eval('expression') computes a column with a sandboxed expression
+// over the source columns, see transform_eval.go:
+//   select id, eval('substring_index(name, \' \', 1)') as first_name from t
+//
+// transform_row('name') in the where clause calls the registered row
+// transform on every source row before it's applied. It can change
+// the values of the row, or skip it:
+//   select * from t where transform_row('drop_test_accounts')
+
+// ColumnTransform computes the value of a target column from the
+// values of the source columns it was called with. It must be safe
+// for concurrent use.
+type ColumnTransform func(args []sqltypes.Value) (sqltypes.Value, error)
+
+// RowTransform transforms a source row before it's applied to the target.
+// It can change the values of row in place, and returns false if the row
+// must be skipped. It must be safe for concurrent use.
+type RowTransform func(fields []*querypb.Field, row []sqltypes.Value) (bool, error)
+
+var (
+	transformsMu     sync.Mutex
+	columnTransforms = make(map[string]ColumnTransform)
+	rowTransforms    = make(map[string]RowTransform)
+)
+
+// RegisterColumnTransform registers a column transform under name.
+// It's meant to be called from init functions.
+func RegisterColumnTransform(name string, transform ColumnTransform) {
+	transformsMu.Lock()
+	defer transformsMu.Unlock()
+	if _, ok := columnTransforms[name]; ok {
+		log.Fatalf("column transform %s already exists", name)
+	}
+	columnTransforms[name] = transform
+}
+
+// RegisterRowTransform registers a row transform under name.
+// It's meant to be called from init functions.
+func RegisterRowTransform(name string, transform RowTransform) {
+	transformsMu.Lock()
+	defer transformsMu.Unlock()
+	if _, ok := rowTransforms[name]; ok {
+		log.Fatalf("row transform %s already exists", name)
+	}
+	rowTransforms[name] = transform
+}
+
+func getColumnTransform(name string) (ColumnTransform, error) {
+	transformsMu.Lock()
+	defer transformsMu.Unlock()
+	if transform, ok := columnTransforms[name]; ok {
+		return transform, nil
+	}
+	var names []string
+	for name := range columnTransforms {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return nil, fmt.Errorf("no column transform registered for name %s, available transforms: %v", name, names)
+}
+
+func getRowTransform(name string) (RowTransform, error) {
+	transformsMu.Lock()
+	defer transformsMu.Unlock()
+	if transform, ok := rowTransforms[name]; ok {
+		return transform, nil
+	}
+	var names []string
+	for name := range rowTransforms {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return nil, fmt.Errorf("no row transform registered for name %s, available transforms: %v", name, names)
+}
+
+func init() {
+	RegisterColumnTransform("mask", maskTransform)
+	RegisterColumnTransform("sha256", sha256Transform)
+	RegisterColumnTransform("redact", redactTransform)
+}
+
+// maskTransform replaces all but the last four characters of
+// a value with '*'. An optional second argument changes the
+// number of characters left visible.
+func maskTransform(args []sqltypes.Value) (sqltypes.Value, error) {
+	if len(args) != 1 && len(args) != 2 {
+		return sqltypes.NULL, fmt.Errorf("mask: expected 1 or 2 arguments, got %d", len(args))
+	}
+	if args[0].IsNull() {
+		return sqltypes.NULL, nil
+	}
+	visible := 4
+	if len(args) == 2 {
+		n, err := evalengine.ToInt64(args[1])
+		if err != nil {
+			return sqltypes.NULL, fmt.Errorf("mask: %v", err)
+		}
+		visible = int(n)
+	}
+	chars := []rune(args[0].ToString())
+	for i := 0; i < len(chars)-visible; i++ {
+		chars[i] = '*'
+	}
+	return sqltypes.NewVarChar(string(chars)), nil
+}
+
+// sha256Transform returns the hex encoded SHA-256 of the
+// concatenated arguments.
+func sha256Transform(args []sqltypes.Value) (sqltypes.Value, error) {
+	h := sha256.New()
+	for _, arg := range args {
+		if arg.IsNull() {
+			return sqltypes.NULL, nil
+		}
+		h.Write(arg.Raw())
+	}
+	return sqltypes.NewVarChar(hex.EncodeToString(h.Sum(nil))), nil
+}
+
+// redactTransform always returns NULL.
+func redactTransform(args []sqltypes.Value) (sqltypes.Value, error) {
+	return sqltypes.NULL, nil
+}
+
+// TransformPlan computes the value of a target column that uses
+// transform or eval. The value is bound under Column, like the
+// values of the source columns.
+type TransformPlan struct {
+	Column string
+	// Transform is the name of the column transform, or "eval".
+	Transform string
+	// Args are the source columns the value is computed from.
+	Args []string
+	// Expr is set for eval.
+	Expr string `json:",omitempty"`
+
+	columnTransform ColumnTransform
+	expr            sqlparser.Expr
+	// eval is expr built against the fields of the source table.
+	eval vstreamer.Expr
+}
+
+// buildTransforms returns copies of the transforms with their eval
+// expressions built against the fields of the source table.
+func buildTransforms(tableName string, transforms []*TransformPlan, fields []*querypb.Field) ([]*TransformPlan, error) {
+	if transforms == nil {
+		return nil, nil
+	}
+	built := make([]*TransformPlan, 0, len(transforms))
+	for _, trp := range transforms {
+		trp := *trp
+		if trp.expr != nil {
+			var err error
+			if trp.eval, err = buildEvalExpr(tableName, trp.expr, fields); err != nil {
+				return nil, fmt.Errorf("invalid expression in eval('%s'): %v", trp.Expr, err)
+			}
+		}
+		built = append(built, &trp)
+	}
+	return built, nil
+}
+
+// bind computes the value from the values of the source row, whose
+// bind vars have prefix, and adds it to bindvars.
+func (trp *TransformPlan) bind(prefix string, vals []sqltypes.Value, bindvars map[string]*querypb.BindVariable) error {
+	var val sqltypes.Value
+	var err error
+	switch {
+	case trp.eval != nil:
+		val, err = trp.eval.Evaluate(vals)
+	case trp.expr != nil:
+		// Unreachable: the expressions are built with the execution plan.
+		err = fmt.Errorf("expression not built: %s", trp.Expr)
+	default:
+		args := make([]sqltypes.Value, 0, len(trp.Args))
+		for _, col := range trp.Args {
+			bv, ok := bindvars[prefix+col]
+			if !ok {
+				return fmt.Errorf("cannot compute column %s: unknown column %s", trp.Column, col)
+			}
+			arg, err := sqltypes.BindVariableToValue(bv)
+			if err != nil {
+				return err
+			}
+			args = append(args, arg)
+		}
+		val, err = trp.columnTransform(args)
+	}
+	if err != nil {
+		return fmt.Errorf("cannot compute column %s: %v", trp.Column, err)
+	}
+	bindvars[prefix+trp.Column] = sqltypes.ValueBindVariable(val)
+	return nil
+}
+
+// RowTransformPlan is a row transform referenced by a rule.
+type RowTransformPlan struct {
+	Name string
+
+	rowTransform RowTransform
+}
+
+// transformColumn returns the name under which the value
+// of a transformed target column is bound.
+func transformColumn(colName sqlparser.ColIdent) sqlparser.ColIdent {
+	return sqlparser.NewColIdent("transform_" + colName.Lowered())
+}
+
+// analyzeTransform analyzes transform('name', col, ...) and eval('expr')
+// expressions. It returns false if expr is neither of them.
+func (tpb *tablePlanBuilder) analyzeTransform(cexpr *colExpr, expr *sqlparser.FuncExpr) (bool, error) {
+	fname := expr.Name.Lowered()
+	if fname != "transform" && fname != "eval" {
+		return false, nil
+	}
+	if len(expr.Exprs) == 0 {
+		return true, fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+	}
+	name, err := stringArg(expr.Exprs[0])
+	if err != nil {
+		return true, fmt.Errorf("%v: %v", err, sqlparser.String(expr))
+	}
+	trp := &TransformPlan{
+		Column:    transformColumn(cexpr.colName).String(),
+		Transform: fname,
+	}
+	var refs []*sqlparser.ColName
+	if fname == "eval" {
+		if len(expr.Exprs) != 1 {
+			return true, fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+		}
+		trp.Expr = name
+		if trp.expr, err = parseEvalExpr(name); err != nil {
+			return true, fmt.Errorf("invalid expression in %v: %v", sqlparser.String(expr), err)
+		}
+		refs = evalReferences(trp.expr)
+	} else {
+		trp.Transform = name
+		if trp.columnTransform, err = getColumnTransform(name); err != nil {
+			return true, err
+		}
+		for _, selExpr := range expr.Exprs[1:] {
+			aliased, ok := selExpr.(*sqlparser.AliasedExpr)
+			if !ok {
+				return true, fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+			}
+			col, ok := aliased.Expr.(*sqlparser.ColName)
+			if !ok || !col.Qualifier.IsEmpty() {
+				return true, fmt.Errorf("transform arguments must be columns: %v", sqlparser.String(expr))
+			}
+			refs = append(refs, col)
+		}
+	}
+	seen := make(map[string]bool)
+	for _, col := range refs {
+		tpb.addCol(col.Name)
+		cexpr.references[col.Name.Lowered()] = true
+		// The columns of an expression are listed once.
+		if fname == "eval" && seen[col.Name.String()] {
+			continue
+		}
+		seen[col.Name.String()] = true
+		trp.Args = append(trp.Args, col.Name.String())
+	}
+	cexpr.expr = &sqlparser.ColName{Name: transformColumn(cexpr.colName)}
+	tpb.transforms = append(tpb.transforms, trp)
+	return true, nil
+}
+
+// analyzeRowTransforms removes the transform_row('name') conditions
+// from the where clause of sel and returns their plans.
+func analyzeRowTransforms(sel *sqlparser.Select) ([]*RowTransformPlan, error) {
+	if sel.Where == nil {
+		return nil, nil
+	}
+	var plans []*RowTransformPlan
+	var conds []sqlparser.Expr
+	for _, cond := range sqlparser.SplitAndExpression(nil, sel.Where.Expr) {
+		fexpr, ok := cond.(*sqlparser.FuncExpr)
+		if !ok || !fexpr.Name.EqualString("transform_row") {
+			conds = append(conds, cond)
+			continue
+		}
+		if len(fexpr.Exprs) != 1 {
+			return nil, fmt.Errorf("unexpected: %v", sqlparser.String(fexpr))
+		}
+		name, err := stringArg(fexpr.Exprs[0])
+		if err != nil {
+			return nil, fmt.Errorf("%v: %v", err, sqlparser.String(fexpr))
+		}
+		rowTransform, err := getRowTransform(name)
+		if err != nil {
+			return nil, err
+		}
+		plans = append(plans, &RowTransformPlan{Name: name, rowTransform: rowTransform})
+	}
+	if plans == nil {
+		return nil, nil
+	}
+	var where sqlparser.Expr
+	for _, cond := range conds {
+		if where == nil {
+			where = cond
+			continue
+		}
+		where = &sqlparser.AndExpr{Left: where, Right: cond}
+	}
+	sel.Where = sqlparser.NewWhere(sqlparser.WhereStr, where)
+	return plans, nil
+}
+
+// stringArg returns the value of a string literal argument.
+func stringArg(selExpr sqlparser.SelectExpr) (string, error) {
+	aliased, ok := selExpr.(*sqlparser.AliasedExpr)
+	if !ok {
+		return "", fmt.Errorf("unexpected argument %v", sqlparser.String(selExpr))
+	}
+	lit, ok := aliased.Expr.(*sqlparser.Literal)
+	if !ok || lit.Type != sqlparser.StrVal {
+		return "", fmt.Errorf("expected a string literal, got %v", sqlparser.String(aliased.Expr))
+	}
+	return string(lit.Val), nil
+}
+
+// transformRow applies the row transforms to the values of a row.
+// It returns nil if the row must be skipped.
+func (tp *TablePlan) transformRow(vals []sqltypes.Value) ([]sqltypes.Value, error) {
+	for _, rtp := range tp.RowTransforms {
+		keep, err := rtp.rowTransform(tp.Fields, vals)
+		if err != nil {
+			return nil, fmt.Errorf("row transform %s: %v", rtp.Name, err)
+		}
+		if !keep {
+			return nil, nil
+		}
+	}
+	return vals, nil
+}
+
+// bindRow adds the values of row to bindvars, with their names
+// prefixed by prefix, followed by the transformed values. It returns
+// false if the row was skipped by a row transform.
+func (tp *TablePlan) bindRow(prefix string, row *querypb.Row, bindvars map[string]*querypb.BindVariable) (bool, error) {
+	// MakeRowTrusted is needed here because Proto3ToResult is not convenient.
+	vals, err := tp.transformRow(sqltypes.MakeRowTrusted(tp.Fields, row))
+	if err != nil || vals == nil {
+		return false, err
+	}
+	for i, field := range tp.Fields {
+		bindvars[prefix+field.Name] = sqltypes.ValueBindVariable(vals[i])
+	}
+	for _, trp := range tp.Transforms {
+		if err := trp.bind(prefix, vals, bindvars); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
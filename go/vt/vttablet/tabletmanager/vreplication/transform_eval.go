@@ -0,0 +1,96 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/vstreamer"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// The expression language of eval is the one of the filters of
+// vstreamer, see vstreamer.Expr. Expressions are evaluated in the
+// process, against the values of a single row: they can't run
+// queries, and they always terminate.
+//
+// An expression is validated when the plan is built, and built again
+// against the actual types of the source columns when the fields of
+// the table are known, see buildTransforms.
+
+// parseEvalExpr parses an eval expression, and verifies that
+// it only uses the supported constructs.
+func parseEvalExpr(expr string) (sqlparser.Expr, error) {
+	stmt, err := sqlparser.Parse("select " + expr + " from dual")
+	if err != nil {
+		return nil, err
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok || len(sel.SelectExprs) != 1 || sel.Where != nil || sel.GroupBy != nil || sel.Having != nil || sel.OrderBy != nil || sel.Limit != nil {
+		return nil, fmt.Errorf("not an expression: %s", expr)
+	}
+	aliased, ok := sel.SelectExprs[0].(*sqlparser.AliasedExpr)
+	if !ok || !aliased.As.IsEmpty() {
+		return nil, fmt.Errorf("not an expression: %s", expr)
+	}
+	var fields []*querypb.Field
+	seen := make(map[string]bool)
+	for _, col := range evalReferences(aliased.Expr) {
+		// Variables are parsed as columns.
+		if strings.HasPrefix(col.Name.String(), "@") {
+			return nil, fmt.Errorf("unsupported expression: %v", sqlparser.String(col))
+		}
+		if seen[col.Name.Lowered()] {
+			continue
+		}
+		seen[col.Name.Lowered()] = true
+		fields = append(fields, &querypb.Field{Name: col.Name.String(), Type: sqltypes.Null})
+	}
+	// The types of the columns are not known yet. The expression
+	// is built with NULL columns to validate its constructs.
+	if _, err := buildEvalExpr("", aliased.Expr, fields); err != nil {
+		return nil, err
+	}
+	return aliased.Expr, nil
+}
+
+// buildEvalExpr builds an expression returned by parseEvalExpr
+// against the fields of the source table.
+func buildEvalExpr(tableName string, expr sqlparser.Expr, fields []*querypb.Field) (vstreamer.Expr, error) {
+	return vstreamer.BuildExpr(&vstreamer.Table{Name: tableName, Fields: fields}, expr)
+}
+
+// evalReferences returns the columns referenced by an eval expression.
+func evalReferences(expr sqlparser.Expr) []*sqlparser.ColName {
+	var refs []*sqlparser.ColName
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.ColName:
+			refs = append(refs, node)
+		case *sqlparser.SubstrExpr:
+			if node.Name != nil {
+				refs = append(refs, node.Name)
+			}
+		}
+		return true, nil
+	}, expr)
+	return refs
+}
@@ -0,0 +1,321 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func init() {
+	RegisterRowTransform("skip_test_accounts", func(fields []*querypb.Field, row []sqltypes.Value) (bool, error) {
+		for i, field := range fields {
+			if field.Name == "email" && row[i].ToString() == "test@example.com" {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	RegisterRowTransform("lower_email", func(fields []*querypb.Field, row []sqltypes.Value) (bool, error) {
+		for i, field := range fields {
+			if field.Name == "email" && !row[i].IsNull() {
+				row[i] = sqltypes.NewVarChar(strings.ToLower(row[i].ToString()))
+			}
+		}
+		return true, nil
+	})
+}
+
+func TestBuildPlayerPlanTransforms(t *testing.T) {
+	input := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "t1",
+			Filter: "select transform('sha256', id) as id, transform('mask', ssn) as ssn, eval('concat(upper(first), \\' \\', last)') as name from t2 where in_keyrange('-80') and transform_row('skip_test_accounts')",
+		}},
+	}
+	plan, err := buildReplicatorPlan(input, map[string][]*PrimaryKeyInfo{"t1": {{Name: "id"}}}, nil)
+	require.NoError(t, err)
+	tp := plan.TablePlans["t2"]
+	require.NotNil(t, tp)
+	assert.Equal(t, "select id, ssn, first, last from t2 where in_keyrange('-80')", tp.SendRule.Filter)
+	assert.Equal(t, "insert into t1(id,ssn,name) values (:a_transform_id,:a_transform_ssn,:a_transform_name)", tp.Insert.Query)
+	assert.Equal(t, "update t1 set ssn=:a_transform_ssn, name=:a_transform_name where id=:b_transform_id", tp.Update.Query)
+	assert.Equal(t, []string{"id"}, tp.PKReferences)
+	require.Len(t, tp.Transforms, 3)
+	assert.Equal(t, []string{"first", "last"}, tp.Transforms[2].Args)
+	require.Len(t, tp.RowTransforms, 1)
+	assert.Equal(t, "skip_test_accounts", tp.RowTransforms[0].Name)
+
+	// The row transforms are removed from the filter of select * too.
+	input.Rules[0].Filter = "select * from t2 where transform_row('skip_test_accounts')"
+	plan, err = buildReplicatorPlan(input, map[string][]*PrimaryKeyInfo{"t1": {{Name: "id"}}}, nil)
+	require.NoError(t, err)
+	tp = plan.TablePlans["t2"]
+	assert.Equal(t, "select * from t2", tp.SendRule.Filter)
+	tp, err = plan.buildExecutionPlan(&binlogdatapb.FieldEvent{
+		TableName: "t2",
+		Fields:    sqltypes.MakeTestFields("id|email", "int64|varchar"),
+	})
+	require.NoError(t, err)
+	require.Len(t, tp.RowTransforms, 1)
+}
+
+func TestBuildPlayerPlanTransformErrors(t *testing.T) {
+	testcases := []struct {
+		filter string
+		err    string
+	}{{
+		filter: "select id, transform('nope', ssn) as ssn from t2",
+		err:    "no column transform registered for name nope, available transforms: [mask redact sha256]",
+	}, {
+		filter: "select id, transform(ssn) as ssn from t2",
+		err:    "expected a string literal, got ssn: transform(ssn)",
+	}, {
+		filter: "select id, transform('mask', left(ssn, 2)) as ssn from t2",
+		err:    "transform arguments must be columns: transform('mask', left(ssn, 2))",
+	}, {
+		filter: "select id, eval('(select 1)') as c from t2",
+		err:    "invalid expression in eval('(select 1)'): unsupported: (select 1 from dual)",
+	}, {
+		filter: "select id, eval('sleep(10)') as c from t2",
+		err:    "invalid expression in eval('sleep(10)'): unsupported function: sleep(10)",
+	}, {
+		filter: "select id from t2 where transform_row('nope')",
+		err:    "no row transform registered for name nope, available transforms: [lower_email skip_test_accounts]",
+	}}
+	for _, tcase := range testcases {
+		input := &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{Match: "t1", Filter: tcase.filter}},
+		}
+		_, err := buildReplicatorPlan(input, map[string][]*PrimaryKeyInfo{"t1": {{Name: "id"}}}, nil)
+		assert.EqualError(t, err, tcase.err, tcase.filter)
+	}
+}
+
+func TestApplyChangeTransforms(t *testing.T) {
+	input := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "t1",
+			Filter: "select id, transform('mask', email) as email, eval('substring_index(email, \\'@\\', -1)') as domain from t2 where transform_row('lower_email') and transform_row('skip_test_accounts')",
+		}},
+	}
+	plan, err := buildReplicatorPlan(input, map[string][]*PrimaryKeyInfo{"t1": {{Name: "id"}}}, nil)
+	require.NoError(t, err)
+	tp, err := plan.buildExecutionPlan(&binlogdatapb.FieldEvent{
+		TableName: "t2",
+		Fields:    sqltypes.MakeTestFields("id|email", "int64|varchar"),
+	})
+	require.NoError(t, err)
+
+	var queries []string
+	executor := func(query string) (*sqltypes.Result, error) {
+		queries = append(queries, query)
+		return &sqltypes.Result{RowsAffected: 1}, nil
+	}
+	row := func(id int64, email string) *querypb.Row {
+		return sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(id), sqltypes.NewVarChar(email)})
+	}
+
+	// The row transforms are applied before the column transforms.
+	_, err = tp.applyChange(&binlogdatapb.RowChange{Before: row(1, "a@b.com"), After: row(1, "CD@E.org")}, executor)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"update t1 set email='****.org', domain='e.org' where id=1"}, queries)
+
+	// A row skipped by a row transform turns an update into a delete.
+	queries = nil
+	_, err = tp.applyChange(&binlogdatapb.RowChange{Before: row(1, "a@b.com"), After: row(1, "test@example.com")}, executor)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"delete from t1 where id=1"}, queries)
+
+	queries = nil
+	_, err = tp.applyChange(&binlogdatapb.RowChange{After: row(2, "Test@Example.com")}, executor)
+	require.NoError(t, err)
+	assert.Empty(t, queries)
+
+	queries = nil
+	_, err = tp.applyBulkInsert(&binlogdatapb.VStreamRowsResponse{Rows: []*querypb.Row{row(1, "a@b.com"), row(2, "test@example.com"), row(3, "x@y.z")}}, executor)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"insert into t1(id,email,domain) values (1,'***.com','b.com'), (3,'*@y.z','y.z')"}, queries)
+
+	queries = nil
+	_, err = tp.applyBulkInsert(&binlogdatapb.VStreamRowsResponse{Rows: []*querypb.Row{row(2, "test@example.com")}}, executor)
+	require.NoError(t, err)
+	assert.Empty(t, queries)
+}
+
+func TestColumnTransforms(t *testing.T) {
+	testcases := []struct {
+		name string
+		args []sqltypes.Value
+		want sqltypes.Value
+	}{{
+		name: "mask",
+		args: []sqltypes.Value{sqltypes.NewVarChar("123-45-6789")},
+		want: sqltypes.NewVarChar("*******6789"),
+	}, {
+		name: "mask",
+		args: []sqltypes.Value{sqltypes.NewVarChar("abc"), sqltypes.NewInt64(1)},
+		want: sqltypes.NewVarChar("**c"),
+	}, {
+		name: "mask",
+		args: []sqltypes.Value{sqltypes.NULL},
+		want: sqltypes.NULL,
+	}, {
+		name: "sha256",
+		args: []sqltypes.Value{sqltypes.NewVarChar("abc")},
+		want: sqltypes.NewVarChar("ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"),
+	}, {
+		name: "redact",
+		args: []sqltypes.Value{sqltypes.NewVarChar("abc")},
+		want: sqltypes.NULL,
+	}}
+	for _, tcase := range testcases {
+		transform, err := getColumnTransform(tcase.name)
+		require.NoError(t, err)
+		got, err := transform(tcase.args)
+		require.NoError(t, err)
+		assert.Equal(t, tcase.want, got, "%s%v", tcase.name, tcase.args)
+	}
+}
+
+func TestEvalExpr(t *testing.T) {
+	fields := sqltypes.MakeTestFields("a|b|s|n|mail", "int64|int64|varchar|int64|varchar")
+	row := []sqltypes.Value{
+		sqltypes.NewInt64(7),
+		sqltypes.NewInt64(2),
+		sqltypes.NewVarChar("Hello World"),
+		sqltypes.NULL,
+		sqltypes.NewVarChar("jane.doe@example.com"),
+	}
+	testcases := []struct {
+		expr string
+		want string
+	}{
+		{"a + b * 3", "13"},
+		{"a - 10", "-3"},
+		{"-a", "-7"},
+		{"a % b", "1"},
+		{"mod(a, b)", "1"},
+		{"a / b", "3.5"},
+		{"a + n", "NULL"},
+		{"a > b", "1"},
+		{"a = n", "NULL"},
+		{"n <=> null", "1"},
+		{"a > b and s is not null", "1"},
+		{"a in (1, 7)", "1"},
+		{"a not in (1, n)", "NULL"},
+		{"a between b and 10", "1"},
+		{"n = 1 or a < b", "NULL"},
+		{"n = 1 and a < b", "0"},
+		{"not a", "0"},
+		{"n is null", "1"},
+		{"a is not null", "1"},
+		{"a is true", "1"},
+		{"case when a > 10 then 'big' when a > 5 then 'medium' else 'small' end", "medium"},
+		{"case b when 1 then 'one' when 2 then 'two' end", "two"},
+		{"case b when 3 then 'three' end", "NULL"},
+		{"concat(s, '!')", "Hello World!"},
+		{"concat(s, n)", "NULL"},
+		{"concat_ws('-', a, n, b)", "7-2"},
+		{"upper(s)", "HELLO WORLD"},
+		{"lcase(s)", "hello world"},
+		{"length(s)", "11"},
+		// A literal is binary, so its length is in bytes.
+		{"char_length('héllo')", "6"},
+		{"trim('  x  ')", "x"},
+		{"ltrim('  x  ')", "x  "},
+		{"rtrim('  x  ')", "  x"},
+		{"substr(s, 7)", "World"},
+		{"substring(s, 1, 5)", "Hello"},
+		{"substr(s, -5, 3)", "Wor"},
+		{"substr(s, 0)", ""},
+		{"substring(s from 7 for 2)", "Wo"},
+		{"substring_index(mail, '@', 1)", "jane.doe"},
+		{"substring_index(mail, '.', -2)", "doe@example.com"},
+		{"substring_index(mail, '.', 10)", "jane.doe@example.com"},
+		{"left(s, 5)", "Hello"},
+		{"right(s, 5)", "World"},
+		{"replace(s, 'o', '0')", "Hell0 W0rld"},
+		{"replace(s, '', 'x')", "Hello World"},
+		{"coalesce(n, n, s)", "Hello World"},
+		{"ifnull(n, 'x')", "x"},
+		{"nullif(a, 7)", "NULL"},
+		{"if(a > b, 'yes', 'no')", "yes"},
+		{"if(n, 'yes', 'no')", "no"},
+		// String literals are binary, so they are compared
+		// with a VARCHAR byte by byte.
+		{"s = 'Hello World'", "1"},
+		{"s = 'hello world'", "0"},
+		{"s != 'Hello World'", "0"},
+		{"s in ('x', 'Hello World')", "1"},
+		{"s like 'Hello%'", "1"},
+		{"s < 'a'", "1"},
+	}
+	for _, tcase := range testcases {
+		expr, err := parseEvalExpr(tcase.expr)
+		require.NoError(t, err, tcase.expr)
+		eval, err := buildEvalExpr("t", expr, fields)
+		require.NoError(t, err, tcase.expr)
+		got, err := eval.Evaluate(row)
+		require.NoError(t, err, tcase.expr)
+		if got.IsNull() {
+			assert.Equal(t, tcase.want, "NULL", tcase.expr)
+			continue
+		}
+		assert.Equal(t, tcase.want, got.ToString(), tcase.expr)
+	}
+
+	expr, err := parseEvalExpr("concat(x, 1)")
+	require.NoError(t, err)
+	_, err = buildEvalExpr("t", expr, fields)
+	assert.EqualError(t, err, "column x not found in table t")
+
+	// Comparing two non-binary strings needs a collation.
+	expr, err = parseEvalExpr("s = mail")
+	require.NoError(t, err)
+	_, err = buildEvalExpr("t", expr, fields)
+	assert.Contains(t, fmt.Sprint(err), "collations are not supported")
+}
+
+func TestParseEvalExprErrors(t *testing.T) {
+	testcases := []struct {
+		expr string
+		err  string
+	}{
+		{"a from t", "syntax error at position 21 near 'from'"},
+		{"a, b", "not an expression: a, b"},
+		{"a as b", "not an expression: a as b"},
+		{"t.a", "unsupported qualifier for column: t.a"},
+		{"database()", "unsupported function: database()"},
+		{"upper(a, b)", "incorrect parameter count in the call to upper: upper(a, b)"},
+		{"a << 1", "unsupported operator: a << 1"},
+		{"exists (select 1 from t)", "unsupported: exists (select 1 from t)"},
+		{"@@version", "unsupported expression: @@version"},
+	}
+	for _, tcase := range testcases {
+		_, err := parseEvalExpr(tcase.expr)
+		assert.EqualError(t, err, tcase.err, tcase.expr)
+	}
+}
@@ -527,6 +527,9 @@ func (vp *vplayer) applyEvent(ctx context.Context, event *binlogdatapb.VEvent, m
 				return err
 			}
 			stats.Send(fmt.Sprintf("%v", event.Statement))
+			if err := vp.vr.insertLog(ctx, logTypeDDL, fmt.Sprintf("Applied %s", event.Statement)); err != nil {
+				return err
+			}
 			posReached, err := vp.updatePos(event.Timestamp)
 			if err != nil {
 				return err
@@ -535,10 +538,28 @@ func (vp *vplayer) applyEvent(ctx context.Context, event *binlogdatapb.VEvent, m
 				return io.EOF
 			}
 		case binlogdatapb.OnDDLAction_EXEC_IGNORE:
+			message := fmt.Sprintf("Applied %s", event.Statement)
 			if _, err := vp.vr.dbClient.ExecuteWithRetry(ctx, event.Statement); err != nil {
 				log.Infof("Ignoring error: %v for DDL: %s", err, event.Statement)
+				message = fmt.Sprintf("Ignored error %v for %s", err, event.Statement)
 			}
 			stats.Send(fmt.Sprintf("%v", event.Statement))
+			if err := vp.vr.insertLog(ctx, logTypeDDL, message); err != nil {
+				return err
+			}
+			posReached, err := vp.updatePos(event.Timestamp)
+			if err != nil {
+				return err
+			}
+			if posReached {
+				return io.EOF
+			}
+		case binlogdatapb.OnDDLAction_EXEC_COLUMNS:
+			// Column additions and removals of tables that are copied as is
+			// are applied, the other DDLs are ignored.
+			if err := vp.applyCompatibleDDL(ctx, event.Statement); err != nil {
+				return err
+			}
 			posReached, err := vp.updatePos(event.Timestamp)
 			if err != nil {
 				return err
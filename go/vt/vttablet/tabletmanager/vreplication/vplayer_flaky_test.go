@@ -1219,6 +1219,7 @@ func TestPlayerDDL(t *testing.T) {
 	execStatements(t, []string{"alter table t1 add column val1 varchar(128)"})
 	expectDBClientQueries(t, []string{
 		"alter table t1 add column val1 varchar(128)",
+		"/insert into _vt.vreplication_log.*'Applied alter table t1 add column val1",
 		"/update _vt.vreplication set pos=",
 		// The apply of the DDL on target generates an "other" event.
 		"/update _vt.vreplication set pos=",
@@ -1247,6 +1248,7 @@ func TestPlayerDDL(t *testing.T) {
 	execStatements(t, []string{"alter table t1 add column val1 varchar(128)"})
 	expectDBClientQueries(t, []string{
 		"alter table t1 add column val1 varchar(128)",
+		"/insert into _vt.vreplication_log.*'Applied alter table t1 add column val1",
 		"/update _vt.vreplication set pos=",
 		// The apply of the DDL on target generates an "other" event.
 		"/update _vt.vreplication set pos=",
@@ -1254,6 +1256,47 @@ func TestPlayerDDL(t *testing.T) {
 	execStatements(t, []string{"alter table t1 add column val2 varchar(128)"})
 	expectDBClientQueries(t, []string{
 		"alter table t1 add column val2 varchar(128)",
+		"/insert into _vt.vreplication_log.*'Ignored error",
+		"/update _vt.vreplication set pos=",
+	})
+	cancel()
+
+	bls = &binlogdatapb.BinlogSource{
+		Keyspace: env.KeyspaceName,
+		Shard:    env.ShardName,
+		Filter:   filter,
+		OnDdl:    binlogdatapb.OnDDLAction_EXEC_COLUMNS,
+	}
+	cancel, _ = startVReplication(t, bls, "")
+	// The plan of t1 is only built on its first row event.
+	execStatements(t, []string{"insert into t1(id) values(2)"})
+	expectDBClientQueries(t, []string{
+		"begin",
+		"/insert into t1",
+		"/update _vt.vreplication set pos=",
+		"commit",
+	})
+	// Column additions and removals are applied to the target.
+	execStatements(t, []string{"alter table t1 add column val3 varchar(128)"})
+	expectDBClientQueries(t, []string{
+		"alter table t1 add column val3 varchar(128)",
+		"/insert into _vt.vreplication_log.*'Applied alter table t1 add column val3",
+		"/update _vt.vreplication set pos=",
+		// The apply of the DDL on target generates an "other" event.
+		"/update _vt.vreplication set pos=",
+	})
+	execStatements(t, []string{"alter table t1 drop column val3"})
+	expectDBClientQueries(t, []string{
+		"alter table t1 drop column val3",
+		"/insert into _vt.vreplication_log.*'DDL'",
+		"/update _vt.vreplication set pos=",
+		"/update _vt.vreplication set pos=",
+	})
+	// Other DDLs are ignored.
+	execStatements(t, []string{"alter table t1 add index val_idx(id)"})
+	execStatements(t, []string{"alter table t1 drop index val_idx"})
+	expectDBClientQueries(t, []string{
+		"/update _vt.vreplication set pos=",
 		"/update _vt.vreplication set pos=",
 	})
 	cancel()
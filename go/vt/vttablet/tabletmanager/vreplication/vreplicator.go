@@ -283,6 +283,16 @@ func (vr *vreplicator) setMessage(message string) error {
 	return nil
 }
 
+// insertLog records an event of the stream in _vt.vreplication_log.
+func (vr *vreplicator) insertLog(ctx context.Context, typ, message string) error {
+	query := fmt.Sprintf("insert into _vt.vreplication_log(vrepl_id, type, state, message, time_created) values (%v, %v, %v, %v, %v)",
+		vr.id, encodeString(typ), encodeString(vr.stats.State.Get()), encodeString(message), time.Now().Unix())
+	if _, err := withDDL.Exec(ctx, query, vr.dbClient.ExecuteFetch); err != nil {
+		return fmt.Errorf("could not insert log: %v: %v", query, err)
+	}
+	return nil
+}
+
 func (vr *vreplicator) setState(state, message string) error {
 	if message != "" {
 		vr.stats.History.Add(&binlogplayer.StatsHistoryRecord{
@@ -19,6 +19,7 @@ package planbuilder
 import (
 	"strings"
 
+	vtschema "vitess.io/vitess/go/vt/schema"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
@@ -153,6 +154,19 @@ func analyzeSet(set *sqlparser.Set) (plan *Plan) {
 	}
 }
 
+// analyzeAlterMigration translates the statement into the update
+// of _vt.schema_migrations that the online DDL executor acts upon.
+func analyzeAlterMigration(stmt *sqlparser.AlterMigration) (*Plan, error) {
+	query, err := vtschema.AlterMigrationQuery(stmt)
+	if err != nil {
+		return nil, vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, err.Error())
+	}
+	return &Plan{
+		PlanID:    PlanAlterMigration,
+		FullQuery: sqlparser.BuildParsedQuery("%s", query),
+	}, nil
+}
+
 func lookupTable(tableExprs sqlparser.TableExprs, tables map[string]*schema.Table) *schema.Table {
 	if len(tableExprs) > 1 {
 		return nil
@@ -65,6 +65,8 @@ const (
 	PlanRelease
 	PlanSRollback
 	PlanShowTables
+	// PlanAlterMigration is for "alter vitess_migration" statements.
+	PlanAlterMigration
 	NumPlans
 )
 
@@ -90,6 +92,7 @@ var planName = []string{
 	"Release",
 	"RollbackSavepoint",
 	"ShowTables",
+	"AlterMigration",
 }
 
 func (pt PlanType) String() string {
@@ -196,6 +199,8 @@ func Build(statement sqlparser.Statement, tables map[string]*schema.Table, isRes
 		plan, err = &Plan{PlanID: PlanOtherRead}, nil
 	case *sqlparser.OtherAdmin:
 		plan, err = &Plan{PlanID: PlanOtherAdmin}, nil
+	case *sqlparser.AlterMigration:
+		plan, err = analyzeAlterMigration(stmt)
 	case *sqlparser.Savepoint:
 		plan, err = &Plan{PlanID: PlanSavepoint}, nil
 	case *sqlparser.Release:
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"net/http"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"golang.org/x/net/context"
@@ -31,6 +32,7 @@ import (
 	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/streamlog"
 	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/timer"
 	"vitess.io/vitess/go/trace"
 	"vitess.io/vitess/go/vt/dbconnpool"
 	"vitess.io/vitess/go/vt/log"
@@ -64,6 +66,11 @@ type TabletPlan struct {
 	MysqlTime  time.Duration
 	RowCount   int64
 	ErrorCount int64
+	// ResourceUsage is only updated if resource accounting is enabled.
+	// ResourceCount is the number of executions, streaming ones
+	// included, that ResourceUsage covers.
+	ResourceUsage tabletenv.ResourceUsage
+	ResourceCount int64
 }
 
 // Size allows TabletPlan to be in cache.LRUCache.
@@ -94,6 +101,22 @@ func (ep *TabletPlan) Stats() (queryCount int64, duration, mysqlTime time.Durati
 	return
 }
 
+// AddResourceUsage adds the MySQL resource usage of a query to the plan.
+func (ep *TabletPlan) AddResourceUsage(ru tabletenv.ResourceUsage) {
+	ep.mu.Lock()
+	ep.ResourceUsage.Add(ru)
+	ep.ResourceCount++
+	ep.mu.Unlock()
+}
+
+// ResourceStats returns the MySQL resource usage accumulated by the plan,
+// and the number of executions it covers.
+func (ep *TabletPlan) ResourceStats() (tabletenv.ResourceUsage, int64) {
+	ep.mu.Lock()
+	defer ep.mu.Unlock()
+	return ep.ResourceUsage, ep.ResourceCount
+}
+
 // buildAuthorized builds 'Authorized', which is the runtime part for 'Permissions'.
 func (ep *TabletPlan) buildAuthorized() {
 	ep.Authorized = make([]*tableacl.ACLResult, len(ep.Permissions))
@@ -135,6 +158,11 @@ type QueryEngine struct {
 	// For implementation details, please see BeginExecute() in tabletserver.go.
 	txSerializer *txserializer.TxSerializer
 	streamQList  *QueryList
+	// runtimeQList tracks the queries that are subject to
+	// the limit of a MAX_RUNTIME query rule. They are killed
+	// by runtimeTicks once they exceed it.
+	runtimeQList *QueryList
+	runtimeTicks *timer.Timer
 
 	// Vars
 	maxResultSize    sync2.AtomicInt64
@@ -152,9 +180,18 @@ type QueryEngine struct {
 
 	consolidatorMode            string
 	enableQueryPlanFieldCaching bool
+	enableResourceAccounting    bool
+
+	// resourceOverhead is what the two session status snapshots
+	// of resource accounting add to the counters. It is measured
+	// once, the first time accounting runs, and then only read, so
+	// it is an atomic.Value to keep a lock off the query path.
+	// It holds a tabletenv.ResourceUsage once set.
+	resourceOverhead atomic.Value
 
 	// stats
 	queryCounts, queryTimes, queryRowCounts, queryErrorCounts *stats.CountersWithMultiLabels
+	queryRowsExamined, queryTmpDiskTables                     *stats.CountersWithMultiLabels
 
 	// Loggers
 	accessCheckerLogger *logutil.ThrottledLogger
@@ -177,9 +214,12 @@ func NewQueryEngine(env tabletenv.Env, se *schema.Engine) *QueryEngine {
 	qe.streamConns = connpool.NewPool(env, "StreamConnPool", config.OlapReadPool)
 	qe.consolidatorMode = config.Consolidator
 	qe.enableQueryPlanFieldCaching = config.CacheResultFields
+	qe.enableResourceAccounting = config.EnableResourceAccounting
 	qe.consolidator = sync2.NewConsolidator()
 	qe.txSerializer = txserializer.New(env)
 	qe.streamQList = NewQueryList()
+	qe.runtimeQList = NewQueryList()
+	qe.runtimeTicks = timer.NewTimer(config.MaxRuntimeCheckIntervalSeconds.Get())
 
 	qe.strictTableACL = config.StrictTableACL
 	qe.enableTableACLDryRun = config.EnableTableACLDryRun
@@ -223,6 +263,8 @@ func NewQueryEngine(env tabletenv.Env, se *schema.Engine) *QueryEngine {
 	qe.queryTimes = env.Exporter().NewCountersWithMultiLabels("QueryTimesNs", "query times in ns", []string{"Table", "Plan"})
 	qe.queryRowCounts = env.Exporter().NewCountersWithMultiLabels("QueryRowCounts", "query row counts", []string{"Table", "Plan"})
 	qe.queryErrorCounts = env.Exporter().NewCountersWithMultiLabels("QueryErrorCounts", "query error counts", []string{"Table", "Plan"})
+	qe.queryRowsExamined = env.Exporter().NewCountersWithMultiLabels("QueryRowsExamined", "query rows examined by mysql", []string{"Table", "Plan"})
+	qe.queryTmpDiskTables = env.Exporter().NewCountersWithMultiLabels("QueryTmpDiskTables", "query on-disk temporary tables created by mysql", []string{"Table", "Plan"})
 
 	env.Exporter().HandleFunc("/debug/hotrows", qe.txSerializer.ServeHTTP)
 	env.Exporter().HandleFunc("/debug/tablet_plans", qe.handleHTTPQueryPlans)
@@ -260,6 +302,7 @@ func (qe *QueryEngine) Open() error {
 
 	qe.streamConns.Open(qe.env.Config().DB.AppWithDB(), qe.env.Config().DB.DbaWithDB(), qe.env.Config().DB.AppDebugWithDB())
 	qe.se.RegisterNotifier("qe", qe.schemaChanged)
+	qe.runtimeTicks.Start(qe.killExpiredQueries)
 	qe.isOpen = true
 	return nil
 }
@@ -271,6 +314,15 @@ func (qe *QueryEngine) StopServing() {
 	qe.streamQList.TerminateAll()
 }
 
+// killExpiredQueries kills the queries that have exceeded
+// the limit of their MAX_RUNTIME query rule.
+func (qe *QueryEngine) killExpiredQueries() {
+	defer qe.env.LogError()
+	if count := qe.runtimeQList.TerminateExpired(); count > 0 {
+		qe.env.Stats().KillCounters.Add("MaxRuntimeQueries", int64(count))
+	}
+}
+
 // Close must be called to shut down QueryEngine.
 // You must ensure that no more queries will be sent
 // before calling Close.
@@ -279,6 +331,7 @@ func (qe *QueryEngine) Close() {
 		return
 	}
 	// Close in reverse order of Open.
+	qe.runtimeTicks.Stop()
 	qe.se.UnregisterNotifier("qe")
 	qe.plans.Clear()
 	qe.tables = make(map[string]*schema.Table)
@@ -435,6 +488,13 @@ func (qe *QueryEngine) AddStats(planName, tableName string, queryCount int64, du
 	qe.queryErrorCounts.Add(keys, errorCount)
 }
 
+// AddResourceUsage adds the given MySQL resource usage for the planName.tableName
+func (qe *QueryEngine) AddResourceUsage(planName, tableName string, ru tabletenv.ResourceUsage) {
+	keys := []string{tableName, planName}
+	qe.queryRowsExamined.Add(keys, ru.RowsExamined)
+	qe.queryTmpDiskTables.Add(keys, ru.CreatedTmpDiskTables)
+}
+
 type perQueryStats struct {
 	Query      string
 	Table      string
@@ -31,6 +31,7 @@ import (
 	"vitess.io/vitess/go/trace"
 	"vitess.io/vitess/go/vt/callerid"
 	"vitess.io/vitess/go/vt/callinfo"
+	"vitess.io/vitess/go/vt/dbconnpool"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/tableacl"
@@ -57,6 +58,9 @@ type QueryExecutor struct {
 	logStats       *tabletenv.LogStats
 	tsv            *TabletServer
 	tabletType     topodatapb.TabletType
+	// maxRuntime is set if a MAX_RUNTIME query rule applies.
+	maxRuntime     time.Duration
+	maxRuntimeRule string
 }
 
 var sequenceFields = []*querypb.Field{
@@ -88,6 +92,7 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 		}
 		qre.tsv.qe.AddStats(planName, tableName, 1, duration, mysqlTime, int64(reply.RowsAffected), 0)
 		qre.plan.AddStats(1, duration, mysqlTime, int64(reply.RowsAffected), 0)
+		qre.recordResourceUsage(planName, tableName)
 		qre.logStats.RowsAffected = int(reply.RowsAffected)
 		qre.logStats.Rows = reply.Rows
 		qre.tsv.Stats().ResultHistogram.Add(int64(len(reply.Rows)))
@@ -100,6 +105,8 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 	switch qre.plan.PlanID {
 	case planbuilder.PlanNextval:
 		return qre.execNextval()
+	case planbuilder.PlanAlterMigration:
+		return qre.execAlterMigration()
 	case planbuilder.PlanSelectImpossible:
 		// If the fields did not get cached, we have send the query
 		// to mysql, which you can see below.
@@ -257,7 +264,25 @@ func (qre *QueryExecutor) Stream(callback func(*sqltypes.Result) error) error {
 	qre.tsv.qe.streamQList.Add(qd)
 	defer qre.tsv.qe.streamQList.Remove(qd)
 
-	return qre.streamFetch(conn, qre.plan.FullQuery, qre.bindVars, callback)
+	if err := qre.streamFetch(conn, qre.plan.FullQuery, qre.bindVars, callback); err != nil {
+		return err
+	}
+	tableName := qre.plan.TableName().String()
+	if tableName == "" {
+		tableName = "Join"
+	}
+	qre.recordResourceUsage(qre.plan.PlanID.String(), tableName)
+	return nil
+}
+
+// recordResourceUsage adds the resource usage accounted in the LogStats,
+// if any, to the query engine and plan stats.
+func (qre *QueryExecutor) recordResourceUsage(planName, tableName string) {
+	if qre.logStats.ResourceUsage == nil {
+		return
+	}
+	qre.tsv.qe.AddResourceUsage(planName, tableName, *qre.logStats.ResourceUsage)
+	qre.plan.AddResourceUsage(*qre.logStats.ResourceUsage)
 }
 
 // MessageStream streams messages from a message table.
@@ -312,6 +337,7 @@ func (qre *QueryExecutor) checkPermissions() error {
 	case rules.QRFailRetry:
 		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "disallowed due to rule: %s", desc)
 	}
+	qre.maxRuntime, qre.maxRuntimeRule = qre.plan.Rules.GetMaxRuntime(remoteAddr, username, qre.bindVars)
 
 	// Skip ACL check for queries against the dummy dual table
 	if qre.plan.TableName().String() == "dual" {
@@ -526,6 +552,18 @@ func (qre *QueryExecutor) verifyRowCount(count, maxrows int64) error {
 	return nil
 }
 
+// execAlterMigration updates _vt.schema_migrations, which is not
+// accessible to the app user, through a dba connection. The online DDL
+// executor picks up the change on its next review.
+func (qre *QueryExecutor) execAlterMigration() (*sqltypes.Result, error) {
+	conn, err := dbconnpool.NewDBConnection(qre.ctx, qre.tsv.config.DB.DbaWithDB())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	return conn.ExecuteFetch(qre.plan.FullQuery.Query, 1, false)
+}
+
 func (qre *QueryExecutor) execOther() (*sqltypes.Result, error) {
 	conn, err := qre.getConn()
 	if err != nil {
@@ -676,10 +714,103 @@ func (qre *QueryExecutor) execSQL(conn executor, sql string, wantfields bool) (*
 	span, ctx := trace.NewSpan(qre.ctx, "QueryExecutor.execSQL")
 	defer span.Finish()
 
+	if qre.tsv.qe.enableResourceAccounting {
+		defer qre.accountResources(ctx, conn)()
+	}
 	defer qre.logStats.AddRewrittenSQL(sql, time.Now())
+	if qre.maxRuntime != 0 {
+		var result *sqltypes.Result
+		err := qre.execWithMaxRuntime(ctx, conn, func(ctx context.Context) (err error) {
+			result, err = conn.Exec(ctx, sql, int(qre.tsv.qe.maxResultSize.Get()), wantfields)
+			return err
+		})
+		return result, err
+	}
 	return conn.Exec(ctx, sql, int(qre.tsv.qe.maxResultSize.Get()), wantfields)
 }
 
+// execWithMaxRuntime registers the query with the runtime QueryList before
+// calling exec, so that it gets killed if it runs longer than the limit of
+// its MAX_RUNTIME query rule. If that happens, the error of exec gets
+// replaced by one that explains the reason.
+func (qre *QueryExecutor) execWithMaxRuntime(ctx context.Context, conn executor, exec func(ctx context.Context) error) error {
+	var kconn killable
+	switch conn := conn.(type) {
+	case *StatefulConnection:
+		kconn = conn.UnderlyingDBConn()
+	case killable:
+		kconn = conn
+	default:
+		return exec(ctx)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	qd := NewMaxRuntimeQueryDetail(qre.logStats.Ctx, kconn, qre.maxRuntime, cancel)
+	qre.tsv.qe.runtimeQList.Add(qd)
+	err := exec(ctx)
+	qre.tsv.qe.runtimeQList.Remove(qd)
+	if err != nil && qd.Expired() {
+		return vterrors.Errorf(vtrpcpb.Code_DEADLINE_EXCEEDED, "query killed after exceeding max runtime %v due to rule: %s", qre.maxRuntime, qre.maxRuntimeRule)
+	}
+	return err
+}
+
+// accountResources takes a snapshot of the session status of conn and
+// returns a function that records the difference with a new snapshot
+// in the LogStats, minus what the snapshots themselves add to the
+// counters. Failures to read the status are logged and ignored:
+// resource accounting must never cause a query to fail.
+func (qre *QueryExecutor) accountResources(ctx context.Context, conn executor) func() {
+	before, err := readResourceUsage(ctx, conn)
+	if err != nil {
+		log.Warningf("Could not read session status for resource accounting: %v", err)
+		return func() {}
+	}
+	overhead, before, err := qre.tsv.qe.resourceUsageOverhead(ctx, conn, before)
+	if err != nil {
+		log.Warningf("Could not read session status for resource accounting: %v", err)
+		return func() {}
+	}
+	return func() {
+		after, err := readResourceUsage(ctx, conn)
+		if err != nil {
+			log.Warningf("Could not read session status for resource accounting: %v", err)
+			return
+		}
+		if qre.logStats.ResourceUsage == nil {
+			qre.logStats.ResourceUsage = &tabletenv.ResourceUsage{}
+		}
+		qre.logStats.ResourceUsage.Add(after.Sub(before).Sub(overhead))
+	}
+}
+
+// resourceUsageOverhead returns the overhead of the session status
+// snapshots. The first time, it is measured by taking a second snapshot
+// right after the one of the caller, and that second snapshot replaces
+// the caller's one as the starting point. Concurrent first calls may
+// each measure it: any of the measurements is valid, so the last one
+// stored wins.
+func (qe *QueryEngine) resourceUsageOverhead(ctx context.Context, conn executor, before tabletenv.ResourceUsage) (tabletenv.ResourceUsage, tabletenv.ResourceUsage, error) {
+	if overhead, ok := qe.resourceOverhead.Load().(tabletenv.ResourceUsage); ok {
+		return overhead, before, nil
+	}
+	again, err := readResourceUsage(ctx, conn)
+	if err != nil {
+		return tabletenv.ResourceUsage{}, before, err
+	}
+	overhead := again.Sub(before)
+	qe.resourceOverhead.Store(overhead)
+	return overhead, again, nil
+}
+
+func readResourceUsage(ctx context.Context, conn executor) (tabletenv.ResourceUsage, error) {
+	qr, err := conn.Exec(ctx, tabletenv.ResourceUsageQuery, 100, false)
+	if err != nil {
+		return tabletenv.ResourceUsage{}, err
+	}
+	return tabletenv.NewResourceUsage(qr)
+}
+
 func (qre *QueryExecutor) execStreamSQL(conn *connpool.DBConn, sql string, callback func(*sqltypes.Result) error) error {
 	span, ctx := trace.NewSpan(qre.ctx, "QueryExecutor.execStreamSQL")
 	trace.AnnotateSQL(span, sql)
@@ -688,8 +819,19 @@ func (qre *QueryExecutor) execStreamSQL(conn *connpool.DBConn, sql string, callb
 		return callback(result)
 	}
 
+	if qre.tsv.qe.enableResourceAccounting {
+		defer qre.accountResources(ctx, conn)()
+	}
 	start := time.Now()
-	err := conn.Stream(ctx, sql, callBackClosingSpan, int(qre.tsv.qe.streamBufferSize.Get()), sqltypes.IncludeFieldsOrDefault(qre.options))
+	stream := func(ctx context.Context) error {
+		return conn.Stream(ctx, sql, callBackClosingSpan, int(qre.tsv.qe.streamBufferSize.Get()), sqltypes.IncludeFieldsOrDefault(qre.options))
+	}
+	var err error
+	if qre.maxRuntime != 0 {
+		err = qre.execWithMaxRuntime(ctx, conn, stream)
+	} else {
+		err = stream(ctx)
+	}
 	qre.logStats.AddRewrittenSQL(sql, start)
 	if err != nil {
 		// MySQL error that isn't due to a connection issue
@@ -23,6 +23,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tx"
 
@@ -492,6 +493,22 @@ func TestQueryExecutorPlanPassSelectWithLockOutsideATransaction(t *testing.T) {
 	}
 }
 
+func TestQueryExecutorPlanAlterMigration(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	update := "update _vt.schema_migrations set migration_status='cancel' where migration_status in ('queued', 'running', 'ready') and migration_uuid='9748c3b7_7fdb_11eb_ac2c_f875a4d24e90'"
+	db.AddQuery(update, &sqltypes.Result{RowsAffected: 1})
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	qre := newTestQueryExecutor(ctx, tsv, "alter vitess_migration '9748c3b7_7fdb_11eb_ac2c_f875a4d24e90' cancel", 0)
+	assert.Equal(t, planbuilder.PlanAlterMigration, qre.plan.PlanID)
+	got, err := qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), got.RowsAffected)
+	assert.Equal(t, 1, db.GetQueryCalledNum(update))
+}
+
 func TestQueryExecutorPlanNextval(t *testing.T) {
 	db := setUpQueryExecutorTest(t)
 	defer db.Close()
@@ -1068,6 +1085,183 @@ func TestQueryExecutorBlacklistQRRetry(t *testing.T) {
 	}
 }
 
+func TestQueryExecutorResourceAccounting(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table limit 1000"
+	want := &sqltypes.Result{
+		Fields: getTestTableFields(),
+	}
+	db.AddQuery(query, want)
+
+	statusFields := sqltypes.MakeTestFields("Variable_name|Value", "varchar|varchar")
+	status := sqltypes.MakeTestResult(statusFields,
+		"Handler_read_rnd_next|100",
+		"Created_tmp_tables|1",
+		"Created_tmp_disk_tables|0",
+		"Sort_merge_passes|0",
+	)
+	statusResult := db.AddQuery(tabletenv.ResourceUsageQuery, status)
+	// Bump the counters while the query runs, so that the
+	// snapshot taken after it sees different values.
+	db.SetBeforeFunc(query, func() {
+		statusResult.Rows = sqltypes.MakeTestResult(statusFields,
+			"Handler_read_rnd_next|150",
+			"Created_tmp_tables|3",
+			"Created_tmp_disk_tables|1",
+			"Sort_merge_passes|2",
+		).Rows
+	})
+
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, enableResourceAccounting, db)
+	defer tsv.StopService()
+	qre := newTestQueryExecutor(ctx, tsv, query, 0)
+	got, err := qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	wantUsage := tabletenv.ResourceUsage{
+		RowsExamined:         50,
+		CreatedTmpTables:     2,
+		CreatedTmpDiskTables: 1,
+		SortMergePasses:      2,
+	}
+	assert.Equal(t, &wantUsage, qre.logStats.ResourceUsage)
+	gotUsage, count := qre.plan.ResourceStats()
+	assert.Equal(t, wantUsage, gotUsage)
+	assert.EqualValues(t, 1, count)
+	// The first execution takes an extra snapshot to measure the
+	// overhead of the snapshots.
+	assert.Equal(t, 3, db.GetQueryCalledNum(tabletenv.ResourceUsageQuery))
+
+	// The next ones don't.
+	qre = newTestQueryExecutor(ctx, tsv, query, 0)
+	_, err = qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, 5, db.GetQueryCalledNum(tabletenv.ResourceUsageQuery))
+}
+
+func TestQueryExecutorResourceAccountingOverhead(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table limit 1000"
+	db.AddQuery(query, &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+
+	// Every snapshot reads 5 rows, and the query 50.
+	rowsRead := 100
+	statusFields := sqltypes.MakeTestFields("Variable_name|Value", "varchar|varchar")
+	statusResult := db.AddQuery(tabletenv.ResourceUsageQuery, &sqltypes.Result{})
+	db.SetBeforeFunc(tabletenv.ResourceUsageQuery, func() {
+		rowsRead += 5
+		statusResult.Result = sqltypes.MakeTestResult(statusFields, fmt.Sprintf("Handler_read_rnd_next|%d", rowsRead))
+	})
+	db.SetBeforeFunc(query, func() {
+		rowsRead += 50
+	})
+
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, enableResourceAccounting, db)
+	defer tsv.StopService()
+	for i := 0; i < 2; i++ {
+		qre := newTestQueryExecutor(ctx, tsv, query, 0)
+		_, err := qre.Execute()
+		require.NoError(t, err)
+		assert.Equal(t, &tabletenv.ResourceUsage{RowsExamined: 50}, qre.logStats.ResourceUsage, "execution %d", i)
+	}
+}
+
+func TestQueryExecutorResourceAccountingStream(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table"
+	db.AddQuery(query, &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+
+	statusFields := sqltypes.MakeTestFields("Variable_name|Value", "varchar|varchar")
+	statusResult := db.AddQuery(tabletenv.ResourceUsageQuery, sqltypes.MakeTestResult(statusFields, "Handler_read_rnd_next|100"))
+	db.SetBeforeFunc(query, func() {
+		statusResult.Rows = sqltypes.MakeTestResult(statusFields, "Handler_read_rnd_next|130").Rows
+	})
+
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, enableResourceAccounting, db)
+	defer tsv.StopService()
+	plan, err := tsv.qe.GetStreamPlan(query, false /* isReservedConn */)
+	require.NoError(t, err)
+	logStats := tabletenv.NewLogStats(ctx, "TestQueryExecutorResourceAccountingStream")
+	qre := &QueryExecutor{
+		ctx:      ctx,
+		query:    query,
+		bindVars: make(map[string]*querypb.BindVariable),
+		plan:     plan,
+		logStats: logStats,
+		tsv:      tsv,
+	}
+	err = qre.Stream(func(*sqltypes.Result) error { return nil })
+	require.NoError(t, err)
+
+	assert.Equal(t, &tabletenv.ResourceUsage{RowsExamined: 30}, logStats.ResourceUsage)
+	gotUsage, count := plan.ResourceStats()
+	assert.Equal(t, tabletenv.ResourceUsage{RowsExamined: 30}, gotUsage)
+	assert.EqualValues(t, 1, count)
+}
+
+func TestQueryExecutorMaxRuntimeRule(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table limit 1000"
+	db.AddQuery(query, &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+
+	maxRuntimeRule := rules.NewQueryRule("limit select runtime", "max_runtime", rules.QRMaxRuntime)
+	maxRuntimeRule.SetMaxRuntime(time.Millisecond)
+	maxRuntimeRule.AddPlanCond(planbuilder.PlanSelect)
+	maxRuntimeRule.AddTableCond("test_table")
+	rulesName := "maxRuntimeRules"
+	qrs := rules.New()
+	qrs.Add(maxRuntimeRule)
+
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	tsv.qe.queryRuleSources.RegisterSource(rulesName)
+	defer tsv.qe.queryRuleSources.UnRegisterSource(rulesName)
+	require.NoError(t, tsv.qe.queryRuleSources.SetRules(rulesName, qrs))
+
+	// A query that completes in time is not affected.
+	qre := newTestQueryExecutor(ctx, tsv, query, 0)
+	_, err := qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, time.Millisecond, qre.maxRuntime)
+	assert.Equal(t, "limit select runtime", qre.maxRuntimeRule)
+
+	// Simulate a query that is still running when its limit expires.
+	conn := &testExecConn{testConn: testConn{id: 1, query: query}}
+	err = qre.execWithMaxRuntime(ctx, conn, func(ctx context.Context) error {
+		time.Sleep(2 * time.Millisecond)
+		tsv.qe.killExpiredQueries()
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	assert.Equal(t, vtrpcpb.Code_DEADLINE_EXCEEDED, vterrors.Code(err))
+	assert.Contains(t, err.Error(), "due to rule: limit select runtime")
+	assert.Equal(t, int64(1), tsv.stats.KillCounters.Counts()["MaxRuntimeQueries"])
+	assert.Empty(t, tsv.qe.runtimeQList.GetQueryzRows())
+}
+
+type testExecConn struct {
+	testConn
+}
+
+func (tc *testExecConn) Exec(context.Context, string, int, bool) (*sqltypes.Result, error) {
+	return &sqltypes.Result{}, nil
+}
+
 type executorFlags int64
 
 const (
@@ -1077,6 +1271,7 @@ const (
 	noTwopc
 	shortTwopcAge
 	smallResultSize
+	enableResourceAccounting
 )
 
 // newTestQueryExecutor uses a package level variable testTabletServer defined in tabletserver_test.go
@@ -1107,6 +1302,9 @@ func newTestTabletServer(ctx context.Context, flags executorFlags, db *fakesqldb
 	if flags&smallResultSize > 0 {
 		config.Oltp.MaxRows = 2
 	}
+	if flags&enableResourceAccounting > 0 {
+		config.EnableResourceAccounting = true
+	}
 	tsv := NewTabletServer("TabletServerTest", config, memorytopo.NewServer(""), topodatapb.TabletAlias{})
 	dbconfigs := newDBConfigs(db)
 	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
@@ -24,7 +24,9 @@ import (
 	"time"
 
 	"golang.org/x/net/context"
+	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/vt/callinfo"
+	"vitess.io/vitess/go/vt/log"
 )
 
 // QueryDetail is a simple wrapper for Query, Context and a killable conn.
@@ -33,6 +35,12 @@ type QueryDetail struct {
 	conn   killable
 	connID int64
 	start  time.Time
+
+	// maxRuntime, if set, is the runtime after which
+	// TerminateExpired cancels the query through cancel.
+	maxRuntime time.Duration
+	cancel     context.CancelFunc
+	expired    sync2.AtomicBool
 }
 
 type killable interface {
@@ -46,6 +54,23 @@ func NewQueryDetail(ctx context.Context, conn killable) *QueryDetail {
 	return &QueryDetail{ctx: ctx, conn: conn, connID: conn.ID(), start: time.Now()}
 }
 
+// NewMaxRuntimeQueryDetail creates a new QueryDetail for a query that
+// must not run longer than maxRuntime. The query must be executed with
+// a context that gets canceled by cancel: canceling the context makes
+// the connection kill the query without retrying it.
+func NewMaxRuntimeQueryDetail(ctx context.Context, conn killable, maxRuntime time.Duration, cancel context.CancelFunc) *QueryDetail {
+	qd := NewQueryDetail(ctx, conn)
+	qd.maxRuntime = maxRuntime
+	qd.cancel = cancel
+	return qd
+}
+
+// Expired returns true if the query was terminated
+// because it exceeded its max runtime.
+func (qd *QueryDetail) Expired() bool {
+	return qd.expired.Get()
+}
+
 // QueryList holds a thread safe list of QueryDetails
 type QueryList struct {
 	mu           sync.Mutex
@@ -92,6 +117,27 @@ func (ql *QueryList) TerminateAll() {
 	}
 }
 
+// TerminateExpired terminates the queries that have been running
+// for longer than their max runtime. It returns the number of
+// queries that were terminated.
+func (ql *QueryList) TerminateExpired() int {
+	ql.mu.Lock()
+	defer ql.mu.Unlock()
+	count := 0
+	for _, qd := range ql.queryDetails {
+		if qd.maxRuntime == 0 || qd.expired.Get() {
+			continue
+		}
+		if elapsed := time.Since(qd.start); elapsed >= qd.maxRuntime {
+			log.Infof("Query exceeded max runtime %v, elapsed time: %v, killing query ID %v %s", qd.maxRuntime, elapsed, qd.connID, qd.conn.Current())
+			qd.expired.Set(true)
+			qd.cancel()
+			count++
+		}
+	}
+	return count
+}
+
 // QueryDetailzRow is used for rendering QueryDetail in a template
 type QueryDetailzRow struct {
 	Query             string
@@ -66,3 +66,28 @@ func TestQueryList(t *testing.T) {
 		t.Errorf("failed to remove from QueryList")
 	}
 }
+
+func TestQueryListTerminateExpired(t *testing.T) {
+	ql := NewQueryList()
+	canceled := false
+	qd := NewMaxRuntimeQueryDetail(context.Background(), &testConn{id: 1}, time.Millisecond, func() { canceled = true })
+	ql.Add(qd)
+	// Queries without a max runtime are never terminated.
+	unlimited := &testConn{id: 2}
+	ql.Add(NewQueryDetail(context.Background(), unlimited))
+
+	time.Sleep(2 * time.Millisecond)
+	if got := ql.TerminateExpired(); got != 1 {
+		t.Errorf("TerminateExpired: %d, want 1", got)
+	}
+	if !canceled || !qd.Expired() {
+		t.Errorf("query exceeding its max runtime was not terminated")
+	}
+	if unlimited.IsKilled() {
+		t.Errorf("query without max runtime was killed")
+	}
+	// An expired query is only terminated once.
+	if got := ql.TerminateExpired(); got != 0 {
+		t.Errorf("TerminateExpired: %d, want 0", got)
+	}
+}
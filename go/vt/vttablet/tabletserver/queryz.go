@@ -28,6 +28,7 @@ import (
 	"vitess.io/vitess/go/vt/logz"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 )
 
 var (
@@ -45,6 +46,10 @@ var (
 			<th>MySQL Time per query</th>
 			<th>Rows per query</th>
 			<th>Errors per query</th>
+			<th>Rows Examined per query</th>
+			<th>Tmp Tables per query</th>
+			<th>Tmp Disk Tables per query</th>
+			<th>Sort Merge Passes per query</th>
 		</tr>
         </thead>
 	`)
@@ -62,6 +67,10 @@ var (
 			<td>{{.MysqlTimePQ}}</td>
 			<td>{{.RowsPQ}}</td>
 			<td>{{.ErrorsPQ}}</td>
+			<td>{{.RowsExaminedPQ}}</td>
+			<td>{{.TmpTablesPQ}}</td>
+			<td>{{.TmpDiskTablesPQ}}</td>
+			<td>{{.SortMergePassesPQ}}</td>
 		</tr>
 	`))
 )
@@ -78,6 +87,9 @@ type queryzRow struct {
 	Rows      int64
 	Errors    int64
 	Color     string
+	resources tabletenv.ResourceUsage
+	// resourceCount is the number of executions resources covers.
+	resourceCount int64
 }
 
 // Time returns the total time as a string.
@@ -116,6 +128,34 @@ func (qzs *queryzRow) ErrorsPQ() string {
 	return fmt.Sprintf("%.6f", float64(qzs.Errors)/float64(qzs.Count))
 }
 
+// RowsExaminedPQ returns the rows examined by MySQL per query as a string.
+func (qzs *queryzRow) RowsExaminedPQ() string {
+	return qzs.perQuery(qzs.resources.RowsExamined)
+}
+
+// TmpTablesPQ returns the temporary tables created per query as a string.
+func (qzs *queryzRow) TmpTablesPQ() string {
+	return qzs.perQuery(qzs.resources.CreatedTmpTables)
+}
+
+// TmpDiskTablesPQ returns the on-disk temporary tables created per query
+// as a string.
+func (qzs *queryzRow) TmpDiskTablesPQ() string {
+	return qzs.perQuery(qzs.resources.CreatedTmpDiskTables)
+}
+
+// SortMergePassesPQ returns the sort merge passes per query as a string.
+func (qzs *queryzRow) SortMergePassesPQ() string {
+	return qzs.perQuery(qzs.resources.SortMergePasses)
+}
+
+func (qzs *queryzRow) perQuery(val int64) string {
+	if qzs.resourceCount == 0 {
+		return fmt.Sprintf("%.6f", 0.0)
+	}
+	return fmt.Sprintf("%.6f", float64(val)/float64(qzs.resourceCount))
+}
+
 type queryzSorter struct {
 	rows []*queryzRow
 	less func(row1, row2 *queryzRow) bool
@@ -152,6 +192,7 @@ func queryzHandler(qe *QueryEngine, w http.ResponseWriter, r *http.Request) {
 			Plan:  plan.PlanID,
 		}
 		Value.Count, Value.tm, Value.mysqlTime, Value.Rows, Value.Errors = plan.Stats()
+		Value.resources, Value.resourceCount = plan.ResourceStats()
 		var timepq time.Duration
 		if Value.Count != 0 {
 			timepq = time.Duration(int64(Value.tm) / Value.Count)
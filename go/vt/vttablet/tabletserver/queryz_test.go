@@ -30,6 +30,7 @@ import (
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 )
 
 func TestQueryzHandler(t *testing.T) {
@@ -44,6 +45,19 @@ func TestQueryzHandler(t *testing.T) {
 		},
 	}
 	plan1.AddStats(10, 2*time.Second, 1*time.Second, 2, 0)
+	for i := 0; i < 10; i++ {
+		ru := tabletenv.ResourceUsage{
+			RowsExamined:     5,
+			CreatedTmpTables: 1,
+		}
+		if i%2 == 0 {
+			ru.CreatedTmpDiskTables = 1
+		}
+		if i == 0 {
+			ru.SortMergePasses = 1
+		}
+		plan1.AddResourceUsage(ru)
+	}
 	qe.plans.Set("select name from test_table", plan1)
 
 	plan2 := &TabletPlan{
@@ -95,6 +109,10 @@ func TestQueryzHandler(t *testing.T) {
 		`<td>0.100000</td>`,
 		`<td>0.200000</td>`,
 		`<td>0.000000</td>`,
+		`<td>5.000000</td>`,
+		`<td>1.000000</td>`,
+		`<td>0.500000</td>`,
+		`<td>0.100000</td>`,
 	}
 	checkQueryzHasPlan(t, planPattern1, plan1, body)
 	planPattern2 := []string{
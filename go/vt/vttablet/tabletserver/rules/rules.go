@@ -23,6 +23,7 @@ import (
 	"reflect"
 	"regexp"
 	"strconv"
+	"time"
 
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 
@@ -156,15 +157,32 @@ func (qrs *Rules) FilterByPlan(query string, planid planbuilder.PlanType, tableN
 }
 
 // GetAction runs the input against the rules engine and returns the action to be performed.
+// QRMaxRuntime rules don't stop the query from executing, and are therefore
+// skipped. Use GetMaxRuntime to find out if a runtime limit applies.
 func (qrs *Rules) GetAction(ip, user string, bindVars map[string]*querypb.BindVariable) (action Action, desc string) {
 	for _, qr := range qrs.rules {
-		if act := qr.GetAction(ip, user, bindVars); act != QRContinue {
+		if act := qr.GetAction(ip, user, bindVars); act != QRContinue && act != QRMaxRuntime {
 			return act, qr.Description
 		}
 	}
 	return QRContinue, ""
 }
 
+// GetMaxRuntime runs the input against the QRMaxRuntime rules and returns
+// the smallest runtime limit of the matching rules, along with its description.
+// It returns 0 if no limit applies.
+func (qrs *Rules) GetMaxRuntime(ip, user string, bindVars map[string]*querypb.BindVariable) (maxRuntime time.Duration, desc string) {
+	for _, qr := range qrs.rules {
+		if qr.GetAction(ip, user, bindVars) != QRMaxRuntime {
+			continue
+		}
+		if maxRuntime == 0 || qr.maxRuntime < maxRuntime {
+			maxRuntime, desc = qr.maxRuntime, qr.Description
+		}
+	}
+	return maxRuntime, desc
+}
+
 //-----------------------------------------------
 
 // Rule represents one rule (conditions-action).
@@ -195,6 +213,10 @@ type Rule struct {
 
 	// Action to be performed on trigger
 	act Action
+
+	// maxRuntime is the runtime after which a query is killed.
+	// It's only used by QRMaxRuntime.
+	maxRuntime time.Duration
 }
 
 type namedRegexp struct {
@@ -234,7 +256,8 @@ func (qr *Rule) Equal(other *Rule) bool {
 		reflect.DeepEqual(qr.plans, other.plans) &&
 		reflect.DeepEqual(qr.tableNames, other.tableNames) &&
 		reflect.DeepEqual(qr.bindVarConds, other.bindVarConds) &&
-		qr.act == other.act)
+		qr.act == other.act &&
+		qr.maxRuntime == other.maxRuntime)
 }
 
 // Copy performs a deep copy of a Rule.
@@ -246,6 +269,7 @@ func (qr *Rule) Copy() (newqr *Rule) {
 		user:        qr.user,
 		query:       qr.query,
 		act:         qr.act,
+		maxRuntime:  qr.maxRuntime,
 	}
 	if qr.plans != nil {
 		newqr.plans = make([]planbuilder.PlanType, len(qr.plans))
@@ -288,10 +312,24 @@ func (qr *Rule) MarshalJSON() ([]byte, error) {
 	if qr.act != QRContinue {
 		safeEncode(b, `,"Action":`, qr.act)
 	}
+	if qr.maxRuntime != 0 {
+		safeEncode(b, `,"MaxRuntime":`, qr.maxRuntime.String())
+	}
 	_, _ = b.WriteString("}")
 	return b.Bytes(), nil
 }
 
+// SetMaxRuntime sets the runtime after which a query matching
+// a QRMaxRuntime rule gets killed.
+func (qr *Rule) SetMaxRuntime(maxRuntime time.Duration) {
+	qr.maxRuntime = maxRuntime
+}
+
+// MaxRuntime returns the runtime limit of the rule.
+func (qr *Rule) MaxRuntime() time.Duration {
+	return qr.maxRuntime
+}
+
 // SetIPCond adds a regular expression condition for the client IP.
 // It has to be a full match (not substring).
 func (qr *Rule) SetIPCond(pattern string) (err error) {
@@ -480,6 +518,9 @@ const (
 	QRContinue = Action(iota)
 	QRFail
 	QRFailRetry
+	// QRMaxRuntime lets the query execute, but kills it
+	// if it runs longer than the rule's MaxRuntime.
+	QRMaxRuntime
 )
 
 // MarshalJSON marshals to JSON.
@@ -491,6 +532,8 @@ func (act Action) MarshalJSON() ([]byte, error) {
 		str = "FAIL"
 	case QRFailRetry:
 		str = "FAIL_RETRY"
+	case QRMaxRuntime:
+		str = "MAX_RUNTIME"
 	default:
 		str = "INVALID"
 	}
@@ -781,7 +824,7 @@ func BuildQueryRule(ruleInfo map[string]interface{}) (qr *Rule, err error) {
 		var lv []interface{}
 		var ok bool
 		switch k {
-		case "Name", "Description", "RequestIP", "User", "Query", "Action":
+		case "Name", "Description", "RequestIP", "User", "Query", "Action", "MaxRuntime":
 			sv, ok = v.(string)
 			if !ok {
 				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want string for %s", k)
@@ -851,11 +894,21 @@ func BuildQueryRule(ruleInfo map[string]interface{}) (qr *Rule, err error) {
 				qr.act = QRFail
 			case "FAIL_RETRY":
 				qr.act = QRFailRetry
+			case "MAX_RUNTIME":
+				qr.act = QRMaxRuntime
 			default:
 				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid Action %s", sv)
 			}
+		case "MaxRuntime":
+			qr.maxRuntime, err = time.ParseDuration(sv)
+			if err != nil || qr.maxRuntime <= 0 {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid MaxRuntime %s", sv)
+			}
 		}
 	}
+	if (qr.act == QRMaxRuntime) != (qr.maxRuntime != 0) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "MaxRuntime must be specified if and only if Action is MAX_RUNTIME")
+	}
 	return qr, nil
 }
 
@@ -23,6 +23,9 @@ import (
 	"regexp"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/vterrors"
@@ -524,6 +527,47 @@ func TestAction(t *testing.T) {
 	}
 }
 
+func TestMaxRuntime(t *testing.T) {
+	qrs := New()
+
+	qr1 := NewQueryRule("rule 1", "r1", QRMaxRuntime)
+	qr1.SetMaxRuntime(10 * time.Second)
+	qr1.SetUserCond("user")
+
+	qr2 := NewQueryRule("rule 2", "r2", QRMaxRuntime)
+	qr2.SetMaxRuntime(time.Second)
+	qr2.SetIPCond("123")
+
+	qr3 := NewQueryRule("rule 3", "r3", QRFail)
+	qr3.SetIPCond("456")
+
+	qrs.Add(qr1)
+	qrs.Add(qr2)
+	qrs.Add(qr3)
+
+	// Max runtime rules don't prevent execution.
+	action, _ := qrs.GetAction("123", "user", nil)
+	assert.Equal(t, QRContinue, action)
+	action, desc := qrs.GetAction("456", "user", nil)
+	assert.Equal(t, QRFail, action)
+	assert.Equal(t, "rule 3", desc)
+
+	// The smallest matching limit wins.
+	maxRuntime, desc := qrs.GetMaxRuntime("123", "user", nil)
+	assert.Equal(t, time.Second, maxRuntime)
+	assert.Equal(t, "rule 2", desc)
+	maxRuntime, desc = qrs.GetMaxRuntime("456", "user", nil)
+	assert.Equal(t, 10*time.Second, maxRuntime)
+	assert.Equal(t, "rule 1", desc)
+	maxRuntime, _ = qrs.GetMaxRuntime("456", "user1", nil)
+	assert.Equal(t, time.Duration(0), maxRuntime)
+
+	// The limit is preserved when rules are filtered for a plan.
+	filtered := qrs.FilterByPlan("select * from a", planbuilder.PlanSelect, "a")
+	maxRuntime, _ = filtered.GetMaxRuntime("123", "user", nil)
+	assert.Equal(t, time.Second, maxRuntime)
+}
+
 func TestImport(t *testing.T) {
 	var qrs = New()
 	jsondata := `[{
@@ -550,6 +594,12 @@ func TestImport(t *testing.T) {
 		"Description": "desc2",
 		"Name": "name2",
 		"Action": "FAIL"
+	},{
+		"Description": "desc3",
+		"Name": "name3",
+		"Plans": ["Select"],
+		"Action": "MAX_RUNTIME",
+		"MaxRuntime": "1m30s"
 	}]`
 	err := qrs.UnmarshalJSON([]byte(jsondata))
 	if err != nil {
@@ -672,6 +722,11 @@ var invalidjsons = []InvalidJSONCase{
 	{`[{"BindVarConds": [{"Name": "a", "OnAbsent": true, "OnMismatch": true, "Operator": "NOMATCH", "Value": "["}]}]`, "processing [: error parsing regexp: missing closing ]: `[$`"},
 	{`[{"Action": 1 }]`, "want string for Action"},
 	{`[{"Action": "foo" }]`, "invalid Action foo"},
+	{`[{"MaxRuntime": 1 }]`, "want string for MaxRuntime"},
+	{`[{"Action": "MAX_RUNTIME", "MaxRuntime": "foo" }]`, "invalid MaxRuntime foo"},
+	{`[{"Action": "MAX_RUNTIME", "MaxRuntime": "-1s" }]`, "invalid MaxRuntime -1s"},
+	{`[{"Action": "MAX_RUNTIME" }]`, "MaxRuntime must be specified if and only if Action is MAX_RUNTIME"},
+	{`[{"Action": "FAIL", "MaxRuntime": "1s" }]`, "MaxRuntime must be specified if and only if Action is MAX_RUNTIME"},
 }
 
 func TestInvalidJSON(t *testing.T) {
@@ -104,6 +104,7 @@ func init() {
 	flag.IntVar(&currentConfig.QueryCacheSize, "queryserver-config-query-cache-size", defaultConfig.QueryCacheSize, "query server query cache size, maximum number of queries to be cached. vttablet analyzes every incoming query and generate a query plan, these plans are being cached in a lru cache. This config controls the capacity of the lru cache.")
 	SecondsVar(&currentConfig.SchemaReloadIntervalSeconds, "queryserver-config-schema-reload-time", defaultConfig.SchemaReloadIntervalSeconds, "query server schema reload time, how often vttablet reloads schemas from underlying MySQL instance in seconds. vttablet keeps table schemas in its own memory and periodically refreshes it from MySQL. This config controls the reload time.")
 	SecondsVar(&currentConfig.Oltp.QueryTimeoutSeconds, "queryserver-config-query-timeout", defaultConfig.Oltp.QueryTimeoutSeconds, "query server query timeout (in seconds), this is the query timeout in vttablet side. If a query takes more than this timeout, it will be killed.")
+	SecondsVar(&currentConfig.MaxRuntimeCheckIntervalSeconds, "queryserver-config-max-runtime-check-interval", defaultConfig.MaxRuntimeCheckIntervalSeconds, "query server max runtime check interval (in seconds), how often vttablet looks for queries that exceed the MaxRuntime of a MAX_RUNTIME query rule, and kills them.")
 	SecondsVar(&currentConfig.OltpReadPool.TimeoutSeconds, "queryserver-config-query-pool-timeout", defaultConfig.OltpReadPool.TimeoutSeconds, "query server query pool timeout (in seconds), it is how long vttablet waits for a connection from the query pool. If set to 0 (default) then the overall query timeout is used instead.")
 	SecondsVar(&currentConfig.OlapReadPool.TimeoutSeconds, "queryserver-config-stream-pool-timeout", defaultConfig.OlapReadPool.TimeoutSeconds, "query server stream pool timeout (in seconds), it is how long vttablet waits for a connection from the stream pool. If set to 0 (default) then there is no timeout.")
 	SecondsVar(&currentConfig.TxPool.TimeoutSeconds, "queryserver-config-txpool-timeout", defaultConfig.TxPool.TimeoutSeconds, "query server transaction pool timeout, it is how long vttablet waits if tx pool is full")
@@ -147,6 +148,7 @@ func init() {
 	flag.BoolVar(&enableConsolidator, "enable-consolidator", true, "This option enables the query consolidator.")
 	flag.BoolVar(&enableConsolidatorReplicas, "enable-consolidator-replicas", false, "This option enables the query consolidator only on replicas.")
 	flag.BoolVar(&currentConfig.CacheResultFields, "enable-query-plan-field-caching", defaultConfig.CacheResultFields, "This option fetches & caches fields (columns) when storing query plans")
+	flag.BoolVar(&currentConfig.EnableResourceAccounting, "queryserver-config-enable-resource-accounting", defaultConfig.EnableResourceAccounting, "If true, vttablet samples the MySQL session status around every query to record rows examined, temp tables and sort passes. The results are attached to the query log stats and aggregated in /debug/queryz. This costs two extra round trips to MySQL per statement.")
 
 	flag.DurationVar(&healthCheckInterval, "health_check_interval", 20*time.Second, "Interval between health checks")
 	flag.DurationVar(&degradedThreshold, "degraded_threshold", 30*time.Second, "replication lag after which a replica is considered degraded")
@@ -242,6 +244,11 @@ type TabletConfig struct {
 	TerseErrors                 bool    `json:"terseErrors,omitempty"`
 	MessagePostponeParallelism  int     `json:"messagePostponeParallelism,omitempty"`
 	CacheResultFields           bool    `json:"cacheResultFields,omitempty"`
+	EnableResourceAccounting    bool    `json:"enableResourceAccounting,omitempty"`
+
+	// MaxRuntimeCheckIntervalSeconds is the interval at which queries
+	// are checked against the limits of MAX_RUNTIME query rules.
+	MaxRuntimeCheckIntervalSeconds Seconds `json:"maxRuntimeCheckIntervalSeconds,omitempty"`
 
 	ExternalConnections map[string]*dbconfigs.DBConfigs `json:"externalConnections,omitempty"`
 
@@ -442,6 +449,8 @@ var defaultConfig = TabletConfig{
 	MessagePostponeParallelism:  4,
 	CacheResultFields:           true,
 
+	MaxRuntimeCheckIntervalSeconds: 1,
+
 	EnableTxThrottler:           false,
 	TxThrottlerConfig:           defaultTxThrottlerConfig(),
 	TxThrottlerHealthCheckCells: []string{},
@@ -117,6 +117,7 @@ hotRowProtection:
   maxGlobalQueueSize: 1000
   maxQueueSize: 20
   mode: disable
+maxRuntimeCheckIntervalSeconds: 1
 messagePostponeParallelism: 4
 olapReadPool:
   idleTimeoutSeconds: 1800
@@ -200,8 +201,9 @@ func TestFlags(t *testing.T) {
 			TransactionLimitByUsername:  true,
 			TransactionLimitByPrincipal: true,
 		},
-		EnforceStrictTransTables: true,
-		DB:                       &dbconfigs.DBConfigs{},
+		EnforceStrictTransTables:       true,
+		MaxRuntimeCheckIntervalSeconds: 1,
+		DB:                             &dbconfigs.DBConfigs{},
 	}
 	assert.Equal(t, want.DB, currentConfig.DB)
 	assert.Equal(t, want, currentConfig)
@@ -61,6 +61,8 @@ type LogStats struct {
 	TransactionID        int64
 	ReservedID           int64
 	Error                error
+	// ResourceUsage is only set if resource accounting is enabled.
+	ResourceUsage *ResourceUsage
 }
 
 // NewLogStats constructs a new LogStats with supplied Method and ctx
@@ -202,18 +204,20 @@ func (stats *LogStats) Logf(w io.Writer, params url.Values) error {
 	// TODO: remove username here we fully enforce immediate caller id
 	callInfo, username := stats.CallInfo()
 
-	// Valid options for the QueryLogFormat are text or json
-	var fmtString string
+	// Valid options for the QueryLogFormat are text or json.
+	// The resource usage fields are only logged if resource
+	// accounting is enabled.
+	var fmtString, ruFmtString string
 	switch *streamlog.QueryLogFormat {
 	case streamlog.QueryLogFormatText:
-		fmtString = "%v\t%v\t%v\t'%v'\t'%v'\t%v\t%v\t%.6f\t%v\t%q\t%v\t%v\t%q\t%v\t%.6f\t%.6f\t%v\t%v\t%q\t\n"
+		fmtString = "%v\t%v\t%v\t'%v'\t'%v'\t%v\t%v\t%.6f\t%v\t%q\t%v\t%v\t%q\t%v\t%.6f\t%.6f\t%v\t%v\t%q\t"
+		ruFmtString = "%v\t%v\t%v\t%v\t%v\t%v\t%v\t"
 	case streamlog.QueryLogFormatJSON:
-		fmtString = "{\"Method\": %q, \"CallInfo\": %q, \"Username\": %q, \"ImmediateCaller\": %q, \"Effective Caller\": %q, \"Start\": \"%v\", \"End\": \"%v\", \"TotalTime\": %.6f, \"PlanType\": %q, \"OriginalSQL\": %q, \"BindVars\": %v, \"Queries\": %v, \"RewrittenSQL\": %q, \"QuerySources\": %q, \"MysqlTime\": %.6f, \"ConnWaitTime\": %.6f, \"RowsAffected\": %v, \"ResponseSize\": %v, \"Error\": %q}\n"
+		fmtString = "{\"Method\": %q, \"CallInfo\": %q, \"Username\": %q, \"ImmediateCaller\": %q, \"Effective Caller\": %q, \"Start\": \"%v\", \"End\": \"%v\", \"TotalTime\": %.6f, \"PlanType\": %q, \"OriginalSQL\": %q, \"BindVars\": %v, \"Queries\": %v, \"RewrittenSQL\": %q, \"QuerySources\": %q, \"MysqlTime\": %.6f, \"ConnWaitTime\": %.6f, \"RowsAffected\": %v, \"ResponseSize\": %v, \"Error\": %q"
+		ruFmtString = ", \"RowsExamined\": %v, \"CreatedTmpTables\": %v, \"CreatedTmpDiskTables\": %v, \"SortMergePasses\": %v, \"SortRows\": %v, \"SelectScan\": %v, \"SelectFullJoin\": %v}"
 	}
 
-	_, err := fmt.Fprintf(
-		w,
-		fmtString,
+	args := []interface{}{
 		stats.Method,
 		callInfo,
 		username,
@@ -233,6 +237,15 @@ func (stats *LogStats) Logf(w io.Writer, params url.Values) error {
 		stats.RowsAffected,
 		stats.SizeOfResponse(),
 		stats.ErrorStr(),
-	)
+	}
+	if ru := stats.ResourceUsage; ru != nil {
+		fmtString += ruFmtString
+		args = append(args, ru.RowsExamined, ru.CreatedTmpTables, ru.CreatedTmpDiskTables, ru.SortMergePasses, ru.SortRows, ru.SelectScan, ru.SelectFullJoin)
+	} else if *streamlog.QueryLogFormat == streamlog.QueryLogFormatJSON {
+		fmtString += "}"
+	}
+	fmtString += "\n"
+
+	_, err := fmt.Fprintf(w, fmtString, args...)
 	return err
 }
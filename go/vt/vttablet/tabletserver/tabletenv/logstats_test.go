@@ -118,6 +118,27 @@ func TestLogStatsFormat(t *testing.T) {
 		t.Errorf("logstats format: got:\n%q\nwant:\n%v\n", string(formatted), want)
 	}
 
+	// The resource usage is logged if it was accounted.
+	logStats.ResourceUsage = &ResourceUsage{RowsExamined: 10, CreatedTmpTables: 1}
+	*streamlog.RedactDebugUIQueries = false
+	*streamlog.QueryLogFormat = "text"
+	got = testFormat(logStats, url.Values(params))
+	want = "test\t\t\t''\t''\t2017-01-01 01:02:03.000000\t2017-01-01 01:02:04.000001\t1.000001\t\t\"sql\"\tmap[intVal:type:INT64 value:\"1\" ]\t1\t\"sql with pii\"\tmysql\t0.000000\t0.000000\t0\t1\t\"\"\t10\t1\t0\t0\t0\t0\t0\t\n"
+	if got != want {
+		t.Errorf("logstats format: got:\n%q\nwant:\n%q\n", got, want)
+	}
+	*streamlog.QueryLogFormat = "json"
+	got = testFormat(logStats, url.Values(params))
+	parsed = nil
+	if err := json.Unmarshal([]byte(got), &parsed); err != nil {
+		t.Errorf("logstats format: error unmarshaling json: %v -- got:\n%v", err, got)
+	}
+	if parsed["RowsExamined"] != float64(10) || parsed["CreatedTmpTables"] != float64(1) {
+		t.Errorf("logstats format: missing resource usage in %v", got)
+	}
+	logStats.ResourceUsage = nil
+	parsed = nil
+
 	*streamlog.RedactDebugUIQueries = false
 
 	// Make sure formatting works for string bind vars. We can't do this as part of a single
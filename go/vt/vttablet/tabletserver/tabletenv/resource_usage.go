@@ -0,0 +1,118 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletenv
+
+import (
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+// ResourceUsageQuery fetches the MySQL session status counters
+// that are used to compute ResourceUsage. The counters are cumulative
+// for the connection, so the usage of a statement is the difference
+// between a snapshot taken before and one taken after it. The status
+// query itself adds to some of the counters (it reads a temporary
+// table), so that overhead is measured once with two back to back
+// snapshots, and subtracted from every difference.
+const ResourceUsageQuery = "show session status where variable_name in (" +
+	"'Handler_read_first', 'Handler_read_key', 'Handler_read_last', 'Handler_read_next', " +
+	"'Handler_read_prev', 'Handler_read_rnd', 'Handler_read_rnd_next', " +
+	"'Created_tmp_tables', 'Created_tmp_disk_tables', 'Sort_merge_passes', 'Sort_rows', " +
+	"'Select_scan', 'Select_full_join')"
+
+// ResourceUsage records the MySQL-side cost of one or more statements,
+// as reported by the session status counters. It has no CPU time:
+// MySQL does not report it per session, and the CPU usage of the
+// vttablet process would not say anything about the statement.
+type ResourceUsage struct {
+	// RowsExamined is the sum of the Handler_read_* counters.
+	RowsExamined         int64
+	CreatedTmpTables     int64
+	CreatedTmpDiskTables int64
+	SortMergePasses      int64
+	SortRows             int64
+	SelectScan           int64
+	SelectFullJoin       int64
+}
+
+// NewResourceUsage builds a ResourceUsage from the result of
+// ResourceUsageQuery. Unknown variables are ignored.
+func NewResourceUsage(qr *sqltypes.Result) (ResourceUsage, error) {
+	var ru ResourceUsage
+	for _, row := range qr.Rows {
+		if len(row) != 2 {
+			return ResourceUsage{}, fmt.Errorf("unexpected row in session status: %v", row)
+		}
+		val, err := evalengine.ToInt64(row[1])
+		if err != nil {
+			return ResourceUsage{}, fmt.Errorf("invalid value for %s: %v", row[0].ToString(), err)
+		}
+		name := strings.ToLower(row[0].ToString())
+		switch {
+		case strings.HasPrefix(name, "handler_read_"):
+			ru.RowsExamined += val
+		case name == "created_tmp_tables":
+			ru.CreatedTmpTables = val
+		case name == "created_tmp_disk_tables":
+			ru.CreatedTmpDiskTables = val
+		case name == "sort_merge_passes":
+			ru.SortMergePasses = val
+		case name == "sort_rows":
+			ru.SortRows = val
+		case name == "select_scan":
+			ru.SelectScan = val
+		case name == "select_full_join":
+			ru.SelectFullJoin = val
+		}
+	}
+	return ru, nil
+}
+
+// Add adds the usage of other to ru.
+func (ru *ResourceUsage) Add(other ResourceUsage) {
+	ru.RowsExamined += other.RowsExamined
+	ru.CreatedTmpTables += other.CreatedTmpTables
+	ru.CreatedTmpDiskTables += other.CreatedTmpDiskTables
+	ru.SortMergePasses += other.SortMergePasses
+	ru.SortRows += other.SortRows
+	ru.SelectScan += other.SelectScan
+	ru.SelectFullJoin += other.SelectFullJoin
+}
+
+// Sub returns the difference between ru and an earlier snapshot.
+// Counters that went backwards, for example because the session
+// status was flushed, are reported as zero.
+func (ru ResourceUsage) Sub(earlier ResourceUsage) ResourceUsage {
+	delta := func(now, then int64) int64 {
+		if now < then {
+			return 0
+		}
+		return now - then
+	}
+	return ResourceUsage{
+		RowsExamined:         delta(ru.RowsExamined, earlier.RowsExamined),
+		CreatedTmpTables:     delta(ru.CreatedTmpTables, earlier.CreatedTmpTables),
+		CreatedTmpDiskTables: delta(ru.CreatedTmpDiskTables, earlier.CreatedTmpDiskTables),
+		SortMergePasses:      delta(ru.SortMergePasses, earlier.SortMergePasses),
+		SortRows:             delta(ru.SortRows, earlier.SortRows),
+		SelectScan:           delta(ru.SelectScan, earlier.SelectScan),
+		SelectFullJoin:       delta(ru.SelectFullJoin, earlier.SelectFullJoin),
+	}
+}
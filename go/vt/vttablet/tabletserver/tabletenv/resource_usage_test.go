@@ -0,0 +1,72 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletenv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+func TestNewResourceUsage(t *testing.T) {
+	qr := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("Variable_name|Value", "varchar|varchar"),
+		"Handler_read_key|10",
+		"Handler_read_rnd_next|5",
+		"Created_tmp_tables|2",
+		"Created_tmp_disk_tables|1",
+		"Sort_merge_passes|3",
+		"Sort_rows|7",
+		"Select_scan|4",
+		"Select_full_join|6",
+		"Unknown_counter|100",
+	)
+	got, err := NewResourceUsage(qr)
+	require.NoError(t, err)
+	want := ResourceUsage{
+		RowsExamined:         15,
+		CreatedTmpTables:     2,
+		CreatedTmpDiskTables: 1,
+		SortMergePasses:      3,
+		SortRows:             7,
+		SelectScan:           4,
+		SelectFullJoin:       6,
+	}
+	assert.Equal(t, want, got)
+
+	qr = sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("Variable_name|Value", "varchar|varchar"),
+		"Handler_read_key|abc",
+	)
+	_, err = NewResourceUsage(qr)
+	assert.Error(t, err)
+}
+
+func TestResourceUsageAddSub(t *testing.T) {
+	before := ResourceUsage{RowsExamined: 10, CreatedTmpTables: 1, SortRows: 5}
+	after := ResourceUsage{RowsExamined: 25, CreatedTmpTables: 2, SortRows: 3}
+	delta := after.Sub(before)
+	assert.Equal(t, ResourceUsage{RowsExamined: 15, CreatedTmpTables: 1}, delta)
+
+	var total ResourceUsage
+	total.Add(delta)
+	total.Add(delta)
+	assert.Equal(t, ResourceUsage{RowsExamined: 30, CreatedTmpTables: 2}, total)
+}
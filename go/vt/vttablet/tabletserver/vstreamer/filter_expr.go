@@ -0,0 +1,889 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vstreamer
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// Expr is an expression of a filter rule, evaluated against a row of
+// the table. It is used for where clause constraints and for select
+// expressions other than plain columns. vreplication also uses it
+// for its eval transforms, see BuildExpr.
+// The supported constructs are: columns, literals, comparisons, IN,
+// BETWEEN, LIKE, IS [NOT] NULL, AND, OR, NOT, arithmetic, CASE, and the
+// functions listed in evalengine.Funcs.
+// Collations are not supported: string literals are binary, so a
+// string column compared with a literal is compared byte by byte,
+// case and trailing spaces included. Comparing two non-binary strings,
+// such as two VARCHAR columns, is an error when the expression is built,
+// see evalengine.CheckComparable.
+type Expr interface {
+	Evaluate(row []sqltypes.Value) (sqltypes.Value, error)
+	// Type is the type of the values returned by Evaluate,
+	// used for the field of a select expression.
+	Type() querypb.Type
+	String() string
+}
+
+type (
+	columnExpr struct {
+		colnum int
+		field  *querypb.Field
+	}
+	literalExpr struct {
+		val sqltypes.Value
+	}
+	comparisonExpr struct {
+		op          string
+		left, right Expr
+	}
+	inExpr struct {
+		left   Expr
+		values []Expr
+		negate bool
+	}
+	betweenExpr struct {
+		left, from, to Expr
+		negate         bool
+	}
+	likeExpr struct {
+		left    Expr
+		pattern *regexp.Regexp
+		raw     string
+		negate  bool
+	}
+	isExpr struct {
+		op   string
+		expr Expr
+	}
+	logicalExpr struct {
+		op          string
+		left, right Expr
+	}
+	notExpr struct {
+		expr Expr
+	}
+	arithmeticExpr struct {
+		op          string
+		left, right Expr
+	}
+	caseExpr struct {
+		// expr is nil for a searched case.
+		expr  Expr
+		whens []caseWhen
+		// elseExpr is nil if there is no else.
+		elseExpr Expr
+	}
+	caseWhen struct {
+		cond, val Expr
+	}
+	funcExpr struct {
+		name string
+		fn   evalengine.Func
+		args []Expr
+	}
+)
+
+var (
+	_ Expr = (*columnExpr)(nil)
+	_ Expr = (*literalExpr)(nil)
+	_ Expr = (*comparisonExpr)(nil)
+	_ Expr = (*inExpr)(nil)
+	_ Expr = (*betweenExpr)(nil)
+	_ Expr = (*likeExpr)(nil)
+	_ Expr = (*isExpr)(nil)
+	_ Expr = (*logicalExpr)(nil)
+	_ Expr = (*notExpr)(nil)
+	_ Expr = (*arithmeticExpr)(nil)
+	_ Expr = (*caseExpr)(nil)
+	_ Expr = (*funcExpr)(nil)
+)
+
+// Operators of logicalExpr.
+const (
+	opAnd = "and"
+	opOr  = "or"
+)
+
+var (
+	trueValue  = sqltypes.NewInt64(1)
+	falseValue = sqltypes.NewInt64(0)
+)
+
+func boolValue(b bool) sqltypes.Value {
+	if b {
+		return trueValue
+	}
+	return falseValue
+}
+
+// Evaluate implements the Expr interface
+func (c *columnExpr) Evaluate(row []sqltypes.Value) (sqltypes.Value, error) {
+	if c.colnum >= len(row) {
+		return sqltypes.NULL, fmt.Errorf("index out of range, colnum: %d, len(row): %d", c.colnum, len(row))
+	}
+	return row[c.colnum], nil
+}
+
+// Evaluate implements the Expr interface
+func (l *literalExpr) Evaluate([]sqltypes.Value) (sqltypes.Value, error) {
+	return l.val, nil
+}
+
+// Evaluate implements the Expr interface
+func (c *comparisonExpr) Evaluate(row []sqltypes.Value) (sqltypes.Value, error) {
+	left, err := c.left.Evaluate(row)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	right, err := c.right.Evaluate(row)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	if c.op == sqlparser.NullSafeEqualStr {
+		if left.IsNull() || right.IsNull() {
+			return boolValue(left.IsNull() && right.IsNull()), nil
+		}
+	} else if left.IsNull() || right.IsNull() {
+		return sqltypes.NULL, nil
+	}
+	cmp, err := evalengine.Compare(left, right)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	switch c.op {
+	case sqlparser.EqualStr, sqlparser.NullSafeEqualStr:
+		return boolValue(cmp == 0), nil
+	case sqlparser.NotEqualStr:
+		return boolValue(cmp != 0), nil
+	case sqlparser.LessThanStr:
+		return boolValue(cmp < 0), nil
+	case sqlparser.LessEqualStr:
+		return boolValue(cmp <= 0), nil
+	case sqlparser.GreaterThanStr:
+		return boolValue(cmp > 0), nil
+	case sqlparser.GreaterEqualStr:
+		return boolValue(cmp >= 0), nil
+	}
+	return sqltypes.NULL, fmt.Errorf("unsupported operator: %s", c.op)
+}
+
+// Evaluate implements the Expr interface
+func (in *inExpr) Evaluate(row []sqltypes.Value) (sqltypes.Value, error) {
+	left, err := in.left.Evaluate(row)
+	if err != nil || left.IsNull() {
+		return sqltypes.NULL, err
+	}
+	sawNull := false
+	for _, expr := range in.values {
+		val, err := expr.Evaluate(row)
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		if val.IsNull() {
+			sawNull = true
+			continue
+		}
+		cmp, err := evalengine.Compare(left, val)
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		if cmp == 0 {
+			return boolValue(!in.negate), nil
+		}
+	}
+	if sawNull {
+		return sqltypes.NULL, nil
+	}
+	return boolValue(in.negate), nil
+}
+
+// Evaluate implements the Expr interface
+func (b *betweenExpr) Evaluate(row []sqltypes.Value) (sqltypes.Value, error) {
+	left, err := b.left.Evaluate(row)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	from, err := b.from.Evaluate(row)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	to, err := b.to.Evaluate(row)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	if left.IsNull() || from.IsNull() || to.IsNull() {
+		return sqltypes.NULL, nil
+	}
+	cmpFrom, err := evalengine.Compare(left, from)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	cmpTo, err := evalengine.Compare(left, to)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	return boolValue((cmpFrom >= 0 && cmpTo <= 0) != b.negate), nil
+}
+
+// Evaluate implements the Expr interface
+func (l *likeExpr) Evaluate(row []sqltypes.Value) (sqltypes.Value, error) {
+	left, err := l.left.Evaluate(row)
+	if err != nil || left.IsNull() {
+		return sqltypes.NULL, err
+	}
+	return boolValue(l.pattern.Match(left.Raw()) != l.negate), nil
+}
+
+// Evaluate implements the Expr interface
+func (is *isExpr) Evaluate(row []sqltypes.Value) (sqltypes.Value, error) {
+	val, err := is.expr.Evaluate(row)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	switch is.op {
+	case sqlparser.IsNullStr:
+		return boolValue(val.IsNull()), nil
+	case sqlparser.IsNotNullStr:
+		return boolValue(!val.IsNull()), nil
+	case sqlparser.IsTrueStr:
+		return boolValue(evalengine.IsTrue(val)), nil
+	case sqlparser.IsNotTrueStr:
+		return boolValue(!evalengine.IsTrue(val)), nil
+	case sqlparser.IsFalseStr:
+		return boolValue(!val.IsNull() && !evalengine.IsTrue(val)), nil
+	case sqlparser.IsNotFalseStr:
+		return boolValue(val.IsNull() || evalengine.IsTrue(val)), nil
+	}
+	return sqltypes.NULL, fmt.Errorf("unsupported operator: %s", is.op)
+}
+
+// Evaluate implements the Expr interface. NULLs follow the
+// three-valued logic of MySQL.
+func (l *logicalExpr) Evaluate(row []sqltypes.Value) (sqltypes.Value, error) {
+	left, err := l.left.Evaluate(row)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	// Short-circuit if the result is known.
+	if !left.IsNull() {
+		if l.op == opAnd && !evalengine.IsTrue(left) {
+			return falseValue, nil
+		}
+		if l.op == opOr && evalengine.IsTrue(left) {
+			return trueValue, nil
+		}
+	}
+	right, err := l.right.Evaluate(row)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	if !right.IsNull() {
+		if l.op == opAnd && !evalengine.IsTrue(right) {
+			return falseValue, nil
+		}
+		if l.op == opOr && evalengine.IsTrue(right) {
+			return trueValue, nil
+		}
+	}
+	if left.IsNull() || right.IsNull() {
+		return sqltypes.NULL, nil
+	}
+	// Both sides are true for AND, or false for OR.
+	return boolValue(l.op == opAnd), nil
+}
+
+// Evaluate implements the Expr interface
+func (n *notExpr) Evaluate(row []sqltypes.Value) (sqltypes.Value, error) {
+	val, err := n.expr.Evaluate(row)
+	if err != nil || val.IsNull() {
+		return sqltypes.NULL, err
+	}
+	return boolValue(!evalengine.IsTrue(val)), nil
+}
+
+// Evaluate implements the Expr interface
+func (a *arithmeticExpr) Evaluate(row []sqltypes.Value) (sqltypes.Value, error) {
+	left, err := a.left.Evaluate(row)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	right, err := a.right.Evaluate(row)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	if left.IsNull() || right.IsNull() {
+		return sqltypes.NULL, nil
+	}
+	left, right = evalengine.ToNumber(left), evalengine.ToNumber(right)
+	var result sqltypes.Value
+	switch a.op {
+	case sqlparser.PlusStr:
+		result, err = evalengine.Add(left, right)
+	case sqlparser.MinusStr:
+		result, err = evalengine.Subtract(left, right)
+	case sqlparser.MultStr:
+		result, err = evalengine.Multiply(left, right)
+	case sqlparser.DivStr:
+		if f, _ := evalengine.ToFloat64(right); f == 0 {
+			// Division by zero is NULL in MySQL.
+			return sqltypes.NULL, nil
+		}
+		result, err = evalengine.Divide(left, right)
+	case sqlparser.ModStr:
+		result, err = evalengine.Mod(left, right)
+	default:
+		return sqltypes.NULL, fmt.Errorf("unsupported operator: %s", a.op)
+	}
+	if err != nil || result.IsNull() {
+		return sqltypes.NULL, err
+	}
+	// Make the value match the type of the field.
+	if typ := a.Type(); result.Type() != typ {
+		return evalengine.Cast(result, typ)
+	}
+	return result, nil
+}
+
+// Evaluate implements the Expr interface
+func (c *caseExpr) Evaluate(row []sqltypes.Value) (sqltypes.Value, error) {
+	var base sqltypes.Value
+	if c.expr != nil {
+		var err error
+		if base, err = c.expr.Evaluate(row); err != nil {
+			return sqltypes.NULL, err
+		}
+	}
+	for _, when := range c.whens {
+		cond, err := when.cond.Evaluate(row)
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		matched := evalengine.IsTrue(cond)
+		if c.expr != nil {
+			matched = false
+			if !base.IsNull() && !cond.IsNull() {
+				cmp, err := evalengine.Compare(base, cond)
+				if err != nil {
+					return sqltypes.NULL, err
+				}
+				matched = cmp == 0
+			}
+		}
+		if matched {
+			return c.result(when.val, row)
+		}
+	}
+	if c.elseExpr == nil {
+		return sqltypes.NULL, nil
+	}
+	return c.result(c.elseExpr, row)
+}
+
+// result evaluates a branch of the case, with the type of the case.
+func (c *caseExpr) result(expr Expr, row []sqltypes.Value) (sqltypes.Value, error) {
+	val, err := expr.Evaluate(row)
+	if err != nil || val.IsNull() {
+		return val, err
+	}
+	if typ := c.Type(); val.Type() != typ {
+		if cast, err := evalengine.Cast(val, typ); err == nil {
+			return cast, nil
+		}
+	}
+	return val, nil
+}
+
+// Evaluate implements the Expr interface
+func (f *funcExpr) Evaluate(row []sqltypes.Value) (sqltypes.Value, error) {
+	args := make([]sqltypes.Value, len(f.args))
+	for i, arg := range f.args {
+		val, err := arg.Evaluate(row)
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		args[i] = val
+	}
+	return f.fn.Call(f.argTypes(), args)
+}
+
+func (f *funcExpr) argTypes() []querypb.Type {
+	types := make([]querypb.Type, len(f.args))
+	for i, arg := range f.args {
+		types[i] = arg.Type()
+	}
+	return types
+}
+
+// Type implements the Expr interface
+func (c *columnExpr) Type() querypb.Type {
+	return c.field.Type
+}
+
+// Type implements the Expr interface
+func (l *literalExpr) Type() querypb.Type {
+	return l.val.Type()
+}
+
+// Type implements the Expr interface
+func (c *comparisonExpr) Type() querypb.Type {
+	return sqltypes.Int64
+}
+
+// Type implements the Expr interface
+func (in *inExpr) Type() querypb.Type {
+	return sqltypes.Int64
+}
+
+// Type implements the Expr interface
+func (b *betweenExpr) Type() querypb.Type {
+	return sqltypes.Int64
+}
+
+// Type implements the Expr interface
+func (l *likeExpr) Type() querypb.Type {
+	return sqltypes.Int64
+}
+
+// Type implements the Expr interface
+func (is *isExpr) Type() querypb.Type {
+	return sqltypes.Int64
+}
+
+// Type implements the Expr interface
+func (l *logicalExpr) Type() querypb.Type {
+	return sqltypes.Int64
+}
+
+// Type implements the Expr interface
+func (n *notExpr) Type() querypb.Type {
+	return sqltypes.Int64
+}
+
+// Type implements the Expr interface
+func (a *arithmeticExpr) Type() querypb.Type {
+	ltype, rtype := a.left.Type(), a.right.Type()
+	switch {
+	case a.op == sqlparser.DivStr, sqltypes.IsFloat(ltype), sqltypes.IsFloat(rtype),
+		ltype == sqltypes.Decimal, rtype == sqltypes.Decimal,
+		!sqltypes.IsNumber(ltype), !sqltypes.IsNumber(rtype):
+		return sqltypes.Float64
+	case sqltypes.IsUnsigned(ltype) || sqltypes.IsUnsigned(rtype):
+		return sqltypes.Uint64
+	}
+	return sqltypes.Int64
+}
+
+// Type implements the Expr interface. It's the type of the first
+// branch that is not NULL.
+func (c *caseExpr) Type() querypb.Type {
+	for _, when := range c.whens {
+		if typ := when.val.Type(); typ != sqltypes.Null {
+			return typ
+		}
+	}
+	if c.elseExpr != nil {
+		return c.elseExpr.Type()
+	}
+	return sqltypes.Null
+}
+
+// Type implements the Expr interface
+func (f *funcExpr) Type() querypb.Type {
+	return f.fn.Type(f.argTypes())
+}
+
+// String implements the Expr interface
+func (c *columnExpr) String() string {
+	return sqlparser.String(sqlparser.NewColIdent(c.field.Name))
+}
+
+// String implements the Expr interface
+func (l *literalExpr) String() string {
+	var buf strings.Builder
+	l.val.EncodeSQL(&buf)
+	return buf.String()
+}
+
+// String implements the Expr interface
+func (c *comparisonExpr) String() string {
+	return fmt.Sprintf("%s %s %s", c.left, c.op, c.right)
+}
+
+// String implements the Expr interface
+func (in *inExpr) String() string {
+	values := make([]string, len(in.values))
+	for i, val := range in.values {
+		values[i] = val.String()
+	}
+	op := sqlparser.InStr
+	if in.negate {
+		op = sqlparser.NotInStr
+	}
+	return fmt.Sprintf("%s %s (%s)", in.left, op, strings.Join(values, ", "))
+}
+
+// String implements the Expr interface
+func (b *betweenExpr) String() string {
+	op := sqlparser.BetweenStr
+	if b.negate {
+		op = sqlparser.NotBetweenStr
+	}
+	return fmt.Sprintf("%s %s %s and %s", b.left, op, b.from, b.to)
+}
+
+// String implements the Expr interface
+func (l *likeExpr) String() string {
+	op := sqlparser.LikeStr
+	if l.negate {
+		op = sqlparser.NotLikeStr
+	}
+	return fmt.Sprintf("%s %s %q", l.left, op, l.raw)
+}
+
+// String implements the Expr interface
+func (is *isExpr) String() string {
+	return fmt.Sprintf("%s %s", is.expr, is.op)
+}
+
+// String implements the Expr interface
+func (l *logicalExpr) String() string {
+	return fmt.Sprintf("(%s %s %s)", l.left, l.op, l.right)
+}
+
+// String implements the Expr interface
+func (n *notExpr) String() string {
+	return fmt.Sprintf("not %s", n.expr)
+}
+
+// String implements the Expr interface
+func (a *arithmeticExpr) String() string {
+	return fmt.Sprintf("(%s %s %s)", a.left, a.op, a.right)
+}
+
+// String implements the Expr interface
+func (c *caseExpr) String() string {
+	var buf strings.Builder
+	buf.WriteString("case")
+	if c.expr != nil {
+		fmt.Fprintf(&buf, " %s", c.expr)
+	}
+	for _, when := range c.whens {
+		fmt.Fprintf(&buf, " when %s then %s", when.cond, when.val)
+	}
+	if c.elseExpr != nil {
+		fmt.Fprintf(&buf, " else %s", c.elseExpr)
+	}
+	buf.WriteString(" end")
+	return buf.String()
+}
+
+// String implements the Expr interface
+func (f *funcExpr) String() string {
+	args := make([]string, len(f.args))
+	for i, arg := range f.args {
+		args[i] = arg.String()
+	}
+	return fmt.Sprintf("%s(%s)", f.name, strings.Join(args, ", "))
+}
+
+// BuildExpr converts the AST of an expression into an Expr that can be
+// evaluated against the rows of the table. It returns an error if the
+// expression uses a construct that is not supported.
+func BuildExpr(ti *Table, node sqlparser.Expr) (Expr, error) {
+	return buildExpr(ti, node)
+}
+
+func buildExpr(ti *Table, node sqlparser.Expr) (Expr, error) {
+	switch node := node.(type) {
+	case *sqlparser.ColName:
+		if !node.Qualifier.IsEmpty() {
+			return nil, fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(node))
+		}
+		colnum, err := findColumn(ti, node.Name)
+		if err != nil {
+			return nil, err
+		}
+		return &columnExpr{colnum: colnum, field: ti.Fields[colnum]}, nil
+	case *sqlparser.Literal:
+		val, err := literalValue(node)
+		if err != nil {
+			return nil, err
+		}
+		return &literalExpr{val: val}, nil
+	case *sqlparser.NullVal:
+		return &literalExpr{val: sqltypes.NULL}, nil
+	case sqlparser.BoolVal:
+		return &literalExpr{val: boolValue(bool(node))}, nil
+	case *sqlparser.UnaryExpr:
+		if node.Operator == sqlparser.UPlusStr {
+			return buildExpr(ti, node.Expr)
+		}
+		if node.Operator != sqlparser.UMinusStr {
+			break
+		}
+		if lit, ok := node.Expr.(*sqlparser.Literal); ok && (lit.Type == sqlparser.IntVal || lit.Type == sqlparser.FloatVal) {
+			return buildExpr(ti, &sqlparser.Literal{Type: lit.Type, Val: append([]byte("-"), lit.Val...)})
+		}
+		expr, err := buildExpr(ti, node.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return &arithmeticExpr{op: sqlparser.MinusStr, left: &literalExpr{val: sqltypes.NewInt64(0)}, right: expr}, nil
+	case *sqlparser.ComparisonExpr:
+		return buildComparison(ti, node)
+	case *sqlparser.RangeCond:
+		left, err := buildExpr(ti, node.Left)
+		if err != nil {
+			return nil, err
+		}
+		from, err := buildExpr(ti, node.From)
+		if err != nil {
+			return nil, err
+		}
+		to, err := buildExpr(ti, node.To)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkComparable(node, left, from, to); err != nil {
+			return nil, err
+		}
+		return &betweenExpr{left: left, from: from, to: to, negate: node.Operator == sqlparser.NotBetweenStr}, nil
+	case *sqlparser.IsExpr:
+		expr, err := buildExpr(ti, node.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return &isExpr{op: node.Operator, expr: expr}, nil
+	case *sqlparser.AndExpr:
+		return buildLogical(ti, opAnd, node.Left, node.Right)
+	case *sqlparser.OrExpr:
+		return buildLogical(ti, opOr, node.Left, node.Right)
+	case *sqlparser.NotExpr:
+		expr, err := buildExpr(ti, node.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return &notExpr{expr: expr}, nil
+	case *sqlparser.BinaryExpr:
+		switch node.Operator {
+		case sqlparser.PlusStr, sqlparser.MinusStr, sqlparser.MultStr, sqlparser.DivStr, sqlparser.ModStr:
+		default:
+			return nil, fmt.Errorf("unsupported operator: %v", sqlparser.String(node))
+		}
+		left, err := buildExpr(ti, node.Left)
+		if err != nil {
+			return nil, err
+		}
+		right, err := buildExpr(ti, node.Right)
+		if err != nil {
+			return nil, err
+		}
+		return &arithmeticExpr{op: node.Operator, left: left, right: right}, nil
+	case *sqlparser.CaseExpr:
+		return buildCase(ti, node)
+	case *sqlparser.FuncExpr:
+		return buildFunc(ti, node)
+	case *sqlparser.SubstrExpr:
+		var str sqlparser.Expr = node.StrVal
+		if node.Name != nil {
+			str = node.Name
+		}
+		exprs := []sqlparser.Expr{str, node.From}
+		if node.To != nil {
+			exprs = append(exprs, node.To)
+		}
+		return buildFuncArgs(ti, "substr", exprs, node)
+	}
+	return nil, fmt.Errorf("unsupported: %v", sqlparser.String(node))
+}
+
+func buildComparison(ti *Table, node *sqlparser.ComparisonExpr) (Expr, error) {
+	left, err := buildExpr(ti, node.Left)
+	if err != nil {
+		return nil, err
+	}
+	switch node.Operator {
+	case sqlparser.EqualStr, sqlparser.NotEqualStr, sqlparser.NullSafeEqualStr,
+		sqlparser.LessThanStr, sqlparser.LessEqualStr, sqlparser.GreaterThanStr, sqlparser.GreaterEqualStr:
+		right, err := buildExpr(ti, node.Right)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkComparable(node, left, right); err != nil {
+			return nil, err
+		}
+		return &comparisonExpr{op: node.Operator, left: left, right: right}, nil
+	case sqlparser.InStr, sqlparser.NotInStr:
+		tuple, ok := node.Right.(sqlparser.ValTuple)
+		if !ok {
+			return nil, fmt.Errorf("unsupported: %v", sqlparser.String(node))
+		}
+		in := &inExpr{left: left, negate: node.Operator == sqlparser.NotInStr}
+		for _, val := range tuple {
+			expr, err := buildExpr(ti, val)
+			if err != nil {
+				return nil, err
+			}
+			in.values = append(in.values, expr)
+		}
+		if err := checkComparable(node, left, in.values...); err != nil {
+			return nil, err
+		}
+		return in, nil
+	case sqlparser.LikeStr, sqlparser.NotLikeStr:
+		lit, ok := node.Right.(*sqlparser.Literal)
+		if !ok || lit.Type != sqlparser.StrVal || node.Escape != nil {
+			return nil, fmt.Errorf("unsupported: %v: the pattern must be a string literal", sqlparser.String(node))
+		}
+		// Like the other string literals, the pattern is binary,
+		// so it is matched byte by byte.
+		pattern, err := evalengine.CompileLike(string(lit.Val))
+		if err != nil {
+			return nil, err
+		}
+		return &likeExpr{left: left, pattern: pattern, raw: string(lit.Val), negate: node.Operator == sqlparser.NotLikeStr}, nil
+	}
+	return nil, fmt.Errorf("unsupported operator: %v", sqlparser.String(node))
+}
+
+func buildLogical(ti *Table, op string, leftNode, rightNode sqlparser.Expr) (Expr, error) {
+	left, err := buildExpr(ti, leftNode)
+	if err != nil {
+		return nil, err
+	}
+	right, err := buildExpr(ti, rightNode)
+	if err != nil {
+		return nil, err
+	}
+	return &logicalExpr{op: op, left: left, right: right}, nil
+}
+
+// checkComparable returns an error if left can't be compared
+// with one of the values of node.
+func checkComparable(node sqlparser.SQLNode, left Expr, values ...Expr) error {
+	for _, val := range values {
+		if err := evalengine.CheckComparable(left.Type(), val.Type()); err != nil {
+			return fmt.Errorf("unsupported: %v: %v", sqlparser.String(node), err)
+		}
+	}
+	return nil
+}
+
+func buildCase(ti *Table, node *sqlparser.CaseExpr) (Expr, error) {
+	c := &caseExpr{}
+	if node.Expr != nil {
+		expr, err := buildExpr(ti, node.Expr)
+		if err != nil {
+			return nil, err
+		}
+		c.expr = expr
+	}
+	for _, when := range node.Whens {
+		cond, err := buildExpr(ti, when.Cond)
+		if err != nil {
+			return nil, err
+		}
+		if c.expr != nil {
+			if err := checkComparable(node, c.expr, cond); err != nil {
+				return nil, err
+			}
+		}
+		val, err := buildExpr(ti, when.Val)
+		if err != nil {
+			return nil, err
+		}
+		c.whens = append(c.whens, caseWhen{cond: cond, val: val})
+	}
+	if node.Else != nil {
+		expr, err := buildExpr(ti, node.Else)
+		if err != nil {
+			return nil, err
+		}
+		c.elseExpr = expr
+	}
+	return c, nil
+}
+
+func buildFunc(ti *Table, node *sqlparser.FuncExpr) (Expr, error) {
+	if !node.Qualifier.IsEmpty() || node.Distinct {
+		return nil, fmt.Errorf("unsupported: %v", sqlparser.String(node))
+	}
+	var exprs []sqlparser.Expr
+	for _, selExpr := range node.Exprs {
+		aliased, ok := selExpr.(*sqlparser.AliasedExpr)
+		if !ok || !aliased.As.IsEmpty() {
+			return nil, fmt.Errorf("unsupported: %v", sqlparser.String(node))
+		}
+		exprs = append(exprs, aliased.Expr)
+	}
+	return buildFuncArgs(ti, node.Name.Lowered(), exprs, node)
+}
+
+// buildFuncArgs builds a call to the function name with the arguments
+// exprs. node is the call, for the errors.
+func buildFuncArgs(ti *Table, name string, exprs []sqlparser.Expr, node sqlparser.Expr) (Expr, error) {
+	fn, ok := evalengine.Funcs[name]
+	if !ok {
+		return nil, fmt.Errorf("unsupported function: %v", sqlparser.String(node))
+	}
+	f := &funcExpr{name: name, fn: fn}
+	for _, expr := range exprs {
+		arg, err := buildExpr(ti, expr)
+		if err != nil {
+			return nil, err
+		}
+		f.args = append(f.args, arg)
+	}
+	if len(f.args) < fn.MinArgs || (fn.MaxArgs >= 0 && len(f.args) > fn.MaxArgs) {
+		return nil, fmt.Errorf("incorrect parameter count in the call to %s: %v", name, sqlparser.String(node))
+	}
+	if fn.Check != nil {
+		if err := fn.Check(f.argTypes()); err != nil {
+			return nil, fmt.Errorf("unsupported: %v: %v", sqlparser.String(node), err)
+		}
+	}
+	return f, nil
+}
+
+func literalValue(lit *sqlparser.Literal) (sqltypes.Value, error) {
+	switch lit.Type {
+	case sqlparser.IntVal:
+		if _, err := strconv.ParseInt(string(lit.Val), 10, 64); err == nil {
+			return sqltypes.NewValue(sqltypes.Int64, lit.Val)
+		}
+		return sqltypes.NewValue(sqltypes.Uint64, lit.Val)
+	case sqlparser.FloatVal:
+		return sqltypes.NewValue(sqltypes.Float64, lit.Val)
+	case sqlparser.StrVal:
+		// Unlike in MySQL, string literals are binary: they are
+		// compared byte by byte, like in the equality constraints
+		// that analyzeEqual accepts.
+		return sqltypes.MakeTrusted(sqltypes.VarBinary, lit.Val), nil
+	}
+	return sqltypes.NULL, fmt.Errorf("unsupported literal: %v", sqlparser.String(lit))
+}
@@ -0,0 +1,226 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vstreamer
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestFilterExpr(t *testing.T) {
+	table := &Table{
+		Name: "t1",
+		Fields: []*querypb.Field{{
+			Name: "id",
+			Type: sqltypes.Int64,
+		}, {
+			Name: "name",
+			Type: sqltypes.VarChar,
+		}, {
+			Name: "created",
+			Type: sqltypes.Datetime,
+		}, {
+			Name: "price",
+			Type: sqltypes.Decimal,
+		}, {
+			Name: "login",
+			Type: sqltypes.VarBinary,
+		}},
+	}
+	rows := [][]sqltypes.Value{{
+		sqltypes.NewInt64(1),
+		sqltypes.NewVarChar(" Alice "),
+		sqltypes.MakeTrusted(sqltypes.Datetime, []byte("2020-01-15 10:00:00")),
+		sqltypes.MakeTrusted(sqltypes.Decimal, []byte("10.50")),
+		sqltypes.NewVarBinary("alice"),
+	}, {
+		sqltypes.NewInt64(2),
+		sqltypes.NewVarChar("bob"),
+		sqltypes.MakeTrusted(sqltypes.Datetime, []byte("2020-02-20 11:00:00")),
+		sqltypes.NULL,
+		sqltypes.NewVarBinary("Bob"),
+	}, {
+		sqltypes.NewInt64(3),
+		sqltypes.NULL,
+		sqltypes.NULL,
+		sqltypes.MakeTrusted(sqltypes.Decimal, []byte("3")),
+		sqltypes.NULL,
+	}}
+
+	testcases := []struct {
+		filter string
+		fields string
+		out    []string
+	}{{
+		filter: "select id from t1 where id > 1",
+		out:    []string{"[INT64(2)]", "[INT64(3)]"},
+	}, {
+		filter: "select id from t1 where id != 2 and id <= 3",
+		out:    []string{"[INT64(1)]", "[INT64(3)]"},
+	}, {
+		filter: "select id from t1 where id in (1, 3)",
+		out:    []string{"[INT64(1)]", "[INT64(3)]"},
+	}, {
+		filter: "select id from t1 where id not in (1, 3)",
+		out:    []string{"[INT64(2)]"},
+	}, {
+		filter: "select id from t1 where id between 2 and 5",
+		out:    []string{"[INT64(2)]", "[INT64(3)]"},
+	}, {
+		filter: "select id from t1 where name is null",
+		out:    []string{"[INT64(3)]"},
+	}, {
+		filter: "select id from t1 where name is not null and price is not null",
+		out:    []string{"[INT64(1)]"},
+	}, {
+		filter: "select id from t1 where login like 'B%'",
+		out:    []string{"[INT64(2)]"},
+	}, {
+		filter: "select id from t1 where id = 1 or login = 'Bob'",
+		out:    []string{"[INT64(1)]", "[INT64(2)]"},
+	}, {
+		filter: "select id from t1 where not (id = 1)",
+		out:    []string{"[INT64(2)]", "[INT64(3)]"},
+	}, {
+		filter: "select id from t1 where price > 5",
+		out:    []string{"[INT64(1)]"},
+	}, {
+		filter: "select id from t1 where created >= '2020-02-01'",
+		out:    []string{"[INT64(2)]"},
+	}, {
+		filter: "select id from t1 where year(created) = 2020 and month(created) = 1",
+		out:    []string{"[INT64(1)]"},
+	}, {
+		filter: "select id from t1 where lower(trim(name)) = login",
+		out:    []string{"[INT64(1)]"},
+	}, {
+		filter: "select id from t1 where login in ('alice', 'bob')",
+		out:    []string{"[INT64(1)]"},
+	}, {
+		// String literals are binary, so a VARCHAR column is
+		// compared with them byte by byte.
+		filter: "select id from t1 where name != 'bob'",
+		out:    []string{"[INT64(1)]"},
+	}, {
+		filter: "select id from t1 where name = 'BOB' or name = ' Alice'",
+	}, {
+		filter: "select id from t1 where name in ('bob', 'Bob')",
+		out:    []string{"[INT64(2)]"},
+	}, {
+		filter: "select id from t1 where name not in ('bob')",
+		out:    []string{"[INT64(1)]"},
+	}, {
+		filter: "select id from t1 where name like 'b%' or name > 'z'",
+		out:    []string{"[INT64(2)]"},
+	}, {
+		filter: "select id from t1 where name < 'a'",
+		out:    []string{"[INT64(1)]"},
+	}, {
+		filter: "select id as pk, upper(name) as uname, date(created) as day from t1 where id < 3",
+		fields: "[name:\"pk\" type:INT64  name:\"uname\" type:VARCHAR  name:\"day\" type:DATE ]",
+		out: []string{
+			`[INT64(1) VARCHAR(" ALICE ") DATE("2020-01-15")]`,
+			`[INT64(2) VARCHAR("BOB") DATE("2020-02-20")]`,
+		},
+	}, {
+		// upper doesn't change binary strings, and trim only removes spaces.
+		filter: "select id, upper(login) as ulogin, trim('\\t x ') as t, replace(login, '', 'x') as r from t1 where id < 3",
+		fields: "[name:\"id\" type:INT64  name:\"ulogin\" type:VARBINARY  name:\"t\" type:VARBINARY  name:\"r\" type:VARBINARY ]",
+		out: []string{
+			`[INT64(1) VARBINARY("alice") VARBINARY("\t x") VARBINARY("alice")]`,
+			`[INT64(2) VARBINARY("Bob") VARBINARY("\t x") VARBINARY("Bob")]`,
+		},
+	}, {
+		filter: "select id, case when id % 2 = 0 then 'even' else 'odd' end as parity from t1 where id < 3",
+		fields: "[name:\"id\" type:INT64  name:\"parity\" type:VARBINARY ]",
+		out:    []string{`[INT64(1) VARBINARY("odd")]`, `[INT64(2) VARBINARY("even")]`},
+	}, {
+		filter: "select id, concat(left(trim(name), 2), '-', id) as code, ifnull(price, 0) as price from t1 where id < 3",
+		fields: "[name:\"id\" type:INT64  name:\"code\" type:VARBINARY  name:\"price\" type:DECIMAL ]",
+		out: []string{
+			`[INT64(1) VARBINARY("Al-1") DECIMAL(10.50)]`,
+			`[INT64(2) VARBINARY("bo-2") DECIMAL(0)]`,
+		},
+	}, {
+		filter: "select id, id * 2 + 1 as odd, price / 0 as nothing from t1 where id = 1",
+		fields: "[name:\"id\" type:INT64  name:\"odd\" type:INT64  name:\"nothing\" type:FLOAT64 ]",
+		out:    []string{"[INT64(1) INT64(3) NULL]"},
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.filter, func(t *testing.T) {
+			plan, err := buildPlan(table, testLocalVSchema, &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{Match: "t1", Filter: tcase.filter}},
+			})
+			require.NoError(t, err)
+			if tcase.fields != "" {
+				assert.Equal(t, tcase.fields, fmt.Sprintf("%v", plan.fields()))
+			}
+			var got []string
+			for _, row := range rows {
+				ok, values, err := plan.filter(row)
+				require.NoError(t, err)
+				if ok {
+					got = append(got, fmt.Sprintf("%v", values))
+				}
+			}
+			assert.Equal(t, tcase.out, got)
+		})
+	}
+}
+
+func TestFilterExprErrors(t *testing.T) {
+	table := &Table{
+		Name: "t1",
+		Fields: []*querypb.Field{{
+			Name: "id",
+			Type: sqltypes.Int64,
+		}, {
+			Name: "name",
+			Type: sqltypes.VarChar,
+		}},
+	}
+	testcases := []struct {
+		filter string
+		err    string
+	}{{
+		filter: "select id from t1 where name != upper(name)",
+		err:    "unsupported constraint: name != upper(name): unsupported: name != upper(name): cannot compare VARCHAR with VARCHAR: collations are not supported, one of the values must be binary",
+	}, {
+		filter: "select id from t1 where name in (lower(name), 'b')",
+		err:    "unsupported constraint: name in (lower(name), 'b'): unsupported: name in (lower(name), 'b'): cannot compare VARCHAR with VARCHAR: collations are not supported, one of the values must be binary",
+	}, {
+		filter: "select id, nullif(name, trim(name)) as n from t1",
+		err:    "unsupported: nullif(name, trim(name)): cannot compare VARCHAR with VARCHAR: collations are not supported, one of the values must be binary",
+	}, {
+		filter: "select id, upper(name, id) as n from t1",
+		err:    "incorrect parameter count in the call to upper: upper(name, id)",
+	}}
+	for _, tcase := range testcases {
+		_, err := buildPlan(table, testLocalVSchema, &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{Match: "t1", Filter: tcase.filter}},
+		})
+		assert.EqualError(t, err, tcase.err, tcase.filter)
+	}
+}
@@ -21,6 +21,8 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/golang/protobuf/proto"
+
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 
 	"vitess.io/vitess/go/mysql"
@@ -55,6 +57,9 @@ const (
 	Equal = Opcode(iota)
 	// VindexMatch is used for an in_keyrange() construct
 	VindexMatch
+	// ExprMatch is used for any other constraint. The row
+	// matches if the expression evaluates to true.
+	ExprMatch
 )
 
 // Filter contains opcodes for filtering.
@@ -71,6 +76,9 @@ type Filter struct {
 	Vindex        vindexes.Vindex
 	VindexColumns []int
 	KeyRange      *topodatapb.KeyRange
+
+	// Expr is the constraint for ExprMatch.
+	Expr Expr
 }
 
 // ColExpr represents a column expression.
@@ -85,6 +93,10 @@ type ColExpr struct {
 	Vindex        vindexes.Vindex
 	VindexColumns []int
 
+	// Expr, if set, is evaluated to generate the value.
+	// If so, ColNum is ignored.
+	Expr Expr
+
 	Field *querypb.Field
 }
 
@@ -124,11 +136,27 @@ func (plan *Plan) filter(values []sqltypes.Value) (bool, []sqltypes.Value, error
 			if !key.KeyRangeContains(filter.KeyRange, ksid) {
 				return false, nil, nil
 			}
+		case ExprMatch:
+			result, err := filter.Expr.Evaluate(values)
+			if err != nil {
+				return false, nil, err
+			}
+			if !evalengine.IsTrue(result) {
+				return false, nil, nil
+			}
 		}
 	}
 
 	result := make([]sqltypes.Value, len(plan.ColExprs))
 	for i, colExpr := range plan.ColExprs {
+		if colExpr.Expr != nil {
+			val, err := colExpr.Expr.Evaluate(values)
+			if err != nil {
+				return false, nil, err
+			}
+			result[i] = val
+			continue
+		}
 		if colExpr.ColNum >= len(values) {
 			return false, nil, fmt.Errorf("index out of range, colExpr.ColNum: %d, len(values): %d", colExpr.ColNum, len(values))
 		}
@@ -347,54 +375,68 @@ func (plan *Plan) analyzeWhere(vschema *localVSchema, where *sqlparser.Where) er
 	}
 	exprs := splitAndExpression(nil, where.Expr)
 	for _, expr := range exprs {
-		switch expr := expr.(type) {
-		case *sqlparser.ComparisonExpr:
-			qualifiedName, ok := expr.Left.(*sqlparser.ColName)
-			if !ok {
-				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
-			}
-			if !qualifiedName.Qualifier.IsEmpty() {
-				return fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(qualifiedName))
-			}
-			colnum, err := findColumn(plan.Table, qualifiedName.Name)
-			if err != nil {
-				return err
-			}
-			val, ok := expr.Right.(*sqlparser.Literal)
-			if !ok {
-				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
-			}
-			//StrVal is varbinary, we do not support varchar since we would have to implement all collation types
-			if val.Type != sqlparser.IntVal && val.Type != sqlparser.StrVal {
-				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
-			}
-			pv, err := sqlparser.NewPlanValue(val)
-			if err != nil {
-				return err
-			}
-			resolved, err := pv.ResolveValue(nil)
-			if err != nil {
-				return err
-			}
-			plan.Filters = append(plan.Filters, Filter{
-				Opcode: Equal,
-				ColNum: colnum,
-				Value:  resolved,
-			})
-		case *sqlparser.FuncExpr:
-			if !expr.Name.EqualString("in_keyrange") {
-				return fmt.Errorf("unsupported constraint: %v", sqlparser.String(expr))
-			}
-			if err := plan.analyzeInKeyRange(vschema, expr.Exprs); err != nil {
+		if filter, ok, err := plan.analyzeEqual(expr); err != nil {
+			return err
+		} else if ok {
+			plan.Filters = append(plan.Filters, filter)
+			continue
+		}
+		if funcExpr, ok := expr.(*sqlparser.FuncExpr); ok && funcExpr.Name.EqualString("in_keyrange") {
+			if err := plan.analyzeInKeyRange(vschema, funcExpr.Exprs); err != nil {
 				return err
 			}
-		default:
-			return fmt.Errorf("unsupported constraint: %v", sqlparser.String(expr))
+			continue
+		}
+		filterExpr, err := buildExpr(plan.Table, expr)
+		if err != nil {
+			return fmt.Errorf("unsupported constraint: %v: %v", sqlparser.String(expr), err)
 		}
+		plan.Filters = append(plan.Filters, Filter{
+			Opcode: ExprMatch,
+			Expr:   filterExpr,
+		})
 	}
 	return nil
 }
 
+// analyzeEqual returns an Equal filter if expr is an equality
+// between a column and an integer or string literal.
+func (plan *Plan) analyzeEqual(expr sqlparser.Expr) (Filter, bool, error) {
+	comparison, ok := expr.(*sqlparser.ComparisonExpr)
+	if !ok || comparison.Operator != sqlparser.EqualStr {
+		return Filter{}, false, nil
+	}
+	qualifiedName, ok := comparison.Left.(*sqlparser.ColName)
+	if !ok {
+		return Filter{}, false, nil
+	}
+	val, ok := comparison.Right.(*sqlparser.Literal)
+	//StrVal is varbinary, we do not support varchar since we would have to implement all collation types
+	if !ok || (val.Type != sqlparser.IntVal && val.Type != sqlparser.StrVal) {
+		return Filter{}, false, nil
+	}
+	if !qualifiedName.Qualifier.IsEmpty() {
+		return Filter{}, false, fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(qualifiedName))
+	}
+	colnum, err := findColumn(plan.Table, qualifiedName.Name)
+	if err != nil {
+		return Filter{}, false, err
+	}
+	pv, err := sqlparser.NewPlanValue(val)
+	if err != nil {
+		return Filter{}, false, err
+	}
+	resolved, err := pv.ResolveValue(nil)
+	if err != nil {
+		return Filter{}, false, err
+	}
+	return Filter{
+		Opcode: Equal,
+		ColNum: colnum,
+		Value:  resolved,
+	}, true, nil
+}
+
 // splitAndExpression breaks up the Expr into AND-separated conditions
 // and appends them to filters, which can be shuffled and recombined
 // as needed.
@@ -446,17 +488,19 @@ func (plan *Plan) analyzeExpr(vschema *localVSchema, selExpr sqlparser.SelectExp
 		if err != nil {
 			return ColExpr{}, err
 		}
-		as := aliased.As
-		if as.IsEmpty() {
-			as = sqlparser.NewColIdent(sqlparser.String(aliased.Expr))
+		field := plan.Table.Fields[colnum]
+		if !aliased.As.IsEmpty() {
+			// The column is renamed.
+			field = proto.Clone(field).(*querypb.Field)
+			field.Name = aliased.As.String()
 		}
 		return ColExpr{
 			ColNum: colnum,
-			Field:  plan.Table.Fields[colnum],
+			Field:  field,
 		}, nil
 	case *sqlparser.FuncExpr:
 		if inner.Name.Lowered() != "keyspace_id" {
-			return ColExpr{}, fmt.Errorf("unsupported function: %v", sqlparser.String(inner))
+			return plan.analyzeExprValue(aliased)
 		}
 		if len(inner.Exprs) != 0 {
 			return ColExpr{}, fmt.Errorf("unexpected: %v", sqlparser.String(inner))
@@ -469,19 +513,43 @@ func (plan *Plan) analyzeExpr(vschema *localVSchema, selExpr sqlparser.SelectExp
 		if err != nil {
 			return ColExpr{}, err
 		}
+		name := "keyspace_id"
+		if !aliased.As.IsEmpty() {
+			name = aliased.As.String()
+		}
 		return ColExpr{
 			Field: &querypb.Field{
-				Name: "keyspace_id",
+				Name: name,
 				Type: sqltypes.VarBinary,
 			},
 			Vindex:        cv.Vindex,
 			VindexColumns: vindexColumns,
 		}, nil
 	default:
-		return ColExpr{}, fmt.Errorf("unsupported: %v", sqlparser.String(aliased.Expr))
+		return plan.analyzeExprValue(aliased)
 	}
 }
 
+// analyzeExprValue handles select expressions that are evaluated
+// for every row, like functions and arithmetic.
+func (plan *Plan) analyzeExprValue(aliased *sqlparser.AliasedExpr) (ColExpr, error) {
+	expr, err := buildExpr(plan.Table, aliased.Expr)
+	if err != nil {
+		return ColExpr{}, err
+	}
+	name := aliased.As.String()
+	if name == "" {
+		name = sqlparser.String(aliased.Expr)
+	}
+	return ColExpr{
+		Expr: expr,
+		Field: &querypb.Field{
+			Name: name,
+			Type: expr.Type(),
+		},
+	}, nil
+}
+
 // analyzeInKeyRange allows the following constructs: "in_keyrange('-80')",
 // "in_keyrange(col, 'hash', '-80')", "in_keyrange(col, 'local_vindex', '-80')", or
 // "in_keyrange(col, 'ks.external_vindex', '-80')".
@@ -492,7 +492,7 @@ func TestPlanbuilder(t *testing.T) {
 	}, {
 		inTable: t1,
 		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where max(id)"},
-		outErr:  `unsupported constraint: max(id)`,
+		outErr:  `unsupported constraint: max(id): unsupported function: max(id)`,
 	}, {
 		inTable: t1,
 		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where in_keyrange(id)"},
@@ -532,8 +532,26 @@ func TestPlanbuilder(t *testing.T) {
 		outErr:  `unsupported function: max(val)`,
 	}, {
 		inTable: t1,
-		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id+1, val from t1"},
-		outErr:  `unsupported: id + 1`,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id+1, val from t1 where val = now()"},
+		outErr:  `unsupported constraint: val = now(): unsupported function: now()`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id as pk, val from t1"},
+		outPlan: &Plan{
+			ColExprs: []ColExpr{{
+				ColNum: 0,
+				Field: &querypb.Field{
+					Name: "pk",
+					Type: sqltypes.Int64,
+				},
+			}, {
+				ColNum: 1,
+				Field: &querypb.Field{
+					Name: "val",
+					Type: sqltypes.VarBinary,
+				},
+			}},
+		},
 	}, {
 		inTable: t1,
 		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select t1.id, val from t1"},
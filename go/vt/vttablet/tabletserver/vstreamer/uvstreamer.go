@@ -120,6 +120,8 @@ func newUVStreamer(ctx context.Context, vse *Engine, cp dbconfigs.Connector, se
 // it can be called
 //		the first time, with just the filter and an empty pos
 //		during a restart, with both the filter and list of TableLastPK from the vgtid
+//		with a pos and a list of TableLastPK, to copy only the listed tables: this is
+//		how tables are added to a running stream, the other tables continue from pos
 func (uvs *uvstreamer) buildTablePlan() error {
 	uvs.plans = make(map[string]*tablePlan)
 	tableLastPKs := make(map[string]*binlogdatapb.TableLastPK)
@@ -145,20 +147,23 @@ func (uvs *uvstreamer) buildTablePlan() error {
 		if rule == nil {
 			continue
 		}
-		plan := &tablePlan{
-			tablePK: nil,
-			rule: &binlogdatapb.Rule{
-				Filter: rule.Filter,
-				Match:  rule.Match,
-			},
-		}
 		tablePK, ok := tableLastPKs[tableName]
+		if !ok && uvs.startPos != "" {
+			continue
+		}
 		if !ok {
 			tablePK = &binlogdatapb.TableLastPK{
 				TableName: tableName,
 				Lastpk:    nil,
 			}
 		}
+		plan := &tablePlan{
+			tablePK: nil,
+			rule: &binlogdatapb.Rule{
+				Filter: rule.Filter,
+				Match:  rule.Match,
+			},
+		}
 		plan.tablePK = tablePK
 		uvs.plans[tableName] = plan
 		uvs.tablesToCopy = append(uvs.tablesToCopy, tableName)
@@ -340,7 +345,8 @@ func (uvs *uvstreamer) init() error {
 		if err := uvs.setStreamStartPosition(); err != nil {
 			return err
 		}
-	} else if uvs.startPos == "" || len(uvs.inTablePKs) > 0 {
+	}
+	if uvs.startPos == "" || len(uvs.inTablePKs) > 0 {
 		if err := uvs.buildTablePlan(); err != nil {
 			return err
 		}
@@ -162,6 +162,13 @@ func TestVStreamCopyFilterValidations(t *testing.T) {
 		log.Infof("Running %v", tc.rules)
 		testFilter(tc.rules, tc.tablePKs, tc.expected, tc.expectedError)
 	}
+
+	// With a start position, only the tables in tablePKs are copied.
+	uvs := getUVStreamer(&binlogdatapb.Filter{Rules: []*binlogdatapb.Rule{{Match: "/.*"}}}, []*binlogdatapb.TableLastPK{{TableName: "t2a"}})
+	uvs.startPos = "current"
+	require.NoError(t, uvs.buildTablePlan())
+	require.Equal(t, []string{"t2a"}, uvs.tablesToCopy)
+	require.Nil(t, uvs.plans["t2a"].tablePK.Lastpk)
 }
 
 func TestVStreamCopyCompleteFlow(t *testing.T) {
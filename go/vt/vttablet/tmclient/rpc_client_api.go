@@ -225,8 +225,10 @@ type TabletManagerClient interface {
 	// Backup creates a database backup
 	Backup(ctx context.Context, tablet *topodatapb.Tablet, concurrency int, allowMaster bool) (logutil.EventStream, error)
 
-	// RestoreFromBackup deletes local data and restores database from backup
-	RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet) (logutil.EventStream, error)
+	// RestoreFromBackup deletes local data and restores database from backup.
+	// If restoreToPos or restoreToTime is set, the binlog backups are replayed
+	// on top of the backup up to that point.
+	RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet, restoreToPos string, restoreToTime time.Time) (logutil.EventStream, error)
 
 	//
 	// Management methods
@@ -57,8 +57,9 @@ const (
 	createDDLAsCopyDropConstraint = "copy:drop_constraint"
 )
 
-// MoveTables initiates moving table(s) over to another keyspace
-func (wr *Wrangler) MoveTables(ctx context.Context, workflow, sourceKeyspace, targetKeyspace, tableSpecs, cell, tabletTypes string) error {
+// MoveTables initiates moving table(s) over to another keyspace.
+// onDDL is the name of the binlogdata.OnDDLAction of the streams.
+func (wr *Wrangler) MoveTables(ctx context.Context, workflow, sourceKeyspace, targetKeyspace, tableSpecs, cell, tabletTypes, onDDL string) error {
 	var tables []string
 	var vschema *vschemapb.Keyspace
 	if strings.HasPrefix(tableSpecs, "{") {
@@ -112,6 +113,7 @@ func (wr *Wrangler) MoveTables(ctx context.Context, workflow, sourceKeyspace, ta
 		TargetKeyspace: targetKeyspace,
 		Cell:           cell,
 		TabletTypes:    tabletTypes,
+		OnDdl:          onDDL,
 	}
 	for _, table := range tables {
 		buf := sqlparser.NewTrackedBuffer(nil)
@@ -535,6 +537,9 @@ func (wr *Wrangler) ExternalizeVindex(ctx context.Context, qualifiedVindexName s
 
 // Materialize performs the steps needed to materialize a list of tables based on the materialization specs.
 func (wr *Wrangler) Materialize(ctx context.Context, ms *vtctldatapb.MaterializeSettings) error {
+	if _, ok := binlogdatapb.OnDDLAction_value[ms.OnDdl]; ms.OnDdl != "" && !ok {
+		return fmt.Errorf("invalid on_ddl action %s, must be one of IGNORE, STOP, EXEC, EXEC_IGNORE or EXEC_COLUMNS", ms.OnDdl)
+	}
 	if err := wr.validateNewWorkflow(ctx, ms.TargetKeyspace, ms.Workflow); err != nil {
 		return err
 	}
@@ -556,6 +561,9 @@ func (wr *Wrangler) Materialize(ctx context.Context, ms *vtctldatapb.Materialize
 }
 
 func (wr *Wrangler) buildMaterializer(ctx context.Context, ms *vtctldatapb.MaterializeSettings) (*materializer, error) {
+	if err := validateHelperTables(ms); err != nil {
+		return nil, err
+	}
 	vschema, err := wr.ts.GetVSchema(ctx, ms.TargetKeyspace)
 	if err != nil {
 		return nil, err
@@ -569,6 +577,17 @@ func (wr *Wrangler) buildMaterializer(ctx context.Context, ms *vtctldatapb.Mater
 			if targetVSchema.Tables[ts.TargetTable] == nil {
 				return nil, fmt.Errorf("table %s not found in vschema for keyspace %s", ts.TargetTable, ms.TargetKeyspace)
 			}
+			if targetVSchema.Tables[ts.TargetTable].Type == vindexes.TypeReference {
+				continue
+			}
+			// The rows of a join can't be filtered by keyrange.
+			helpers, err := vreplication.JoinHelpers(ts.TargetTable, ts.SourceExpression)
+			if err != nil {
+				return nil, err
+			}
+			if helpers != nil {
+				return nil, fmt.Errorf("a join cannot be materialized into the sharded table %s: %s", ts.TargetTable, ts.SourceExpression)
+			}
 		}
 	}
 
@@ -616,6 +635,22 @@ func (mz *materializer) deploySchema(ctx context.Context) error {
 	var sourceDDLs map[string]string
 	var mu sync.Mutex
 
+	getSourceDDLs := func() error {
+		var err error
+		mu.Lock()
+		if len(sourceDDLs) == 0 {
+			//only get ddls for tables, once and lazily: if we need to copy the schema from source to target
+			//we copy schemas from masters on the source keyspace
+			//and we have found use cases where user just has a replica (no master) in the source keyspace
+			sourceDDLs, err = mz.getSourceTableDDLs(ctx)
+		}
+		mu.Unlock()
+		if err != nil {
+			log.Errorf("Error getting DDLs of source tables: %s", err.Error())
+		}
+		return err
+	}
+
 	return mz.forAllTargets(func(target *topo.ShardInfo) error {
 		allTables := []string{"/.*/"}
 
@@ -627,8 +662,10 @@ func (mz *materializer) deploySchema(ctx context.Context) error {
 		}
 		log.Infof("got table schemas from target master %v.", target.MasterAlias)
 
+		targetDDLs := make(map[string]string)
 		for _, td := range targetSchema.TableDefinitions {
 			hasTargetTable[td.Name] = true
+			targetDDLs[td.Name] = td.Schema
 		}
 
 		targetTablet, err := mz.wr.ts.GetTablet(ctx, target.MasterAlias)
@@ -646,17 +683,7 @@ func (mz *materializer) deploySchema(ctx context.Context) error {
 				return fmt.Errorf("target table %v does not exist and there is no create ddl defined", ts.TargetTable)
 			}
 
-			var err error
-			mu.Lock()
-			if len(sourceDDLs) == 0 {
-				//only get ddls for tables, once and lazily: if we need to copy the schema from source to target
-				//we copy schemas from masters on the source keyspace
-				//and we have found use cases where user just has a replica (no master) in the source keyspace
-				sourceDDLs, err = mz.getSourceTableDDLs(ctx)
-			}
-			mu.Unlock()
-			if err != nil {
-				log.Errorf("Error getting DDLs of source tables: %s", err.Error())
+			if err := getSourceDDLs(); err != nil {
 				return err
 			}
 
@@ -691,6 +718,61 @@ func (mz *materializer) deploySchema(ctx context.Context) error {
 			}
 
 			applyDDLs = append(applyDDLs, createDDL)
+			targetDDLs[ts.TargetTable] = createDDL
+		}
+
+		// Create the helper tables of the aggregates and joins that need them.
+		for _, ts := range mz.ms.TableSettings {
+			if ts.SourceExpression == "" {
+				continue
+			}
+			helpers, err := vreplication.AggregateHelpers(ts.TargetTable, ts.SourceExpression)
+			if err != nil {
+				return err
+			}
+			for _, helper := range helpers {
+				if hasTargetTable[helper.Table] {
+					continue
+				}
+				if err := getSourceDDLs(); err != nil {
+					return err
+				}
+				sourceTableName, err := sqlparser.TableFromStatement(ts.SourceExpression)
+				if err != nil {
+					return err
+				}
+				sourceDDL, ok := sourceDDLs[sourceTableName.Name.String()]
+				if !ok {
+					return fmt.Errorf("source table %v does not exist", sqlparser.String(sourceTableName))
+				}
+				ddl, err := aggregateHelperDDL(helper, targetDDLs[ts.TargetTable], sourceDDL)
+				if err != nil {
+					return err
+				}
+				applyDDLs = append(applyDDLs, ddl)
+			}
+
+			joinHelpers, err := vreplication.JoinHelpers(ts.TargetTable, ts.SourceExpression)
+			if err != nil {
+				return err
+			}
+			for _, helper := range joinHelpers {
+				if hasTargetTable[helper.Table] {
+					continue
+				}
+				if err := getSourceDDLs(); err != nil {
+					return err
+				}
+				sourceDDL, ok := sourceDDLs[helper.SourceTable]
+				if !ok {
+					return fmt.Errorf("source table %v does not exist", helper.SourceTable)
+				}
+				ddl, err := joinHelperDDL(helper, sourceDDL)
+				if err != nil {
+					return err
+				}
+				applyDDLs = append(applyDDLs, ddl)
+			}
 		}
 
 		if len(applyDDLs) > 0 {
@@ -712,6 +794,151 @@ func (mz *materializer) deploySchema(ctx context.Context) error {
 	})
 }
 
+// validateHelperTables returns an error if a helper table of a target
+// table collides with another target table or another helper table.
+func validateHelperTables(ms *vtctldatapb.MaterializeSettings) error {
+	targetTables := make(map[string]bool)
+	for _, ts := range ms.TableSettings {
+		targetTables[ts.TargetTable] = true
+	}
+	helpers := make(map[string]string)
+	for _, ts := range ms.TableSettings {
+		if ts.SourceExpression == "" {
+			continue
+		}
+		names, err := vreplication.HelperTables(ts.TargetTable, ts.SourceExpression)
+		if err != nil {
+			return err
+		}
+		for _, name := range names {
+			if targetTables[name] {
+				return fmt.Errorf("helper table %s of %s is also a target table", name, ts.TargetTable)
+			}
+			if other, ok := helpers[name]; ok && other != ts.TargetTable {
+				return fmt.Errorf("helper table %s of %s is also a helper table of %s", name, ts.TargetTable, other)
+			}
+			helpers[name] = ts.TargetTable
+		}
+	}
+	return nil
+}
+
+// aggregateHelperDDL returns the create statement of the helper table
+// of an aggregate. The group by columns take their types from the target
+// table and the val column takes the type of the aggregated source column.
+// All of them make the primary key, so none of them can be a TEXT, BLOB,
+// JSON or spatial column, which MySQL can't index without a prefix.
+func aggregateHelperDDL(helper *vreplication.AggregateHelper, targetDDL, sourceDDL string) (string, error) {
+	targetCols, err := tableColumnTypes(targetDDL)
+	if err != nil {
+		return "", err
+	}
+	sourceCols, err := tableColumnTypes(sourceDDL)
+	if err != nil {
+		return "", err
+	}
+	spec := &sqlparser.TableSpec{}
+	pk := &sqlparser.IndexDefinition{
+		Info: &sqlparser.IndexInfo{Type: "primary key", Name: sqlparser.NewColIdent("PRIMARY"), Primary: true},
+	}
+	addColumn := func(name string, typ *sqlparser.ColumnType) error {
+		if !isKeyableType(typ.Type) {
+			return fmt.Errorf("unsupported: column %s of %s is part of its primary key and cannot be of type %s", name, helper.Table, typ.Type)
+		}
+		spec.Columns = append(spec.Columns, &sqlparser.ColumnDefinition{
+			Name: sqlparser.NewColIdent(name),
+			Type: sqlparser.ColumnType{
+				Type:       typ.Type,
+				NotNull:    true,
+				Length:     typ.Length,
+				Unsigned:   typ.Unsigned,
+				Zerofill:   typ.Zerofill,
+				Scale:      typ.Scale,
+				Charset:    typ.Charset,
+				Collate:    typ.Collate,
+				EnumValues: typ.EnumValues,
+			},
+		})
+		pk.Columns = append(pk.Columns, &sqlparser.IndexColumn{Column: sqlparser.NewColIdent(name)})
+		return nil
+	}
+	for _, col := range helper.GroupColumns {
+		typ, ok := targetCols[strings.ToLower(col)]
+		if !ok {
+			return "", fmt.Errorf("column %s not found in the target table of %s", col, helper.Table)
+		}
+		if err := addColumn(col, typ); err != nil {
+			return "", err
+		}
+	}
+	typ, ok := sourceCols[strings.ToLower(helper.SourceColumn)]
+	if !ok {
+		return "", fmt.Errorf("column %s not found in the source table of %s", helper.SourceColumn, helper.Table)
+	}
+	if err := addColumn("val", typ); err != nil {
+		return "", err
+	}
+	spec.Columns = append(spec.Columns, &sqlparser.ColumnDefinition{
+		Name: sqlparser.NewColIdent("cnt"),
+		Type: sqlparser.ColumnType{Type: "bigint", NotNull: true},
+	})
+	spec.Indexes = []*sqlparser.IndexDefinition{pk}
+	ddl := &sqlparser.DDL{
+		Action:    sqlparser.CreateStr,
+		Table:     sqlparser.TableName{Name: sqlparser.NewTableIdent(helper.Table)},
+		TableSpec: spec,
+	}
+	return sqlparser.String(ddl), nil
+}
+
+// isKeyableType returns false for the column types that
+// can only be indexed with a prefix length.
+func isKeyableType(typ string) bool {
+	switch strings.ToLower(typ) {
+	case "tinytext", "text", "mediumtext", "longtext",
+		"tinyblob", "blob", "mediumblob", "longblob", "json",
+		"geometry", "point", "linestring", "polygon",
+		"multipoint", "multilinestring", "multipolygon", "geometrycollection":
+		return false
+	}
+	return true
+}
+
+// joinHelperDDL returns the create statement of the helper table of
+// a source table of a join. It's a copy of the source table without
+// its constraints, which may refer to tables that are not on the target.
+func joinHelperDDL(helper *vreplication.JoinHelper, sourceDDL string) (string, error) {
+	stmt, err := sqlparser.ParseStrictDDL(sourceDDL)
+	if err != nil {
+		return "", err
+	}
+	create, ok := stmt.(*sqlparser.DDL)
+	if !ok || create.TableSpec == nil {
+		return "", fmt.Errorf("unexpected table definition: %s", sourceDDL)
+	}
+	create.Table = sqlparser.TableName{Name: sqlparser.NewTableIdent(helper.Table)}
+	create.TableSpec.Constraints = nil
+	return sqlparser.String(create), nil
+}
+
+// tableColumnTypes returns the types of the columns of a create
+// statement, keyed by the lower case column name.
+func tableColumnTypes(ddl string) (map[string]*sqlparser.ColumnType, error) {
+	stmt, err := sqlparser.ParseStrictDDL(ddl)
+	if err != nil {
+		return nil, err
+	}
+	create, ok := stmt.(*sqlparser.DDL)
+	if !ok || create.TableSpec == nil {
+		return nil, fmt.Errorf("unexpected table definition: %s", ddl)
+	}
+	types := make(map[string]*sqlparser.ColumnType)
+	for _, col := range create.TableSpec.Columns {
+		types[col.Name.Lowered()] = &col.Type
+	}
+	return types, nil
+}
+
 func stripTableConstraints(ddl string) (string, error) {
 	ast, err := sqlparser.ParseStrictDDL(ddl)
 	if err != nil {
@@ -743,6 +970,7 @@ func (mz *materializer) generateInserts(ctx context.Context) (string, error) {
 			Shard:         source.ShardName(),
 			Filter:        &binlogdatapb.Filter{},
 			StopAfterCopy: mz.ms.StopAfterCopy,
+			OnDdl:         binlogdatapb.OnDDLAction(binlogdatapb.OnDDLAction_value[mz.ms.OnDdl]),
 		}
 		for _, ts := range mz.ms.TableSettings {
 			rule := &binlogdatapb.Rule{
@@ -55,7 +55,7 @@ func TestMigrateTables(t *testing.T) {
 	env.tmc.expectVRQuery(200, mzUpdateQuery, &sqltypes.Result{})
 
 	ctx := context.Background()
-	err := env.wr.MoveTables(ctx, "workflow", "sourceks", "targetks", "t1", "", "")
+	err := env.wr.MoveTables(ctx, "workflow", "sourceks", "targetks", "t1", "", "", "")
 	assert.NoError(t, err)
 	vschema, err := env.wr.ts.GetSrvVSchema(ctx, env.cell)
 	assert.NoError(t, err)
@@ -87,7 +87,7 @@ func TestMigrateVSchema(t *testing.T) {
 	env.tmc.expectVRQuery(200, mzUpdateQuery, &sqltypes.Result{})
 
 	ctx := context.Background()
-	err := env.wr.MoveTables(ctx, "workflow", "sourceks", "targetks", `{"t1":{}}`, "", "")
+	err := env.wr.MoveTables(ctx, "workflow", "sourceks", "targetks", `{"t1":{}}`, "", "", "")
 	assert.NoError(t, err)
 	vschema, err := env.wr.ts.GetSrvVSchema(ctx, env.cell)
 	assert.NoError(t, err)
@@ -1884,6 +1884,192 @@ func TestMaterializerStopAfterCopy(t *testing.T) {
 	env.tmc.verifyQueries(t)
 }
 
+func TestMaterializerOnDDL(t *testing.T) {
+	ms := &vtctldatapb.MaterializeSettings{
+		Workflow:       "workflow",
+		SourceKeyspace: "sourceks",
+		TargetKeyspace: "targetks",
+		OnDdl:          "EXEC",
+		TableSettings: []*vtctldatapb.TableMaterializeSettings{{
+			TargetTable:      "t1",
+			SourceExpression: "select * from t1",
+			CreateDdl:        "t1ddl",
+		}},
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"0"}, []string{"0"})
+	defer env.close()
+
+	env.tmc.expectVRQuery(200, insertPrefix+`.*on_ddl:EXEC `, &sqltypes.Result{})
+	env.tmc.expectVRQuery(200, mzUpdateQuery, &sqltypes.Result{})
+
+	err := env.wr.Materialize(context.Background(), ms)
+	assert.NoError(t, err)
+	env.tmc.verifyQueries(t)
+
+	ms.OnDdl = "DROP"
+	err = env.wr.Materialize(context.Background(), ms)
+	assert.EqualError(t, err, "invalid on_ddl action DROP, must be one of IGNORE, STOP, EXEC, EXEC_IGNORE or EXEC_COLUMNS")
+}
+
+func TestMaterializerAggregateHelpers(t *testing.T) {
+	ms := &vtctldatapb.MaterializeSettings{
+		Workflow:       "workflow",
+		SourceKeyspace: "sourceks",
+		TargetKeyspace: "targetks",
+		TableSettings: []*vtctldatapb.TableMaterializeSettings{{
+			TargetTable:      "t2",
+			SourceExpression: "select c1, max(c2) as mx, count(*) as cnt from t1 group by c1",
+			CreateDdl:        "t2ddl",
+		}},
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"0"}, []string{"0"})
+	defer env.close()
+
+	env.tmc.schema["sourceks.t1"].TableDefinitions[0].Schema = "create table t1(id bigint, c1 varchar(64) not null default '', c2 int unsigned, primary key(id))"
+	env.tmc.schema["targetks.t2"].TableDefinitions[0].Schema = "create table t2(c1 varchar(64), mx int unsigned, cnt bigint, primary key(c1))"
+
+	env.tmc.expectVRQuery(200, "create table _vt_agg_t2__mx (\n\tc1 varchar(64) not null,\n\tval int unsigned not null,\n\tcnt bigint not null,\n\tprimary key (c1, val)\n)", &sqltypes.Result{})
+	env.tmc.expectVRQuery(200, insertPrefix, &sqltypes.Result{})
+	env.tmc.expectVRQuery(200, mzUpdateQuery, &sqltypes.Result{})
+
+	err := env.wr.Materialize(context.Background(), ms)
+	assert.NoError(t, err)
+	env.tmc.verifyQueries(t)
+}
+
+func TestMaterializerAggregateHelperUnkeyableType(t *testing.T) {
+	ms := &vtctldatapb.MaterializeSettings{
+		Workflow:       "workflow",
+		SourceKeyspace: "sourceks",
+		TargetKeyspace: "targetks",
+		TableSettings: []*vtctldatapb.TableMaterializeSettings{{
+			TargetTable:      "t2",
+			SourceExpression: "select c1, max(c2) as mx, count(*) as cnt from t1 group by c1",
+			CreateDdl:        "t2ddl",
+		}},
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"0"}, []string{"0"})
+	defer env.close()
+
+	env.tmc.schema["sourceks.t1"].TableDefinitions[0].Schema = "create table t1(id bigint, c1 varchar(64) not null default '', c2 text, primary key(id))"
+	env.tmc.schema["targetks.t2"].TableDefinitions[0].Schema = "create table t2(c1 varchar(64), mx text, cnt bigint, primary key(c1))"
+
+	err := env.wr.Materialize(context.Background(), ms)
+	assert.EqualError(t, err, "unsupported: column val of _vt_agg_t2__mx is part of its primary key and cannot be of type text")
+
+	env.tmc.schema["sourceks.t1"].TableDefinitions[0].Schema = "create table t1(id bigint, c1 json, c2 int, primary key(id))"
+	env.tmc.schema["targetks.t2"].TableDefinitions[0].Schema = "create table t2(c1 json, mx int, cnt bigint, primary key(id))"
+	env.tmc.expectVRQuery(200, "select 1 from _vt.vreplication where db_name='vt_targetks' and workflow='workflow'", &sqltypes.Result{})
+	err = env.wr.Materialize(context.Background(), ms)
+	assert.EqualError(t, err, "unsupported: column c1 of _vt_agg_t2__mx is part of its primary key and cannot be of type json")
+}
+
+func TestMaterializerJoin(t *testing.T) {
+	ms := &vtctldatapb.MaterializeSettings{
+		Workflow:       "workflow",
+		SourceKeyspace: "sourceks",
+		TargetKeyspace: "targetks",
+		TableSettings: []*vtctldatapb.TableMaterializeSettings{{
+			TargetTable:      "t1",
+			SourceExpression: "select o.id, c.name from orders as o join custs as c on o.cid = c.id",
+			CreateDdl:        "t1ddl",
+		}},
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"0"}, []string{"0"})
+	defer env.close()
+
+	env.tmc.schema["sourceks.orders"] = &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:   "orders",
+			Schema: "create table orders(id bigint, cid bigint, primary key(id), constraint fk_cid foreign key (cid) references custs (id))",
+		}},
+	}
+	env.tmc.schema["sourceks.custs"] = &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:   "custs",
+			Schema: "create table custs(id bigint, name varchar(64), primary key(id))",
+		}},
+	}
+
+	env.tmc.expectVRQuery(200, "create table _vt_join_t1__orders (\n\tid bigint,\n\tcid bigint,\n\tprimary key (id)\n)", &sqltypes.Result{})
+	env.tmc.expectVRQuery(200, "\ncreate table _vt_join_t1__custs (\n\tid bigint,\n\tname varchar(64),\n\tprimary key (id)\n)", &sqltypes.Result{})
+	env.tmc.expectVRQuery(200, insertPrefix+`.*select o.id, c.name from orders as o join custs as c on o.cid = c.id`, &sqltypes.Result{})
+	env.tmc.expectVRQuery(200, mzUpdateQuery, &sqltypes.Result{})
+
+	err := env.wr.Materialize(context.Background(), ms)
+	assert.NoError(t, err)
+	env.tmc.verifyQueries(t)
+}
+
+func TestMaterializerHelperTableCollision(t *testing.T) {
+	ms := &vtctldatapb.MaterializeSettings{
+		Workflow:       "workflow",
+		SourceKeyspace: "sourceks",
+		TargetKeyspace: "targetks",
+		TableSettings: []*vtctldatapb.TableMaterializeSettings{{
+			TargetTable:      "t2",
+			SourceExpression: "select c1, max(c2) as b__mx from t1 group by c1",
+			CreateDdl:        "t2ddl",
+		}, {
+			TargetTable:      "t2__b",
+			SourceExpression: "select c1, max(c2) as mx from t1 group by c1",
+			CreateDdl:        "t2__bddl",
+		}},
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"0"}, []string{"0"})
+	defer env.close()
+
+	err := env.wr.Materialize(context.Background(), ms)
+	assert.EqualError(t, err, "helper table _vt_agg_t2__b__mx of t2__b is also a helper table of t2")
+
+	ms.TableSettings[1] = &vtctldatapb.TableMaterializeSettings{
+		TargetTable:      "_vt_agg_t2__b__mx",
+		SourceExpression: "select * from t1",
+		CreateDdl:        "t3ddl",
+	}
+	env.tmc.expectVRQuery(200, "select 1 from _vt.vreplication where db_name='vt_targetks' and workflow='workflow'", &sqltypes.Result{})
+	err = env.wr.Materialize(context.Background(), ms)
+	assert.EqualError(t, err, "helper table _vt_agg_t2__b__mx of t2 is also a target table")
+}
+
+func TestMaterializerJoinShardedTarget(t *testing.T) {
+	ms := &vtctldatapb.MaterializeSettings{
+		Workflow:       "workflow",
+		SourceKeyspace: "sourceks",
+		TargetKeyspace: "targetks",
+		TableSettings: []*vtctldatapb.TableMaterializeSettings{{
+			TargetTable:      "t1",
+			SourceExpression: "select o.id, c.name from orders as o join custs as c on o.cid = c.id",
+			CreateDdl:        "t1ddl",
+		}},
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"0"}, []string{"-80", "80-"})
+	defer env.close()
+
+	vs := &vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"hash": {
+				Type: "hash",
+			},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"t1": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{
+					Column: "id",
+					Name:   "hash",
+				}},
+			},
+		},
+	}
+	if err := env.topoServ.SaveVSchema(context.Background(), "targetks", vs); err != nil {
+		t.Fatal(err)
+	}
+
+	err := env.wr.Materialize(context.Background(), ms)
+	assert.EqualError(t, err, "a join cannot be materialized into the sharded table t1: select o.id, c.name from orders as o join custs as c on o.cid = c.id")
+}
+
 func TestMaterializerNoTargetVSchema(t *testing.T) {
 	ms := &vtctldatapb.MaterializeSettings{
 		Workflow:       "workflow",
@@ -0,0 +1,76 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// OnlineDDL runs an online schema migration command on all masters of
+// the keyspace. The command is one of show, cancel, retry or cutover.
+// uuid identifies the migration, or is "all" to act on all migrations.
+func (wr *Wrangler) OnlineDDL(ctx context.Context, keyspace, command, uuid string) (map[*topo.TabletInfo]*sqltypes.Result, error) {
+	if uuid != "all" && !schema.IsOnlineDDLUUID(uuid) {
+		return nil, fmt.Errorf("invalid migration UUID: %s", uuid)
+	}
+	var query string
+	switch command {
+	case "show":
+		query = schema.ShowMigrationsQuery(uuid)
+	case "cancel":
+		query = schema.CancelMigrationQuery(uuid)
+	case "retry":
+		query = schema.RetryMigrationQuery(uuid)
+	case "cutover":
+		query = schema.CutOverMigrationQuery(uuid)
+	default:
+		return nil, fmt.Errorf("unknown OnlineDDL command: %s", command)
+	}
+
+	vx := newVExec(ctx, "", keyspace, query, wr)
+	if err := vx.getMasters(); err != nil {
+		return nil, err
+	}
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	allErrors := &concurrency.AllErrorRecorder{}
+	results := make(map[*topo.TabletInfo]*sqltypes.Result)
+	for _, master := range vx.masters {
+		wg.Add(1)
+		go func(master *topo.TabletInfo) {
+			defer wg.Done()
+			qr, err := wr.tmc.ExecuteFetchAsDba(ctx, master.Tablet, false, []byte(query), 10000, false, false)
+			if err != nil {
+				allErrors.RecordError(fmt.Errorf("%s: %v", master.AliasString(), err))
+				return
+			}
+			mu.Lock()
+			results[master] = sqltypes.Proto3ToResult(qr)
+			mu.Unlock()
+		}(master)
+	}
+	wg.Wait()
+	return results, allErrors.AggrError(vterrors.Aggregate)
+}
@@ -118,6 +118,18 @@ func (r *switcher) dropSourceReverseVReplicationStreams(ctx context.Context) err
 	return r.ts.dropSourceReverseVReplicationStreams(ctx)
 }
 
+func (r *switcher) allowSourceWrites(ctx context.Context) error {
+	return r.ts.allowSourceWrites(ctx)
+}
+
+func (r *switcher) deleteRoutingRules(ctx context.Context) error {
+	return r.ts.deleteRoutingRules(ctx)
+}
+
+func (r *switcher) deleteJournals(ctx context.Context) error {
+	return r.ts.deleteJournals(ctx)
+}
+
 func (r *switcher) logs() *[]string {
 	return nil
 }
@@ -93,9 +93,12 @@ func (dr *switcherDryRun) changeRouting(ctx context.Context) error {
 		for _, table := range dr.ts.tables {
 			for _, tabletType := range []topodatapb.TabletType{topodatapb.TabletType_REPLICA, topodatapb.TabletType_RDONLY} {
 				tt := strings.ToLower(tabletType.String())
-				deleteLogs = append(deleteLogs, fmt.Sprintf("\t%s => %s", table+"@"+tt, strings.Trim(rules[table+"@"+tt][0], "[]")))
-				deleteLogs = append(deleteLogs, fmt.Sprintf("\t%s => %s", dr.ts.targetKeyspace+"."+table+"@"+tt, strings.Trim(rules[dr.ts.targetKeyspace+"."+table+"@"+tt][0], "[]")))
-				deleteLogs = append(deleteLogs, fmt.Sprintf("\t%s => %s", dr.ts.sourceKeyspace+"."+table+"@"+tt, strings.Trim(rules[dr.ts.sourceKeyspace+"."+table+"@"+tt][0], "[]")))
+				for _, fromTable := range []string{table + "@" + tt, dr.ts.targetKeyspace + "." + table + "@" + tt, dr.ts.sourceKeyspace + "." + table + "@" + tt} {
+					// The rules don't exist when the traffic is switched back.
+					if toTables := rules[fromTable]; len(toTables) > 0 {
+						deleteLogs = append(deleteLogs, fmt.Sprintf("\t%s => %s", fromTable, strings.Trim(toTables[0], "[]")))
+					}
+				}
 			}
 			addLogs = append(addLogs, fmt.Sprintf("\t%s => %s", table, dr.ts.targetKeyspace+"."+table))
 			addLogs = append(addLogs, fmt.Sprintf("\t%s => %s", dr.ts.sourceKeyspace+"."+table, dr.ts.targetKeyspace+"."+table))
@@ -103,6 +106,8 @@ func (dr *switcherDryRun) changeRouting(ctx context.Context) error {
 		if len(deleteLogs) > 0 {
 			dr.drLog.Log("Following rules will be deleted:")
 			dr.drLog.LogSlice(deleteLogs)
+		}
+		if len(addLogs) > 0 {
 			dr.drLog.Log("Following rules will be added:")
 			dr.drLog.LogSlice(addLogs)
 		}
@@ -315,6 +320,26 @@ func (dr *switcherDryRun) dropSourceBlacklistedTables(ctx context.Context) error
 	return nil
 }
 
+func (dr *switcherDryRun) allowSourceWrites(ctx context.Context) error {
+	dr.drLog.Log(fmt.Sprintf("Enable writes on keyspace %s tables %s", dr.ts.sourceKeyspace, strings.Join(dr.ts.tables, ",")))
+	return nil
+}
+
+func (dr *switcherDryRun) deleteRoutingRules(ctx context.Context) error {
+	dr.drLog.Log(fmt.Sprintf("Delete routing rules of tables %s", strings.Join(dr.ts.tables, ",")))
+	return nil
+}
+
+func (dr *switcherDryRun) deleteJournals(ctx context.Context) error {
+	logs := make([]string, 0)
+	for _, source := range dr.ts.sources {
+		logs = append(logs, fmt.Sprintf("\tKeyspace %s Shard %s Tablet %d", dr.ts.sourceKeyspace, source.si.ShardName(), source.master.Alias.Uid))
+	}
+	dr.drLog.Log(fmt.Sprintf("Delete journal %d from:", dr.ts.id))
+	dr.drLog.LogSlice(logs)
+	return nil
+}
+
 func (dr *switcherDryRun) logs() *[]string {
 	return &dr.drLog.logs
 }
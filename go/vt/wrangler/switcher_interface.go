@@ -46,5 +46,8 @@ type iswitcher interface {
 	freezeTargetVReplication(ctx context.Context) error
 	dropSourceReverseVReplicationStreams(ctx context.Context) error
 	dropTargetVReplicationStreams(ctx context.Context) error
+	allowSourceWrites(ctx context.Context) error
+	deleteRoutingRules(ctx context.Context) error
+	deleteJournals(ctx context.Context) error
 	logs() *[]string
 }
@@ -107,4 +107,52 @@ sharding_column_name: "col2"
 	if !proto.Equal(ks3.Keyspace, expected) {
 		t.Fatalf("copy data to topo failed, got %v expected %v", ks3.Keyspace, expected)
 	}
+
+	// Test TopoExport, and TopoDiff against the same topology.
+	archiveFile := path.Join(tmp, "topo.json")
+	if _, err := vp.RunAndOutput([]string{"TopoExport", archiveFile}); err != nil {
+		t.Fatalf("TopoExport failed: %v", err)
+	}
+	if out, err := vp.RunAndOutput([]string{"TopoDiff", archiveFile}); err != nil || out != "" {
+		t.Fatalf("TopoDiff with no change returned %q, %v", out, err)
+	}
+
+	// Test TopoDiff with a modified keyspace.
+	conn, err := ts.ConnForCell(context.Background(), "global")
+	if err != nil {
+		t.Fatalf("ConnForCell failed: %v", err)
+	}
+	modified, err := proto.Marshal(&topodatapb.Keyspace{ShardingColumnName: "col9"})
+	if err != nil {
+		t.Fatalf("proto.Marshal failed: %v", err)
+	}
+	if _, err := conn.Update(context.Background(), "/keyspaces/ks1/Keyspace", modified, nil); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	out, err := vp.RunAndOutput([]string{"TopoDiff", archiveFile})
+	if err == nil {
+		t.Fatalf("TopoDiff with a change didn't fail")
+	}
+	want := `~ global:/keyspaces/ks1/Keyspace
+  - sharding_column_name: "col1"
+  + sharding_column_name: "col9"
+`
+	if out != want {
+		t.Errorf("TopoDiff with a change returned:\n%vwant:\n%v", out, want)
+	}
+
+	// Test TopoRestore, which only overwrites with -force.
+	if _, err := vp.RunAndOutput([]string{"TopoRestore", archiveFile, "/keyspaces/ks1"}); err == nil {
+		t.Fatalf("TopoRestore with a conflict didn't fail")
+	}
+	if _, err := vp.RunAndOutput([]string{"TopoRestore", "-force", archiveFile, "/keyspaces/ks1"}); err != nil {
+		t.Fatalf("TopoRestore -force failed: %v", err)
+	}
+	ks1, err := ts.GetKeyspace(context.Background(), "ks1")
+	if err != nil {
+		t.Fatalf("GetKeyspace failed: %v", err)
+	}
+	if !proto.Equal(ks1.Keyspace, expected) {
+		t.Fatalf("TopoRestore failed, got %v expected %v", ks1.Keyspace, expected)
+	}
 }
@@ -373,6 +373,188 @@ func (wr *Wrangler) DropSources(ctx context.Context, targetKeyspace, workflow st
 	return sw.logs(), nil
 }
 
+// CancelWorkflow aborts a MoveTables/Reshard workflow whose writes have not been
+// switched, and restores the routing and serving state from before the workflow.
+// Reads that were switched are switched back, writes are re-enabled on the sources
+// and the streams are deleted. Unless keepData is set, the target tables are removed
+// for MoveTables along with their routing rules, and the target shards are deleted
+// for Reshard.
+func (wr *Wrangler) CancelWorkflow(ctx context.Context, targetKeyspace, workflow string, removalType TableRemovalType, keepData, dryRun bool) (*[]string, error) {
+	ts, err := wr.buildTrafficSwitcher(ctx, targetKeyspace, workflow)
+	if err != nil {
+		wr.Logger().Errorf("buildTrafficSwitcher failed: %v", err)
+		return nil, err
+	}
+	if ts.frozen {
+		return nil, fmt.Errorf("writes have already been switched for workflow %s, use reverse-rollback instead", workflow)
+	}
+	// The targets of the mirror are the sources of the workflow, and vice versa.
+	// It acts on the targets the way DropSources acts on the sources.
+	mirror := ts.mirror()
+	var sw, msw iswitcher
+	if dryRun {
+		drLog := NewLogRecorder()
+		sw = &switcherDryRun{ts: ts, drLog: drLog}
+		msw = &switcherDryRun{ts: mirror, drLog: drLog}
+	} else {
+		sw = &switcher{ts: ts, wr: wr}
+		msw = &switcher{ts: mirror, wr: wr}
+	}
+
+	ctx, unlock, err := ts.lockKeyspaces(ctx, sw, "CancelWorkflow")
+	if err != nil {
+		return nil, err
+	}
+	defer unlock(&err)
+
+	journalsExist, _, err := ts.checkJournals(ctx)
+	if err != nil {
+		ts.wr.Logger().Errorf("checkJournals failed: %v", err)
+		return nil, err
+	}
+	if journalsExist {
+		return nil, fmt.Errorf("writes are being switched for workflow %s: complete SwitchWrites, then use reverse-rollback", workflow)
+	}
+	if err := ts.switchReadsBack(ctx, sw); err != nil {
+		ts.wr.Logger().Errorf("switchReadsBack failed: %v", err)
+		return nil, err
+	}
+	if err := sw.allowSourceWrites(ctx); err != nil {
+		ts.wr.Logger().Errorf("allowSourceWrites failed: %v", err)
+		return nil, err
+	}
+	if err := sw.dropSourceReverseVReplicationStreams(ctx); err != nil {
+		return nil, err
+	}
+	if err := sw.dropTargetVReplicationStreams(ctx); err != nil {
+		return nil, err
+	}
+	if keepData {
+		return sw.logs(), nil
+	}
+	switch ts.migrationType {
+	case binlogdatapb.MigrationType_TABLES:
+		if err := msw.removeSourceTables(ctx, removalType); err != nil {
+			return nil, err
+		}
+		if err := sw.deleteRoutingRules(ctx); err != nil {
+			return nil, err
+		}
+	case binlogdatapb.MigrationType_SHARDS:
+		if err := msw.dropSourceShards(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return sw.logs(), nil
+}
+
+// RollbackWorkflow returns a MoveTables/Reshard workflow whose writes have been
+// switched to the state from before the workflow. The reverse replication created
+// by SwitchWrites must exist: writes are switched back to the sources once it has
+// caught up, so that no write is lost. Reads are switched back too, and the streams
+// of both directions and the journals are deleted. Unless keepData is set, the target
+// tables are removed for MoveTables along with their routing rules, and the target
+// shards are deleted for Reshard.
+func (wr *Wrangler) RollbackWorkflow(ctx context.Context, targetKeyspace, workflow string, filteredReplicationWaitTime time.Duration, removalType TableRemovalType, keepData, dryRun bool) (*[]string, error) {
+	ts, err := wr.buildTrafficSwitcher(ctx, targetKeyspace, workflow)
+	if err != nil {
+		wr.Logger().Errorf("buildTrafficSwitcher failed: %v", err)
+		return nil, err
+	}
+	if !ts.frozen {
+		return nil, fmt.Errorf("writes have not been switched for workflow %s, use cancel instead", workflow)
+	}
+	rts, err := wr.buildTrafficSwitcher(ctx, ts.sourceKeyspace, ts.reverseWorkflow)
+	if err != nil {
+		return nil, fmt.Errorf("cannot roll back workflow %s without its reverse replication %s: %v", workflow, ts.reverseWorkflow, err)
+	}
+	var sw, rsw iswitcher
+	if dryRun {
+		drLog := NewLogRecorder()
+		sw = &switcherDryRun{ts: ts, drLog: drLog}
+		rsw = &switcherDryRun{ts: rts, drLog: drLog}
+	} else {
+		sw = &switcher{ts: ts, wr: wr}
+		rsw = &switcher{ts: rts, wr: wr}
+	}
+
+	ctx, unlock, err := ts.lockKeyspaces(ctx, sw, "RollbackWorkflow")
+	if err != nil {
+		return nil, err
+	}
+	defer unlock(&err)
+
+	_, sourceWorkflows, err := ts.checkJournals(ctx)
+	if err != nil {
+		ts.wr.Logger().Errorf("checkJournals failed: %v", err)
+		return nil, err
+	}
+	if len(sourceWorkflows) > 0 {
+		return nil, fmt.Errorf("streams of workflows %v were migrated to the targets by SwitchWrites, they must be moved back before workflow %s can be rolled back", sourceWorkflows, workflow)
+	}
+	if err := ts.switchReadsBack(ctx, sw); err != nil {
+		ts.wr.Logger().Errorf("switchReadsBack failed: %v", err)
+		return nil, err
+	}
+
+	// Switch writes back, the way SwitchWrites would for the reverse workflow.
+	if err := rsw.stopSourceWrites(ctx); err != nil {
+		ts.wr.Logger().Errorf("stopSourceWrites failed: %v", err)
+		if !dryRun {
+			rts.cancelRollback(ctx)
+		}
+		return nil, err
+	}
+	if err := rsw.waitForCatchup(ctx, filteredReplicationWaitTime); err != nil {
+		ts.wr.Logger().Errorf("waitForCatchup failed: %v", err)
+		if !dryRun {
+			rts.cancelRollback(ctx)
+		}
+		return nil, err
+	}
+	if err := rsw.allowTargetWrites(ctx); err != nil {
+		ts.wr.Logger().Errorf("allowTargetWrites failed: %v", err)
+		return nil, err
+	}
+	if err := rsw.changeRouting(ctx); err != nil {
+		ts.wr.Logger().Errorf("changeRouting failed: %v", err)
+		return nil, err
+	}
+
+	// The sources serve all the traffic again. Remove what the workflow created.
+	if err := rsw.dropTargetVReplicationStreams(ctx); err != nil {
+		return nil, err
+	}
+	if err := rsw.dropSourceReverseVReplicationStreams(ctx); err != nil {
+		return nil, err
+	}
+	if err := sw.deleteJournals(ctx); err != nil {
+		return nil, err
+	}
+	if ts.migrationType == binlogdatapb.MigrationType_TABLES {
+		if err := rsw.dropSourceBlacklistedTables(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if keepData {
+		return sw.logs(), nil
+	}
+	switch ts.migrationType {
+	case binlogdatapb.MigrationType_TABLES:
+		if err := rsw.removeSourceTables(ctx, removalType); err != nil {
+			return nil, err
+		}
+		if err := sw.deleteRoutingRules(ctx); err != nil {
+			return nil, err
+		}
+	case binlogdatapb.MigrationType_SHARDS:
+		if err := rsw.dropSourceShards(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return sw.logs(), nil
+}
+
 func (wr *Wrangler) buildTrafficSwitcher(ctx context.Context, targetKeyspace, workflow string) (*trafficSwitcher, error) {
 	targets, frozen, optCells, optTabletTypes, err := wr.buildTargets(ctx, targetKeyspace, workflow)
 	if err != nil {
@@ -766,12 +948,7 @@ func (ts *trafficSwitcher) waitForCatchup(ctx context.Context, filteredReplicati
 }
 
 func (ts *trafficSwitcher) cancelMigration(ctx context.Context, sm *streamMigrater) {
-	var err error
-	if ts.migrationType == binlogdatapb.MigrationType_TABLES {
-		err = ts.changeTableSourceWrites(ctx, allowWrites)
-	} else {
-		err = ts.changeShardsAccess(ctx, ts.sourceKeyspace, ts.sourceShards(), allowWrites)
-	}
+	err := ts.allowSourceWrites(ctx)
 	if err != nil {
 		ts.wr.Logger().Errorf("Cancel migration failed:", err)
 	}
@@ -1176,6 +1353,165 @@ func doValidateWorkflowHasCompleted(ctx context.Context, ts *trafficSwitcher) er
 
 }
 
+// mirror returns a traffic switcher whose sources are the targets of ts, and
+// whose targets are its sources. The streams are not mirrored: it can only be
+// used to act on the shards and tables of the targets.
+func (ts *trafficSwitcher) mirror() *trafficSwitcher {
+	m := &trafficSwitcher{
+		migrationType:   ts.migrationType,
+		wr:              ts.wr,
+		workflow:        ts.reverseWorkflow,
+		reverseWorkflow: ts.workflow,
+		id:              ts.id,
+		sources:         make(map[string]*tsSource),
+		targets:         make(map[string]*tsTarget),
+		sourceKeyspace:  ts.targetKeyspace,
+		targetKeyspace:  ts.sourceKeyspace,
+		tables:          ts.tables,
+	}
+	for shard, target := range ts.targets {
+		m.sources[shard] = &tsSource{
+			si:     target.si,
+			master: target.master,
+		}
+	}
+	for shard, source := range ts.sources {
+		m.targets[shard] = &tsTarget{
+			si:      source.si,
+			master:  source.master,
+			sources: make(map[uint32]*binlogdatapb.BinlogSource),
+		}
+	}
+	return m
+}
+
+// lockKeyspaces locks the source and target keyspaces.
+func (ts *trafficSwitcher) lockKeyspaces(ctx context.Context, sw iswitcher, action string) (context.Context, func(*error), error) {
+	ctx, sourceUnlock, err := sw.lockKeyspace(ctx, ts.sourceKeyspace, action)
+	if err != nil {
+		ts.wr.Logger().Errorf("Source LockKeyspace failed: %v", err)
+		return nil, nil, err
+	}
+	if ts.targetKeyspace == ts.sourceKeyspace {
+		return ctx, sourceUnlock, nil
+	}
+	ctx, targetUnlock, err := sw.lockKeyspace(ctx, ts.targetKeyspace, action)
+	if err != nil {
+		ts.wr.Logger().Errorf("Target LockKeyspace failed: %v", err)
+		sourceUnlock(&err)
+		return nil, nil, err
+	}
+	return ctx, func(err *error) {
+		targetUnlock(err)
+		sourceUnlock(err)
+	}, nil
+}
+
+// switchReadsBack switches the reads that were switched to the targets back to the sources.
+func (ts *trafficSwitcher) switchReadsBack(ctx context.Context, sw iswitcher) error {
+	for _, servedType := range []topodatapb.TabletType{topodatapb.TabletType_RDONLY, topodatapb.TabletType_REPLICA} {
+		if ts.migrationType == binlogdatapb.MigrationType_TABLES {
+			if err := sw.switchTableReads(ctx, nil, servedType, DirectionBackward); err != nil {
+				return err
+			}
+			continue
+		}
+		cells, err := ts.switchedReadCells(ctx, servedType)
+		if err != nil {
+			return err
+		}
+		if len(cells) == 0 {
+			continue
+		}
+		if err := sw.switchShardReads(ctx, cells, servedType, DirectionBackward); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// switchedReadCells returns the cells in which the target shards serve servedType.
+func (ts *trafficSwitcher) switchedReadCells(ctx context.Context, servedType topodatapb.TabletType) ([]string, error) {
+	cells, err := ts.wr.ts.GetCellInfoNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var switched []string
+	for _, cell := range cells {
+		srvKeyspace, err := ts.wr.ts.GetSrvKeyspace(ctx, cell, ts.targetKeyspace)
+		switch {
+		case topo.IsErrType(err, topo.NoNode):
+			continue
+		case err != nil:
+			return nil, err
+		}
+	partitions:
+		for _, partition := range srvKeyspace.GetPartitions() {
+			if partition.GetServedType() != servedType {
+				continue
+			}
+			for _, shardReference := range partition.GetShardReferences() {
+				if _, ok := ts.targets[shardReference.GetName()]; ok {
+					switched = append(switched, cell)
+					break partitions
+				}
+			}
+		}
+	}
+	return switched, nil
+}
+
+func (ts *trafficSwitcher) allowSourceWrites(ctx context.Context) error {
+	if ts.migrationType == binlogdatapb.MigrationType_TABLES {
+		return ts.changeTableSourceWrites(ctx, allowWrites)
+	}
+	return ts.changeShardsAccess(ctx, ts.sourceKeyspace, ts.sourceShards(), allowWrites)
+}
+
+// cancelRollback undoes the first steps of switching writes back to the sources
+// of a rolled back workflow. ts is the traffic switcher of the reverse workflow.
+func (ts *trafficSwitcher) cancelRollback(ctx context.Context) {
+	if err := ts.allowSourceWrites(ctx); err != nil {
+		ts.wr.Logger().Errorf("Cancel rollback failed: could not allow writes: %v", err)
+	}
+	err := ts.forAllTargets(func(target *tsTarget) error {
+		query := fmt.Sprintf("update _vt.vreplication set state='Running', message='' where db_name=%s and workflow=%s", encodeString(target.master.DbName()), encodeString(ts.workflow))
+		_, err := ts.wr.tmc.VReplicationExec(ctx, target.master.Tablet, query)
+		return err
+	})
+	if err != nil {
+		ts.wr.Logger().Errorf("Cancel rollback failed: could not restart vreplication: %v", err)
+	}
+}
+
+// deleteRoutingRules deletes all the routing rules of the tables of the workflow.
+func (ts *trafficSwitcher) deleteRoutingRules(ctx context.Context) error {
+	rules, err := ts.wr.getRoutingRules(ctx)
+	if err != nil {
+		return err
+	}
+	for _, table := range ts.tables {
+		for _, fromTable := range []string{table, ts.sourceKeyspace + "." + table, ts.targetKeyspace + "." + table} {
+			delete(rules, fromTable)
+			for _, tabletType := range []topodatapb.TabletType{topodatapb.TabletType_REPLICA, topodatapb.TabletType_RDONLY} {
+				delete(rules, fromTable+"@"+strings.ToLower(tabletType.String()))
+			}
+		}
+	}
+	if err := ts.wr.saveRoutingRules(ctx, rules); err != nil {
+		return err
+	}
+	return ts.wr.ts.RebuildSrvVSchema(ctx, nil)
+}
+
+func (ts *trafficSwitcher) deleteJournals(ctx context.Context) error {
+	return ts.forAllSources(func(source *tsSource) error {
+		statement := fmt.Sprintf("delete from _vt.resharding_journal where id=%v", ts.id)
+		_, err := ts.wr.tmc.VReplicationExec(ctx, source.master.Tablet, statement)
+		return err
+	})
+}
+
 func (ts *trafficSwitcher) removeSourceTables(ctx context.Context, removalType TableRemovalType) error {
 	return ts.forAllSources(func(source *tsSource) error {
 		for _, tableName := range ts.tables {
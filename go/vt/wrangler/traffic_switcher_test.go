@@ -1809,3 +1809,283 @@ func stoppedResult(id int) *sqltypes.Result {
 func runningResult(id int) *sqltypes.Result {
 	return getResult(id, "Running", tpChoice.keyspace, tpChoice.shard)
 }
+
+func TestTableMigrateCancelWorkflow(t *testing.T) {
+	ctx := context.Background()
+	tme := newTestTableMigrater(ctx, t)
+	defer tme.stopTablets(t)
+
+	_, err := tme.wr.SwitchReads(ctx, tme.targetKeyspace, "test", topodatapb.TabletType_RDONLY, nil, DirectionForward, false)
+	require.NoError(t, err)
+
+	checkJournals := func() {
+		tme.dbSourceClients[0].addQuery("select val from _vt.resharding_journal where id=7672494164556733923", &sqltypes.Result{}, nil)
+		tme.dbSourceClients[1].addQuery("select val from _vt.resharding_journal where id=7672494164556733923", &sqltypes.Result{}, nil)
+	}
+	checkJournals()
+	wantDryRun := []string{
+		"Lock keyspace ks1",
+		"Lock keyspace ks2",
+		"Switch reads for tables t1,t2 to keyspace ks1",
+		"Switch reads for tables t1,t2 to keyspace ks1",
+		"Enable writes on keyspace ks1 tables t1,t2",
+		"Delete reverse vreplication streams on source:",
+		"	Keyspace ks1 Shard -40 Workflow test_reverse DbName vt_ks1 Tablet 10",
+		"	Keyspace ks1 Shard 40- Workflow test_reverse DbName vt_ks1 Tablet 20",
+		"Delete vreplication streams on target:",
+		"	Keyspace ks2 Shard -80 Workflow test DbName vt_ks2 Tablet 30",
+		"	Keyspace ks2 Shard 80- Workflow test DbName vt_ks2 Tablet 40",
+		"Dropping following tables:",
+		"	Keyspace ks2 Shard -80 DbName vt_ks2 Tablet 30 Table t1 RemovalType DROP TABLE",
+		"	Keyspace ks2 Shard -80 DbName vt_ks2 Tablet 30 Table t2 RemovalType DROP TABLE",
+		"	Keyspace ks2 Shard 80- DbName vt_ks2 Tablet 40 Table t1 RemovalType DROP TABLE",
+		"	Keyspace ks2 Shard 80- DbName vt_ks2 Tablet 40 Table t2 RemovalType DROP TABLE",
+		"Delete routing rules of tables t1,t2",
+		"Unlock keyspace ks2",
+		"Unlock keyspace ks1",
+	}
+	results, err := tme.wr.CancelWorkflow(ctx, tme.targetKeyspace, "test", DropTable, false, true)
+	require.NoError(t, err)
+	require.Empty(t, cmp.Diff(wantDryRun, *results))
+	verifyQueries(t, tme.allDBClients)
+
+	checkJournals()
+	deleteStreams := func() {
+		tme.dbSourceClients[0].addQuery("select id from _vt.vreplication where db_name = 'vt_ks1' and workflow = 'test_reverse'", &sqltypes.Result{}, nil)
+		tme.dbSourceClients[1].addQuery("select id from _vt.vreplication where db_name = 'vt_ks1' and workflow = 'test_reverse'", &sqltypes.Result{}, nil)
+		for _, dbclient := range tme.dbTargetClients {
+			dbclient.addQuery("select id from _vt.vreplication where db_name = 'vt_ks2' and workflow = 'test'", resultid12, nil)
+			dbclient.addQuery("delete from _vt.vreplication where id in (1, 2)", &sqltypes.Result{}, nil)
+			dbclient.addQuery("delete from _vt.copy_state where vrepl_id in (1, 2)", &sqltypes.Result{}, nil)
+		}
+	}
+	deleteStreams()
+	tme.tmeDB.AddQuery("drop table vt_ks2.t1", &sqltypes.Result{})
+	tme.tmeDB.AddQuery("drop table vt_ks2.t2", &sqltypes.Result{})
+
+	_, err = tme.wr.CancelWorkflow(ctx, tme.targetKeyspace, "test", DropTable, false, false)
+	require.NoError(t, err)
+	checkRouting(t, tme.wr, map[string][]string{})
+	checkBlacklist(t, tme.ts, "ks1:-40", nil)
+	checkBlacklist(t, tme.ts, "ks1:40-", nil)
+	verifyQueries(t, tme.allDBClients)
+}
+
+func TestTableMigrateCancelWorkflowErrors(t *testing.T) {
+	ctx := context.Background()
+	tme := newTestTableMigrater(ctx, t)
+	defer tme.stopTablets(t)
+
+	// Writes are being switched: a journal exists.
+	journal := sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+		"val",
+		"varbinary"),
+		"",
+	)
+	tme.dbSourceClients[0].addQuery("select val from _vt.resharding_journal where id=7672494164556733923", journal, nil)
+	tme.dbSourceClients[1].addQuery("select val from _vt.resharding_journal where id=7672494164556733923", &sqltypes.Result{}, nil)
+	_, err := tme.wr.CancelWorkflow(ctx, tme.targetKeyspace, "test", DropTable, false, false)
+	require.EqualError(t, err, "writes are being switched for workflow test: complete SwitchWrites, then use reverse-rollback")
+	verifyQueries(t, tme.allDBClients)
+
+	_, err = tme.wr.RollbackWorkflow(ctx, tme.targetKeyspace, "test", time.Second, DropTable, false, false)
+	require.EqualError(t, err, "writes have not been switched for workflow test, use cancel instead")
+}
+
+func TestShardMigrateCancelWorkflow(t *testing.T) {
+	ctx := context.Background()
+	tme := newTestShardMigrater(ctx, t, []string{"-40", "40-"}, []string{"-80", "80-"})
+	defer tme.stopTablets(t)
+
+	_, err := tme.wr.SwitchReads(ctx, tme.targetKeyspace, "test", topodatapb.TabletType_RDONLY, []string{"cell1"}, DirectionForward, false)
+	require.NoError(t, err)
+	_, err = tme.wr.SwitchReads(ctx, tme.targetKeyspace, "test", topodatapb.TabletType_REPLICA, nil, DirectionForward, false)
+	require.NoError(t, err)
+	checkCellServedTypes(t, tme.ts, "ks:-80", "cell1", 2)
+	checkCellServedTypes(t, tme.ts, "ks:-80", "cell2", 1)
+
+	tme.expectCheckJournals()
+	wantDryRun := []string{
+		"Lock keyspace ks",
+		"Switch reads from keyspace ks to keyspace ks for shards -80,80- to shards -40,40-",
+		"Switch reads from keyspace ks to keyspace ks for shards -80,80- to shards -40,40-",
+		"Enable writes on keyspace ks tables /.*",
+		"Delete reverse vreplication streams on source:",
+		"	Keyspace ks Shard -40 Workflow test_reverse DbName vt_ks Tablet 10",
+		"	Keyspace ks Shard 40- Workflow test_reverse DbName vt_ks Tablet 20",
+		"Delete vreplication streams on target:",
+		"	Keyspace ks Shard -80 Workflow test DbName vt_ks Tablet 30",
+		"	Keyspace ks Shard 80- Workflow test DbName vt_ks Tablet 40",
+		"Unlock keyspace ks",
+	}
+	results, err := tme.wr.CancelWorkflow(ctx, tme.targetKeyspace, "test", DropTable, true, true)
+	require.NoError(t, err)
+	require.Empty(t, cmp.Diff(wantDryRun, *results))
+	verifyQueries(t, tme.allDBClients)
+
+	tme.expectCheckJournals()
+	tme.expectDeleteReverseVReplication()
+	tme.expectDeleteTargetVReplication()
+	_, err = tme.wr.CancelWorkflow(ctx, tme.targetKeyspace, "test", DropTable, true, false)
+	require.NoError(t, err)
+	checkServedTypes(t, tme.ts, "ks:-40", 3)
+	checkServedTypes(t, tme.ts, "ks:40-", 3)
+	checkServedTypes(t, tme.ts, "ks:-80", 0)
+	checkServedTypes(t, tme.ts, "ks:80-", 0)
+	checkIsMasterServing(t, tme.ts, "ks:-40", true)
+	checkIsMasterServing(t, tme.ts, "ks:40-", true)
+	verifyQueries(t, tme.allDBClients)
+}
+
+func TestTableMigrateRollbackWorkflow(t *testing.T) {
+	ctx := context.Background()
+	tme := newTestTableMigraterCustom(ctx, t, []string{"0"}, []string{"-80", "80-"}, "select * %s")
+	defer tme.stopTablets(t)
+
+	_, err := tme.wr.SwitchReads(ctx, tme.targetKeyspace, "test", topodatapb.TabletType_RDONLY, nil, DirectionForward, false)
+	require.NoError(t, err)
+	_, err = tme.wr.SwitchReads(ctx, tme.targetKeyspace, "test", topodatapb.TabletType_REPLICA, nil, DirectionForward, false)
+	require.NoError(t, err)
+
+	// SwitchWrites, as in TestTableMigrateOneToMany.
+	tme.dbSourceClients[0].addQueryRE("select val from _vt.resharding_journal.*", &sqltypes.Result{}, nil)
+	state := sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+		"pos|state|message",
+		"varchar|varchar|varchar"),
+		"MariaDB/5-456-892|Running",
+	)
+	for _, dbclient := range tme.dbTargetClients {
+		dbclient.addQuery("select pos, state, message from _vt.vreplication where id=1", state, nil)
+		dbclient.addQuery("select id from _vt.vreplication where id = 1", resultid1, nil)
+		dbclient.addQuery("update _vt.vreplication set state = 'Stopped', message = 'stopped for cutover' where id in (1)", &sqltypes.Result{}, nil)
+		dbclient.addQuery("select * from _vt.vreplication where id = 1", stoppedResult(1), nil)
+	}
+	tme.dbSourceClients[0].addQuery("select id from _vt.vreplication where db_name = 'vt_ks1' and workflow = 'test_reverse'", &sqltypes.Result{}, nil)
+	tme.dbSourceClients[0].addQueryRE(`insert into _vt.vreplication.*test_reverse.*ks2.*-80.*t1.*from t1\\".*t2.*from t2\\"`, &sqltypes.Result{InsertID: 1}, nil)
+	tme.dbSourceClients[0].addQueryRE(`insert into _vt.vreplication.*test_reverse.*ks2.*80-.*t1.*from t1\\".*t2.*from t2\\"`, &sqltypes.Result{InsertID: 2}, nil)
+	tme.dbSourceClients[0].addQuery("select * from _vt.vreplication where id = 1", stoppedResult(1), nil)
+	tme.dbSourceClients[0].addQuery("select * from _vt.vreplication where id = 2", stoppedResult(2), nil)
+	tme.dbSourceClients[0].addQueryRE("insert into _vt.resharding_journal.*", &sqltypes.Result{}, nil)
+	for _, dbclient := range tme.dbTargetClients {
+		dbclient.addQuery("select id from _vt.vreplication where db_name = 'vt_ks2' and workflow = 'test'", resultid1, nil)
+		dbclient.addQuery("update _vt.vreplication set message = 'FROZEN' where id in (1)", &sqltypes.Result{}, nil)
+		dbclient.addQuery("select * from _vt.vreplication where id = 1", stoppedResult(1), nil)
+	}
+	_, _, err = tme.wr.SwitchWrites(ctx, tme.targetKeyspace, "test", 1*time.Second, false, false, false)
+	require.NoError(t, err)
+	verifyQueries(t, tme.allDBClients)
+
+	// The forward streams are now frozen, and the reverse streams exist.
+	frozen := func() {
+		for i, targetShard := range tme.targetShards {
+			bls := &binlogdatapb.BinlogSource{
+				Keyspace: "ks1",
+				Shard:    "0",
+				Filter: &binlogdatapb.Filter{
+					Rules: []*binlogdatapb.Rule{{
+						Match:  "t1",
+						Filter: fmt.Sprintf("select * from t1 where in_keyrange('%s')", targetShard),
+					}, {
+						Match:  "t2",
+						Filter: fmt.Sprintf("select * from t2 where in_keyrange('%s')", targetShard),
+					}},
+				},
+			}
+			tme.dbTargetClients[i].addQuery(vreplQueryks2, sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+				"id|source|message|cell|tablet_types",
+				"int64|varchar|varchar|varchar|varchar"),
+				fmt.Sprintf("1|%v|FROZEN||", bls)),
+				nil)
+		}
+		var rows []string
+		for i, targetShard := range tme.targetShards {
+			bls := &binlogdatapb.BinlogSource{
+				Keyspace: "ks2",
+				Shard:    targetShard,
+				Filter: &binlogdatapb.Filter{
+					Rules: []*binlogdatapb.Rule{{
+						Match:  "t1",
+						Filter: "select * from t1",
+					}, {
+						Match:  "t2",
+						Filter: "select * from t2",
+					}},
+				},
+			}
+			rows = append(rows, fmt.Sprintf("%d|%v|||", i+1, bls))
+		}
+		tme.dbSourceClients[0].addQuery("select id, source, message, cell, tablet_types from _vt.vreplication where workflow='test_reverse' and db_name='vt_ks1'", sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+			"id|source|message|cell|tablet_types",
+			"int64|varchar|varchar|varchar|varchar"),
+			rows...),
+			nil)
+		tme.dbSourceClients[0].addQueryRE("select val from _vt.resharding_journal.*", &sqltypes.Result{}, nil)
+	}
+	frozen()
+	wantDryRun := []string{
+		"Lock keyspace ks1",
+		"Lock keyspace ks2",
+		"Switch reads for tables t1,t2 to keyspace ks1",
+		"Switch reads for tables t1,t2 to keyspace ks1",
+		"Stop writes on keyspace ks2, tables t1,t2:",
+		"	Keyspace ks2, Shard -80 at Position MariaDB/5-456-893",
+		"	Keyspace ks2, Shard 80- at Position MariaDB/5-456-893",
+		"Wait for VReplication on stopped streams to catchup for upto 1s",
+		"Enable writes on keyspace ks1 tables t1,t2",
+		"Switch routing from keyspace ks2 to keyspace ks1",
+		"Following rules will be added:",
+		"	ks2.t1 => ks1.t1",
+		"	ks2.t2 => ks1.t2",
+		"	t1 => ks1.t1",
+		"	t2 => ks1.t2",
+		"Delete vreplication streams on target:",
+		"	Keyspace ks1 Shard 0 Workflow test_reverse DbName vt_ks1 Tablet 10",
+		"Delete reverse vreplication streams on source:",
+		"	Keyspace ks2 Shard -80 Workflow test DbName vt_ks2 Tablet 20",
+		"	Keyspace ks2 Shard 80- Workflow test DbName vt_ks2 Tablet 30",
+		"Delete journal 7309863361012310039 from:",
+		"	Keyspace ks1 Shard 0 Tablet 10",
+		"Blacklisted tables t1,t2 will be removed from:",
+		"	Keyspace ks2 Shard -80 Tablet 20",
+		"	Keyspace ks2 Shard 80- Tablet 30",
+		"Unlock keyspace ks2",
+		"Unlock keyspace ks1",
+	}
+	results, err := tme.wr.RollbackWorkflow(ctx, tme.targetKeyspace, "test", 1*time.Second, DropTable, true, true)
+	require.NoError(t, err)
+	require.Empty(t, cmp.Diff(wantDryRun, *results))
+	verifyQueries(t, tme.allDBClients)
+
+	frozen()
+	// Writes are switched back once the reverse streams caught up with the targets.
+	state = sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+		"pos|state|message",
+		"varchar|varchar|varchar"),
+		"MariaDB/5-456-893|Running",
+	)
+	for _, id := range []int{1, 2} {
+		tme.dbSourceClients[0].addQuery(fmt.Sprintf("select pos, state, message from _vt.vreplication where id=%d", id), state, nil)
+		tme.dbSourceClients[0].addQuery(fmt.Sprintf("select id from _vt.vreplication where id = %d", id), &sqltypes.Result{Rows: [][]sqltypes.Value{{sqltypes.NewInt64(int64(id))}}}, nil)
+		tme.dbSourceClients[0].addQuery(fmt.Sprintf("update _vt.vreplication set state = 'Stopped', message = 'stopped for cutover' where id in (%d)", id), &sqltypes.Result{}, nil)
+		tme.dbSourceClients[0].addQuery(fmt.Sprintf("select * from _vt.vreplication where id = %d", id), stoppedResult(id), nil)
+	}
+	tme.dbSourceClients[0].addQuery("select id from _vt.vreplication where db_name = 'vt_ks1' and workflow = 'test_reverse'", resultid12, nil)
+	tme.dbSourceClients[0].addQuery("delete from _vt.vreplication where id in (1, 2)", &sqltypes.Result{}, nil)
+	tme.dbSourceClients[0].addQuery("delete from _vt.copy_state where vrepl_id in (1, 2)", &sqltypes.Result{}, nil)
+	for _, dbclient := range tme.dbTargetClients {
+		dbclient.addQuery("select id from _vt.vreplication where db_name = 'vt_ks2' and workflow = 'test'", resultid1, nil)
+		dbclient.addQuery("delete from _vt.vreplication where id in (1)", &sqltypes.Result{}, nil)
+		dbclient.addQuery("delete from _vt.copy_state where vrepl_id in (1)", &sqltypes.Result{}, nil)
+	}
+	tme.dbSourceClients[0].addQuery("delete from _vt.resharding_journal where id=7309863361012310039", &sqltypes.Result{}, nil)
+	tme.tmeDB.AddQuery("drop table vt_ks2.t1", &sqltypes.Result{})
+	tme.tmeDB.AddQuery("drop table vt_ks2.t2", &sqltypes.Result{})
+
+	_, err = tme.wr.RollbackWorkflow(ctx, tme.targetKeyspace, "test", 1*time.Second, DropTable, false, false)
+	require.NoError(t, err)
+	checkRouting(t, tme.wr, map[string][]string{})
+	checkBlacklist(t, tme.ts, "ks1:0", nil)
+	checkBlacklist(t, tme.ts, "ks2:-80", nil)
+	checkBlacklist(t, tme.ts, "ks2:80-", nil)
+	verifyQueries(t, tme.allDBClients)
+}
@@ -0,0 +1,238 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/dtids"
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vttablet/queryservice"
+	"vitess.io/vitess/go/vt/vttablet/tabletconn"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// The 2PC metadata is kept by vttablet in these sidecar tables.
+// See tabletserver/twopc.go for their definitions.
+const (
+	sqlReadDistributedTransactions = `select t.dtid, t.state, t.time_created, p.keyspace, p.shard
+	from _vt.dt_state t
+	join _vt.dt_participant p on t.dtid = p.dtid
+	order by t.dtid, p.id`
+	sqlReadPreparedTransactions = "select dtid, state, time_created from _vt.redo_state order by dtid"
+
+	// redoStatePrepared is tabletserver.RedoStatePrepared.
+	redoStatePrepared = 1
+)
+
+// DistributedTransaction describes an unresolved distributed transaction
+// of a keyspace, as seen by the metadata manager and by the participants.
+type DistributedTransaction struct {
+	Dtid string
+	// Shard is the metadata manager shard. Its master owns the decision.
+	Shard string
+	// State is PREPARE, COMMIT or ROLLBACK. It is empty if the metadata
+	// manager is in another keyspace, and only participants were found.
+	State        string
+	Created      time.Time
+	Participants []*querypb.Target
+	// Prepared and Failed list the shards of the keyspace that still have
+	// a prepared, or failed, redo log for the transaction.
+	Prepared []string
+	Failed   []string
+}
+
+// ListDistributedTransactions returns the unresolved distributed transactions
+// that involve the keyspace, either as metadata manager or as participant.
+// The metadata is read from the masters of all shards.
+func (wr *Wrangler) ListDistributedTransactions(ctx context.Context, keyspace string) ([]*DistributedTransaction, error) {
+	vx := newVExec(ctx, "", keyspace, "", wr)
+	if err := vx.getMasters(); err != nil {
+		return nil, err
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	allErrors := &concurrency.AllErrorRecorder{}
+	transactions := make(map[string]*DistributedTransaction)
+	getTransaction := func(dtid string) *DistributedTransaction {
+		dt, ok := transactions[dtid]
+		if !ok {
+			dt = &DistributedTransaction{Dtid: dtid}
+			if mmShard, err := dtids.ShardSession(dtid); err == nil {
+				dt.Shard = mmShard.Target.Shard
+			}
+			transactions[dtid] = dt
+		}
+		return dt
+	}
+	for _, master := range vx.masters {
+		wg.Add(1)
+		go func(master *topodatapb.Tablet) {
+			defer wg.Done()
+			metadata, err := wr.executeFetchAsDba(ctx, master, sqlReadDistributedTransactions)
+			if err != nil {
+				allErrors.RecordError(fmt.Errorf("%v/%v: %v", master.Keyspace, master.Shard, err))
+				return
+			}
+			redo, err := wr.executeFetchAsDba(ctx, master, sqlReadPreparedTransactions)
+			if err != nil {
+				allErrors.RecordError(fmt.Errorf("%v/%v: %v", master.Keyspace, master.Shard, err))
+				return
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			for _, row := range metadata.Rows {
+				dt := getTransaction(row[0].ToString())
+				if dt.State == "" {
+					// A failure in parsing will show up as UNKNOWN,
+					// or as a very old time, which is harmless.
+					state, _ := evalengine.ToInt64(row[1])
+					created, _ := evalengine.ToInt64(row[2])
+					dt.Shard = master.Shard
+					dt.State = querypb.TransactionState(state).String()
+					dt.Created = time.Unix(0, created)
+				}
+				dt.Participants = append(dt.Participants, &querypb.Target{
+					Keyspace:   row[3].ToString(),
+					Shard:      row[4].ToString(),
+					TabletType: topodatapb.TabletType_MASTER,
+				})
+			}
+			for _, row := range redo.Rows {
+				dt := getTransaction(row[0].ToString())
+				if state, _ := evalengine.ToInt64(row[1]); state == redoStatePrepared {
+					dt.Prepared = append(dt.Prepared, master.Shard)
+				} else {
+					dt.Failed = append(dt.Failed, master.Shard)
+				}
+				if dt.Created.IsZero() {
+					created, _ := evalengine.ToInt64(row[2])
+					dt.Created = time.Unix(0, created)
+				}
+			}
+		}(master.Tablet)
+	}
+	wg.Wait()
+	if allErrors.HasErrors() {
+		return nil, allErrors.AggrError(vterrors.Aggregate)
+	}
+
+	result := make([]*DistributedTransaction, 0, len(transactions))
+	for _, dt := range transactions {
+		sort.Strings(dt.Prepared)
+		sort.Strings(dt.Failed)
+		result = append(result, dt)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Dtid < result[j].Dtid
+	})
+	return result, nil
+}
+
+// TransactionResolver resolves a distributed transaction according to
+// the decision recorded by its metadata manager. It is implemented by
+// *vtgateconn.VTGateConn, whose ResolveTransaction runs the same
+// resolution as the vtgate transaction watchdog.
+type TransactionResolver interface {
+	ResolveTransaction(ctx context.Context, dtid string) error
+}
+
+// ConcludeDistributedTransaction resolves a stuck distributed transaction
+// through resolver, which commits or rolls back all its participants and
+// deletes its metadata. The requested action must be consistent with the
+// decision already recorded by the metadata manager: a transaction in the
+// COMMIT state can only be committed, and one in the PREPARE or ROLLBACK
+// state can only be rolled back. Resolving a transaction that is already
+// resolved is a no-op.
+func (wr *Wrangler) ConcludeDistributedTransaction(ctx context.Context, resolver TransactionResolver, dtid string, commit bool) error {
+	mmShard, err := dtids.ShardSession(dtid)
+	if err != nil {
+		return err
+	}
+	mmTarget := &querypb.Target{
+		Keyspace:   mmShard.Target.Keyspace,
+		Shard:      mmShard.Target.Shard,
+		TabletType: topodatapb.TabletType_MASTER,
+	}
+	mm, err := wr.masterQueryService(ctx, mmTarget)
+	if err != nil {
+		return err
+	}
+	defer mm.Close(ctx)
+
+	transaction, err := mm.ReadTransaction(ctx, mmTarget, dtid)
+	if err != nil {
+		return err
+	}
+	if transaction == nil || transaction.Dtid == "" {
+		wr.Logger().Printf("Distributed transaction %v is already resolved\n", dtid)
+		return nil
+	}
+
+	switch transaction.State {
+	case querypb.TransactionState_COMMIT:
+		if !commit {
+			return fmt.Errorf("cannot roll back %v: the commit decision was already made, it can only be committed", dtid)
+		}
+	case querypb.TransactionState_PREPARE:
+		if commit {
+			return fmt.Errorf("cannot commit %v: it is in the PREPARE state, and not all participants may have prepared; it can only be rolled back", dtid)
+		}
+	case querypb.TransactionState_ROLLBACK:
+		if commit {
+			return fmt.Errorf("cannot commit %v: the rollback decision was already made, it can only be rolled back", dtid)
+		}
+	default:
+		return fmt.Errorf("invalid state for %v: %v", dtid, transaction.State)
+	}
+	return resolver.ResolveTransaction(ctx, dtid)
+}
+
+func (wr *Wrangler) executeFetchAsDba(ctx context.Context, tablet *topodatapb.Tablet, query string) (*sqltypes.Result, error) {
+	qr, err := wr.tmc.ExecuteFetchAsDba(ctx, tablet, false, []byte(query), 10000, false, false)
+	if err != nil {
+		return nil, err
+	}
+	return sqltypes.Proto3ToResult(qr), nil
+}
+
+func (wr *Wrangler) masterQueryService(ctx context.Context, target *querypb.Target) (queryservice.QueryService, error) {
+	si, err := wr.ts.GetShard(ctx, target.Keyspace, target.Shard)
+	if err != nil {
+		return nil, err
+	}
+	if !si.HasMaster() {
+		return nil, fmt.Errorf("shard %v/%v has no master", target.Keyspace, target.Shard)
+	}
+	ti, err := wr.ts.GetTablet(ctx, si.MasterAlias)
+	if err != nil {
+		return nil, err
+	}
+	return tabletconn.GetDialer()(ti.Tablet, grpcclient.FailFast(false))
+}
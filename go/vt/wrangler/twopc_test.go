@@ -0,0 +1,163 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	"vitess.io/vitess/go/vt/vttablet/sandboxconn"
+)
+
+func newTwoPCTestEnv(t *testing.T) (*testWranglerEnv, *sandboxconn.SandboxConn, *sandboxconn.SandboxConn) {
+	env := newWranglerTestEnv([]string{"-80", "80-"}, []string{"0"}, "", nil)
+	sbc1 := sandboxconn.NewSandboxConn(env.tablets[100].tablet)
+	sbc2 := sandboxconn.NewSandboxConn(env.tablets[110].tablet)
+	env.tablets[100].QueryService = sbc1
+	env.tablets[110].QueryService = sbc2
+	return env, sbc1, sbc2
+}
+
+func TestListDistributedTransactions(t *testing.T) {
+	env, _, _ := newTwoPCTestEnv(t)
+	defer env.close()
+
+	metadataFields := sqltypes.MakeTestFields("dtid|state|time_created|keyspace|shard", "varbinary|int64|int64|varchar|varchar")
+	redoFields := sqltypes.MakeTestFields("dtid|state|time_created", "varbinary|int64|int64")
+	created := time.Unix(1600000000, 0)
+	ts := "1600000000000000000"
+
+	// -80 is the metadata manager of a stuck commit, and a prepared participant.
+	env.tablets[100].queryResults[sqlReadDistributedTransactions] = sqltypes.ResultToProto3(sqltypes.MakeTestResult(metadataFields,
+		"source:-80:1|2|"+ts+"|source|-80",
+		"source:-80:1|2|"+ts+"|source|80-",
+		"source:-80:1|2|"+ts+"|target|0",
+	))
+	env.tablets[100].queryResults[sqlReadPreparedTransactions] = sqltypes.ResultToProto3(sqltypes.MakeTestResult(redoFields,
+		"source:-80:1|1|"+ts,
+	))
+	// 80- failed to prepare, and participates in a transaction managed elsewhere.
+	env.tablets[110].queryResults[sqlReadDistributedTransactions] = sqltypes.ResultToProto3(sqltypes.MakeTestResult(metadataFields))
+	env.tablets[110].queryResults[sqlReadPreparedTransactions] = sqltypes.ResultToProto3(sqltypes.MakeTestResult(redoFields,
+		"other:0:5|1|"+ts,
+		"source:-80:1|0|"+ts,
+	))
+
+	got, err := env.wr.ListDistributedTransactions(context.Background(), "source")
+	require.NoError(t, err)
+	want := []*DistributedTransaction{{
+		Dtid:  "other:0:5",
+		Shard: "0",
+		// The metadata manager is not in the keyspace.
+		Created:  created,
+		Prepared: []string{"80-"},
+	}, {
+		Dtid:    "source:-80:1",
+		Shard:   "-80",
+		State:   "COMMIT",
+		Created: created,
+		Participants: []*querypb.Target{
+			{Keyspace: "source", Shard: "-80", TabletType: topodatapb.TabletType_MASTER},
+			{Keyspace: "source", Shard: "80-", TabletType: topodatapb.TabletType_MASTER},
+			{Keyspace: "target", Shard: "0", TabletType: topodatapb.TabletType_MASTER},
+		},
+		Prepared: []string{"-80"},
+		Failed:   []string{"80-"},
+	}}
+	assert.Equal(t, want, got)
+}
+
+type fakeTransactionResolver struct {
+	resolved []string
+}
+
+func (r *fakeTransactionResolver) ResolveTransaction(ctx context.Context, dtid string) error {
+	r.resolved = append(r.resolved, dtid)
+	return nil
+}
+
+func TestConcludeDistributedTransaction(t *testing.T) {
+	participants := []*querypb.Target{
+		{Keyspace: "source", Shard: "-80"},
+		{Keyspace: "source", Shard: "80-"},
+	}
+	tcases := []struct {
+		name   string
+		state  querypb.TransactionState
+		commit bool
+		err    string
+	}{{
+		name:   "commit decided",
+		state:  querypb.TransactionState_COMMIT,
+		commit: true,
+	}, {
+		name:  "cannot roll back a commit",
+		state: querypb.TransactionState_COMMIT,
+		err:   "cannot roll back source:-80:1: the commit decision was already made, it can only be committed",
+	}, {
+		name:  "roll back prepare",
+		state: querypb.TransactionState_PREPARE,
+	}, {
+		name:   "cannot commit a prepare",
+		state:  querypb.TransactionState_PREPARE,
+		commit: true,
+		err:    "cannot commit source:-80:1: it is in the PREPARE state, and not all participants may have prepared; it can only be rolled back",
+	}, {
+		name:  "roll back decided",
+		state: querypb.TransactionState_ROLLBACK,
+	}, {
+		name:   "cannot commit a rollback",
+		state:  querypb.TransactionState_ROLLBACK,
+		commit: true,
+		err:    "cannot commit source:-80:1: the rollback decision was already made, it can only be rolled back",
+	}}
+	for _, tcase := range tcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			env, sbc1, _ := newTwoPCTestEnv(t)
+			defer env.close()
+			sbc1.ReadTransactionResults = []*querypb.TransactionMetadata{{
+				Dtid:         "source:-80:1",
+				State:        tcase.state,
+				Participants: participants,
+			}}
+			resolver := &fakeTransactionResolver{}
+
+			err := env.wr.ConcludeDistributedTransaction(context.Background(), resolver, "source:-80:1", tcase.commit)
+			if tcase.err != "" {
+				assert.EqualError(t, err, tcase.err)
+				assert.Empty(t, resolver.resolved)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, []string{"source:-80:1"}, resolver.resolved)
+		})
+	}
+
+	// A resolved transaction is a no-op.
+	env, _, _ := newTwoPCTestEnv(t)
+	defer env.close()
+	resolver := &fakeTransactionResolver{}
+	require.NoError(t, env.wr.ConcludeDistributedTransaction(context.Background(), resolver, "source:-80:1", true))
+	assert.Empty(t, resolver.resolved)
+}
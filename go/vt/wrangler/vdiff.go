@@ -19,6 +19,7 @@ package wrangler
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"strings"
 	"sync"
 	"time"
@@ -44,6 +45,7 @@ import (
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
 	"vitess.io/vitess/go/vt/vttablet/tabletconn"
 	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
 )
@@ -71,6 +73,20 @@ type vdiff struct {
 	// The source and target keyspaces are pulled from ts.
 	sources map[string]*shardStreamer
 	targets map[string]*shardStreamer
+
+	// syncMu serializes the synchronization of the sources
+	// and targets, which stops and restarts the workflow.
+	syncMu sync.Mutex
+
+	// repair is one of the VDiffRepair modes, and repairRate
+	// limits the number of statements applied per second.
+	repair     string
+	repairRate int
+	// repairOut receives the repair statements if it's set.
+	repairOut io.Writer
+	// targetVSchema is used to route the inserts of a repair.
+	// It's loaded on first use.
+	targetVSchema *vindexes.KeyspaceSchema
 }
 
 // tableDiffer performs a diff for one table in the workflow.
@@ -111,9 +127,21 @@ type shardStreamer struct {
 }
 
 // VDiff reports differences between the sources and targets of a vreplication workflow.
+// Tables with a single integral primary key column are split into the requested number
+// of chunks, and up to concurrency chunks are diffed at the same time. The progress is
+// recorded on the target, and an interrupted vdiff can be continued by setting resume.
+// Unless repair is VDiffRepairNone, the primary keys of the rows that differ are recorded
+// in _vt.vdiff_diff, and the statements that repair the target are generated or applied.
+// If repairFile is set, the statements are also written to that file.
 func (wr *Wrangler) VDiff(ctx context.Context, targetKeyspace, workflow, sourceCell, targetCell, tabletTypesStr string,
 	filteredReplicationWaitTime time.Duration,
-	format string) (map[string]*DiffReport, error) {
+	format string, concurrency, chunks int, resume bool, repair string, repairRate int, repairFile string) (map[string]*DiffReport, error) {
+	if err := validateRepairMode(repair, repairFile); err != nil {
+		return nil, err
+	}
+	if concurrency < 1 {
+		concurrency = 1
+	}
 	// Assign defaults to sourceCell and targetCell if not specified.
 	if sourceCell == "" && targetCell == "" {
 		cells, err := wr.ts.GetCellInfoNames(ctx)
@@ -153,6 +181,8 @@ func (wr *Wrangler) VDiff(ctx context.Context, targetKeyspace, workflow, sourceC
 		tabletTypesStr: tabletTypesStr,
 		sources:        make(map[string]*shardStreamer),
 		targets:        make(map[string]*shardStreamer),
+		repair:         repair,
+		repairRate:     repairRate,
 	}
 	for shard, source := range ts.sources {
 		df.sources[shard] = &shardStreamer{
@@ -193,35 +223,73 @@ func (wr *Wrangler) VDiff(ctx context.Context, targetKeyspace, workflow, sourceC
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	// TODO(sougou): parallelize
-	diffReports := make(map[string]*DiffReport)
-	jsonOutput := ""
-	for table, td := range df.differs {
-		// Stop the targets and record their source positions.
-		if err := df.stopTargets(ctx); err != nil {
-			return nil, vterrors.Wrap(err, "stopTargets")
-		}
-		// Make sure all sources are past the target's positions and start a query stream that records the current source positions.
-		if err := df.startQueryStreams(ctx, df.ts.sourceKeyspace, df.sources, td.sourceExpression, filteredReplicationWaitTime); err != nil {
-			return nil, vterrors.Wrap(err, "startQueryStreams(sources)")
-		}
-		// Fast forward the targets to the newly recorded source positions.
-		if err := df.syncTargets(ctx, filteredReplicationWaitTime); err != nil {
-			return nil, vterrors.Wrap(err, "syncTargets")
-		}
-		// Sources and targets are in sync. Start query streams on the targets.
-		if err := df.startQueryStreams(ctx, df.ts.targetKeyspace, df.targets, td.targetExpression, filteredReplicationWaitTime); err != nil {
-			return nil, vterrors.Wrap(err, "startQueryStreams(targets)")
+	saved, err := df.initState(ctx, resume)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "initState")
+	}
+	allChunks, err := df.planChunks(ctx, chunks, saved)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "planChunks")
+	}
+
+	// Diff the chunks that are not completed yet, with up to
+	// concurrency chunks at a time. The first error cancels
+	// the other diffs.
+	chunkch := make(chan *vdiffChunk, len(allChunks))
+	for _, chunk := range allChunks {
+		if chunk.state != vdiffStateCompleted {
+			chunkch <- chunk
 		}
-		// Now that queries are running, target vreplication streams can be restarted.
-		if err := df.restartTargets(ctx); err != nil {
-			return nil, vterrors.Wrap(err, "restartTargets")
+	}
+	close(chunkch)
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var firstErr error
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for chunk := range chunkch {
+				if ctx.Err() != nil {
+					return
+				}
+				if err := df.diffChunk(ctx, chunk, filteredReplicationWaitTime); err != nil {
+					mu.Lock()
+					if firstErr == nil {
+						firstErr = err
+					}
+					mu.Unlock()
+					cancel()
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	if repair == VDiffRepairDryRun || repair == VDiffRepairApply {
+		if err := df.repairTables(ctx, repairFile, filteredReplicationWaitTime); err != nil {
+			return nil, err
 		}
-		// Perform the diff of source and target streams.
-		dr, err := td.diff(ctx, df.ts.wr)
-		if err != nil {
-			return nil, vterrors.Wrap(err, "diff")
+	}
+
+	diffReports := make(map[string]*DiffReport)
+	var tables []string
+	for _, chunk := range allChunks {
+		dr, ok := diffReports[chunk.table]
+		if !ok {
+			dr = &DiffReport{}
+			diffReports[chunk.table] = dr
+			tables = append(tables, chunk.table)
 		}
+		dr.add(&chunk.report)
+	}
+	jsonOutput := ""
+	for _, table := range tables {
+		td, dr := df.differs[table], diffReports[table]
 		if format == "json" {
 			json, err := json.MarshalIndent(*dr, "", "")
 			if err != nil {
@@ -234,7 +302,6 @@ func (wr *Wrangler) VDiff(ctx context.Context, targetKeyspace, workflow, sourceC
 		} else {
 			wr.Logger().Printf("Summary for %v: %+v\n", td.targetTable, *dr)
 		}
-		diffReports[table] = dr
 	}
 	if format == "json" && jsonOutput != "" {
 		wr.logger.Printf(`[ %s ]`, jsonOutput)
@@ -242,6 +309,83 @@ func (wr *Wrangler) VDiff(ctx context.Context, targetKeyspace, workflow, sourceC
 	return diffReports, nil
 }
 
+// diffChunk diffs one chunk of a table, and records its progress.
+func (df *vdiff) diffChunk(ctx context.Context, chunk *vdiffChunk, filteredReplicationWaitTime time.Duration) error {
+	td := df.differs[chunk.table]
+	sourceQuery, targetQuery, pkCols, err := td.chunkQueries(chunk)
+	if err != nil {
+		return vterrors.Wrap(err, "chunkQueries")
+	}
+	sources := newParticipants(df.sources)
+	targets := newParticipants(df.targets)
+	if err := df.startStreams(ctx, sources, targets, sourceQuery, targetQuery, filteredReplicationWaitTime); err != nil {
+		return err
+	}
+
+	now := time.Now().Unix()
+	if chunk.timeStarted == 0 {
+		chunk.timeStarted = now
+	}
+	chunk.timeUpdated = now
+	chunk.state = vdiffStateRunning
+	if err := df.saveChunk(ctx, chunk); err != nil {
+		return vterrors.Wrap(err, "saveChunk")
+	}
+
+	// Perform the diff of source and target streams.
+	sourcePrimitive := withParticipants(td.sourcePrimitive, sources)
+	targetPrimitive := withParticipants(td.targetPrimitive, targets)
+	var onDiff func(sourceRow, targetRow []sqltypes.Value) error
+	if df.repair != VDiffRepairNone {
+		onDiff = func(sourceRow, targetRow []sqltypes.Value) error {
+			return df.recordDiff(ctx, chunk.table, pkCols, sourceRow, targetRow)
+		}
+	}
+	err = td.diff(ctx, df.ts.wr, sourcePrimitive, targetPrimitive, pkCols, &chunk.report, func(lastPK []sqltypes.Value) error {
+		chunk.lastPK = lastPK
+		chunk.timeUpdated = time.Now().Unix()
+		return df.saveChunk(ctx, chunk)
+	}, onDiff)
+	if err != nil {
+		return vterrors.Wrap(err, "diff")
+	}
+	chunk.state = vdiffStateCompleted
+	chunk.timeUpdated = time.Now().Unix()
+	if err := df.saveChunk(ctx, chunk); err != nil {
+		return vterrors.Wrap(err, "saveChunk")
+	}
+	return nil
+}
+
+// startStreams synchronizes the sources and targets, and starts the query
+// streams of one chunk on them. The targets are left running.
+func (df *vdiff) startStreams(ctx context.Context, sources, targets map[string]*shardStreamer, sourceQuery, targetQuery string, filteredReplicationWaitTime time.Duration) error {
+	df.syncMu.Lock()
+	defer df.syncMu.Unlock()
+
+	// Stop the targets and record their source positions.
+	if err := df.stopTargets(ctx, sources); err != nil {
+		return vterrors.Wrap(err, "stopTargets")
+	}
+	// Make sure all sources are past the target's positions and start a query stream that records the current source positions.
+	if err := df.startQueryStreams(ctx, df.ts.sourceKeyspace, sources, sourceQuery, filteredReplicationWaitTime); err != nil {
+		return vterrors.Wrap(err, "startQueryStreams(sources)")
+	}
+	// Fast forward the targets to the newly recorded source positions.
+	if err := df.syncTargets(ctx, sources, targets, filteredReplicationWaitTime); err != nil {
+		return vterrors.Wrap(err, "syncTargets")
+	}
+	// Sources and targets are in sync. Start query streams on the targets.
+	if err := df.startQueryStreams(ctx, df.ts.targetKeyspace, targets, targetQuery, filteredReplicationWaitTime); err != nil {
+		return vterrors.Wrap(err, "startQueryStreams(targets)")
+	}
+	// Now that queries are running, target vreplication streams can be restarted.
+	if err := df.restartTargets(ctx); err != nil {
+		return vterrors.Wrap(err, "restartTargets")
+	}
+	return nil
+}
+
 // buildVDiffPlan builds all the differs.
 func (df *vdiff) buildVDiffPlan(ctx context.Context, filter *binlogdatapb.Filter, schm *tabletmanagerdatapb.SchemaDefinition) error {
 	df.differs = make(map[string]*tableDiffer)
@@ -489,7 +633,7 @@ func (df *vdiff) selectTablets(ctx context.Context) error {
 }
 
 // stopTargets stops all the targets and records their source positions.
-func (df *vdiff) stopTargets(ctx context.Context) error {
+func (df *vdiff) stopTargets(ctx context.Context, sources map[string]*shardStreamer) error {
 	var mu sync.Mutex
 
 	err := df.forAll(df.targets, func(shard string, target *shardStreamer) error {
@@ -518,7 +662,7 @@ func (df *vdiff) stopTargets(ctx context.Context) error {
 				mu.Lock()
 				defer mu.Unlock()
 
-				source, ok := df.sources[bls.Shard]
+				source, ok := sources[bls.Shard]
 				if !ok {
 					// Unreachable.
 					return
@@ -617,12 +761,12 @@ func (df *vdiff) streamOne(ctx context.Context, keyspace, shard string, particip
 
 // syncTargets fast-forwards the vreplication to the source snapshot positons
 // and waits for the selected tablets to catch up to that point.
-func (df *vdiff) syncTargets(ctx context.Context, filteredReplicationWaitTime time.Duration) error {
+func (df *vdiff) syncTargets(ctx context.Context, sources, targets map[string]*shardStreamer, filteredReplicationWaitTime time.Duration) error {
 	waitCtx, cancel := context.WithTimeout(ctx, filteredReplicationWaitTime)
 	defer cancel()
 	err := df.ts.forAllUids(func(target *tsTarget, uid uint32) error {
 		bls := target.sources[uid]
-		pos := df.sources[bls.Shard].snapshotPosition
+		pos := sources[bls.Shard].snapshotPosition
 		query := fmt.Sprintf("update _vt.vreplication set state='Running', stop_pos='%s', message='synchronizing for vdiff' where id=%d", pos, uid)
 		if _, err := df.ts.wr.tmc.VReplicationExec(ctx, target.master.Tablet, query); err != nil {
 			return err
@@ -636,7 +780,7 @@ func (df *vdiff) syncTargets(ctx context.Context, filteredReplicationWaitTime ti
 		return err
 	}
 
-	err = df.forAll(df.targets, func(shard string, target *shardStreamer) error {
+	err = df.forAll(targets, func(shard string, target *shardStreamer) error {
 		pos, err := df.ts.wr.tmc.MasterPosition(ctx, target.master.Tablet)
 		if err != nil {
 			return err
@@ -723,7 +867,8 @@ func (pe *primitiveExecutor) next() ([]sqltypes.Value, error) {
 	return row, nil
 }
 
-func (pe *primitiveExecutor) drain(ctx context.Context) (int, error) {
+// drain consumes the remaining rows, and calls f for each of them if it's set.
+func (pe *primitiveExecutor) drain(ctx context.Context, f func([]sqltypes.Value) error) (int, error) {
 	count := 0
 	for {
 		row, err := pe.next()
@@ -733,6 +878,11 @@ func (pe *primitiveExecutor) drain(ctx context.Context) (int, error) {
 		if row == nil {
 			return count, nil
 		}
+		if f != nil {
+			if err := f(row); err != nil {
+				return 0, err
+			}
+		}
 		count++
 	}
 }
@@ -740,6 +890,36 @@ func (pe *primitiveExecutor) drain(ctx context.Context) (int, error) {
 //-----------------------------------------------------------------
 // shardStreamer
 
+// newParticipants returns new shardStreamers for the same tablets,
+// so that the streams of several chunks can run at the same time.
+func newParticipants(participants map[string]*shardStreamer) map[string]*shardStreamer {
+	result := make(map[string]*shardStreamer, len(participants))
+	for shard, participant := range participants {
+		result[shard] = &shardStreamer{
+			master: participant.master,
+			tablet: participant.tablet,
+		}
+	}
+	return result
+}
+
+// withParticipants returns a copy of a primitive built by buildTablePlan
+// that streams from the specified participants.
+func withParticipants(prim engine.Primitive, participants map[string]*shardStreamer) engine.Primitive {
+	switch prim := prim.(type) {
+	case *engine.OrderedAggregate:
+		oa := *prim
+		oa.Input = withParticipants(prim.Input, participants)
+		return &oa
+	case *engine.MergeSort:
+		ms := newMergeSorter(participants, nil)
+		ms.OrderBy = prim.OrderBy
+		return ms
+	}
+	// Unreachable.
+	return prim
+}
+
 func (sm *shardStreamer) StreamExecute(vcursor engine.VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
 	for result := range sm.result {
 		if err := callback(result); err != nil {
@@ -752,30 +932,42 @@ func (sm *shardStreamer) StreamExecute(vcursor engine.VCursor, bindVars map[stri
 //-----------------------------------------------------------------
 // tableDiffer
 
-func (td *tableDiffer) diff(ctx context.Context, wr *Wrangler) (*DiffReport, error) {
-	sourceExecutor := newPrimitiveExecutor(ctx, td.sourcePrimitive)
-	targetExecutor := newPrimitiveExecutor(ctx, td.targetPrimitive)
-	dr := &DiffReport{}
+// diff compares the rows streamed by the source and target primitives, and adds
+// the results to dr. Every vdiffCheckpointRows rows, checkpoint is called with
+// the primary key of the last row that was compared on both sides. The pk values
+// are at pkCols in the rows. If onDiff is set, it's called for every row that
+// differs. The source or target row is nil if it's missing.
+func (td *tableDiffer) diff(ctx context.Context, wr *Wrangler, sourcePrimitive, targetPrimitive engine.Primitive, pkCols []int, dr *DiffReport,
+	checkpoint func(lastPK []sqltypes.Value) error,
+	onDiff func(sourceRow, targetRow []sqltypes.Value) error) error {
+	var onExtraSource, onExtraTarget func([]sqltypes.Value) error
+	if onDiff != nil {
+		onExtraSource = func(row []sqltypes.Value) error { return onDiff(row, nil) }
+		onExtraTarget = func(row []sqltypes.Value) error { return onDiff(nil, row) }
+	}
+	sourceExecutor := newPrimitiveExecutor(ctx, sourcePrimitive)
+	targetExecutor := newPrimitiveExecutor(ctx, targetPrimitive)
 	var sourceRow, targetRow []sqltypes.Value
 	var err error
 	advanceSource := true
 	advanceTarget := true
+	lastCheckpoint := dr.ProcessedRows
 	for {
 		if advanceSource {
 			sourceRow, err = sourceExecutor.next()
 			if err != nil {
-				return nil, err
+				return err
 			}
 		}
 		if advanceTarget {
 			targetRow, err = targetExecutor.next()
 			if err != nil {
-				return nil, err
+				return err
 			}
 		}
 
 		if sourceRow == nil && targetRow == nil {
-			return dr, nil
+			return nil
 		}
 
 		advanceSource = true
@@ -784,25 +976,35 @@ func (td *tableDiffer) diff(ctx context.Context, wr *Wrangler) (*DiffReport, err
 		if sourceRow == nil {
 			// drain target, update count
 			wr.Logger().Errorf("Draining extra row(s) found on the target starting with: %v", targetRow)
-			count, err := targetExecutor.drain(ctx)
+			if onExtraTarget != nil {
+				if err := onExtraTarget(targetRow); err != nil {
+					return err
+				}
+			}
+			count, err := targetExecutor.drain(ctx, onExtraTarget)
 			if err != nil {
-				return nil, err
+				return err
 			}
 			dr.ExtraRowsTarget += 1 + count
 			dr.ProcessedRows += 1 + count
-			return dr, nil
+			return nil
 		}
 		if targetRow == nil {
 			// no more rows from the target
 			// we know we have rows from source, drain, update count
 			wr.Logger().Errorf("Draining extra row(s) found on the source starting with: %v", sourceRow)
-			count, err := sourceExecutor.drain(ctx)
+			if onExtraSource != nil {
+				if err := onExtraSource(sourceRow); err != nil {
+					return err
+				}
+			}
+			count, err := sourceExecutor.drain(ctx, onExtraSource)
 			if err != nil {
-				return nil, err
+				return err
 			}
 			dr.ExtraRowsSource += 1 + count
 			dr.ProcessedRows += 1 + count
-			return dr, nil
+			return nil
 		}
 
 		dr.ProcessedRows++
@@ -811,13 +1013,18 @@ func (td *tableDiffer) diff(ctx context.Context, wr *Wrangler) (*DiffReport, err
 		c, err := td.compare(sourceRow, targetRow, td.comparePKs)
 		switch {
 		case err != nil:
-			return nil, err
+			return err
 		case c < 0:
 			if dr.ExtraRowsSource < 10 {
 				wr.Logger().Errorf("[table=%v] Extra row %v on source: %v", td.targetTable, dr.ExtraRowsSource, sourceRow)
 			}
 			dr.ExtraRowsSource++
 			advanceTarget = false
+			if onExtraSource != nil {
+				if err := onExtraSource(sourceRow); err != nil {
+					return err
+				}
+			}
 			continue
 		case c > 0:
 			if dr.ExtraRowsTarget < 10 {
@@ -825,6 +1032,11 @@ func (td *tableDiffer) diff(ctx context.Context, wr *Wrangler) (*DiffReport, err
 			}
 			dr.ExtraRowsTarget++
 			advanceSource = false
+			if onExtraTarget != nil {
+				if err := onExtraTarget(targetRow); err != nil {
+					return err
+				}
+			}
 			continue
 		}
 
@@ -833,16 +1045,125 @@ func (td *tableDiffer) diff(ctx context.Context, wr *Wrangler) (*DiffReport, err
 		c, err = td.compare(sourceRow, targetRow, td.compareCols)
 		switch {
 		case err != nil:
-			return nil, err
+			return err
 		case c != 0:
 			if dr.MismatchedRows < 10 {
 				wr.Logger().Errorf("[table=%v] Different content %v in same PK: %v != %v", td.targetTable, dr.MismatchedRows, sourceRow, targetRow)
 			}
 			dr.MismatchedRows++
+			if onDiff != nil {
+				if err := onDiff(sourceRow, targetRow); err != nil {
+					return err
+				}
+			}
 		default:
 			dr.MatchingRows++
 		}
+
+		// All rows up to this pk were compared on both sides.
+		if checkpoint != nil && dr.ProcessedRows-lastCheckpoint >= vdiffCheckpointRows {
+			lastPK := make([]sqltypes.Value, 0, len(pkCols))
+			for _, col := range pkCols {
+				lastPK = append(lastPK, targetRow[col])
+			}
+			if err := checkpoint(lastPK); err != nil {
+				return err
+			}
+			lastCheckpoint = dr.ProcessedRows
+		}
+	}
+}
+
+// tablePKs describes the primary key columns of the queries of a tableDiffer.
+type tablePKs struct {
+	sourceSelect *sqlparser.Select
+	targetSelect *sqlparser.Select
+	// sourceExprs and targetExprs are the pk expressions
+	// of the source and target queries.
+	sourceExprs []sqlparser.Expr
+	targetExprs []sqlparser.Expr
+	// cols are the column numbers of the pk values in the results.
+	cols []int
+}
+
+// parsePKs finds the primary key columns from the order by of the target query.
+func (td *tableDiffer) parsePKs() (*tablePKs, error) {
+	pk := &tablePKs{}
+	for _, query := range []string{td.sourceExpression, td.targetExpression} {
+		statement, err := sqlparser.Parse(query)
+		if err != nil {
+			return nil, err
+		}
+		sel, ok := statement.(*sqlparser.Select)
+		if !ok {
+			return nil, fmt.Errorf("unexpected: %v", query)
+		}
+		if pk.sourceSelect == nil {
+			pk.sourceSelect = sel
+		} else {
+			pk.targetSelect = sel
+		}
+	}
+	for _, order := range pk.targetSelect.OrderBy {
+		name, ok := order.Expr.(*sqlparser.ColName)
+		if !ok {
+			return nil, fmt.Errorf("unexpected: %v", sqlparser.String(order))
+		}
+		found := false
+		for i, selExpr := range pk.targetSelect.SelectExprs {
+			colName, ok := selExpr.(*sqlparser.AliasedExpr).Expr.(*sqlparser.ColName)
+			if !ok || !colName.Name.Equal(name.Name) {
+				continue
+			}
+			pk.targetExprs = append(pk.targetExprs, colName)
+			pk.sourceExprs = append(pk.sourceExprs, pk.sourceSelect.SelectExprs[i].(*sqlparser.AliasedExpr).Expr)
+			pk.cols = append(pk.cols, i)
+			found = true
+			break
+		}
+		if !found {
+			return nil, fmt.Errorf("column %v not found in %v", sqlparser.String(name), td.targetExpression)
+		}
 	}
+	return pk, nil
+}
+
+// chunkQueries returns the source and target queries for a chunk, and
+// the column numbers of the pk values in their results.
+func (td *tableDiffer) chunkQueries(chunk *vdiffChunk) (sourceQuery, targetQuery string, pkCols []int, err error) {
+	pk, err := td.parsePKs()
+	if err != nil {
+		return "", "", nil, err
+	}
+	if chunk.lowerBound.IsNull() && chunk.upperBound.IsNull() && len(chunk.lastPK) == 0 {
+		return td.sourceExpression, td.targetExpression, pk.cols, nil
+	}
+	if len(chunk.lastPK) != 0 && len(chunk.lastPK) != len(pk.cols) {
+		return "", "", nil, fmt.Errorf("primary key values don't match length: %v vs %v", chunk.lastPK, td.comparePKs)
+	}
+	for _, side := range []struct {
+		sel   *sqlparser.Select
+		exprs []sqlparser.Expr
+	}{{pk.sourceSelect, pk.sourceExprs}, {pk.targetSelect, pk.targetExprs}} {
+		if !chunk.lowerBound.IsNull() {
+			side.sel.AddWhere(&sqlparser.ComparisonExpr{
+				Operator: sqlparser.GreaterEqualStr,
+				Left:     side.exprs[0],
+				Right:    valueExpr(chunk.lowerBound),
+			})
+		}
+		if !chunk.upperBound.IsNull() {
+			side.sel.AddWhere(&sqlparser.ComparisonExpr{
+				Operator: sqlparser.LessThanStr,
+				Left:     side.exprs[0],
+				Right:    valueExpr(chunk.upperBound),
+			})
+		}
+		if len(chunk.lastPK) != 0 {
+			side.sel.AddWhere(afterPK(side.exprs, chunk.lastPK))
+		}
+	}
+	return sqlparser.String(pk.sourceSelect), sqlparser.String(pk.targetSelect), pk.cols, nil
 }
 
 func (td *tableDiffer) compare(sourceRow, targetRow []sqltypes.Value, cols []int) (int, error) {
@@ -923,3 +1244,47 @@ func wrapWeightString(expr sqlparser.SelectExpr) *sqlparser.AliasedExpr {
 		},
 	}
 }
+
+// afterPK returns the condition for rows that come after lastPK.
+// If lastpk was (1,2), the condition would be:
+// (col1 = 1 and col2 > 2) or (col1 > 1).
+// A tuple inequality like (col1,col2) > (1,2) ends up
+// being a full table scan for mysql.
+func afterPK(exprs []sqlparser.Expr, lastPK []sqltypes.Value) sqlparser.Expr {
+	var result sqlparser.Expr
+	for lastcol := len(exprs) - 1; lastcol >= 0; lastcol-- {
+		var cond sqlparser.Expr = &sqlparser.ComparisonExpr{
+			Operator: sqlparser.GreaterThanStr,
+			Left:     exprs[lastcol],
+			Right:    valueExpr(lastPK[lastcol]),
+		}
+		for i := lastcol - 1; i >= 0; i-- {
+			cond = &sqlparser.AndExpr{
+				Left: &sqlparser.ComparisonExpr{
+					Operator: sqlparser.EqualStr,
+					Left:     exprs[i],
+					Right:    valueExpr(lastPK[i]),
+				},
+				Right: cond,
+			}
+		}
+		if result == nil {
+			result = cond
+			continue
+		}
+		result = &sqlparser.OrExpr{Left: result, Right: cond}
+	}
+	return result
+}
+
+func valueExpr(v sqltypes.Value) sqlparser.Expr {
+	switch {
+	case v.IsNull():
+		return &sqlparser.NullVal{}
+	case v.IsIntegral():
+		return sqlparser.NewIntLiteral(v.ToBytes())
+	case v.IsFloat() || v.Type() == sqltypes.Decimal:
+		return sqlparser.NewFloatLiteral(v.ToBytes())
+	}
+	return sqlparser.NewStrLiteral(v.ToBytes())
+}
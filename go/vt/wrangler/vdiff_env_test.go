@@ -278,14 +278,22 @@ type testVDiffTMClient struct {
 	waitpos   map[int]string
 	vrpos     map[int]string
 	pos       map[int]string
+
+	// dbaQueries are the results of ExecuteFetchAsDba. Other
+	// queries succeed with an empty result, and are only logged
+	// in dbaLog.
+	mu         sync.Mutex
+	dbaQueries map[int]map[string]*querypb.QueryResult
+	dbaLog     []string
 }
 
 func newTestVDiffTMClient() *testVDiffTMClient {
 	return &testVDiffTMClient{
-		vrQueries: make(map[int]map[string]*querypb.QueryResult),
-		waitpos:   make(map[int]string),
-		vrpos:     make(map[int]string),
-		pos:       make(map[int]string),
+		vrQueries:  make(map[int]map[string]*querypb.QueryResult),
+		waitpos:    make(map[int]string),
+		vrpos:      make(map[int]string),
+		pos:        make(map[int]string),
+		dbaQueries: make(map[int]map[string]*querypb.QueryResult),
 	}
 }
 
@@ -310,6 +318,27 @@ func (tmc *testVDiffTMClient) VReplicationExec(ctx context.Context, tablet *topo
 	return result, nil
 }
 
+func (tmc *testVDiffTMClient) setDBAResults(tablet *topodatapb.Tablet, query string, result *sqltypes.Result) {
+	tmc.mu.Lock()
+	defer tmc.mu.Unlock()
+	queries, ok := tmc.dbaQueries[int(tablet.Alias.Uid)]
+	if !ok {
+		queries = make(map[string]*querypb.QueryResult)
+		tmc.dbaQueries[int(tablet.Alias.Uid)] = queries
+	}
+	queries[query] = sqltypes.ResultToProto3(result)
+}
+
+func (tmc *testVDiffTMClient) ExecuteFetchAsDba(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, query []byte, maxRows int, disableBinlogs, reloadSchema bool) (*querypb.QueryResult, error) {
+	tmc.mu.Lock()
+	defer tmc.mu.Unlock()
+	tmc.dbaLog = append(tmc.dbaLog, fmt.Sprintf("%d: %s", tablet.Alias.Uid, query))
+	if result, ok := tmc.dbaQueries[int(tablet.Alias.Uid)][string(query)]; ok {
+		return result, nil
+	}
+	return &querypb.QueryResult{}, nil
+}
+
 func (tmc *testVDiffTMClient) WaitForPosition(ctx context.Context, tablet *topodatapb.Tablet, pos string) error {
 	select {
 	case <-ctx.Done():
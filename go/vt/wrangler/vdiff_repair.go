@@ -0,0 +1,516 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+)
+
+// The repair modes of VDiff.
+const (
+	// VDiffRepairNone only reports the differences.
+	VDiffRepairNone = ""
+	// VDiffRepairRecord records the primary keys of the rows
+	// that differ in the _vt.vdiff_diff table of the target.
+	VDiffRepairRecord = "record"
+	// VDiffRepairDryRun also records the statements that would
+	// repair the target, without applying them.
+	VDiffRepairDryRun = "dry_run"
+	// VDiffRepairApply also applies the statements.
+	VDiffRepairApply = "apply"
+)
+
+// The differing rows are kept next to the progress of the vdiff.
+// pk is encoded like the lastpk of _vt.vdiff. A composite or long
+// primary key doesn't fit in an index next to the other key columns,
+// so rows are keyed by pk_hash, the hex SHA-256 of pk, see vdiffPKHash.
+// The recorded rows are read in pages, in the order of pk_hash.
+// fix is the statement that repairs the target.
+const (
+	sqlCreateVDiffDiffTable = `create table if not exists _vt.vdiff_diff (
+  db_name varbinary(255) not null,
+  workflow varbinary(1000) not null,
+  table_name varbinary(128) not null,
+  pk_hash varbinary(64) not null,
+  pk blob not null,
+  diff_type varbinary(20) not null,
+  state varbinary(20) not null,
+  fix mediumblob,
+  primary key (db_name, workflow, table_name, pk_hash)
+) engine=InnoDB`
+	sqlDeleteVDiffDiffs = "delete from _vt.vdiff_diff where db_name=%s and workflow=%s"
+	sqlRecordVDiffDiff  = `insert into _vt.vdiff_diff(db_name, workflow, table_name, pk_hash, pk, diff_type, state, fix) values (%s, %s, %s, %s, %s, %s, %s, '')
+  on duplicate key update diff_type=values(diff_type), state=values(state), fix=values(fix)`
+	sqlReadVDiffDiffs  = "select pk, pk_hash from _vt.vdiff_diff where db_name=%s and workflow=%s and table_name=%s and state=%s and pk_hash>%s order by pk_hash limit %d"
+	sqlUpdateVDiffDiff = "update _vt.vdiff_diff set state=%s, fix=%s where db_name=%s and workflow=%s and table_name=%s and pk_hash=%s"
+)
+
+// maxVDiffPKLen is the size of the pk column of _vt.vdiff_diff.
+const maxVDiffPKLen = 65535
+
+// The types of differences.
+const (
+	vdiffExtraSource = "ExtraSource"
+	vdiffExtraTarget = "ExtraTarget"
+	vdiffMismatch    = "Mismatch"
+)
+
+// The states of a differing row. A row is Resolved if
+// it no longer differs when the repair is attempted.
+const (
+	vdiffDiffFound    = "Found"
+	vdiffDiffFixed    = "Fixed"
+	vdiffDiffResolved = "Resolved"
+)
+
+// vdiffRepairBatchSize is the number of rows that are repaired
+// with the target streams stopped. It's also the number of recorded
+// rows that are read at a time.
+var vdiffRepairBatchSize = 100
+
+func validateRepairMode(repair, repairFile string) error {
+	switch repair {
+	case VDiffRepairNone, VDiffRepairRecord, VDiffRepairDryRun, VDiffRepairApply:
+	default:
+		return fmt.Errorf("invalid repair mode %q, must be one of %q, %q or %q", repair, VDiffRepairRecord, VDiffRepairDryRun, VDiffRepairApply)
+	}
+	if repairFile != "" && repair != VDiffRepairDryRun && repair != VDiffRepairApply {
+		return fmt.Errorf("a repair file needs the repair mode %q or %q", VDiffRepairDryRun, VDiffRepairApply)
+	}
+	return nil
+}
+
+func diffType(sourceRow, targetRow []sqltypes.Value) string {
+	switch {
+	case targetRow == nil:
+		return vdiffExtraSource
+	case sourceRow == nil:
+		return vdiffExtraTarget
+	}
+	return vdiffMismatch
+}
+
+// vdiffPKHash returns the key of the row of _vt.vdiff_diff
+// that records the encoded primary key pk.
+func vdiffPKHash(pk string) string {
+	hash := sha256.Sum256([]byte(pk))
+	return hex.EncodeToString(hash[:])
+}
+
+// recordDiff records the primary key of a row that differs.
+func (df *vdiff) recordDiff(ctx context.Context, table string, pkCols []int, sourceRow, targetRow []sqltypes.Value) error {
+	row := sourceRow
+	if row == nil {
+		row = targetRow
+	}
+	pk := make([]sqltypes.Value, 0, len(pkCols))
+	for _, col := range pkCols {
+		pk = append(pk, row[col])
+	}
+	encodedPK := encodeVDiffValues(pk)
+	if len(encodedPK) > maxVDiffPKLen {
+		return fmt.Errorf("cannot record a difference in table %v: the encoded primary key is %d bytes long, more than %d", table, len(encodedPK), maxVDiffPKLen)
+	}
+	query := fmt.Sprintf(sqlRecordVDiffDiff,
+		encodeString(df.stateTarget().master.DbName()),
+		encodeString(df.ts.workflow),
+		encodeString(table),
+		encodeString(vdiffPKHash(encodedPK)),
+		encodeString(encodedPK),
+		encodeString(diffType(sourceRow, targetRow)),
+		encodeString(vdiffDiffFound),
+	)
+	_, err := df.stateQuery(ctx, query)
+	return err
+}
+
+// repairTables repairs the recorded differences of all the tables. They're
+// repaired one at a time, because every batch stops the workflow. If
+// repairFile is set, the repair statements are written to it, one per line.
+func (df *vdiff) repairTables(ctx context.Context, repairFile string, filteredReplicationWaitTime time.Duration) (err error) {
+	if repairFile != "" {
+		f, err := os.Create(repairFile)
+		if err != nil {
+			return vterrors.Wrap(err, "repair file")
+		}
+		defer func() {
+			if closeErr := f.Close(); err == nil && closeErr != nil {
+				err = vterrors.Wrap(closeErr, "repair file")
+			}
+		}()
+		w := bufio.NewWriter(f)
+		defer func() {
+			if flushErr := w.Flush(); err == nil && flushErr != nil {
+				err = vterrors.Wrap(flushErr, "repair file")
+			}
+		}()
+		fmt.Fprintf(w, "-- VDiff %s of %s.%s\n", df.repair, df.ts.targetKeyspace, df.ts.workflow)
+		df.repairOut = w
+	}
+	var tables []string
+	for table := range df.differs {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+	for _, table := range tables {
+		if err := df.repairTable(ctx, df.differs[table], filteredReplicationWaitTime); err != nil {
+			return vterrors.Wrapf(err, "repair %v", table)
+		}
+	}
+	return nil
+}
+
+// repairTable repairs the recorded differences of a table, in batches.
+// A batch is a page of the recorded differences. For every batch, the targets are synchronized with the sources and stopped,
+// the rows are diffed again, and the statements that repair the target are
+// generated, and applied if the mode is VDiffRepairApply. The targets are
+// restarted after every batch. At most repairRate statements are applied
+// per second, if it's set.
+func (df *vdiff) repairTable(ctx context.Context, td *tableDiffer, filteredReplicationWaitTime time.Duration) error {
+	dbName := df.stateTarget().master.DbName()
+	fixed, total := 0, 0
+	// Repairing a row changes its state, but not its pk_hash, so
+	// the pages are the same whether the rows are fixed or not.
+	lastHash := ""
+	for {
+		qr, err := df.stateQuery(ctx, fmt.Sprintf(sqlReadVDiffDiffs, encodeString(dbName), encodeString(df.ts.workflow), encodeString(td.targetTable), encodeString(vdiffDiffFound), encodeString(lastHash), vdiffRepairBatchSize))
+		if err != nil {
+			return err
+		}
+		if len(qr.Rows) == 0 {
+			break
+		}
+		var batch [][]sqltypes.Value
+		for _, row := range qr.Rows {
+			pk, err := decodeVDiffValues(row[0].ToString())
+			if err != nil {
+				return err
+			}
+			batch = append(batch, pk)
+		}
+		lastHash = qr.Rows[len(qr.Rows)-1][1].ToString()
+		n, err := df.repairBatch(ctx, td, batch, filteredReplicationWaitTime)
+		if err != nil {
+			return err
+		}
+		fixed += n
+		total += len(batch)
+		if len(qr.Rows) < vdiffRepairBatchSize {
+			break
+		}
+	}
+	if total != 0 {
+		verb := "Repaired"
+		if df.repair != VDiffRepairApply {
+			verb = "Would repair"
+		}
+		df.ts.wr.Logger().Printf("%s %d of %d rows of %v\n", verb, fixed, total, td.targetTable)
+	}
+	return nil
+}
+
+// repairBatch repairs the rows of one batch, and returns the number of
+// rows that still differed.
+func (df *vdiff) repairBatch(ctx context.Context, td *tableDiffer, batch [][]sqltypes.Value, filteredReplicationWaitTime time.Duration) (int, error) {
+	pk, err := td.parsePKs()
+	if err != nil {
+		return 0, err
+	}
+	pk.sourceSelect.AddWhere(pkInExpr(pk.sourceExprs, batch))
+	pk.targetSelect.AddWhere(pkInExpr(pk.targetExprs, batch))
+	sources := newParticipants(df.sources)
+	targets := newParticipants(df.targets)
+
+	df.syncMu.Lock()
+	defer df.syncMu.Unlock()
+	// This is the same sequence as startStreams, except that the targets
+	// are only restarted once the fixes are applied. This way, the rows
+	// can't change on the target, and the fixes are consistent with the
+	// source snapshot, which vreplication continues from.
+	if err := df.stopTargets(ctx, sources); err != nil {
+		return 0, vterrors.Wrap(err, "stopTargets")
+	}
+	if err := df.startQueryStreams(ctx, df.ts.sourceKeyspace, sources, sqlparser.String(pk.sourceSelect), filteredReplicationWaitTime); err != nil {
+		return 0, vterrors.Wrap(err, "startQueryStreams(sources)")
+	}
+	if err := df.syncTargets(ctx, sources, targets, filteredReplicationWaitTime); err != nil {
+		return 0, vterrors.Wrap(err, "syncTargets")
+	}
+	if err := df.startQueryStreams(ctx, df.ts.targetKeyspace, targets, sqlparser.String(pk.targetSelect), filteredReplicationWaitTime); err != nil {
+		return 0, vterrors.Wrap(err, "startQueryStreams(targets)")
+	}
+
+	dbName := df.stateTarget().master.DbName()
+	found := make(map[string]bool)
+	err = td.diff(ctx, df.ts.wr, withParticipants(td.sourcePrimitive, sources), withParticipants(td.targetPrimitive, targets), pk.cols, &DiffReport{}, nil, func(sourceRow, targetRow []sqltypes.Value) error {
+		row := sourceRow
+		if row == nil {
+			row = targetRow
+		}
+		rowPK := make([]sqltypes.Value, 0, len(pk.cols))
+		for _, col := range pk.cols {
+			rowPK = append(rowPK, row[col])
+		}
+		encodedPK := encodeVDiffValues(rowPK)
+		found[encodedPK] = true
+
+		fix := repairStatement(td, pk, sourceRow, targetRow)
+		if df.repairOut != nil {
+			if _, err := fmt.Fprintf(df.repairOut, "%s;\n", fix); err != nil {
+				return vterrors.Wrap(err, "repair file")
+			}
+		}
+		state := vdiffDiffFound
+		if df.repair == VDiffRepairApply {
+			if err := df.applyFix(ctx, td, pk, sourceRow, targetRow, fix); err != nil {
+				return err
+			}
+			state = vdiffDiffFixed
+		} else {
+			df.ts.wr.Logger().Printf("Would apply to %v: %v\n", td.targetTable, fix)
+		}
+		_, err := df.stateQuery(ctx, fmt.Sprintf(sqlUpdateVDiffDiff, encodeString(state), encodeString(fix), encodeString(dbName), encodeString(df.ts.workflow), encodeString(td.targetTable), encodeString(vdiffPKHash(encodedPK))))
+		return err
+	})
+	if err != nil {
+		return 0, vterrors.Wrap(err, "diff")
+	}
+	for _, rowPK := range batch {
+		encodedPK := encodeVDiffValues(rowPK)
+		if found[encodedPK] {
+			continue
+		}
+		if _, err := df.stateQuery(ctx, fmt.Sprintf(sqlUpdateVDiffDiff, encodeString(vdiffDiffResolved), "''", encodeString(dbName), encodeString(df.ts.workflow), encodeString(td.targetTable), encodeString(vdiffPKHash(encodedPK)))); err != nil {
+			return 0, err
+		}
+	}
+	if err := df.restartTargets(ctx); err != nil {
+		return 0, vterrors.Wrap(err, "restartTargets")
+	}
+	return len(found), nil
+}
+
+// applyFix applies the repair statement of a row to the target masters,
+// with throttling.
+func (df *vdiff) applyFix(ctx context.Context, td *tableDiffer, pk *tablePKs, sourceRow, targetRow []sqltypes.Value, fix string) error {
+	if df.repairRate > 0 {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Second / time.Duration(df.repairRate)):
+		}
+	}
+	targets, err := df.repairTargets(ctx, td, pk, sourceRow, targetRow)
+	if err != nil {
+		return err
+	}
+	for _, target := range targets {
+		if _, err := df.ts.wr.executeFetchAsDba(ctx, target.master.Tablet, fix); err != nil {
+			return vterrors.Wrapf(err, "%v on %v", fix, topoproto.TabletAliasString(target.master.Alias))
+		}
+	}
+	return nil
+}
+
+// repairTargets returns the targets a repair statement must be sent to.
+// Updates and deletes are sent to all targets, because only the target
+// that has the row will be affected. Inserts are sent to the target that
+// owns the keyspace id of the row.
+func (df *vdiff) repairTargets(ctx context.Context, td *tableDiffer, pk *tablePKs, sourceRow, targetRow []sqltypes.Value) ([]*tsTarget, error) {
+	var targets []*tsTarget
+	for _, target := range df.ts.targets {
+		targets = append(targets, target)
+	}
+	if targetRow != nil || len(targets) == 1 {
+		return targets, nil
+	}
+
+	if df.targetVSchema == nil {
+		vschema, err := df.ts.wr.ts.GetVSchema(ctx, df.ts.targetKeyspace)
+		if err != nil {
+			return nil, err
+		}
+		if df.targetVSchema, err = vindexes.BuildKeyspaceSchema(vschema, df.ts.targetKeyspace); err != nil {
+			return nil, err
+		}
+	}
+	table := df.targetVSchema.Tables[td.targetTable]
+	if table == nil || len(table.ColumnVindexes) == 0 {
+		return nil, fmt.Errorf("table %v has no primary vindex in keyspace %v", td.targetTable, df.ts.targetKeyspace)
+	}
+	cv := table.ColumnVindexes[0]
+	if cv.Vindex.NeedsVCursor() {
+		return nil, fmt.Errorf("cannot route rows of table %v: vindex %v needs a vcursor", td.targetTable, cv.Name)
+	}
+	columns := targetColumns(pk)
+	var values []sqltypes.Value
+	for _, vindexColumn := range cv.Columns {
+		found := false
+		for i, column := range columns {
+			if column.Equal(vindexColumn) {
+				values = append(values, sourceRow[i])
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("cannot route rows of table %v: vindex column %v is not compared", td.targetTable, vindexColumn)
+		}
+	}
+	destinations, err := vindexes.Map(cv.Vindex, nil, [][]sqltypes.Value{values})
+	if err != nil {
+		return nil, err
+	}
+	ksid, ok := destinations[0].(key.DestinationKeyspaceID)
+	if !ok {
+		return nil, fmt.Errorf("cannot route rows of table %v: vindex %v returned %v", td.targetTable, cv.Name, destinations[0])
+	}
+	for _, target := range targets {
+		if key.KeyRangeContains(target.si.KeyRange, ksid) {
+			return []*tsTarget{target}, nil
+		}
+	}
+	return nil, fmt.Errorf("no target shard for keyspace id %v", ksid)
+}
+
+// targetColumns returns the columns of the target table that are
+// compared. The weight_string expressions that follow them are skipped.
+func targetColumns(pk *tablePKs) []sqlparser.ColIdent {
+	var columns []sqlparser.ColIdent
+	for _, selExpr := range pk.targetSelect.SelectExprs {
+		colName, ok := selExpr.(*sqlparser.AliasedExpr).Expr.(*sqlparser.ColName)
+		if !ok {
+			break
+		}
+		columns = append(columns, colName.Name)
+	}
+	return columns
+}
+
+// repairStatement returns the statement that makes the target row
+// identical to the source row.
+func repairStatement(td *tableDiffer, pk *tablePKs, sourceRow, targetRow []sqltypes.Value) string {
+	columns := targetColumns(pk)
+	table := sqlparser.NewTableIdent(td.targetTable)
+	buf := sqlparser.NewTrackedBuffer(nil)
+	switch {
+	case targetRow == nil:
+		buf.Myprintf("insert into %v(", table)
+		for i, column := range columns {
+			if i != 0 {
+				buf.Myprintf(", ")
+			}
+			buf.Myprintf("%v", column)
+		}
+		buf.Myprintf(") values (")
+		for i := range columns {
+			if i != 0 {
+				buf.Myprintf(", ")
+			}
+			sourceRow[i].EncodeSQL(buf)
+		}
+		buf.Myprintf(")")
+		return buf.String()
+	case sourceRow == nil:
+		buf.Myprintf("delete from %v", table)
+	default:
+		buf.Myprintf("update %v set ", table)
+		prefix := ""
+		for i, column := range columns {
+			if isPKCol(pk, i) {
+				continue
+			}
+			buf.Myprintf("%s%v = ", prefix, column)
+			sourceRow[i].EncodeSQL(buf)
+			prefix = ", "
+		}
+	}
+	row := sourceRow
+	if row == nil {
+		row = targetRow
+	}
+	buf.Myprintf(" where ")
+	for i, col := range pk.cols {
+		if i != 0 {
+			buf.Myprintf(" and ")
+		}
+		buf.Myprintf("%v = ", columns[col])
+		row[col].EncodeSQL(buf)
+	}
+	return buf.String()
+}
+
+func isPKCol(pk *tablePKs, col int) bool {
+	for _, pkCol := range pk.cols {
+		if pkCol == col {
+			return true
+		}
+	}
+	return false
+}
+
+// pkInExpr returns the condition for rows that have one of the pks.
+func pkInExpr(exprs []sqlparser.Expr, pks [][]sqltypes.Value) sqlparser.Expr {
+	if len(exprs) == 1 {
+		var tuple sqlparser.ValTuple
+		for _, pk := range pks {
+			tuple = append(tuple, valueExpr(pk[0]))
+		}
+		return &sqlparser.ComparisonExpr{
+			Operator: sqlparser.InStr,
+			Left:     exprs[0],
+			Right:    tuple,
+		}
+	}
+	var result sqlparser.Expr
+	for _, pk := range pks {
+		var cond sqlparser.Expr
+		for i, expr := range exprs {
+			eq := &sqlparser.ComparisonExpr{
+				Operator: sqlparser.EqualStr,
+				Left:     expr,
+				Right:    valueExpr(pk[i]),
+			}
+			if cond == nil {
+				cond = eq
+				continue
+			}
+			cond = &sqlparser.AndExpr{Left: cond, Right: eq}
+		}
+		if result == nil {
+			result = cond
+			continue
+		}
+		result = &sqlparser.OrExpr{Left: result, Right: cond}
+	}
+	return result
+}
@@ -0,0 +1,485 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// The progress of a vdiff is recorded in the _vt.vdiff table of the
+// master of the first target shard. There is one row per chunk, which
+// is a primary key range of a table. A chunk without bounds covers
+// the whole table. lastpk is the primary key of the last row that was
+// compared, encoded like the lastpk of _vt.copy_state.
+const (
+	sqlCreateVDiffTable = `create table if not exists _vt.vdiff (
+  db_name varbinary(255) not null,
+  workflow varbinary(1000) not null,
+  table_name varbinary(128) not null,
+  chunk int not null,
+  state varbinary(20) not null,
+  lower_bound varbinary(2000) not null default '',
+  upper_bound varbinary(2000) not null default '',
+  lastpk varbinary(2000) not null default '',
+  rows_estimate bigint not null default 0,
+  processed_rows bigint not null default 0,
+  matching_rows bigint not null default 0,
+  mismatched_rows bigint not null default 0,
+  extra_rows_source bigint not null default 0,
+  extra_rows_target bigint not null default 0,
+  time_started bigint not null default 0,
+  time_updated bigint not null default 0,
+  primary key (db_name, workflow, table_name, chunk)
+) engine=InnoDB`
+	sqlReadVDiffState = `select table_name, chunk, state, lower_bound, upper_bound, lastpk, rows_estimate,
+  processed_rows, matching_rows, mismatched_rows, extra_rows_source, extra_rows_target, time_started, time_updated
+  from _vt.vdiff where db_name=%s and workflow=%s order by table_name, chunk`
+	sqlDeleteVDiffState = "delete from _vt.vdiff where db_name=%s and workflow=%s"
+	sqlSaveVDiffChunk   = `insert into _vt.vdiff(db_name, workflow, table_name, chunk, state, lower_bound, upper_bound, lastpk, rows_estimate,
+  processed_rows, matching_rows, mismatched_rows, extra_rows_source, extra_rows_target, time_started, time_updated)
+  values (%s, %s, %s, %d, %s, %s, %s, %s, %d, %d, %d, %d, %d, %d, %d, %d)
+  on duplicate key update state=values(state), lastpk=values(lastpk), processed_rows=values(processed_rows),
+  matching_rows=values(matching_rows), mismatched_rows=values(mismatched_rows), extra_rows_source=values(extra_rows_source),
+  extra_rows_target=values(extra_rows_target), time_started=values(time_started), time_updated=values(time_updated)`
+	sqlReadTableRows = "select table_rows from information_schema.tables where table_schema=%s and table_name=%s"
+)
+
+// The states of a vdiff chunk.
+const (
+	vdiffStatePending   = "Pending"
+	vdiffStateRunning   = "Running"
+	vdiffStateCompleted = "Completed"
+)
+
+// vdiffCheckpointRows is the number of rows compared between two
+// saves of the progress of a chunk. It can be changed for tests.
+var vdiffCheckpointRows = 10000
+
+// vdiffChunk is a primary key range of a table that is diffed in one pass.
+type vdiffChunk struct {
+	table string
+	chunk int
+	state string
+	// lowerBound and upperBound are values of the first primary key
+	// column. The range includes lowerBound and excludes upperBound.
+	// A NULL bound is unbounded.
+	lowerBound sqltypes.Value
+	upperBound sqltypes.Value
+	// lastPK is set once rows were compared, and the diff
+	// resumes after it.
+	lastPK       []sqltypes.Value
+	rowsEstimate int64
+	report       DiffReport
+	timeStarted  int64
+	timeUpdated  int64
+}
+
+// VDiffProgress is the progress of the vdiff of one table.
+type VDiffProgress struct {
+	Table           string
+	State           string
+	Chunks          int
+	CompletedChunks int
+	// RowsEstimate is based on the table statistics of the target.
+	RowsEstimate int64
+	DiffReport
+	Started time.Time
+	Updated time.Time
+	// ETA is the estimated time left. It is zero if it
+	// cannot be estimated yet, or if the diff is done.
+	ETA time.Duration
+}
+
+// VDiffShow returns the progress of the last vdiff of a workflow, as
+// recorded on the target.
+func (wr *Wrangler) VDiffShow(ctx context.Context, targetKeyspace, workflow string) ([]*VDiffProgress, error) {
+	ts, err := wr.buildTrafficSwitcher(ctx, targetKeyspace, workflow)
+	if err != nil {
+		return nil, err
+	}
+	df := &vdiff{ts: ts}
+	saved, err := df.readState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var tables []string
+	for table := range saved {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+
+	var progress []*VDiffProgress
+	for _, table := range tables {
+		p := &VDiffProgress{
+			Table:  table,
+			State:  vdiffStatePending,
+			Chunks: len(saved[table]),
+		}
+		var started, updated int64
+		for _, chunk := range saved[table] {
+			if chunk.state == vdiffStateCompleted {
+				p.CompletedChunks++
+			}
+			if chunk.state != vdiffStatePending {
+				p.State = vdiffStateRunning
+			}
+			p.RowsEstimate += chunk.rowsEstimate
+			p.DiffReport.add(&chunk.report)
+			if chunk.timeStarted != 0 && (started == 0 || chunk.timeStarted < started) {
+				started = chunk.timeStarted
+			}
+			if chunk.timeUpdated > updated {
+				updated = chunk.timeUpdated
+			}
+		}
+		if p.CompletedChunks == p.Chunks {
+			p.State = vdiffStateCompleted
+		}
+		if started != 0 {
+			p.Started = time.Unix(started, 0)
+			p.Updated = time.Unix(updated, 0)
+		}
+		if p.State == vdiffStateRunning && p.ProcessedRows > 0 && int64(p.ProcessedRows) < p.RowsEstimate {
+			elapsed := p.Updated.Sub(p.Started)
+			p.ETA = time.Duration(float64(elapsed) * float64(p.RowsEstimate-int64(p.ProcessedRows)) / float64(p.ProcessedRows))
+		}
+		progress = append(progress, p)
+	}
+	return progress, nil
+}
+
+func (dr *DiffReport) add(other *DiffReport) {
+	dr.ProcessedRows += other.ProcessedRows
+	dr.MatchingRows += other.MatchingRows
+	dr.MismatchedRows += other.MismatchedRows
+	dr.ExtraRowsSource += other.ExtraRowsSource
+	dr.ExtraRowsTarget += other.ExtraRowsTarget
+}
+
+// stateTarget returns the target that keeps the progress of the vdiff.
+func (df *vdiff) stateTarget() *tsTarget {
+	var shards []string
+	for shard := range df.ts.targets {
+		shards = append(shards, shard)
+	}
+	sort.Strings(shards)
+	return df.ts.targets[shards[0]]
+}
+
+func (df *vdiff) stateQuery(ctx context.Context, query string) (*sqltypes.Result, error) {
+	return df.ts.wr.executeFetchAsDba(ctx, df.stateTarget().master.Tablet, query)
+}
+
+// initState creates the state tables if needed. If resume is set, it
+// returns the chunks of the previous vdiff of the workflow. Otherwise,
+// the previous state, and the differences it recorded, are deleted.
+func (df *vdiff) initState(ctx context.Context, resume bool) (map[string][]*vdiffChunk, error) {
+	if _, err := df.stateQuery(ctx, sqlCreateVDiffTable); err != nil {
+		return nil, err
+	}
+	if df.repair != VDiffRepairNone {
+		if _, err := df.stateQuery(ctx, sqlCreateVDiffDiffTable); err != nil {
+			return nil, err
+		}
+	}
+	if resume {
+		return df.readState(ctx)
+	}
+	dbName := df.stateTarget().master.DbName()
+	if _, err := df.stateQuery(ctx, fmt.Sprintf(sqlDeleteVDiffState, encodeString(dbName), encodeString(df.ts.workflow))); err != nil {
+		return nil, err
+	}
+	if df.repair != VDiffRepairNone {
+		if _, err := df.stateQuery(ctx, fmt.Sprintf(sqlDeleteVDiffDiffs, encodeString(dbName), encodeString(df.ts.workflow))); err != nil {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+func (df *vdiff) readState(ctx context.Context) (map[string][]*vdiffChunk, error) {
+	dbName := df.stateTarget().master.DbName()
+	qr, err := df.stateQuery(ctx, fmt.Sprintf(sqlReadVDiffState, encodeString(dbName), encodeString(df.ts.workflow)))
+	if err != nil {
+		return nil, err
+	}
+	saved := make(map[string][]*vdiffChunk)
+	for _, row := range qr.Rows {
+		chunk := &vdiffChunk{
+			table: row[0].ToString(),
+			state: row[2].ToString(),
+		}
+		var ints [8]int64
+		for i := range ints {
+			if ints[i], err = evalengine.ToInt64(row[i+6]); err != nil {
+				return nil, err
+			}
+		}
+		n, err := evalengine.ToInt64(row[1])
+		if err != nil {
+			return nil, err
+		}
+		chunk.chunk = int(n)
+		chunk.rowsEstimate = ints[0]
+		chunk.report = DiffReport{
+			ProcessedRows:   int(ints[1]),
+			MatchingRows:    int(ints[2]),
+			MismatchedRows:  int(ints[3]),
+			ExtraRowsSource: int(ints[4]),
+			ExtraRowsTarget: int(ints[5]),
+		}
+		chunk.timeStarted = ints[6]
+		chunk.timeUpdated = ints[7]
+
+		if chunk.lowerBound, err = decodeVDiffValue(row[3].ToString()); err != nil {
+			return nil, err
+		}
+		if chunk.upperBound, err = decodeVDiffValue(row[4].ToString()); err != nil {
+			return nil, err
+		}
+		if chunk.lastPK, err = decodeVDiffValues(row[5].ToString()); err != nil {
+			return nil, err
+		}
+		saved[chunk.table] = append(saved[chunk.table], chunk)
+	}
+	return saved, nil
+}
+
+// saveChunk records the state and progress of a chunk.
+func (df *vdiff) saveChunk(ctx context.Context, chunk *vdiffChunk) error {
+	var bounds []string
+	for _, bound := range []sqltypes.Value{chunk.lowerBound, chunk.upperBound} {
+		encoded := ""
+		if !bound.IsNull() {
+			encoded = encodeVDiffValues([]sqltypes.Value{bound})
+		}
+		bounds = append(bounds, encoded)
+	}
+	query := fmt.Sprintf(sqlSaveVDiffChunk,
+		encodeString(df.stateTarget().master.DbName()),
+		encodeString(df.ts.workflow),
+		encodeString(chunk.table),
+		chunk.chunk,
+		encodeString(chunk.state),
+		encodeString(bounds[0]),
+		encodeString(bounds[1]),
+		encodeString(encodeVDiffValues(chunk.lastPK)),
+		chunk.rowsEstimate,
+		chunk.report.ProcessedRows,
+		chunk.report.MatchingRows,
+		chunk.report.MismatchedRows,
+		chunk.report.ExtraRowsSource,
+		chunk.report.ExtraRowsTarget,
+		chunk.timeStarted,
+		chunk.timeUpdated,
+	)
+	_, err := df.stateQuery(ctx, query)
+	return err
+}
+
+// planChunks returns the chunks of all the tables of the vdiff. The chunks
+// of the tables found in saved are reused. The other tables are split into
+// the requested number of chunks, which is only possible for tables that
+// have a single integral primary key column.
+func (df *vdiff) planChunks(ctx context.Context, numChunks int, saved map[string][]*vdiffChunk) ([]*vdiffChunk, error) {
+	var tables []string
+	for table := range df.differs {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+
+	var chunks []*vdiffChunk
+	for _, table := range tables {
+		if tableChunks, ok := saved[table]; ok {
+			chunks = append(chunks, tableChunks...)
+			continue
+		}
+		td := df.differs[table]
+		bounds, err := df.chunkBounds(ctx, td, numChunks)
+		if err != nil {
+			return nil, err
+		}
+		rowsEstimate, err := df.rowsEstimate(ctx, table)
+		if err != nil {
+			return nil, err
+		}
+		lowerBound := sqltypes.NULL
+		for i := 0; i <= len(bounds); i++ {
+			upperBound := sqltypes.NULL
+			if i < len(bounds) {
+				upperBound = bounds[i]
+			}
+			chunk := &vdiffChunk{
+				table:        table,
+				chunk:        i,
+				state:        vdiffStatePending,
+				lowerBound:   lowerBound,
+				upperBound:   upperBound,
+				rowsEstimate: rowsEstimate / int64(len(bounds)+1),
+			}
+			if err := df.saveChunk(ctx, chunk); err != nil {
+				return nil, err
+			}
+			chunks = append(chunks, chunk)
+			lowerBound = upperBound
+		}
+	}
+	return chunks, nil
+}
+
+// chunkBounds returns the values that split the table into numChunks ranges
+// of the first primary key column. It returns no bounds if the table can't
+// be split.
+func (df *vdiff) chunkBounds(ctx context.Context, td *tableDiffer, numChunks int) ([]sqltypes.Value, error) {
+	if numChunks <= 1 {
+		return nil, nil
+	}
+	pk, err := td.parsePKs()
+	if err != nil {
+		return nil, err
+	}
+	if len(pk.targetExprs) != 1 {
+		return nil, nil
+	}
+	query := sqlparser.String(&sqlparser.Select{
+		SelectExprs: sqlparser.SelectExprs{
+			&sqlparser.AliasedExpr{Expr: &sqlparser.FuncExpr{Name: sqlparser.NewColIdent("min"), Exprs: sqlparser.SelectExprs{&sqlparser.AliasedExpr{Expr: pk.targetExprs[0]}}}},
+			&sqlparser.AliasedExpr{Expr: &sqlparser.FuncExpr{Name: sqlparser.NewColIdent("max"), Exprs: sqlparser.SelectExprs{&sqlparser.AliasedExpr{Expr: pk.targetExprs[0]}}}},
+		},
+		From: sqlparser.TableExprs{&sqlparser.AliasedTableExpr{Expr: &sqlparser.TableName{Name: sqlparser.NewTableIdent(td.targetTable)}}},
+	})
+	var min, max int64
+	found := false
+	for _, target := range df.ts.targets {
+		qr, err := df.ts.wr.executeFetchAsDba(ctx, target.master.Tablet, query)
+		if err != nil {
+			return nil, err
+		}
+		if len(qr.Rows) != 1 || qr.Rows[0][0].IsNull() {
+			continue
+		}
+		if !qr.Rows[0][0].IsIntegral() || !qr.Rows[0][1].IsIntegral() {
+			return nil, nil
+		}
+		shardMin, err := evalengine.ToInt64(qr.Rows[0][0])
+		if err != nil {
+			return nil, nil
+		}
+		shardMax, err := evalengine.ToInt64(qr.Rows[0][1])
+		if err != nil {
+			return nil, nil
+		}
+		if !found || shardMin < min {
+			min = shardMin
+		}
+		if !found || shardMax > max {
+			max = shardMax
+		}
+		found = true
+	}
+	if !found {
+		return nil, nil
+	}
+	// The subtraction can't overflow as an unsigned number.
+	span := uint64(max) - uint64(min)
+	var bounds []sqltypes.Value
+	for i := 1; i < numChunks; i++ {
+		bound := min + int64(span/uint64(numChunks)*uint64(i))
+		if bound == min {
+			// The range is smaller than the number of chunks.
+			continue
+		}
+		bounds = append(bounds, sqltypes.NewInt64(bound))
+	}
+	return bounds, nil
+}
+
+// rowsEstimate returns the sum of the row estimates of the table
+// on all target masters.
+func (df *vdiff) rowsEstimate(ctx context.Context, table string) (int64, error) {
+	var total int64
+	for _, target := range df.ts.targets {
+		query := fmt.Sprintf(sqlReadTableRows, encodeString(target.master.DbName()), encodeString(table))
+		qr, err := df.ts.wr.executeFetchAsDba(ctx, target.master.Tablet, query)
+		if err != nil {
+			return 0, err
+		}
+		if len(qr.Rows) == 0 || qr.Rows[0][0].IsNull() {
+			continue
+		}
+		rows, err := evalengine.ToInt64(qr.Rows[0][0])
+		if err != nil {
+			return 0, err
+		}
+		total += rows
+	}
+	return total, nil
+}
+
+func encodeVDiffValues(values []sqltypes.Value) string {
+	if len(values) == 0 {
+		return ""
+	}
+	fields := make([]*querypb.Field, len(values))
+	for i, value := range values {
+		fields[i] = &querypb.Field{Type: value.Type()}
+	}
+	var buf strings.Builder
+	if err := proto.CompactText(&buf, &querypb.QueryResult{
+		Fields: fields,
+		Rows:   []*querypb.Row{sqltypes.RowToProto3(values)},
+	}); err != nil {
+		// Unreachable: writes to a strings.Builder can't fail.
+		panic(err)
+	}
+	return buf.String()
+}
+
+func decodeVDiffValues(encoded string) ([]sqltypes.Value, error) {
+	if encoded == "" {
+		return nil, nil
+	}
+	var qr querypb.QueryResult
+	if err := proto.UnmarshalText(encoded, &qr); err != nil {
+		return nil, err
+	}
+	result := sqltypes.Proto3ToResult(&qr)
+	if len(result.Rows) != 1 {
+		return nil, fmt.Errorf("unexpected number of rows in %v", encoded)
+	}
+	return result.Rows[0], nil
+}
+
+func decodeVDiffValue(encoded string) (sqltypes.Value, error) {
+	values, err := decodeVDiffValues(encoded)
+	if err != nil || len(values) == 0 {
+		return sqltypes.NULL, err
+	}
+	return values[0], nil
+}
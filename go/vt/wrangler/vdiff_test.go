@@ -17,6 +17,11 @@ limitations under the License.
 package wrangler
 
 import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
 	"testing"
 	"time"
 
@@ -559,7 +564,7 @@ func TestVDiffUnsharded(t *testing.T) {
 		env.tablets[101].setResults("select c1, c2 from t1 order by c1 asc", vdiffSourceGtid, tcase.source)
 		env.tablets[201].setResults("select c1, c2 from t1 order by c1 asc", vdiffTargetMasterPosition, tcase.target)
 
-		dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "")
+		dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "", 1, 1, false, "", 0, "")
 		require.NoError(t, err)
 		assert.Equal(t, tcase.dr, dr["t1"], tcase.id)
 	}
@@ -621,7 +626,7 @@ func TestVDiffSharded(t *testing.T) {
 		),
 	)
 
-	dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "")
+	dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "", 1, 1, false, "", 0, "")
 	require.NoError(t, err)
 	wantdr := &DiffReport{
 		ProcessedRows: 3,
@@ -687,7 +692,7 @@ func TestVDiffAggregates(t *testing.T) {
 		),
 	)
 
-	dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "")
+	dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "", 1, 1, false, "", 0, "")
 	require.NoError(t, err)
 	wantdr := &DiffReport{
 		ProcessedRows: 5,
@@ -751,7 +756,7 @@ func TestVDiffPKWeightString(t *testing.T) {
 		),
 	)
 
-	dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "")
+	dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "", 1, 1, false, "", 0, "")
 	require.NoError(t, err)
 	wantdr := &DiffReport{
 		ProcessedRows: 4,
@@ -815,7 +820,7 @@ func TestVDiffNoPKWeightString(t *testing.T) {
 		),
 	)
 
-	dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "")
+	dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "", 1, 1, false, "", 0, "")
 	require.NoError(t, err)
 	wantdr := &DiffReport{
 		ProcessedRows: 4,
@@ -853,11 +858,11 @@ func TestVDiffDefaults(t *testing.T) {
 	env.tablets[101].setResults("select c1, c2 from t1 order by c1 asc", vdiffSourceGtid, source)
 	env.tablets[201].setResults("select c1, c2 from t1 order by c1 asc", vdiffTargetMasterPosition, target)
 
-	_, err := env.wr.VDiff(context.Background(), "target", env.workflow, "", "", "replica", 30*time.Second, "")
+	_, err := env.wr.VDiff(context.Background(), "target", env.workflow, "", "", "replica", 30*time.Second, "", 1, 1, false, "", 0, "")
 	require.NoError(t, err)
-	_, err = env.wr.VDiff(context.Background(), "target", env.workflow, "", env.cell, "replica", 30*time.Second, "")
+	_, err = env.wr.VDiff(context.Background(), "target", env.workflow, "", env.cell, "replica", 30*time.Second, "", 1, 1, false, "", 0, "")
 	require.NoError(t, err)
-	_, err = env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, "", "replica", 30*time.Second, "")
+	_, err = env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, "", "replica", 30*time.Second, "", 1, 1, false, "", 0, "")
 	require.NoError(t, err)
 }
 
@@ -890,7 +895,7 @@ func TestVDiffReplicationWait(t *testing.T) {
 	env.tablets[101].setResults("select c1, c2 from t1 order by c1 asc", vdiffSourceGtid, source)
 	env.tablets[201].setResults("select c1, c2 from t1 order by c1 asc", vdiffTargetMasterPosition, target)
 
-	_, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 0*time.Second, "")
+	_, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 0*time.Second, "", 1, 1, false, "", 0, "")
 	require.EqualError(t, err, "startQueryStreams(sources): WaitForPosition for tablet cell-0000000101: context deadline exceeded")
 }
 
@@ -962,3 +967,384 @@ func TestVDiffFindPKs(t *testing.T) {
 	}
 
 }
+
+func TestVDiffChunks(t *testing.T) {
+	env := newTestVDiffEnv([]string{"0"}, []string{"0"}, "", nil)
+	defer env.close()
+
+	schm := &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:              "t1",
+			Columns:           []string{"c1", "c2"},
+			PrimaryKeyColumns: []string{"c1"},
+			Fields:            sqltypes.MakeTestFields("c1|c2", "int64|int64"),
+		}},
+	}
+	env.tmc.schema = schm
+
+	fields := sqltypes.MakeTestFields(
+		"c1|c2",
+		"int64|int64",
+	)
+	master := env.tablets[200].tablet
+	env.tmc.setDBAResults(master, "select min(c1), max(c1) from t1", sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+		"min(c1)|max(c1)",
+		"int64|int64"),
+		"1|9",
+	))
+	env.tmc.setDBAResults(master, "select table_rows from information_schema.tables where table_schema='vt_target' and table_name='t1'", sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+		"table_rows",
+		"int64"),
+		"6",
+	))
+
+	// The range 1-9 is split at 3 and 5.
+	chunks := []struct {
+		where  string
+		source []string
+		target []string
+	}{{
+		where:  "where c1 < 3",
+		source: []string{"1|3", "2|4"},
+		target: []string{"1|3", "2|4"},
+	}, {
+		where:  "where c1 >= 3 and c1 < 5",
+		source: []string{"3|1"},
+		target: []string{"3|2", "4|4"},
+	}, {
+		where:  "where c1 >= 5",
+		source: []string{"9|1"},
+		target: []string{"9|1"},
+	}}
+	for _, chunk := range chunks {
+		query := "select c1, c2 from t1 " + chunk.where + " order by c1 asc"
+		env.tablets[101].setResults(query, vdiffSourceGtid, sqltypes.MakeTestStreamingResults(fields, chunk.source...))
+		env.tablets[201].setResults(query, vdiffTargetMasterPosition, sqltypes.MakeTestStreamingResults(fields, chunk.target...))
+	}
+
+	dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "", 2, 3, false, "", 0, "")
+	require.NoError(t, err)
+	wantdr := &DiffReport{
+		ProcessedRows:   5,
+		MatchingRows:    3,
+		MismatchedRows:  1,
+		ExtraRowsTarget: 1,
+	}
+	assert.Equal(t, wantdr, dr["t1"])
+
+	// The previous state was deleted, and every chunk was recorded
+	// as completed, with a share of the estimated rows.
+	env.tmc.mu.Lock()
+	defer env.tmc.mu.Unlock()
+	assert.Contains(t, env.tmc.dbaLog, "200: delete from _vt.vdiff where db_name='vt_target' and workflow='vdiffTest'")
+	completed := 0
+	for _, query := range env.tmc.dbaLog {
+		if strings.Contains(query, "'Completed'") {
+			assert.Contains(t, query, ", 2, ")
+			completed++
+		}
+	}
+	assert.Equal(t, 3, completed)
+}
+
+func TestVDiffResume(t *testing.T) {
+	env := newTestVDiffEnv([]string{"0"}, []string{"0"}, "", nil)
+	defer env.close()
+
+	schm := &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:              "t1",
+			Columns:           []string{"c1", "c2"},
+			PrimaryKeyColumns: []string{"c1"},
+			Fields:            sqltypes.MakeTestFields("c1|c2", "int64|int64"),
+		}, {
+			Name:              "t2",
+			Columns:           []string{"c1", "c2"},
+			PrimaryKeyColumns: []string{"c1", "c2"},
+			Fields:            sqltypes.MakeTestFields("c1|c2", "int64|int64"),
+		}},
+	}
+	env.tmc.schema = schm
+	env.tmc.setVRResults(
+		env.tablets[200].tablet,
+		"select id, source, message, cell, tablet_types from _vt.vreplication where workflow='vdiffTest' and db_name='vt_target'",
+		sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+			"id|source|message|cell|tablet_types",
+			"int64|varchar|varchar|varchar|varchar"),
+			`1|keyspace:"source" shard:"0" filter:<rules:<match:"t1" > rules:<match:"t2" > > |||`,
+		),
+	)
+
+	// The first chunk of t1 is completed, and the second one
+	// was interrupted after c1=3. t2 was interrupted after (1, 2).
+	bound := encodeVDiffValues([]sqltypes.Value{sqltypes.NewInt64(3)})
+	env.tmc.setDBAResults(env.tablets[200].tablet, fmt.Sprintf(sqlReadVDiffState, "'vt_target'", "'vdiffTest'"), sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+		"table_name|chunk|state|lower_bound|upper_bound|lastpk|rows_estimate|processed_rows|matching_rows|mismatched_rows|extra_rows_source|extra_rows_target|time_started|time_updated",
+		"varbinary|int64|varbinary|varbinary|varbinary|varbinary|int64|int64|int64|int64|int64|int64|int64|int64"),
+		"t1|0|Completed||"+bound+"||2|2|2|0|0|0|100|110",
+		"t1|1|Running|"+bound+"||"+encodeVDiffValues([]sqltypes.Value{sqltypes.NewInt64(3)})+"|2|1|0|1|0|0|100|110",
+		"t2|0|Running|||"+encodeVDiffValues([]sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(2)})+"|4|1|1|0|0|0|100|110",
+	))
+
+	fields := sqltypes.MakeTestFields(
+		"c1|c2",
+		"int64|int64",
+	)
+	t1query := "select c1, c2 from t1 where c1 >= 3 and c1 > 3 order by c1 asc"
+	env.tablets[101].setResults(t1query, vdiffSourceGtid, sqltypes.MakeTestStreamingResults(fields, "4|1", "5|1"))
+	env.tablets[201].setResults(t1query, vdiffTargetMasterPosition, sqltypes.MakeTestStreamingResults(fields, "4|1", "5|1"))
+	t2query := "select c1, c2 from t2 where c1 = 1 and c2 > 2 or c1 > 1 order by c1 asc, c2 asc"
+	env.tablets[101].setResults(t2query, vdiffSourceGtid, sqltypes.MakeTestStreamingResults(fields, "1|3", "2|1"))
+	env.tablets[201].setResults(t2query, vdiffTargetMasterPosition, sqltypes.MakeTestStreamingResults(fields, "1|3", "2|1", "3|1"))
+
+	saved := vdiffCheckpointRows
+	defer func() { vdiffCheckpointRows = saved }()
+	vdiffCheckpointRows = 1
+
+	dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "", 1, 1, true, "", 0, "")
+	require.NoError(t, err)
+	assert.Equal(t, &DiffReport{ProcessedRows: 5, MatchingRows: 4, MismatchedRows: 1}, dr["t1"])
+	assert.Equal(t, &DiffReport{ProcessedRows: 4, MatchingRows: 3, ExtraRowsTarget: 1}, dr["t2"])
+
+	// The progress of t1 was checkpointed after c1=4.
+	env.tmc.mu.Lock()
+	defer env.tmc.mu.Unlock()
+	checkpoint := encodeString(encodeVDiffValues([]sqltypes.Value{sqltypes.NewInt64(4)}))
+	found := false
+	for _, query := range env.tmc.dbaLog {
+		if strings.Contains(query, "'t1', 1, 'Running'") && strings.Contains(query, checkpoint) {
+			found = true
+		}
+		assert.NotContains(t, query, "delete from _vt.vdiff")
+	}
+	assert.True(t, found, "checkpoint %s not found in %v", checkpoint, env.tmc.dbaLog)
+}
+
+func TestVDiffShow(t *testing.T) {
+	env := newTestVDiffEnv([]string{"0"}, []string{"-80", "80-"}, "", nil)
+	defer env.close()
+
+	// The state is kept on the master of the first target shard.
+	env.tmc.setDBAResults(env.tablets[200].tablet, fmt.Sprintf(sqlReadVDiffState, "'vt_target'", "'vdiffTest'"), sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+		"table_name|chunk|state|lower_bound|upper_bound|lastpk|rows_estimate|processed_rows|matching_rows|mismatched_rows|extra_rows_source|extra_rows_target|time_started|time_updated",
+		"varbinary|int64|varbinary|varbinary|varbinary|varbinary|int64|int64|int64|int64|int64|int64|int64|int64"),
+		"t1|0|Completed|||"+encodeVDiffValues([]sqltypes.Value{sqltypes.NewInt64(9)})+"|500|500|499|1|0|0|100|200",
+		"t1|1|Running|||"+encodeVDiffValues([]sqltypes.Value{sqltypes.NewInt64(12)})+"|500|100|100|0|0|0|150|300",
+		"t2|0|Pending||||1000|0|0|0|0|0|0|0",
+	))
+
+	progress, err := env.wr.VDiffShow(context.Background(), "target", env.workflow)
+	require.NoError(t, err)
+	want := []*VDiffProgress{{
+		Table:           "t1",
+		State:           vdiffStateRunning,
+		Chunks:          2,
+		CompletedChunks: 1,
+		RowsEstimate:    1000,
+		DiffReport: DiffReport{
+			ProcessedRows:  600,
+			MatchingRows:   599,
+			MismatchedRows: 1,
+		},
+		Started: time.Unix(100, 0),
+		Updated: time.Unix(300, 0),
+		// 600 rows took 200s.
+		ETA: 400 * time.Second / 3,
+	}, {
+		Table:        "t2",
+		State:        vdiffStatePending,
+		Chunks:       1,
+		RowsEstimate: 1000,
+	}}
+	assert.Equal(t, want, progress)
+}
+
+// vdiffDiffsResult returns the rows of _vt.vdiff_diff that
+// record the integer primary keys pks.
+func vdiffDiffsResult(pks ...int64) *sqltypes.Result {
+	var rows []string
+	for _, pk := range pks {
+		encodedPK := encodeVDiffValues([]sqltypes.Value{sqltypes.NewInt64(pk)})
+		rows = append(rows, encodedPK+"|"+vdiffPKHash(encodedPK))
+	}
+	return sqltypes.MakeTestResult(sqltypes.MakeTestFields("pk|pk_hash", "varbinary|varbinary"), rows...)
+}
+
+func TestVDiffRepair(t *testing.T) {
+	env := newTestVDiffEnv([]string{"0"}, []string{"0"}, "", nil)
+	defer env.close()
+
+	schm := &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:              "t1",
+			Columns:           []string{"c1", "c2"},
+			PrimaryKeyColumns: []string{"c1"},
+			Fields:            sqltypes.MakeTestFields("c1|c2", "int64|int64"),
+		}},
+	}
+	env.tmc.schema = schm
+
+	fields := sqltypes.MakeTestFields(
+		"c1|c2",
+		"int64|int64",
+	)
+	source := []string{"1|3", "2|3", "4|1"}
+	target := []string{"1|3", "2|4", "3|1"}
+	query := "select c1, c2 from t1 order by c1 asc"
+	repairQuery := "select c1, c2 from t1 where c1 in (2, 3, 4) order by c1 asc"
+	env.tmc.setDBAResults(env.tablets[200].tablet, fmt.Sprintf(sqlReadVDiffDiffs, "'vt_target'", "'vdiffTest'", "'t1'", "'Found'", "''", vdiffRepairBatchSize), vdiffDiffsResult(2, 3, 4))
+
+	testcases := []struct {
+		repair  string
+		applied bool
+		fixed   bool
+	}{{
+		repair: VDiffRepairRecord,
+	}, {
+		repair: VDiffRepairDryRun,
+		fixed:  true,
+	}, {
+		repair:  VDiffRepairApply,
+		applied: true,
+		fixed:   true,
+	}}
+	dir, err := ioutil.TempDir("", "vdiff_repair")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	for _, tcase := range testcases {
+		t.Run(tcase.repair, func(t *testing.T) {
+			repairFile := ""
+			if tcase.fixed {
+				repairFile = path.Join(dir, tcase.repair+".sql")
+			}
+			env.tablets[101].setResults(query, vdiffSourceGtid, sqltypes.MakeTestStreamingResults(fields, source...))
+			env.tablets[201].setResults(query, vdiffTargetMasterPosition, sqltypes.MakeTestStreamingResults(fields, target...))
+			env.tablets[101].setResults(repairQuery, vdiffSourceGtid, sqltypes.MakeTestStreamingResults(fields, source[1:]...))
+			env.tablets[201].setResults(repairQuery, vdiffTargetMasterPosition, sqltypes.MakeTestStreamingResults(fields, target[1:]...))
+			env.tmc.mu.Lock()
+			env.tmc.dbaLog = nil
+			env.tmc.mu.Unlock()
+
+			dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "", 1, 1, false, tcase.repair, 0, repairFile)
+			require.NoError(t, err)
+			assert.Equal(t, &DiffReport{ProcessedRows: 4, MatchingRows: 1, MismatchedRows: 1, ExtraRowsSource: 1, ExtraRowsTarget: 1}, dr["t1"])
+
+			env.tmc.mu.Lock()
+			defer env.tmc.mu.Unlock()
+			log := strings.Join(env.tmc.dbaLog, "\n")
+			assert.Contains(t, log, "200: delete from _vt.vdiff_diff where db_name='vt_target' and workflow='vdiffTest'")
+			for _, diff := range []string{"'Mismatch'", "'ExtraTarget'", "'ExtraSource'"} {
+				assert.Contains(t, log, diff)
+			}
+			fixes := []string{
+				"update t1 set c2 = 3 where c1 = 2",
+				"delete from t1 where c1 = 3",
+				"insert into t1(c1, c2) values (4, 1)",
+			}
+			for _, fix := range fixes {
+				if tcase.applied {
+					assert.Contains(t, log, "200: "+fix)
+				} else {
+					assert.NotContains(t, log, "200: "+fix)
+				}
+				if tcase.fixed {
+					assert.Contains(t, log, encodeString(fix))
+				} else {
+					assert.NotContains(t, log, fix)
+				}
+			}
+			if repairFile != "" {
+				data, err := ioutil.ReadFile(repairFile)
+				require.NoError(t, err)
+				want := fmt.Sprintf("-- VDiff %s of target.vdiffTest\n%s;\n", tcase.repair, strings.Join(fixes, ";\n"))
+				assert.Equal(t, want, string(data))
+			}
+		})
+	}
+
+	_, err = env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "", 1, 1, false, "fix", 0, "")
+	assert.EqualError(t, err, `invalid repair mode "fix", must be one of "record", "dry_run" or "apply"`)
+	_, err = env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "", 1, 1, false, VDiffRepairRecord, 0, path.Join(dir, "record.sql"))
+	assert.EqualError(t, err, `a repair file needs the repair mode "dry_run" or "apply"`)
+}
+
+func TestVDiffRepairPages(t *testing.T) {
+	env := newTestVDiffEnv([]string{"0"}, []string{"0"}, "", nil)
+	defer env.close()
+
+	saved := vdiffRepairBatchSize
+	defer func() { vdiffRepairBatchSize = saved }()
+	vdiffRepairBatchSize = 2
+
+	env.tmc.schema = &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:              "t1",
+			Columns:           []string{"c1", "c2"},
+			PrimaryKeyColumns: []string{"c1"},
+			Fields:            sqltypes.MakeTestFields("c1|c2", "int64|int64"),
+		}},
+	}
+	fields := sqltypes.MakeTestFields(
+		"c1|c2",
+		"int64|int64",
+	)
+	source := []string{"1|3", "2|3", "4|1"}
+	target := []string{"1|3", "2|4", "3|1"}
+	query := "select c1, c2 from t1 order by c1 asc"
+	env.tablets[101].setResults(query, vdiffSourceGtid, sqltypes.MakeTestStreamingResults(fields, source...))
+	env.tablets[201].setResults(query, vdiffTargetMasterPosition, sqltypes.MakeTestStreamingResults(fields, target...))
+
+	// The second page starts after the last pk_hash of the first one,
+	// and is the last one because it isn't full.
+	lastHash := vdiffPKHash(encodeVDiffValues([]sqltypes.Value{sqltypes.NewInt64(3)}))
+	env.tmc.setDBAResults(env.tablets[200].tablet, fmt.Sprintf(sqlReadVDiffDiffs, "'vt_target'", "'vdiffTest'", "'t1'", "'Found'", "''", 2), vdiffDiffsResult(2, 3))
+	env.tmc.setDBAResults(env.tablets[200].tablet, fmt.Sprintf(sqlReadVDiffDiffs, "'vt_target'", "'vdiffTest'", "'t1'", "'Found'", encodeString(lastHash), 2), vdiffDiffsResult(4))
+	firstQuery := "select c1, c2 from t1 where c1 in (2, 3) order by c1 asc"
+	env.tablets[101].setResults(firstQuery, vdiffSourceGtid, sqltypes.MakeTestStreamingResults(fields, source[1]))
+	env.tablets[201].setResults(firstQuery, vdiffTargetMasterPosition, sqltypes.MakeTestStreamingResults(fields, target[1:]...))
+	secondQuery := "select c1, c2 from t1 where c1 in (4) order by c1 asc"
+	env.tablets[101].setResults(secondQuery, vdiffSourceGtid, sqltypes.MakeTestStreamingResults(fields, source[2]))
+	env.tablets[201].setResults(secondQuery, vdiffTargetMasterPosition, sqltypes.MakeTestStreamingResults(fields))
+
+	_, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "", 1, 1, false, VDiffRepairApply, 0, "")
+	require.NoError(t, err)
+
+	env.tmc.mu.Lock()
+	defer env.tmc.mu.Unlock()
+	log := strings.Join(env.tmc.dbaLog, "\n")
+	for _, fix := range []string{
+		"update t1 set c2 = 3 where c1 = 2",
+		"delete from t1 where c1 = 3",
+		"insert into t1(c1, c2) values (4, 1)",
+	} {
+		assert.Contains(t, log, "200: "+fix)
+	}
+	// There is no third page.
+	assert.Equal(t, 2, strings.Count(log, "select pk, pk_hash from _vt.vdiff_diff"))
+}
+
+func TestVDiffRecordLongPK(t *testing.T) {
+	env := newTestVDiffEnv([]string{"0"}, []string{"0"}, "", nil)
+	defer env.close()
+
+	env.tmc.schema = &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:              "t1",
+			Columns:           []string{"c1", "c2"},
+			PrimaryKeyColumns: []string{"c1"},
+			Fields:            sqltypes.MakeTestFields("c1|c2", "varbinary|int64"),
+		}},
+	}
+	fields := sqltypes.MakeTestFields(
+		"c1|c2",
+		"varbinary|int64",
+	)
+	longPK := strings.Repeat("x", maxVDiffPKLen)
+	query := "select c1, c2 from t1 order by c1 asc"
+	env.tablets[101].setResults(query, vdiffSourceGtid, sqltypes.MakeTestStreamingResults(fields, longPK+"|1"))
+	env.tablets[201].setResults(query, vdiffTargetMasterPosition, sqltypes.MakeTestStreamingResults(fields, longPK+"|2"))
+
+	_, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "", 1, 1, false, VDiffRepairRecord, 0, "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot record a difference in table t1: the encoded primary key is")
+}
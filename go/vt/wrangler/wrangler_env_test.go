@@ -310,3 +310,13 @@ func (tmc *testWranglerTMClient) ExecuteFetchAsApp(ctx context.Context, tablet *
 	}
 	return result, nil
 }
+
+func (tmc *testWranglerTMClient) ExecuteFetchAsDba(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, query []byte, maxRows int, disableBinlogs, reloadSchema bool) (*querypb.QueryResult, error) {
+	t := wranglerEnv.tablets[int(tablet.Alias.Uid)]
+	t.gotQueries = append(t.gotQueries, string(query))
+	result, ok := t.queryResults[string(query)]
+	if !ok {
+		return nil, fmt.Errorf("query %q not found for tablet %d", query, tablet.Alias.Uid)
+	}
+	return result, nil
+}
@@ -9,6 +9,7 @@ import { Observable } from 'rxjs/Observable';
 @Injectable()
 export class FeaturesService {
   activeReparents = false;
+  resolveTransactions = false;
   showStatus = false;
   showTopologyCRUD = false;
   showWorkflows = false;
@@ -18,6 +19,7 @@ export class FeaturesService {
   constructor(private http: Http) {
     this.getFeatures().subscribe(update => {
       this.activeReparents = update.activeReparents;
+      this.resolveTransactions = update.resolveTransactions;
       this.showStatus = update.showStatus;
       this.showTopologyCRUD = update.showTopologyCRUD;
       this.showWorkflows = update.showWorkflows;
@@ -0,0 +1,26 @@
+import { Http } from '@angular/http';
+import { Injectable } from '@angular/core';
+
+import { Observable } from 'rxjs/Observable';
+
+// TransactionService lists and resolves the unresolved distributed (2PC)
+// transactions of a keyspace through the api/transactions/ endpoint.
+@Injectable()
+export class TransactionService {
+  private transactionsUrl = '../api/transactions/';
+
+  constructor(private http: Http) {}
+
+  getTransactions(keyspaceName: string): Observable<any> {
+    return this.http.get(this.transactionsUrl + keyspaceName)
+      .map(resp => resp.json());
+  }
+
+  // resolveTransaction asks vtctld to commit or roll back the transaction,
+  // according to action. vtctld refuses an action that contradicts the
+  // decision of the metadata manager.
+  resolveTransaction(dtid: string, action: string): Observable<any> {
+    return this.http.post(this.transactionsUrl + encodeURIComponent(dtid) + '?action=' + action, '')
+      .map(resp => resp.json());
+  }
+}
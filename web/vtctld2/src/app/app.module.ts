@@ -29,6 +29,7 @@ import { StatusComponent } from './status/status.component';
 import { TopoBrowserComponent } from './topo/topo-browser.component';
 import { TabletComponent } from './dashboard/tablet.component';
 import { TabletPopupComponent } from './status/tablet-popup.component';
+import { TransactionsComponent } from './transactions/transactions.component';
 import { WorkflowListComponent } from './workflows/workflow-list.component';
 
 import { FeaturesService } from './api/features.service';
@@ -38,6 +39,7 @@ import { TabletService } from './api/tablet.service';
 import { TabletStatusService } from './api/tablet-status.service';
 import { TopoDataService } from './api/topo-data.service';
 import { TopologyInfoService } from './api/topology-info.service';
+import { TransactionService } from './api/transaction.service';
 import { VtctlService } from './api/vtctl.service';
 
 @NgModule({
@@ -76,6 +78,7 @@ import { VtctlService } from './api/vtctl.service';
     TopoBrowserComponent,
     TabletComponent,
     TabletPopupComponent,
+    TransactionsComponent,
     WorkflowListComponent,
   ],
   providers: [
@@ -87,6 +90,7 @@ import { VtctlService } from './api/vtctl.service';
     TabletStatusService,
     TopoDataService,
     TopologyInfoService,
+    TransactionService,
     VtctlService,
   ],
   entryComponents: [AppComponent],
@@ -8,6 +8,7 @@ import { ShardComponent } from './dashboard/shard.component';
 import { StatusComponent } from './status/status.component';
 import { TabletComponent } from './dashboard/tablet.component';
 import { TopoBrowserComponent } from './topo/topo-browser.component';
+import { TransactionsComponent } from './transactions/transactions.component';
 import { WorkflowListComponent } from './workflows/workflow-list.component';
 
 export const routes: Routes = [
@@ -18,6 +19,7 @@ export const routes: Routes = [
   { path: 'tablet', component: TabletComponent},
   { path: 'workflows', component: WorkflowListComponent},
   { path: 'topo', component: TopoBrowserComponent },
+  { path: 'transactions', component: TransactionsComponent},
   { path: 'keyspace', component: KeyspaceComponent},
   { path: 'shard', component: ShardComponent},
 ];
@@ -0,0 +1,80 @@
+import { Component, OnInit } from '@angular/core';
+
+import { FeaturesService } from '../api/features.service';
+import { KeyspaceService } from '../api/keyspace.service';
+import { TransactionService } from '../api/transaction.service';
+
+@Component({
+  selector: 'vt-transactions',
+  templateUrl: './transactions.component.html',
+  styleUrls: ['./transactions.component.css', '../styles/vt.style.css'],
+})
+export class TransactionsComponent implements OnInit {
+  keyspaces = [];
+  selectedKeyspace: any;
+  transactions = [];
+  error = '';
+  output = '';
+
+  constructor(private featuresService: FeaturesService,
+              private keyspaceService: KeyspaceService,
+              private transactionService: TransactionService) {}
+
+  ngOnInit() {
+    this.keyspaceService.getKeyspaceNames().subscribe(keyspaceNames => {
+      this.keyspaces = keyspaceNames.map(keyspaceName => {
+        return {label: keyspaceName, value: keyspaceName};
+      });
+      this.keyspaces.sort((a, b) => a.label.localeCompare(b.label));
+      if (this.keyspaces.length > 0) {
+        this.selectedKeyspace = this.keyspaces[0].value;
+        this.getTransactions(this.selectedKeyspace);
+      }
+    });
+  }
+
+  getTransactions(keyspaceName) {
+    this.transactions = [];
+    this.error = '';
+    this.transactionService.getTransactions(keyspaceName).subscribe(transactions => {
+      this.transactions = transactions.map(dt => {
+        return {
+          dtid: dt.Dtid,
+          shard: dt.Shard,
+          state: dt.State,
+          created: dt.Created,
+          participants: (dt.Participants || []).map(p => p.keyspace + '/' + p.shard).join(', '),
+          prepared: (dt.Prepared || []).join(', '),
+          failed: (dt.Failed || []).join(', '),
+        };
+      });
+    }, error => this.error = error.text ? error.text() : error);
+  }
+
+  // resolveAction returns the only action that is consistent with the
+  // decision of the metadata manager, or '' if the state is unknown.
+  resolveAction(transaction): string {
+    switch (transaction.state) {
+      case 'COMMIT':
+        return 'commit';
+      case 'PREPARE':
+      case 'ROLLBACK':
+        return 'rollback';
+    }
+    return '';
+  }
+
+  canResolve(transaction): boolean {
+    return this.featuresService.resolveTransactions && this.resolveAction(transaction) !== '';
+  }
+
+  resolve(transaction) {
+    let action = this.resolveAction(transaction);
+    this.output = '';
+    this.error = '';
+    this.transactionService.resolveTransaction(transaction.dtid, action).subscribe(resp => {
+      this.output = `${resp.Dtid}: ${resp.Action} done. ${resp.Output}`;
+      this.getTransactions(this.selectedKeyspace);
+    }, error => this.error = error.text ? error.text() : error);
+  }
+}